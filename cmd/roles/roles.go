@@ -55,6 +55,10 @@ import (
 var Params paramtable.GlobalParamTable
 
 func newMsgFactory(localMsg bool) msgstream.Factory {
+	// kafka takes the place of both pulsar and rocksmq once it is configured
+	if Params.KafkaCfg.Address != "" {
+		return msgstream.NewKmsFactory(Params.KafkaCfg.Address)
+	}
 	if localMsg {
 		return msgstream.NewRmsFactory()
 	}
@@ -352,9 +356,10 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	Params.Init()
+
 	// only standalone enable localMsg
 	if local {
-		Params.Init()
 		if err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode); err != nil {
 			log.Error("Failed to set deploy mode: ", zap.Error(err))
 		}
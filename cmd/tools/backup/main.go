@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/backuputil"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"go.uber.org/zap"
+)
+
+var (
+	etcdAddr = flag.String("etcd", "127.0.0.1:2379", "Etcd Endpoint to connect")
+	metaRoot = flag.String("metaRoot", "by-dev/meta", "Etcd meta root path the coordinators register under")
+
+	storageType = flag.String("storage", storage.MinioStorage, "Storage of the binlogs and the backups, minio or local")
+	storagePath = flag.String("path", "/var/lib/milvus/storage", "Root directory of the local storage")
+
+	minioAddr      = flag.String("minio", "localhost:9000", "MinIO Endpoint to connect")
+	minioAccessKey = flag.String("accessKey", "minioadmin", "MinIO access key")
+	minioSecretKey = flag.String("secretKey", "minioadmin", "MinIO secret key")
+	minioBucket    = flag.String("bucket", "a-bucket", "MinIO bucket of the binlogs and the backups")
+	minioUseSSL    = flag.Bool("ssl", false, "Connect to MinIO with SSL")
+
+	restore        = flag.Bool("restore", false, "Restore the backup instead of creating it")
+	backupName     = flag.String("name", "", "Name of the backup")
+	backupRoot     = flag.String("backupRoot", "backup", "Path in the bucket the backups are saved under")
+	dbName         = flag.String("db", "", "Database of the backed up collection, or the database it is restored to, empty means the default database")
+	collectionName = flag.String("collection", "", "Collection to back up, or the name of the restored collection, empty means the backed up one")
+	timestamp      = flag.Uint64("timestamp", 0, "Back up the data written up to the timestamp, 0 means all the data")
+	pinTTL         = flag.Duration("pinTTL", time.Hour, "How long the collection is kept from compaction during the backup, a backup taking longer fails")
+)
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	etcdCli, err := etcd.GetRemoteEtcdClient([]string{*etcdAddr})
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	rootCoord, err := rcc.NewClient(ctx, *metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create RootCoord client", zap.Error(err))
+	}
+	defer rootCoord.Stop()
+	dataCoord, err := dcc.NewClient(ctx, *metaRoot, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create DataCoord client", zap.Error(err))
+	}
+	defer dataCoord.Stop()
+
+	var cm storage.ChunkManager
+	switch *storageType {
+	case storage.MinioStorage:
+		kv, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+			Address:           *minioAddr,
+			AccessKeyID:       *minioAccessKey,
+			SecretAccessKeyID: *minioSecretKey,
+			UseSSL:            *minioUseSSL,
+			BucketName:        *minioBucket,
+		})
+		if err != nil {
+			log.Fatal("failed to connect to minio", zap.Error(err))
+		}
+		cm = storage.NewMinioChunkManager(kv)
+	case storage.LocalStorage:
+		cm = storage.NewLocalChunkManager(*storagePath)
+	default:
+		log.Fatal("unknown storage type", zap.String("storage", *storageType))
+	}
+
+	if *restore {
+		err = backuputil.Restore(ctx, rootCoord, dataCoord, cm, &backuputil.RestoreParam{
+			Name:           *backupName,
+			RootPath:       *backupRoot,
+			DbName:         *dbName,
+			CollectionName: *collectionName,
+		})
+		if err != nil {
+			log.Fatal("failed to restore backup", zap.String("name", *backupName), zap.Error(err))
+		}
+		fmt.Printf("Backup %s restored\n", *backupName)
+		return
+	}
+
+	manifest, err := backuputil.Backup(ctx, rootCoord, dataCoord, cm, &backuputil.BackupParam{
+		Name:           *backupName,
+		RootPath:       *backupRoot,
+		DbName:         *dbName,
+		CollectionName: *collectionName,
+		Timestamp:      *timestamp,
+		PinTTL:         *pinTTL,
+	})
+	if err != nil {
+		log.Fatal("failed to create backup", zap.String("name", *backupName), zap.Error(err))
+	}
+	segmentNum := 0
+	for _, partition := range manifest.GetPartitions() {
+		segmentNum += len(partition.GetSegments())
+	}
+	fmt.Printf("Backup %s of collection %s created, partitions: %d, segments: %d\n",
+		manifest.GetName(), manifest.GetCollectionName(), len(manifest.GetPartitions()), segmentNum)
+}
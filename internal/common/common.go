@@ -44,6 +44,9 @@ const (
 	// DefaultShardsNum defines the default number of shards when creating a collection
 	DefaultShardsNum = int32(2)
 
+	// DefaultPartitionsWithPartitionKey defines the default number of partitions when creating a collection with partition key
+	DefaultPartitionsWithPartitionKey = int64(16)
+
 	// InvalidPartitionID indicates that the partition is not specified. It will be set when the partitionName is empty
 	InvalidPartitionID = int64(-1)
 
@@ -52,6 +55,19 @@ const (
 
 	// NotRegisteredID means node is not registered into etcd.
 	NotRegisteredID = int64(-1)
+
+	// DefaultDBName is the name of the database used when a request doesn't specify one
+	DefaultDBName = "default"
+
+	// DefaultDBID is the ID of the default database, collections created before databases
+	// were introduced belong to it
+	DefaultDBID = int64(0)
+
+	// ImportFailedReasonKey is the key of the failure reason in the infos of an import task
+	ImportFailedReasonKey = "failed_reason"
+
+	// CollectionTTLConfigKey is the key of the collection property holding the time-to-live of entities in seconds
+	CollectionTTLConfigKey = "ttl_seconds"
 )
 
 // Endian is type alias of binary.LittleEndian.
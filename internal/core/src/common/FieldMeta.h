@@ -43,6 +43,10 @@ datatype_sizeof(DataType data_type, int dim = 1) {
             return sizeof(float);
         case DataType::DOUBLE:
             return sizeof(double);
+        case DataType::STRING:
+        case DataType::JSON:
+            // strings and json documents are held as std::string in segments, their lengths in rows vary
+            return sizeof(std::string);
         case DataType::VECTOR_FLOAT:
             return sizeof(float) * dim;
         case DataType::VECTOR_BINARY: {
@@ -73,6 +77,10 @@ datatype_name(DataType data_type) {
             return "float";
         case DataType::DOUBLE:
             return "double";
+        case DataType::STRING:
+            return "string";
+        case DataType::JSON:
+            return "json";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
         case DataType::VECTOR_BINARY: {
@@ -90,6 +98,11 @@ datatype_is_vector(DataType datatype) {
     return datatype == DataType::VECTOR_BINARY || datatype == DataType::VECTOR_FLOAT;
 }
 
+inline bool
+datatype_is_string(DataType datatype) {
+    return datatype == DataType::STRING;
+}
+
 inline bool
 datatype_is_integer(DataType datatype) {
     switch (datatype) {
@@ -139,6 +152,12 @@ class FieldMeta {
         return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
     }
 
+    // json documents are stored the same way as strings
+    bool
+    is_string() const {
+        return type_ == DataType::STRING || type_ == DataType::JSON;
+    }
+
     int64_t
     get_dim() const {
         Assert(is_vector());
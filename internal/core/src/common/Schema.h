@@ -111,6 +111,12 @@ class Schema {
         return total_sizeof_;
     }
 
+    // rows of a schema with string fields have variable lengths
+    bool
+    has_string_field() const {
+        return has_string_field_;
+    }
+
     const std::vector<int64_t>&
     get_sizeof_infos() const {
         return sizeof_infos_;
@@ -146,6 +152,12 @@ class Schema {
         return primary_key_offset_opt_;
     }
 
+    // the primary keys of a schema without a string primary key field are int64
+    bool
+    has_string_primary_key() const {
+        return primary_key_offset_opt_.has_value() && (*this)[primary_key_offset_opt_.value()].is_string();
+    }
+
  public:
     static std::shared_ptr<Schema>
     ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto);
@@ -160,6 +172,7 @@ class Schema {
 
         auto field_sizeof = field_meta.get_sizeof();
         sizeof_infos_.push_back(std::move(field_sizeof));
+        has_string_field_ = has_string_field_ || field_meta.is_string();
         fields_.emplace_back(std::move(field_meta));
         total_sizeof_ += field_sizeof;
     }
@@ -173,6 +186,7 @@ class Schema {
     std::unordered_map<FieldId, FieldOffset> id_offsets_;      // field_id -> offset
     std::vector<int64_t> sizeof_infos_;
     int total_sizeof_ = 0;
+    bool has_string_field_ = false;
     bool is_auto_id_ = true;
     std::optional<FieldOffset> primary_key_offset_opt_;
 };
@@ -20,6 +20,7 @@
 #include <limits>
 #include <string>
 #include <utility>
+#include <variant>
 #include <vector>
 #include <boost/align/aligned_allocator.hpp>
 #include <NamedType/named_type.hpp>
@@ -43,6 +44,10 @@ using VectorArray = proto::schema::VectorField;
 using IdArray = proto::schema::IDs;
 using MetricType = faiss::MetricType;
 
+// a primary key is either an int64 or a string, std::monostate stands for no entity
+using PkType = std::variant<std::monostate, int64_t, std::string>;
+inline const PkType INVALID_PK = std::monostate();
+
 MetricType
 GetMetricType(const std::string& type);
 
@@ -84,7 +89,7 @@ struct SearchResult {
     // TODO(gexi): utilize these fields
     void* segment_;
     std::vector<int64_t> result_offsets_;
-    std::vector<int64_t> primary_keys_;
+    std::vector<PkType> primary_keys_;
     std::vector<std::vector<char>> row_data_;
 };
 
@@ -54,6 +54,7 @@ typedef struct CLoadFieldDataInfo {
 
 typedef struct CLoadDeletedRecordInfo {
     void* timestamps;
+    // int64 primary keys, or string primary keys each encoded as its uint32 length followed by its bytes
     void* primary_keys;
     int64_t row_count;
 } CLoadDeletedRecordInfo;
@@ -70,6 +70,9 @@ struct LogicalBinaryExpr : BinaryExprBase {
 struct TermExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // key path into a json field and the type of the compared values, only set for json fields
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
 
  protected:
     // prevent accidential instantiation
@@ -88,6 +91,9 @@ enum class OpType {
     LessEqual = 4,
     Equal = 5,
     NotEqual = 6,
+    // string only
+    PrefixMatch = 7,
+    PostfixMatch = 8,
 };
 
 static const std::map<std::string, OpType> mapping_ = {
@@ -100,6 +106,9 @@ static const std::map<std::string, OpType> mapping_ = {
 struct UnaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // key path into a json field and the type of the compared values, only set for json fields
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
     OpType op_type_;
 
  protected:
@@ -114,6 +123,9 @@ struct UnaryRangeExpr : Expr {
 struct BinaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // key path into a json field and the type of the compared values, only set for json fields
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
     bool lower_inclusive_;
     bool upper_inclusive_;
 
@@ -138,4 +150,46 @@ struct CompareExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+enum class ArithOpType {
+    Unknown = 0,
+    Add = 1,
+    Sub = 2,
+    Mul = 3,
+    Div = 4,
+    Mod = 5,
+};
+
+// numeric operand of ArithCompareExpr, a column, a constant or an arithmetic of two operands
+struct ArithNode {
+    enum class Kind { Column = 0, Value = 1, Binary = 2 };
+    Kind kind_;
+    // type of the result, double if true, otherwise int64
+    bool is_float_ = false;
+
+    // Kind::Column
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+
+    // Kind::Value
+    int64_t int_value_ = 0;
+    double float_value_ = 0;
+
+    // Kind::Binary
+    ArithOpType op_type_ = ArithOpType::Unknown;
+    std::unique_ptr<ArithNode> left_;
+    std::unique_ptr<ArithNode> right_;
+};
+
+using ArithNodePtr = std::unique_ptr<ArithNode>;
+
+struct ArithCompareExpr : Expr {
+    ArithNodePtr left_;
+    ArithNodePtr right_;
+    OpType op_type_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 }  // namespace milvus::query
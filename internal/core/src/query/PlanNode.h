@@ -30,6 +30,9 @@ struct PlanNode {
     virtual ~PlanNode() = default;
     virtual void
     accept(PlanNodeVisitor&) = 0;
+
+    // entities inserted before expire_ts_ are expired, 0 means never expire
+    Timestamp expire_ts_ = 0;
 };
 
 using PlanNodePtr = std::unique_ptr<PlanNode>;
@@ -66,6 +69,8 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // keep only the limit_ entities with the smallest primary keys, 0 means no limit
+    int64_t limit_ = 0;
 };
 
 }  // namespace milvus::query
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <google/protobuf/text_format.h>
+#include <string>
 
 #include "ExprImpl.h"
 #include "PlanProto.h"
@@ -22,7 +23,7 @@ namespace planpb = milvus::proto::plan;
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
 ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::TermExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<TermExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -36,8 +37,16 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             result->terms_.emplace_back(static_cast<T>(value_proto.int64_val()));
         } else if constexpr (std::is_floating_point_v<T>) {
-            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
-            result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+            // integers are compared with the numbers of json fields as doubles
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                result->terms_.emplace_back(static_cast<T>(value_proto.int64_val()));
+            } else {
+                Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+                result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+            }
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->terms_.emplace_back(value_proto.string_val());
         } else {
             static_assert(always_false<T>);
         }
@@ -48,11 +57,14 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
 template <typename T>
 std::unique_ptr<UnaryRangeExprImpl<T>>
 ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<UnaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
     result->op_type_ = static_cast<OpType>(expr_proto.op());
+    if (result->op_type_ == OpType::PrefixMatch || result->op_type_ == OpType::PostfixMatch) {
+        AssertInfo((std::is_same_v<T, std::string>), "prefix and postfix matches are for strings only");
+    }
 
     auto setValue = [&](T& v, const auto& value_proto) {
         if constexpr (std::is_same_v<T, bool>) {
@@ -62,8 +74,15 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             v = static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
-            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
-            v = static_cast<T>(value_proto.float_val());
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                v = static_cast<T>(value_proto.int64_val());
+            } else {
+                Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+                v = static_cast<T>(value_proto.float_val());
+            }
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -75,7 +94,7 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
 ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<BinaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -88,8 +107,15 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             v = static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
-            Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
-            v = static_cast<T>(value_proto.float_val());
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                v = static_cast<T>(value_proto.int64_val());
+            } else {
+                Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
+                v = static_cast<T>(value_proto.float_val());
+            }
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -101,6 +127,48 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
     return result;
 }
 
+// the values at a key path of json fields are compared as bools, doubles or strings, depending on the given value
+static DataType
+GetJsonValueType(const planpb::GenericValue& value_proto) {
+    switch (value_proto.val_case()) {
+        case planpb::GenericValue::kBoolVal:
+            return DataType::BOOL;
+        case planpb::GenericValue::kInt64Val:
+        case planpb::GenericValue::kFloatVal:
+            return DataType::DOUBLE;
+        case planpb::GenericValue::kStringVal:
+            return DataType::STRING;
+        default:
+            PanicInfo("unsupported json value");
+    }
+}
+
+template <typename ExprType, typename ExtractFunc>
+ExprPtr
+ExtractJsonExprImpl(DataType value_type, const planpb::ColumnInfo& column_info, ExtractFunc extract) {
+    std::unique_ptr<ExprType> result;
+    switch (value_type) {
+        case DataType::BOOL: {
+            result = extract(bool{});
+            break;
+        }
+        case DataType::DOUBLE: {
+            result = extract(double{});
+            break;
+        }
+        case DataType::STRING: {
+            result = extract(std::string{});
+            break;
+        }
+        default: {
+            PanicInfo("unsupported json value type");
+        }
+    }
+    result->nested_path_.assign(column_info.nested_path().begin(), column_info.nested_path().end());
+    result->value_type_ = value_type;
+    return result;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -136,6 +204,7 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     plan_node->placeholder_tag_ = anns_proto.placeholder_tag();
     plan_node->predicate_ = std::move(expr_opt);
     plan_node->search_info_ = std::move(search_info);
+    plan_node->expire_ts_ = plan_node_proto.expire_timestamp();
     return plan_node;
 }
 
@@ -147,6 +216,8 @@ ProtoParser::RetrievePlanNodeFromProto(const planpb::PlanNode& plan_node_proto)
 
     auto plan_node = [&]() -> std::unique_ptr<RetrievePlanNode> { return std::make_unique<RetrievePlanNode>(); }();
     plan_node->predicate_ = std::move(expr_opt);
+    plan_node->expire_ts_ = plan_node_proto.expire_timestamp();
+    plan_node->limit_ = plan_node_proto.limit();
     return plan_node;
 }
 
@@ -221,6 +292,16 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractUnaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                auto value_type = GetJsonValueType(expr_pb.value());
+                auto extract = [&](auto v) -> std::unique_ptr<UnaryRangeExpr> {
+                    return ExtractUnaryRangeExprImpl<decltype(v)>(field_offset, data_type, expr_pb);
+                };
+                return ExtractJsonExprImpl<UnaryRangeExpr>(value_type, column_info, extract);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -260,6 +341,16 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractBinaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractBinaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                auto value_type = GetJsonValueType(expr_pb.lower_value());
+                auto extract = [&](auto v) -> std::unique_ptr<BinaryRangeExpr> {
+                    return ExtractBinaryRangeExprImpl<decltype(v)>(field_offset, data_type, expr_pb);
+                };
+                return ExtractJsonExprImpl<BinaryRangeExpr>(value_type, columnInfo, extract);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -293,6 +384,65 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ArithNodePtr
+ProtoParser::ParseArithExpr(const proto::plan::ArithExpr& expr_pb) {
+    using ppa = proto::plan::ArithExpr;
+    auto result = std::make_unique<ArithNode>();
+    switch (expr_pb.expr_case()) {
+        case ppa::kColumnInfo: {
+            auto& column_info = expr_pb.column_info();
+            auto field_id = FieldId(column_info.field_id());
+            auto field_offset = schema.get_offset(field_id);
+            auto data_type = schema[field_offset].get_data_type();
+            Assert(data_type == static_cast<DataType>(column_info.data_type()));
+            result->kind_ = ArithNode::Kind::Column;
+            result->field_offset_ = field_offset;
+            result->data_type_ = data_type;
+            result->is_float_ = data_type == DataType::FLOAT || data_type == DataType::DOUBLE;
+            break;
+        }
+        case ppa::kValue: {
+            auto& value = expr_pb.value();
+            result->kind_ = ArithNode::Kind::Value;
+            switch (value.val_case()) {
+                case proto::plan::GenericValue::kInt64Val: {
+                    result->int_value_ = value.int64_val();
+                    break;
+                }
+                case proto::plan::GenericValue::kFloatVal: {
+                    result->float_value_ = value.float_val();
+                    result->is_float_ = true;
+                    break;
+                }
+                default:
+                    PanicInfo("unsupported arith value");
+            }
+            break;
+        }
+        case ppa::kBinaryArithExpr: {
+            auto& binary = expr_pb.binary_arith_expr();
+            result->kind_ = ArithNode::Kind::Binary;
+            result->op_type_ = static_cast<ArithOpType>(binary.op());
+            result->left_ = ParseArithExpr(binary.left());
+            result->right_ = ParseArithExpr(binary.right());
+            result->is_float_ = result->left_->is_float_ || result->right_->is_float_;
+            break;
+        }
+        default:
+            PanicInfo("unsupported arith expr proto node");
+    }
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb) {
+    auto result = std::make_unique<ArithCompareExpr>();
+    result->left_ = ParseArithExpr(expr_pb.left());
+    result->right_ = ParseArithExpr(expr_pb.right());
+    result->op_type_ = static_cast<OpType>(expr_pb.op());
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -325,6 +475,16 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractTermExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::STRING: {
+                return ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                auto value_type = expr_pb.values_size() == 0 ? DataType::DOUBLE : GetJsonValueType(expr_pb.values(0));
+                auto extract = [&](auto v) -> std::unique_ptr<TermExpr> {
+                    return ExtractTermExprImpl<decltype(v)>(field_offset, data_type, expr_pb);
+                };
+                return ExtractJsonExprImpl<TermExpr>(value_type, columnInfo, extract);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -378,6 +538,9 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kArithCompareExpr: {
+            return ParseArithCompareExpr(expr_pb.arith_compare_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -38,6 +38,12 @@ class ProtoParser {
     ExprPtr
     ParseCompareExpr(const proto::plan::CompareExpr& expr_pb);
 
+    ArithNodePtr
+    ParseArithExpr(const proto::plan::ArithExpr& expr_pb);
+
+    ExprPtr
+    ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb);
+
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
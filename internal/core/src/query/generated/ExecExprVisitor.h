@@ -42,6 +42,9 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     using RetType = boost::dynamic_bitset<>;
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
@@ -62,10 +65,32 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecStringVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
     template <typename T>
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
 
+    auto
+    ExecStringUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
@@ -78,6 +103,18 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    template <typename T>
+    auto
+    ExecArithNode(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid) -> std::vector<T>;
+
+    template <typename T>
+    auto
+    ExecArithNodeAs(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid) -> std::vector<T>;
+
+    template <typename T, typename CmpFunc>
+    auto
+    ExecArithCompareImpl(ArithCompareExpr& expr, CmpFunc cmp_func) -> RetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -37,6 +37,9 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     using RetType = Json;
 
@@ -42,6 +42,9 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
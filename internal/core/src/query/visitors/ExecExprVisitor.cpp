@@ -10,12 +10,18 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <deque>
+#include <functional>
+#include <limits>
 #include <optional>
+#include <string>
+#include <string_view>
+#include <type_traits>
 #include <utility>
 #include <boost/dynamic_bitset.hpp>
 #include <boost/variant.hpp>
 
 #include "query/ExprImpl.h"
+#include "utils/Json.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
 
@@ -44,10 +50,32 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecStringVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
     template <typename T>
     auto
     ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> RetType;
 
+    auto
+    ExecStringUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType;
+
+    auto
+    ExecStringBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType;
+
+    template <typename T>
+    auto
+    ExecJsonTermVisitorImpl(TermExpr& expr_raw) -> RetType;
+
     template <typename T>
     auto
     ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) -> RetType;
@@ -60,6 +88,18 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    template <typename T>
+    auto
+    ExecArithNode(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid) -> std::vector<T>;
+
+    template <typename T>
+    auto
+    ExecArithNodeAs(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid) -> std::vector<T>;
+
+    template <typename T, typename CmpFunc>
+    auto
+    ExecArithCompareImpl(ArithCompareExpr& expr, CmpFunc cmp_func) -> RetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -174,6 +214,28 @@ ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc index_
     return final_result;
 }
 
+// strings have no index, their chunks are always scanned
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecStringVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<boost::dynamic_bitset<>> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        boost::dynamic_bitset<> result(this_size);
+        auto chunk = segment_.chunk_data<std::string>(field_offset, chunk_id);
+        const std::string* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(data[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
 #pragma clang diagnostic push
 #pragma ide diagnostic ignored "Simplify"
 template <typename T>
@@ -256,6 +318,178 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+auto
+ExecExprVisitor::ExecStringUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<std::string>&>(expr_raw);
+    const auto& val = expr.value_;
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x == val; });
+        }
+        case OpType::NotEqual: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x != val; });
+        }
+        case OpType::GreaterEqual: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x >= val; });
+        }
+        case OpType::GreaterThan: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x > val; });
+        }
+        case OpType::LessEqual: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x <= val; });
+        }
+        case OpType::LessThan: {
+            return ExecStringVisitorImpl(expr.field_offset_, [&val](const std::string& x) { return x < val; });
+        }
+        case OpType::PrefixMatch: {
+            auto elem_func = [&val](const std::string& x) {
+                return x.size() >= val.size() && x.compare(0, val.size(), val) == 0;
+            };
+            return ExecStringVisitorImpl(expr.field_offset_, elem_func);
+        }
+        case OpType::PostfixMatch: {
+            auto elem_func = [&val](const std::string& x) {
+                return x.size() >= val.size() && x.compare(x.size() - val.size(), val.size(), val) == 0;
+            };
+            return ExecStringVisitorImpl(expr.field_offset_, elem_func);
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+auto
+ExecExprVisitor::ExecStringBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<std::string>&>(expr_raw);
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    const auto& val1 = expr.lower_value_;
+    const auto& val2 = expr.upper_value_;
+    if (val1 > val2 || (val1 == val2 && !(lower_inclusive && upper_inclusive))) {
+        RetType res(row_count_, false);
+        return res;
+    }
+    auto elem_func = [&](const std::string& x) {
+        return (lower_inclusive ? val1 <= x : val1 < x) && (upper_inclusive ? x <= val2 : x < val2);
+    };
+    return ExecStringVisitorImpl(expr.field_offset_, elem_func);
+}
+
+// extracts the value at path from a json document, rows without the key or with a value of another type match nothing
+template <typename T>
+static std::optional<T>
+ExtractJsonValue(const std::string& doc, const std::vector<std::string>& path) {
+    auto root = json::parse(doc, nullptr, false);
+    if (root.is_discarded()) {
+        return std::nullopt;
+    }
+    const json* node = &root;
+    for (const auto& key : path) {
+        if (!node->is_object()) {
+            return std::nullopt;
+        }
+        auto iter = node->find(key);
+        if (iter == node->end()) {
+            return std::nullopt;
+        }
+        node = &iter.value();
+    }
+    if constexpr (std::is_same_v<T, bool>) {
+        if (!node->is_boolean()) {
+            return std::nullopt;
+        }
+    } else if constexpr (std::is_floating_point_v<T>) {
+        if (!node->is_number()) {
+            return std::nullopt;
+        }
+    } else if constexpr (std::is_same_v<T, std::string>) {
+        if (!node->is_string()) {
+            return std::nullopt;
+        }
+    } else {
+        static_assert(always_false<T>);
+    }
+    return node->get<T>();
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecJsonUnaryRangeVisitorImpl(UnaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<T>&>(expr_raw);
+    const auto& path = expr.nested_path_;
+    const auto& val = expr.value_;
+    auto exec = [&](auto cmp) {
+        return ExecStringVisitorImpl(expr.field_offset_, [&](const std::string& doc) {
+            auto x = ExtractJsonValue<T>(doc, path);
+            return x.has_value() && cmp(x.value(), val);
+        });
+    };
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            return exec(std::equal_to<T>{});
+        }
+        case OpType::NotEqual: {
+            return exec(std::not_equal_to<T>{});
+        }
+        case OpType::GreaterEqual: {
+            return exec(std::greater_equal<T>{});
+        }
+        case OpType::GreaterThan: {
+            return exec(std::greater<T>{});
+        }
+        case OpType::LessEqual: {
+            return exec(std::less_equal<T>{});
+        }
+        case OpType::LessThan: {
+            return exec(std::less<T>{});
+        }
+        case OpType::PrefixMatch: {
+            if constexpr (std::is_same_v<T, std::string>) {
+                return exec([](const std::string& x, const std::string& v) {
+                    return x.size() >= v.size() && x.compare(0, v.size(), v) == 0;
+                });
+            }
+            PanicInfo("prefix match is for strings only");
+        }
+        case OpType::PostfixMatch: {
+            if constexpr (std::is_same_v<T, std::string>) {
+                return exec([](const std::string& x, const std::string& v) {
+                    return x.size() >= v.size() && x.compare(x.size() - v.size(), v.size(), v) == 0;
+                });
+            }
+            PanicInfo("postfix match is for strings only");
+        }
+        default: {
+            PanicInfo("unsupported range node");
+        }
+    }
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecJsonBinaryRangeVisitorImpl(BinaryRangeExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<T>&>(expr_raw);
+    const auto& path = expr.nested_path_;
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    const auto& val1 = expr.lower_value_;
+    const auto& val2 = expr.upper_value_;
+    if (val1 > val2 || (val1 == val2 && !(lower_inclusive && upper_inclusive))) {
+        RetType res(row_count_, false);
+        return res;
+    }
+    auto elem_func = [&](const std::string& doc) {
+        auto x = ExtractJsonValue<T>(doc, path);
+        if (!x.has_value()) {
+            return false;
+        }
+        auto& v = x.value();
+        return (lower_inclusive ? val1 <= v : val1 < v) && (upper_inclusive ? v <= val2 : v < val2);
+    };
+    return ExecStringVisitorImpl(expr.field_offset_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
@@ -291,6 +525,29 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringUnaryRangeVisitorImpl(expr);
+            break;
+        }
+        case DataType::JSON: {
+            switch (expr.value_type_) {
+                case DataType::BOOL: {
+                    res = ExecJsonUnaryRangeVisitorImpl<bool>(expr);
+                    break;
+                }
+                case DataType::DOUBLE: {
+                    res = ExecJsonUnaryRangeVisitorImpl<double>(expr);
+                    break;
+                }
+                case DataType::STRING: {
+                    res = ExecJsonUnaryRangeVisitorImpl<std::string>(expr);
+                    break;
+                }
+                default:
+                    PanicInfo("unsupported json value type");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -333,6 +590,29 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecStringBinaryRangeVisitorImpl(expr);
+            break;
+        }
+        case DataType::JSON: {
+            switch (expr.value_type_) {
+                case DataType::BOOL: {
+                    res = ExecJsonBinaryRangeVisitorImpl<bool>(expr);
+                    break;
+                }
+                case DataType::DOUBLE: {
+                    res = ExecJsonBinaryRangeVisitorImpl<double>(expr);
+                    break;
+                }
+                case DataType::STRING: {
+                    res = ExecJsonBinaryRangeVisitorImpl<std::string>(expr);
+                    break;
+                }
+                default:
+                    PanicInfo("unsupported json value type");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -342,9 +622,10 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
 
 template <typename Op>
 struct relational {
+    // only viable for comparable operands, e.g. a string is never compared with a number
     template <typename T, typename U>
-    bool
-    operator()(T const& a, U const& b) const {
+    auto
+    operator()(T const& a, U const& b) const -> decltype(Op{}(a, b)) {
         return Op{}(a, b);
     }
     template <typename... T>
@@ -357,7 +638,7 @@ struct relational {
 template <typename Op>
 auto
 ExecExprVisitor::ExecCompareExprDispatcher(CompareExpr& expr, Op op) -> RetType {
-    using number = boost::variant<bool, int8_t, int16_t, int32_t, int64_t, float, double>;
+    using number = boost::variant<bool, int8_t, int16_t, int32_t, int64_t, float, double, std::string_view>;
     auto size_per_chunk = segment_.size_per_chunk();
     auto num_chunk = upper_div(row_count_, size_per_chunk);
     std::deque<RetType> bitsets;
@@ -393,6 +674,10 @@ ExecExprVisitor::ExecCompareExprDispatcher(CompareExpr& expr, Op op) -> RetType
                     auto chunk_data = segment_.chunk_data<double>(offset, chunk_id).data();
                     return [chunk_data](int i) -> const number { return chunk_data[i]; };
                 }
+                case DataType::STRING: {
+                    auto chunk_data = segment_.chunk_data<std::string>(offset, chunk_id).data();
+                    return [chunk_data](int i) -> const number { return std::string_view(chunk_data[i]); };
+                }
                 default:
                     PanicInfo("unsupported datatype");
             }
@@ -456,6 +741,171 @@ ExecExprVisitor::visit(CompareExpr& expr) {
     ret_ = std::move(res);
 }
 
+// apply op on l and r, return false if the result is undefined, e.g. divided by zero or overflow
+template <typename T>
+static bool
+ApplyArithOp(ArithOpType op, T l, T r, T& res) {
+    if constexpr (std::is_integral_v<T>) {
+        switch (op) {
+            case ArithOpType::Add:
+                return !__builtin_add_overflow(l, r, &res);
+            case ArithOpType::Sub:
+                return !__builtin_sub_overflow(l, r, &res);
+            case ArithOpType::Mul:
+                return !__builtin_mul_overflow(l, r, &res);
+            case ArithOpType::Div:
+            case ArithOpType::Mod: {
+                if (r == 0 || (l == std::numeric_limits<T>::min() && r == -1)) {
+                    return false;
+                }
+                res = op == ArithOpType::Div ? l / r : l % r;
+                return true;
+            }
+            default:
+                PanicInfo("unsupported arith op");
+        }
+    } else {
+        switch (op) {
+            case ArithOpType::Add:
+                res = l + r;
+                return true;
+            case ArithOpType::Sub:
+                res = l - r;
+                return true;
+            case ArithOpType::Mul:
+                res = l * r;
+                return true;
+            case ArithOpType::Div: {
+                if (r == 0) {
+                    return false;
+                }
+                res = l / r;
+                return true;
+            }
+            default:
+                PanicInfo("unsupported arith op on floating point");
+        }
+    }
+}
+
+template <typename T>
+auto
+ExecExprVisitor::ExecArithNode(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid)
+    -> std::vector<T> {
+    switch (node.kind_) {
+        case ArithNode::Kind::Column: {
+            auto copy_chunk = [&](auto chunk_data) { return std::vector<T>(chunk_data, chunk_data + size); };
+            switch (node.data_type_) {
+                case DataType::INT8:
+                    return copy_chunk(segment_.chunk_data<int8_t>(node.field_offset_, chunk_id).data());
+                case DataType::INT16:
+                    return copy_chunk(segment_.chunk_data<int16_t>(node.field_offset_, chunk_id).data());
+                case DataType::INT32:
+                    return copy_chunk(segment_.chunk_data<int32_t>(node.field_offset_, chunk_id).data());
+                case DataType::INT64:
+                    return copy_chunk(segment_.chunk_data<int64_t>(node.field_offset_, chunk_id).data());
+                case DataType::FLOAT:
+                    return copy_chunk(segment_.chunk_data<float>(node.field_offset_, chunk_id).data());
+                case DataType::DOUBLE:
+                    return copy_chunk(segment_.chunk_data<double>(node.field_offset_, chunk_id).data());
+                default:
+                    PanicInfo("unsupported datatype");
+            }
+        }
+        case ArithNode::Kind::Value: {
+            if (node.is_float_) {
+                return std::vector<T>(size, static_cast<T>(node.float_value_));
+            }
+            return std::vector<T>(size, static_cast<T>(node.int_value_));
+        }
+        case ArithNode::Kind::Binary: {
+            auto left = ExecArithNodeAs<T>(*node.left_, chunk_id, size, valid);
+            auto right = ExecArithNodeAs<T>(*node.right_, chunk_id, size, valid);
+            std::vector<T> res(size);
+            for (int64_t i = 0; i < size; ++i) {
+                if (valid[i] && !ApplyArithOp<T>(node.op_type_, left[i], right[i], res[i])) {
+                    valid[i] = false;
+                }
+            }
+            return res;
+        }
+        default:
+            PanicInfo("unsupported arith node");
+    }
+}
+
+// evaluate node in its own type, integer arithmetic stays integer arithmetic even under a floating parent
+template <typename T>
+auto
+ExecExprVisitor::ExecArithNodeAs(const ArithNode& node, int64_t chunk_id, int64_t size, RetType& valid)
+    -> std::vector<T> {
+    if (node.is_float_) {
+        auto res = ExecArithNode<double>(node, chunk_id, size, valid);
+        return std::vector<T>(res.begin(), res.end());
+    }
+    auto res = ExecArithNode<int64_t>(node, chunk_id, size, valid);
+    return std::vector<T>(res.begin(), res.end());
+}
+
+template <typename T, typename CmpFunc>
+auto
+ExecExprVisitor::ExecArithCompareImpl(ArithCompareExpr& expr, CmpFunc cmp_func) -> RetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<RetType> bitsets;
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        RetType valid(size);
+        valid.set();
+        auto left = ExecArithNodeAs<T>(*expr.left_, chunk_id, size, valid);
+        auto right = ExecArithNodeAs<T>(*expr.right_, chunk_id, size, valid);
+
+        RetType bitset(size);
+        for (int64_t i = 0; i < size; ++i) {
+            bitset[i] = valid[i] && cmp_func(left[i], right[i]);
+        }
+        bitsets.emplace_back(std::move(bitset));
+    }
+    auto final_result = Assemble(bitsets);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    return final_result;
+}
+
+template <typename T>
+static auto
+ExecArithCompareDispatcher(ArithCompareExpr& expr, T exec) {
+    switch (expr.op_type_) {
+        case OpType::Equal:
+            return exec(std::equal_to<>{});
+        case OpType::NotEqual:
+            return exec(std::not_equal_to<>{});
+        case OpType::GreaterEqual:
+            return exec(std::greater_equal<>{});
+        case OpType::GreaterThan:
+            return exec(std::greater<>{});
+        case OpType::LessEqual:
+            return exec(std::less_equal<>{});
+        case OpType::LessThan:
+            return exec(std::less<>{});
+        default:
+            PanicInfo("unsupported optype");
+    }
+}
+
+void
+ExecExprVisitor::visit(ArithCompareExpr& expr) {
+    RetType res;
+    if (expr.left_->is_float_ || expr.right_->is_float_) {
+        res = ExecArithCompareDispatcher(
+            expr, [&](auto cmp_func) { return ExecArithCompareImpl<double>(expr, cmp_func); });
+    } else {
+        res = ExecArithCompareDispatcher(
+            expr, [&](auto cmp_func) { return ExecArithCompareImpl<int64_t>(expr, cmp_func); });
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    ret_ = std::move(res);
+}
+
 template <typename T>
 auto
 ExecExprVisitor::ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType {
@@ -484,6 +934,19 @@ ExecExprVisitor::ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType {
     return final_result;
 }
 
+template <typename T>
+auto
+ExecExprVisitor::ExecJsonTermVisitorImpl(TermExpr& expr_raw) -> RetType {
+    auto& expr = static_cast<TermExprImpl<T>&>(expr_raw);
+    const auto& path = expr.nested_path_;
+    std::sort(expr.terms_.begin(), expr.terms_.end());
+    auto elem_func = [&](const std::string& doc) {
+        auto x = ExtractJsonValue<T>(doc, path);
+        return x.has_value() && std::binary_search(expr.terms_.begin(), expr.terms_.end(), x.value());
+    };
+    return ExecStringVisitorImpl(expr.field_offset_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(TermExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
@@ -519,6 +982,29 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<double>(expr);
             break;
         }
+        case DataType::STRING: {
+            res = ExecTermVisitorImpl<std::string>(expr);
+            break;
+        }
+        case DataType::JSON: {
+            switch (expr.value_type_) {
+                case DataType::BOOL: {
+                    res = ExecJsonTermVisitorImpl<bool>(expr);
+                    break;
+                }
+                case DataType::DOUBLE: {
+                    res = ExecJsonTermVisitorImpl<double>(expr);
+                    break;
+                }
+                case DataType::STRING: {
+                    res = ExecJsonTermVisitorImpl<std::string>(expr);
+                    break;
+                }
+                default:
+                    PanicInfo("unsupported json value type");
+            }
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -103,6 +103,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder.resize(active_count, true);
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_expire_ts(bitset_holder, node.expire_ts_);
 
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
@@ -138,6 +139,12 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (node.expire_ts_ != 0) {
+        if (bitset_holder.empty()) {
+            bitset_holder.resize(active_count, true);
+        }
+        segment->mask_with_expire_ts(bitset_holder, node.expire_ts_);
+    }
 
     BitsetView view;
     if (!bitset_holder.empty()) {
@@ -9,6 +9,8 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <functional>
+
 #include "query/Plan.h"
 #include "query/generated/ExtractInfoExprVisitor.h"
 
@@ -59,4 +61,18 @@ ExtractInfoExprVisitor::visit(CompareExpr& expr) {
     plan_info_.add_involved_field(expr.right_field_offset_);
 }
 
+void
+ExtractInfoExprVisitor::visit(ArithCompareExpr& expr) {
+    std::function<void(const ArithNode&)> extract = [&](const ArithNode& node) {
+        if (node.kind_ == ArithNode::Kind::Column) {
+            plan_info_.add_involved_field(node.field_offset_);
+        } else if (node.kind_ == ArithNode::Kind::Binary) {
+            extract(*node.left_);
+            extract(*node.right_);
+        }
+    };
+    extract(*expr.left_);
+    extract(*expr.right_);
+}
+
 }  // namespace milvus::query
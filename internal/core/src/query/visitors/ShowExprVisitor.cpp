@@ -98,6 +98,13 @@ ShowExprVisitor::visit(LogicalBinaryExpr& expr) {
     ret_ = this->combine(std::move(extra), expr);
 }
 
+// the type of the values held by expr, json fields hold the type of the values at their key path
+template <typename ExprType>
+static DataType
+ValueType(const ExprType& expr) {
+    return expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
+}
+
 template <typename T>
 static Json
 TermExtract(const TermExpr& expr_raw) {
@@ -111,7 +118,7 @@ ShowExprVisitor::visit(TermExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
     auto terms = [&] {
-        switch (expr.data_type_) {
+        switch (ValueType(expr)) {
             case DataType::BOOL:
                 return TermExtract<bool>(expr);
             case DataType::INT8:
@@ -126,6 +133,8 @@ ShowExprVisitor::visit(TermExpr& expr) {
                 return TermExtract<double>(expr);
             case DataType::FLOAT:
                 return TermExtract<float>(expr);
+            case DataType::STRING:
+                return TermExtract<std::string>(expr);
             default:
                 PanicInfo("unsupported type");
         }
@@ -135,6 +144,9 @@ ShowExprVisitor::visit(TermExpr& expr) {
              {"field_offset", expr.field_offset_.get()},
              {"data_type", datatype_name(expr.data_type_)},
              {"terms", std::move(terms)}};
+    if (expr.data_type_ == DataType::JSON) {
+        res["nested_path"] = expr.nested_path_;
+    }
 
     ret_ = res;
 }
@@ -151,6 +163,9 @@ UnaryRangeExtract(const UnaryRangeExpr& expr_raw) {
              {"data_type", datatype_name(expr->data_type_)},
              {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
              {"value", expr->value_}};
+    if (expr->data_type_ == DataType::JSON) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -158,7 +173,7 @@ void
 ShowExprVisitor::visit(UnaryRangeExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
-    switch (expr.data_type_) {
+    switch (ValueType(expr)) {
         case DataType::BOOL:
             ret_ = UnaryRangeExtract<bool>(expr);
             return;
@@ -180,6 +195,9 @@ ShowExprVisitor::visit(UnaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = UnaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = UnaryRangeExtract<std::string>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -199,6 +217,9 @@ BinaryRangeExtract(const BinaryRangeExpr& expr_raw) {
              {"upper_inclusive", expr->upper_inclusive_},
              {"lower_value", expr->lower_value_},
              {"upper_value", expr->upper_value_}};
+    if (expr->data_type_ == DataType::JSON) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -206,7 +227,7 @@ void
 ShowExprVisitor::visit(BinaryRangeExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
-    switch (expr.data_type_) {
+    switch (ValueType(expr)) {
         case DataType::BOOL:
             ret_ = BinaryRangeExtract<bool>(expr);
             return;
@@ -228,6 +249,9 @@ ShowExprVisitor::visit(BinaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = BinaryRangeExtract<float>(expr);
             return;
+        case DataType::STRING:
+            ret_ = BinaryRangeExtract<std::string>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -248,4 +272,42 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     ret_ = res;
 }
 
+static Json
+ArithNodeToJson(const ArithNode& node) {
+    using proto::plan::ArithOpType;
+    using proto::plan::ArithOpType_Name;
+    switch (node.kind_) {
+        case ArithNode::Kind::Column: {
+            return Json{{"field_offset", node.field_offset_.get()}, {"data_type", datatype_name(node.data_type_)}};
+        }
+        case ArithNode::Kind::Value: {
+            if (node.is_float_) {
+                return Json{{"value", node.float_value_}};
+            }
+            return Json{{"value", node.int_value_}};
+        }
+        case ArithNode::Kind::Binary: {
+            return Json{{"arith_op", ArithOpType_Name(static_cast<ArithOpType>(node.op_type_))},
+                        {"left", ArithNodeToJson(*node.left_)},
+                        {"right", ArithNodeToJson(*node.right_)}};
+        }
+        default: {
+            PanicInfo("unsupported arith node");
+        }
+    }
+}
+
+void
+ShowExprVisitor::visit(ArithCompareExpr& expr) {
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "ArithCompare"},
+             {"left", ArithNodeToJson(*expr.left_)},
+             {"right", ArithNodeToJson(*expr.right_)},
+             {"op", OpType_Name(static_cast<OpType>(expr.op_type_))}};
+    ret_ = res;
+}
+
 }  // namespace milvus::query
@@ -16,6 +16,7 @@
 #include <deque>
 #include <mutex>
 #include <shared_mutex>
+#include <string>
 #include <utility>
 #include <vector>
 
@@ -240,6 +241,14 @@ class ConcurrentVector : public ConcurrentVectorImpl<Type, true> {
     }
 };
 
+template <>
+class ConcurrentVector<std::string> : public ConcurrentVectorImpl<std::string, true> {
+ public:
+    explicit ConcurrentVector(int64_t size_per_chunk)
+        : ConcurrentVectorImpl<std::string, true>::ConcurrentVectorImpl(1, size_per_chunk) {
+    }
+};
+
 template <>
 class ConcurrentVector<FloatVector> : public ConcurrentVectorImpl<float, false> {
  public:
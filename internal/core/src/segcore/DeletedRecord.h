@@ -11,13 +11,18 @@
 
 #pragma once
 
+#include <algorithm>
 #include <memory>
+#include <string>
+#include <tuple>
 #include <utility>
+#include <vector>
 
 #include "AckResponder.h"
 #include "common/Schema.h"
 #include "knowhere/index/vector_index/IndexIVF.h"
 #include "segcore/Record.h"
+#include "segcore/Utils.h"
 
 namespace milvus::segcore {
 
@@ -34,7 +39,8 @@ struct DeletedRecord {
     DeletedRecord()
         : lru_(std::make_shared<TmpBitmap>()),
           timestamps_(deprecated_size_per_chunk),
-          uids_(deprecated_size_per_chunk) {
+          uids_(deprecated_size_per_chunk),
+          string_pks_(deprecated_size_per_chunk) {
         lru_->bitmap_ptr = std::make_shared<faiss::ConcurrentBitset>(0);
     }
 
@@ -56,11 +62,22 @@ struct DeletedRecord {
         lru_ = std::move(new_entry);
     }
 
+    // sort the deletes by timestamp and set them from reserved_begin, primary_keys are int64s,
+    // or strings encoded as their uint32 lengths followed by their bytes if string_pk
+    void
+    set_data(int64_t reserved_begin,
+             int64_t size,
+             const void* primary_keys,
+             const Timestamp* timestamps,
+             bool string_pk);
+
  public:
     std::atomic<int64_t> reserved = 0;
     AckResponder ack_responder_;
     ConcurrentVector<Timestamp> timestamps_;
     ConcurrentVector<idx_t> uids_;
+    // deleted primary keys of a schema with a string primary key, uids_ is left empty then
+    ConcurrentVector<std::string> string_pks_;
     int64_t record_size_ = 0;
 
  private:
@@ -78,4 +95,35 @@ DeletedRecord::TmpBitmap::clone(int64_t capacity) -> std::shared_ptr<TmpBitmap>
     return res;
 }
 
+inline void
+DeletedRecord::set_data(
+    int64_t reserved_begin, int64_t size, const void* primary_keys, const Timestamp* timestamps, bool string_pk) {
+    std::vector<std::tuple<Timestamp, int64_t>> ordering(size);
+    for (int64_t i = 0; i < size; ++i) {
+        ordering[i] = std::make_tuple(timestamps[i], i);
+    }
+    std::sort(ordering.begin(), ordering.end());
+
+    std::vector<Timestamp> sorted_timestamps(size);
+    for (int64_t i = 0; i < size; ++i) {
+        sorted_timestamps[i] = std::get<0>(ordering[i]);
+    }
+    if (string_pk) {
+        auto pks = DecodeStrings(primary_keys, size);
+        std::vector<std::string> sorted_pks(size);
+        for (int64_t i = 0; i < size; ++i) {
+            sorted_pks[i] = std::move(pks[std::get<1>(ordering[i])]);
+        }
+        string_pks_.set_data(reserved_begin, sorted_pks.data(), size);
+    } else {
+        auto pks = reinterpret_cast<const idx_t*>(primary_keys);
+        std::vector<idx_t> sorted_pks(size);
+        for (int64_t i = 0; i < size; ++i) {
+            sorted_pks[i] = pks[std::get<1>(ordering[i])];
+        }
+        uids_.set_data(reserved_begin, sorted_pks.data(), size);
+    }
+    timestamps_.set_data(reserved_begin, sorted_timestamps.data(), size);
+}
+
 }  // namespace milvus::segcore
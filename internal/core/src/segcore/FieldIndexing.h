@@ -130,6 +130,10 @@ class IndexingRecord {
                     continue;
                 }
             }
+            // no small index for strings, they are always scanned
+            if (field.is_string()) {
+                continue;
+            }
 
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
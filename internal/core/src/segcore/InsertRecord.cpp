@@ -56,6 +56,11 @@ InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
                 this->append_field_data<double>(size_per_chunk);
                 break;
             }
+            case DataType::STRING:
+            case DataType::JSON: {
+                this->append_field_data<std::string>(size_per_chunk);
+                break;
+            }
             default: {
                 PanicInfo("unsupported");
             }
@@ -75,6 +75,24 @@ ScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
     return {std::move(dst_ids), std::move(dst_offsets)};
 }
 
+std::vector<SegOffset>
+ScalarIndexVector::find_offsets(idx_t id) const {
+    using Pair = std::pair<T, SegOffset>;
+    auto [iter_beg, iter_end] =
+        std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
+                         [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    std::vector<SegOffset> offsets;
+    for (auto iter = iter_beg; iter != iter_end; ++iter) {
+        offsets.push_back(iter->second);
+    }
+    return offsets;
+}
+
+std::vector<SegOffset>
+ScalarIndexVector::find_offsets(const std::string& id) const {
+    PanicInfo("string id doesn't match int64 primary keys");
+}
+
 void
 ScalarIndexVector::append_data(const ScalarIndexVector::T* ids, int64_t count, SegOffset base) {
     for (int64_t i = 0; i < count; ++i) {
@@ -86,4 +104,59 @@ void
 ScalarIndexVector::build() {
     std::sort(mapping_.begin(), mapping_.end());
 }
+
+std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
+StringScalarIndexVector::do_search_ids(const IdArray& ids) const {
+    auto res_ids = std::make_unique<IdArray>();
+    AssertInfo(ids.has_str_id(), "ids doesn't have str_id field");
+    auto dst_ids = res_ids->mutable_str_id();
+    std::vector<SegOffset> dst_offsets;
+
+    for (auto& id : ids.str_id().data()) {
+        auto offsets = find_offsets(id);
+        if (offsets.empty()) {
+            // no data
+            continue;
+        }
+        dst_ids->add_data(id);
+        dst_offsets.push_back(offsets[0]);
+    }
+    return {std::move(res_ids), std::move(dst_offsets)};
+}
+
+std::pair<std::vector<idx_t>, std::vector<SegOffset>>
+StringScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
+    PanicInfo("int64 ids don't match string primary keys");
+}
+
+std::vector<SegOffset>
+StringScalarIndexVector::find_offsets(idx_t id) const {
+    PanicInfo("int64 id doesn't match string primary keys");
+}
+
+std::vector<SegOffset>
+StringScalarIndexVector::find_offsets(const std::string& id) const {
+    using Pair = std::pair<T, SegOffset>;
+    auto [iter_beg, iter_end] =
+        std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
+                         [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    std::vector<SegOffset> offsets;
+    for (auto iter = iter_beg; iter != iter_end; ++iter) {
+        offsets.push_back(iter->second);
+    }
+    return offsets;
+}
+
+void
+StringScalarIndexVector::append_data(const StringScalarIndexVector::T* ids, int64_t count, SegOffset base) {
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = base + SegOffset(i);
+        mapping_.emplace_back(ids[i], offset);
+    }
+}
+
+void
+StringScalarIndexVector::build() {
+    std::sort(mapping_.begin(), mapping_.end());
+}
 }  // namespace milvus::segcore
@@ -27,6 +27,11 @@ class ScalarIndexBase {
     do_search_ids(const IdArray& ids) const = 0;
     virtual std::pair<std::vector<idx_t>, std::vector<SegOffset>>
     do_search_ids(const std::vector<idx_t>& ids) const = 0;
+    // return all the offsets of the rows with the given id
+    virtual std::vector<SegOffset>
+    find_offsets(idx_t id) const = 0;
+    virtual std::vector<SegOffset>
+    find_offsets(const std::string& id) const = 0;
     virtual ~ScalarIndexBase() = default;
     virtual std::string
     debug() const = 0;
@@ -49,6 +54,12 @@ class ScalarIndexVector : public ScalarIndexBase {
     std::pair<std::vector<idx_t>, std::vector<SegOffset>>
     do_search_ids(const std::vector<idx_t>& ids) const override;
 
+    std::vector<SegOffset>
+    find_offsets(idx_t id) const override;
+
+    std::vector<SegOffset>
+    find_offsets(const std::string& id) const override;
+
     std::string
     debug() const override {
         std::string dbg_str;
@@ -62,4 +73,40 @@ class ScalarIndexVector : public ScalarIndexBase {
     std::vector<std::pair<T, SegOffset>> mapping_;
 };
 
+// index of string primary keys
+class StringScalarIndexVector : public ScalarIndexBase {
+    using T = std::string;
+
+ public:
+    void
+    append_data(const T* ids, int64_t count, SegOffset base);
+
+    void
+    build();
+
+    std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
+    do_search_ids(const IdArray& ids) const override;
+
+    std::pair<std::vector<idx_t>, std::vector<SegOffset>>
+    do_search_ids(const std::vector<idx_t>& ids) const override;
+
+    std::vector<SegOffset>
+    find_offsets(idx_t id) const override;
+
+    std::vector<SegOffset>
+    find_offsets(const std::string& id) const override;
+
+    std::string
+    debug() const override {
+        std::string dbg_str;
+        for (auto& pr : mapping_) {
+            dbg_str += "<" + pr.first + "->" + std::to_string(pr.second.get()) + ">";
+        }
+        return dbg_str;
+    }
+
+ private:
+    std::vector<std::pair<T, SegOffset>> mapping_;
+};
+
 }  // namespace milvus::segcore
@@ -26,7 +26,7 @@ namespace milvus::segcore {
 using SearchResult = milvus::SearchResult;
 struct RowBasedRawData {
     void* raw_data;      // schema
-    int sizeof_per_row;  // alignment
+    int sizeof_per_row;  // alignment, -1 if the rows have variable lengths
     int64_t count;
 };
 
@@ -57,15 +57,13 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
     auto bitmap = current->bitmap_ptr;
     if (del_barrier < old->del_barrier) {
         for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
-            // get uid in delete logs
-            auto uid = deleted_record_.uids_[del_index];
-            // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            auto del_timestamp = deleted_record_.timestamps_[del_index];
+            // map the primary key in delete logs to corresponding offsets, select the max one, which should be
+            // the target, the max one should be closest to the delete timestamp, so the delete log should refer to it,
+            // rows inserted at the same timestamp (e.g. by an upsert) are not affected
             int64_t the_offset = -1;
-            auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
-            for (auto iter = iter_b; iter != iter_e; ++iter) {
-                auto offset = iter->second;
-                if (record_.timestamps_[offset] < query_timestamp) {
+            for (auto offset : find_deleted_offsets(del_index)) {
+                if (record_.timestamps_[offset] < del_timestamp) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -80,18 +78,16 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
         return current;
     } else {
         for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
-            // get uid in delete logs
-            auto uid = deleted_record_.uids_[del_index];
-            // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            auto del_timestamp = deleted_record_.timestamps_[del_index];
+            // map the primary key in delete logs to corresponding offsets, select the max one, which should be
+            // the target, the max one should be closest to the delete timestamp, so the delete log should refer to it,
+            // rows inserted at the same timestamp (e.g. by an upsert) are not affected
             int64_t the_offset = -1;
-            auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
-            for (auto iter = iter_b; iter != iter_e; ++iter) {
-                auto offset = iter->second;
+            for (auto offset : find_deleted_offsets(del_index)) {
                 if (offset >= insert_barrier) {
                     continue;
                 }
-                if (record_.timestamps_[offset] < query_timestamp) {
+                if (record_.timestamps_[offset] < del_timestamp) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -110,6 +106,23 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
     return current;
 }
 
+std::vector<int64_t>
+SegmentGrowingImpl::find_deleted_offsets(int64_t del_index) const {
+    std::vector<int64_t> offsets;
+    if (schema_->has_string_primary_key()) {
+        auto [iter_b, iter_e] = string_pk2offset_.equal_range(deleted_record_.string_pks_[del_index]);
+        for (auto iter = iter_b; iter != iter_e; ++iter) {
+            offsets.push_back(iter->second);
+        }
+    } else {
+        auto [iter_b, iter_e] = uid2offset_.equal_range(deleted_record_.uids_[del_index]);
+        for (auto iter = iter_b; iter != iter_e; ++iter) {
+            offsets.push_back(iter->second);
+        }
+    }
+    return offsets;
+}
+
 BitsetView
 SegmentGrowingImpl::get_filtered_bitmap(const BitsetView& bitset, int64_t ins_barrier, Timestamp timestamp) const {
     auto del_barrier = get_barrier(get_deleted_record(), timestamp);
@@ -142,16 +155,38 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            const Timestamp* timestamps_raw,
                            const RowBasedRawData& entities_raw) {
     AssertInfo(entities_raw.count == size, "Entities_raw count not equal to insert size");
-    // step 1: check schema if valid
-    if (entities_raw.sizeof_per_row != schema_->get_total_sizeof()) {
-        std::string msg = "entity length = " + std::to_string(entities_raw.sizeof_per_row) +
-                          ", schema length = " + std::to_string(schema_->get_total_sizeof());
-        throw std::runtime_error(msg);
+    auto raw_data = reinterpret_cast<const char*>(entities_raw.raw_data);
+    auto sizeof_infos = schema_->get_sizeof_infos();
+
+    // step 1: check schema if valid, and locate the rows
+    std::vector<int64_t> row_begins(size);
+    if (schema_->has_string_field()) {
+        // rows are variable-length, a string is encoded as its uint32 length followed by its bytes
+        int64_t row_begin = 0;
+        for (int i = 0; i < size; ++i) {
+            row_begins[i] = row_begin;
+            for (int fid = 0; fid < schema_->size(); ++fid) {
+                if (schema_->operator[](FieldOffset(fid)).is_string()) {
+                    uint32_t len;
+                    memcpy(&len, raw_data + row_begin, sizeof(len));
+                    row_begin += sizeof(len) + len;
+                } else {
+                    row_begin += sizeof_infos[fid];
+                }
+            }
+        }
+    } else {
+        if (entities_raw.sizeof_per_row != schema_->get_total_sizeof()) {
+            std::string msg = "entity length = " + std::to_string(entities_raw.sizeof_per_row) +
+                              ", schema length = " + std::to_string(schema_->get_total_sizeof());
+            throw std::runtime_error(msg);
+        }
+        for (int i = 0; i < size; ++i) {
+            row_begins[i] = i * entities_raw.sizeof_per_row;
+        }
     }
 
     // step 2: sort timestamp
-    auto raw_data = reinterpret_cast<const char*>(entities_raw.raw_data);
-    auto len_per_row = entities_raw.sizeof_per_row;
     std::vector<std::tuple<Timestamp, idx_t, int64_t>> ordering;
     ordering.resize(size);
     // #pragma omp parallel for
@@ -161,12 +196,14 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
     std::sort(ordering.begin(), ordering.end());
 
     // step 3: and convert row-based data to column-based data accordingly
-    auto sizeof_infos = schema_->get_sizeof_infos();
-    std::vector<int> offset_infos(schema_->size() + 1, 0);
-    std::partial_sum(sizeof_infos.begin(), sizeof_infos.end(), offset_infos.begin() + 1);
     std::vector<aligned_vector<uint8_t>> entities(schema_->size());
+    std::vector<std::vector<std::string>> strings(schema_->size());
 
     for (int fid = 0; fid < schema_->size(); ++fid) {
+        if (schema_->operator[](FieldOffset(fid)).is_string()) {
+            strings[fid].resize(size);
+            continue;
+        }
         auto len = sizeof_infos[fid];
         entities[fid].resize(len * size);
     }
@@ -178,16 +215,23 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
         auto [t, uid, order_index] = ordering[index];
         timestamps[index] = t;
         uids[index] = uid;
+        auto src = raw_data + row_begins[order_index];
         for (int fid = 0; fid < schema_->size(); ++fid) {
+            if (schema_->operator[](FieldOffset(fid)).is_string()) {
+                uint32_t len;
+                memcpy(&len, src, sizeof(len));
+                strings[fid][index].assign(src + sizeof(len), len);
+                src += sizeof(len) + len;
+                continue;
+            }
             auto len = sizeof_infos[fid];
-            auto offset = offset_infos[fid];
-            auto src = raw_data + order_index * len_per_row + offset;
             auto dst = entities[fid].data() + index * len;
             memcpy(dst, src, len);
+            src += len;
         }
     }
 
-    do_insert(reserved_begin, size, uids.data(), timestamps.data(), entities);
+    do_insert(reserved_begin, size, uids.data(), timestamps.data(), entities, strings);
     return Status::OK();
 }
 
@@ -196,13 +240,18 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
                               int64_t size,
                               const idx_t* row_ids,
                               const Timestamp* timestamps,
-                              const std::vector<aligned_vector<uint8_t>>& columns_data) {
+                              const std::vector<aligned_vector<uint8_t>>& columns_data,
+                              const std::vector<std::vector<std::string>>& strings_data) {
     // step 4: fill into Segment.ConcurrentVector
     record_.timestamps_.set_data(reserved_begin, timestamps, size);
     record_.uids_.set_data(reserved_begin, row_ids, size);
     for (int fid = 0; fid < schema_->size(); ++fid) {
         auto field_offset = FieldOffset(fid);
-        record_.get_field_data_base(field_offset)->set_data_raw(reserved_begin, columns_data[fid].data(), size);
+        const void* data = columns_data[fid].data();
+        if (schema_->operator[](field_offset).is_string()) {
+            data = strings_data[fid].data();
+        }
+        record_.get_field_data_base(field_offset)->set_data_raw(reserved_begin, data, size);
     }
 
     if (schema_->get_is_auto_id()) {
@@ -211,6 +260,12 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
             // NOTE: this must be the last step, cannot be put above
             uid2offset_.insert(std::make_pair(row_id, reserved_begin + i));
         }
+    } else if (schema_->has_string_primary_key()) {
+        auto offset = schema_->get_primary_key_offset().value();
+        auto& pks = strings_data[offset.get()];
+        for (int i = 0; i < size; ++i) {
+            string_pk2offset_.insert(std::make_pair(pks[i], reserved_begin + i));
+        }
     } else {
         auto offset = schema_->get_primary_key_offset().value_or(FieldOffset(-1));
         AssertInfo(offset.get() != -1, "Primary key offset is -1");
@@ -231,25 +286,9 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
 Status
 SegmentGrowingImpl::Delete(int64_t reserved_begin,
                            int64_t size,
-                           const int64_t* uids_raw,
+                           const void* primary_keys,
                            const Timestamp* timestamps_raw) {
-    std::vector<std::tuple<Timestamp, idx_t>> ordering;
-    ordering.resize(size);
-    // #pragma omp parallel for
-    for (int i = 0; i < size; ++i) {
-        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
-    }
-    std::sort(ordering.begin(), ordering.end());
-    std::vector<idx_t> uids(size);
-    std::vector<Timestamp> timestamps(size);
-    // #pragma omp parallel for
-    for (int index = 0; index < size; ++index) {
-        auto [t, uid] = ordering[index];
-        timestamps[index] = t;
-        uids[index] = uid;
-    }
-    deleted_record_.timestamps_.set_data(reserved_begin, timestamps.data(), size);
-    deleted_record_.uids_.set_data(reserved_begin, uids.data(), size);
+    deleted_record_.set_data(reserved_begin, size, primary_keys, timestamps_raw, schema_->has_string_primary_key());
     deleted_record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
     return Status::OK();
 }
@@ -343,6 +382,11 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
             bulk_subscript_impl<double>(*vec_ptr, seg_offsets, count, -1.0, output);
             break;
         }
+        case DataType::STRING:
+        case DataType::JSON: {
+            bulk_subscript_impl<std::string>(*vec_ptr, seg_offsets, count, std::string(), output);
+            break;
+        }
         default: {
             PanicInfo("unsupported type");
         }
@@ -374,7 +418,7 @@ template <typename T>
 void
 SegmentGrowingImpl::bulk_subscript_impl(
     const VectorBase& vec_raw, const int64_t* seg_offsets, int64_t count, T default_value, void* output_raw) const {
-    static_assert(IsScalar<T>);
+    static_assert(IsScalar<T> || std::is_same_v<T, std::string>);
     auto vec_ptr = dynamic_cast<const ConcurrentVector<T>*>(&vec_raw);
     AssertInfo(vec_ptr, "Pointer of vec_raw is nullptr");
     auto& vec = *vec_ptr;
@@ -428,6 +472,7 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     std::vector<Timestamp> timestamps(size);
     std::vector<idx_t> row_ids(size);
     AssertInfo(values.count == size, "Insert values count not equal to insert size");
+    AssertInfo(!schema_->has_string_field(), "column based insert of string fields is unsupported");
     for (int64_t i = 0; i < size; ++i) {
         auto offset = indexes[i];
         timestamps[i] = timestamps_raw[offset];
@@ -495,26 +540,32 @@ SegmentGrowingImpl::search_ids(const BitsetView& bitset, Timestamp timestamp) co
 
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
-    AssertInfo(id_array.has_int_id(), "Id array doesn't have int_id element");
-    auto& src_int_arr = id_array.int_id();
     auto res_id_arr = std::make_unique<IdArray>();
-    auto res_int_id_arr = res_id_arr->mutable_int_id();
     std::vector<SegOffset> res_offsets;
-    for (auto uid : src_int_arr.data()) {
-        auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
-        SegOffset the_offset(-1);
-        for (auto iter = iter_b; iter != iter_e; ++iter) {
-            auto offset = SegOffset(iter->second);
-            if (record_.timestamps_[offset.get()] < timestamp) {
-                the_offset = std::max(the_offset, offset);
+    // find the last offset of every id inserted before timestamp, the ids not found are skipped
+    auto search = [&](const auto& pk2offset, const auto& src_ids, auto* res_ids) {
+        for (auto& pk : src_ids) {
+            auto [iter_b, iter_e] = pk2offset.equal_range(pk);
+            SegOffset the_offset(-1);
+            for (auto iter = iter_b; iter != iter_e; ++iter) {
+                auto offset = SegOffset(iter->second);
+                if (record_.timestamps_[offset.get()] < timestamp) {
+                    the_offset = std::max(the_offset, offset);
+                }
             }
+            if (the_offset == SegOffset(-1)) {
+                continue;
+            }
+            res_ids->add_data(pk);
+            res_offsets.push_back(the_offset);
         }
-        // if not found, skip
-        if (the_offset == SegOffset(-1)) {
-            continue;
-        }
-        res_int_id_arr->add_data(uid);
-        res_offsets.push_back(the_offset);
+    };
+    if (schema_->has_string_primary_key()) {
+        AssertInfo(id_array.has_str_id(), "Id array doesn't have str_id element");
+        search(string_pk2offset_, id_array.str_id().data(), res_id_arr->mutable_str_id());
+    } else {
+        AssertInfo(id_array.has_int_id(), "Id array doesn't have int_id element");
+        search(uid2offset_, id_array.int_id().data(), res_id_arr->mutable_int_id());
     }
     return {std::move(res_id_arr), std::move(res_offsets)};
 }
@@ -538,4 +589,17 @@ SegmentGrowingImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_expire_ts(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_ts) const {
+    if (expire_ts == 0) {
+        return;
+    }
+    auto& ts_vec = this->get_insert_record().timestamps_;
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (ts_vec[i] < expire_ts) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
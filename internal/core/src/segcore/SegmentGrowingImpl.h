@@ -61,7 +61,7 @@ class SegmentGrowingImpl : public SegmentGrowing {
 
     // TODO: add id into delete log, possibly bitmap
     Status
-    Delete(int64_t reserverd_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+    Delete(int64_t reserverd_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) override;
 
     int64_t
     GetMemoryUsageInBytes() const override;
@@ -167,6 +167,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expire_ts(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_ts) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -213,7 +216,12 @@ class SegmentGrowingImpl : public SegmentGrowing {
               int64_t size,
               const idx_t* row_ids,
               const Timestamp* timestamps,
-              const std::vector<aligned_vector<uint8_t>>& columns_data);
+              const std::vector<aligned_vector<uint8_t>>& columns_data,
+              const std::vector<std::vector<std::string>>& strings_data = {});
+
+    // all the offsets of the rows with the primary key of the del_index-th delete log
+    std::vector<int64_t>
+    find_deleted_offsets(int64_t del_index) const;
 
  private:
     SegcoreConfig segcore_config_;
@@ -225,6 +233,7 @@ class SegmentGrowingImpl : public SegmentGrowing {
     SealedIndexingRecord sealed_indexing_record_;
 
     tbb::concurrent_unordered_multimap<idx_t, int64_t> uid2offset_;
+    tbb::concurrent_unordered_multimap<std::string, int64_t> string_pk2offset_;
     int64_t id_;
 
  private:
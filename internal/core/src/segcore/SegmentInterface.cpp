@@ -9,6 +9,11 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
+#include <map>
+#include <string>
+#include <vector>
+
 #include "SegmentInterface.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 
@@ -23,19 +28,33 @@ SegmentInternalInterface::FillPrimaryKeys(const query::Plan* plan, SearchResult&
     Assert(results.primary_keys_.size() == 0);
     results.primary_keys_.resize(size);
 
-    auto element_sizeof = sizeof(int64_t);
-    aligned_vector<char> blob(size * element_sizeof);
+    if (get_schema().has_string_primary_key()) {
+        auto key_offset = get_schema().get_primary_key_offset().value();
+        std::vector<std::string> pks(size);
+        bulk_subscript(key_offset, results.ids_.data(), size, pks.data());
+        for (int64_t i = 0; i < size; ++i) {
+            if (results.ids_[i] != INVALID_SEG_OFFSET) {
+                results.primary_keys_[i] = std::move(pks[i]);
+            }
+        }
+        return;
+    }
+
+    std::vector<int64_t> pks(size);
     if (plan->schema_.get_is_auto_id()) {
-        bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, blob.data());
+        bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, pks.data());
     } else {
         auto key_offset_opt = get_schema().get_primary_key_offset();
         AssertInfo(key_offset_opt.has_value(), "Cannot get primary key offset from schema");
         auto key_offset = key_offset_opt.value();
         AssertInfo(get_schema()[key_offset].get_data_type() == DataType::INT64, "Primary key field is not INT64 type");
-        bulk_subscript(key_offset, results.ids_.data(), size, blob.data());
+        bulk_subscript(key_offset, results.ids_.data(), size, pks.data());
+    }
+    for (int64_t i = 0; i < size; ++i) {
+        if (results.ids_[i] != INVALID_SEG_OFFSET) {
+            results.primary_keys_[i] = pks[i];
+        }
     }
-
-    memcpy(results.primary_keys_.data(), blob.data(), element_sizeof * size);
 }
 
 void
@@ -49,8 +68,17 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     std::vector<int64_t> element_sizeofs;
     std::vector<aligned_vector<char>> blobs;
 
-    // fill row_ids
-    {
+    // strings are variable-length so they are kept apart
+    std::map<int, std::vector<std::string>> string_entries;
+
+    // fill row_ids, a string primary key is kept apart as well
+    if (get_schema().has_string_primary_key()) {
+        std::vector<std::string> pks(size);
+        bulk_subscript(get_schema().get_primary_key_offset().value(), results.ids_.data(), size, pks.data());
+        string_entries.emplace(blobs.size(), std::move(pks));
+        blobs.emplace_back();
+        element_sizeofs.push_back(0);
+    } else {
         aligned_vector<char> blob(size * sizeof(int64_t));
         if (plan->schema_.get_is_auto_id()) {
             bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, blob.data());
@@ -69,6 +97,14 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     // fill other entries except primary key
     for (auto field_offset : plan->target_entries_) {
         auto& field_meta = get_schema()[field_offset];
+        if (field_meta.is_string()) {
+            std::vector<std::string> strings(size);
+            bulk_subscript(field_offset, results.ids_.data(), size, strings.data());
+            string_entries.emplace(blobs.size(), std::move(strings));
+            blobs.emplace_back();
+            element_sizeofs.push_back(0);
+            continue;
+        }
         auto element_sizeof = field_meta.get_sizeof();
         aligned_vector<char> blob(size * element_sizeof);
         bulk_subscript(field_offset, results.ids_.data(), size, blob.data());
@@ -82,6 +118,16 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
         int64_t element_offset = 0;
         std::vector<char> target(target_sizeof);
         for (int loc = 0; loc < blobs.size(); ++loc) {
+            if (string_entries.count(loc)) {
+                // a string is encoded as its uint32 length followed by its bytes, the same as in inserted rows
+                auto& str = string_entries.at(loc)[i];
+                uint32_t len = str.size();
+                target.resize(target.size() + sizeof(len) + len);
+                memcpy(target.data() + element_offset, &len, sizeof(len));
+                memcpy(target.data() + element_offset + sizeof(len), str.data(), len);
+                element_offset += sizeof(len) + len;
+                continue;
+            }
             auto element_sizeof = element_sizeofs[loc];
             auto blob_ptr = blobs[loc].data();
             auto src = blob_ptr + element_sizeof * i;
@@ -89,7 +135,7 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
             memcpy(dst, src, element_sizeof);
             element_offset += element_sizeof;
         }
-        assert(element_offset == target_sizeof);
+        assert(element_offset == target.size());
         results.row_data_.emplace_back(std::move(target));
     }
 }
@@ -155,6 +201,22 @@ CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
             obj->mutable_data()->Add(data, data + count);
             break;
         }
+        case DataType::STRING: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_string_data();
+            for (int64_t i = 0; i < count; ++i) {
+                obj->add_data(data[i]);
+            }
+            break;
+        }
+        case DataType::JSON: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_bytes_data();
+            for (int64_t i = 0; i < count; ++i) {
+                obj->add_data(data[i]);
+            }
+            break;
+        }
         default: {
             PanicInfo("unsupported datatype");
         }
@@ -204,6 +266,11 @@ std::unique_ptr<DataArray>
 SegmentInternalInterface::BulkSubScript(FieldOffset field_offset, const SegOffset* seg_offsets, int64_t count) const {
     if (field_offset.get() >= 0) {
         auto& field_meta = get_schema()[field_offset];
+        if (field_meta.is_string()) {
+            std::vector<std::string> data(count);
+            bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
+            return CreateDataArrayFrom(data.data(), count, field_meta);
+        }
         aligned_vector<char> data(field_meta.get_sizeof() * count);
         bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
         return CreateDataArrayFrom(data.data(), count, field_meta);
@@ -215,6 +282,44 @@ SegmentInternalInterface::BulkSubScript(FieldOffset field_offset, const SegOffse
     }
 }
 
+void
+SegmentInternalInterface::limit_by_primary_keys(std::vector<int64_t>& seg_offsets, int64_t limit) const {
+    int64_t size = seg_offsets.size();
+    std::vector<std::pair<PkType, int64_t>> pk_offsets(size);
+    if (get_schema().has_string_primary_key()) {
+        std::vector<std::string> pks(size);
+        bulk_subscript(get_schema().get_primary_key_offset().value(), seg_offsets.data(), size, pks.data());
+        for (int64_t i = 0; i < size; ++i) {
+            pk_offsets[i] = std::make_pair(std::move(pks[i]), seg_offsets[i]);
+        }
+    } else {
+        std::vector<int64_t> pks(size);
+        if (get_schema().get_is_auto_id()) {
+            bulk_subscript(SystemFieldType::RowId, seg_offsets.data(), size, pks.data());
+        } else {
+            auto key_offset_opt = get_schema().get_primary_key_offset();
+            AssertInfo(key_offset_opt.has_value(), "Cannot get primary key offset from schema");
+            auto key_offset = key_offset_opt.value();
+            AssertInfo(get_schema()[key_offset].get_data_type() == DataType::INT64,
+                       "Primary key field is not INT64 type");
+            bulk_subscript(key_offset, seg_offsets.data(), size, pks.data());
+        }
+        for (int64_t i = 0; i < size; ++i) {
+            pk_offsets[i] = std::make_pair(pks[i], seg_offsets[i]);
+        }
+    }
+    std::sort(pk_offsets.begin(), pk_offsets.end());
+
+    // an upserted primary key could be hit by more than one entity, keep the first of them
+    seg_offsets.clear();
+    for (int64_t i = 0; i < size && int64_t(seg_offsets.size()) < limit; ++i) {
+        if (i > 0 && pk_offsets[i].first == pk_offsets[i - 1].first) {
+            continue;
+        }
+        seg_offsets.push_back(pk_offsets[i].second);
+    }
+}
+
 std::unique_ptr<proto::segcore::RetrieveResults>
 SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp timestamp) const {
     std::shared_lock lck(mutex_);
@@ -223,6 +328,11 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     auto retrieve_results = visitor.get_retrieve_result(*plan->plan_node_);
     retrieve_results.segment_ = (void*)this;
 
+    auto limit = plan->plan_node_->limit_;
+    if (limit > 0 && int64_t(retrieve_results.result_offsets_.size()) > limit) {
+        limit_by_primary_keys(retrieve_results.result_offsets_, limit);
+    }
+
     results->mutable_offset()->Add(retrieve_results.result_offsets_.begin(), retrieve_results.result_offsets_.end());
 
     auto fields_data = results->mutable_fields_data();
@@ -234,9 +344,16 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_offset.has_value() && pk_offset.value() == field_offset) {
-            auto int_ids = ids->mutable_int_id();
-            auto src_data = col_data->scalars().long_data();
-            int_ids->mutable_data()->Add(src_data.data().begin(), src_data.data().end());
+            if (plan->schema_.has_string_primary_key()) {
+                auto str_ids = ids->mutable_str_id();
+                for (auto& str : col_data->scalars().string_data().data()) {
+                    str_ids->add_data(str);
+                }
+            } else {
+                auto int_ids = ids->mutable_int_id();
+                auto src_data = col_data->scalars().long_data();
+                int_ids->mutable_data()->Add(src_data.data().begin(), src_data.data().end());
+            }
         }
     }
     return results;
@@ -59,8 +59,10 @@ class SegmentInterface {
     virtual int64_t
     PreDelete(int64_t size) = 0;
 
+    // primary_keys is an array of size int64_t, or of size strings encoded as their uint32 lengths followed by
+    // their bytes if the primary key of the schema is a string
     virtual Status
-    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
+    Delete(int64_t reserved_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) = 0;
 };
 
 // internal API for DSL calculation
@@ -121,6 +123,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // clear the bits of the entities inserted before expire_ts, 0 means never expire
+    virtual void
+    mask_with_expire_ts(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_ts) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -153,6 +159,7 @@ class SegmentInternalInterface : public SegmentInterface {
     bulk_subscript(SystemFieldType system_type, const int64_t* seg_offsets, int64_t count, void* output) const = 0;
 
     // calculate output[i] = Vec[seg_offsets[i]}, where Vec binds to field_offset
+    // output of a string field is an array of count std::string
     virtual void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const = 0;
 
@@ -167,6 +174,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     check_search(const query::Plan* plan) const = 0;
 
+    // keep only the limit offsets of the smallest distinct primary keys, in ascending order of primary key
+    void
+    limit_by_primary_keys(std::vector<int64_t>& seg_offsets, int64_t limit) const;
+
  protected:
     mutable std::shared_mutex mutex_;
 };
@@ -125,6 +125,28 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         // prepare data
         auto field_offset = schema_->get_offset(field_id);
         auto& field_meta = schema_->operator[](field_offset);
+        if (field_meta.is_string()) {
+            // a string is encoded as its uint32 length followed by its bytes
+            auto strings = DecodeStrings(info.blob, info.row_count);
+
+            std::unique_ptr<StringScalarIndexVector> pk_index_;
+            if (schema_->get_primary_key_offset() == field_offset) {
+                pk_index_ = std::make_unique<StringScalarIndexVector>();
+                pk_index_->append_data(strings.data(), info.row_count, SegOffset(0));
+                pk_index_->build();
+            }
+
+            // write data under lock
+            std::unique_lock lck(mutex_);
+            update_row_count(info.row_count);
+            AssertInfo(string_fields_data_[field_offset.get()].empty(), "field data already exists");
+            string_fields_data_[field_offset.get()] = std::move(strings);
+            if (schema_->get_primary_key_offset() == field_offset) {
+                primary_key_index_ = std::move(pk_index_);
+            }
+            set_bit(field_data_ready_bitset_, field_offset, true);
+            return;
+        }
         // Assert(!field_meta.is_vector());
         auto element_sizeof = field_meta.get_sizeof();
         auto span = SpanBase(info.blob, info.row_count, element_sizeof);
@@ -170,12 +192,10 @@ SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
     AssertInfo(info.primary_keys, "Deleted primary keys is null");
     AssertInfo(info.timestamps, "Deleted timestamps is null");
-    auto primary_keys = reinterpret_cast<const idx_t*>(info.primary_keys);
     auto timestamps = reinterpret_cast<const Timestamp*>(info.timestamps);
     int64_t size = info.row_count;
 
-    deleted_record_.uids_.set_data(0, primary_keys, size);
-    deleted_record_.timestamps_.set_data(0, timestamps, size);
+    deleted_record_.set_data(0, size, info.primary_keys, timestamps, schema_->has_string_primary_key());
     deleted_record_.ack_responder_.AddSegment(0, size);
     deleted_record_.reserved.fetch_add(size);
     deleted_record_.record_size_ = size;
@@ -203,6 +223,9 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
                "Can't get bitset element at " + std::to_string(field_offset.get()));
     auto& field_meta = schema_->operator[](field_offset);
     auto element_sizeof = field_meta.get_sizeof();
+    if (field_meta.is_string()) {
+        return SpanBase(string_fields_data_[field_offset.get()].data(), row_count_opt_.value(), element_sizeof);
+    }
     SpanBase base(fields_data_[field_offset.get()].data(), row_count_opt_.value(), element_sizeof);
     return base;
 }
@@ -241,39 +264,45 @@ SegmentSealedImpl::get_deleted_bitmap(int64_t del_barrier,
                                       int64_t insert_barrier,
                                       bool force) const {
     auto old = deleted_record_.get_lru_entry();
+    if (old->bitmap_ptr->count() == insert_barrier && old->del_barrier == del_barrier) {
+        return old;
+    }
 
     auto current = old->clone(insert_barrier);
     current->del_barrier = del_barrier;
     auto bitmap = current->bitmap_ptr;
-    // Sealed segment only has one chunk with chunk_id 0
-    auto span = deleted_record_.uids_.get_span_base(0);
-    auto uids_ptr = reinterpret_cast<const idx_t*>(span.data());
-    auto del_size = deleted_record_.reserved.load();
-    std::vector<idx_t> ids(del_size);
-    std::copy_n(uids_ptr, del_size, ids.data());
-
-    auto [uids, seg_offsets] = primary_key_index_->do_search_ids(ids);
-    for (int i = 0; i < uids.size(); ++i) {
-        bitmap->set(seg_offsets[i].get());
-    }
-    if (uids.size() == 0 || seg_offsets.size() == 0) {
-        return current;
-    }
+    AssertInfo(primary_key_index_, "Primary key index is null");
+
+    // map the primary key in delete logs to the max offset inserted before the delete, which should be the target,
+    // rows inserted at the same timestamp (e.g. by an upsert) are not affected
+    auto get_deleted_offset = [&](int64_t del_index) {
+        auto del_timestamp = deleted_record_.timestamps_[del_index];
+        auto offsets = schema_->has_string_primary_key()
+                           ? primary_key_index_->find_offsets(deleted_record_.string_pks_[del_index])
+                           : primary_key_index_->find_offsets(deleted_record_.uids_[del_index]);
+        int64_t the_offset = -1;
+        for (auto offset : offsets) {
+            if (offset.get() < insert_barrier && timestamps_[offset.get()] < del_timestamp) {
+                the_offset = std::max(the_offset, offset.get());
+            }
+        }
+        return the_offset;
+    };
 
     if (del_barrier < old->del_barrier) {
         for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
-            AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
+            auto the_offset = get_deleted_offset(del_index);
+            // if not found, skip, otherwise, clear the flag
+            if (the_offset != -1) {
                 bitmap->clear(the_offset);
             }
         }
         return current;
     } else {
         for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
-            AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
+            auto the_offset = get_deleted_offset(del_index);
+            // if not found, skip, otherwise, set the flag
+            if (the_offset != -1) {
                 bitmap->set(the_offset);
             }
         }
@@ -398,9 +427,11 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         std::unique_lock lck(mutex_);
         set_bit(field_data_ready_bitset_, field_offset, false);
         auto vec = std::move(fields_data_[field_offset.get()]);
+        auto strings = std::move(string_fields_data_[field_offset.get()]);
         lck.unlock();
 
         vec.clear();
+        strings.clear();
     }
 }
 
@@ -450,6 +481,7 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
 SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema, int64_t segment_id)
     : schema_(schema),
       fields_data_(schema->size()),
+      string_fields_data_(schema->size()),
       field_data_ready_bitset_(schema->size()),
       vecindex_ready_bitset_(schema->size()),
       scalar_indexings_(schema->size()),
@@ -531,6 +563,16 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
             bulk_subscript_impl<double>(src_vec, seg_offsets, count, output);
             break;
         }
+        case DataType::STRING:
+        case DataType::JSON: {
+            auto& src = string_fields_data_[field_offset.get()];
+            auto dst = reinterpret_cast<std::string*>(output);
+            for (int64_t i = 0; i < count; ++i) {
+                auto offset = seg_offsets[i];
+                dst[i] = (offset == INVALID_SEG_OFFSET ? std::string() : src[offset]);
+            }
+            break;
+        }
 
         case DataType::VECTOR_FLOAT:
         case DataType::VECTOR_BINARY: {
@@ -566,8 +608,6 @@ SegmentSealedImpl::HasFieldData(FieldId field_id) const {
 
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
-    AssertInfo(id_array.has_int_id(), "string ids are not implemented");
-    auto arr = id_array.int_id();
     AssertInfo(primary_key_index_, "Primary key index is null");
     return primary_key_index_->do_search_ids(id_array);
 }
@@ -575,24 +615,10 @@ SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) cons
 Status
 SegmentSealedImpl::Delete(int64_t reserved_offset,
                           int64_t row_count,
-                          const int64_t* uids_raw,
+                          const void* primary_keys,
                           const Timestamp* timestamps_raw) {
-    std::vector<std::tuple<Timestamp, idx_t>> ordering(row_count);
-    for (int i = 0; i < row_count; i++) {
-        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
-    }
-    std::sort(ordering.begin(), ordering.end());
-    std::vector<idx_t> src_uids(row_count);
-    std::vector<Timestamp> src_timestamps(row_count);
-
-    for (int i = 0; i < row_count; i++) {
-        auto [t, uid] = ordering[i];
-        src_timestamps[i] = t;
-        src_uids[i] = uid;
-    }
-    auto current_size = deleted_record_.record_size_;
-    deleted_record_.timestamps_.set_data(reserved_offset, src_timestamps.data(), row_count);
-    deleted_record_.uids_.set_data(reserved_offset, src_uids.data(), row_count);
+    deleted_record_.set_data(reserved_offset, row_count, primary_keys, timestamps_raw,
+                             schema_->has_string_primary_key());
     deleted_record_.ack_responder_.AddSegment(reserved_offset, row_count);
     return Status::OK();
 }
@@ -666,4 +692,17 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_expire_ts(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_ts) const {
+    if (expire_ts == 0) {
+        return;
+    }
+    AssertInfo(this->timestamps_.size() == get_row_count(), "Timestamp size not equal to row count");
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (this->timestamps_[i] < expire_ts) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
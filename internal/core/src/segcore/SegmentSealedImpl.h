@@ -84,7 +84,7 @@ class SegmentSealedImpl : public SegmentSealed {
     PreDelete(int64_t size) override;
 
     Status
-    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+    Delete(int64_t reserved_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) override;
 
  protected:
     // blob and row_count
@@ -131,6 +131,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expire_ts(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_ts) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -181,6 +184,7 @@ class SegmentSealedImpl : public SegmentSealed {
     std::unique_ptr<ScalarIndexBase> primary_key_index_;
 
     std::vector<aligned_vector<char>> fields_data_;
+    std::vector<std::vector<std::string>> string_fields_data_;
     mutable DeletedRecord deleted_record_;
 
     SealedIndexingRecord vecindexs_;
@@ -9,8 +9,12 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#pragma once
+
 #include <stdlib.h>
+#include <cstring>
 #include <string>
+#include <vector>
 #include <exception>
 #include <stdexcept>
 
@@ -52,4 +56,18 @@ MetricTypeToString(faiss::MetricType metric_type) {
     }
 }
 
+// decode count strings from blob, each of them is encoded as its uint32 length followed by its bytes
+inline std::vector<std::string>
+DecodeStrings(const void* blob, int64_t count) {
+    auto src = reinterpret_cast<const char*>(blob);
+    std::vector<std::string> strings(count);
+    for (int64_t i = 0; i < count; ++i) {
+        uint32_t len;
+        memcpy(&len, src, sizeof(len));
+        strings[i].assign(src + sizeof(len), len);
+        src += sizeof(len) + len;
+    }
+    return strings;
+}
+
 }  // namespace milvus::segcore
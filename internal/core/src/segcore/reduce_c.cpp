@@ -79,7 +79,7 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
     }
 
     std::vector<std::vector<int64_t>> search_records(num_segments);
-    std::unordered_set<int64_t> pk_set;
+    std::unordered_set<milvus::PkType> pk_set;
     int64_t skip_dup_cnt = 0;
 
     // reduce search results
@@ -110,14 +110,14 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             std::sort(result_pairs.begin(), result_pairs.end(), std::greater<>());
             auto& pilot = result_pairs[0];
             auto index = pilot.index_;
-            int64_t curr_pk = pilot.primary_key_;
+            auto curr_pk = pilot.primary_key_;
             // remove duplicates
-            if (curr_pk == INVALID_ID || pk_set.count(curr_pk) == 0) {
+            if (curr_pk == milvus::INVALID_PK || pk_set.count(curr_pk) == 0) {
                 pilot.search_result_->result_offsets_.push_back(curr_offset++);
                 // when inserted data are dirty, it's possible that primary keys are duplicated,
                 // in this case, "offset_" may be greater than "offset_rb_" (#10530)
                 search_records[index].push_back(pilot.offset_ < pilot.offset_rb_ ? pilot.offset_ : INVALID_OFFSET);
-                if (curr_pk != INVALID_ID) {
+                if (curr_pk != milvus::INVALID_PK) {
                     pk_set.insert(curr_pk);
                 }
             } else {
@@ -137,12 +137,13 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             continue;
         }
 
-        std::vector<int64_t> primary_keys;
+        std::vector<milvus::PkType> primary_keys;
         std::vector<float> distances;
         std::vector<int64_t> ids;
         for (int j = 0; j < search_records[i].size(); j++) {
             auto& offset = search_records[i][j];
-            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset] : INVALID_ID);
+            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset]
+                                                            : milvus::INVALID_PK);
             distances.push_back(offset != INVALID_OFFSET ? search_result->distances_[offset]
                                                          : std::numeric_limits<float>::max());
             ids.push_back(offset != INVALID_OFFSET ? search_result->ids_[offset] : INVALID_ID);
@@ -198,6 +199,8 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
         auto sr = (SearchResult*)c_search_results[0];
         auto topk = sr->topk_;
         auto num_queries = sr->num_queries_;
+        // the string primary keys are only in row data, the hits of them have no int64 ids
+        auto string_pk = ((milvus::segcore::SegmentInterface*)sr->segment_)->get_schema().has_string_primary_key();
 
         std::vector<float> result_distances(num_queries * topk);
         std::vector<std::vector<char>> row_datas(num_queries * topk);
@@ -236,7 +239,9 @@ ReorganizeSearchResults(CMarshaledHits* c_marshaled_hits, CSearchResult* c_searc
                 hits[m].add_scores(result_distances[result_offset]);
                 auto& row_data = row_datas[result_offset];
                 hits[m].add_row_data(row_data.data(), row_data.size());
-                hits[m].add_ids(*(int64_t*)row_data.data());
+                if (!string_pk) {
+                    hits[m].add_ids(*(int64_t*)row_data.data());
+                }
             }
         }
 
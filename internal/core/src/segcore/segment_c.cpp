@@ -174,12 +174,12 @@ CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* primary_keys,
        const uint64_t* timestamps) {
     auto segment = (milvus::segcore::SegmentInterface*)c_segment;
 
     try {
-        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        auto res = segment->Delete(reserved_offset, size, primary_keys, timestamps);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
@@ -74,11 +74,13 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+// primary_keys is an array of int64, or of strings each encoded as its uint32 length followed by its bytes
+// if the primary key of the collection is a string, the same as primary_keys of CLoadDeletedRecordInfo
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* primary_keys,
        const uint64_t* timestamps);
 
 int64_t
@@ -43,6 +43,7 @@ enum class DataType {
     DOUBLE = 11,
 
     STRING = 20,
+    JSON = 23,
 
     VECTOR_BINARY = 100,
     VECTOR_FLOAT = 101,
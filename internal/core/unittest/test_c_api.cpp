@@ -505,7 +505,7 @@ CheckSearchResultDuplicate(const std::vector<CSearchResult>& results) {
     auto num_queries = sr->num_queries_;
 
     // fill primary keys
-    std::vector<int64_t> result_pks(num_queries * topk);
+    std::vector<milvus::PkType> result_pks(num_queries * topk);
     for (int i = 0; i < results.size(); i++) {
         auto search_result = (SearchResult*)results[i];
         auto size = search_result->result_offsets_.size();
@@ -520,7 +520,7 @@ CheckSearchResultDuplicate(const std::vector<CSearchResult>& results) {
 
     // check primary key duplicates
     int64_t cnt = 0;
-    std::unordered_set<int64_t> pk_set;
+    std::unordered_set<milvus::PkType> pk_set;
     for (int qi = 0; qi < num_queries; qi++) {
         pk_set.clear();
         for (int k = 0; k < topk; k++) {
@@ -10,17 +10,21 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <boost/format.hpp>
+#include <google/protobuf/text_format.h>
 #include <gtest/gtest.h>
+#include <random>
 #include <regex>
 
 #include "query/Expr.h"
 #include "query/Plan.h"
 #include "query/PlanNode.h"
+#include "query/PlanProto.h"
 #include "query/generated/ExprVisitor.h"
 #include "query/generated/PlanNodeVisitor.h"
 #include "query/generated/ShowPlanNodeVisitor.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
+#include "segcore/SegmentSealedImpl.h"
 #include "test_utils/DataGen.h"
 #include "utils/Utils.h"
 
@@ -581,3 +585,301 @@ TEST(Expr, TestCompare) {
         }
     }
 }
+
+TEST(Expr, TestArithCompare) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto binary_tpl = R"(
+      binary_arith_expr: <
+        op: %1%
+        left: < %2% >
+        right: < %3% >
+      >)";
+    auto age1 = R"(column_info: < field_id: 101 data_type: Int32 >)";
+    auto age2 = R"(column_info: < field_id: 102 data_type: Int64 >)";
+    auto int_value = [](int64_t v) { return boost::str(boost::format("value: < int64_val: %1% >") % v); };
+    auto float_value = [](double v) { return boost::str(boost::format("value: < float_val: %1% >") % v); };
+    auto binary = [&](const std::string& op, const std::string& left, const std::string& right) {
+        return boost::str(boost::format(binary_tpl) % op % left % right);
+    };
+
+    // age1 + age2 * 2 < 3000
+    // age2 % 7 == 3
+    // age2 / age1 > 1, false if age1 is zero
+    // age1 * 0.5 >= 400
+    std::vector<std::tuple<std::string, std::string, std::string, std::function<bool(int, int64_t)>>> testcases = {
+        {binary("Add", age1, binary("Mul", age2, int_value(2))), int_value(3000), "LessThan",
+         [](int a, int64_t b) { return a + b * 2 < 3000; }},
+        {binary("Mod", age2, int_value(7)), int_value(3), "Equal", [](int a, int64_t b) { return b % 7 == 3; }},
+        {binary("Div", age2, age1), int_value(1), "GreaterThan",
+         [](int a, int64_t b) { return a != 0 && b / a > 1; }},
+        {binary("Mul", age1, float_value(0.5)), int_value(400), "GreaterEqual",
+         [](int a, int64_t b) { return a * 0.5 >= 400; }},
+    };
+
+    auto schema = std::make_shared<Schema>();
+    schema->AddField(FieldName("fakevec"), FieldId(100), DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddField(FieldName("age1"), FieldId(101), DataType::INT32);
+    schema->AddField(FieldName("age2"), FieldId(102), DataType::INT64);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<int> age1_col;
+    std::vector<int64_t> age2_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age1_col = raw_data.get_col<int>(1);
+        auto new_age2_col = raw_data.get_col<int64_t>(2);
+        age1_col.insert(age1_col.end(), new_age1_col.begin(), new_age1_col.end());
+        age2_col.insert(age2_col.end(), new_age2_col.begin(), new_age2_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [left, right, op, ref_func] : testcases) {
+        auto proto_text = boost::str(boost::format(R"(
+arith_compare_expr: <
+  left: < %1% >
+  right: < %2% >
+  op: %3%
+>
+)") % left % right % op);
+        proto::plan::Expr expr_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &expr_proto)) << proto_text;
+        auto expr = ProtoParser(*schema).ParseExpr(expr_proto);
+        auto final = visitor.call_child(*expr);
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+
+            auto val1 = age1_col[i];
+            auto val2 = age2_col[i];
+            auto ref = ref_func(val1, val2);
+            ASSERT_EQ(ans, ref) << proto_text << "@" << i << "!!" << boost::format("[%1%, %2%]") % val1 % val2;
+        }
+    }
+}
+
+TEST(Expr, TestString) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto str1 = R"(column_info: < field_id: 101 data_type: String >)";
+    auto str2 = R"(column_info: < field_id: 102 data_type: String >)";
+    auto unary_tpl = R"(unary_range_expr: < %1% op: %2% value: < string_val: "%3%" > >)";
+    auto unary = [&](const std::string& op, const std::string& value) {
+        return boost::str(boost::format(unary_tpl) % str1 % op % value);
+    };
+
+    std::vector<std::tuple<std::string, std::function<bool(const std::string&, const std::string&)>>> testcases = {
+        {unary("Equal", "42"), [](const std::string& a, const std::string& b) { return a == "42"; }},
+        {unary("NotEqual", "42x"), [](const std::string& a, const std::string& b) { return a != "42x"; }},
+        {unary("LessThan", "5"), [](const std::string& a, const std::string& b) { return a < "5"; }},
+        {unary("GreaterEqual", "7x"), [](const std::string& a, const std::string& b) { return a >= "7x"; }},
+        {unary("PrefixMatch", "4"), [](const std::string& a, const std::string& b) { return a[0] == '4'; }},
+        {unary("PostfixMatch", "2x"),
+         [](const std::string& a, const std::string& b) {
+             return a.size() >= 2 && a.substr(a.size() - 2) == "2x";
+         }},
+        {boost::str(boost::format(R"(binary_range_expr: < %1% lower_inclusive: true upper_inclusive: false
+             lower_value: < string_val: "2" > upper_value: < string_val: "5x" > >)") %
+                    str1),
+         [](const std::string& a, const std::string& b) { return "2" <= a && a < "5x"; }},
+        {boost::str(boost::format(R"(term_expr: < %1% values: < string_val: "1" > values: < string_val: "42x" >
+             values: < string_val: "7xx" > >)") %
+                    str1),
+         [](const std::string& a, const std::string& b) { return a == "1" || a == "42x" || a == "7xx"; }},
+        {R"(compare_expr: < left_column_info: < field_id: 101 data_type: String >
+             right_column_info: < field_id: 102 data_type: String > op: GreaterThan >)",
+         [](const std::string& a, const std::string& b) { return a > b; }},
+        {R"(compare_expr: < left_column_info: < field_id: 101 data_type: String >
+             right_column_info: < field_id: 102 data_type: String > op: Equal >)",
+         [](const std::string& a, const std::string& b) { return a == b; }},
+    };
+
+    auto schema = std::make_shared<Schema>();
+    schema->AddField(FieldName("fakevec"), FieldId(100), DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddField(FieldName("str1"), FieldId(101), DataType::STRING);
+    schema->AddField(FieldName("str2"), FieldId(102), DataType::STRING);
+
+    // a string is encoded as its uint32 length followed by its bytes
+    auto append_string = [](std::vector<uint8_t>& blob, const std::string& str) {
+        uint32_t len = str.size();
+        auto len_ptr = reinterpret_cast<const uint8_t*>(&len);
+        blob.insert(blob.end(), len_ptr, len_ptr + sizeof(len));
+        blob.insert(blob.end(), str.begin(), str.end());
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<std::string> str1_col;
+    std::vector<std::string> str2_col;
+    int num_iters = 10;
+    std::default_random_engine er(42);
+    std::vector<float> vec(16, 1.0);
+    auto vec_ptr = reinterpret_cast<const uint8_t*>(vec.data());
+    for (int iter = 0; iter < num_iters; ++iter) {
+        // rows have variable lengths
+        std::vector<uint8_t> rows;
+        std::vector<idx_t> row_ids(N);
+        std::vector<Timestamp> timestamps(N);
+        for (int i = 0; i < N; ++i) {
+            auto val1 = std::to_string(er() % 100) + std::string(er() % 3, 'x');
+            auto val2 = std::to_string(er() % 100);
+            rows.insert(rows.end(), vec_ptr, vec_ptr + vec.size() * sizeof(float));
+            append_string(rows, val1);
+            append_string(rows, val2);
+            str1_col.push_back(val1);
+            str2_col.push_back(val2);
+            row_ids[i] = iter * N + i;
+            timestamps[i] = iter * N + i;
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, row_ids.data(), timestamps.data(), RowBasedRawData{rows.data(), -1, N});
+    }
+
+    // the same strings loaded into a sealed segment
+    std::vector<uint8_t> str1_blob;
+    std::vector<uint8_t> str2_blob;
+    for (int i = 0; i < N * num_iters; ++i) {
+        append_string(str1_blob, str1_col[i]);
+        append_string(str2_blob, str2_col[i]);
+    }
+    auto sealed = CreateSealedSegment(schema);
+    sealed->LoadFieldData(LoadFieldDataInfo{101, str1_blob.data(), N * num_iters});
+    sealed->LoadFieldData(LoadFieldDataInfo{102, str2_blob.data(), N * num_iters});
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    auto sealed_promote = dynamic_cast<SegmentSealedImpl*>(sealed.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    ExecExprVisitor sealed_visitor(*sealed_promote, sealed_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [proto_text, ref_func] : testcases) {
+        proto::plan::Expr expr_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &expr_proto)) << proto_text;
+        auto expr = ProtoParser(*schema).ParseExpr(expr_proto);
+        auto final = visitor.call_child(*expr);
+        auto sealed_final = sealed_visitor.call_child(*expr);
+        EXPECT_EQ(final.size(), N * num_iters);
+        EXPECT_EQ(sealed_final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto val1 = str1_col[i];
+            auto val2 = str2_col[i];
+            auto ref = ref_func(val1, val2);
+            ASSERT_EQ(final[i], ref) << proto_text << "@" << i << "!!" << boost::format("[%1%, %2%]") % val1 % val2;
+            ASSERT_EQ(sealed_final[i], ref) << proto_text << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestJson) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto ab = R"(column_info: < field_id: 101 data_type: JSON nested_path: "a" nested_path: "b" >)";
+    auto c = R"(column_info: < field_id: 101 data_type: JSON nested_path: "c" >)";
+    auto d = R"(column_info: < field_id: 101 data_type: JSON nested_path: "d" >)";
+    auto unary = [&](const std::string& column, const std::string& op, const std::string& value) {
+        return boost::str(boost::format(R"(unary_range_expr: < %1% op: %2% value: < %3% > >)") % column % op % value);
+    };
+
+    // rows without "a" match no expr on a.b
+    struct Row {
+        std::optional<int64_t> b;
+        std::string c;
+        bool d;
+    };
+    std::vector<std::tuple<std::string, std::function<bool(const Row&)>>> testcases = {
+        {unary(ab, "LessThan", "int64_val: 50"), [](const Row& r) { return r.b.has_value() && r.b.value() < 50; }},
+        {unary(ab, "Equal", "float_val: 10"), [](const Row& r) { return r.b.has_value() && r.b.value() == 10; }},
+        {unary(ab, "NotEqual", "int64_val: 10"), [](const Row& r) { return r.b.has_value() && r.b.value() != 10; }},
+        {unary(c, "Equal", R"(string_val: "x3")"), [](const Row& r) { return r.c == "x3"; }},
+        {unary(c, "PrefixMatch", R"(string_val: "x1")"), [](const Row& r) { return r.c.rfind("x1", 0) == 0; }},
+        {unary(d, "Equal", "bool_val: true"), [](const Row& r) { return r.d; }},
+        // a string is never equal to a number
+        {unary(c, "Equal", "int64_val: 3"), [](const Row& r) { return false; }},
+        {boost::str(boost::format(R"(binary_range_expr: < %1% lower_inclusive: true upper_inclusive: false
+             lower_value: < int64_val: 10 > upper_value: < float_val: 20.5 > >)") %
+                    ab),
+         [](const Row& r) { return r.b.has_value() && 10 <= r.b.value() && r.b.value() < 20.5; }},
+        {boost::str(boost::format(R"(term_expr: < %1% values: < int64_val: 1 > values: < float_val: 2.5 >
+             values: < int64_val: 42 > >)") %
+                    ab),
+         [](const Row& r) { return r.b.has_value() && (r.b.value() == 1 || r.b.value() == 42); }},
+    };
+
+    auto schema = std::make_shared<Schema>();
+    schema->AddField(FieldName("fakevec"), FieldId(100), DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddField(FieldName("meta"), FieldId(101), DataType::JSON);
+
+    // a json document is encoded the same way as a string
+    auto append_string = [](std::vector<uint8_t>& blob, const std::string& str) {
+        uint32_t len = str.size();
+        auto len_ptr = reinterpret_cast<const uint8_t*>(&len);
+        blob.insert(blob.end(), len_ptr, len_ptr + sizeof(len));
+        blob.insert(blob.end(), str.begin(), str.end());
+    };
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<Row> rows_ref;
+    std::vector<std::string> docs;
+    int num_iters = 4;
+    std::default_random_engine er(42);
+    std::vector<float> vec(16, 1.0);
+    auto vec_ptr = reinterpret_cast<const uint8_t*>(vec.data());
+    for (int iter = 0; iter < num_iters; ++iter) {
+        std::vector<uint8_t> rows;
+        std::vector<idx_t> row_ids(N);
+        std::vector<Timestamp> timestamps(N);
+        for (int i = 0; i < N; ++i) {
+            Row row;
+            if (er() % 5 != 0) {
+                row.b = er() % 100;
+            }
+            row.c = "x" + std::to_string(er() % 20);
+            row.d = er() % 2 == 0;
+            json doc{{"c", row.c}, {"d", row.d}};
+            if (row.b.has_value()) {
+                doc["a"] = {{"b", row.b.value()}};
+            }
+            rows.insert(rows.end(), vec_ptr, vec_ptr + vec.size() * sizeof(float));
+            append_string(rows, doc.dump());
+            rows_ref.push_back(row);
+            docs.push_back(doc.dump());
+            row_ids[i] = iter * N + i;
+            timestamps[i] = iter * N + i;
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, row_ids.data(), timestamps.data(), RowBasedRawData{rows.data(), -1, N});
+    }
+
+    std::vector<uint8_t> docs_blob;
+    for (auto& doc : docs) {
+        append_string(docs_blob, doc);
+    }
+    auto sealed = CreateSealedSegment(schema);
+    sealed->LoadFieldData(LoadFieldDataInfo{101, docs_blob.data(), N * num_iters});
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    auto sealed_promote = dynamic_cast<SegmentSealedImpl*>(sealed.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    ExecExprVisitor sealed_visitor(*sealed_promote, sealed_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [proto_text, ref_func] : testcases) {
+        proto::plan::Expr expr_proto;
+        ASSERT_TRUE(google::protobuf::TextFormat::ParseFromString(proto_text, &expr_proto)) << proto_text;
+        auto expr = ProtoParser(*schema).ParseExpr(expr_proto);
+        auto final = visitor.call_child(*expr);
+        auto sealed_final = sealed_visitor.call_child(*expr);
+        EXPECT_EQ(final.size(), N * num_iters);
+        EXPECT_EQ(sealed_final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ref = ref_func(rows_ref[i]);
+            ASSERT_EQ(final[i], ref) << proto_text << "@" << i << "!!" << docs[i];
+            ASSERT_EQ(sealed_final[i], ref) << proto_text << "@" << i;
+        }
+    }
+}
@@ -200,6 +200,83 @@ TEST(Retrieve2, LargeTimestamp) {
     }
 }
 
+TEST(Retrieve, ExpireTimestamp) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    uint64_t ts_offset = 100;
+    auto dataset = DataGen(schema, N, 42, ts_offset);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    for (int i = 0; i < N; ++i) {
+        term_expr->terms_.emplace_back(i64_col[i]);
+    }
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0)};
+
+    // entities inserted before ts_offset + expired are filtered out
+    std::vector<int64_t> expired_nums{0, 1, 10, N / 2, N};
+    for (auto expired : expired_nums) {
+        plan->plan_node_->expire_ts_ = expired == 0 ? 0 : ts_offset + expired;
+        auto retrieve_results = segment->Retrieve(plan.get(), ts_offset + N);
+        auto field0 = retrieve_results->fields_data(0);
+        ASSERT_EQ(field0.scalars().long_data().data_size(), N - expired);
+    }
+}
+
+TEST(Retrieve, Limit) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 100;
+    int64_t limit = 10;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(0);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::INT64;
+    for (int i = 0; i < N; ++i) {
+        term_expr->terms_.emplace_back(i64_col[i]);
+    }
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->plan_node_->limit_ = limit;
+    plan->field_offsets_ = std::vector<FieldOffset>{FieldOffset(0), FieldOffset(1)};
+
+    std::vector<int64_t> expected(i64_col.begin(), i64_col.end());
+    std::sort(expected.begin(), expected.end());
+    expected.erase(std::unique(expected.begin(), expected.end()), expected.end());
+    expected.resize(limit);
+
+    auto retrieve_results = segment->Retrieve(plan.get(), 100);
+    ASSERT_EQ(retrieve_results->offset_size(), limit);
+    auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+    ASSERT_EQ(field0_data.data_size(), limit);
+    for (int i = 0; i < limit; ++i) {
+        ASSERT_EQ(field0_data.data(i), expected[i]);
+        ASSERT_EQ(retrieve_results->ids().int_id().data(i), expected[i]);
+    }
+    ASSERT_EQ(retrieve_results->fields_data(1).vectors().float_vector().data_size(), limit * DIM);
+}
+
 TEST(GetEntityByIds, PrimaryKey) {
     auto schema = std::make_shared<Schema>();
     auto fid_64 = schema->AddDebugField("counter_i64", DataType::INT64);
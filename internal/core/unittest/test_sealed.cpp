@@ -14,6 +14,7 @@
 #include "knowhere/index/vector_index/IndexIVF.h"
 #include "knowhere/index/vector_index/VecIndex.h"
 #include "knowhere/index/vector_index/adapter/VectorAdapter.h"
+#include "query/ExprImpl.h"
 #include "segcore/SegmentSealedImpl.h"
 #include "test_utils/DataGen.h"
 
@@ -385,3 +386,103 @@ TEST(Sealed, Delete) {
     segment->Delete(reserved_offset, new_count, reinterpret_cast<const int64_t*>(new_pks.data()),
                     reinterpret_cast<const Timestamp*>(new_timestamps.data()));
 }
+
+TEST(Sealed, DeleteAfterUpsert) {
+    auto schema = std::make_shared<Schema>();
+    auto pk_id = schema->AddDebugField("pk", DataType::INT64);
+    schema->set_primary_key(FieldOffset(0));
+    auto segment = CreateSealedSegment(schema);
+
+    // a flushed segment holding the old and the upserted rows of pk 1,
+    // the delete of the upsert is flushed into the deltalog of the same segment
+    int64_t N = 4;
+    std::vector<idx_t> row_ids{0, 1, 2, 3};
+    std::vector<Timestamp> timestamps{10, 10, 20, 20};
+    std::vector<int64_t> pks{1, 2, 1, 3};
+    segment->LoadFieldData(LoadFieldDataInfo{0, row_ids.data(), N});
+    segment->LoadFieldData(LoadFieldDataInfo{1, timestamps.data(), N});
+    segment->LoadFieldData(LoadFieldDataInfo{pk_id.get(), pks.data(), N});
+
+    std::vector<idx_t> deleted_pks{2, 1, 3};
+    std::vector<Timestamp> deleted_timestamps{15, 20, 20};
+    LoadDeletedRecordInfo info = {deleted_timestamps.data(), deleted_pks.data(), 3};
+    segment->LoadDeletedRecord(info);
+
+    std::vector<uint8_t> tmp_block{0};
+    auto view = BitsetView(tmp_block.data(), N);
+    auto bitset = segment->get_filtered_bitmap(view, N, 30);
+    ASSERT_EQ(bitset.size(), N);
+    // the old row of pk 1 and the row of pk 2 are deleted
+    ASSERT_TRUE(bitset.test(0));
+    ASSERT_TRUE(bitset.test(1));
+    // the upserted row of pk 1 and the row of pk 3 inserted with the deletes are kept
+    ASSERT_FALSE(bitset.test(2));
+    ASSERT_FALSE(bitset.test(3));
+}
+
+TEST(Sealed, StringPrimaryKeyDelete) {
+    auto schema = std::make_shared<Schema>();
+    auto pk_id = schema->AddDebugField("pk", DataType::STRING);
+    schema->set_primary_key(FieldOffset(0));
+    auto segment = CreateSealedSegment(schema);
+
+    // a string is encoded as its uint32 length followed by its bytes
+    auto append_string = [](std::vector<uint8_t>& blob, const std::string& str) {
+        uint32_t len = str.size();
+        auto len_ptr = reinterpret_cast<const uint8_t*>(&len);
+        blob.insert(blob.end(), len_ptr, len_ptr + sizeof(len));
+        blob.insert(blob.end(), str.begin(), str.end());
+    };
+
+    int64_t N = 4;
+    std::vector<idx_t> row_ids{0, 1, 2, 3};
+    std::vector<Timestamp> timestamps{10, 10, 20, 20};
+    std::vector<uint8_t> pks;
+    for (auto& pk : {"a", "bb", "a", "ccc"}) {
+        append_string(pks, pk);
+    }
+    segment->LoadFieldData(LoadFieldDataInfo{0, row_ids.data(), N});
+    segment->LoadFieldData(LoadFieldDataInfo{1, timestamps.data(), N});
+    segment->LoadFieldData(LoadFieldDataInfo{pk_id.get(), pks.data(), N});
+
+    std::vector<uint8_t> deleted_pks;
+    for (auto& pk : {"bb", "a"}) {
+        append_string(deleted_pks, pk);
+    }
+    std::vector<Timestamp> deleted_timestamps{15, 15};
+    LoadDeletedRecordInfo info = {deleted_timestamps.data(), deleted_pks.data(), 2};
+    segment->LoadDeletedRecord(info);
+
+    std::vector<uint8_t> new_pks;
+    append_string(new_pks, "ccc");
+    std::vector<Timestamp> new_timestamps{25};
+    auto reserved_offset = segment->PreDelete(1);
+    ASSERT_EQ(reserved_offset, 2);
+    segment->Delete(reserved_offset, 1, new_pks.data(), new_timestamps.data());
+
+    std::vector<uint8_t> tmp_block{0};
+    auto view = BitsetView(tmp_block.data(), N);
+    auto bitset = segment->get_filtered_bitmap(view, N, 30);
+    ASSERT_EQ(bitset.size(), N);
+    // the old row of "a", the row of "bb" and the row of "ccc" are deleted
+    ASSERT_TRUE(bitset.test(0));
+    ASSERT_TRUE(bitset.test(1));
+    ASSERT_FALSE(bitset.test(2));
+    ASSERT_TRUE(bitset.test(3));
+
+    // retrieve by string primary keys, the string primary key is filled into the ids of the result
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    auto term_expr = std::make_unique<query::TermExprImpl<std::string>>();
+    term_expr->field_offset_ = FieldOffset(0);
+    term_expr->data_type_ = DataType::STRING;
+    term_expr->terms_ = {"ccc", "zz"};
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->field_offsets_ = {FieldOffset(0)};
+
+    auto retrieve_results = segment->Retrieve(plan.get(), 22);
+    ASSERT_EQ(retrieve_results->ids().str_id().data_size(), 1);
+    ASSERT_EQ(retrieve_results->ids().str_id().data(0), "ccc");
+    ASSERT_EQ(retrieve_results->offset_size(), 1);
+    ASSERT_EQ(retrieve_results->offset(0), 3);
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+)
+
+// collectionPin is a lease on a collection, which expires at deadline
+type collectionPin struct {
+	collectionID UniqueID
+	deadline     time.Time
+}
+
+// collectionPins keeps the pinned collections, whose segments are neither compacted nor garbage collected,
+// so that the binlogs of the flushed segments stay readable, e.g. during a backup.
+// The pins are kept in memory only and released after their ttl, a restarted DataCoord forgets all pins.
+type collectionPins struct {
+	mu   sync.Mutex
+	pins map[UniqueID]*collectionPin // pinID -> pin
+}
+
+func newCollectionPins() *collectionPins {
+	return &collectionPins{
+		pins: make(map[UniqueID]*collectionPin),
+	}
+}
+
+// pin pins the collection for ttl with pinID
+func (p *collectionPins) pin(pinID UniqueID, collectionID UniqueID, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pins[pinID] = &collectionPin{
+		collectionID: collectionID,
+		deadline:     time.Now().Add(ttl),
+	}
+}
+
+// unpin releases the pin, it returns false if the pin doesn't exist or has expired
+func (p *collectionPins) unpin(pinID UniqueID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pin, ok := p.pins[pinID]
+	if !ok {
+		return false
+	}
+	delete(p.pins, pinID)
+	return time.Now().Before(pin.deadline)
+}
+
+// isPinned returns whether the collection has an unexpired pin, a nil collectionPins pins nothing
+func (p *collectionPins) isPinned(collectionID UniqueID) bool {
+	if p == nil {
+		return false
+	}
+	now := time.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pinned := false
+	for pinID, pin := range p.pins {
+		if now.After(pin.deadline) {
+			delete(p.pins, pinID)
+			continue
+		}
+		if pin.collectionID == collectionID {
+			pinned = true
+		}
+	}
+	return pinned
+}
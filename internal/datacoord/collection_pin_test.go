@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionPins(t *testing.T) {
+	t.Run("pin and unpin", func(t *testing.T) {
+		pins := newCollectionPins()
+		assert.False(t, pins.isPinned(100))
+
+		pins.pin(1, 100, time.Hour)
+		pins.pin(2, 100, time.Hour)
+		assert.True(t, pins.isPinned(100))
+		assert.False(t, pins.isPinned(101))
+
+		assert.True(t, pins.unpin(1))
+		assert.True(t, pins.isPinned(100))
+		assert.True(t, pins.unpin(2))
+		assert.False(t, pins.isPinned(100))
+		assert.False(t, pins.unpin(2))
+	})
+
+	t.Run("expired pin", func(t *testing.T) {
+		pins := newCollectionPins()
+		pins.pin(1, 100, -time.Second)
+		assert.False(t, pins.isPinned(100))
+		assert.False(t, pins.unpin(1))
+
+		// an expired pin is released by unpin as well
+		pins.pin(2, 100, -time.Second)
+		assert.False(t, pins.unpin(2))
+		assert.Equal(t, 0, len(pins.pins))
+	})
+
+	t.Run("nil pins", func(t *testing.T) {
+		var pins *collectionPins
+		assert.False(t, pins.isPinned(100))
+	})
+}
+
+func TestCompactionTriggerSkipsPinnedCollection(t *testing.T) {
+	pins := newCollectionPins()
+	pins.pin(1, 100, time.Hour)
+	trigger := &compactionTrigger{pins: pins}
+
+	plan, err := trigger.singleCompaction(buildSegment(100, 10, 1, "ch"), true, &compactionSignal{})
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+}
@@ -203,6 +203,10 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
@@ -211,6 +215,11 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction {
 		c.flushCh <- result.GetSegmentID()
 	}
+	if c.plans[planID].plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		for _, segment := range result.GetSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
+	}
 	// TODO: when to clean task list
 
 	return nil
@@ -224,6 +233,10 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return c.meta.CompleteMergeCompaction(plan.GetSegmentBinlogs(), result)
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	return c.meta.CompleteClusteringCompaction(plan.GetSegmentBinlogs(), result)
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
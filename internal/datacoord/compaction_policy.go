@@ -18,8 +18,10 @@ package datacoord
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 type singleCompactionPolicy interface {
@@ -32,6 +34,11 @@ type mergeCompactionPolicy interface {
 	generatePlan(segments []*SegmentInfo, timeTravel *timetravel) []*datapb.CompactionPlan
 }
 
+type clusteringCompactionPolicy interface {
+	// generatePlan generates a compaction plan to cluster the segments by the clustering key, return nil if no plan can be generated.
+	generatePlan(segments []*SegmentInfo, clusteringKey *schemapb.FieldSchema, timeTravel *timetravel) *datapb.CompactionPlan
+}
+
 type singleCompactionFunc func(segment *SegmentInfo, timeTravel *timetravel) *datapb.CompactionPlan
 
 func (f singleCompactionFunc) generatePlan(segment *SegmentInfo, timeTravel *timetravel) *datapb.CompactionPlan {
@@ -136,3 +143,78 @@ func greedyGeneratePlans(sortedSegments []*SegmentInfo, timeTravel *timetravel)
 
 	return plans
 }
+
+type clusteringCompactionFunc func(segments []*SegmentInfo, clusteringKey *schemapb.FieldSchema, timeTravel *timetravel) *datapb.CompactionPlan
+
+func (f clusteringCompactionFunc) generatePlan(segments []*SegmentInfo, clusteringKey *schemapb.FieldSchema, timeTravel *timetravel) *datapb.CompactionPlan {
+	return f(segments, clusteringKey, timeTravel)
+}
+
+// clusterAllSegments clusters all the segments of a channel and partition together, so that the key ranges of
+// the result segments are disjoint. No plan is generated if the segments are clustered already.
+func clusterAllSegments(segments []*SegmentInfo, clusteringKey *schemapb.FieldSchema, timeTravel *timetravel) *datapb.CompactionPlan {
+	if len(segments) == 0 || isClustered(segments, clusteringKey.GetFieldID()) {
+		return nil
+	}
+
+	plan := &datapb.CompactionPlan{
+		Timetravel:           timeTravel.time,
+		Type:                 datapb.CompactionType_ClusteringCompaction,
+		Channel:              segments[0].GetInsertChannel(),
+		ClusteringKeyFieldID: clusteringKey.GetFieldID(),
+		MaxSegmentRows:       segments[0].GetMaxRowNum(),
+	}
+	for _, s := range segments {
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           s.GetID(),
+			FieldBinlogs:        s.GetBinlogs(),
+			Field2StatslogPaths: s.GetStatslogs(),
+			Deltalogs:           s.GetDeltalogs(),
+		})
+	}
+	return plan
+}
+
+// isClustered checks whether every segment has a range of the clustering key and no two ranges overlap
+func isClustered(segments []*SegmentInfo, fieldID int64) bool {
+	ranges := make([]*datapb.FieldRange, 0, len(segments))
+	for _, s := range segments {
+		if s.GetClusteringKeyRange().GetFieldID() != fieldID {
+			return false
+		}
+		ranges = append(ranges, s.GetClusteringKeyRange())
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return compareValueField(ranges[i].GetMin(), ranges[j].GetMin()) < 0
+	})
+	for i := 1; i < len(ranges); i++ {
+		if compareValueField(ranges[i].GetMin(), ranges[i-1].GetMax()) <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// compareValueField compares two values of a field range
+func compareValueField(a, b *schemapb.ValueField) int {
+	switch a.GetData().(type) {
+	case *schemapb.ValueField_LongData:
+		x, y := a.GetLongData(), b.GetLongData()
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	case *schemapb.ValueField_DoubleData:
+		x, y := a.GetDoubleData(), b.GetDoubleData()
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	default:
+		return strings.Compare(a.GetStringData(), b.GetStringData())
+	}
+}
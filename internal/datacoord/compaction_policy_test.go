@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -166,3 +167,51 @@ func Test_chooseAllBinlogs(t *testing.T) {
 		})
 	}
 }
+
+func newClusteredSegment(id int64, min, max int64) *SegmentInfo {
+	return &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            id,
+			InsertChannel: "ch1",
+			MaxRowNum:     100,
+			ClusteringKeyRange: &datapb.FieldRange{
+				FieldID: 101,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: min}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: max}},
+			},
+		},
+	}
+}
+
+func Test_isClustered(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []*SegmentInfo
+		want     bool
+	}{
+		{"test disjoint ranges", []*SegmentInfo{newClusteredSegment(1, 11, 20), newClusteredSegment(2, 1, 10)}, true},
+		{"test overlapped ranges", []*SegmentInfo{newClusteredSegment(1, 1, 10), newClusteredSegment(2, 10, 20)}, false},
+		{"test segment without range", []*SegmentInfo{newClusteredSegment(1, 1, 10), {SegmentInfo: &datapb.SegmentInfo{ID: 2}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isClustered(tt.segments, 101))
+		})
+	}
+}
+
+func Test_clusterAllSegments(t *testing.T) {
+	clusteringKey := &schemapb.FieldSchema{FieldID: 101, DataType: schemapb.DataType_Int64, IsClusteringKey: true}
+
+	plan := clusterAllSegments([]*SegmentInfo{newClusteredSegment(1, 1, 10), newClusteredSegment(2, 11, 20)}, clusteringKey, &timetravel{})
+	assert.Nil(t, plan)
+
+	plan = clusterAllSegments([]*SegmentInfo{newClusteredSegment(1, 1, 10), newClusteredSegment(2, 5, 20)}, clusteringKey, &timetravel{time: 100})
+	assert.NotNil(t, plan)
+	assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plan.GetType())
+	assert.Equal(t, "ch1", plan.GetChannel())
+	assert.Equal(t, int64(101), plan.GetClusteringKeyFieldID())
+	assert.Equal(t, int64(100), plan.GetMaxSegmentRows())
+	assert.Equal(t, uint64(100), plan.GetTimetravel())
+	assert.Equal(t, 2, len(plan.GetSegmentBinlogs()))
+}
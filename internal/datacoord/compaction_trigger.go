@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -25,16 +26,21 @@ import (
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
 const (
-	signalBufferSize                = 100
-	maxLittleSegmentNum             = 10
-	maxCompactionTimeoutInSeconds   = 60
-	singleCompactionRatioThreshold  = 0.2
-	singleCompactionDeltaLogMaxSize = 10 * 1024 * 1024 //10MiB
-	globalCompactionInterval        = 60 * time.Second
+	signalBufferSize                   = 100
+	maxLittleSegmentNum                = 10
+	maxCompactionTimeoutInSeconds      = 60
+	singleCompactionRatioThreshold     = 0.2
+	singleCompactionDeltaLogMaxSize    = 10 * 1024 * 1024 //10MiB
+	clusteringCompactionRatioThreshold = 0.2
+	globalCompactionInterval           = 60 * time.Second
 )
 
 type timetravel struct {
@@ -71,7 +77,10 @@ type compactionTrigger struct {
 	signals                         chan *compactionSignal
 	singleCompactionPolicy          singleCompactionPolicy
 	mergeCompactionPolicy           mergeCompactionPolicy
+	clusteringCompactionPolicy      clusteringCompactionPolicy
 	compactionHandler               compactionPlanContext
+	handler                         Handler
+	pins                            *collectionPins // segments of the pinned collections are not compacted
 	globalTrigger                   *time.Ticker
 	forceMu                         sync.Mutex
 	mergeCompactionSegmentThreshold int
@@ -79,14 +88,17 @@ type compactionTrigger struct {
 	wg                              sync.WaitGroup
 }
 
-func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator) *compactionTrigger {
+func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator, handler Handler, pins *collectionPins) *compactionTrigger {
 	return &compactionTrigger{
 		meta:                            meta,
 		allocator:                       allocator,
 		signals:                         make(chan *compactionSignal, signalBufferSize),
 		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
 		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
+		clusteringCompactionPolicy:      (clusteringCompactionFunc)(clusterAllSegments),
 		compactionHandler:               compactionHandler,
+		handler:                         handler,
+		pins:                            pins,
 		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
 	}
 }
@@ -311,7 +323,12 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 
 	segments := t.getCandidateSegments(channel, partitionID)
 
-	plans := t.mergeCompaction(segments, signal, false)
+	var plans []*datapb.CompactionPlan
+	if clusteringKey := t.getClusteringKey(segment.GetCollectionID()); clusteringKey != nil {
+		plans = t.clusteringCompaction(segments, clusteringKey, signal, false)
+	} else {
+		plans = t.mergeCompaction(segments, signal, false)
+	}
 	if len(plans) != 0 {
 		log.Debug("merge compaction plans", zap.Int64("signalID", signal.id), zap.Int64s("plans", getPlanIDs(plans)))
 	}
@@ -330,14 +347,22 @@ func (t *compactionTrigger) globalMergeCompaction(signal *compactionSignal, isFo
 		return (has || len(collections) == 0) && // if filters collection
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
-			!segment.isCompacting // not compacting now
+			!segment.isCompacting && // not compacting now
+			!t.pins.isPinned(segment.GetCollectionID())
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 	plans := make([]*datapb.CompactionPlan, 0)
 	for _, segments := range m {
 		if !isForce && t.compactionHandler.isFull() {
 			return plans
 		}
-		mplans := t.mergeCompaction(segments.segments, signal, isForce)
+		// the segments of a collection with clustering key are merged by the clustering compaction,
+		// the merge compaction would mix up the key ranges of the clustered segments
+		var mplans []*datapb.CompactionPlan
+		if clusteringKey := t.getClusteringKey(segments.collecionID); clusteringKey != nil {
+			mplans = t.clusteringCompaction(segments.segments, clusteringKey, signal, isForce)
+		} else {
+			mplans = t.mergeCompaction(segments.segments, signal, isForce)
+		}
 		plans = append(plans, mplans...)
 	}
 
@@ -375,12 +400,68 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 	return res
 }
 
+func (t *compactionTrigger) clusteringCompaction(segments []*SegmentInfo, clusteringKey *schemapb.FieldSchema, signal *compactionSignal, isForce bool) []*datapb.CompactionPlan {
+	if !isForce && !shouldDoClusteringCompaction(segments, clusteringKey.GetFieldID()) {
+		return nil
+	}
+
+	plan := t.clusteringCompactionPolicy.generatePlan(segments, clusteringKey, signal.timetravel)
+	if plan == nil {
+		return nil
+	}
+	if !isForce && t.compactionHandler.isFull() {
+		return nil
+	}
+
+	if err := t.fillOriginPlan(plan); err != nil {
+		log.Warn("failed to fill plan", zap.Error(err))
+		return nil
+	}
+
+	log.Debug("exec clustering compaction plan", zap.Any("plan", plan))
+	if err := t.compactionHandler.execCompactionPlan(signal, plan); err != nil {
+		log.Warn("failed to execute compaction plan", zap.Error(err))
+		return nil
+	}
+	return []*datapb.CompactionPlan{plan}
+}
+
+// shouldDoClusteringCompaction checks whether enough rows are written into the segments without range of
+// the clustering key since the last clustering compaction, or the key ranges of the segments overlap
+func shouldDoClusteringCompaction(segments []*SegmentInfo, fieldID int64) bool {
+	var totalRows, unclusteredRows int64
+	for _, s := range segments {
+		totalRows += s.GetNumOfRows()
+		if s.GetClusteringKeyRange().GetFieldID() != fieldID {
+			unclusteredRows += s.GetNumOfRows()
+		}
+	}
+	if totalRows == 0 {
+		return false
+	}
+	if unclusteredRows == 0 {
+		return !isClustered(segments, fieldID)
+	}
+	return float64(unclusteredRows)/float64(totalRows) >= clusteringCompactionRatioThreshold
+}
+
+// getClusteringKey returns the clustering key field of the collection, nil if there is none
+func (t *compactionTrigger) getClusteringKey(collectionID UniqueID) *schemapb.FieldSchema {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	collection := t.handler.GetCollection(ctx, collectionID)
+	if collection == nil {
+		return nil
+	}
+	return typeutil.GetClusteringKeyField(collection.GetSchema())
+}
+
 func (t *compactionTrigger) getCandidateSegments(channel string, partitionID UniqueID) []*SegmentInfo {
 	segments := t.meta.GetSegmentsByChannel(channel)
 	res := make([]*SegmentInfo, 0)
 	for _, s := range segments {
 		if !isFlush(s) || s.GetInsertChannel() != channel ||
-			s.GetPartitionID() != partitionID || s.isCompacting {
+			s.GetPartitionID() != partitionID || s.isCompacting || t.pins.isPinned(s.GetCollectionID()) {
 			continue
 		}
 		res = append(res, s)
@@ -458,18 +539,40 @@ func (t *compactionTrigger) globalSingleCompaction(segments []*SegmentInfo, isFo
 }
 
 func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool, signal *compactionSignal) (*datapb.CompactionPlan, error) {
-	if segment == nil {
+	if segment == nil || t.pins.isPinned(segment.GetCollectionID()) {
 		return nil, nil
 	}
 
-	if !isForce && !t.shouldDoSingleCompaction(segment, signal.timetravel) {
+	ttl, err := t.getCollectionTTL(segment.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get collection ttl", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+	}
+	expired := hasExpiredEntities(segment, ttl)
+
+	if !isForce && !expired && !t.shouldDoSingleCompaction(segment, signal.timetravel) {
 		return nil, nil
 	}
 
 	plan := t.singleCompactionPolicy.generatePlan(segment, signal.timetravel)
+	if plan == nil && expired {
+		// no delta logs to merge, the segment is compacted to drop the expired entities only
+		plan = &datapb.CompactionPlan{
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+				{
+					SegmentID:           segment.GetID(),
+					FieldBinlogs:        segment.GetBinlogs(),
+					Field2StatslogPaths: segment.GetStatslogs(),
+				},
+			},
+			Type:       datapb.CompactionType_InnerCompaction,
+			Timetravel: signal.timetravel.time,
+			Channel:    segment.GetInsertChannel(),
+		}
+	}
 	if plan == nil {
 		return nil, nil
 	}
+	plan.CollectionTtl = ttl.Nanoseconds()
 
 	if err := t.fillOriginPlan(plan); err != nil {
 		return nil, err
@@ -477,6 +580,34 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 	return plan, t.compactionHandler.execCompactionPlan(signal, plan)
 }
 
+// getCollectionTTL returns the time-to-live of the entities of the collection, 0 means never expire
+func (t *compactionTrigger) getCollectionTTL(collectionID UniqueID) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	collection := t.handler.GetCollection(ctx, collectionID)
+	if collection == nil {
+		return 0, fmt.Errorf("collection %d not found", collectionID)
+	}
+	return funcutil.GetCollectionTTL(collection.GetProperties())
+}
+
+// hasExpiredEntities checks whether any entity of the segment is expired by the ttl,
+// the insert binlogs without time range are ignored
+func hasExpiredEntities(segment *SegmentInfo, ttl time.Duration) bool {
+	if ttl <= 0 {
+		return false
+	}
+	expireTs := tsoutil.ComposeTSByTime(time.Now().Add(-ttl), 0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			if l.GetTimestampFrom() > 0 && l.GetTimestampFrom() < expireTs {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isFlush(segment *SegmentInfo) bool {
 	return segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing
 }
@@ -22,9 +22,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -278,6 +280,7 @@ func Test_compactionTrigger_forceTriggerCompaction(t *testing.T) {
 				singleCompactionPolicy: tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:  tt.fields.mergeCompactionPolicy,
 				compactionHandler:      tt.fields.compactionHandler,
+				handler:                newMockHandler(),
 				globalTrigger:          tt.fields.globalTrigger,
 			}
 			_, err := tr.forceTriggerCompaction(tt.args.collectionID, tt.args.timetravel)
@@ -671,6 +674,7 @@ func Test_compactionTrigger_triggerCompaction(t *testing.T) {
 				singleCompactionPolicy:          tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:           tt.fields.mergeCompactionPolicy,
 				compactionHandler:               tt.fields.compactionHandler,
+				handler:                         newMockHandler(),
 				mergeCompactionSegmentThreshold: tt.fields.mergeCompactionSegmentThreshold,
 			}
 			tr.start()
@@ -1064,6 +1068,7 @@ func Test_compactionTrigger_singleTriggerCompaction(t *testing.T) {
 				singleCompactionPolicy: tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:  tt.fields.mergeCompactionPolicy,
 				compactionHandler:      tt.fields.compactionHandler,
+				handler:                newMockHandler(),
 				globalTrigger:          tt.fields.globalTrigger,
 			}
 			tr.start()
@@ -1112,7 +1117,7 @@ func Test_newCompactionTrigger(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator)
+			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator, newMockHandler(), newCollectionPins())
 			assert.Equal(t, tt.args.meta, got.meta)
 			assert.Equal(t, tt.args.compactionHandler, got.compactionHandler)
 			assert.Equal(t, tt.args.allocator, got.allocator)
@@ -1122,7 +1127,7 @@ func Test_newCompactionTrigger(t *testing.T) {
 
 func Test_handleSignal(t *testing.T) {
 
-	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator())
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), newCollectionPins())
 	signal := &compactionSignal{
 		segmentID: 1,
 	}
@@ -1130,3 +1135,72 @@ func Test_handleSignal(t *testing.T) {
 		got.handleSignal(signal)
 	})
 }
+
+func Test_compactionTrigger_singleCompactionExpired(t *testing.T) {
+	handler := newMockHandler()
+	handler.collections[2] = &datapb.CollectionInfo{
+		ID:         2,
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}},
+	}
+	spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 1)}
+	tr := &compactionTrigger{
+		allocator:              newMockAllocator(),
+		singleCompactionPolicy: (singleCompactionFunc)(chooseAllBinlogs),
+		compactionHandler:      spy,
+		handler:                handler,
+	}
+
+	genSegment := func(collectionID UniqueID, insertTs time.Time) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:             1,
+				CollectionID:   collectionID,
+				InsertChannel:  "ch1",
+				NumOfRows:      100,
+				LastExpireTime: 100,
+				State:          commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{
+					{FieldID: 1, Binlogs: []*datapb.Binlog{{LogPath: "log1", TimestampFrom: tsoutil.ComposeTSByTime(insertTs, 0)}}},
+				},
+			},
+		}
+	}
+	signal := &compactionSignal{id: 1, timetravel: &timetravel{200}}
+
+	// the segment has entities inserted 2 hours ago, which are expired
+	plan, err := tr.singleCompaction(genSegment(2, time.Now().Add(-2*time.Hour)), false, signal)
+	assert.Nil(t, err)
+	assert.NotNil(t, plan)
+	assert.Equal(t, datapb.CompactionType_InnerCompaction, plan.GetType())
+	assert.Equal(t, int64(time.Hour), plan.GetCollectionTtl())
+	assert.Equal(t, 1, len(plan.GetSegmentBinlogs()))
+	assert.Equal(t, plan, <-spy.spyChan)
+
+	// none of the entities is expired
+	plan, err = tr.singleCompaction(genSegment(2, time.Now()), false, signal)
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+
+	// the collection has no ttl
+	handler.collections[3] = &datapb.CollectionInfo{ID: 3}
+	plan, err = tr.singleCompaction(genSegment(3, time.Now().Add(-2*time.Hour)), false, signal)
+	assert.Nil(t, err)
+	assert.Nil(t, plan)
+}
+
+func Test_hasExpiredEntities(t *testing.T) {
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []*datapb.Binlog{{TimestampFrom: tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)}}},
+			},
+		},
+	}
+	assert.False(t, hasExpiredEntities(segment, 0))
+	assert.False(t, hasExpiredEntities(segment, 3*time.Hour))
+	assert.True(t, hasExpiredEntities(segment, time.Hour))
+
+	// binlogs written before the time range was recorded are ignored
+	segment.Binlogs[0].Binlogs[0].TimestampFrom = 0
+	assert.False(t, hasExpiredEntities(segment, time.Hour))
+}
@@ -17,7 +17,6 @@
 package datacoord
 
 import (
-	"context"
 	"path"
 	"sync"
 	"time"
@@ -25,7 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
-	"github.com/minio/minio-go/v7"
+	"github.com/milvus-io/milvus/internal/storage"
 	"go.uber.org/zap"
 )
 
@@ -38,13 +37,13 @@ const (
 
 // GcOption garbage collection options
 type GcOption struct {
-	cli              *minio.Client // OSS client
-	enabled          bool          // enable switch
-	checkInterval    time.Duration // each interval
-	missingTolerance time.Duration // key missing in meta tolerace time
-	dropTolerance    time.Duration // dropped segment related key tolerance time
-	bucketName       string
+	cli              storage.ChunkManager // OSS client
+	enabled          bool                 // enable switch
+	checkInterval    time.Duration        // each interval
+	missingTolerance time.Duration        // key missing in meta tolerace time
+	dropTolerance    time.Duration        // dropped segment related key tolerance time
 	rootPath         string
+	pins             *collectionPins // logs of the dropped segments of the pinned collections are kept
 }
 
 // garbageCollector handles garbage files in object storage
@@ -124,21 +123,23 @@ func (gc *garbageCollector) scan() {
 	prefixes = append(prefixes, path.Join(gc.option.rootPath, deltaLogPrefix))
 
 	for _, prefix := range prefixes {
-		for info := range gc.option.cli.ListObjects(context.TODO(), gc.option.bucketName, minio.ListObjectsOptions{
-			Prefix:    prefix,
-			Recursive: true,
-		}) {
-			_, has := vm[info.Key]
+		keys, modTimes, err := gc.option.cli.ListWithPrefix(prefix)
+		if err != nil {
+			log.Warn("failed to list files", zap.String("prefix", prefix), zap.Error(err))
+			continue
+		}
+		for i, key := range keys {
+			_, has := vm[key]
 			if has {
 				v++
 				continue
 			}
 			m++
 			// not found in meta, check last modified time exceeds tolerance duration
-			if time.Since(info.LastModified) > gc.option.missingTolerance {
+			if time.Since(modTimes[i]) > gc.option.missingTolerance {
 				e++
 				// ignore error since it could be cleaned up next time
-				_ = gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, info.Key, minio.RemoveObjectOptions{})
+				_ = gc.option.cli.Remove(key)
 			}
 		}
 	}
@@ -151,7 +152,7 @@ func (gc *garbageCollector) clearEtcd() {
 	})
 
 	for _, sinfo := range drops {
-		if !gc.isExpire(sinfo.GetDroppedAt()) {
+		if !gc.isExpire(sinfo.GetDroppedAt()) || gc.option.pins.isPinned(sinfo.GetCollectionID()) {
 			continue
 		}
 		logs := getLogs(sinfo)
@@ -185,9 +186,8 @@ func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
 	delFlag := true
 	for _, l := range logs {
-		err := gc.option.cli.RemoveObject(context.TODO(), gc.option.bucketName, l.GetLogPath(), minio.RemoveObjectOptions{})
-		errResp := minio.ToErrorResponse(err)
-		if errResp.Code != "" && errResp.Code != "NoSuchKey" {
+		// removing a missing key is not an error, so only actual failures keep the segment
+		if err := gc.option.cli.Remove(l.GetLogPath()); err != nil {
 			delFlag = false
 		}
 	}
@@ -17,27 +17,23 @@
 package datacoord
 
 import (
-	"bytes"
-	"context"
+	"io/ioutil"
+	"os"
 	"path"
-	"strings"
 	"testing"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_garbageCollector_basic(t *testing.T) {
-	bucketName := `datacoord-ut` + strings.ToLower(funcutil.RandomString(8))
 	rootPath := `gc` + funcutil.RandomString(8)
-	//TODO change to Params
-	cli, _, _, _, _, err := initUtOSSEnv(bucketName, rootPath, 0)
+	cli, _, _, _, _, err := initUtOSSEnv(t, rootPath, 0)
 	require.NoError(t, err)
 
 	mockAllocator := newMockAllocator()
@@ -51,7 +47,6 @@ func Test_garbageCollector_basic(t *testing.T) {
 			checkInterval:    time.Millisecond * 10,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
@@ -69,7 +64,6 @@ func Test_garbageCollector_basic(t *testing.T) {
 			checkInterval:    time.Millisecond * 10,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		assert.NotPanics(t, func() {
@@ -83,19 +77,15 @@ func Test_garbageCollector_basic(t *testing.T) {
 
 }
 
-func validateMinioPrefixElements(t *testing.T, cli *minio.Client, bucketName string, prefix string, elements []string) {
-	var current []string
-	for info := range cli.ListObjects(context.TODO(), bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
-		current = append(current, info.Key)
-	}
+func validatePrefixElements(t *testing.T, cli storage.ChunkManager, prefix string, elements []string) {
+	current, _, err := cli.ListWithPrefix(prefix)
+	require.NoError(t, err)
 	assert.ElementsMatch(t, elements, current)
 }
 
 func Test_garbageCollector_scan(t *testing.T) {
-	bucketName := `datacoord-ut` + strings.ToLower(funcutil.RandomString(8))
 	rootPath := `gc` + funcutil.RandomString(8)
-	//TODO change to Params
-	cli, inserts, stats, delta, others, err := initUtOSSEnv(bucketName, rootPath, 4)
+	cli, inserts, stats, delta, others, err := initUtOSSEnv(t, rootPath, 4)
 	require.NoError(t, err)
 
 	mockAllocator := newMockAllocator()
@@ -109,15 +99,14 @@ func Test_garbageCollector_scan(t *testing.T) {
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.scan()
 
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), stats)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deltaLogPrefix), delta)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, `indexes`), others)
+		validatePrefixElements(t, cli, path.Join(rootPath, insertLogPrefix), inserts)
+		validatePrefixElements(t, cli, path.Join(rootPath, statsLogPrefix), stats)
+		validatePrefixElements(t, cli, path.Join(rootPath, deltaLogPrefix), delta)
+		validatePrefixElements(t, cli, path.Join(rootPath, `indexes`), others)
 
 		gc.close()
 	})
@@ -136,15 +125,14 @@ func Test_garbageCollector_scan(t *testing.T) {
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    time.Hour * 24,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
 		gc.scan()
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), stats)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deltaLogPrefix), delta)
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, `indexes`), others)
+		validatePrefixElements(t, cli, path.Join(rootPath, insertLogPrefix), inserts)
+		validatePrefixElements(t, cli, path.Join(rootPath, statsLogPrefix), stats)
+		validatePrefixElements(t, cli, path.Join(rootPath, deltaLogPrefix), delta)
+		validatePrefixElements(t, cli, path.Join(rootPath, `indexes`), others)
 
 		gc.close()
 	})
@@ -160,20 +148,29 @@ func Test_garbageCollector_scan(t *testing.T) {
 		err = meta.AddSegment(segment)
 		require.NoError(t, err)
 
+		// the logs of a pinned collection are kept
+		pins := newCollectionPins()
+		pins.pin(1, segment.GetCollectionID(), time.Hour)
 		gc := newGarbageCollector(meta, GcOption{
 			cli:              cli,
 			enabled:          true,
 			checkInterval:    time.Minute * 30,
 			missingTolerance: time.Hour * 24,
 			dropTolerance:    0,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
+			pins:             pins,
 		})
 		gc.clearEtcd()
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts[1:])
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), stats[1:])
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deltaLogPrefix), delta[1:])
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, `indexes`), others)
+		validatePrefixElements(t, cli, path.Join(rootPath, insertLogPrefix), inserts)
+		validatePrefixElements(t, cli, path.Join(rootPath, statsLogPrefix), stats)
+		validatePrefixElements(t, cli, path.Join(rootPath, deltaLogPrefix), delta)
+		assert.True(t, pins.unpin(1))
+
+		gc.clearEtcd()
+		validatePrefixElements(t, cli, path.Join(rootPath, insertLogPrefix), inserts[1:])
+		validatePrefixElements(t, cli, path.Join(rootPath, statsLogPrefix), stats[1:])
+		validatePrefixElements(t, cli, path.Join(rootPath, deltaLogPrefix), delta[1:])
+		validatePrefixElements(t, cli, path.Join(rootPath, `indexes`), others)
 
 		gc.close()
 	})
@@ -184,43 +181,30 @@ func Test_garbageCollector_scan(t *testing.T) {
 			checkInterval:    time.Minute * 30,
 			missingTolerance: 0,
 			dropTolerance:    0,
-			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
 		gc.start()
 		gc.scan()
 		gc.clearEtcd()
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), []string{})
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), []string{})
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, deltaLogPrefix), []string{})
-		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, `indexes`), others)
+		validatePrefixElements(t, cli, path.Join(rootPath, insertLogPrefix), []string{})
+		validatePrefixElements(t, cli, path.Join(rootPath, statsLogPrefix), []string{})
+		validatePrefixElements(t, cli, path.Join(rootPath, deltaLogPrefix), []string{})
+		validatePrefixElements(t, cli, path.Join(rootPath, `indexes`), others)
 
 		gc.close()
 	})
-
-	cleanupOSS(cli, bucketName, rootPath)
 }
 
 // initialize unit test sso env
-func initUtOSSEnv(bucket, root string, n int) (cli *minio.Client, inserts []string, stats []string, delta []string, other []string, err error) {
+func initUtOSSEnv(t *testing.T, root string, n int) (cli storage.ChunkManager, inserts []string, stats []string, delta []string, other []string, err error) {
 	Params.Init()
-	cli, err = minio.New(Params.MinioCfg.Address, &minio.Options{
-		Creds:  credentials.NewStaticV4(Params.MinioCfg.AccessKeyID, Params.MinioCfg.SecretAccessKey, ""),
-		Secure: Params.MinioCfg.UseSSL,
-	})
+	dir, err := ioutil.TempDir("", "datacoord-gc")
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
 	}
-	has, err := cli.BucketExists(context.TODO(), bucket)
-	if err != nil {
-		return nil, nil, nil, nil, nil, err
-	}
-	if !has {
-		err = cli.MakeBucket(context.TODO(), bucket, minio.MakeBucketOptions{})
-		if err != nil {
-			return nil, nil, nil, nil, nil, err
-		}
-	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	cli = storage.NewLocalChunkManager(dir)
+
 	inserts = make([]string, 0, n)
 	stats = make([]string, 0, n)
 	delta = make([]string, 0, n)
@@ -228,44 +212,33 @@ func initUtOSSEnv(bucket, root string, n int) (cli *minio.Client, inserts []stri
 
 	content := []byte("test")
 	for i := 0; i < n; i++ {
-		reader := bytes.NewReader(content)
 		token := funcutil.RandomString(8)
 		// insert
 		filePath := path.Join(root, insertLogPrefix, token)
-		info, err := cli.PutObject(context.TODO(), bucket, filePath, reader, int64(len(content)), minio.PutObjectOptions{})
-		if err != nil {
+		if err = cli.Write(filePath, content); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
-		inserts = append(inserts, info.Key)
+		inserts = append(inserts, filePath)
 		// stats
 		filePath = path.Join(root, statsLogPrefix, token)
-		info, err = cli.PutObject(context.TODO(), bucket, filePath, reader, int64(len(content)), minio.PutObjectOptions{})
-		if err != nil {
+		if err = cli.Write(filePath, content); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
-		stats = append(stats, info.Key)
+		stats = append(stats, filePath)
 
 		// delta
 		filePath = path.Join(root, deltaLogPrefix, token)
-		info, err = cli.PutObject(context.TODO(), bucket, filePath, reader, int64(len(content)), minio.PutObjectOptions{})
-		if err != nil {
+		if err = cli.Write(filePath, content); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
-		delta = append(delta, info.Key)
+		delta = append(delta, filePath)
 
 		// other
 		filePath = path.Join(root, `indexes`, token)
-		info, err = cli.PutObject(context.TODO(), bucket, filePath, reader, int64(len(content)), minio.PutObjectOptions{})
-		if err != nil {
+		if err = cli.Write(filePath, content); err != nil {
 			return nil, nil, nil, nil, nil, err
 		}
-		other = append(other, info.Key)
+		other = append(other, filePath)
 	}
 	return cli, inserts, stats, delta, other, nil
 }
-
-func cleanupOSS(cli *minio.Client, bucket, root string) {
-	ch := cli.ListObjects(context.TODO(), bucket, minio.ListObjectsOptions{Prefix: root, Recursive: true})
-	cli.RemoveObjects(context.TODO(), bucket, ch, minio.RemoveObjectsOptions{})
-	cli.RemoveBucket(context.TODO(), bucket)
-}
@@ -0,0 +1,421 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+var errNoDataNodeForImport = errors.New("no available DataNode to execute import task")
+
+const (
+	// importTaskTimeout is how long an unfinished task may go without any report from its DataNode,
+	// e.g. the DataNode may fail to report the final state within its retries
+	importTaskTimeout = 30 * time.Minute
+	// importTaskRetention is how long the state of a finished task is kept
+	importTaskRetention = 24 * time.Hour
+	// importTaskCheckInterval is the interval of checking timeout and retention of tasks
+	importTaskCheckInterval = time.Minute
+)
+
+func isImportTaskDone(task *datapb.ImportTaskInfo) bool {
+	return task.GetState() == commonpb.ImportState_ImportCompleted || task.GetState() == commonpb.ImportState_ImportFailed
+}
+
+// importManager splits import requests into tasks and distributes them to DataNodes,
+// a row-based request becomes one task per file since every json file holds complete rows,
+// a column-based request becomes a single task since its files are columns of the same rows.
+// The states of tasks are persisted in meta, the segments of a task stay importing and invisible
+// until the task completes, then they are turned into Flushing segments at once.
+type importManager struct {
+	mu             sync.RWMutex
+	tasks          map[UniqueID]*datapb.ImportTaskInfo
+	meta           *meta
+	allocator      allocator
+	sessionManager *SessionManager
+	flushCh        chan UniqueID
+
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// newImportManager creates the import manager and loads the tasks from meta
+func newImportManager(meta *meta, allocator allocator, sessionManager *SessionManager, flushCh chan UniqueID) (*importManager, error) {
+	m := &importManager{
+		tasks:          make(map[UniqueID]*datapb.ImportTaskInfo),
+		meta:           meta,
+		allocator:      allocator,
+		sessionManager: sessionManager,
+		flushCh:        flushCh,
+		closeCh:        make(chan struct{}),
+	}
+	tasks, err := meta.ListImportTasks()
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		m.tasks[task.GetId()] = task
+		// DataCoord may crash before all the segments of a failed task are dropped
+		if task.GetState() == commonpb.ImportState_ImportFailed {
+			m.dropSegments(task)
+		}
+	}
+	return m, nil
+}
+
+// start starts the loop failing the tasks without reports and removing the expired tasks
+func (m *importManager) start() {
+	m.startOnce.Do(func() {
+		m.wg.Add(1)
+		go m.work()
+	})
+}
+
+func (m *importManager) work() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(importTaskCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			m.expireTasks(time.Now())
+		case <-m.closeCh:
+			log.Info("import manager quit")
+			return
+		}
+	}
+}
+
+func (m *importManager) close() {
+	m.stopOnce.Do(func() {
+		close(m.closeCh)
+		m.wg.Wait()
+	})
+}
+
+// importJob creates tasks for the request and sends them to DataNodes, it returns the ids of the tasks.
+// A task fails immediately if it can't be sent to a DataNode, its state tells the reason.
+func (m *importManager) importJob(ctx context.Context, req *datapb.ImportTask) ([]UniqueID, error) {
+	if len(req.GetFiles()) == 0 {
+		return nil, errors.New("no file to import")
+	}
+	if len(m.sessionManager.GetSessions()) == 0 {
+		return nil, errNoDataNodeForImport
+	}
+
+	var fileGroups [][]string
+	if req.GetRowBased() {
+		for _, file := range req.GetFiles() {
+			fileGroups = append(fileGroups, []string{file})
+		}
+	} else {
+		fileGroups = append(fileGroups, req.GetFiles())
+	}
+
+	ids := make([]UniqueID, 0, len(fileGroups))
+	for _, files := range fileGroups {
+		id, err := m.allocator.allocID(ctx)
+		if err != nil {
+			return ids, err
+		}
+		ts, err := m.allocator.allocTimestamp(ctx)
+		if err != nil {
+			return ids, err
+		}
+
+		task := &datapb.ImportTaskInfo{
+			Id:           id,
+			CollectionId: req.GetCollectionId(),
+			Files:        files,
+			State:        commonpb.ImportState_ImportPending,
+			LastUpdate:   time.Now().Unix(),
+		}
+		m.mu.Lock()
+		nodeID, err := m.pickDataNode()
+		if err == nil {
+			task.DatanodeId = nodeID
+		}
+		if saveErr := m.meta.SaveImportTask(task); saveErr != nil {
+			m.mu.Unlock()
+			return ids, saveErr
+		}
+		m.tasks[id] = task
+		m.mu.Unlock()
+		ids = append(ids, id)
+
+		if err == nil {
+			err = m.sessionManager.Import(ctx, nodeID, &datapb.ImportTaskRequest{
+				Base: &commonpb.MsgBase{
+					SourceID: Params.DataCoordCfg.NodeID,
+				},
+				ImportTask: &datapb.ImportTask{
+					CollectionId: req.GetCollectionId(),
+					PartitionId:  req.GetPartitionId(),
+					ChannelNames: req.GetChannelNames(),
+					RowBased:     req.GetRowBased(),
+					TaskId:       id,
+					Files:        files,
+					Infos:        req.GetInfos(),
+					Timestamp:    ts,
+				},
+			})
+		}
+		if err != nil {
+			log.Warn("failed to dispatch import task", zap.Int64("taskID", id), zap.Strings("files", files), zap.Error(err))
+			m.mu.Lock()
+			m.failTask(task, err.Error())
+			m.mu.Unlock()
+			continue
+		}
+		log.Debug("import task dispatched", zap.Int64("taskID", id), zap.Int64("nodeID", nodeID), zap.Strings("files", files))
+	}
+	return ids, nil
+}
+
+// pickDataNode returns the DataNode executing the fewest import tasks, the caller must hold the lock
+func (m *importManager) pickDataNode() (UniqueID, error) {
+	running := make(map[UniqueID]int)
+	for _, task := range m.tasks {
+		if !isImportTaskDone(task) {
+			running[task.GetDatanodeId()]++
+		}
+	}
+
+	nodeID, minRunning := UniqueID(-1), -1
+	for _, session := range m.sessionManager.GetSessions() {
+		id := session.info.NodeID
+		if minRunning < 0 || running[id] < minRunning {
+			nodeID, minRunning = id, running[id]
+		}
+	}
+	if minRunning < 0 {
+		return 0, errNoDataNodeForImport
+	}
+	return nodeID, nil
+}
+
+// updateTaskState updates the task with the result reported by the DataNode. The segments of a completed task
+// become Flushing together with the task state in one transaction, the segments of a failed task are dropped,
+// so that a task is either imported completely or not at all.
+func (m *importManager) updateTaskState(result *datapb.ImportResult) error {
+	flushSegments, err := m.saveTaskState(result)
+	if err != nil {
+		return err
+	}
+	// sending blocks while the flush channel is full, it must not hold the lock
+	for _, segmentID := range flushSegments {
+		m.flushCh <- segmentID
+	}
+	return nil
+}
+
+// saveTaskState saves the result of the task and returns the segments to flush
+func (m *importManager) saveTaskState(result *datapb.ImportResult) ([]UniqueID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task, ok := m.tasks[result.GetTaskId()]
+	if !ok {
+		return nil, fmt.Errorf("import task %d not found", result.GetTaskId())
+	}
+	if isImportTaskDone(task) {
+		return nil, fmt.Errorf("import task %d is already in state %s", task.GetId(), task.GetState().String())
+	}
+
+	updated := proto.Clone(task).(*datapb.ImportTaskInfo)
+	updated.State = result.GetState()
+	updated.RowCount = result.GetRowCount()
+	// the segments allocated for the task are recorded even if the DataNode fails before reporting them
+	updated.Segments = mergeSegmentIDs(task.GetSegments(), result.GetSegments())
+	updated.Infos = result.GetInfos()
+	updated.LastUpdate = time.Now().Unix()
+
+	switch updated.GetState() {
+	case commonpb.ImportState_ImportCompleted:
+		// the allocated segments which are not reported hold no data, e.g. the DataNode failed to save their binlogs
+		reported := make(map[UniqueID]struct{}, len(result.GetSegments()))
+		for _, segmentID := range result.GetSegments() {
+			reported[segmentID] = struct{}{}
+		}
+		for _, segmentID := range task.GetSegments() {
+			if _, ok := reported[segmentID]; !ok {
+				m.dropSegment(task.GetId(), segmentID)
+			}
+		}
+		updated.Segments = result.GetSegments()
+		if err := m.meta.CompleteImportTask(updated); err != nil {
+			return nil, err
+		}
+		m.tasks[updated.GetId()] = updated
+		return updated.GetSegments(), nil
+	case commonpb.ImportState_ImportFailed:
+		if err := m.meta.SaveImportTask(updated); err != nil {
+			return nil, err
+		}
+		m.tasks[updated.GetId()] = updated
+		m.dropSegments(updated)
+	default:
+		if err := m.meta.SaveImportTask(updated); err != nil {
+			return nil, err
+		}
+		m.tasks[updated.GetId()] = updated
+	}
+	return nil, nil
+}
+
+// addTaskSegment records the segment allocated for the task, so that it's dropped if the task fails or expires
+// before the segment is reported. A segment allocated for an unknown or finished task is dropped at once.
+func (m *importManager) addTaskSegment(taskID UniqueID, segmentID UniqueID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task, ok := m.tasks[taskID]
+	if !ok {
+		m.dropSegment(taskID, segmentID)
+		return fmt.Errorf("import task %d not found", taskID)
+	}
+	if isImportTaskDone(task) {
+		m.dropSegment(taskID, segmentID)
+		return fmt.Errorf("import task %d is already in state %s", taskID, task.GetState().String())
+	}
+
+	updated := proto.Clone(task).(*datapb.ImportTaskInfo)
+	updated.Segments = mergeSegmentIDs(task.GetSegments(), []UniqueID{segmentID})
+	if err := m.meta.SaveImportTask(updated); err != nil {
+		m.dropSegment(taskID, segmentID)
+		return err
+	}
+	m.tasks[taskID] = updated
+	return nil
+}
+
+// mergeSegmentIDs returns the ids in a followed by the ids in b which are not in a
+func mergeSegmentIDs(a, b []UniqueID) []UniqueID {
+	merged := append([]UniqueID{}, a...)
+	existed := make(map[UniqueID]struct{}, len(a))
+	for _, id := range a {
+		existed[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := existed[id]; !ok {
+			merged = append(merged, id)
+			existed[id] = struct{}{}
+		}
+	}
+	return merged
+}
+
+// failNodeTasks fails the unfinished tasks of a DataNode which went offline
+func (m *importManager) failNodeTasks(nodeID UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, task := range m.tasks {
+		if task.GetDatanodeId() != nodeID || isImportTaskDone(task) {
+			continue
+		}
+		log.Warn("import task failed since DataNode is offline", zap.Int64("taskID", task.GetId()), zap.Int64("nodeID", nodeID))
+		m.failTask(task, fmt.Sprintf("DataNode %d is offline", nodeID))
+	}
+}
+
+// expireTasks fails the unfinished tasks which haven't been reported for importTaskTimeout,
+// and removes the finished tasks older than importTaskRetention
+func (m *importManager) expireTasks(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, task := range m.tasks {
+		lastUpdate := time.Unix(task.GetLastUpdate(), 0)
+		if !isImportTaskDone(task) {
+			if now.Sub(lastUpdate) > importTaskTimeout {
+				log.Warn("import task failed since it is not reported for a long time",
+					zap.Int64("taskID", id), zap.Int64("nodeID", task.GetDatanodeId()), zap.Time("lastUpdate", lastUpdate))
+				m.failTask(task, fmt.Sprintf("no report from DataNode %d since %s", task.GetDatanodeId(), lastUpdate.String()))
+			}
+			continue
+		}
+		if now.Sub(lastUpdate) > importTaskRetention {
+			if err := m.meta.RemoveImportTask(id); err != nil {
+				log.Warn("failed to remove expired import task", zap.Int64("taskID", id), zap.Error(err))
+				continue
+			}
+			delete(m.tasks, id)
+		}
+	}
+}
+
+// failTask marks the task failed for the reason and drops its segments, the caller must hold the lock
+func (m *importManager) failTask(task *datapb.ImportTaskInfo, reason string) {
+	task.State = commonpb.ImportState_ImportFailed
+	task.Infos = append(task.Infos, &commonpb.KeyValuePair{Key: common.ImportFailedReasonKey, Value: reason})
+	task.LastUpdate = time.Now().Unix()
+	if err := m.meta.SaveImportTask(task); err != nil {
+		// the task is failed again by the timeout check after DataCoord restarts
+		log.Warn("failed to save failed import task", zap.Int64("taskID", task.GetId()), zap.Error(err))
+	}
+	m.dropSegments(task)
+}
+
+// dropSegments marks the segments of the task dropped, their binlogs are recycled by the garbage collector
+func (m *importManager) dropSegments(task *datapb.ImportTaskInfo) {
+	for _, segmentID := range task.GetSegments() {
+		m.dropSegment(task.GetId(), segmentID)
+	}
+}
+
+func (m *importManager) dropSegment(taskID UniqueID, segmentID UniqueID) {
+	if err := m.meta.UpdateFlushSegmentsInfo(segmentID, false, true, nil, nil, nil, nil, nil); err != nil {
+		log.Warn("failed to drop segment of import task",
+			zap.Int64("taskID", taskID), zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
+
+// getTaskState returns the state of the task
+func (m *importManager) getTaskState(id UniqueID) (*milvuspb.GetImportStateResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	task, ok := m.tasks[id]
+	if !ok {
+		return nil, fmt.Errorf("import task %d not found", id)
+	}
+	return &milvuspb.GetImportStateResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		State:        task.GetState(),
+		RowCount:     task.GetRowCount(),
+		IdList:       append([]UniqueID{}, task.GetSegments()...),
+		Infos:        append([]*commonpb.KeyValuePair{}, task.GetInfos()...),
+		Id:           task.GetId(),
+		CollectionId: task.GetCollectionId(),
+	}, nil
+}
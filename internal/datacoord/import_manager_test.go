@@ -0,0 +1,325 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestImportManager(t *testing.T, nodes ...*NodeInfo) *importManager {
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	return newTestImportManagerWithMeta(t, meta, nodes...)
+}
+
+func newTestImportManagerWithMeta(t *testing.T, meta *meta, nodes ...*NodeInfo) *importManager {
+	sessionManager := NewSessionManager(withSessionCreator(func(ctx context.Context, addr string) (types.DataNode, error) {
+		if addr == "unreachable" {
+			return nil, errors.New("unreachable")
+		}
+		return newMockDataNodeClient(0, nil)
+	}))
+	for _, node := range nodes {
+		sessionManager.AddSession(node)
+	}
+	m, err := newImportManager(meta, newMockAllocator(), sessionManager, make(chan UniqueID, 1024))
+	assert.Nil(t, err)
+	return m
+}
+
+func TestImportManager_ImportJob(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no datanode", func(t *testing.T) {
+		m := newTestImportManager(t)
+		_, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json"}, RowBased: true})
+		assert.Equal(t, errNoDataNodeForImport, err)
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+		_, err := m.importJob(ctx, &datapb.ImportTask{RowBased: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("row based", func(t *testing.T) {
+		m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"}, &NodeInfo{NodeID: 2, Address: "localhost:2"})
+		ids, err := m.importJob(ctx, &datapb.ImportTask{CollectionId: 100, Files: []string{"a.json", "b.json"}, RowBased: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(ids))
+
+		// tasks are spread over the datanodes
+		nodes := make(map[UniqueID]struct{})
+		for _, id := range ids {
+			resp, err := m.getTaskState(id)
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ImportState_ImportPending, resp.GetState())
+			assert.EqualValues(t, 100, resp.GetCollectionId())
+			nodes[m.tasks[id].GetDatanodeId()] = struct{}{}
+		}
+		assert.Equal(t, 2, len(nodes))
+	})
+
+	t.Run("column based", func(t *testing.T) {
+		m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+		ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"pk.npy", "vec.npy"}})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(ids))
+		assert.Equal(t, []string{"pk.npy", "vec.npy"}, m.tasks[ids[0]].GetFiles())
+	})
+
+	t.Run("dispatch fails", func(t *testing.T) {
+		m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "unreachable"})
+		ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json"}, RowBased: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(ids))
+		resp, err := m.getTaskState(ids[0])
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+		assert.Equal(t, common.ImportFailedReasonKey, resp.GetInfos()[0].GetKey())
+	})
+}
+
+func TestImportManager_UpdateTaskState(t *testing.T) {
+	ctx := context.Background()
+	m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+	ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json", "b.json"}, RowBased: true})
+	assert.Nil(t, err)
+
+	err = m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Growing, IsImporting: true}))
+	assert.Nil(t, err)
+	err = m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 11, State: commonpb.SegmentState_Growing, IsImporting: true}))
+	assert.Nil(t, err)
+
+	t.Run("progress and completion", func(t *testing.T) {
+		err := m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportStarted,
+			Segments: []int64{10}, RowCount: 5})
+		assert.Nil(t, err)
+		err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportCompleted,
+			Segments: []int64{10}, RowCount: 10})
+		assert.Nil(t, err)
+
+		resp, err := m.getTaskState(ids[0])
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.GetState())
+		assert.EqualValues(t, 10, resp.GetRowCount())
+		assert.Equal(t, []int64{10}, resp.GetIdList())
+
+		// the segments become visible once the task completes
+		segment := m.meta.GetSegment(10)
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.False(t, segment.GetIsImporting())
+		assert.EqualValues(t, 10, <-m.flushCh)
+
+		// a finished task can't be updated anymore
+		err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportFailed})
+		assert.Error(t, err)
+		assert.NotNil(t, m.meta.GetSegment(10))
+	})
+
+	t.Run("progress keeps segments importing", func(t *testing.T) {
+		err := m.updateTaskState(&datapb.ImportResult{TaskId: ids[1], State: commonpb.ImportState_ImportStarted,
+			Segments: []int64{11}, RowCount: 5})
+		assert.Nil(t, err)
+		segment := m.meta.GetSegment(11)
+		assert.Equal(t, commonpb.SegmentState_Growing, segment.GetState())
+		assert.True(t, segment.GetIsImporting())
+	})
+
+	t.Run("failure drops segments", func(t *testing.T) {
+		// a segment allocated for the task but never reported is dropped as well
+		err := m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 12, State: commonpb.SegmentState_Growing, IsImporting: true}))
+		assert.Nil(t, err)
+		err = m.addTaskSegment(ids[1], 12)
+		assert.Nil(t, err)
+		err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[1], State: commonpb.ImportState_ImportFailed,
+			Segments: []int64{11}})
+		assert.Nil(t, err)
+		assert.Nil(t, m.meta.GetSegment(11))
+		assert.Nil(t, m.meta.GetSegment(12))
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		err := m.updateTaskState(&datapb.ImportResult{TaskId: -1})
+		assert.Error(t, err)
+		_, err = m.getTaskState(-1)
+		assert.Error(t, err)
+	})
+}
+
+func TestImportManager_AddTaskSegment(t *testing.T) {
+	ctx := context.Background()
+	m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+	ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json", "b.json"}, RowBased: true})
+	assert.Nil(t, err)
+
+	for _, id := range []int64{10, 11, 12, 13} {
+		err = m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: id, State: commonpb.SegmentState_Growing, IsImporting: true}))
+		assert.Nil(t, err)
+	}
+
+	t.Run("record allocated segments", func(t *testing.T) {
+		err := m.addTaskSegment(ids[0], 10)
+		assert.Nil(t, err)
+		err = m.addTaskSegment(ids[0], 11)
+		assert.Nil(t, err)
+		resp, err := m.getTaskState(ids[0])
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{10, 11}, resp.GetIdList())
+		tasks, err := m.meta.ListImportTasks()
+		assert.Nil(t, err)
+		for _, task := range tasks {
+			if task.GetId() == ids[0] {
+				assert.Equal(t, []int64{10, 11}, task.GetSegments())
+			}
+		}
+	})
+
+	t.Run("completion drops unreported segments", func(t *testing.T) {
+		err := m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportCompleted,
+			Segments: []int64{10}, RowCount: 10})
+		assert.Nil(t, err)
+		resp, err := m.getTaskState(ids[0])
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{10}, resp.GetIdList())
+		assert.NotNil(t, m.meta.GetSegment(10))
+		assert.Nil(t, m.meta.GetSegment(11))
+		assert.EqualValues(t, 10, <-m.flushCh)
+	})
+
+	t.Run("finished or unknown task drops segment", func(t *testing.T) {
+		err := m.addTaskSegment(ids[0], 12)
+		assert.Error(t, err)
+		assert.Nil(t, m.meta.GetSegment(12))
+		err = m.addTaskSegment(-1, 13)
+		assert.Error(t, err)
+		assert.Nil(t, m.meta.GetSegment(13))
+	})
+}
+
+func TestImportManager_FailNodeTasks(t *testing.T) {
+	ctx := context.Background()
+	m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+	ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json"}, RowBased: true})
+	assert.Nil(t, err)
+
+	err = m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Growing, IsImporting: true}))
+	assert.Nil(t, err)
+	err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportStarted,
+		Segments: []int64{10}})
+	assert.Nil(t, err)
+
+	m.failNodeTasks(2)
+	resp, err := m.getTaskState(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+
+	m.failNodeTasks(1)
+	resp, err = m.getTaskState(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	assert.Nil(t, m.meta.GetSegment(10))
+}
+
+func TestImportManager_Reload(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(newMockAllocator())
+	assert.Nil(t, err)
+	m := newTestImportManagerWithMeta(t, meta, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+	ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json", "b.json"}, RowBased: true})
+	assert.Nil(t, err)
+
+	err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Growing, IsImporting: true}))
+	assert.Nil(t, err)
+	err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[0], State: commonpb.ImportState_ImportStarted,
+		Segments: []int64{10}, RowCount: 5})
+	assert.Nil(t, err)
+
+	// the states of tasks survive the restart of DataCoord
+	reloaded := newTestImportManagerWithMeta(t, meta)
+	assert.Equal(t, 2, len(reloaded.tasks))
+	resp, err := reloaded.getTaskState(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+	assert.EqualValues(t, 5, resp.GetRowCount())
+	assert.Equal(t, []int64{10}, resp.GetIdList())
+	resp, err = reloaded.getTaskState(ids[1])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportPending, resp.GetState())
+}
+
+func TestImportManager_ExpireTasks(t *testing.T) {
+	ctx := context.Background()
+	m := newTestImportManager(t, &NodeInfo{NodeID: 1, Address: "localhost:1"})
+	ids, err := m.importJob(ctx, &datapb.ImportTask{Files: []string{"a.json", "b.json"}, RowBased: true})
+	assert.Nil(t, err)
+
+	err = m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Growing, IsImporting: true}))
+	assert.Nil(t, err)
+	err = m.addTaskSegment(ids[0], 10)
+	assert.Nil(t, err)
+	err = m.updateTaskState(&datapb.ImportResult{TaskId: ids[1], State: commonpb.ImportState_ImportCompleted})
+	assert.Nil(t, err)
+
+	m.expireTasks(time.Now())
+	resp, err := m.getTaskState(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+
+	// an unfinished task without reports fails, e.g. its final report exhausts the retries
+	m.expireTasks(time.Now().Add(importTaskTimeout + time.Minute))
+	resp, err = m.getTaskState(ids[0])
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	assert.Equal(t, common.ImportFailedReasonKey, resp.GetInfos()[0].GetKey())
+	assert.Nil(t, m.meta.GetSegment(10))
+	tasks, err := m.meta.ListImportTasks()
+	assert.Nil(t, err)
+	for _, task := range tasks {
+		if task.GetId() == ids[0] {
+			assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState())
+		}
+	}
+
+	// finished tasks are removed after the retention
+	m.expireTasks(time.Now().Add(importTaskRetention + time.Minute))
+	_, err = m.getTaskState(ids[0])
+	assert.Error(t, err)
+	_, err = m.getTaskState(ids[1])
+	assert.Error(t, err)
+	tasks, err = m.meta.ListImportTasks()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tasks))
+}
+
+func TestImportManager_StartClose(t *testing.T) {
+	m := newTestImportManager(t)
+	m.start()
+	m.close()
+	// closing twice is fine
+	m.close()
+}
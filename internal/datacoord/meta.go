@@ -36,6 +36,7 @@ const (
 	metaPrefix           = "datacoord-meta"
 	segmentPrefix        = metaPrefix + "/s"
 	channelRemovePrefix  = metaPrefix + "/channel-removal"
+	importTaskPrefix     = metaPrefix + "/import-task"
 	handoffSegmentPrefix = "querycoord-handoff"
 
 	removeFlagTomestone = "removed"
@@ -228,6 +229,8 @@ func (m *meta) UpdateFlushSegmentsInfo(
 
 	if flushed {
 		clonedSegment.State = commonpb.SegmentState_Flushing
+		// imported segments become normal ones once they are flushed
+		clonedSegment.IsImporting = false
 		modSegments[segmentID] = clonedSegment
 	}
 
@@ -311,6 +314,13 @@ func (m *meta) UpdateFlushSegmentsInfo(
 			continue
 		}
 
+		// checkpoints of imported segments carry no position since their rows don't come from channels
+		if cp.GetPosition() == nil {
+			s.NumOfRows = cp.GetNumOfRows()
+			modSegments[cp.GetSegmentID()] = s
+			continue
+		}
+
 		if s.DmlPosition != nil && s.DmlPosition.Timestamp >= cp.Position.Timestamp {
 			// segment position in etcd is larger than checkpoint, then dont change it
 			continue
@@ -789,12 +799,104 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	return nil
 }
 
+// CompleteClusteringCompaction drops the compacted segments and adds the segments split from them by the clustering key,
+// the delta logs added to the compacted segments during the compaction are kept by every new segment
+func (m *meta) CompleteClusteringCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segments := make([]*SegmentInfo, 0, len(compactionLogs))
+	for _, cl := range compactionLogs {
+		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
+			cloned := segment.Clone()
+			cloned.State = commonpb.SegmentState_Dropped
+			cloned.DroppedAt = uint64(time.Now().UnixNano())
+			segments = append(segments, cloned)
+		}
+	}
+	if len(segments) == 0 {
+		return fmt.Errorf("segments of compaction plan %d are not found", result.GetPlanID())
+	}
+
+	var startPosition, dmlPosition *internalpb.MsgPosition
+	var originDeltalogs []*datapb.FieldBinlog
+	compactionFrom := make([]UniqueID, 0, len(segments))
+	for _, s := range segments {
+		if dmlPosition == nil || s.GetDmlPosition().Timestamp > dmlPosition.Timestamp {
+			dmlPosition = s.GetDmlPosition()
+		}
+		if startPosition == nil || s.GetStartPosition().Timestamp < startPosition.Timestamp {
+			startPosition = s.GetStartPosition()
+		}
+		originDeltalogs = append(originDeltalogs, s.GetDeltalogs()...)
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	var deletedDeltalogs []*datapb.FieldBinlog
+	for _, l := range compactionLogs {
+		deletedDeltalogs = append(deletedDeltalogs, l.GetDeltalogs()...)
+	}
+	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
+
+	newSegments := make([]*SegmentInfo, 0, len(result.GetSegments()))
+	for _, rs := range result.GetSegments() {
+		newSegments = append(newSegments, &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:                  rs.GetSegmentID(),
+				CollectionID:        segments[0].CollectionID,
+				PartitionID:         segments[0].PartitionID,
+				InsertChannel:       segments[0].InsertChannel,
+				NumOfRows:           rs.GetNumOfRows(),
+				State:               commonpb.SegmentState_Flushing,
+				MaxRowNum:           segments[0].MaxRowNum,
+				Binlogs:             rs.GetInsertLogs(),
+				Statslogs:           rs.GetField2StatslogPaths(),
+				Deltalogs:           append(rs.GetDeltalogs(), newAddedDeltalogs...),
+				StartPosition:       startPosition,
+				DmlPosition:         dmlPosition,
+				CreatedByCompaction: true,
+				CompactionFrom:      compactionFrom,
+				ClusteringKeyRange:  rs.GetClusteringKeyRange(),
+			},
+		})
+	}
+
+	data := make(map[string]string)
+	for _, s := range append(segments, newSegments...) {
+		k, v, err := m.marshal(s)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+	}
+	if err := m.saveKvTxn(data); err != nil {
+		return err
+	}
+
+	for _, s := range segments {
+		m.segments.DropSegment(s.GetID())
+	}
+	for _, s := range newSegments {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+	return nil
+}
+
 func (m *meta) CompleteInnerCompaction(segmentBinlogs *datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult) error {
 	m.Lock()
 	defer m.Unlock()
 
 	if segment := m.segments.GetSegment(segmentBinlogs.SegmentID); segment != nil {
 		cloned := segment.Clone()
+		// the result holds all the remaining entities if every insert binlog of the segment is compacted
+		if len(m.updateBinlogs(cloned.GetBinlogs(), segmentBinlogs.GetFieldBinlogs(), nil)) == 0 {
+			cloned.NumOfRows = result.GetNumOfRows()
+			// all the entities are deleted or expired, the segment is recycled by the garbage collector
+			if len(result.GetInsertLogs()) == 0 {
+				cloned.State = commonpb.SegmentState_Dropped
+				cloned.DroppedAt = uint64(time.Now().UnixNano())
+			}
+		}
 		cloned.Binlogs = m.updateBinlogs(cloned.GetBinlogs(), segmentBinlogs.GetFieldBinlogs(), result.GetInsertLogs())
 		cloned.Statslogs = m.updateBinlogs(cloned.GetStatslogs(), segmentBinlogs.GetField2StatslogPaths(), result.GetField2StatslogPaths())
 		cloned.Deltalogs = m.updateDeltalogs(cloned.GetDeltalogs(), segmentBinlogs.GetDeltalogs(), result.GetDeltalogs())
@@ -898,12 +1000,20 @@ func (m *meta) marshal(segment *SegmentInfo) (string, string, error) {
 
 // saveSegmentInfo utility function saving segment info into kv store
 func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
+	kvs := make(map[string]string)
+	if err := m.addSegmentKvs(kvs, segment); err != nil {
+		return err
+	}
+	return m.client.MultiSave(kvs)
+}
+
+// addSegmentKvs adds the kvs of segment info into kvs, along with the handoff info if the segment is flushed
+func (m *meta) addSegmentKvs(kvs map[string]string, segment *SegmentInfo) error {
 	segBytes, err := proto.Marshal(segment.SegmentInfo)
 	if err != nil {
 		log.Error("DataCoord saveSegmentInfo marshal failed", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
 		return fmt.Errorf("DataCoord saveSegmentInfo segmentID:%d, marshal failed:%w", segment.GetID(), err)
 	}
-	kvs := make(map[string]string)
 	dataKey := buildSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 	kvs[dataKey] = string(segBytes)
 	if segment.State == commonpb.SegmentState_Flushed {
@@ -924,8 +1034,7 @@ func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 		queryKey := buildQuerySegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
 		kvs[queryKey] = string(handoffSegBytes)
 	}
-
-	return m.client.MultiSave(kvs)
+	return nil
 }
 
 // removeSegmentInfo utility function removing segment info from kv store
@@ -935,6 +1044,76 @@ func (m *meta) removeSegmentInfo(segment *SegmentInfo) error {
 	return m.client.Remove(key)
 }
 
+// SaveImportTask saves the state of an import task into kv store
+func (m *meta) SaveImportTask(task *datapb.ImportTaskInfo) error {
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal import task %d, %w", task.GetId(), err)
+	}
+	return m.client.Save(buildImportTaskPath(task.GetId()), string(value))
+}
+
+// RemoveImportTask removes the import task from kv store
+func (m *meta) RemoveImportTask(taskID UniqueID) error {
+	return m.client.Remove(buildImportTaskPath(taskID))
+}
+
+// ListImportTasks loads all the import tasks from kv store
+func (m *meta) ListImportTasks() ([]*datapb.ImportTaskInfo, error) {
+	_, values, err := m.client.LoadWithPrefix(importTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*datapb.ImportTaskInfo, 0, len(values))
+	for _, value := range values {
+		task := &datapb.ImportTaskInfo{}
+		if err := proto.Unmarshal([]byte(value), task); err != nil {
+			return nil, fmt.Errorf("DataCoord ListImportTasks UnMarshal datapb.ImportTaskInfo err:%w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// CompleteImportTask turns all the importing segments of a completed import task into Flushing ones and saves
+// the task in one transaction, so that either all or none of the imported rows become visible
+func (m *meta) CompleteImportTask(task *datapb.ImportTaskInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	kvs := make(map[string]string)
+	modSegments := make([]*SegmentInfo, 0, len(task.GetSegments()))
+	for _, segmentID := range task.GetSegments() {
+		segment := m.segments.GetSegment(segmentID)
+		if segment == nil || !isSegmentHealthy(segment) {
+			return fmt.Errorf("segment %d of import task %d not found", segmentID, task.GetId())
+		}
+		if !segment.GetIsImporting() {
+			return fmt.Errorf("segment %d of import task %d is not importing", segmentID, task.GetId())
+		}
+		cloned := segment.Clone()
+		cloned.State = commonpb.SegmentState_Flushing
+		cloned.IsImporting = false
+		if err := m.addSegmentKvs(kvs, cloned); err != nil {
+			return err
+		}
+		modSegments = append(modSegments, cloned)
+	}
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal import task %d, %w", task.GetId(), err)
+	}
+	kvs[buildImportTaskPath(task.GetId())] = string(value)
+
+	if err := m.saveKvTxn(kvs); err != nil {
+		return err
+	}
+	for _, segment := range modSegments {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
 // saveKvTxn batch save kvs
 func (m *meta) saveKvTxn(kv map[string]string) error {
 	return m.client.MultiSave(kv)
@@ -950,6 +1129,11 @@ func buildQuerySegmentPath(collectionID UniqueID, partitionID UniqueID, segmentI
 	return fmt.Sprintf("%s/%d/%d/%d", handoffSegmentPrefix, collectionID, partitionID, segmentID)
 }
 
+// buildImportTaskPath common logic mapping import task to corresponding key in kv store
+func buildImportTaskPath(taskID UniqueID) string {
+	return fmt.Sprintf("%s/%d", importTaskPrefix, taskID)
+}
+
 // buildChannelRemovePat builds vchannel remove flag path
 func buildChannelRemovePath(channel string) string {
 	return fmt.Sprintf("%s/%s", channelRemovePrefix, channel)
@@ -258,6 +258,27 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		assert.True(t, proto.Equal(expected, updated))
 	})
 
+	t.Run("save binlogs of imported segment", func(t *testing.T) {
+		meta, err := newMeta(memkv.NewMemoryKV())
+		assert.Nil(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing, IsImporting: true,
+			DmlPosition: &internalpb.MsgPosition{Timestamp: 100}}}
+		err = meta.AddSegment(segment1)
+		assert.Nil(t, err)
+
+		// checkpoints of imported segments have no position
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, "binlog1")},
+			nil, nil, []*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, nil)
+		assert.Nil(t, err)
+
+		updated := meta.GetSegment(1)
+		assert.Equal(t, commonpb.SegmentState_Growing, updated.GetState())
+		assert.True(t, updated.GetIsImporting())
+		assert.EqualValues(t, 10, updated.GetNumOfRows())
+		assert.EqualValues(t, 100, updated.GetDmlPosition().GetTimestamp())
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMeta(memkv.NewMemoryKV())
 		assert.Nil(t, err)
@@ -310,6 +331,82 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 	})
 }
 
+func TestImportTaskMeta(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	meta, err := newMeta(kv)
+	assert.Nil(t, err)
+
+	err = meta.SaveImportTask(&datapb.ImportTaskInfo{Id: 1, State: commonpb.ImportState_ImportStarted})
+	assert.Nil(t, err)
+	err = meta.SaveImportTask(&datapb.ImportTaskInfo{Id: 2, State: commonpb.ImportState_ImportPending})
+	assert.Nil(t, err)
+	tasks, err := meta.ListImportTasks()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tasks))
+
+	err = meta.RemoveImportTask(1)
+	assert.Nil(t, err)
+	tasks, err = meta.ListImportTasks()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.EqualValues(t, 2, tasks[0].GetId())
+
+	// segments are not reloaded as import tasks
+	err = meta.AddSegment(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Growing}})
+	assert.Nil(t, err)
+	tasks, err = meta.ListImportTasks()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tasks))
+}
+
+func TestCompleteImportTask(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	meta, err := newMeta(kv)
+	assert.Nil(t, err)
+
+	for _, id := range []UniqueID{1, 2} {
+		err = meta.AddSegment(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: id, State: commonpb.SegmentState_Growing, IsImporting: true}})
+		assert.Nil(t, err)
+	}
+	err = meta.AddSegment(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 3, State: commonpb.SegmentState_Growing}})
+	assert.Nil(t, err)
+
+	t.Run("segment not importing", func(t *testing.T) {
+		err := meta.CompleteImportTask(&datapb.ImportTaskInfo{Id: 100, State: commonpb.ImportState_ImportCompleted, Segments: []int64{1, 3}})
+		assert.Error(t, err)
+		// nothing changes if any segment is invalid
+		assert.True(t, meta.GetSegment(1).GetIsImporting())
+		tasks, err := meta.ListImportTasks()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(tasks))
+	})
+
+	t.Run("segment not found", func(t *testing.T) {
+		err := meta.CompleteImportTask(&datapb.ImportTaskInfo{Id: 100, State: commonpb.ImportState_ImportCompleted, Segments: []int64{1, 4}})
+		assert.Error(t, err)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		err := meta.CompleteImportTask(&datapb.ImportTaskInfo{Id: 100, State: commonpb.ImportState_ImportCompleted, Segments: []int64{1, 2}})
+		assert.Nil(t, err)
+		for _, id := range []UniqueID{1, 2} {
+			segment := meta.GetSegment(id)
+			assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+			assert.False(t, segment.GetIsImporting())
+		}
+		tasks, err := meta.ListImportTasks()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, tasks[0].GetState())
+
+		// the segments are persisted as well
+		reloaded, err := newMeta(kv)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.SegmentState_Flushing, reloaded.GetSegment(1).GetState())
+		assert.False(t, reloaded.GetSegment(2).GetIsImporting())
+	})
+}
+
 func TestSaveHandoffMeta(t *testing.T) {
 	meta, err := newMeta(memkv.NewMemoryKV())
 	assert.Nil(t, err)
@@ -493,6 +590,36 @@ func Test_meta_CompleteInnerCompaction(t *testing.T) {
 	}
 }
 
+func Test_meta_CompleteInnerCompactionDropSegment(t *testing.T) {
+	m := &meta{
+		client: memkv.NewMemoryKV(),
+		segments: &SegmentsInfo{
+			map[int64]*SegmentInfo{
+				1: {SegmentInfo: &datapb.SegmentInfo{
+					ID:        1,
+					NumOfRows: 10,
+					State:     commonpb.SegmentState_Flushed,
+					Binlogs:   []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")},
+					Statslogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog1")},
+				}},
+			},
+		},
+	}
+
+	// all the entities of the segment are expired
+	err := m.CompleteInnerCompaction(&datapb.CompactionSegmentBinlogs{
+		SegmentID:           1,
+		FieldBinlogs:        []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")},
+		Field2StatslogPaths: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "statlog1")},
+	}, &datapb.CompactionResult{SegmentID: 1, NumOfRows: 0})
+	assert.Nil(t, err)
+	assert.Nil(t, m.GetSegment(1))
+	segment := m.segments.GetSegment(1)
+	assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+	assert.Equal(t, int64(0), segment.GetNumOfRows())
+	assert.NotZero(t, segment.GetDroppedAt())
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.TxnKV
@@ -19,11 +19,13 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"go.uber.org/zap"
@@ -106,6 +108,9 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 		SystemConfigurations: metricsinfo.DataCoordConfiguration{
 			SegmentMaxSize: Params.DataCoordCfg.SegmentMaxSize,
 		},
+		QuotaMetrics: metricsinfo.DataCoordQuotaMetrics{
+			FlushLag: s.getFlushLag(time.Now()),
+		},
 	}
 
 	metricsinfo.FillDeployMetricsWithEnv(&ret.BaseComponentInfos.SystemInfo)
@@ -113,6 +118,23 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 	return ret
 }
 
+// getFlushLag returns how long the oldest sealed segment has been waiting for flush since its
+// last allocation expired, growing segments are not counted as they are not ready for flush
+func (s *Server) getFlushLag(now time.Time) time.Duration {
+	var lag time.Duration
+	segments := append(s.meta.GetUnFlushedSegments(), s.meta.GetFlushingSegments()...)
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Growing || segment.GetIsImporting() {
+			continue
+		}
+		expireTime, _ := tsoutil.ParseTS(segment.GetLastExpireTime())
+		if d := now.Sub(expireTime); d > lag {
+			lag = d
+		}
+	}
+	return lag
+}
+
 // getDataNodeMetrics composes DataNode infos
 // this function will invoke GetMetrics with DataNode specified in NodeInfo
 func (s *Server) getDataNodeMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *Session) (metricsinfo.DataNodeInfos, error) {
@@ -20,11 +20,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +115,24 @@ func TestGetDataNodeMetrics(t *testing.T) {
 	assert.True(t, info.HasError)
 
 }
+
+func TestGetFlushLag(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	assert.Equal(t, time.Duration(0), svr.getFlushLag(now))
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, State: commonpb.SegmentState_Growing, LastExpireTime: tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)},
+		{ID: 2, State: commonpb.SegmentState_Sealed, LastExpireTime: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)},
+		{ID: 3, State: commonpb.SegmentState_Flushing, LastExpireTime: tsoutil.ComposeTSByTime(now.Add(-2*time.Minute), 0)},
+		{ID: 4, State: commonpb.SegmentState_Flushed, LastExpireTime: tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)},
+		{ID: 5, State: commonpb.SegmentState_Sealed, IsImporting: true},
+	}
+	for _, segment := range segments {
+		err := svr.meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2*time.Minute, svr.getFlushLag(now))
+}
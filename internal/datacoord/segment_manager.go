@@ -70,6 +70,8 @@ const segmentMaxLifetime = 24 * time.Hour
 type Manager interface {
 	// AllocSegment allocates rows and record the allocation.
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
+	// AllocImportSegment allocates a segment to hold requestRows rows written by bulk import.
+	AllocImportSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) (*Allocation, error)
 	// DropSegment drops the segment from manager.
 	DropSegment(ctx context.Context, segmentID UniqueID)
 	// SealAllSegments seals all segments of collection with collectionID and return sealed segments
@@ -210,6 +212,10 @@ func (s *SegmentManager) loadSegmentsFromMeta() {
 	segments := s.meta.GetUnFlushedSegments()
 	segmentsID := make([]UniqueID, 0, len(segments))
 	for _, segment := range segments {
+		// segments of bulk import are filled by importers instead of insert messages
+		if segment.GetIsImporting() {
+			continue
+		}
 		segmentsID = append(segmentsID, segment.GetID())
 	}
 	s.segments = segmentsID
@@ -251,7 +257,7 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		return nil, err
 	}
 	for _, allocation := range newSegmentAllocations {
-		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName)
+		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, false)
 		if err != nil {
 			return nil, err
 		}
@@ -273,6 +279,30 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	return allocations, nil
 }
 
+// AllocImportSegment allocates a new segment for bulk import. The segment is marked as importing and isn't
+// tracked by the manager, so no insert message is assigned to it and seal policies don't apply to it,
+// it turns to Flushing together with the other segments of its import task once the task completes.
+func (s *SegmentManager) AllocImportSegment(ctx context.Context, collectionID UniqueID,
+	partitionID UniqueID, channelName string, requestRows int64) (*Allocation, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expireTs, err := s.genExpireTs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, true)
+	if err != nil {
+		return nil, err
+	}
+	allocation := getAllocation(requestRows)
+	allocation.ExpireTime = expireTs
+	allocation.SegmentID = segment.GetID()
+	return allocation, nil
+}
+
 func satisfy(segment *SegmentInfo, collectionID, partitionID UniqueID, channel string) bool {
 	return segment.GetCollectionID() == collectionID && segment.GetPartitionID() == partitionID &&
 		segment.GetInsertChannel() == channel
@@ -293,7 +323,7 @@ func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 	return expireTs, nil
 }
 
-func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string) (*SegmentInfo, error) {
+func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string, isImport bool) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	id, err := s.allocator.allocID(ctx)
@@ -314,12 +344,15 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		State:          commonpb.SegmentState_Growing,
 		MaxRowNum:      int64(maxNumOfRows),
 		LastExpireTime: 0,
+		IsImporting:    isImport,
 	}
 	segment := NewSegmentInfo(segmentInfo)
 	if err := s.meta.AddSegment(segment); err != nil {
 		return nil, err
 	}
-	s.segments = append(s.segments, id)
+	if !isImport {
+		s.segments = append(s.segments, id)
+	}
 	log.Debug("datacoord: estimateTotalRows: ",
 		zap.Int64("CollectionID", segmentInfo.CollectionID),
 		zap.Int64("SegmentID", segmentInfo.ID),
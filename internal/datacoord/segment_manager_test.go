@@ -121,6 +121,38 @@ func TestAllocSegment(t *testing.T) {
 	})
 }
 
+func TestAllocImportSegment(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+	mockAllocator := newMockAllocator()
+	meta, err := newMemoryMeta(mockAllocator)
+	assert.Nil(t, err)
+	segmentManager := newSegmentManager(meta, mockAllocator)
+
+	schema := newTestSchema()
+	collID, err := mockAllocator.allocID(ctx)
+	assert.Nil(t, err)
+	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
+
+	allocation, err := segmentManager.AllocImportSegment(ctx, collID, 100, "c1", 100)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 100, allocation.NumOfRows)
+	assert.NotEqualValues(t, 0, allocation.ExpireTime)
+
+	segment := meta.GetSegment(allocation.SegmentID)
+	assert.NotNil(t, segment)
+	assert.True(t, segment.GetIsImporting())
+	// import segments are not assigned to inserts
+	assert.Equal(t, 0, len(segmentManager.segments))
+	allocations, err := segmentManager.AllocSegment(ctx, collID, 100, "c1", 100)
+	assert.Nil(t, err)
+	assert.NotEqual(t, allocation.SegmentID, allocations[0].SegmentID)
+
+	// import segments are not loaded by a new segment manager either
+	segmentManager = newSegmentManager(meta, mockAllocator)
+	assert.Equal(t, []UniqueID{allocations[0].SegmentID}, segmentManager.segments)
+}
+
 func TestLoadSegmentsFromMeta(t *testing.T) {
 	ctx := context.Background()
 	Params.Init()
@@ -28,14 +28,16 @@ import (
 
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -44,8 +46,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	"github.com/minio/minio-go/v7"
-	"github.com/minio/minio-go/v7/pkg/credentials"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -103,7 +103,7 @@ type Server struct {
 	helper           ServerHelper
 
 	etcdCli          *clientv3.Client
-	kvClient         *etcdkv.EtcdKV
+	kvClient         kv.MetaKv
 	meta             *meta
 	segmentManager   Manager
 	allocator        allocator
@@ -114,9 +114,11 @@ type Server struct {
 	garbageCollector *garbageCollector
 	gcOpt            GcOption
 	handler          Handler
+	pins             *collectionPins
 
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
+	importManager     *importManager
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
@@ -190,6 +192,7 @@ func CreateServer(ctx context.Context, factory msgstream.Factory, opts ...Option
 		dataNodeCreator:        defaultDataNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
 		helper:                 defaultServerHelper(),
+		pins:                   newCollectionPins(),
 
 		metricsCacheManager: metricsinfo.NewMetricsCacheManager(),
 	}
@@ -230,7 +233,7 @@ func (s *Server) Register() error {
 }
 
 func (s *Server) initSession() error {
-	s.session = sessionutil.NewSession(s.ctx, Params.BaseParams.MetaRootPath, s.etcdCli)
+	s.session = metastore.NewSession(s.ctx, s.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if s.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -278,6 +281,9 @@ func (s *Server) Start() error {
 	}
 
 	s.allocator = newRootCoordAllocator(s.rootCoordClient)
+	if s.importManager, err = newImportManager(s.meta, s.allocator, s.sessionManager, s.flushCh); err != nil {
+		return err
+	}
 	if Params.DataCoordCfg.EnableCompaction {
 		s.createCompactionHandler()
 		s.createCompactionTrigger()
@@ -331,7 +337,7 @@ func (s *Server) stopCompactionHandler() {
 }
 
 func (s *Server) createCompactionTrigger() {
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator)
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler, s.pins)
 	s.compactionTrigger.start()
 }
 
@@ -340,45 +346,20 @@ func (s *Server) stopCompactionTrigger() {
 }
 
 func (s *Server) initGarbageCollection() error {
-	var cli *minio.Client
+	var cli storage.ChunkManager
 	var err error
 	if Params.DataCoordCfg.EnableGarbageCollection {
-		cli, err = minio.New(Params.MinioCfg.Address, &minio.Options{
-			Creds:  credentials.NewStaticV4(Params.MinioCfg.AccessKeyID, Params.MinioCfg.SecretAccessKey, ""),
-			Secure: Params.MinioCfg.UseSSL,
-		})
-		if err != nil {
-			return err
-		}
-
-		checkBucketFn := func() error {
-			has, err := cli.BucketExists(context.TODO(), Params.MinioCfg.BucketName)
-			if err != nil {
-				return err
-			}
-			if !has {
-				err = cli.MakeBucket(context.TODO(), Params.MinioCfg.BucketName, minio.MakeBucketOptions{})
-				if err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-		// retry times shall be two, just to prevent
-		// 1. bucket not exists
-		// 2. bucket is created by other componnent
-		// 3. datacoord try to create but failed with bucket already exists error
-		err = retry.Do(s.ctx, checkBucketFn, retry.Attempts(2))
+		cli, err = storage.NewChunkManager(s.ctx, &Params)
 		if err != nil {
 			return err
 		}
 	}
 
 	s.garbageCollector = newGarbageCollector(s.meta, GcOption{
-		cli:        cli,
-		enabled:    Params.DataCoordCfg.EnableGarbageCollection,
-		bucketName: Params.MinioCfg.BucketName,
-		rootPath:   Params.MinioCfg.RootPath,
+		cli:      cli,
+		enabled:  Params.DataCoordCfg.EnableGarbageCollection,
+		rootPath: Params.MinioCfg.RootPath,
+		pins:     s.pins,
 
 		checkInterval:    Params.DataCoordCfg.GCInterval,
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance,
@@ -417,8 +398,11 @@ func (s *Server) startSegmentManager() {
 }
 
 func (s *Server) initMeta() error {
-	etcdKV := etcdkv.NewEtcdKV(s.etcdCli, Params.BaseParams.MetaRootPath)
-	s.kvClient = etcdKV
+	metaKV, err := metastore.NewMetaKV(s.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
+	if err != nil {
+		return err
+	}
+	s.kvClient = metaKV
 	reloadEtcdFn := func() error {
 		var err error
 		s.meta, err = newMeta(s.kvClient)
@@ -436,6 +420,7 @@ func (s *Server) startServerLoop() {
 	s.startDataNodeTtLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.importManager.start()
 	s.garbageCollector.start()
 }
 
@@ -655,6 +640,7 @@ func (s *Server) handleSessionEvent(ctx context.Context, event *sessionutil.Sess
 			log.Warn("failed to deregisger node", zap.Int64("id", node.NodeID), zap.String("address", node.Address), zap.Error(err))
 			return err
 		}
+		s.importManager.failNodeTasks(node.NodeID)
 		s.metricsCacheManager.InvalidateSystemInfoMetrics()
 	default:
 		log.Warn("receive unknown service event type",
@@ -751,6 +737,7 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Debug("server shutdown")
 	s.cluster.Close()
 	s.garbageCollector.close()
+	s.importManager.close()
 	s.stopServerLoop()
 	s.session.Revoke(time.Second)
 
@@ -820,6 +807,7 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 		Schema:         resp.Schema,
 		Partitions:     presp.PartitionIDs,
 		StartPositions: resp.GetStartPositions(),
+		Properties:     resp.GetProperties(),
 	}
 	s.meta.AddCollection(collInfo)
 	return nil
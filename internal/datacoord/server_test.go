@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -99,6 +100,99 @@ func TestAssignSegmentID(t *testing.T) {
 		assert.EqualValues(t, 1000, assign.Count)
 	})
 
+	t.Run("assign import segment", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{
+			ID:         collID,
+			Schema:     schema,
+			Partitions: []int64{},
+		})
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+			IsImport:     true,
+		}
+
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			NodeID:            0,
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(resp.SegIDAssignments))
+		assign := resp.SegIDAssignments[0]
+		assert.EqualValues(t, commonpb.ErrorCode_Success, assign.Status.ErrorCode)
+		assert.EqualValues(t, 1000, assign.Count)
+		segment := svr.meta.GetSegment(assign.SegID)
+		assert.NotNil(t, segment)
+		assert.True(t, segment.GetIsImporting())
+	})
+
+	t.Run("assign segment of import task", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{NodeID: 1, Address: "localhost:1"})
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{
+			ID:         collID,
+			Schema:     schema,
+			Partitions: []int64{},
+		})
+		importResp, err := svr.Import(context.TODO(), &datapb.ImportTaskRequest{
+			ImportTask: &datapb.ImportTask{
+				CollectionId: collID,
+				Files:        []string{"a.json"},
+				RowBased:     true,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, importResp.GetStatus().GetErrorCode())
+		taskID := importResp.GetTasks()[0]
+
+		req := &datapb.SegmentIDRequest{
+			Count:        1000,
+			ChannelName:  channel0,
+			CollectionID: collID,
+			PartitionID:  partID,
+			IsImport:     true,
+			ImportTaskId: taskID,
+		}
+		resp, err := svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			NodeID:            1,
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(resp.SegIDAssignments))
+		segID := resp.SegIDAssignments[0].SegID
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{Task: taskID})
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{segID}, stateResp.GetIdList())
+
+		// the segment is dropped when the task fails without reporting it
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			TaskId:     taskID,
+			DatanodeId: 1,
+			State:      commonpb.ImportState_ImportFailed,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Nil(t, svr.meta.GetSegment(segID))
+
+		// no segment is assigned to a finished task
+		resp, err = svr.AssignSegmentID(context.TODO(), &datapb.AssignSegmentIDRequest{
+			NodeID:            1,
+			PeerRole:          typeutil.DataNodeRole,
+			SegmentIDRequests: []*datapb.SegmentIDRequest{req},
+		})
+		assert.Nil(t, err)
+		assert.EqualValues(t, 0, len(resp.SegIDAssignments))
+	})
+
 	t.Run("with closed server", func(t *testing.T) {
 		req := &datapb.SegmentIDRequest{
 			Count:        100,
@@ -725,6 +819,11 @@ func (s *spySegmentManager) AllocSegment(ctx context.Context, collectionID Uniqu
 	panic("not implemented") // TODO: Implement
 }
 
+// AllocImportSegment allocates a segment to hold requestRows rows written by bulk import.
+func (s *spySegmentManager) AllocImportSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string, requestRows int64) (*Allocation, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // DropSegment drops the segment from manager.
 func (s *spySegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
 }
@@ -2231,6 +2330,133 @@ func TestGetFlushState(t *testing.T) {
 	})
 }
 
+func TestImport(t *testing.T) {
+	t.Run("import without datanode", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTaskRequest{
+			ImportTask: &datapb.ImportTask{
+				CollectionId: 100,
+				Files:        []string{"a.json"},
+				RowBased:     true,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("import and report", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{NodeID: 1, Address: "localhost:1"})
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTaskRequest{
+			ImportTask: &datapb.ImportTask{
+				CollectionId: 100,
+				Files:        []string{"a.json"},
+				RowBased:     true,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetTasks()))
+		taskID := resp.GetTasks()[0]
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			TaskId:     taskID,
+			DatanodeId: 1,
+			State:      commonpb.ImportState_ImportCompleted,
+			RowCount:   10,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{Task: taskID})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, stateResp.GetState())
+		assert.EqualValues(t, 10, stateResp.GetRowCount())
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{TaskId: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{Task: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.Import(context.TODO(), &datapb.ImportTaskRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID), resp.GetStatus().GetReason())
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID), stateResp.GetStatus().GetReason())
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID), status.GetReason())
+	})
+}
+
+func TestPinCollection(t *testing.T) {
+	t.Run("pin and unpin", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.PinCollection(context.TODO(), &datapb.PinCollectionRequest{
+			CollectionID: 100,
+			TtlSeconds:   60,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, svr.pins.isPinned(100))
+
+		status, err := svr.UnpinCollection(context.TODO(), &datapb.UnpinCollectionRequest{PinID: resp.GetPinID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.False(t, svr.pins.isPinned(100))
+
+		status, err = svr.UnpinCollection(context.TODO(), &datapb.UnpinCollectionRequest{PinID: resp.GetPinID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		resp, err := svr.PinCollection(context.TODO(), &datapb.PinCollectionRequest{CollectionID: 100})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.False(t, svr.pins.isPinned(100))
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		resp, err := svr.PinCollection(context.TODO(), &datapb.PinCollectionRequest{CollectionID: 100, TtlSeconds: 60})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID), resp.GetStatus().GetReason())
+
+		status, err := svr.UnpinCollection(context.TODO(), &datapb.UnpinCollectionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID), status.GetReason())
+	})
+}
+
 func newTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
 	Params.Init()
 	Params.DataCoordCfg.TimeTickChannelName = Params.DataCoordCfg.TimeTickChannelName + strconv.Itoa(rand.Int())
@@ -125,13 +125,33 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 			}
 		}
 
-		s.cluster.Watch(r.ChannelName, r.CollectionID)
+		var allocations []*Allocation
+		if r.GetIsImport() {
+			// segments of bulk import are written by the importer directly, no DataNode needs to watch the channel
+			allocation, err := s.segmentManager.AllocImportSegment(ctx,
+				r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+			if err != nil {
+				log.Warn("failed to alloc segment for import", zap.Any("request", r), zap.Error(err))
+				continue
+			}
+			if r.GetImportTaskId() != 0 {
+				if err := s.importManager.addTaskSegment(r.GetImportTaskId(), allocation.SegmentID); err != nil {
+					log.Warn("failed to record segment of import task", zap.Any("request", r),
+						zap.Int64("segmentID", allocation.SegmentID), zap.Error(err))
+					continue
+				}
+			}
+			allocations = append(allocations, allocation)
+		} else {
+			s.cluster.Watch(r.ChannelName, r.CollectionID)
 
-		allocations, err := s.segmentManager.AllocSegment(ctx,
-			r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
-		if err != nil {
-			log.Warn("failed to alloc segment", zap.Any("request", r), zap.Error(err))
-			continue
+			var err error
+			allocations, err = s.segmentManager.AllocSegment(ctx,
+				r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+			if err != nil {
+				log.Warn("failed to alloc segment", zap.Any("request", r), zap.Error(err))
+				continue
+			}
 		}
 
 		log.Debug("success to assign segments", zap.Int64("collectionID", r.GetCollectionID()), zap.Any("assignments", allocations))
@@ -317,6 +337,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		zap.Int64("segmentID", req.GetSegmentID()),
 		zap.Bool("isFlush", req.GetFlushed()),
 		zap.Bool("isDropped", req.GetDropped()),
+		zap.Bool("isImporting", req.GetImporting()),
 		zap.Any("startPositions", req.GetStartPositions()),
 		zap.Any("checkpoints", req.GetCheckPoints()))
 
@@ -331,8 +352,9 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		return resp, nil
 	}
 
+	// imported segments are written by the DataNode executing the import task, which may not watch the channel
 	channel := segment.GetInsertChannel()
-	if !s.channelManager.Match(nodeID, channel) {
+	if !req.GetImporting() && !s.channelManager.Match(nodeID, channel) {
 		FailResponse(resp, fmt.Sprintf("channel %s is not watched on node %d", channel, nodeID))
 		log.Warn("node is not matched with channel", zap.String("channel", channel), zap.Int64("nodeID", nodeID))
 		return resp, nil
@@ -501,6 +523,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	segment2StatsBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segment2DeltaBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segmentsNumOfRows := make(map[UniqueID]int64)
+	segment2ClusteringKeyRange := make(map[UniqueID]*datapb.FieldRange)
+	segment2CompactionFrom := make(map[UniqueID][]UniqueID)
 
 	flushedIDs := make(map[int64]struct{})
 	for _, id := range segmentIDs {
@@ -539,6 +563,8 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		}
 
 		segmentsNumOfRows[id] = segment.NumOfRows
+		segment2ClusteringKeyRange[id] = segment.GetClusteringKeyRange()
+		segment2CompactionFrom[id] = segment.GetCompactionFrom()
 
 		statsBinlogs := segment.GetStatslogs()
 		field2StatsBinlog := make(map[UniqueID][]*datapb.Binlog)
@@ -562,11 +588,13 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
 	for segmentID := range flushedIDs {
 		sbl := &datapb.SegmentBinlogs{
-			SegmentID:    segmentID,
-			NumOfRows:    segmentsNumOfRows[segmentID],
-			FieldBinlogs: segment2Binlogs[segmentID],
-			Statslogs:    segment2StatsBinlogs[segmentID],
-			Deltalogs:    segment2DeltaBinlogs[segmentID],
+			SegmentID:          segmentID,
+			NumOfRows:          segmentsNumOfRows[segmentID],
+			FieldBinlogs:       segment2Binlogs[segmentID],
+			Statslogs:          segment2StatsBinlogs[segmentID],
+			Deltalogs:          segment2DeltaBinlogs[segmentID],
+			ClusteringKeyRange: segment2ClusteringKeyRange[segmentID],
+			CompactionFrom:     segment2CompactionFrom[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -962,3 +990,121 @@ func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateR
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
+
+// Import distributes the import task to DataNodes, row-based files are imported by one task per file,
+// column-based files are imported by a single task.
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	log.Debug("received import request", zap.Int64("collectionID", req.GetImportTask().GetCollectionId()),
+		zap.Int64("partitionID", req.GetImportTask().GetPartitionId()), zap.Strings("files", req.GetImportTask().GetFiles()))
+
+	resp := &milvuspb.ImportResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}
+	if s.isClosed() {
+		log.Warn("failed to import because of closed server", zap.Int64("collectionID", req.GetImportTask().GetCollectionId()))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	ids, err := s.importManager.importJob(ctx, req.GetImportTask())
+	resp.Tasks = ids
+	if err != nil {
+		log.Warn("failed to import", zap.Int64("collectionID", req.GetImportTask().GetCollectionId()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to create import tasks", zap.Int64("collectionID", req.GetImportTask().GetCollectionId()), zap.Int64s("taskIDs", ids))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetImportState gets the state of an import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("received get import state request", zap.Int64("taskID", req.GetTask()))
+
+	resp := &milvuspb.GetImportStateResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}
+	if s.isClosed() {
+		log.Warn("failed to get import state because of closed server", zap.Int64("taskID", req.GetTask()))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	state, err := s.importManager.getTaskState(req.GetTask())
+	if err != nil {
+		log.Warn("failed to get import state", zap.Int64("taskID", req.GetTask()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	return state, nil
+}
+
+// ReportImport receives the progress and the result of an import task from DataNode
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	log.Debug("received import report", zap.Int64("taskID", req.GetTaskId()), zap.Int64("nodeID", req.GetDatanodeId()),
+		zap.String("state", req.GetState().String()), zap.Int64("rowCount", req.GetRowCount()), zap.Int64s("segments", req.GetSegments()))
+
+	resp := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+	if s.isClosed() {
+		log.Warn("failed to report import because of closed server", zap.Int64("taskID", req.GetTaskId()))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	if err := s.importManager.updateTaskState(req); err != nil {
+		log.Warn("failed to update import task state", zap.Int64("taskID", req.GetTaskId()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// PinCollection keeps the segments of a collection from being compacted and garbage collected for ttl_seconds,
+// or until the pin is released by UnpinCollection
+func (s *Server) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error) {
+	log.Debug("received pin collection request", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("ttlSeconds", req.GetTtlSeconds()))
+
+	resp := &datapb.PinCollectionResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}}
+	if s.isClosed() {
+		log.Warn("failed to pin collection because of closed server", zap.Int64("collectionID", req.GetCollectionID()))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+	if req.GetTtlSeconds() <= 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid pin ttl %d, it must be positive", req.GetTtlSeconds())
+		return resp, nil
+	}
+
+	pinID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		log.Warn("failed to allocate pin id", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	s.pins.pin(pinID, req.GetCollectionID(), time.Duration(req.GetTtlSeconds())*time.Second)
+
+	log.Debug("success to pin collection", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("pinID", pinID))
+	resp.PinID = pinID
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// UnpinCollection releases a pin of PinCollection, it fails if the pin has expired,
+// since the segments of the collection may have been compacted in the meantime
+func (s *Server) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error) {
+	log.Debug("received unpin collection request", zap.Int64("pinID", req.GetPinID()))
+
+	resp := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+	if s.isClosed() {
+		log.Warn("failed to unpin collection because of closed server", zap.Int64("pinID", req.GetPinID()))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	if !s.pins.unpin(req.GetPinID()) {
+		log.Warn("pin doesn't exist or has expired", zap.Int64("pinID", req.GetPinID()))
+		resp.Reason = fmt.Sprintf("pin %d doesn't exist or has expired", req.GetPinID())
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
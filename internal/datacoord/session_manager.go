@@ -30,7 +30,8 @@ import (
 )
 
 const (
-	flushTimeout = 5 * time.Second
+	flushTimeout  = 5 * time.Second
+	importTimeout = 5 * time.Second
 )
 
 // SessionManager provides the grpc interfaces of cluster
@@ -148,6 +149,27 @@ func (c *SessionManager) execCompaction(nodeID int64, plan *datapb.CompactionPla
 	log.Debug("success to execute compaction", zap.Int64("node", nodeID), zap.Any("planID", plan.GetPlanID()))
 }
 
+// Import is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously,
+// the DataNode executes the import task in background and reports the result through ReportImport.
+func (c *SessionManager) Import(ctx context.Context, nodeID int64, req *datapb.ImportTaskRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.Import(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to send import task", zap.Int64("node", nodeID), zap.Error(err), zap.Int64("taskID", req.GetImportTask().GetTaskId()))
+		return err
+	}
+
+	log.Debug("success to send import task", zap.Int64("node", nodeID), zap.Int64("taskID", req.GetImportTask().GetTaskId()))
+	return nil
+}
+
 func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.DataNode, error) {
 	c.sessions.RLock()
 	session, ok := c.sessions.data[nodeID]
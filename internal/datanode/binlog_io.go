@@ -17,15 +17,14 @@
 package datanode
 
 import (
-	"bytes"
 	"context"
 	"errors"
+	"math"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -54,7 +53,7 @@ type uploader interface {
 }
 
 type binlogIO struct {
-	kv.BaseKV
+	storage.ChunkManager
 	allocatorInterface
 }
 
@@ -64,7 +63,7 @@ var _ uploader = (*binlogIO)(nil)
 func (b *binlogIO) download(ctx context.Context, paths []string) ([]*Blob, error) {
 	var (
 		err = errStart
-		vs  = []string{}
+		vs  = [][]byte{}
 	)
 
 	g, gCtx := errgroup.WithContext(ctx)
@@ -81,7 +80,7 @@ func (b *binlogIO) download(ctx context.Context, paths []string) ([]*Blob, error
 					<-time.After(50 * time.Millisecond)
 					log.Warn("Try multiloading again", zap.Strings("paths", paths))
 				}
-				vs, err = b.MultiLoad(paths)
+				vs, err = b.MultiRead(paths)
 			}
 		}
 		return nil
@@ -93,7 +92,7 @@ func (b *binlogIO) download(ctx context.Context, paths []string) ([]*Blob, error
 
 	rst := make([]*Blob, len(vs))
 	for i := range rst {
-		rst[i] = &Blob{Value: bytes.NewBufferString(vs[i]).Bytes()}
+		rst[i] = &Blob{Value: vs[i]}
 	}
 
 	return rst, nil
@@ -117,7 +116,7 @@ func (b *binlogIO) upload(
 	var (
 		inPathm    = make(map[UniqueID]*datapb.FieldBinlog) // FieldID > its FieldBinlog
 		statsPathm = make(map[UniqueID]*datapb.FieldBinlog) // FieldID > its statsBinlog
-		kvs        = make(map[string]string)
+		kvs        = make(map[string][]byte)
 	)
 
 	for _, iData := range iDatas {
@@ -177,7 +176,7 @@ func (b *binlogIO) upload(
 			return nil, err
 		}
 
-		kvs[k] = bytes.NewBuffer(v).String()
+		kvs[k] = v
 		p.deltaInfo = append(p.deltaInfo, &datapb.FieldBinlog{
 			//Field id shall be primary key id
 			Binlogs: []*datapb.Binlog{
@@ -203,7 +202,7 @@ func (b *binlogIO) upload(
 					<-time.After(50 * time.Millisecond)
 					log.Info("retry save binlogs")
 				}
-				err = b.MultiSave(kvs)
+				err = b.MultiWrite(kvs)
 			}
 		}
 		return nil
@@ -236,7 +235,7 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 }
 
 // genInsertBlobs returns kvs, insert-paths, stats-paths
-func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string]string, map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
+func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string][]byte, map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
 	inCodec := storage.NewInsertCodec(meta)
 	inlogs, statslogs, err := inCodec.Serialize(partID, segID, data)
 	if err != nil {
@@ -244,7 +243,7 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 	}
 
 	var (
-		kvs        = make(map[string]string, len(inlogs)+len(statslogs))
+		kvs        = make(map[string][]byte, len(inlogs)+len(statslogs))
 		inpaths    = make(map[UniqueID]*datapb.FieldBinlog)
 		statspaths = make(map[UniqueID]*datapb.FieldBinlog)
 	)
@@ -257,19 +256,21 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		return nil, nil, nil, err
 	}
 
+	tsFrom, tsTo := getTimestampRange(data)
+
 	for _, blob := range inlogs {
 		// Blob Key is generated by Serialize from int64 fieldID in collection schema, which won't raise error in ParseInt
 		fID, _ := strconv.ParseInt(blob.GetKey(), 10, 64)
 		k := JoinIDPath(meta.GetID(), partID, segID, fID, <-generator)
 		key := path.Join(Params.DataNodeCfg.InsertBinlogRootPath, k)
 
-		value := bytes.NewBuffer(blob.GetValue()).String()
+		value := blob.GetValue()
 		fileLen := len(value)
 
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, TimestampFrom: tsFrom, TimestampTo: tsTo}},
 		}
 	}
 
@@ -280,7 +281,7 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		k := JoinIDPath(meta.GetID(), partID, segID, fID, <-generator)
 		key := path.Join(Params.DataNodeCfg.StatsBinlogRootPath, k)
 
-		value := bytes.NewBuffer(blob.GetValue()).String()
+		value := blob.GetValue()
 		fileLen := len(value)
 
 		kvs[key] = value
@@ -293,6 +294,24 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 	return kvs, inpaths, statspaths, nil
 }
 
+// getTimestampRange returns the min and max insert timestamps of the data, both are 0 if the data has no timestamp
+func getTimestampRange(data *InsertData) (Timestamp, Timestamp) {
+	tsField, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok || len(tsField.Data) == 0 {
+		return 0, 0
+	}
+	tsFrom, tsTo := Timestamp(math.MaxUint64), Timestamp(0)
+	for _, ts := range tsField.Data {
+		if Timestamp(ts) < tsFrom {
+			tsFrom = Timestamp(ts)
+		}
+		if Timestamp(ts) > tsTo {
+			tsTo = Timestamp(ts)
+		}
+	}
+	return tsFrom, tsTo
+}
+
 func (b *binlogIO) idxGenerator(n int, done <-chan struct{}) (<-chan UniqueID, error) {
 
 	idStart, _, err := b.allocIDBatch(uint32(n))
@@ -315,7 +334,3 @@ func (b *binlogIO) idxGenerator(n int, done <-chan struct{}) (<-chan UniqueID, e
 
 	return rt, nil
 }
-
-func (b *binlogIO) close() {
-	b.Close()
-}
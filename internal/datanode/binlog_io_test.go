@@ -24,8 +24,6 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/kv"
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
@@ -35,9 +33,9 @@ import (
 
 func TestBinlogIOInterfaceMethods(t *testing.T) {
 	alloc := NewAllocatorFactory()
-	kv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 
-	b := &binlogIO{kv, alloc}
+	b := &binlogIO{cm, alloc}
 	t.Run("Test upload", func(t *testing.T) {
 		f := &MetaFactory{}
 		meta := f.GetCollectionMeta(UniqueID(10001), "uploads")
@@ -45,14 +43,14 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		iData := genInsertData()
 		dData := &DeleteData{
 			RowCount: 1,
-			Pks:      []int64{888},
+			Pks:      storage.NewInt64PrimaryKeys([]int64{888}),
 			Tss:      []uint64{666666},
 		}
 
 		p, err := b.upload(context.TODO(), 1, 10, []*InsertData{iData}, dData, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 11, len(p.inPaths))
-		assert.Equal(t, 3, len(p.statsPaths))
+		assert.Equal(t, 8, len(p.statsPaths))
 		assert.Equal(t, 1, len(p.inPaths[0].GetBinlogs()))
 		assert.Equal(t, 1, len(p.statsPaths[0].GetBinlogs()))
 		assert.NotNil(t, p.deltaInfo)
@@ -60,7 +58,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		p, err = b.upload(context.TODO(), 1, 10, []*InsertData{iData, iData}, dData, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 11, len(p.inPaths))
-		assert.Equal(t, 3, len(p.statsPaths))
+		assert.Equal(t, 8, len(p.statsPaths))
 		assert.Equal(t, 2, len(p.inPaths[0].GetBinlogs()))
 		assert.Equal(t, 2, len(p.statsPaths[0].GetBinlogs()))
 		assert.NotNil(t, p.deltaInfo)
@@ -77,7 +75,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		f := &MetaFactory{}
 		meta := f.GetCollectionMeta(UniqueID(10001), "uploads")
 		dData := &DeleteData{
-			Pks: []int64{},
+			Pks: []storage.PrimaryKey{},
 			Tss: []uint64{},
 		}
 
@@ -97,7 +95,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 
 		iData = genInsertData()
 		dData = &DeleteData{
-			Pks:      []int64{},
+			Pks:      []storage.PrimaryKey{},
 			Tss:      []uint64{1},
 			RowCount: 1,
 		}
@@ -105,11 +103,11 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		assert.Error(t, err)
 		assert.Empty(t, p)
 
-		mkv := &mockKv{errMultiSave: true}
+		mkv := &mockCm{errMultiSave: true}
 		bin := &binlogIO{mkv, alloc}
 		iData = genInsertData()
 		dData = &DeleteData{
-			Pks:      []int64{1},
+			Pks:      storage.NewInt64PrimaryKeys([]int64{1}),
 			Tss:      []uint64{1},
 			RowCount: 1,
 		}
@@ -138,7 +136,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 				if test.isvalid {
 					inkeys := []string{}
 					for _, k := range test.ks {
-						blob, key, err := prepareBlob(kv, k)
+						blob, key, err := prepareBlob(cm, k)
 						require.NoError(t, err)
 						assert.NotEmpty(t, blob)
 						inkeys = append(inkeys, key)
@@ -163,7 +161,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 	})
 
 	t.Run("Test download twice", func(t *testing.T) {
-		mkv := &mockKv{errMultiLoad: true}
+		mkv := &mockCm{errMultiLoad: true}
 		b := &binlogIO{mkv, alloc}
 
 		ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*20)
@@ -174,11 +172,11 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 	})
 }
 
-func prepareBlob(kv kv.BaseKV, key string) ([]byte, string, error) {
+func prepareBlob(cm storage.ChunkManager, key string) ([]byte, string, error) {
 	k := path.Join("test_prepare_blob", key)
 	blob := []byte{1, 2, 3, 255, 188}
 
-	err := kv.Save(k, string(blob[:]))
+	err := cm.Write(k, blob)
 	if err != nil {
 		return nil, "", err
 	}
@@ -188,7 +186,7 @@ func prepareBlob(kv kv.BaseKV, key string) ([]byte, string, error) {
 func TestBinlogIOInnerMethods(t *testing.T) {
 	alloc := NewAllocatorFactory()
 	b := &binlogIO{
-		memkv.NewMemoryKV(),
+		newTestChunkManager(t),
 		alloc,
 	}
 
@@ -211,7 +209,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				if test.isvalid {
 
 					k, v, err := b.genDeltaBlobs(&DeleteData{
-						Pks: []int64{test.deletepk},
+						Pks: storage.NewInt64PrimaryKeys([]int64{test.deletepk}),
 						Tss: []uint64{test.ts},
 					}, meta.GetID(), 10, 1)
 
@@ -226,7 +224,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 	})
 
 	t.Run("Test genDeltaBlobs error", func(t *testing.T) {
-		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: []int64{1}, Tss: []uint64{}}, 1, 1, 1)
+		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: storage.NewInt64PrimaryKeys([]int64{1}), Tss: []uint64{}}, 1, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
@@ -234,8 +232,8 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		errAlloc := NewAllocatorFactory()
 		errAlloc.isvalid = false
 
-		bin := binlogIO{memkv.NewMemoryKV(), errAlloc}
-		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: []int64{1}, Tss: []uint64{1}}, 1, 1, 1)
+		bin := binlogIO{newTestChunkManager(t), errAlloc}
+		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: storage.NewInt64PrimaryKeys([]int64{1}), Tss: []uint64{1}}, 1, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
@@ -248,9 +246,13 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		kvs, pin, pstats, err := b.genInsertBlobs(genInsertData(), 10, 1, meta)
 
 		assert.NoError(t, err)
-		assert.Equal(t, 3, len(pstats))
+		assert.Equal(t, 8, len(pstats))
 		assert.Equal(t, 11, len(pin))
-		assert.Equal(t, 14, len(kvs))
+		assert.Equal(t, 19, len(kvs))
+		for _, fieldBinlog := range pin {
+			assert.Equal(t, Timestamp(3), fieldBinlog.GetBinlogs()[0].GetTimestampFrom())
+			assert.Equal(t, Timestamp(4), fieldBinlog.GetBinlogs()[0].GetTimestampTo())
+		}
 
 		log.Debug("test paths",
 			zap.Any("kvs no.", len(kvs)),
@@ -276,7 +278,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 
 		errAlloc := NewAllocatorFactory()
 		errAlloc.errAllocBatch = true
-		bin := &binlogIO{memkv.NewMemoryKV(), errAlloc}
+		bin := &binlogIO{newTestChunkManager(t), errAlloc}
 		kvs, pin, pstats, err = bin.genInsertBlobs(genInsertData(), 10, 1, meta)
 
 		assert.Error(t, err)
@@ -332,36 +334,28 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				}
 			})
 		}
-
-		b.close()
 	})
 
 }
 
-type mockKv struct {
+type mockCm struct {
+	storage.ChunkManager
 	errMultiLoad bool
 	errMultiSave bool
 }
 
-var _ kv.BaseKV = (*mockKv)(nil)
+var _ storage.ChunkManager = (*mockCm)(nil)
 
-func (mk *mockKv) Load(key string) (string, error) { return "", nil }
-func (mk *mockKv) MultiLoad(keys []string) ([]string, error) {
+func (mk *mockCm) MultiRead(keys []string) ([][]byte, error) {
 	if mk.errMultiLoad {
-		return []string{}, errors.New("mockKv multiload error")
+		return nil, errors.New("mockCm multiread error")
 	}
-	return []string{"a"}, nil
+	return [][]byte{[]byte("a")}, nil
 }
 
-func (mk *mockKv) LoadWithPrefix(key string) ([]string, []string, error) { return nil, nil, nil }
-func (mk *mockKv) Save(key, value string) error                          { return nil }
-func (mk *mockKv) MultiSave(kvs map[string]string) error {
+func (mk *mockCm) MultiWrite(contents map[string][]byte) error {
 	if mk.errMultiSave {
-		return errors.New("mockKv multisave error")
+		return errors.New("mockCm multiwrite error")
 	}
 	return nil
 }
-func (mk *mockKv) Remove(key string) error           { return nil }
-func (mk *mockKv) MultiRemove(keys []string) error   { return nil }
-func (mk *mockKv) RemoveWithPrefix(key string) error { return nil }
-func (mk *mockKv) Close()                            {}
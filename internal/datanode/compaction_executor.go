@@ -19,8 +19,10 @@ package datanode
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"go.uber.org/zap"
 )
 
@@ -79,12 +81,16 @@ func (c *compactionExecutor) executeTask(task compactor) {
 	c.executing.Store(task.getPlanID(), task)
 	log.Info("start to execute compaction", zap.Int64("planID", task.getPlanID()))
 
+	start := time.Now()
 	err := task.compact()
 	if err != nil {
 		log.Warn("compaction task failed",
 			zap.Int64("planID", task.getPlanID()),
 			zap.Error(err),
 		)
+		metrics.DataNodeCompactionLatency.WithLabelValues(metrics.FailLabel).Observe(float64(time.Since(start).Milliseconds()))
+	} else {
+		metrics.DataNodeCompactionLatency.WithLabelValues(metrics.SuccessLabel).Observe(float64(time.Since(start).Milliseconds()))
 	}
 
 	c.executing.Delete(task.getPlanID())
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -114,15 +116,17 @@ func (t *compactionTask) getChannelName() string {
 	return t.plan.GetChannel()
 }
 
-func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[UniqueID]Timestamp, *DelDataBuf, error) {
+// mergeDeltalogs returns the delete timestamps of the pks to compact in insert logs, keyed by the raw pk value,
+// and the remaining delete data which is newer than timetravelTs.
+func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[interface{}]Timestamp, *DelDataBuf, error) {
 
 	dCodec := storage.NewDeleteCodec()
 
 	var (
-		pk2ts = make(map[UniqueID]Timestamp)
+		pk2ts = make(map[interface{}]Timestamp)
 		dbuff = &DelDataBuf{
 			delData: &DeleteData{
-				Pks: make([]UniqueID, 0),
+				Pks: make([]storage.PrimaryKey, 0),
 				Tss: make([]Timestamp, 0)},
 			Binlog: datapb.Binlog{
 				TimestampFrom: math.MaxUint64,
@@ -143,7 +147,7 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 			ts := dData.Tss[i]
 
 			if timetravelTs != Timestamp(0) && dData.Tss[i] <= timetravelTs {
-				pk2ts[pk] = ts
+				pk2ts[pk.GetValue()] = ts
 				continue
 			}
 
@@ -166,35 +170,30 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 	return pk2ts, dbuff, nil
 }
 
-func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+	fID2Content, expired, err := t.mergeRows(mergeItr, delta)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	var (
-		dim int // dimension of vector field
-		num int // numOfRows in each binlog
-		n   int // binlog number
-		err error
+	iDatas, numRows, err := buildInsertDatas(fID2Content, schema)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		iDatas      = make([]*InsertData, 0)
-		fID2Type    = make(map[UniqueID]schemapb.DataType)
+	log.Debug("merge end", zap.Int64("planID", t.getPlanID()), zap.Int64("remaining insert numRows", numRows),
+		zap.Int64("expired entities", expired))
+	return iDatas, numRows, nil
+}
+
+// mergeRows returns the values of the rows which are neither deleted nor expired by field ID,
+// and the number of expired rows
+func (t *compactionTask) mergeRows(mergeItr iterator, delta map[interface{}]Timestamp) (map[UniqueID][]interface{}, int64, error) {
+	var (
 		fID2Content = make(map[UniqueID][]interface{})
-	)
 
-	// get dim
-	for _, fs := range schema.GetFields() {
-		fID2Type[fs.GetFieldID()] = fs.GetDataType()
-		if fs.GetDataType() == schemapb.DataType_FloatVector ||
-			fs.GetDataType() == schemapb.DataType_BinaryVector {
-			for _, t := range fs.GetTypeParams() {
-				if t.Key == "dim" {
-					if dim, err = strconv.Atoi(t.Value); err != nil {
-						log.Warn("strconv wrong on get dim", zap.Error(err))
-						return nil, 0, err
-					}
-					break
-				}
-			}
-		}
-	}
+		expired int64 // the number of expired entities
+	)
 
 	for mergeItr.HasNext() {
 		//  no error if HasNext() returns true
@@ -206,7 +205,15 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 			return nil, 0, errors.New("unexpected error")
 		}
 
-		if _, ok := delta[v.PK]; ok {
+		// a delete only removes the entities inserted before it, the ones inserted at the same
+		// timestamp by an upsert are the replacements and must be kept
+		if ts, ok := delta[v.PK.GetValue()]; ok && Timestamp(v.Timestamp) < ts {
+			continue
+		}
+
+		// the expired entities are dropped physically
+		if isExpiredEntity(t.plan.GetCollectionTtl(), t.plan.GetStartTime(), Timestamp(v.Timestamp)) {
+			expired++
 			continue
 		}
 
@@ -223,6 +230,37 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 			fID2Content[fID] = append(fID2Content[fID], vInter)
 		}
 	}
+	return fID2Content, expired, nil
+}
+
+// buildInsertDatas splits the rows into insert datas of the flush buffer size
+func buildInsertDatas(fID2Content map[UniqueID][]interface{}, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+	var (
+		dim int // dimension of vector field
+		num int // numOfRows in each binlog
+		n   int // binlog number
+		err error
+
+		iDatas   = make([]*InsertData, 0)
+		fID2Type = make(map[UniqueID]schemapb.DataType)
+	)
+
+	// get dim
+	for _, fs := range schema.GetFields() {
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if fs.GetDataType() == schemapb.DataType_FloatVector ||
+			fs.GetDataType() == schemapb.DataType_BinaryVector {
+			for _, t := range fs.GetTypeParams() {
+				if t.Key == "dim" {
+					if dim, err = strconv.Atoi(t.Value); err != nil {
+						log.Warn("strconv wrong on get dim", zap.Error(err))
+						return nil, 0, err
+					}
+					break
+				}
+			}
+		}
+	}
 
 	// calculate numRows from rowID field, fieldID 0
 	numRows := int64(len(fID2Content[0]))
@@ -260,10 +298,19 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 
 	}
 
-	log.Debug("merge end", zap.Int64("planID", t.getPlanID()), zap.Int64("remaining insert numRows", numRows))
 	return iDatas, numRows, nil
 }
 
+// isExpiredEntity checks whether the entity inserted at ts is expired at now, ttl is in nanoseconds and 0 means never expire
+func isExpiredEntity(ttl int64, now, ts Timestamp) bool {
+	if ttl <= 0 {
+		return false
+	}
+	pts, _ := tsoutil.ParseTS(ts)
+	pnow, _ := tsoutil.ParseTS(now)
+	return pts.Add(time.Duration(ttl)).Before(pnow)
+}
+
 func (t *compactionTask) compact() error {
 	t.wg.Add(1)
 	defer t.wg.Done()
@@ -282,7 +329,13 @@ func (t *compactionTask) compact() error {
 		log.Error("compact wrong, there's no segments in segment binlogs")
 		return errIllegalCompactionPlan
 
-	case t.plan.GetType() == datapb.CompactionType_MergeCompaction:
+	case t.plan.GetType() == datapb.CompactionType_ClusteringCompaction &&
+		(t.plan.GetClusteringKeyFieldID() <= 0 || t.plan.GetMaxSegmentRows() <= 0):
+		log.Error("compact wrong, clustering compaction without clustering key or max segment rows")
+		return errIllegalCompactionPlan
+
+	case t.plan.GetType() == datapb.CompactionType_MergeCompaction,
+		t.plan.GetType() == datapb.CompactionType_ClusteringCompaction:
 		targetSegID, err = t.allocID()
 		if err != nil {
 			log.Error("compact wrong", zap.Error(err))
@@ -327,7 +380,7 @@ func (t *compactionTask) compact() error {
 
 	// Get PK fieldID
 	for _, fs := range meta.GetSchema().GetFields() {
-		if fs.GetFieldID() >= 100 && fs.GetDataType() == schemapb.DataType_Int64 && fs.GetIsPrimaryKey() {
+		if fs.GetFieldID() >= 100 && fs.GetIsPrimaryKey() {
 			PKfieldID = fs.GetFieldID()
 			break
 		}
@@ -352,7 +405,8 @@ func (t *compactionTask) compact() error {
 					return err
 				}
 
-				itr, err := storage.NewInsertBinlogIterator(bs, PKfieldID)
+				// segments compacted together may be written before and after a field was added
+				itr, err := storage.NewInsertBinlogIteratorWithSchema(bs, PKfieldID, meta)
 				if err != nil {
 					log.Warn("new insert binlogs Itr wrong")
 					return err
@@ -399,6 +453,15 @@ func (t *compactionTask) compact() error {
 		return err
 	}
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		// the flush packs of the compacted segments are redirected to the first clustered segment
+		if err := t.clusteringCompact(ctxTimeout, mergeItr, deltaPk2Ts, deltaBuf, targetSegID, collID, partID, segIDs, meta, PKfieldID); err != nil {
+			return err
+		}
+		ti.injectDone(true)
+		return nil
+	}
+
 	iDatas, numRows, err := t.merge(mergeItr, deltaPk2Ts, meta.GetSchema())
 	if err != nil {
 		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
@@ -457,6 +520,230 @@ func (t *compactionTask) compact() error {
 	return nil
 }
 
+// clusteringCompact sorts the remaining rows by the clustering key and splits them into segments of at most
+// MaxSegmentRows rows with disjoint key ranges, the rows of the same key are never split. The first segment
+// takes firstSegID.
+func (t *compactionTask) clusteringCompact(ctx context.Context, mergeItr iterator, delta map[interface{}]Timestamp,
+	deltaBuf *DelDataBuf, firstSegID, collID, partID UniqueID, segIDs []UniqueID, meta *etcdpb.CollectionMeta, pkFieldID UniqueID) error {
+
+	keyFieldID := t.plan.GetClusteringKeyFieldID()
+	fID2Content, expired, err := t.mergeRows(mergeItr, delta)
+	if err != nil {
+		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+		return err
+	}
+
+	var keyType schemapb.DataType
+	for _, fs := range meta.GetSchema().GetFields() {
+		if fs.GetFieldID() == keyFieldID {
+			keyType = fs.GetDataType()
+			break
+		}
+	}
+
+	// the column of a field added after the rows were written is filled with defaults by the iterator
+	keys := fID2Content[keyFieldID]
+	clusters := splitClusters(keys, t.plan.GetMaxSegmentRows())
+
+	var (
+		segments   = make([]*datapb.CompactionSegment, 0, len(clusters))
+		segNumRows = make(map[UniqueID]int64, len(clusters))
+	)
+	for i, rows := range clusters {
+		segID := firstSegID
+		if i > 0 {
+			if segID, err = t.allocID(); err != nil {
+				log.Error("compact wrong", zap.Error(err))
+				return err
+			}
+		}
+
+		content := make(map[UniqueID][]interface{}, len(fID2Content))
+		for fID, values := range fID2Content {
+			c := make([]interface{}, 0, len(rows))
+			for _, row := range rows {
+				c = append(c, values[row])
+			}
+			content[fID] = c
+		}
+		iDatas, numRows, err := buildInsertDatas(content, meta.GetSchema())
+		if err != nil {
+			log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return err
+		}
+
+		// only the deletes of the pks in the segment are kept with it
+		segDeltaBuf := filterDelDataBuf(deltaBuf, content[pkFieldID])
+		cpaths, err := t.upload(ctx, segID, partID, iDatas, segDeltaBuf.delData, meta)
+		if err != nil {
+			log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return err
+		}
+		for _, fbl := range cpaths.deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.TimestampFrom = segDeltaBuf.GetTimestampFrom()
+				deltaLogInfo.TimestampTo = segDeltaBuf.GetTimestampTo()
+				deltaLogInfo.EntriesNum = segDeltaBuf.GetEntriesNum()
+			}
+		}
+
+		segments = append(segments, &datapb.CompactionSegment{
+			SegmentID:           segID,
+			NumOfRows:           numRows,
+			InsertLogs:          cpaths.inPaths,
+			Field2StatslogPaths: cpaths.statsPaths,
+			Deltalogs:           cpaths.deltaInfo,
+			ClusteringKeyRange:  clusteringKeyRange(keyFieldID, keyType, content[keyFieldID]),
+		})
+		segNumRows[segID] = numRows
+	}
+
+	pack := &datapb.CompactionResult{
+		PlanID:   t.plan.GetPlanID(),
+		Segments: segments,
+	}
+	status, err := t.dc.CompleteCompaction(ctx, pack)
+	if err != nil {
+		log.Error("complete compaction rpc wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("complete compaction wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.String("reason", status.GetReason()))
+		return fmt.Errorf("complete comapction wrong: %s", status.GetReason())
+	}
+
+	t.splitFlushedSegments(segNumRows, collID, partID, segIDs, t.plan.GetChannel())
+	log.Info("clustering compaction done", zap.Int64("planID", t.plan.GetPlanID()),
+		zap.Int("num of segments", len(segments)),
+		zap.Int64("expired entities", expired),
+	)
+	return nil
+}
+
+// splitClusters returns the row indices of each segment, sorted by the key values. The rows are split
+// into the fewest segments of at most maxRows rows and of about the same size, except that the rows of
+// the same key are kept in one segment. There is a single empty segment if there is no row.
+func splitClusters(keys []interface{}, maxRows int64) [][]int {
+	rows := make([]int, len(keys))
+	for i := range rows {
+		rows[i] = i
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compareClusteringKey(keys[rows[i]], keys[rows[j]]) < 0
+	})
+
+	n := (int64(len(rows)) + maxRows - 1) / maxRows
+	if n == 0 {
+		return [][]int{rows}
+	}
+	size := (int64(len(rows)) + n - 1) / n
+
+	clusters := make([][]int, 0, n)
+	start := 0
+	for i := 1; i < len(rows); i++ {
+		if int64(i-start) >= size && compareClusteringKey(keys[rows[i-1]], keys[rows[i]]) != 0 {
+			clusters = append(clusters, rows[start:i])
+			start = i
+		}
+	}
+	return append(clusters, rows[start:])
+}
+
+// compareClusteringKey compares two values of a clustering key, values of other types are equal
+func compareClusteringKey(a, b interface{}) int {
+	cmp := func(less, greater bool) int {
+		switch {
+		case less:
+			return -1
+		case greater:
+			return 1
+		default:
+			return 0
+		}
+	}
+	switch av := a.(type) {
+	case int8:
+		bv, _ := b.(int8)
+		return cmp(av < bv, av > bv)
+	case int16:
+		bv, _ := b.(int16)
+		return cmp(av < bv, av > bv)
+	case int32:
+		bv, _ := b.(int32)
+		return cmp(av < bv, av > bv)
+	case int64:
+		bv, _ := b.(int64)
+		return cmp(av < bv, av > bv)
+	case float32:
+		bv, _ := b.(float32)
+		return cmp(av < bv, av > bv)
+	case float64:
+		bv, _ := b.(float64)
+		return cmp(av < bv, av > bv)
+	case string:
+		bv, _ := b.(string)
+		return cmp(av < bv, av > bv)
+	default:
+		return 0
+	}
+}
+
+// clusteringKeyRange returns the range of the sorted key values of a segment, nil if there is no value
+func clusteringKeyRange(fieldID UniqueID, dataType schemapb.DataType, keys []interface{}) *datapb.FieldRange {
+	if len(keys) == 0 {
+		return nil
+	}
+	toValueField := func(v interface{}) *schemapb.ValueField {
+		switch dataType {
+		case schemapb.DataType_Int8:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: int64(v.(int8))}}
+		case schemapb.DataType_Int16:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: int64(v.(int16))}}
+		case schemapb.DataType_Int32:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: int64(v.(int32))}}
+		case schemapb.DataType_Int64:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: v.(int64)}}
+		case schemapb.DataType_Float:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: float64(v.(float32))}}
+		case schemapb.DataType_Double:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: v.(float64)}}
+		case schemapb.DataType_String:
+			return &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: v.(string)}}
+		default:
+			return nil
+		}
+	}
+	min, max := toValueField(keys[0]), toValueField(keys[len(keys)-1])
+	if min == nil || max == nil {
+		return nil
+	}
+	return &datapb.FieldRange{
+		FieldID: fieldID,
+		Min:     min,
+		Max:     max,
+	}
+}
+
+// filterDelDataBuf returns the deletes of buf whose pks are in pks
+func filterDelDataBuf(buf *DelDataBuf, pks []interface{}) *DelDataBuf {
+	pkSet := make(map[interface{}]struct{}, len(pks))
+	for _, pk := range pks {
+		pkSet[pk] = struct{}{}
+	}
+
+	filtered := newDelDataBuf()
+	for i, pk := range buf.delData.Pks {
+		if _, ok := pkSet[pk.GetValue()]; !ok {
+			continue
+		}
+		ts := buf.delData.Tss[i]
+		filtered.delData.Append(pk, ts)
+		filtered.updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+	}
+	filtered.updateSize(filtered.delData.RowCount)
+	return filtered
+}
+
 // TODO copy maybe expensive, but this seems to be the only convinent way.
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
@@ -567,6 +854,36 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		}
 		rst = data
 
+	case schemapb.DataType_String:
+		var data = &storage.StringFieldData{
+			NumRows: numOfRows,
+			Data:    make([]string, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(string)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case schemapb.DataType_JSON:
+		var data = &storage.JSONFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
 	case schemapb.DataType_FloatVector:
 		var data = &storage.FloatVectorFieldData{
 			NumRows: numOfRows,
@@ -21,12 +21,13 @@ import (
 	"testing"
 	"time"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -34,7 +35,7 @@ import (
 func TestCompactionTaskInnerMethods(t *testing.T) {
 	t.Run("Test getSegmentMeta", func(t *testing.T) {
 		rc := &RootCoordFactory{}
-		replica, err := newReplica(context.TODO(), rc, 1)
+		replica, err := newReplica(context.TODO(), rc, newTestChunkManager(t), 1)
 		require.NoError(t, err)
 
 		task := &compactionTask{
@@ -75,6 +76,8 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{true, schemapb.DataType_Int64, []interface{}{int64(1), int64(2)}, "valid int64"},
 			{true, schemapb.DataType_Float, []interface{}{float32(1), float32(2)}, "valid float32"},
 			{true, schemapb.DataType_Double, []interface{}{float64(1), float64(2)}, "valid float64"},
+			{true, schemapb.DataType_String, []interface{}{"a", "b"}, "valid string"},
+			{true, schemapb.DataType_JSON, []interface{}{[]byte(`{"a":1}`), []byte(`{"b":2}`)}, "valid json"},
 			{true, schemapb.DataType_FloatVector, []interface{}{[]float32{1.0, 2.0}}, "valid floatvector"},
 			{true, schemapb.DataType_BinaryVector, []interface{}{[]byte{255}}, "valid binaryvector"},
 			{false, schemapb.DataType_Bool, []interface{}{1, 2}, "invalid bool"},
@@ -84,9 +87,11 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{false, schemapb.DataType_Int64, []interface{}{nil, nil}, "invalid int64"},
 			{false, schemapb.DataType_Float, []interface{}{nil, nil}, "invalid float32"},
 			{false, schemapb.DataType_Double, []interface{}{nil, nil}, "invalid float64"},
+			{false, schemapb.DataType_String, []interface{}{nil, nil}, "invalid string"},
+			{false, schemapb.DataType_JSON, []interface{}{nil, nil}, "invalid json"},
 			{false, schemapb.DataType_FloatVector, []interface{}{nil, nil}, "invalid floatvector"},
 			{false, schemapb.DataType_BinaryVector, []interface{}{nil, nil}, "invalid binaryvector"},
-			{false, schemapb.DataType_String, nil, "invalid data type"},
+			{false, schemapb.DataType_None, nil, "invalid data type"},
 		}
 
 		for _, test := range tests {
@@ -156,7 +161,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 						assert.Equal(t, 3, len(pk2ts))
 						assert.Equal(t, int64(3), db.GetEntriesNum())
 						assert.Equal(t, int64(3), db.delData.RowCount)
-						assert.ElementsMatch(t, []UniqueID{1, 4, 5}, db.delData.Pks)
+						assert.ElementsMatch(t, storage.NewInt64PrimaryKeys([]UniqueID{1, 4, 5}), db.delData.Pks)
 						assert.ElementsMatch(t, []Timestamp{30000, 50000, 50000}, db.delData.Tss)
 
 					} else {
@@ -246,8 +251,8 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 
 		mitr := storage.NewMergeIterator([]iterator{iitr})
 
-		dm := map[UniqueID]Timestamp{
-			1: 10000,
+		dm := map[interface{}]Timestamp{
+			int64(1): 10000,
 		}
 
 		ct := &compactionTask{}
@@ -257,11 +262,125 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		assert.Equal(t, 1, len(idata))
 
 	})
+
+	t.Run("Test merge keeps rows upserted at delete timestamp", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// pk 1 is inserted at ts 3, pk 2 at ts 4
+		dm := map[interface{}]Timestamp{
+			int64(1): 3,
+			int64(2): 5,
+		}
+
+		ct := &compactionTask{}
+		idata, numOfRow, err := ct.merge(mitr, dm, meta.GetSchema())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), numOfRow)
+		assert.Equal(t, 1, len(idata))
+		assert.Equal(t, []int64{1}, idata[0].Data[106].(*storage.Int64FieldData).Data)
+	})
+
+	t.Run("Test merge drops expired entities", func(t *testing.T) {
+		now := time.Now()
+		iData := genInsertData()
+		// pk 1 is inserted 2 hours ago, pk 2 just now
+		iData.Data[common.TimeStampField].(*storage.Int64FieldData).Data = []int64{
+			int64(tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)),
+			int64(tsoutil.ComposeTSByTime(now, 0)),
+		}
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		ct := &compactionTask{
+			plan: &datapb.CompactionPlan{
+				StartTime:     tsoutil.ComposeTSByTime(now, 0),
+				CollectionTtl: int64(time.Hour),
+			},
+		}
+		idata, numOfRow, err := ct.merge(mitr, map[interface{}]Timestamp{}, meta.GetSchema())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), numOfRow)
+		assert.Equal(t, 1, len(idata))
+		assert.Equal(t, []int64{2}, idata[0].Data[106].(*storage.Int64FieldData).Data)
+	})
+}
+
+func TestIsExpiredEntity(t *testing.T) {
+	now := time.Now()
+	nowTs := tsoutil.ComposeTSByTime(now, 0)
+
+	assert.False(t, isExpiredEntity(0, nowTs, tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)))
+	assert.False(t, isExpiredEntity(int64(time.Hour), nowTs, tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)))
+	assert.True(t, isExpiredEntity(int64(time.Hour), nowTs, tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)))
+}
+
+func TestSplitClusters(t *testing.T) {
+	t.Run("no row", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, splitClusters(nil, 10))
+	})
+
+	t.Run("sorted and even", func(t *testing.T) {
+		keys := []interface{}{int64(5), int64(1), int64(4), int64(2), int64(3)}
+		clusters := splitClusters(keys, 4)
+		assert.Equal(t, [][]int{{1, 3, 4}, {2, 0}}, clusters)
+	})
+
+	t.Run("same keys are not split", func(t *testing.T) {
+		keys := []interface{}{"b", "a", "b", "b", "c"}
+		clusters := splitClusters(keys, 2)
+		assert.Equal(t, [][]int{{1, 0, 2, 3}, {4}}, clusters)
+	})
+}
+
+func TestClusteringKeyRange(t *testing.T) {
+	assert.Nil(t, clusteringKeyRange(100, schemapb.DataType_Int32, nil))
+
+	r := clusteringKeyRange(100, schemapb.DataType_Int32, []interface{}{int32(1), int32(3)})
+	assert.Equal(t, int64(100), r.GetFieldID())
+	assert.Equal(t, int64(1), r.GetMin().GetLongData())
+	assert.Equal(t, int64(3), r.GetMax().GetLongData())
+
+	r = clusteringKeyRange(101, schemapb.DataType_Float, []interface{}{float32(1.5)})
+	assert.Equal(t, 1.5, r.GetMin().GetDoubleData())
+	assert.Equal(t, 1.5, r.GetMax().GetDoubleData())
+
+	r = clusteringKeyRange(102, schemapb.DataType_String, []interface{}{"a", "c"})
+	assert.Equal(t, "a", r.GetMin().GetStringData())
+	assert.Equal(t, "c", r.GetMax().GetStringData())
+}
+
+func TestFilterDelDataBuf(t *testing.T) {
+	buf := newDelDataBuf()
+	buf.delData.Append(storage.NewInt64PrimaryKey(1), 10)
+	buf.delData.Append(storage.NewInt64PrimaryKey(2), 20)
+	buf.delData.Append(storage.NewInt64PrimaryKey(3), 30)
+
+	filtered := filterDelDataBuf(buf, []interface{}{int64(2), int64(3), int64(4)})
+	assert.Equal(t, int64(2), filtered.delData.RowCount)
+	assert.Equal(t, int64(2), filtered.GetEntriesNum())
+	assert.Equal(t, Timestamp(20), filtered.GetTimestampFrom())
+	assert.Equal(t, Timestamp(30), filtered.GetTimestampTo())
 }
 
 func getDeltaBlobs(segID UniqueID, pks []UniqueID, tss []Timestamp) ([]*Blob, error) {
 	deltaData := &DeleteData{
-		Pks:      pks,
+		Pks:      storage.NewInt64PrimaryKeys(pks),
 		Tss:      tss,
 		RowCount: int64(len(pks)),
 	}
@@ -319,6 +438,10 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		err = emptyTask.compact()
 		assert.Error(t, err)
 
+		plan.Type = datapb.CompactionType_ClusteringCompaction
+		err = emptyTask.compact()
+		assert.Equal(t, errIllegalCompactionPlan, err)
+
 		emptyTask.stop()
 	})
 
@@ -329,16 +452,16 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		rc := &RootCoordFactory{}
 		dc := &DataCoordFactory{}
 		mockfm := &mockFlushManager{}
-		mockKv := memkv.NewMemoryKV()
-		mockbIO := &binlogIO{mockKv, alloc}
-		replica, err := newReplica(context.TODO(), rc, collID)
+		cm := newTestChunkManager(t)
+		mockbIO := &binlogIO{cm, alloc}
+		replica, err := newReplica(context.TODO(), rc, cm, collID)
 		require.NoError(t, err)
-		replica.addFlushedSegmentWithPKs(segID, collID, partID, "channelname", 2, []UniqueID{1})
+		replica.addFlushedSegmentWithPKs(segID, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{1}))
 
 		iData := genInsertData()
 		meta := NewMetaFactory().GetCollectionMeta(collID, "test_compact_coll_name")
 		dData := &DeleteData{
-			Pks:      []UniqueID{1},
+			Pks:      storage.NewInt64PrimaryKeys([]UniqueID{1}),
 			Tss:      []Timestamp{20000},
 			RowCount: 1,
 		}
@@ -384,10 +507,8 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		planID := task.getPlanID()
 		assert.Equal(t, plan.GetPlanID(), planID)
 
-		// New test, remove all the binlogs in memkv
+		// New test
 		//  Deltas in timetravel range
-		err = mockKv.RemoveWithPrefix("/")
-		require.NoError(t, err)
 		cpaths, err = mockbIO.upload(context.TODO(), segID, partID, []*InsertData{iData}, dData, meta)
 		require.NoError(t, err)
 		plan.PlanID++
@@ -400,10 +521,8 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), updates.GetNumRows())
 
-		// New test, remove all the binlogs in memkv
+		// New test
 		//  Timeout
-		err = mockKv.RemoveWithPrefix("/")
-		require.NoError(t, err)
 		cpaths, err = mockbIO.upload(context.TODO(), segID, partID, []*InsertData{iData}, dData, meta)
 		require.NoError(t, err)
 		plan.PlanID++
@@ -420,26 +539,26 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		rc := &RootCoordFactory{}
 		dc := &DataCoordFactory{}
 		mockfm := &mockFlushManager{}
-		mockKv := memkv.NewMemoryKV()
-		mockbIO := &binlogIO{mockKv, alloc}
-		replica, err := newReplica(context.TODO(), rc, collID)
+		cm := newTestChunkManager(t)
+		mockbIO := &binlogIO{cm, alloc}
+		replica, err := newReplica(context.TODO(), rc, cm, collID)
 		require.NoError(t, err)
 
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{1}))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{9}))
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
 
 		meta := NewMetaFactory().GetCollectionMeta(collID, "test_compact_coll_name")
 		iData1 := genInsertDataWithPKs([2]int64{1, 2})
 		dData1 := &DeleteData{
-			Pks:      []UniqueID{1},
+			Pks:      storage.NewInt64PrimaryKeys([]UniqueID{1}),
 			Tss:      []Timestamp{20000},
 			RowCount: 1,
 		}
 		iData2 := genInsertDataWithPKs([2]int64{9, 10})
 		dData2 := &DeleteData{
-			Pks:      []UniqueID{9},
+			Pks:      storage.NewInt64PrimaryKeys([]UniqueID{9}),
 			Tss:      []Timestamp{30000},
 			RowCount: 1,
 		}
@@ -487,15 +606,13 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), updates.GetNumRows())
 
-		// New test, remove all the binlogs in memkv
+		// New test
 		//  Deltas in timetravel range
-		err = mockKv.RemoveWithPrefix("/")
-		require.NoError(t, err)
 		plan.PlanID++
 
 		plan.Timetravel = Timestamp(25000)
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{1}))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{9}))
 		replica.removeSegments(19530)
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
@@ -511,15 +628,13 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3), updates.GetNumRows())
 
-		// New test, remove all the binlogs in memkv
+		// New test
 		//  Deltas in timetravel range
-		err = mockKv.RemoveWithPrefix("/")
-		require.NoError(t, err)
 		plan.PlanID++
 
 		plan.Timetravel = Timestamp(10000)
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{1}))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, storage.NewInt64PrimaryKeys([]UniqueID{9}))
 		replica.removeSegments(19530)
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
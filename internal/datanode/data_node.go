@@ -36,24 +36,26 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -70,6 +72,9 @@ const (
 
 	// ConnectEtcdMaxRetryTime is used to limit the max retry time for connection etcd
 	ConnectEtcdMaxRetryTime = 1000
+
+	// reportImportRetryTimes is used to limit the retry time for reporting import states to DataCoord
+	reportImportRetryTimes = 10
 )
 
 const illegalRequestErrStr = "Illegal request"
@@ -116,7 +121,8 @@ type DataNode struct {
 
 	session *sessionutil.Session
 	watchKv kv.MetaKv
-	blobKv  kv.BaseKV
+
+	chunkManager storage.ChunkManager
 
 	closer io.Closer
 
@@ -198,7 +204,7 @@ func (node *DataNode) Register() error {
 }
 
 func (node *DataNode) initSession() error {
-	node.session = sessionutil.NewSession(node.ctx, Params.BaseParams.MetaRootPath, node.etcdCli)
+	node.session = metastore.NewSession(node.ctx, node.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if node.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -256,9 +262,9 @@ func (node *DataNode) StartWatchChannels(ctx context.Context) {
 			return
 		case event := <-evtChan:
 			if event.Canceled { // event canceled
-				log.Warn("watch channel canceled", zap.Error(event.Err()))
+				log.Warn("watch channel canceled", zap.Error(event.Err))
 				// https://github.com/etcd-io/etcd/issues/8980
-				if event.Err() == v3rpc.ErrCompacted {
+				if event.Err == kv.ErrCompacted {
 					go node.StartWatchChannels(ctx)
 					return
 				}
@@ -293,12 +299,12 @@ func (node *DataNode) checkWatchedList() error {
 }
 
 // handleChannelEvt handles event from kv watch event
-func (node *DataNode) handleChannelEvt(evt *clientv3.Event) {
+func (node *DataNode) handleChannelEvt(evt *kv.Event) {
 	switch evt.Type {
-	case clientv3.EventTypePut: // datacoord shall put channels needs to be watched here
+	case kv.EventTypePut: // datacoord shall put channels needs to be watched here
 		log.Debug("DataNode handleChannelEvt EventTypePut", zap.String("key", string(evt.Kv.Key)))
 		node.handleWatchInfo(string(evt.Kv.Key), evt.Kv.Value)
-	case clientv3.EventTypeDelete:
+	case kv.EventTypeDelete:
 		// guaranteed there is no "/" in channel name
 		parts := strings.Split(string(evt.Kv.Key), "/")
 		vchanName := parts[len(parts)-1]
@@ -361,7 +367,7 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 	}
 	node.chanMut.RUnlock()
 
-	replica, err := newReplica(node.ctx, node.rootCoord, vchan.CollectionID)
+	replica, err := newReplica(node.ctx, node.rootCoord, node.chunkManager, vchan.CollectionID)
 	if err != nil {
 		return err
 	}
@@ -376,7 +382,7 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 
 	flushCh := make(chan flushMsg, 100)
 
-	dataSyncService, err := newDataSyncService(node.ctx, flushCh, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord, node.segmentCache, node.blobKv, node.compactionExecutor)
+	dataSyncService, err := newDataSyncService(node.ctx, flushCh, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord, node.segmentCache, node.chunkManager, node.compactionExecutor)
 	if err != nil {
 		log.Error("DataNode NewDataSyncService newDataSyncService failed",
 			zap.Error(err),
@@ -451,8 +457,11 @@ func (node *DataNode) Start() error {
 	}
 
 	connectEtcdFn := func() error {
-		etcdKV := etcdkv.NewEtcdKV(node.etcdCli, Params.BaseParams.MetaRootPath)
-		node.watchKv = etcdKV
+		metaKV, err := metastore.NewMetaKV(node.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
+		if err != nil {
+			return err
+		}
+		node.watchKv = metaKV
 		return nil
 	}
 	err = retry.Do(node.ctx, connectEtcdFn, retry.Attempts(ConnectEtcdMaxRetryTime))
@@ -460,21 +469,11 @@ func (node *DataNode) Start() error {
 		return errors.New("DataNode fail to connect etcd")
 	}
 
-	option := &miniokv.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	kv, err := miniokv.NewMinIOKV(node.ctx, option)
+	chunkManager, err := storage.NewChunkManager(node.ctx, &Params)
 	if err != nil {
 		return err
 	}
-
-	node.blobKv = kv
+	node.chunkManager = chunkManager
 
 	if rep.Status.ErrorCode != commonpb.ErrorCode_Success || err != nil {
 		return errors.New("DataNode fail to start")
@@ -809,7 +808,7 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		return status, nil
 	}
 
-	binlogIO := &binlogIO{node.blobKv, ds.idAllocator}
+	binlogIO := &binlogIO{node.chunkManager, ds.idAllocator}
 	task := newCompactionTask(
 		node.ctx,
 		binlogIO, binlogIO,
@@ -826,3 +825,184 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+// Import parses the files of an import task and writes the rows into sealed segments,
+// returns status as long as the task is accepted, the progress of the task is reported to DataCoord
+func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
+	task := req.GetImportTask()
+	log.Info("DataNode receive import request",
+		zap.Int64("taskID", task.GetTaskId()),
+		zap.Int64("collectionID", task.GetCollectionId()),
+		zap.Int64("partitionID", task.GetPartitionId()),
+		zap.Strings("files", task.GetFiles()),
+		zap.Bool("rowBased", task.GetRowBased()))
+
+	if !node.isHealthy() {
+		log.Warn("DataNode.Import failed",
+			zap.Int64("taskID", task.GetTaskId()),
+			zap.Error(errDataNodeIsUnhealthy(Params.DataNodeCfg.NodeID)))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataNodeIsUnhealthy(Params.DataNodeCfg.NodeID),
+		}, nil
+	}
+
+	go node.executeImport(task)
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// executeImport imports the files of the task and reports the final state to DataCoord,
+// the segments are dropped by DataCoord if the task fails.
+func (node *DataNode) executeImport(task *datapb.ImportTask) {
+	result := &datapb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     task.GetTaskId(),
+		DatanodeId: node.NodeID,
+		State:      commonpb.ImportState_ImportStarted,
+	}
+	node.reportImport(result)
+
+	if err := node.importFiles(task, result); err != nil {
+		log.Warn("import task failed", zap.Int64("taskID", task.GetTaskId()), zap.Error(err))
+		result.State = commonpb.ImportState_ImportFailed
+		result.Infos = append(result.Infos, &commonpb.KeyValuePair{
+			Key:   common.ImportFailedReasonKey,
+			Value: err.Error(),
+		})
+	} else {
+		log.Info("import task completed", zap.Int64("taskID", task.GetTaskId()),
+			zap.Int64("rowCount", result.GetRowCount()), zap.Int64s("segments", result.GetSegments()))
+		result.State = commonpb.ImportState_ImportCompleted
+	}
+	node.reportImport(result)
+}
+
+// importFiles parses the files into blocks of rows, every block is written into a new sealed segment
+// of the shard its rows belong to.
+func (node *DataNode) importFiles(task *datapb.ImportTask, result *datapb.ImportResult) error {
+	collID := task.GetCollectionId()
+	if len(task.GetChannelNames()) == 0 {
+		return fmt.Errorf("collection %d has no channel to import into", collID)
+	}
+
+	schema, err := newMetaService(node.rootCoord, collID).getCollectionSchema(node.ctx, collID, 0)
+	if err != nil {
+		return err
+	}
+
+	alloc := newAllocator(node.rootCoord)
+	bio := &binlogIO{node.chunkManager, alloc}
+	idAlloc := func(count uint32) (int64, error) {
+		start, _, err := alloc.allocIDBatch(count)
+		return start, err
+	}
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		segmentID, err := node.saveImportSegment(task, schema, bio, fields, task.GetChannelNames()[shardID])
+		if err != nil {
+			return err
+		}
+		result.Segments = append(result.Segments, segmentID)
+		result.RowCount += int64(fields[common.TimeStampField].RowNum())
+		node.reportImport(result)
+		return nil
+	}
+
+	segmentSize := int64(Params.DataCoordCfg.SegmentMaxSize * 1024 * 1024)
+	wrapper := importutil.NewImportWrapper(node.ctx, schema, int32(len(task.GetChannelNames())), segmentSize,
+		task.GetTimestamp(), idAlloc, node.chunkManager, flushFunc)
+	return wrapper.Import(task.GetFiles(), task.GetRowBased())
+}
+
+// saveImportSegment writes the rows into binlogs of a new segment and saves the binlog paths to DataCoord
+func (node *DataNode) saveImportSegment(task *datapb.ImportTask, schema *schemapb.CollectionSchema, bio *binlogIO,
+	fields map[storage.FieldID]storage.FieldData, channelName string) (UniqueID, error) {
+	rowNum := fields[common.TimeStampField].RowNum()
+	resp, err := node.dataCoord.AssignSegmentID(node.ctx, &datapb.AssignSegmentIDRequest{
+		NodeID:   node.NodeID,
+		PeerRole: typeutil.DataNodeRole,
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				CollectionID: task.GetCollectionId(),
+				PartitionID:  task.GetPartitionId(),
+				ChannelName:  channelName,
+				Count:        uint32(rowNum),
+				IsImport:     true,
+				ImportTaskId: task.GetTaskId(),
+			},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.GetStatus().GetReason())
+	}
+	if len(resp.GetSegIDAssignments()) == 0 {
+		return 0, errors.New("no segment assigned for import")
+	}
+	assignment := resp.GetSegIDAssignments()[0]
+	if assignment.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, errors.New(assignment.GetStatus().GetReason())
+	}
+	segmentID := assignment.GetSegID()
+
+	meta := &etcdpb.CollectionMeta{
+		ID:     task.GetCollectionId(),
+		Schema: schema,
+	}
+	paths, err := bio.upload(node.ctx, segmentID, task.GetPartitionId(), []*InsertData{{Data: fields}}, &DeleteData{}, meta)
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.dataCoord.SaveBinlogPaths(node.ctx, &datapb.SaveBinlogPathsRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: node.NodeID,
+		},
+		SegmentID:           segmentID,
+		CollectionID:        task.GetCollectionId(),
+		Field2BinlogPaths:   paths.inPaths,
+		Field2StatslogPaths: paths.statsPaths,
+		CheckPoints: []*datapb.CheckPoint{
+			{
+				SegmentID: segmentID,
+				NumOfRows: int64(rowNum),
+			},
+		},
+		// the segment stays importing and invisible until DataCoord receives the completion of the task
+		Importing: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return 0, errors.New(status.GetReason())
+	}
+	log.Info("import segment saved", zap.Int64("taskID", task.GetTaskId()),
+		zap.Int64("segmentID", segmentID), zap.String("channel", channelName), zap.Int("rowNum", rowNum))
+	return segmentID, nil
+}
+
+// reportImport reports the state of an import task to DataCoord, retries on failures
+func (node *DataNode) reportImport(result *datapb.ImportResult) {
+	err := retry.Do(node.ctx, func() error {
+		status, err := node.dataCoord.ReportImport(node.ctx, result)
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("DataCoord ReportImport failed, reason = %s", status.GetReason())
+		}
+		return nil
+	}, retry.Attempts(reportImportRetryTimes))
+	if err != nil {
+		log.Warn("failed to report import state", zap.Int64("taskID", result.GetTaskId()),
+			zap.String("state", result.GetState().String()), zap.Error(err))
+	}
+}
@@ -363,6 +363,44 @@ func TestDataNode(t *testing.T) {
 			zap.String("response", resp.Response))
 	})
 
+	t.Run("Test Import", func(t *testing.T) {
+		emptyNode := &DataNode{}
+		emptyNode.UpdateStateCode(internalpb.StateCode_Abnormal)
+		status, err := emptyNode.Import(ctx, &datapb.ImportTaskRequest{ImportTask: &datapb.ImportTask{}})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		ds := &DataCoordFactory{}
+		node.dataCoord = ds
+		defer func() { node.dataCoord = &DataCoordFactory{} }()
+
+		// files that don't exist fail the task
+		node.executeImport(&datapb.ImportTask{
+			CollectionId: 1,
+			ChannelNames: []string{"import-channel"},
+			TaskId:       1,
+			Files:        []string{"not_exist.json"},
+			RowBased:     true,
+		})
+		assert.Equal(t, 2, len(ds.ImportResults))
+		assert.Equal(t, commonpb.ImportState_ImportStarted, ds.ImportResults[0].GetState())
+		result := ds.ImportResults[1]
+		assert.Equal(t, commonpb.ImportState_ImportFailed, result.GetState())
+		assert.EqualValues(t, 1, result.GetTaskId())
+		assert.Equal(t, common.ImportFailedReasonKey, result.GetInfos()[0].GetKey())
+
+		// a collection without channels can't be imported
+		ds.ImportResults = nil
+		node.executeImport(&datapb.ImportTask{
+			CollectionId: 1,
+			TaskId:       2,
+			Files:        []string{"rows.json"},
+			RowBased:     true,
+		})
+		assert.Equal(t, 2, len(ds.ImportResults))
+		assert.Equal(t, commonpb.ImportState_ImportFailed, ds.ImportResults[1].GetState())
+	})
+
 	t.Run("Test BackGroundGC", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		node := newIDLEDataNodeMock(ctx)
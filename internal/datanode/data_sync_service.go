@@ -20,10 +20,10 @@ import (
 	"context"
 	"errors"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"go.uber.org/zap"
@@ -45,7 +45,7 @@ type dataSyncService struct {
 
 	flushingSegCache *Cache       // a guarding cache stores currently flushing segment ids
 	flushManager     flushManager // flush manager handles flush process
-	blobKV           kv.BaseKV
+	chunkManager     storage.ChunkManager
 	compactor        *compactionExecutor // reference to compaction executor
 }
 
@@ -58,7 +58,7 @@ func newDataSyncService(ctx context.Context,
 	clearSignal chan<- string,
 	dataCoord types.DataCoord,
 	flushingSegCache *Cache,
-	blobKV kv.BaseKV,
+	chunkManager storage.ChunkManager,
 	compactor *compactionExecutor,
 ) (*dataSyncService, error) {
 
@@ -81,7 +81,7 @@ func newDataSyncService(ctx context.Context,
 		dataCoord:        dataCoord,
 		clearSignal:      clearSignal,
 		flushingSegCache: flushingSegCache,
-		blobKV:           blobKV,
+		chunkManager:     chunkManager,
 		compactor:        compactor,
 	}
 
@@ -137,8 +137,9 @@ func (dsService *dataSyncService) close() {
 // initNodes inits a TimetickedFlowGraph
 func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) error {
 	dsService.fg = flowgraph.NewTimeTickedFlowGraph(dsService.ctx)
+	dsService.fg.SetMetricLabels(dsService.collectionID, dsService.vchannelName)
 	// initialize flush manager for DataSync Service
-	dsService.flushManager = NewRendezvousFlushManager(dsService.idAllocator, dsService.blobKV, dsService.replica,
+	dsService.flushManager = NewRendezvousFlushManager(dsService.idAllocator, dsService.chunkManager, dsService.replica,
 		flushNotifyFunc(dsService), dropVirtualChannelFunc(dsService))
 
 	// recover segment checkpoints
@@ -28,7 +28,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -132,7 +131,8 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 		te.Run(test.description, func(t *testing.T) {
 			df := &DataCoordFactory{}
 
-			replica, err := newReplica(context.Background(), &RootCoordFactory{}, test.collID)
+			cm := newTestChunkManager(t)
+			replica, err := newReplica(context.Background(), &RootCoordFactory{}, cm, test.collID)
 			assert.Nil(t, err)
 			if test.replicaNil {
 				replica = nil
@@ -147,7 +147,7 @@ func TestDataSyncService_newDataSyncService(te *testing.T) {
 				make(chan string),
 				df,
 				newCache(),
-				memkv.NewMemoryKV(),
+				cm,
 				newCompactionExecutor(),
 			)
 
@@ -185,7 +185,8 @@ func TestDataSyncService_Start(t *testing.T) {
 	collectionID := UniqueID(1)
 
 	flushChan := make(chan flushMsg, 100)
-	replica, err := newReplica(context.Background(), mockRootCoord, collectionID)
+	cm := newTestChunkManager(t)
+	replica, err := newReplica(context.Background(), mockRootCoord, cm, collectionID)
 	assert.Nil(t, err)
 
 	allocFactory := NewAllocatorFactory(1)
@@ -225,7 +226,7 @@ func TestDataSyncService_Start(t *testing.T) {
 	}
 
 	signalCh := make(chan string, 100)
-	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, &DataCoordFactory{}, newCache(), memkv.NewMemoryKV(), newCompactionExecutor())
+	sync, err := newDataSyncService(ctx, flushChan, replica, allocFactory, msFactory, vchan, signalCh, &DataCoordFactory{}, newCache(), cm, newCompactionExecutor())
 
 	assert.Nil(t, err)
 	// sync.replica.addCollection(collMeta.ID, collMeta.Schema)
@@ -148,10 +148,12 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 			fgMsg.insertMessages = append(fgMsg.insertMessages, imsg)
 		case commonpb.MsgType_Delete:
 			dmsg := msg.(*msgstream.DeleteMsg)
+			// a delete message carries either int64 or string primary keys
+			numPKs := len(dmsg.GetPrimaryKeys()) + len(dmsg.GetStringPrimaryKeys())
 			log.Debug("DDNode receive delete messages",
-				zap.Int("num", len(dmsg.GetPrimaryKeys())),
+				zap.Int("num", numPKs),
 				zap.String("vChannelName", ddn.vchannelName))
-			for i := 0; i < len(dmsg.PrimaryKeys); i++ {
+			for i := 0; i < numPKs; i++ {
 				dmsg.HashValues = append(dmsg.HashValues, uint32(0))
 			}
 			forwardMsgs = append(forwardMsgs, dmsg)
@@ -99,35 +99,43 @@ func (dn *deleteNode) Close() {
 }
 
 func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) error {
-	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.String("vChannelName", dn.channelName))
+	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.Strings("string primary keys", msg.StringPrimaryKeys),
+		zap.String("vChannelName", dn.channelName))
 
-	// Update delBuf for merged segments
+	// Update delBuf for merged segments, a segment split by a clustering compaction
+	// is compacted to several segments, each of them gets a copy of its delBuf
 	compactedTo2From := dn.replica.listCompactedSegmentIDs()
 	for compactedTo, compactedFrom := range compactedTo2From {
 		compactToDelBuff := newDelDataBuf()
 		for _, segID := range compactedFrom {
-			value, loaded := dn.delBuf.LoadAndDelete(segID)
+			value, loaded := dn.delBuf.Load(segID)
 			if loaded {
 				compactToDelBuff.updateFromBuf(value.(*DelDataBuf))
 			}
 		}
 		dn.delBuf.Store(compactedTo, compactToDelBuff)
-		dn.replica.removeSegments(compactedFrom...)
 		log.Debug("update delBuf for merged segments",
 			zap.Int64("compactedTo segmentID", compactedTo),
 			zap.Int64s("compactedFrom segmentIDs", compactedFrom),
 		)
 	}
+	for _, compactedFrom := range compactedTo2From {
+		for _, segID := range compactedFrom {
+			dn.delBuf.Delete(segID)
+		}
+		dn.replica.removeSegments(compactedFrom...)
+	}
 
-	segIDToPkMap := make(map[UniqueID][]int64)
+	segIDToPkMap := make(map[UniqueID][]storage.PrimaryKey)
 	segIDToTsMap := make(map[UniqueID][]uint64)
 
-	m := dn.filterSegmentByPK(msg.PartitionID, msg.PrimaryKeys)
-	for i, pk := range msg.PrimaryKeys {
-		segIDs, ok := m[pk]
+	pks := storage.ParseDeleteRequestPrimaryKeys(&msg.DeleteRequest)
+	m := dn.filterSegmentByPK(msg.PartitionID, pks)
+	for i, pk := range pks {
+		segIDs, ok := m[pk.GetValue()]
 		if !ok {
 			log.Warn("primary key not exist in all segments",
-				zap.Int64("primary key", pk),
+				zap.Stringer("primary key", pk),
 				zap.String("vChannelName", dn.channelName))
 			continue
 		}
@@ -159,7 +167,7 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 			delData.Pks = append(delData.Pks, pks[i])
 			delData.Tss = append(delData.Tss, tss[i])
 			log.Debug("delete",
-				zap.Int64("primary key", pks[i]),
+				zap.Stringer("primary key", pks[i]),
 				zap.Uint64("ts", tss[i]),
 				zap.Int64("segmentID", segID),
 				zap.String("vChannelName", dn.channelName))
@@ -188,7 +196,7 @@ func (dn *deleteNode) showDelBuf() {
 			length := len(delDataBuf.delData.Pks)
 			for i := 0; i < length; i++ {
 				log.Debug("del data",
-					zap.Int64("pk", delDataBuf.delData.Pks[i]),
+					zap.Stringer("pk", delDataBuf.delData.Pks[i]),
 					zap.Uint64("ts", delDataBuf.delData.Tss[i]),
 					zap.Int64("segmentID", segID),
 					zap.String("vchannel", dn.channelName),
@@ -274,18 +282,16 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 }
 
 // filterSegmentByPK returns the bloom filter check result.
-// If the key may exists in the segment, returns it in map.
+// If the key may exists in the segment, returns it in map keyed by the raw pk value.
 // If the key not exists in the segment, the segment is filter out.
-func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []int64) map[int64][]int64 {
-	result := make(map[int64][]int64)
-	buf := make([]byte, 8)
+func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []storage.PrimaryKey) map[interface{}][]int64 {
+	result := make(map[interface{}][]int64)
 	segments := dn.replica.filterSegments(dn.channelName, partID)
 	for _, pk := range pks {
 		for _, segment := range segments {
-			common.Endian.PutUint64(buf, uint64(pk))
-			exist := segment.pkFilter.Test(buf)
+			exist := storage.TestPKInBloomFilter(segment.pkFilter, pk)
 			if exist {
-				result[pk] = append(result[pk], segment.segmentID)
+				result[pk.GetValue()] = append(result[pk.GetValue()], segment.segmentID)
 			}
 		}
 	}
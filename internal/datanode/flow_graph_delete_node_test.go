@@ -24,9 +24,9 @@ import (
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/common"
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/stretchr/testify/assert"
 )
@@ -82,6 +82,10 @@ func (replica *mockReplica) getCollectionSchema(collectionID UniqueID, ts Timest
 	return &schemapb.CollectionSchema{}, nil
 }
 
+func (replica *mockReplica) refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	return replica.getCollectionSchema(collectionID, ts)
+}
+
 func (replica *mockReplica) getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error) {
 	if segID == -1 {
 		return -1, -1, errors.New("mocked error")
@@ -214,8 +218,8 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		pks    = []int64{3, 17, 44, 190, 425}
 	)
 	replica := genMockReplica(segIDs, pks, chanName)
-	kv := memkv.NewMemoryKV()
-	fm := NewRendezvousFlushManager(NewAllocatorFactory(), kv, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+	cm := newTestChunkManager(t)
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
 	t.Run("Test get segment by primary keys", func(te *testing.T) {
 		c := &nodeConfig{
 			replica:      replica,
@@ -226,7 +230,7 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		dn, err := newDeleteNode(context.Background(), fm, make(chan string, 1), c)
 		assert.Nil(t, err)
 
-		results := dn.filterSegmentByPK(0, pks)
+		results := dn.filterSegmentByPK(0, storage.NewInt64PrimaryKeys(pks))
 		expected := map[int64][]int64{
 			pks[0]: segIDs[0:3],
 			pks[1]: segIDs[0:3],
@@ -239,6 +243,32 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		}
 	})
 
+	t.Run("Test get segment by string primary keys", func(te *testing.T) {
+		strPKs := []string{"a3f1c2d4-uuid", "https://milvus.io/docs"}
+		filter := bloom.NewWithEstimates(1000000, 0.01)
+		storage.AddPKToBloomFilter(filter, storage.NewStringPrimaryKey(strPKs[0]))
+
+		strReplica := newMockReplica()
+		strReplica.flushedSegments[1] = &Segment{
+			segmentID:   1,
+			channelName: chanName,
+			pkFilter:    filter,
+		}
+		c := &nodeConfig{
+			replica:      strReplica,
+			allocator:    &allocator{},
+			vChannelName: chanName,
+		}
+
+		dn, err := newDeleteNode(context.Background(), fm, make(chan string, 1), c)
+		assert.Nil(t, err)
+
+		results := dn.filterSegmentByPK(0, storage.NewStringPrimaryKeys(strPKs))
+		assert.ElementsMatch(t, []int64{1}, results[strPKs[0]])
+		_, ok := results[strPKs[1]]
+		assert.False(t, ok)
+	})
+
 	t.Run("Test deleteNode Operate valid Msg with failure", func(te *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"strconv"
 	"sync"
@@ -426,6 +427,14 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		log.Error("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	// fields were added to the collection after the schema was cached
+	if collSchema.GetVersion() < msg.GetSchemaVersion() {
+		collSchema, err = ibNode.replica.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Error("Refresh schema wrong:", zap.Error(err))
+			return err
+		}
+	}
 
 	// Get Dimension
 	// TODO GOOSE: under assumption that there's only 1 Vector field in one collection schema
@@ -470,7 +479,29 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		blobReaders = append(blobReaders, bytes.NewReader(blob.GetValue()))
 	}
 
-	for _, field := range collSchema.Fields {
+	// the rows buffered before fields were added get their default values
+	if err := storage.FillDefaultFieldData(collSchema, idata); err != nil {
+		log.Error("fill default field data wrong", zap.Error(err))
+		return err
+	}
+
+	// a field is appended to the schema by each version, rows encoded with an older version lack the latest fields
+	encodedFieldNum := len(collSchema.Fields) - int(collSchema.GetVersion()-msg.GetSchemaVersion())
+
+	for idx, field := range collSchema.Fields {
+		readers := blobReaders
+		if idx >= encodedFieldNum {
+			defaultValue, err := encodeDefaultValue(field)
+			if err != nil {
+				log.Error("encode default value wrong", zap.Int64("fieldID", field.FieldID), zap.Error(err))
+				return err
+			}
+			readers = make([]io.Reader, 0, len(msg.RowData))
+			for range msg.RowData {
+				readers = append(readers, bytes.NewReader(defaultValue))
+			}
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			var dim int
@@ -494,7 +525,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData := idata.Data[field.FieldID].(*storage.FloatVectorFieldData)
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v = make([]float32, dim)
 
 				readBinary(r, &v, field.DataType)
@@ -526,7 +557,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 			fieldData := idata.Data[field.FieldID].(*storage.BinaryVectorFieldData)
 
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v = make([]byte, dim/8)
 				readBinary(r, &v, field.DataType)
 
@@ -544,7 +575,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData := idata.Data[field.FieldID].(*storage.BoolFieldData)
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v bool
 				readBinary(r, &v, field.DataType)
 
@@ -562,7 +593,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData := idata.Data[field.FieldID].(*storage.Int8FieldData)
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v int8
 				readBinary(r, &v, field.DataType)
 
@@ -580,7 +611,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData := idata.Data[field.FieldID].(*storage.Int16FieldData)
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v int16
 				readBinary(r, &v, field.DataType)
 
@@ -598,7 +629,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 
 			fieldData := idata.Data[field.FieldID].(*storage.Int32FieldData)
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v int32
 				readBinary(r, &v, field.DataType)
 
@@ -626,7 +657,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 				}
 				fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
 			default:
-				for _, r := range blobReaders {
+				for _, r := range readers {
 					var v int64
 					readBinary(r, &v, field.DataType)
 
@@ -637,9 +668,42 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 			if field.IsPrimaryKey {
 				// update segment pk filter
-				ibNode.replica.updateSegmentPKRange(currentSegID, fieldData.Data)
+				ibNode.replica.updateSegmentPKRange(currentSegID, storage.NewInt64PrimaryKeys(fieldData.Data))
+			}
+
+		case schemapb.DataType_String:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.StringFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]string, 0),
+				}
 			}
 
+			fieldData := idata.Data[field.FieldID].(*storage.StringFieldData)
+			offset := len(fieldData.Data)
+			for _, r := range readers {
+				fieldData.Data = append(fieldData.Data, readString(r, field.DataType))
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+			if field.IsPrimaryKey {
+				// update segment pk filter
+				ibNode.replica.updateSegmentPKRange(currentSegID, storage.NewStringPrimaryKeys(fieldData.Data[offset:]))
+			}
+
+		case schemapb.DataType_JSON:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.JSONFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([][]byte, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.JSONFieldData)
+			for _, r := range readers {
+				fieldData.Data = append(fieldData.Data, []byte(readString(r, field.DataType)))
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
 		case schemapb.DataType_Float:
 			if _, ok := idata.Data[field.FieldID]; !ok {
 				idata.Data[field.FieldID] = &storage.FloatFieldData{
@@ -650,7 +714,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 			fieldData := idata.Data[field.FieldID].(*storage.FloatFieldData)
 
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v float32
 				readBinary(r, &v, field.DataType)
 
@@ -668,7 +732,7 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 
 			fieldData := idata.Data[field.FieldID].(*storage.DoubleFieldData)
 
-			for _, r := range blobReaders {
+			for _, r := range readers {
 				var v float64
 				readBinary(r, &v, field.DataType)
 
@@ -700,6 +764,49 @@ func readBinary(reader io.Reader, receiver interface{}, dataType schemapb.DataTy
 	}
 }
 
+// encodeDefaultValue encodes the default value of field the same way as the field is encoded in a row.
+func encodeDefaultValue(field *schemapb.FieldSchema) ([]byte, error) {
+	var buffer bytes.Buffer
+	var err error
+	switch value := field.GetDefaultValue().GetData().(type) {
+	case *schemapb.ValueField_BoolData:
+		err = binary.Write(&buffer, common.Endian, value.BoolData)
+	case *schemapb.ValueField_IntData:
+		switch field.DataType {
+		case schemapb.DataType_Int8:
+			err = binary.Write(&buffer, common.Endian, int8(value.IntData))
+		case schemapb.DataType_Int16:
+			err = binary.Write(&buffer, common.Endian, int16(value.IntData))
+		default:
+			err = binary.Write(&buffer, common.Endian, value.IntData)
+		}
+	case *schemapb.ValueField_LongData:
+		err = binary.Write(&buffer, common.Endian, value.LongData)
+	case *schemapb.ValueField_FloatData:
+		err = binary.Write(&buffer, common.Endian, value.FloatData)
+	case *schemapb.ValueField_DoubleData:
+		err = binary.Write(&buffer, common.Endian, value.DoubleData)
+	case *schemapb.ValueField_StringData:
+		err = binary.Write(&buffer, common.Endian, uint32(len(value.StringData)))
+		buffer.WriteString(value.StringData)
+	default:
+		return nil, fmt.Errorf("field %d is absent in the row but has no default value", field.FieldID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// readString reads a string encoded as its uint32 byte length followed by the bytes.
+func readString(reader io.Reader, dataType schemapb.DataType) string {
+	var length uint32
+	readBinary(reader, &length, dataType)
+	v := make([]byte, length)
+	readBinary(reader, &v, dataType)
+	return string(v)
+}
+
 // writeHardTimeTick writes timetick once insertBufferNode operates.
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp, segmentIDs []int64) error {
 	ibNode.ttLogger.LogTs(ts)
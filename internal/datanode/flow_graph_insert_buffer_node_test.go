@@ -17,17 +17,22 @@
 package datanode
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 
@@ -67,7 +72,8 @@ func TestFlowGraphInsertBufferNodeCreate(t *testing.T) {
 	collMeta := Factory.GetCollectionMeta(UniqueID(0), "coll1")
 	mockRootCoord := &RootCoordFactory{}
 
-	replica, err := newReplica(ctx, mockRootCoord, collMeta.ID)
+	cm := newTestChunkManager(t)
+	replica, err := newReplica(ctx, mockRootCoord, cm, collMeta.ID)
 	assert.Nil(t, err)
 
 	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
@@ -81,9 +87,7 @@ func TestFlowGraphInsertBufferNodeCreate(t *testing.T) {
 	err = msFactory.SetParams(m)
 	assert.Nil(t, err)
 
-	memkv := memkv.NewMemoryKV()
-
-	fm := NewRendezvousFlushManager(&allocator{}, memkv, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+	fm := NewRendezvousFlushManager(&allocator{}, cm, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
 
 	flushChan := make(chan flushMsg, 100)
 
@@ -157,7 +161,8 @@ func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 	collMeta := Factory.GetCollectionMeta(UniqueID(0), "coll1")
 	mockRootCoord := &RootCoordFactory{}
 
-	replica, err := newReplica(ctx, mockRootCoord, collMeta.ID)
+	cm := newTestChunkManager(t)
+	replica, err := newReplica(ctx, mockRootCoord, cm, collMeta.ID)
 	assert.Nil(t, err)
 
 	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
@@ -171,9 +176,7 @@ func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 	err = msFactory.SetParams(m)
 	assert.Nil(t, err)
 
-	memkv := memkv.NewMemoryKV()
-
-	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
 
 	flushChan := make(chan flushMsg, 100)
 	c := &nodeConfig{
@@ -379,10 +382,10 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 
 	flushPacks := []*segmentFlushPack{}
 	fpMut := sync.Mutex{}
-	memkv := memkv.NewMemoryKV()
 	wg := sync.WaitGroup{}
 
-	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv, colRep, func(pack *segmentFlushPack) {
+	cm := newTestChunkManager(t)
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, colRep, func(pack *segmentFlushPack) {
 		fpMut.Lock()
 		flushPacks = append(flushPacks, pack)
 		fpMut.Unlock()
@@ -637,7 +640,8 @@ func TestInsertBufferNode_bufferInsertMsg(t *testing.T) {
 		compactTs: 100,
 	}
 
-	replica, err := newReplica(ctx, mockRootCoord, collMeta.ID)
+	cm := newTestChunkManager(t)
+	replica, err := newReplica(ctx, mockRootCoord, cm, collMeta.ID)
 	assert.Nil(t, err)
 
 	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
@@ -651,9 +655,7 @@ func TestInsertBufferNode_bufferInsertMsg(t *testing.T) {
 	err = msFactory.SetParams(m)
 	assert.Nil(t, err)
 
-	memkv := memkv.NewMemoryKV()
-
-	fm := NewRendezvousFlushManager(&allocator{}, memkv, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+	fm := NewRendezvousFlushManager(&allocator{}, cm, replica, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
 
 	flushChan := make(chan flushMsg, 100)
 	c := &nodeConfig{
@@ -680,6 +682,83 @@ func TestInsertBufferNode_bufferInsertMsg(t *testing.T) {
 	}
 }
 
+func TestInsertBufferNode_bufferInsertMsgWithAddedField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll1",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	replica := &SegmentReplica{
+		collectionID: 1,
+		collSchema:   schema,
+		newSegments: map[UniqueID]*Segment{
+			1: {segmentID: 1, pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)},
+		},
+		normalSegments:  make(map[UniqueID]*Segment),
+		flushedSegments: make(map[UniqueID]*Segment),
+	}
+	iBNode := &insertBufferNode{replica: replica}
+
+	// a row holds the pk, the vector and the encoded values of extra fields
+	genMsg := func(schemaVersion int32, pk int64, extra ...interface{}) *msgstream.InsertMsg {
+		var buf bytes.Buffer
+		for _, v := range append([]interface{}{pk, []float32{1, 2}}, extra...) {
+			err := binary.Write(&buf, common.Endian, v)
+			require.NoError(t, err)
+		}
+		return &msgstream.InsertMsg{
+			BaseMsg: msgstream.BaseMsg{EndTimestamp: 100},
+			InsertRequest: internalpb.InsertRequest{
+				CollectionID:  1,
+				SegmentID:     1,
+				RowIDs:        []int64{pk},
+				Timestamps:    []uint64{uint64(pk)},
+				RowData:       []*commonpb.Blob{{Value: buf.Bytes()}},
+				SchemaVersion: schemaVersion,
+			},
+		}
+	}
+
+	err := iBNode.bufferInsertMsg(genMsg(0, 1), &internalpb.MsgPosition{})
+	assert.NoError(t, err)
+
+	// field 102 is added by schema version 1
+	addedSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	addedSchema.Version = 1
+	addedSchema.Fields = append(addedSchema.Fields, &schemapb.FieldSchema{
+		FieldID:      102,
+		Name:         "age",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+	})
+	replica.collSchema = addedSchema
+
+	// rows encoded with the old schema lack field 102
+	err = iBNode.bufferInsertMsg(genMsg(0, 2), &internalpb.MsgPosition{})
+	assert.NoError(t, err)
+	err = iBNode.bufferInsertMsg(genMsg(1, 3, int32(9)), &internalpb.MsgPosition{})
+	assert.NoError(t, err)
+
+	bd, ok := iBNode.insertBuffer.Load(UniqueID(1))
+	require.True(t, ok)
+	idata := bd.(*BufferData).buffer
+	assert.Equal(t, []int64{1, 2, 3}, idata.Data[100].(*storage.Int64FieldData).Data)
+	assert.Equal(t, []float32{1, 2, 1, 2, 1, 2}, idata.Data[101].(*storage.FloatVectorFieldData).Data)
+	ageData := idata.Data[102].(*storage.Int32FieldData)
+	assert.Equal(t, []int32{7, 7, 9}, ageData.Data)
+	assert.Equal(t, idata.Data[1].(*storage.Int64FieldData).NumRows, ageData.NumRows)
+
+	// a field without default value can't be absent in the rows
+	addedSchema.Fields[4].DefaultValue = nil
+	err = iBNode.bufferInsertMsg(genMsg(0, 4), &internalpb.MsgPosition{})
+	assert.Error(t, err)
+}
+
 func TestInsertBufferNode_updateSegStatesInReplica(te *testing.T) {
 	invalideTests := []struct {
 		replicaCollID UniqueID
@@ -692,7 +771,7 @@ func TestInsertBufferNode_updateSegStatesInReplica(te *testing.T) {
 	}
 
 	for _, test := range invalideTests {
-		replica, err := newReplica(context.Background(), &RootCoordFactory{}, test.replicaCollID)
+		replica, err := newReplica(context.Background(), &RootCoordFactory{}, newTestChunkManager(te), test.replicaCollID)
 		assert.Nil(te, err)
 
 		ibNode := &insertBufferNode{
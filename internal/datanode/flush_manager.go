@@ -23,7 +23,6 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -236,7 +235,7 @@ type dropHandler struct {
 // rendezvousFlushManager makes sure insert & del buf all flushed
 type rendezvousFlushManager struct {
 	allocatorInterface
-	kv.BaseKV
+	storage.ChunkManager
 	Replica
 
 	// segment id => flush queue
@@ -336,8 +335,9 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		return err
 	}
 
+	tsFrom, tsTo := getTimestampRange(data.buffer)
 	field2Insert := make(map[UniqueID]*datapb.Binlog, len(binLogs))
-	kvs := make(map[string]string, len(binLogs))
+	kvs := make(map[string][]byte, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
 	for idx, blob := range binLogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
@@ -352,11 +352,11 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		k := JoinIDPath(collID, partID, segmentID, fieldID, logidx)
 
 		key := path.Join(Params.DataNodeCfg.InsertBinlogRootPath, k)
-		kvs[key] = string(blob.Value[:])
+		kvs[key] = blob.Value
 		field2Insert[fieldID] = &datapb.Binlog{
 			EntriesNum:    data.size,
-			TimestampFrom: 0, //TODO
-			TimestampTo:   0, //TODO,
+			TimestampFrom: tsFrom,
+			TimestampTo:   tsTo,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
 		}
@@ -378,7 +378,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		k := JoinIDPath(collID, partID, segmentID, fieldID, logidx)
 
 		key := path.Join(Params.DataNodeCfg.StatsBinlogRootPath, k)
-		kvs[key] = string(blob.Value)
+		kvs[key] = blob.Value
 		field2Stats[fieldID] = &datapb.Binlog{
 			EntriesNum:    0,
 			TimestampFrom: 0, //TODO
@@ -390,8 +390,8 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 
 	m.updateSegmentCheckPoint(segmentID)
 	m.handleInsertTask(segmentID, &flushBufferInsertTask{
-		BaseKV: m.BaseKV,
-		data:   kvs,
+		ChunkManager: m.ChunkManager,
+		data:         kvs,
 	}, field2Insert, field2Stats, flushed, dropped, pos)
 	return nil
 }
@@ -426,13 +426,13 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 
 	blobKey := JoinIDPath(collID, partID, segmentID, logID)
 	blobPath := path.Join(Params.DataNodeCfg.DeleteBinlogRootPath, blobKey)
-	kvs := map[string]string{blobPath: string(blob.Value[:])}
+	kvs := map[string][]byte{blobPath: blob.Value}
 	data.LogSize = int64(len(blob.Value))
 	data.LogPath = blobPath
 	log.Debug("delete blob path", zap.String("path", blobPath))
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
-		BaseKV: m.BaseKV,
-		data:   kvs,
+		ChunkManager: m.ChunkManager,
+		data:         kvs,
 	}, data, pos)
 	return nil
 }
@@ -526,36 +526,36 @@ func (m *rendezvousFlushManager) close() {
 }
 
 type flushBufferInsertTask struct {
-	kv.BaseKV
-	data map[string]string
+	storage.ChunkManager
+	data map[string][]byte
 }
 
 // flushInsertData implements flushInsertTask
 func (t *flushBufferInsertTask) flushInsertData() error {
-	if t.BaseKV != nil && len(t.data) > 0 {
-		return t.MultiSave(t.data)
+	if t.ChunkManager != nil && len(t.data) > 0 {
+		return t.MultiWrite(t.data)
 	}
 	return nil
 }
 
 type flushBufferDeleteTask struct {
-	kv.BaseKV
-	data map[string]string
+	storage.ChunkManager
+	data map[string][]byte
 }
 
 // flushDeleteData implements flushDeleteTask
 func (t *flushBufferDeleteTask) flushDeleteData() error {
-	if len(t.data) > 0 && t.BaseKV != nil {
-		return t.MultiSave(t.data)
+	if len(t.data) > 0 && t.ChunkManager != nil {
+		return t.MultiWrite(t.data)
 	}
 	return nil
 }
 
-// NewRendezvousFlushManager create rendezvousFlushManager with provided allocator and kv
-func NewRendezvousFlushManager(allocator allocatorInterface, kv kv.BaseKV, replica Replica, f notifyMetaFunc, drop flushAndDropFunc) *rendezvousFlushManager {
+// NewRendezvousFlushManager create rendezvousFlushManager with provided allocator and chunk manager
+func NewRendezvousFlushManager(allocator allocatorInterface, cm storage.ChunkManager, replica Replica, f notifyMetaFunc, drop flushAndDropFunc) *rendezvousFlushManager {
 	fm := &rendezvousFlushManager{
 		allocatorInterface: allocator,
-		BaseKV:             kv,
+		ChunkManager:       cm,
 		notifyFunc:         f,
 		Replica:            replica,
 		dropHandler: dropHandler{
@@ -24,7 +24,6 @@ import (
 	"testing"
 	"time"
 
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -137,13 +136,13 @@ func TestOrderFlushQueue_Order(t *testing.T) {
 }
 
 func TestRendezvousFlushManager(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 
 	size := 1000
 	var counter atomic.Int64
 	finish := sync.WaitGroup{}
 	finish.Add(size)
-	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+	m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		counter.Inc()
 		finish.Done()
 	}, emptyFlushAndDropFunc)
@@ -173,7 +172,7 @@ func TestRendezvousFlushManager(t *testing.T) {
 }
 
 func TestRendezvousFlushManager_Inject(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 
 	size := 1000
 	var counter atomic.Int64
@@ -181,7 +180,7 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 	finish.Add(size)
 	var packMut sync.Mutex
 	packs := make([]*segmentFlushPack, 0, size+3)
-	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+	m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		packMut.Lock()
 		packs = append(packs, pack)
 		packMut.Unlock()
@@ -275,9 +274,9 @@ func TestRendezvousFlushManager_Inject(t *testing.T) {
 }
 
 func TestRendezvousFlushManager_getSegmentMeta(t *testing.T) {
-	memkv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 	replica := newMockReplica()
-	fm := NewRendezvousFlushManager(NewAllocatorFactory(), memkv, replica, func(*segmentFlushPack) {
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, replica, func(*segmentFlushPack) {
 	}, emptyFlushAndDropFunc)
 
 	// non exists segment
@@ -296,13 +295,13 @@ func TestRendezvousFlushManager_getSegmentMeta(t *testing.T) {
 }
 
 func TestRendezvousFlushManager_waitForAllFlushQueue(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 
 	size := 1000
 	var counter atomic.Int64
 	var finish sync.WaitGroup
 	finish.Add(size)
-	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+	m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		counter.Inc()
 		finish.Done()
 	}, emptyFlushAndDropFunc)
@@ -366,13 +365,13 @@ func TestRendezvousFlushManager_waitForAllFlushQueue(t *testing.T) {
 
 func TestRendezvousFlushManager_dropMode(t *testing.T) {
 	t.Run("test drop mode", func(t *testing.T) {
-		kv := memkv.NewMemoryKV()
+		cm := newTestChunkManager(t)
 
 		var mut sync.Mutex
 		var result []*segmentFlushPack
 		signal := make(chan struct{})
 
-		m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+		m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		}, func(packs []*segmentFlushPack) {
 			mut.Lock()
 			result = packs
@@ -418,13 +417,13 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 		assert.Equal(t, len(target), len(output))
 	})
 	t.Run("test drop mode with injection", func(t *testing.T) {
-		kv := memkv.NewMemoryKV()
+		cm := newTestChunkManager(t)
 
 		var mut sync.Mutex
 		var result []*segmentFlushPack
 		signal := make(chan struct{})
 
-		m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+		m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		}, func(packs []*segmentFlushPack) {
 			mut.Lock()
 			result = packs
@@ -477,13 +476,13 @@ func TestRendezvousFlushManager_dropMode(t *testing.T) {
 }
 
 func TestRendezvousFlushManager_close(t *testing.T) {
-	kv := memkv.NewMemoryKV()
+	cm := newTestChunkManager(t)
 
 	size := 1000
 	var counter atomic.Int64
 	finish := sync.WaitGroup{}
 	finish.Add(size)
-	m := NewRendezvousFlushManager(&allocator{}, kv, newMockReplica(), func(pack *segmentFlushPack) {
+	m := NewRendezvousFlushManager(&allocator{}, cm, newMockReplica(), func(pack *segmentFlushPack) {
 		counter.Inc()
 		finish.Done()
 	}, emptyFlushAndDropFunc)
@@ -517,7 +516,7 @@ func TestFlushNotifyFunc(t *testing.T) {
 	ctx := context.Background()
 	rcf := &RootCoordFactory{}
 
-	replica, err := newReplica(ctx, rcf, 1)
+	replica, err := newReplica(ctx, rcf, newTestChunkManager(t), 1)
 	require.NoError(t, err)
 
 	dataCoord := &DataCoordFactory{}
@@ -567,7 +566,7 @@ func TestFlushNotifyFunc(t *testing.T) {
 func TestDropVirtualChannelFunc(t *testing.T) {
 	ctx := context.Background()
 	rcf := &RootCoordFactory{}
-	replica, err := newReplica(ctx, rcf, 1)
+	replica, err := newReplica(ctx, rcf, newTestChunkManager(t), 1)
 	require.NoError(t, err)
 
 	dataCoord := &DataCoordFactory{}
@@ -20,9 +20,10 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -47,7 +48,6 @@ type flushDeleteTask interface {
 //  then call the notifyFunc
 type flushTaskRunner struct {
 	sync.WaitGroup
-	kv.BaseKV
 
 	initOnce   sync.Once
 	insertOnce sync.Once
@@ -130,12 +130,14 @@ func (t *flushTaskRunner) runFlushInsert(task flushInsertTask,
 		t.pos = pos
 		t.dropped = dropped
 		go func() {
+			start := time.Now()
 			err := retry.Do(context.Background(), func() error {
 				return task.flushInsertData()
 			}, opts...)
 			if err != nil {
 				t.insertErr = err
 			}
+			metrics.DataNodeFlushLatency.WithLabelValues(metrics.InsertLabel).Observe(float64(time.Since(start).Milliseconds()))
 			t.Done()
 		}()
 	})
@@ -157,12 +159,14 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 			}
 		}
 		go func() {
+			start := time.Now()
 			err := retry.Do(context.Background(), func() error {
 				return task.flushDeleteData()
 			}, opts...)
 			if err != nil {
 				t.deleteErr = err
 			}
+			metrics.DataNodeFlushLatency.WithLabelValues(metrics.DeleteLabel).Observe(float64(time.Since(start).Milliseconds()))
 			t.Done()
 		}()
 	})
@@ -21,11 +21,16 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"io/ioutil"
 	"math"
 	"math/rand"
+	"os"
 	"sync"
+	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -50,6 +55,14 @@ const debug = false
 
 var emptyFlushAndDropFunc flushAndDropFunc = func(_ []*segmentFlushPack) {}
 
+// newTestChunkManager returns a LocalChunkManager on a temporary directory, which is removed when the test finishes
+func newTestChunkManager(t *testing.T) s.ChunkManager {
+	dir, err := ioutil.TempDir("", "datanode")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return s.NewLocalChunkManager(dir)
+}
+
 func newIDLEDataNodeMock(ctx context.Context) *DataNode {
 	msFactory := msgstream.NewPmsFactory()
 	node := NewDataNode(ctx, msFactory)
@@ -165,6 +178,8 @@ type DataCoordFactory struct {
 
 	DropVirtualChannelError      bool
 	DropVirtualChannelNotSuccess bool
+
+	ImportResults []*datapb.ImportResult
 }
 
 func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
@@ -207,6 +222,11 @@ func (ds *DataCoordFactory) DropVirtualChannel(ctx context.Context, req *datapb.
 	}, nil
 }
 
+func (ds *DataCoordFactory) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	ds.ImportResults = append(ds.ImportResults, proto.Clone(req).(*datapb.ImportResult))
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (mf *MetaFactory) GetCollectionMeta(collectionID UniqueID, collectionName string) *etcdpb.CollectionMeta {
 	sch := schemapb.CollectionSchema{
 		Name:        collectionName,
@@ -19,7 +19,6 @@ package datanode
 import (
 	"context"
 	"fmt"
-	"math"
 	"sync"
 	"sync/atomic"
 
@@ -27,8 +26,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/kv"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -48,6 +45,7 @@ const (
 type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	listAllSegmentIDs() []UniqueID
@@ -59,8 +57,9 @@ type Replica interface {
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
-	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	updateSegmentPKRange(segID UniqueID, pks []storage.PrimaryKey)
 	mergeFlushedSegments(segID, collID, partID UniqueID, compactedFrom []UniqueID, channelName string, numOfRows int64)
+	splitFlushedSegments(segNumRows map[UniqueID]int64, collID, partID UniqueID, compactedFrom []UniqueID, channelName string)
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
@@ -81,16 +80,15 @@ type Segment struct {
 	isNew        atomic.Value // bool
 	isFlushed    atomic.Value // bool
 	channelName  string
-	compactedTo  UniqueID
+	compactedTo  []UniqueID // segments compacted to, more than one if split by a clustering compaction
 
 	checkPoint segmentCheckPoint
 	startPos   *internalpb.MsgPosition // TODO readonly
 	endPos     *internalpb.MsgPosition
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
-	// TODO silverxia, needs to change to interface to support `string` type PK
-	minPK int64 //	minimal pk value, shortcut for checking whether a pk is inside this segment
-	maxPK int64 //  maximal pk value, same above
+	minPK    storage.PrimaryKey //	minimal pk value, shortcut for checking whether a pk is inside this segment, nil represents no value
+	maxPK    storage.PrimaryKey //  maximal pk value, same above
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -105,19 +103,17 @@ type SegmentReplica struct {
 	flushedSegments   map[UniqueID]*Segment
 	compactedSegments map[UniqueID]*Segment
 
-	metaService *metaService
-	minIOKV     kv.BaseKV
+	metaService  *metaService
+	chunkManager storage.ChunkManager
 }
 
-func (s *Segment) updatePKRange(pks []int64) {
-	buf := make([]byte, 8)
+func (s *Segment) updatePKRange(pks []storage.PrimaryKey) {
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		s.pkFilter.Add(buf)
-		if pk > s.maxPK {
+		storage.AddPKToBloomFilter(s.pkFilter, pk)
+		if s.maxPK == nil || pk.GT(s.maxPK) {
 			s.maxPK = pk
 		}
-		if pk < s.minPK {
+		if s.minPK == nil || pk.LT(s.minPK) {
 			s.minPK = pk
 		}
 	}
@@ -125,27 +121,12 @@ func (s *Segment) updatePKRange(pks []int64) {
 	log.Info("update pk range",
 		zap.Int64("collectionID", s.collectionID), zap.Int64("partitionID", s.partitionID), zap.Int64("segmentID", s.segmentID),
 		zap.String("channel", s.channelName),
-		zap.Int64("num_rows", s.numRows), zap.Int64("minPK", s.minPK), zap.Int64("maxPK", s.maxPK))
+		zap.Int64("num_rows", s.numRows), zap.Any("minPK", s.minPK), zap.Any("maxPK", s.maxPK))
 }
 
 var _ Replica = &SegmentReplica{}
 
-func newReplica(ctx context.Context, rc types.RootCoord, collID UniqueID) (*SegmentReplica, error) {
-	// MinIO
-	option := &miniokv.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
-	if err != nil {
-		return nil, err
-	}
-
+func newReplica(ctx context.Context, rc types.RootCoord, cm storage.ChunkManager, collID UniqueID) (*SegmentReplica, error) {
 	metaService := newMetaService(rc, collID)
 
 	replica := &SegmentReplica{
@@ -156,8 +137,8 @@ func newReplica(ctx context.Context, rc types.RootCoord, collID UniqueID) (*Segm
 		flushedSegments:   make(map[UniqueID]*Segment),
 		compactedSegments: make(map[UniqueID]*Segment),
 
-		metaService: metaService,
-		minIOKV:     minIOKV,
+		metaService:  metaService,
+		chunkManager: cm,
 	}
 
 	return replica, nil
@@ -256,8 +237,6 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		endPos:     endPos,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	seg.isNew.Store(true)
@@ -276,14 +255,9 @@ func (replica *SegmentReplica) listCompactedSegmentIDs() map[UniqueID][]UniqueID
 	compactedTo2From := make(map[UniqueID][]UniqueID)
 
 	for segID, seg := range replica.compactedSegments {
-		var from []UniqueID
-		from, ok := compactedTo2From[seg.compactedTo]
-		if !ok {
-			from = []UniqueID{}
+		for _, to := range seg.compactedTo {
+			compactedTo2From[to] = append(compactedTo2From[to], segID)
 		}
-
-		from = append(from, segID)
-		compactedTo2From[seg.compactedTo] = from
 	}
 
 	return compactedTo2From
@@ -342,8 +316,6 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 	if cp != nil {
 		seg.checkPoint = *cp
@@ -391,8 +363,6 @@ func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID Uniq
 
 		//TODO silverxia, normal segments bloom filter and pk range should be loaded from serialized files
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	err := replica.initPKBloomFilter(seg, statsBinlogs)
@@ -439,13 +409,13 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 		}
 	}
 
-	values, err := replica.minIOKV.MultiLoad(bloomFilterFiles)
+	values, err := replica.chunkManager.MultiRead(bloomFilterFiles)
 	if err != nil {
 		return err
 	}
 	blobs := make([]*Blob, 0)
 	for i := 0; i < len(values); i++ {
-		blobs = append(blobs, &Blob{Value: []byte(values[i])})
+		blobs = append(blobs, &Blob{Value: values[i]})
 	}
 
 	stats, err := storage.DeserializeStats(blobs)
@@ -457,11 +427,11 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 		if err != nil {
 			return err
 		}
-		if s.minPK > stat.Min {
+		if s.minPK == nil || stat.Min.LT(s.minPK) {
 			s.minPK = stat.Min
 		}
 
-		if s.maxPK < stat.Max {
+		if s.maxPK == nil || stat.Max.GT(s.maxPK) {
 			s.maxPK = stat.Max
 		}
 	}
@@ -526,7 +496,7 @@ func (replica *SegmentReplica) updateSegmentEndPosition(segID UniqueID, endPos *
 	log.Warn("No match segment", zap.Int64("ID", segID))
 }
 
-func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []int64) {
+func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []storage.PrimaryKey) {
 	replica.segMu.Lock()
 	defer replica.segMu.Unlock()
 
@@ -654,15 +624,54 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 		return nil, fmt.Errorf("not supported collection %v", collID)
 	}
 
+	replica.segMu.RLock()
+	collSchema := replica.collSchema
+	replica.segMu.RUnlock()
+	if collSchema != nil {
+		return collSchema, nil
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Error("Grpc error", zap.Error(err))
+		return nil, err
+	}
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+	// the schema may have been set or refreshed during the rpc
 	if replica.collSchema == nil {
-		sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
-		if err != nil {
-			log.Error("Grpc error", zap.Error(err))
-			return nil, err
-		}
 		replica.collSchema = sch
 	}
+	return replica.collSchema, nil
+}
 
+// refreshCollectionSchema fetches the collection schema at ts from rootcoord and replaces the cached one,
+//   it's called when fields are added to the collection.
+func (replica *SegmentReplica) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !replica.validCollection(collID) {
+		log.Warn("Mismatch collection for the replica",
+			zap.Int64("Want", replica.collectionID),
+			zap.Int64("Actual", collID),
+		)
+		return nil, fmt.Errorf("not supported collection %v", collID)
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Error("Grpc error", zap.Error(err))
+		return nil, err
+	}
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+	// a concurrent refresh may have cached a newer schema already
+	if replica.collSchema.GetVersion() > sch.GetVersion() {
+		return replica.collSchema, nil
+	}
+	log.Info("refresh collection schema", zap.Int64("collectionID", collID),
+		zap.Int32("old version", replica.collSchema.GetVersion()), zap.Int32("new version", sch.GetVersion()))
+	replica.collSchema = sch
 	return replica.collSchema, nil
 }
 
@@ -711,8 +720,6 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID Unique
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	replica.segMu.Lock()
@@ -724,7 +731,7 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID Unique
 			continue
 		}
 
-		s.compactedTo = segID
+		s.compactedTo = []UniqueID{segID}
 		replica.compactedSegments[ID] = s
 		delete(replica.flushedSegments, ID)
 
@@ -740,8 +747,64 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID Unique
 	replica.segMu.Unlock()
 }
 
+// splitFlushedSegments replaces the compacted flushed segments with the segments a clustering compaction
+// splits them into, segNumRows is the number of rows of each new segment
+func (replica *SegmentReplica) splitFlushedSegments(segNumRows map[UniqueID]int64, collID, partID UniqueID, compactedFrom []UniqueID, channelName string) {
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection",
+			zap.Int64("input ID", collID),
+			zap.Int64("expected ID", replica.collectionID))
+		return
+	}
+
+	segIDs := make([]UniqueID, 0, len(segNumRows))
+	for segID := range segNumRows {
+		segIDs = append(segIDs, segID)
+	}
+	log.Debug("split flushed segments",
+		zap.Int64s("compacted To segmentIDs", segIDs),
+		zap.Int64s("compacted From segmentIDs", compactedFrom),
+		zap.Int64("partition ID", partID),
+		zap.String("channel name", channelName),
+	)
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+
+	// the pks of a new segment may come from any of the compacted segments
+	pkFilter := bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+	for _, ID := range compactedFrom {
+		s, ok := replica.flushedSegments[ID]
+		if !ok {
+			log.Warn("no match flushed segment to split from", zap.Int64("segmentID", ID))
+			continue
+		}
+
+		s.compactedTo = segIDs
+		replica.compactedSegments[ID] = s
+		delete(replica.flushedSegments, ID)
+
+		pkFilter.Merge(s.pkFilter)
+	}
+
+	for segID, numOfRows := range segNumRows {
+		seg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    segID,
+			channelName:  channelName,
+			numRows:      numOfRows,
+
+			pkFilter: pkFilter.Copy(),
+		}
+		seg.isNew.Store(false)
+		seg.isFlushed.Store(true)
+		replica.flushedSegments[segID] = seg
+	}
+}
+
 // for tests only
-func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, pks []UniqueID) {
+func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, pks []storage.PrimaryKey) {
 	if collID != replica.collectionID {
 		log.Warn("Mismatch collection",
 			zap.Int64("input ID", collID),
@@ -764,8 +827,6 @@ func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID Un
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	seg.updatePKRange(pks)
@@ -20,7 +20,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"math/rand"
 	"testing"
 
@@ -29,7 +28,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -37,16 +35,16 @@ import (
 
 func TestNewReplica(t *testing.T) {
 	rc := &RootCoordFactory{}
-	replica, err := newReplica(context.Background(), rc, 0)
+	replica, err := newReplica(context.Background(), rc, newTestChunkManager(t), 0)
 	assert.Nil(t, err)
 	assert.NotNil(t, replica)
 }
 
-type mockMinioKV struct {
-	kv.BaseKV
+type mockDataCm struct {
+	storage.ChunkManager
 }
 
-func (kv *mockMinioKV) MultiLoad(keys []string) ([]string, error) {
+func (kv *mockDataCm) MultiRead(keys []string) ([][]byte, error) {
 	stats := &storage.Int64Stats{
 		FieldID: common.RowIDField,
 		Min:     0,
@@ -54,14 +52,14 @@ func (kv *mockMinioKV) MultiLoad(keys []string) ([]string, error) {
 		BF:      bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
 	}
 	buffer, _ := json.Marshal(stats)
-	return []string{string(buffer)}, nil
+	return [][]byte{buffer}, nil
 }
 
 type mockPkfilterMergeError struct {
-	kv.BaseKV
+	storage.ChunkManager
 }
 
-func (kv *mockPkfilterMergeError) MultiLoad(keys []string) ([]string, error) {
+func (kv *mockPkfilterMergeError) MultiRead(keys []string) ([][]byte, error) {
 	stats := &storage.Int64Stats{
 		FieldID: common.RowIDField,
 		Min:     0,
@@ -69,23 +67,23 @@ func (kv *mockPkfilterMergeError) MultiLoad(keys []string) ([]string, error) {
 		BF:      bloom.NewWithEstimates(1, 0.0001),
 	}
 	buffer, _ := json.Marshal(stats)
-	return []string{string(buffer)}, nil
+	return [][]byte{buffer}, nil
 }
 
-type mockMinioKVError struct {
-	kv.BaseKV
+type mockDataCmError struct {
+	storage.ChunkManager
 }
 
-func (kv *mockMinioKVError) MultiLoad(keys []string) ([]string, error) {
+func (kv *mockDataCmError) MultiRead(keys []string) ([][]byte, error) {
 	return nil, fmt.Errorf("mock error")
 }
 
-type mockMinioKVStatsError struct {
-	kv.BaseKV
+type mockDataCmStatsError struct {
+	storage.ChunkManager
 }
 
-func (kv *mockMinioKVStatsError) MultiLoad(keys []string) ([]string, error) {
-	return []string{"3123123,error,test"}, nil
+func (kv *mockDataCmStatsError) MultiRead(keys []string) ([][]byte, error) {
+	return [][]byte{[]byte("3123123,error,test")}, nil
 }
 
 func getSimpleFieldBinlog() *datapb.FieldBinlog {
@@ -171,7 +169,7 @@ func TestSegmentReplica(t *testing.T) {
 	collID := UniqueID(1)
 
 	t.Run("Test coll mot match", func(t *testing.T) {
-		replica, err := newReplica(context.Background(), rc, collID)
+		replica, err := newReplica(context.Background(), rc, newTestChunkManager(t), collID)
 		assert.Nil(t, err)
 
 		err = replica.addNewSegment(1, collID+1, 0, "", nil, nil)
@@ -263,15 +261,15 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				replica, err := newReplica(context.TODO(), rc, test.replicaCollID)
+				replica, err := newReplica(context.TODO(), rc, newTestChunkManager(t), test.replicaCollID)
 				require.NoError(t, err)
 				if test.isvalid {
-					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, []int64{9})
+					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, storage.NewInt64PrimaryKeys([]int64{9}))
 
 					assert.True(t, replica.hasSegment(100, true))
 					assert.False(t, replica.hasSegment(100, false))
 				} else {
-					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, []int64{9})
+					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, storage.NewInt64PrimaryKeys([]int64{9}))
 					assert.False(t, replica.hasSegment(100, true))
 					assert.False(t, replica.hasSegment(100, false))
 				}
@@ -300,7 +298,7 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				sr, err := newReplica(context.Background(), rc, test.replicaCollID)
+				sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), test.replicaCollID)
 				assert.Nil(t, err)
 				require.False(t, sr.hasSegment(test.inSegID, true))
 				err = sr.addNewSegment(test.inSegID,
@@ -336,8 +334,8 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				sr, err := newReplica(context.Background(), rc, test.replicaCollID)
-				sr.minIOKV = &mockMinioKV{}
+				sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), test.replicaCollID)
+				sr.chunkManager = &mockDataCm{}
 				assert.Nil(t, err)
 				require.False(t, sr.hasSegment(test.inSegID, true))
 				err = sr.addNormalSegment(test.inSegID, test.inCollID, 1, "", 0, []*datapb.FieldBinlog{getSimpleFieldBinlog()}, &segmentCheckPoint{})
@@ -355,9 +353,9 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 	})
 
 	t.Run("Test_addNormalSegmentWithNilDml", func(t *testing.T) {
-		sr, err := newReplica(context.Background(), rc, 1)
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
 		require.NoError(t, err)
-		sr.minIOKV = &mockMinioKV{}
+		sr.chunkManager = &mockDataCm{}
 		segID := int64(101)
 		require.False(t, sr.hasSegment(segID, true))
 		assert.NotPanics(t, func() {
@@ -548,7 +546,7 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				sr, err := newReplica(context.Background(), rc, test.replicaCollID)
+				sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), test.replicaCollID)
 				assert.Nil(t, err)
 
 				if test.metaServiceErr {
@@ -569,6 +567,30 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		}
 	})
 
+	t.Run("Test_refreshCollectionSchema", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
+		assert.Nil(t, err)
+		rc.setCollectionID(1)
+
+		cached, err := sr.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+
+		refreshed, err := sr.refreshCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.NotSame(t, cached, refreshed)
+
+		s, err := sr.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Same(t, refreshed, s)
+
+		_, err = sr.refreshCollectionSchema(2, Timestamp(0))
+		assert.Error(t, err)
+
+		rc.setCollectionID(-1)
+		_, err = sr.refreshCollectionSchema(1, Timestamp(0))
+		assert.Error(t, err)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		sr := &SegmentReplica{
 			newSegments:     map[UniqueID]*Segment{1: {segmentID: 1}},
@@ -581,9 +603,9 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 	})
 
 	t.Run("Test_addSegmentMinIOLoadError", func(t *testing.T) {
-		sr, err := newReplica(context.Background(), rc, 1)
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
 		assert.Nil(t, err)
-		sr.minIOKV = &mockMinioKVError{}
+		sr.chunkManager = &mockDataCmError{}
 
 		cpPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(10)}
 		cp := &segmentCheckPoint{int64(10), *cpPos}
@@ -594,9 +616,9 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 	})
 
 	t.Run("Test_addSegmentStatsError", func(t *testing.T) {
-		sr, err := newReplica(context.Background(), rc, 1)
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
 		assert.Nil(t, err)
-		sr.minIOKV = &mockMinioKVStatsError{}
+		sr.chunkManager = &mockDataCmStatsError{}
 
 		cpPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(10)}
 		cp := &segmentCheckPoint{int64(10), *cpPos}
@@ -607,9 +629,9 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 	})
 
 	t.Run("Test_addSegmentPkfilterError", func(t *testing.T) {
-		sr, err := newReplica(context.Background(), rc, 1)
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
 		assert.Nil(t, err)
-		sr.minIOKV = &mockPkfilterMergeError{}
+		sr.chunkManager = &mockPkfilterMergeError{}
 
 		cpPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(10)}
 		cp := &segmentCheckPoint{int64(10), *cpPos}
@@ -620,11 +642,11 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 	})
 
 	t.Run("Test_mergeFlushedSegments", func(t *testing.T) {
-		sr, err := newReplica(context.Background(), rc, 1)
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
 		assert.Nil(t, err)
 
-		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, []UniqueID{1})
-		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, []UniqueID{1})
+		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, storage.NewInt64PrimaryKeys([]UniqueID{1}))
+		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, storage.NewInt64PrimaryKeys([]UniqueID{1}))
 		require.True(t, sr.hasSegment(1, true))
 		require.True(t, sr.hasSegment(2, true))
 
@@ -641,13 +663,41 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		assert.ElementsMatch(t, []UniqueID{1, 2}, from)
 	})
 
+	t.Run("Test_splitFlushedSegments", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, newTestChunkManager(t), 1)
+		assert.Nil(t, err)
+
+		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, storage.NewInt64PrimaryKeys([]UniqueID{1}))
+		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, storage.NewInt64PrimaryKeys([]UniqueID{2}))
+
+		sr.splitFlushedSegments(map[UniqueID]int64{3: 8, 4: 12}, 1, 0, []UniqueID{1, 2}, "channel")
+		assert.True(t, sr.hasSegment(3, true))
+		assert.True(t, sr.hasSegment(4, true))
+		assert.False(t, sr.hasSegment(1, true))
+		assert.False(t, sr.hasSegment(2, true))
+
+		// a pk of any compacted segment may be in every new segment
+		for _, seg := range sr.filterSegments("channel", 0) {
+			assert.True(t, storage.TestPKInBloomFilter(seg.pkFilter, storage.NewInt64PrimaryKey(1)))
+			assert.True(t, storage.TestPKInBloomFilter(seg.pkFilter, storage.NewInt64PrimaryKey(2)))
+		}
+
+		to2from := sr.listCompactedSegmentIDs()
+		assert.ElementsMatch(t, []UniqueID{1, 2}, to2from[3])
+		assert.ElementsMatch(t, []UniqueID{1, 2}, to2from[4])
+
+		// mismatched collection
+		sr.splitFlushedSegments(map[UniqueID]int64{5: 1}, 2, 0, []UniqueID{3}, "channel")
+		assert.False(t, sr.hasSegment(5, true))
+	})
+
 }
 func TestInnerFunctionSegment(t *testing.T) {
 	rc := &RootCoordFactory{}
 	collID := UniqueID(1)
-	replica, err := newReplica(context.Background(), rc, collID)
+	replica, err := newReplica(context.Background(), rc, newTestChunkManager(t), collID)
 	assert.Nil(t, err)
-	replica.minIOKV = &mockMinioKV{}
+	replica.chunkManager = &mockDataCm{}
 	assert.False(t, replica.hasSegment(0, true))
 	assert.False(t, replica.hasSegment(0, false))
 
@@ -740,8 +790,6 @@ func TestInnerFunctionSegment(t *testing.T) {
 func TestSegmentReplica_UpdatePKRange(t *testing.T) {
 	seg := &Segment{
 		pkFilter: bloom.NewWithEstimates(100000, 0.005),
-		maxPK:    math.MinInt64,
-		minPK:    math.MaxInt64,
 	}
 
 	cases := make([]int64, 0, 100)
@@ -750,10 +798,11 @@ func TestSegmentReplica_UpdatePKRange(t *testing.T) {
 	}
 	buf := make([]byte, 8)
 	for _, c := range cases {
-		seg.updatePKRange([]int64{c})
+		pk := storage.NewInt64PrimaryKey(c)
+		seg.updatePKRange([]storage.PrimaryKey{pk})
 
-		assert.LessOrEqual(t, seg.minPK, c)
-		assert.GreaterOrEqual(t, seg.maxPK, c)
+		assert.True(t, seg.minPK.LE(pk))
+		assert.True(t, seg.maxPK.GE(pk))
 
 		common.Endian.PutUint64(buf, uint64(c))
 		assert.True(t, seg.pkFilter.Test(buf))
@@ -770,9 +819,9 @@ func TestReplica_UpdatePKRange(t *testing.T) {
 	cpPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(10)}
 	cp := &segmentCheckPoint{int64(10), *cpPos}
 
-	replica, err := newReplica(context.Background(), rc, collID)
+	replica, err := newReplica(context.Background(), rc, newTestChunkManager(t), collID)
 	assert.Nil(t, err)
-	replica.minIOKV = &mockMinioKV{}
+	replica.chunkManager = &mockDataCm{}
 
 	err = replica.addNewSegment(1, collID, partID, chanName, startPos, endPos)
 	assert.Nil(t, err)
@@ -788,14 +837,15 @@ func TestReplica_UpdatePKRange(t *testing.T) {
 	}
 	buf := make([]byte, 8)
 	for _, c := range cases {
-		replica.updateSegmentPKRange(1, []int64{c}) // new segment
-		replica.updateSegmentPKRange(2, []int64{c}) // normal segment
-		replica.updateSegmentPKRange(3, []int64{c}) // non-exist segment
-
-		assert.LessOrEqual(t, segNew.minPK, c)
-		assert.GreaterOrEqual(t, segNew.maxPK, c)
-		assert.LessOrEqual(t, segNormal.minPK, c)
-		assert.GreaterOrEqual(t, segNormal.maxPK, c)
+		pk := storage.NewInt64PrimaryKey(c)
+		replica.updateSegmentPKRange(1, []storage.PrimaryKey{pk}) // new segment
+		replica.updateSegmentPKRange(2, []storage.PrimaryKey{pk}) // normal segment
+		replica.updateSegmentPKRange(3, []storage.PrimaryKey{pk}) // non-exist segment
+
+		assert.True(t, segNew.minPK.LE(pk))
+		assert.True(t, segNew.maxPK.GE(pk))
+		assert.True(t, segNormal.minPK.LE(pk))
+		assert.True(t, segNormal.maxPK.GE(pk))
 
 		common.Endian.PutUint64(buf, uint64(c))
 		assert.True(t, segNew.pkFilter.Test(buf))
@@ -327,3 +327,28 @@ func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateR
 func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	return s.dataCoord.DropVirtualChannel(ctx, req)
 }
+
+// Import splits the files into import tasks and distributes them to DataNodes
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return s.dataCoord.Import(ctx, req)
+}
+
+// GetImportState gets the state of an import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.dataCoord.GetImportState(ctx, req)
+}
+
+// ReportImport reports the progress and the result of an import task
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return s.dataCoord.ReportImport(ctx, req)
+}
+
+// PinCollection keeps the segments of a collection from being compacted and garbage collected
+func (s *Server) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error) {
+	return s.dataCoord.PinCollection(ctx, req)
+}
+
+// UnpinCollection releases a pin of PinCollection
+func (s *Server) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UnpinCollection(ctx, req)
+}
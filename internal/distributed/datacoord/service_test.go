@@ -55,6 +55,9 @@ type MockDataCoord struct {
 	watchChannelsResp    *datapb.WatchChannelsResponse
 	getFlushStateResp    *milvuspb.GetFlushStateResponse
 	dropVChanResp        *datapb.DropVirtualChannelResponse
+	importResp           *milvuspb.ImportResponse
+	importStateResp      *milvuspb.GetImportStateResponse
+	pinResp              *datapb.PinCollectionResponse
 }
 
 func (m *MockDataCoord) Init() error {
@@ -164,6 +167,26 @@ func (m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.Drop
 	return m.dropVChanResp, m.err
 }
 
+func (m *MockDataCoord) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return m.importResp, m.err
+}
+
+func (m *MockDataCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return m.importStateResp, m.err
+}
+
+func (m *MockDataCoord) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error) {
+	return m.pinResp, m.err
+}
+
+func (m *MockDataCoord) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -338,6 +361,51 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("Import", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			importResp: &milvuspb.ImportResponse{},
+		}
+		resp, err := server.Import(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetImportState", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			importStateResp: &milvuspb.GetImportStateResponse{},
+		}
+		resp, err := server.GetImportState(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportImport", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.ReportImport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("PinCollection", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			pinResp: &datapb.PinCollectionResponse{},
+		}
+		resp, err := server.PinCollection(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("UnpinCollection", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.UnpinCollection(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
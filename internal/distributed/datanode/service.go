@@ -335,3 +335,8 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 func (s *Server) Compaction(ctx context.Context, request *datapb.CompactionPlan) (*commonpb.Status, error) {
 	return s.datanode.Compaction(ctx, request)
 }
+
+// Import starts to import the files of the task in background
+func (s *Server) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
+	return s.datanode.Import(ctx, req)
+}
@@ -385,6 +385,16 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	return s.queryCoord.LoadBalance(ctx, req)
 }
 
+// GetReplicas gets the available replicas of a collection from QueryCoord.
+func (s *Server) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return s.queryCoord.GetReplicas(ctx, req)
+}
+
+// GetShardLeaders gets the query nodes serving the shards of a collection from QueryCoord.
+func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return s.queryCoord.GetShardLeaders(ctx, req)
+}
+
 // GetMetrics gets the metrics information of QueryCoord.
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
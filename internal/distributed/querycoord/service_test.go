@@ -47,6 +47,8 @@ type MockQueryCoord struct {
 	partResp     *querypb.GetPartitionStatesResponse
 	channelResp  *querypb.CreateQueryChannelResponse
 	infoResp     *querypb.GetSegmentInfoResponse
+	replicasResp *querypb.GetReplicasResponse
+	leadersResp  *querypb.GetShardLeadersResponse
 	metricResp   *milvuspb.GetMetricsResponse
 }
 
@@ -138,6 +140,14 @@ func (m *MockQueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalan
 	return m.status, m.err
 }
 
+func (m *MockQueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return m.replicasResp, m.err
+}
+
+func (m *MockQueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return m.leadersResp, m.err
+}
+
 func (m *MockQueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.metricResp, m.err
 }
@@ -261,6 +271,8 @@ func Test_NewServer(t *testing.T) {
 		partResp:     &querypb.GetPartitionStatesResponse{},
 		channelResp:  &querypb.CreateQueryChannelResponse{},
 		infoResp:     &querypb.GetSegmentInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		replicasResp: &querypb.GetReplicasResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		leadersResp:  &querypb.GetShardLeadersResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 		metricResp:   &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 	}
 
@@ -374,6 +386,20 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("GetReplicas", func(t *testing.T) {
+		req := &querypb.GetReplicasRequest{}
+		resp, err := server.GetReplicas(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("GetShardLeaders", func(t *testing.T) {
+		req := &querypb.GetShardLeadersRequest{}
+		resp, err := server.GetShardLeaders(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
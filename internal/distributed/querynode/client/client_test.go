@@ -97,6 +97,12 @@ func Test_NewClient(t *testing.T) {
 
 		r13, err := client.WatchDeltaChannels(ctx, nil)
 		retCheck(retNotNil, r13, err)
+
+		r14, err := client.Search(ctx, nil)
+		retCheck(retNotNil, r14, err)
+
+		r15, err := client.Query(ctx, nil)
+		retCheck(retNotNil, r15, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
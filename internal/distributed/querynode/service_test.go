@@ -43,6 +43,8 @@ type MockQueryNode struct {
 	strResp    *milvuspb.StringResponse
 	infoResp   *querypb.GetSegmentInfoResponse
 	metricResp *milvuspb.GetMetricsResponse
+	searchResp *internalpb.SearchResults
+	queryResp  *internalpb.RetrieveResults
 }
 
 func (m *MockQueryNode) Init() error {
@@ -109,6 +111,14 @@ func (m *MockQueryNode) GetSegmentInfo(ctx context.Context, req *querypb.GetSegm
 	return m.infoResp, m.err
 }
 
+func (m *MockQueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	return m.searchResp, m.err
+}
+
+func (m *MockQueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	return m.queryResp, m.err
+}
+
 func (m *MockQueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.metricResp, m.err
 }
@@ -213,6 +223,8 @@ func Test_NewServer(t *testing.T) {
 		strResp:    &milvuspb.StringResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 		infoResp:   &querypb.GetSegmentInfoResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 		metricResp: &milvuspb.GetMetricsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		searchResp: &internalpb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
+		queryResp:  &internalpb.RetrieveResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}},
 	}
 	server.querynode = mqn
 
@@ -301,6 +313,20 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	t.Run("Search", func(t *testing.T) {
+		req := &querypb.SearchRequest{}
+		resp, err := server.Search(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		req := &querypb.QueryRequest{}
+		resp, err := server.Query(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
@@ -29,6 +29,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -41,6 +42,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -57,6 +60,14 @@ func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *
 	return p.invalidateCollectionMetaCache(ctx, request)
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (p *proxyMock) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func TestGrpcService(t *testing.T) {
 	const (
 		dbName    = "testDB"
@@ -293,6 +304,66 @@ func TestGrpcService(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		req := &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		}
+		status, err := cli.CreateDatabase(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := cli.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{common.DefaultDBName, dbName}, rsp.DbNames)
+	})
+
+	t.Run("credential", func(t *testing.T) {
+		status, err := cli.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateCredential,
+			},
+			Username: "user1",
+			Password: crypto.Base64Encode("password"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		credRsp, err := cli.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetCredential,
+			},
+			Username: "user1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, credRsp.Status.ErrorCode)
+		assert.True(t, crypto.PasswordVerify("password", credRsp.EncryptedPassword))
+
+		usersRsp, err := cli.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListCredUsernames,
+			},
+		})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{util.UserRoot, "user1"}, usersRsp.Usernames)
+
+		status, err = cli.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DeleteCredential,
+			},
+			Username: "user1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -331,7 +402,7 @@ func TestGrpcService(t *testing.T) {
 
 		status, err := cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
-		colls, err := core.MetaTable.ListCollections(0)
+		colls, err := core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 
 		assert.Equal(t, 1, len(colls))
@@ -365,7 +436,7 @@ func TestGrpcService(t *testing.T) {
 		status, err = cli.CreateCollection(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		colls, err = core.MetaTable.ListCollections(0)
+		colls, err = core.MetaTable.ListCollections(dbName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(colls))
 		_, has = colls[collName2]
@@ -395,7 +466,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 110,
 				SourceID:  110,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.HasCollection(ctx, req)
@@ -410,7 +481,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -426,7 +497,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 111,
 				SourceID:  111,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: "testColl2",
 		}
 		rsp, err = cli.HasCollection(ctx, req)
@@ -436,7 +507,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -445,7 +516,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 120,
 				SourceID:  120,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		rsp, err := cli.DescribeCollection(ctx, req)
@@ -463,7 +534,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 130,
 				SourceID:  130,
 			},
-			DbName: "testDb",
+			DbName: dbName,
 		}
 		rsp, err := cli.ShowCollections(ctx, req)
 		assert.Nil(t, err)
@@ -487,7 +558,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -515,7 +586,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -524,7 +595,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 160,
 				SourceID:  160,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 			CollectionID:   coll.ID,
 		}
@@ -536,7 +607,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -581,13 +652,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -603,7 +674,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -643,7 +714,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -704,7 +775,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.CommonCfg.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.CommonCfg.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, rootcoord.Params.CommonCfg.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -732,7 +803,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -749,7 +820,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 230,
 				SourceID:  230,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 
@@ -769,7 +840,7 @@ func TestGrpcService(t *testing.T) {
 				Timestamp: 231,
 				SourceID:  231,
 			},
-			DbName:         "testDb",
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		status, err = cli.DropCollection(ctx, req)
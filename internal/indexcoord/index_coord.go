@@ -27,7 +27,6 @@ import (
 	"syscall"
 	"time"
 
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
@@ -35,14 +34,15 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -80,8 +80,8 @@ type IndexCoord struct {
 
 	idAllocator *allocator.GlobalIDAllocator
 
-	etcdCli *clientv3.Client
-	kv      kv.BaseKV
+	etcdCli      *clientv3.Client
+	chunkManager storage.ChunkManager
 
 	metaTable   *metaTable
 	nodeManager *NodeManager
@@ -139,7 +139,7 @@ func (i *IndexCoord) Register() error {
 }
 
 func (i *IndexCoord) initSession() error {
-	i.session = sessionutil.NewSession(i.loopCtx, Params.BaseParams.MetaRootPath, i.etcdCli)
+	i.session = metastore.NewSession(i.loopCtx, i.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if i.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -216,24 +216,15 @@ func (i *IndexCoord) Init() error {
 			return
 		}
 
-		option := &miniokv.Option{
-			Address:           Params.MinioCfg.Address,
-			AccessKeyID:       Params.MinioCfg.AccessKeyID,
-			SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-			UseSSL:            Params.MinioCfg.UseSSL,
-			BucketName:        Params.MinioCfg.BucketName,
-			CreateBucket:      true,
-		}
-
-		i.kv, err = miniokv.NewMinIOKV(i.loopCtx, option)
+		i.chunkManager, err = storage.NewChunkManager(i.loopCtx, &Params)
 		if err != nil {
-			log.Error("IndexCoord new minio kv failed", zap.Error(err))
+			log.Error("IndexCoord new chunk manager failed", zap.Error(err))
 			initErr = err
 			return
 		}
-		log.Debug("IndexCoord new minio kv success")
+		log.Debug("IndexCoord new chunk manager success")
 
-		i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.kv, i.metaTable)
+		i.sched, err = NewTaskScheduler(i.loopCtx, i.idAllocator, i.chunkManager, i.metaTable)
 		if err != nil {
 			log.Error("IndexCoord new task scheduler failed", zap.Error(err))
 			initErr = err
@@ -712,7 +703,7 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 					unusedIndexFilePathPrefix := Params.IndexCoordCfg.IndexStorageRootPath + "/" + strconv.Itoa(int(meta.indexMeta.IndexBuildID))
 					log.Debug("IndexCoord recycleUnusedIndexFiles",
 						zap.Int64("Recycle the index files for deleted index with indexBuildID", meta.indexMeta.IndexBuildID))
-					if err := i.kv.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
+					if err := i.chunkManager.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
 						log.Error("IndexCoord recycleUnusedIndexFiles Remove index files failed",
 							zap.Bool("MarkDeleted", true), zap.Error(err))
 					}
@@ -724,7 +715,7 @@ func (i *IndexCoord) recycleUnusedIndexFiles() {
 						zap.Int64("Recycle the low version index files of the index with indexBuildID", meta.indexMeta.IndexBuildID))
 					for j := 1; j < int(meta.indexMeta.Version); j++ {
 						unusedIndexFilePathPrefix := Params.IndexCoordCfg.IndexStorageRootPath + "/" + strconv.Itoa(int(meta.indexMeta.IndexBuildID)) + "/" + strconv.Itoa(j)
-						if err := i.kv.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
+						if err := i.chunkManager.RemoveWithPrefix(unusedIndexFilePathPrefix); err != nil {
 							log.Error("IndexCoord recycleUnusedIndexFiles Remove index files failed",
 								zap.Bool("MarkDeleted", false), zap.Error(err))
 						}
@@ -809,7 +800,7 @@ func (i *IndexCoord) watchMetaLoop() {
 				log.Debug("IndexCoord watchMetaLoop", zap.Any("event.Key", event.Kv.Key),
 					zap.Any("event.V", indexMeta), zap.Int64("IndexBuildID", indexBuildID), zap.Error(err))
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					reload := i.metaTable.LoadMetaFromETCD(indexBuildID, eventRevision)
 					log.Debug("IndexCoord watchMetaLoop PUT", zap.Int64("IndexBuildID", indexBuildID), zap.Bool("reload", reload))
 					if reload {
@@ -818,7 +809,7 @@ func (i *IndexCoord) watchMetaLoop() {
 							zap.Int64("The version of the task", indexMeta.Version))
 						i.nodeManager.pq.IncPriority(indexMeta.NodeID, -1)
 					}
-				case mvccpb.DELETE:
+				case kv.EventTypeDelete:
 					log.Debug("IndexCoord watchMetaLoop DELETE", zap.Int64("The meta has been deleted of indexBuildID", indexBuildID))
 				}
 			}
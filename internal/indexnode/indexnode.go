@@ -40,15 +40,15 @@ import (
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/common"
-	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -81,8 +81,8 @@ type IndexNode struct {
 
 	once sync.Once
 
-	kv      kv.BaseKV
-	session *sessionutil.Session
+	chunkManager storage.ChunkManager
+	session      *sessionutil.Session
 
 	// Add callback functions at different stages
 	startCallbacks []func()
@@ -107,7 +107,7 @@ func NewIndexNode(ctx context.Context) (*IndexNode, error) {
 		loopCancel: cancel,
 	}
 	b.UpdateStateCode(internalpb.StateCode_Abnormal)
-	sc, err := NewTaskScheduler(b.loopCtx, b.kv)
+	sc, err := NewTaskScheduler(b.loopCtx, b.chunkManager)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +146,7 @@ func (i *IndexNode) initKnowhere() {
 }
 
 func (i *IndexNode) initSession() error {
-	i.session = sessionutil.NewSession(i.loopCtx, Params.BaseParams.MetaRootPath, i.etcdCli)
+	i.session = metastore.NewSession(i.loopCtx, i.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if i.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -175,24 +175,16 @@ func (i *IndexNode) Init() error {
 		etcdKV := etcdkv.NewEtcdKV(i.etcdCli, Params.BaseParams.MetaRootPath)
 		i.etcdKV = etcdKV
 
-		option := &miniokv.Option{
-			Address:           Params.MinioCfg.Address,
-			AccessKeyID:       Params.MinioCfg.AccessKeyID,
-			SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-			UseSSL:            Params.MinioCfg.UseSSL,
-			BucketName:        Params.MinioCfg.BucketName,
-			CreateBucket:      true,
-		}
-		kv, err := miniokv.NewMinIOKV(i.loopCtx, option)
+		chunkManager, err := storage.NewChunkManager(i.loopCtx, &Params)
 		if err != nil {
-			log.Error("IndexNode NewMinIOKV failed", zap.Error(err))
+			log.Error("IndexNode NewChunkManager failed", zap.Error(err))
 			initErr = err
 			return
 		}
 
-		i.kv = kv
+		i.chunkManager = chunkManager
 
-		log.Debug("IndexNode NewMinIOKV succeeded")
+		log.Debug("IndexNode NewChunkManager succeeded")
 		i.closer = trace.InitTracing("index_node")
 
 		i.initKnowhere()
@@ -286,7 +278,7 @@ func (i *IndexNode) CreateIndex(ctx context.Context, request *indexpb.CreateInde
 			done: make(chan error),
 		},
 		req:            request,
-		kv:             i.kv,
+		chunkManager:   i.chunkManager,
 		etcdKV:         i.etcdKV,
 		nodeID:         Params.IndexNodeCfg.NodeID,
 		serializedSize: 0,
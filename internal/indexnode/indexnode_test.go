@@ -118,14 +118,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -183,10 +183,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				err = in.kv.Remove(k)
+				err = in.chunkManager.Remove(k)
 				assert.Nil(t, err)
 			}
 		}()
@@ -233,14 +233,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(binaryVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -294,10 +294,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				err = in.kv.Remove(k)
+				err = in.chunkManager.Remove(k)
 				assert.Nil(t, err)
 			}
 		}()
@@ -345,14 +345,14 @@ func TestIndexNode(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -411,10 +411,10 @@ func TestIndexNode(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				err = in.kv.Remove(k)
+				err = in.chunkManager.Remove(k)
 				assert.Nil(t, err)
 			}
 		}()
@@ -529,14 +529,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta := &indexpb.IndexMeta{
@@ -598,10 +598,10 @@ func TestCreateIndexFailed(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				err = in.kv.Remove(k)
+				err = in.chunkManager.Remove(k)
 				assert.Nil(t, err)
 			}
 		}()
@@ -647,14 +647,14 @@ func TestCreateIndexFailed(t *testing.T) {
 		}
 		binLogs, _, err := insertCodec.Serialize(999, 888, &insertData)
 		assert.Nil(t, err)
-		kvs := make(map[string]string, len(binLogs))
+		kvs := make(map[string][]byte, len(binLogs))
 		paths := make([]string, 0, len(binLogs))
 		for i, blob := range binLogs {
 			key := path.Join(floatVectorBinlogPath, strconv.Itoa(i))
 			paths = append(paths, key)
-			kvs[key] = string(blob.Value[:])
+			kvs[key] = blob.Value[:]
 		}
-		err = in.kv.MultiSave(kvs)
+		err = in.chunkManager.MultiWrite(kvs)
 		assert.Nil(t, err)
 
 		indexMeta2 := &indexpb.IndexMeta{
@@ -717,10 +717,10 @@ func TestCreateIndexFailed(t *testing.T) {
 			err = proto.Unmarshal([]byte(strValue), &indexMetaTmp)
 			assert.Nil(t, err)
 		}
-		defer in.kv.MultiRemove(indexMetaTmp.IndexFilePaths)
+		defer in.chunkManager.MultiRemove(indexMetaTmp.IndexFilePaths)
 		defer func() {
 			for k := range kvs {
-				err = in.kv.Remove(k)
+				err = in.chunkManager.Remove(k)
 				assert.Nil(t, err)
 			}
 		}()
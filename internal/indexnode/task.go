@@ -27,9 +27,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/golang/protobuf/proto"
-	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -104,7 +104,7 @@ func (bt *BaseTask) Notify(err error) {
 type IndexBuildTask struct {
 	BaseTask
 	index          Index
-	kv             kv.BaseKV
+	chunkManager   storage.ChunkManager
 	etcdKV         *etcdkv.EtcdKV
 	savePaths      []string
 	req            *indexpb.CreateIndexRequest
@@ -290,15 +290,8 @@ func (it *IndexBuildTask) executePrepareParams(ctx context.Context) error {
 }
 
 func (it *IndexBuildTask) executeStepLoad(ctx context.Context) (storage.FieldID, storage.FieldData, error) {
-	getValueByPath := func(path string) ([]byte, error) {
-		data, err := it.kv.Load(path)
-		if err != nil {
-			return nil, err
-		}
-		return []byte(data), nil
-	}
 	getBlobByPath := func(path string) (*Blob, error) {
-		value, err := getValueByPath(path)
+		value, err := it.chunkManager.Read(path)
 		if err != nil {
 			return nil, err
 		}
@@ -398,7 +391,7 @@ func (it *IndexBuildTask) executeStepBuild(ctx context.Context) ([]*storage.Blob
 		if !fOk && !bOk {
 			return nil, errors.New("we expect FloatVectorFieldData or BinaryVectorFieldData")
 		}
-		it.tr.Record("build index done")
+		metrics.IndexNodeBuildIndexLatency.Observe(float64(it.tr.Record("build index done").Milliseconds()))
 	}
 
 	indexBlobs, err := it.index.Serialize()
@@ -470,7 +463,7 @@ func (it *IndexBuildTask) executeSave(ctx context.Context, blobs []*storage.Blob
 					zap.Any("indexMeta.Version", indexMeta.Version))
 				return errors.New("This task has been reassigned, check indexMeta.version and request ")
 			}
-			return it.kv.Save(savePath, string(blob.Value))
+			return it.chunkManager.Write(savePath, blob.Value)
 		}
 		err := retry.Do(ctx, saveIndexFileFn, retry.Attempts(5))
 		if err != nil {
@@ -0,0 +1,309 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedkv
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// implementation assertion
+var _ kv.MetaKv = (*MetaKV)(nil)
+
+// MetaKV implements MetaKv interface with the keys of a Store under rootPath. It behaves as EtcdKV does.
+type MetaKV struct {
+	store    *Store
+	rootPath string
+}
+
+// NewMetaKV creates a MetaKV with the keys of store under rootPath.
+func NewMetaKV(store *Store, rootPath string) *MetaKV {
+	return &MetaKV{
+		store:    store,
+		rootPath: rootPath,
+	}
+}
+
+// Close does nothing, the store is shared by the MetaKVs over it and closed by its owner.
+func (kv *MetaKV) Close() {
+	log.Debug("embedded meta kv closed", zap.String("path", kv.rootPath))
+}
+
+// GetPath returns the path of the key.
+func (kv *MetaKV) GetPath(key string) string {
+	return path.Join(kv.rootPath, key)
+}
+
+// Load returns value of the key.
+func (kv *MetaKV) Load(key string) (string, error) {
+	key = kv.GetPath(key)
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	var r *record
+	err := kv.store.view(func(t *txn) (err error) {
+		r, err = t.get(key)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	if r == nil {
+		return "", fmt.Errorf("there is no value on key = %s", key)
+	}
+	return r.Value, nil
+}
+
+// MultiLoad gets the values of the keys, an error is returned along with the values if any of the keys doesn't exist.
+func (kv *MetaKV) MultiLoad(keys []string) ([]string, error) {
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	result := make([]string, 0, len(keys))
+	invalid := make([]string, 0, len(keys))
+	err := kv.store.view(func(t *txn) error {
+		for _, key := range keys {
+			r, err := t.get(kv.GetPath(key))
+			if err != nil {
+				return err
+			}
+			if r == nil {
+				invalid = append(invalid, key)
+				result = append(result, "")
+				continue
+			}
+			result = append(result, r.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		return []string{}, err
+	}
+	if len(invalid) != 0 {
+		log.Warn("MultiLoad: there are invalid keys", zap.Strings("keys", invalid))
+		return result, fmt.Errorf("there are invalid keys: %s", invalid)
+	}
+	return result, nil
+}
+
+// loadWithPrefix returns the keys with the given key prefix, their records and the revision of the store.
+func (kv *MetaKV) loadWithPrefix(key string) ([]string, []*record, int64, error) {
+	key = kv.GetPath(key)
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	var keys []string
+	var records []*record
+	err := kv.store.view(func(t *txn) (err error) {
+		keys, records, err = t.loadWithPrefix(key)
+		return err
+	})
+	return keys, records, kv.store.revision, err
+}
+
+// LoadWithPrefix returns all the keys and values with the given key prefix.
+func (kv *MetaKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	keys, values, _, err := kv.LoadWithRevision(key)
+	return keys, values, err
+}
+
+// LoadWithPrefix2 returns all the the keys,values and key versions with the given key prefix.
+func (kv *MetaKV) LoadWithPrefix2(key string) ([]string, []string, []int64, error) {
+	keys, records, _, err := kv.loadWithPrefix(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	values := make([]string, 0, len(records))
+	versions := make([]int64, 0, len(records))
+	for _, r := range records {
+		values = append(values, r.Value)
+		versions = append(versions, r.Version)
+	}
+	return keys, values, versions, nil
+}
+
+// LoadWithRevision returns keys, values and revision with given key prefix.
+func (kv *MetaKV) LoadWithRevision(key string) ([]string, []string, int64, error) {
+	keys, records, revision, err := kv.loadWithPrefix(key)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	values := make([]string, 0, len(records))
+	for _, r := range records {
+		values = append(values, r.Value)
+	}
+	return keys, values, revision, nil
+}
+
+func (kv *MetaKV) update(fn func(t *txn) error) error {
+	kv.store.mu.Lock()
+	defer kv.store.mu.Unlock()
+	return kv.store.update(fn)
+}
+
+// Save saves the key-value pair.
+func (kv *MetaKV) Save(key, value string) error {
+	return kv.SaveWithLease(key, value, 0)
+}
+
+// SaveWithLease saves the key-value pair attached to the lease.
+func (kv *MetaKV) SaveWithLease(key, value string, id kv.LeaseID) error {
+	return kv.update(func(t *txn) error {
+		return t.put(kv.GetPath(key), value, id)
+	})
+}
+
+// MultiSave saves the key-value pairs in a transaction.
+func (kv *MetaKV) MultiSave(kvs map[string]string) error {
+	return kv.MultiSaveAndRemove(kvs, nil)
+}
+
+// RemoveWithPrefix removes the keys with given prefix.
+func (kv *MetaKV) RemoveWithPrefix(prefix string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, []string{prefix})
+}
+
+// Remove removes the key.
+func (kv *MetaKV) Remove(key string) error {
+	return kv.MultiRemove([]string{key})
+}
+
+// MultiRemove removes the keys in a transaction.
+func (kv *MetaKV) MultiRemove(keys []string) error {
+	return kv.MultiSaveAndRemove(nil, keys)
+}
+
+// MultiSaveAndRemove saves the key-value pairs and removes the keys in a transaction.
+func (kv *MetaKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	return kv.update(func(t *txn) error {
+		for key, value := range saves {
+			if err := t.put(kv.GetPath(key), value, 0); err != nil {
+				return err
+			}
+		}
+		for _, key := range removals {
+			if err := t.deleteKey(kv.GetPath(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MultiRemoveWithPrefix removes the keys with given prefixes in a transaction.
+func (kv *MetaKV) MultiRemoveWithPrefix(keys []string) error {
+	return kv.MultiSaveAndRemoveWithPrefix(nil, keys)
+}
+
+// MultiSaveAndRemoveWithPrefix saves kv in @saves and removes the keys with given prefix in @removals.
+func (kv *MetaKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string) error {
+	return kv.update(func(t *txn) error {
+		for key, value := range saves {
+			if err := t.put(kv.GetPath(key), value, 0); err != nil {
+				return err
+			}
+		}
+		for _, prefix := range removals {
+			if err := t.deleteWithPrefix(kv.GetPath(prefix)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Watch starts watching a key, returns a watch channel.
+func (kv *MetaKV) Watch(key string) kv.WatchChan {
+	return kv.store.watch(kv.GetPath(key), false, 0, false, true)
+}
+
+// WatchWithPrefix starts watching a key with prefix, returns a watch channel.
+func (kv *MetaKV) WatchWithPrefix(key string) kv.WatchChan {
+	return kv.store.watch(kv.GetPath(key), true, 0, false, true)
+}
+
+// WatchWithRevision starts watching a key with prefix from revision, returns a watch channel. The events carry the
+// previous key-values.
+func (kv *MetaKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
+	return kv.store.watch(kv.GetPath(key), true, revision, true, false)
+}
+
+// Grant creates a new lease, which expires in ttl seconds if it's not kept alive.
+func (kv *MetaKV) Grant(ttl int64) (id kv.LeaseID, err error) {
+	return kv.store.grant(ttl)
+}
+
+// KeepAlive keeps the lease alive with leaseID until ctx is done.
+func (kv *MetaKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	return kv.store.keepAlive(ctx, id)
+}
+
+// Revoke revokes the lease, the keys attached to it are removed.
+func (kv *MetaKV) Revoke(id kv.LeaseID) error {
+	return kv.store.revoke(id)
+}
+
+// CompareValueAndSwap compares the existing value with compare, and if they are
+// equal, the target is stored.
+func (kv *MetaKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) error {
+	putOpts := newPutOptions(opts)
+	return kv.update(func(t *txn) error {
+		r, err := t.get(kv.GetPath(key))
+		if err != nil {
+			return err
+		}
+		if r == nil || r.Value != value {
+			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s: %w", key, errCompareFailed)
+		}
+		return t.put(kv.GetPath(key), target, putOpts.Lease)
+	})
+}
+
+// CompareVersionAndSwap compares the existing key-value's version with version, and if
+// they are equal, the target is stored.
+func (kv *MetaKV) CompareVersionAndSwap(key string, source int64, target string, opts ...kv.PutOption) error {
+	putOpts := newPutOptions(opts)
+	return kv.update(func(t *txn) error {
+		r, err := t.get(kv.GetPath(key))
+		if err != nil {
+			return err
+		}
+		var version int64
+		if r != nil {
+			version = r.Version
+		}
+		if version != source {
+			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s,"+
+				" source version: %d, target version: %s: %w", key, source, target, errCompareFailed)
+		}
+		return t.put(kv.GetPath(key), target, putOpts.Lease)
+	})
+}
+
+// errCompareFailed is kv.ErrCompareFailed, the kv package is shadowed by the receivers of the methods.
+var errCompareFailed = kv.ErrCompareFailed
+
+func newPutOptions(opts []kv.PutOption) *kv.PutOptions {
+	putOpts := &kv.PutOptions{}
+	for _, opt := range opts {
+		opt(putOpts)
+	}
+	return putOpts
+}
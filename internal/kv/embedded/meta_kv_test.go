@@ -0,0 +1,335 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedkv_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	embeddedkv "github.com/milvus-io/milvus/internal/kv/embedded"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+)
+
+func newStore(t *testing.T, backend kv.TxnKV) *embeddedkv.Store {
+	store, err := embeddedkv.NewStore(backend)
+	require.NoError(t, err)
+	return store
+}
+
+func TestMetaKV_Load(te *testing.T) {
+	store := newStore(te, memkv.NewMemoryKV())
+	defer store.Close()
+
+	te.Run("MetaKV LoadWithRevision", func(t *testing.T) {
+		rootPath := "/embedded/test/root/LoadWithRevision"
+		metaKV := embeddedkv.NewMetaKV(store, rootPath)
+
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
+
+		prepareKV := []struct {
+			inKey   string
+			inValue string
+		}{
+			{"a", "a_version1"},
+			{"b", "b_version2"},
+			{"a", "a_version3"},
+			{"c", "c_version4"},
+			{"a/suba", "a_version5"},
+		}
+
+		for _, test := range prepareKV {
+			err := metaKV.Save(test.inKey, test.inValue)
+			require.NoError(t, err)
+		}
+
+		loadWithRevisionTests := []struct {
+			inKey string
+
+			expectedKeyNo  int
+			expectedValues []string
+		}{
+			{"a", 2, []string{"a_version3", "a_version5"}},
+			{"b", 1, []string{"b_version2"}},
+			{"c", 1, []string{"c_version4"}},
+		}
+
+		for _, test := range loadWithRevisionTests {
+			keys, values, revision, err := metaKV.LoadWithRevision(test.inKey)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedKeyNo, len(keys))
+			assert.ElementsMatch(t, test.expectedValues, values)
+			assert.Equal(t, store.Revision(), revision)
+		}
+
+		_, _, versions, err := metaKV.LoadWithPrefix2("a")
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, versions)
+	})
+
+	te.Run("MetaKV Watch", func(t *testing.T) {
+		rootPath := "/embedded/test/root/watch"
+		metaKV := embeddedkv.NewMetaKV(store, rootPath)
+
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
+
+		ch := metaKV.Watch("x")
+		resp := <-ch
+		assert.True(t, resp.Created)
+
+		prefixCh := metaKV.WatchWithPrefix("x")
+		resp = <-prefixCh
+		assert.True(t, resp.Created)
+
+		err := metaKV.Save("x/a", "1")
+		require.NoError(t, err)
+		err = metaKV.Save("x", "2")
+		require.NoError(t, err)
+		err = metaKV.Remove("x")
+		require.NoError(t, err)
+
+		resp = <-ch
+		require.Equal(t, 1, len(resp.Events))
+		assert.Equal(t, kv.EventTypePut, resp.Events[0].Type)
+		assert.Equal(t, "2", string(resp.Events[0].Kv.Value))
+		resp = <-ch
+		require.Equal(t, 1, len(resp.Events))
+		assert.Equal(t, kv.EventTypeDelete, resp.Events[0].Type)
+		assert.Equal(t, metaKV.GetPath("x"), string(resp.Events[0].Kv.Key))
+		assert.Nil(t, resp.Events[0].PrevKv)
+
+		for _, key := range []string{"x/a", "x", "x"} {
+			resp = <-prefixCh
+			require.Equal(t, 1, len(resp.Events))
+			assert.Equal(t, metaKV.GetPath(key), string(resp.Events[0].Kv.Key))
+		}
+	})
+
+	te.Run("MetaKV Revision", func(t *testing.T) {
+		rootPath := "/embedded/test/root/watch"
+		metaKV := embeddedkv.NewMetaKV(store, rootPath)
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
+
+		revisionTests := []struct {
+			inKey       string
+			fistValue   string
+			secondValue string
+		}{
+			{"a", "v1", "v11"},
+			{"y", "v2", "v22"},
+			{"z", "v3", "v33"},
+		}
+
+		for _, test := range revisionTests {
+			err := metaKV.Save(test.inKey, test.fistValue)
+			require.NoError(t, err)
+
+			_, _, revision, _ := metaKV.LoadWithRevision(test.inKey)
+			ch := metaKV.WatchWithRevision(test.inKey, revision+1)
+
+			err = metaKV.Save(test.inKey, test.secondValue)
+			require.NoError(t, err)
+
+			resp := <-ch
+			assert.Equal(t, 1, len(resp.Events))
+			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
+			assert.Equal(t, test.fistValue, string(resp.Events[0].PrevKv.Value))
+			assert.Equal(t, revision+1, resp.Revision)
+
+			// the events from a past revision are replayed
+			ch = metaKV.WatchWithRevision(test.inKey, revision)
+			resp = <-ch
+			assert.Equal(t, revision, resp.Revision)
+			assert.Equal(t, test.fistValue, string(resp.Events[0].Kv.Value))
+			resp = <-ch
+			assert.Equal(t, revision+1, resp.Revision)
+			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
+		}
+
+		err := metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
+		assert.NoError(t, err)
+
+		value, err := metaKV.Load("a/b/c")
+		assert.NoError(t, err)
+		assert.Equal(t, value, "1")
+
+		err = metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, kv.ErrCompareFailed))
+
+		err = metaKV.CompareValueAndSwap("a/b/c", "1", "2")
+		assert.NoError(t, err)
+
+		err = metaKV.CompareValueAndSwap("a/b/c", "1", "2")
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, kv.ErrCompareFailed))
+
+		err = metaKV.CompareValueAndSwap("a/b/d", "", "2")
+		assert.Error(t, err)
+	})
+
+	te.Run("MetaKV Lease", func(t *testing.T) {
+		rootPath := "/embedded/test/root/lease"
+		metaKV := embeddedkv.NewMetaKV(store, rootPath)
+
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
+
+		leaseID, err := metaKV.Grant(10)
+		assert.NoError(t, err)
+
+		_, err = metaKV.KeepAlive(context.Background(), leaseID)
+		assert.NoError(t, err)
+
+		tests := map[string]string{
+			"a/b":   "v1",
+			"a/b/c": "v2",
+			"x":     "v3",
+		}
+
+		for k, v := range tests {
+			err = metaKV.SaveWithLease(k, v, leaseID)
+			assert.NoError(t, err)
+
+			err = metaKV.SaveWithLease(k, v, kv.LeaseID(999))
+			assert.Error(t, err)
+		}
+
+		err = metaKV.CompareVersionAndSwap("y", 0, "v4", kv.WithLease(leaseID))
+		assert.NoError(t, err)
+
+		ch := metaKV.WatchWithRevision("", store.Revision()+1)
+		err = metaKV.Revoke(leaseID)
+		assert.NoError(t, err)
+		resp := <-ch
+		assert.Equal(t, len(tests)+1, len(resp.Events))
+		for _, e := range resp.Events {
+			assert.Equal(t, kv.EventTypeDelete, e.Type)
+			assert.Equal(t, int64(leaseID), e.PrevKv.Lease)
+		}
+		keys, _, err := metaKV.LoadWithPrefix("")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+
+		err = metaKV.Revoke(leaseID)
+		assert.Error(t, err)
+		_, err = metaKV.KeepAlive(context.Background(), leaseID)
+		assert.Error(t, err)
+		_, err = metaKV.Grant(0)
+		assert.Error(t, err)
+	})
+
+	te.Run("MetaKV Lease Expire", func(t *testing.T) {
+		rootPath := "/embedded/test/root/lease_expire"
+		metaKV := embeddedkv.NewMetaKV(store, rootPath)
+
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
+
+		kept, err := metaKV.Grant(1)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		keepAliveCh, err := metaKV.KeepAlive(ctx, kept)
+		require.NoError(t, err)
+		resp := <-keepAliveCh
+		assert.Equal(t, kept, resp.ID)
+		assert.Equal(t, int64(1), resp.TTL)
+
+		expired, err := metaKV.Grant(1)
+		require.NoError(t, err)
+
+		err = metaKV.SaveWithLease("kept", "v1", kept)
+		require.NoError(t, err)
+		err = metaKV.SaveWithLease("expired", "v2", expired)
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			_, err := metaKV.Load("expired")
+			return err != nil
+		}, 5*time.Second, 100*time.Millisecond)
+		value, err := metaKV.Load("kept")
+		assert.NoError(t, err)
+		assert.Equal(t, "v1", value)
+
+		// the keep alive channel is closed when ctx is done, then the lease expires
+		cancel()
+		assert.Eventually(t, func() bool {
+			_, ok := <-keepAliveCh
+			return !ok
+		}, 5*time.Second, 100*time.Millisecond)
+		assert.Eventually(t, func() bool {
+			_, err := metaKV.Load("kept")
+			return err != nil
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
+
+func TestStore_Reopen(t *testing.T) {
+	backend := memkv.NewMemoryKV()
+	store := newStore(t, backend)
+	metaKV := embeddedkv.NewMetaKV(store, "/embedded/test/root/reopen")
+
+	err := metaKV.Save("a", "v1")
+	require.NoError(t, err)
+	leaseID, err := metaKV.Grant(10)
+	require.NoError(t, err)
+	err = metaKV.SaveWithLease("b", "v2", leaseID)
+	require.NoError(t, err)
+	_, _, revision, err := metaKV.LoadWithRevision("")
+	require.NoError(t, err)
+	store.Close()
+
+	_, err = metaKV.Load("a")
+	assert.Error(t, err)
+	resp := <-metaKV.WatchWithPrefix("")
+	assert.True(t, resp.Canceled)
+
+	// MemoryKV keeps the data after closed, just as rocksdb does
+	store = newStore(t, backend)
+	defer store.Close()
+	metaKV = embeddedkv.NewMetaKV(store, "/embedded/test/root/reopen")
+
+	value, err := metaKV.Load("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	// the keys attached to a lease are removed when the store is reopened
+	_, err = metaKV.Load("b")
+	assert.Error(t, err)
+
+	// the revision keeps growing, the history before reopening is compacted
+	assert.Equal(t, revision+1, store.Revision())
+	resp = <-metaKV.WatchWithRevision("", revision)
+	assert.True(t, resp.Canceled)
+	assert.Equal(t, kv.ErrCompacted, resp.Err)
+	assert.Equal(t, revision+1, resp.CompactRevision)
+
+	ch := metaKV.WatchWithRevision("", store.Revision()+1)
+	err = metaKV.Save("a", "v3")
+	require.NoError(t, err)
+	resp = <-ch
+	require.Equal(t, 1, len(resp.Events))
+	assert.Equal(t, int64(2), resp.Events[0].Kv.Version)
+	assert.Equal(t, "v1", string(resp.Events[0].PrevKv.Value))
+}
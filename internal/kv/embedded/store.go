@@ -0,0 +1,513 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedkv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// dataPrefix is the prefix of the keys in the backend, the records of the keys are saved under it
+	dataPrefix = "data/"
+	// revisionKey is the key in the backend the revision of the store is saved at
+	revisionKey = "revision"
+
+	// maxWatchHistory is the number of the latest revisions kept for the watches from a past revision
+	maxWatchHistory = 10000
+	// keepAliveChanSize is the buffer size of the channel returned by KeepAlive
+	keepAliveChanSize = 16
+)
+
+var (
+	errStoreClosed   = errors.New("embedded kv store is closed")
+	errLeaseNotFound = errors.New("requested lease not found")
+)
+
+// record is what a key is saved as in the backend.
+type record struct {
+	Value          string `json:"value"`
+	CreateRevision int64  `json:"create_revision"`
+	ModRevision    int64  `json:"mod_revision"`
+	Version        int64  `json:"version"`
+	Lease          int64  `json:"lease,omitempty"`
+}
+
+func (r *record) keyValue(key string) *kv.KeyValue {
+	return &kv.KeyValue{
+		Key:            []byte(key),
+		Value:          []byte(r.Value),
+		CreateRevision: r.CreateRevision,
+		ModRevision:    r.ModRevision,
+		Version:        r.Version,
+		Lease:          r.Lease,
+	}
+}
+
+type lease struct {
+	ttl      time.Duration
+	deadline time.Time
+	timer    *time.Timer
+	keys     map[string]struct{}
+	revoked  chan struct{} // closed when the lease is revoked or expires
+}
+
+// history is the events of a revision, kept for the watches from a past revision.
+type history struct {
+	revision int64
+	events   []*kv.Event
+}
+
+// Store implements the watch, lease and revision semantics of etcd on top of a TxnKV backend, such as RocksdbKV,
+// so that the meta of Milvus can be kept without etcd. Each change of the store is a new revision, saved to the
+// backend along with the changed keys in one transaction. Leases and watches are kept in memory: the keys saved
+// with a lease are removed when the store is opened again.
+//
+// A store is shared by all the MetaKVs over it, whatever their root paths are.
+type Store struct {
+	mu       sync.Mutex
+	backend  kv.TxnKV
+	closed   bool
+	revision int64
+
+	leaseSeq int64
+	leases   map[kv.LeaseID]*lease
+
+	watchers        map[*watcher]struct{}
+	histories       []history
+	compactRevision int64 // the events before or at compactRevision are not kept
+}
+
+// NewStore opens a store kept in backend, the store owns backend and closes it in Close.
+func NewStore(backend kv.TxnKV) (*Store, error) {
+	s := &Store{
+		backend:  backend,
+		leases:   make(map[kv.LeaseID]*lease),
+		watchers: make(map[*watcher]struct{}),
+	}
+	value, err := backend.Load(revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	if value != "" {
+		if s.revision, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid revision %s of embedded kv store: %w", value, err)
+		}
+	}
+
+	// the leases are gone with the last process, so are the keys attached to them
+	err = s.update(func(t *txn) error {
+		keys, records, err := t.loadWithPrefix("")
+		if err != nil {
+			return err
+		}
+		for i, r := range records {
+			if r.Lease != int64(kv.NoLease) {
+				t.delete(keys[i], r)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.compactRevision = s.revision
+	log.Debug("embedded kv store opened", zap.Int64("revision", s.revision))
+	return s, nil
+}
+
+// Close stops the leases and the watches of the store, and closes the backend.
+func (s *Store) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for _, l := range s.leases {
+		l.timer.Stop()
+		close(l.revoked)
+	}
+	s.leases = nil
+	for w := range s.watchers {
+		w.stop()
+	}
+	s.watchers = nil
+	s.backend.Close()
+}
+
+// Revision returns the current revision of the store.
+func (s *Store) Revision() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.revision
+}
+
+// txn is the changes of an update, they are committed as a new revision.
+type txn struct {
+	s        *Store
+	revision int64
+	pending  map[string]*record // nil means the key is deleted
+	events   []*kv.Event
+}
+
+func (t *txn) get(key string) (*record, error) {
+	if r, ok := t.pending[key]; ok {
+		return r, nil
+	}
+	value, err := t.s.backend.Load(dataPrefix + key)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRecord(value)
+}
+
+// loadWithPrefix returns the keys with prefix and their records, sorted by key.
+func (t *txn) loadWithPrefix(prefix string) ([]string, []*record, error) {
+	keys, values, err := t.s.backend.LoadWithPrefix(dataPrefix + prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make(map[string]*record, len(keys))
+	for i, key := range keys {
+		r, err := decodeRecord(values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if r != nil {
+			records[strings.TrimPrefix(key, dataPrefix)] = r
+		}
+	}
+	for key, r := range t.pending {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if r == nil {
+			delete(records, key)
+		} else {
+			records[key] = r
+		}
+	}
+
+	retKeys := make([]string, 0, len(records))
+	for key := range records {
+		retKeys = append(retKeys, key)
+	}
+	sort.Strings(retKeys)
+	retRecords := make([]*record, 0, len(retKeys))
+	for _, key := range retKeys {
+		retRecords = append(retRecords, records[key])
+	}
+	return retKeys, retRecords, nil
+}
+
+func (t *txn) put(key, value string, leaseID kv.LeaseID) error {
+	if leaseID != kv.NoLease {
+		if _, ok := t.s.leases[leaseID]; !ok {
+			return fmt.Errorf("failed to put key %s with lease %d: %w", key, leaseID, errLeaseNotFound)
+		}
+	}
+	prev, err := t.get(key)
+	if err != nil {
+		return err
+	}
+	r := &record{
+		Value:          value,
+		CreateRevision: t.revision,
+		ModRevision:    t.revision,
+		Version:        1,
+		Lease:          int64(leaseID),
+	}
+	event := &kv.Event{Type: kv.EventTypePut}
+	if prev != nil {
+		r.CreateRevision = prev.CreateRevision
+		r.Version = prev.Version + 1
+		event.PrevKv = prev.keyValue(key)
+	}
+	event.Kv = r.keyValue(key)
+	t.pending[key] = r
+	t.events = append(t.events, event)
+	return nil
+}
+
+func (t *txn) delete(key string, prev *record) {
+	t.pending[key] = nil
+	t.events = append(t.events, &kv.Event{
+		Type:   kv.EventTypeDelete,
+		Kv:     &kv.KeyValue{Key: []byte(key), ModRevision: t.revision},
+		PrevKv: prev.keyValue(key),
+	})
+}
+
+func (t *txn) deleteKey(key string) error {
+	prev, err := t.get(key)
+	if err != nil || prev == nil {
+		return err
+	}
+	t.delete(key, prev)
+	return nil
+}
+
+func (t *txn) deleteWithPrefix(prefix string) error {
+	keys, records, err := t.loadWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		t.delete(key, records[i])
+	}
+	return nil
+}
+
+// update runs fn in a transaction, the changes fn makes are committed as a new revision if fn succeeds.
+func (s *Store) update(fn func(t *txn) error) error {
+	if s.closed {
+		return errStoreClosed
+	}
+	t := &txn{
+		s:        s,
+		revision: s.revision + 1,
+		pending:  make(map[string]*record),
+	}
+	if err := fn(t); err != nil {
+		return err
+	}
+	if len(t.events) == 0 {
+		return nil
+	}
+
+	saves := map[string]string{revisionKey: strconv.FormatInt(t.revision, 10)}
+	removals := make([]string, 0)
+	for key, r := range t.pending {
+		if r == nil {
+			removals = append(removals, dataPrefix+key)
+			continue
+		}
+		value, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		saves[dataPrefix+key] = string(value)
+	}
+	if err := s.backend.MultiSaveAndRemove(saves, removals); err != nil {
+		return err
+	}
+	s.revision = t.revision
+
+	for _, e := range t.events {
+		if e.PrevKv != nil && e.PrevKv.Lease != int64(kv.NoLease) {
+			if l, ok := s.leases[kv.LeaseID(e.PrevKv.Lease)]; ok {
+				delete(l.keys, string(e.Kv.Key))
+			}
+		}
+	}
+	for _, e := range t.events {
+		if e.Type == kv.EventTypePut && e.Kv.Lease != int64(kv.NoLease) {
+			s.leases[kv.LeaseID(e.Kv.Lease)].keys[string(e.Kv.Key)] = struct{}{}
+		}
+	}
+
+	s.histories = append(s.histories, history{revision: t.revision, events: t.events})
+	if len(s.histories) > maxWatchHistory {
+		s.compactRevision = s.histories[0].revision
+		s.histories = s.histories[1:]
+	}
+	for w := range s.watchers {
+		w.send(t.revision, t.events)
+	}
+	return nil
+}
+
+// view runs fn with a transaction to read the store.
+func (s *Store) view(fn func(t *txn) error) error {
+	if s.closed {
+		return errStoreClosed
+	}
+	return fn(&txn{s: s, pending: make(map[string]*record)})
+}
+
+// grant creates a lease, which expires in ttl seconds if it's not kept alive.
+func (s *Store) grant(ttl int64) (kv.LeaseID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return kv.NoLease, errStoreClosed
+	}
+	if ttl <= 0 {
+		return kv.NoLease, fmt.Errorf("invalid lease ttl %d", ttl)
+	}
+	s.leaseSeq++
+	id := kv.LeaseID(s.leaseSeq)
+	l := &lease{
+		ttl:     time.Duration(ttl) * time.Second,
+		keys:    make(map[string]struct{}),
+		revoked: make(chan struct{}),
+	}
+	l.deadline = time.Now().Add(l.ttl)
+	l.timer = time.AfterFunc(l.ttl, func() {
+		s.expire(id)
+	})
+	s.leases[id] = l
+	return id, nil
+}
+
+// renew keeps the lease alive for another ttl.
+func (s *Store) renew(id kv.LeaseID) (*lease, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errStoreClosed
+	}
+	l, ok := s.leases[id]
+	if !ok {
+		return nil, errLeaseNotFound
+	}
+	l.deadline = time.Now().Add(l.ttl)
+	l.timer.Reset(l.ttl)
+	return l, nil
+}
+
+func (s *Store) expire(id kv.LeaseID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.leases[id]
+	// the lease may be renewed after the timer fires
+	if !ok || time.Now().Before(l.deadline) {
+		return
+	}
+	log.Debug("embedded kv lease expired", zap.Int64("lease", int64(id)))
+	if err := s.revokeLocked(id); err != nil {
+		log.Warn("failed to remove the keys of expired lease", zap.Int64("lease", int64(id)), zap.Error(err))
+	}
+}
+
+// revoke removes the lease and the keys attached to it.
+func (s *Store) revoke(id kv.LeaseID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errStoreClosed
+	}
+	return s.revokeLocked(id)
+}
+
+func (s *Store) revokeLocked(id kv.LeaseID) error {
+	l, ok := s.leases[id]
+	if !ok {
+		return errLeaseNotFound
+	}
+	err := s.update(func(t *txn) error {
+		for key := range l.keys {
+			if err := t.deleteKey(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	l.timer.Stop()
+	close(l.revoked)
+	delete(s.leases, id)
+	return nil
+}
+
+// keepAlive renews the lease every third of its ttl until ctx is done or the lease is gone, the channel returned is
+// closed then.
+func (s *Store) keepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	l, err := s.renew(id)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *kv.LeaseKeepAliveResponse, keepAliveChanSize)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(l.ttl / 3)
+		defer ticker.Stop()
+		for {
+			// drop the response if the receiver falls behind, like etcd does
+			select {
+			case ch <- &kv.LeaseKeepAliveResponse{ID: id, TTL: int64(l.ttl / time.Second)}:
+			default:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-l.revoked:
+				return
+			case <-ticker.C:
+			}
+			if _, err := s.renew(id); err != nil {
+				log.Warn("embedded kv failed to keep lease alive", zap.Int64("lease", int64(id)), zap.Error(err))
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// watch starts watching key, or the keys with prefix key if prefix is set, from revision. Only the changes after
+// now are watched if revision is not positive.
+func (s *Store) watch(key string, prefix bool, revision int64, prevKV bool, createdNotify bool) kv.WatchChan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w := newWatcher(key, prefix, prevKV)
+	if s.closed {
+		w.cancel(errStoreClosed, 0)
+		return w.out
+	}
+	if createdNotify {
+		w.enqueue(kv.WatchResponse{Revision: s.revision, Created: true})
+	}
+	if revision > 0 && revision <= s.revision {
+		if revision <= s.compactRevision {
+			w.cancel(kv.ErrCompacted, s.compactRevision)
+			return w.out
+		}
+		for _, h := range s.histories {
+			if h.revision >= revision {
+				w.send(h.revision, h.events)
+			}
+		}
+	}
+	s.watchers[w] = struct{}{}
+	return w.out
+}
+
+func decodeRecord(value string) (*record, error) {
+	if value == "" {
+		return nil, nil
+	}
+	r := &record{}
+	if err := json.Unmarshal([]byte(value), r); err != nil {
+		return nil, fmt.Errorf("invalid record in embedded kv store: %w", err)
+	}
+	return r, nil
+}
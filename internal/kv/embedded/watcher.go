@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedkv
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/kv"
+)
+
+// watcher sends the events of the keys it watches to its channel. The responses are queued without limit, so that
+// a slow receiver never blocks the changes of the store.
+type watcher struct {
+	key    string
+	prefix bool
+	prevKV bool
+
+	mu       sync.Mutex
+	queue    []kv.WatchResponse
+	canceled bool
+	notify   chan struct{}
+	done     chan struct{}
+	out      chan kv.WatchResponse
+}
+
+func newWatcher(key string, prefix bool, prevKV bool) *watcher {
+	w := &watcher{
+		key:    key,
+		prefix: prefix,
+		prevKV: prevKV,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		out:    make(chan kv.WatchResponse),
+	}
+	go w.loop()
+	return w
+}
+
+func (w *watcher) match(key string) bool {
+	if w.prefix {
+		return strings.HasPrefix(key, w.key)
+	}
+	return key == w.key
+}
+
+// send queues the events of revision which match the watcher.
+func (w *watcher) send(revision int64, events []*kv.Event) {
+	matched := make([]*kv.Event, 0)
+	for _, e := range events {
+		if !w.match(string(e.Kv.Key)) {
+			continue
+		}
+		if !w.prevKV && e.PrevKv != nil {
+			e = &kv.Event{Type: e.Type, Kv: e.Kv}
+		}
+		matched = append(matched, e)
+	}
+	if len(matched) > 0 {
+		w.enqueue(kv.WatchResponse{Revision: revision, Events: matched})
+	}
+}
+
+func (w *watcher) enqueue(resp kv.WatchResponse) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.canceled {
+		return
+	}
+	w.queue = append(w.queue, resp)
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// cancel queues the last response of the watcher, the channel is closed after it is received.
+func (w *watcher) cancel(err error, compactRevision int64) {
+	w.enqueue(kv.WatchResponse{Canceled: true, Err: err, CompactRevision: compactRevision})
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.canceled = true
+}
+
+// stop closes the channel of the watcher without sending the queued responses.
+func (w *watcher) stop() {
+	close(w.done)
+}
+
+func (w *watcher) loop() {
+	defer close(w.out)
+	for {
+		w.mu.Lock()
+		queue := w.queue
+		w.queue = nil
+		canceled := w.canceled
+		w.mu.Unlock()
+
+		for _, resp := range queue {
+			select {
+			case w.out <- resp:
+			case <-w.done:
+				return
+			}
+		}
+		if canceled && len(queue) == 0 {
+			return
+		}
+		if canceled {
+			continue
+		}
+		select {
+		case <-w.notify:
+		case <-w.done:
+			return
+		}
+	}
+}
@@ -204,11 +204,11 @@ func (kv *EmbedEtcdKV) Save(key, value string) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EmbedEtcdKV) SaveWithLease(key, value string, id kv.LeaseID) error {
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	return err
 }
 
@@ -274,22 +274,22 @@ func (kv *EmbedEtcdKV) MultiSaveAndRemove(saves map[string]string, removals []st
 	return err
 }
 
-func (kv *EmbedEtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) Watch(key string) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
-	return rch
+	return toWatchChan(rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithPrefix(key string) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
-	return rch
+	return toWatchChan(rch)
 }
 
-func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EmbedEtcdKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
-	return rch
+	return toWatchChan(rch)
 }
 
 func (kv *EmbedEtcdKV) MultiRemoveWithPrefix(keys []string) error {
@@ -325,24 +325,35 @@ func (kv *EmbedEtcdKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, rem
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EmbedEtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EmbedEtcdKV) Grant(ttl int64) (id kv.LeaseID, err error) {
 	resp, err := kv.client.Grant(context.Background(), ttl)
-	return resp.ID, err
+	if err != nil {
+		return id, err
+	}
+	return toLeaseID(resp.ID), nil
 }
 
-// KeepAlive keeps the lease alive forever with leaseID.
+// KeepAlive keeps the lease alive with leaseID until ctx is done.
 // Implemented in etcd interface.
-func (kv *EmbedEtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+func (kv *EmbedEtcdKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
+	ch, err := kv.client.KeepAlive(ctx, clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
-	return ch, nil
+	return toKeepAliveChan(ch), nil
+}
+
+// Revoke revokes the lease, the keys attached to it are removed.
+func (kv *EmbedEtcdKV) Revoke(id kv.LeaseID) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	_, err := kv.client.Revoke(ctx, clientv3.LeaseID(id))
+	return err
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error {
+func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -350,12 +361,12 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, toOpOptions(opts)...)).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
-		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s: %w", key, errCompareFailed)
 	}
 
 	return nil
@@ -363,7 +374,7 @@ func (kv *EmbedEtcdKV) CompareValueAndSwap(key, value, target string, opts ...cl
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error {
+func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target string, opts ...kv.PutOption) error {
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
 	resp, err := kv.client.Txn(ctx).If(
@@ -371,12 +382,12 @@ func (kv *EmbedEtcdKV) CompareVersionAndSwap(key string, version int64, target s
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			version)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, toOpOptions(opts)...)).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
-		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s: %w", key, errCompareFailed)
 	}
 
 	return nil
@@ -17,16 +17,17 @@
 package etcdkv_test
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	embed_etcd_kv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func TestEmbedEtcd(te *testing.T) {
@@ -441,7 +442,7 @@ func TestEmbedEtcd(te *testing.T) {
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
 		err = metaKv.CompareVersionAndSwap("a/b/c", 0, "1")
@@ -472,7 +473,7 @@ func TestEmbedEtcd(te *testing.T) {
 		leaseID, err := metaKv.Grant(10)
 		assert.NoError(t, err)
 
-		metaKv.KeepAlive(leaseID)
+		metaKv.KeepAlive(context.Background(), leaseID)
 
 		tests := map[string]string{
 			"a/b":   "v1",
@@ -484,7 +485,7 @@ func TestEmbedEtcd(te *testing.T) {
 			err = metaKv.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = metaKv.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = metaKv.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 
@@ -22,7 +22,10 @@ import (
 	"path"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"go.uber.org/zap"
@@ -33,6 +36,9 @@ const (
 	RequestTimeout = 10 * time.Second
 )
 
+// implementation assertion
+var _ kv.MetaKv = (*EtcdKV)(nil)
+
 // EtcdKV implements TxnKV interface, it supports to process multiple kvs in a transaction.
 type EtcdKV struct {
 	client   *clientv3.Client
@@ -187,12 +193,12 @@ func (kv *EtcdKV) Save(key, value string) error {
 }
 
 // SaveWithLease is a function to put value in etcd with etcd lease options.
-func (kv *EtcdKV) SaveWithLease(key, value string, id clientv3.LeaseID) error {
+func (kv *EtcdKV) SaveWithLease(key, value string, id kv.LeaseID) error {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
-	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(id))
+	_, err := kv.client.Put(ctx, key, value, clientv3.WithLease(clientv3.LeaseID(id)))
 	CheckElapseAndWarn(start, "Slow etcd operation save with lease")
 	return err
 }
@@ -274,30 +280,30 @@ func (kv *EtcdKV) MultiSaveAndRemove(saves map[string]string, removals []string)
 }
 
 // Watch starts watching a key, returns a watch channel.
-func (kv *EtcdKV) Watch(key string) clientv3.WatchChan {
+func (kv *EtcdKV) Watch(key string) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch")
-	return rch
+	return toWatchChan(rch)
 }
 
 // WatchWithPrefix starts watching a key with prefix, returns a watch channel.
-func (kv *EtcdKV) WatchWithPrefix(key string) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithPrefix(key string) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 	CheckElapseAndWarn(start, "Slow etcd operation watch with prefix")
-	return rch
+	return toWatchChan(rch)
 }
 
 // WatchWithRevision starts watching a key with revision, returns a watch channel.
-func (kv *EtcdKV) WatchWithRevision(key string, revision int64) clientv3.WatchChan {
+func (kv *EtcdKV) WatchWithRevision(key string, revision int64) kv.WatchChan {
 	start := time.Now()
 	key = path.Join(kv.rootPath, key)
 	rch := kv.client.Watch(context.Background(), key, clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
 	CheckElapseAndWarn(start, "Slow etcd operation watch with revision")
-	return rch
+	return toWatchChan(rch)
 }
 
 // MultiRemoveWithPrefix removes the keys with given prefix.
@@ -337,28 +343,41 @@ func (kv *EtcdKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals
 }
 
 // Grant creates a new lease implemented in etcd grant interface.
-func (kv *EtcdKV) Grant(ttl int64) (id clientv3.LeaseID, err error) {
+func (kv *EtcdKV) Grant(ttl int64) (id kv.LeaseID, err error) {
 	start := time.Now()
 	resp, err := kv.client.Grant(context.Background(), ttl)
 	CheckElapseAndWarn(start, "Slow etcd operation grant")
-	return resp.ID, err
+	if err != nil {
+		return id, err
+	}
+	return toLeaseID(resp.ID), nil
 }
 
-// KeepAlive keeps the lease alive forever with leaseID.
+// KeepAlive keeps the lease alive with leaseID until ctx is done.
 // Implemented in etcd interface.
-func (kv *EtcdKV) KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (kv *EtcdKV) KeepAlive(ctx context.Context, id kv.LeaseID) (<-chan *kv.LeaseKeepAliveResponse, error) {
 	start := time.Now()
-	ch, err := kv.client.KeepAlive(context.Background(), id)
+	ch, err := kv.client.KeepAlive(ctx, clientv3.LeaseID(id))
 	if err != nil {
 		return nil, err
 	}
 	CheckElapseAndWarn(start, "Slow etcd operation keepAlive")
-	return ch, nil
+	return toKeepAliveChan(ch), nil
+}
+
+// Revoke revokes the lease, the keys attached to it are removed.
+func (kv *EtcdKV) Revoke(id kv.LeaseID) error {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
+	defer cancel()
+	_, err := kv.client.Revoke(ctx, clientv3.LeaseID(id))
+	CheckElapseAndWarn(start, "Slow etcd operation revoke")
+	return err
 }
 
 // CompareValueAndSwap compares the existing value with compare, and if they are
 // equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error {
+func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...kv.PutOption) error {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -367,12 +386,12 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 			clientv3.Value(path.Join(kv.rootPath, key)),
 			"=",
 			value)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, toOpOptions(opts)...)).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
-		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
+		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s: %w", key, errCompareFailed)
 	}
 	CheckElapseAndWarn(start, "Slow etcd operation compare value and swap")
 	return nil
@@ -380,7 +399,7 @@ func (kv *EtcdKV) CompareValueAndSwap(key, value, target string, opts ...clientv
 
 // CompareVersionAndSwap compares the existing key-value's version with version, and if
 // they are equal, the target is stored in etcd.
-func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string, opts ...clientv3.OpOption) error {
+func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string, opts ...kv.PutOption) error {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(context.TODO(), RequestTimeout)
 	defer cancel()
@@ -389,13 +408,13 @@ func (kv *EtcdKV) CompareVersionAndSwap(key string, source int64, target string,
 			clientv3.Version(path.Join(kv.rootPath, key)),
 			"=",
 			source)).
-		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, opts...)).Commit()
+		Then(clientv3.OpPut(path.Join(kv.rootPath, key), target, toOpOptions(opts)...)).Commit()
 	if err != nil {
 		return err
 	}
 	if !resp.Succeeded {
 		return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s,"+
-			" source version: %d, target version: %s", key, source, target)
+			" source version: %d, target version: %s: %w", key, source, target, errCompareFailed)
 	}
 	CheckElapseAndWarn(start, "Slow etcd operation compare version and swap")
 	return nil
@@ -410,3 +429,90 @@ func CheckElapseAndWarn(start time.Time, message string) bool {
 	}
 	return false
 }
+
+// errCompareFailed is kv.ErrCompareFailed, the kv package is shadowed by the receivers of the methods.
+var errCompareFailed = kv.ErrCompareFailed
+
+func toLeaseID(id clientv3.LeaseID) kv.LeaseID {
+	return kv.LeaseID(id)
+}
+
+func toOpOptions(opts []kv.PutOption) []clientv3.OpOption {
+	putOpts := &kv.PutOptions{}
+	for _, opt := range opts {
+		opt(putOpts)
+	}
+	if putOpts.Lease == kv.NoLease {
+		return nil
+	}
+	return []clientv3.OpOption{clientv3.WithLease(clientv3.LeaseID(putOpts.Lease))}
+}
+
+func toKeyValue(kvs *mvccpb.KeyValue) *kv.KeyValue {
+	if kvs == nil {
+		return nil
+	}
+	return &kv.KeyValue{
+		Key:            kvs.Key,
+		Value:          kvs.Value,
+		CreateRevision: kvs.CreateRevision,
+		ModRevision:    kvs.ModRevision,
+		Version:        kvs.Version,
+		Lease:          kvs.Lease,
+	}
+}
+
+func toWatchResponse(resp clientv3.WatchResponse) kv.WatchResponse {
+	events := make([]*kv.Event, 0, len(resp.Events))
+	for _, e := range resp.Events {
+		eventType := kv.EventTypePut
+		if e.Type == mvccpb.DELETE {
+			eventType = kv.EventTypeDelete
+		}
+		events = append(events, &kv.Event{
+			Type:   eventType,
+			Kv:     toKeyValue(e.Kv),
+			PrevKv: toKeyValue(e.PrevKv),
+		})
+	}
+	err := resp.Err()
+	if err == v3rpc.ErrCompacted {
+		err = kv.ErrCompacted
+	}
+	return kv.WatchResponse{
+		Revision:        resp.Header.Revision,
+		Events:          events,
+		CompactRevision: resp.CompactRevision,
+		Created:         resp.Created,
+		Canceled:        resp.Canceled,
+		Err:             err,
+	}
+}
+
+// toWatchChan translates the responses of an etcd watch channel, the returned channel is closed with it.
+func toWatchChan(ch clientv3.WatchChan) kv.WatchChan {
+	out := make(chan kv.WatchResponse)
+	go func() {
+		defer close(out)
+		for resp := range ch {
+			out <- toWatchResponse(resp)
+		}
+	}()
+	return out
+}
+
+// toKeepAliveChan translates the responses of an etcd keep alive channel, the returned channel is closed with it.
+func toKeepAliveChan(ch <-chan *clientv3.LeaseKeepAliveResponse) <-chan *kv.LeaseKeepAliveResponse {
+	out := make(chan *kv.LeaseKeepAliveResponse)
+	go func() {
+		defer close(out)
+		for resp := range ch {
+			if resp == nil {
+				out <- nil
+				continue
+			}
+			out <- &kv.LeaseKeepAliveResponse{ID: toLeaseID(resp.ID), TTL: resp.TTL}
+		}
+	}()
+	return out
+}
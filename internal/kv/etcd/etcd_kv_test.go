@@ -17,16 +17,19 @@
 package etcdkv_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	embeddedkv "github.com/milvus-io/milvus/internal/kv/embedded"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 var Params paramtable.GlobalParamTable
@@ -39,16 +42,35 @@ func TestMain(m *testing.M) {
 
 func TestEtcdKV_Load(te *testing.T) {
 	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.NoError(te, err)
 	defer etcdCli.Close()
-	assert.NoError(te, err)
-	te.Run("EtcdKV SaveAndLoad", func(t *testing.T) {
+
+	testMetaKV(te, func(rootPath string) kv.MetaKv {
+		return etcdkv.NewEtcdKV(etcdCli, rootPath)
+	})
+}
+
+// TestEmbeddedKV_Load runs the etcd suite against the embedded meta store, which replaces etcd in standalone mode
+func TestEmbeddedKV_Load(te *testing.T) {
+	store, err := embeddedkv.NewStore(memkv.NewMemoryKV())
+	require.NoError(te, err)
+	defer store.Close()
+
+	testMetaKV(te, func(rootPath string) kv.MetaKv {
+		return embeddedkv.NewMetaKV(store, rootPath)
+	})
+}
+
+func testMetaKV(te *testing.T, newMetaKV func(rootPath string) kv.MetaKv) {
+	var err error
+	te.Run("MetaKV SaveAndLoad", func(t *testing.T) {
 		rootPath := "/etcd/test/root/saveandload"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
-		err = etcdKV.RemoveWithPrefix("")
+		metaKV := newMetaKV(rootPath)
+		err = metaKV.RemoveWithPrefix("")
 		require.NoError(t, err)
 
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
 		saveAndLoadTests := []struct {
 			key   string
@@ -62,11 +84,11 @@ func TestEtcdKV_Load(te *testing.T) {
 
 		for i, test := range saveAndLoadTests {
 			if i < 4 {
-				err = etcdKV.Save(test.key, test.value)
+				err = metaKV.Save(test.key, test.value)
 				assert.NoError(t, err)
 			}
 
-			val, err := etcdKV.Load(test.key)
+			val, err := metaKV.Load(test.key)
 			assert.NoError(t, err)
 			assert.Equal(t, test.value, val)
 		}
@@ -80,7 +102,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range invalidLoadTests {
-			val, err := etcdKV.Load(test.invalidKey)
+			val, err := metaKV.Load(test.invalidKey)
 			assert.Error(t, err)
 			assert.Zero(t, val)
 		}
@@ -93,33 +115,33 @@ func TestEtcdKV_Load(te *testing.T) {
 			expectedError  error
 		}{
 			{"test", []string{
-				etcdKV.GetPath("test1"),
-				etcdKV.GetPath("test2"),
-				etcdKV.GetPath("test1/a"),
-				etcdKV.GetPath("test1/b")}, []string{"value1", "value2", "value_a", "value_b"}, nil},
+				metaKV.GetPath("test1"),
+				metaKV.GetPath("test2"),
+				metaKV.GetPath("test1/a"),
+				metaKV.GetPath("test1/b")}, []string{"value1", "value2", "value_a", "value_b"}, nil},
 			{"test1", []string{
-				etcdKV.GetPath("test1"),
-				etcdKV.GetPath("test1/a"),
-				etcdKV.GetPath("test1/b")}, []string{"value1", "value_a", "value_b"}, nil},
-			{"test2", []string{etcdKV.GetPath("test2")}, []string{"value2"}, nil},
+				metaKV.GetPath("test1"),
+				metaKV.GetPath("test1/a"),
+				metaKV.GetPath("test1/b")}, []string{"value1", "value_a", "value_b"}, nil},
+			{"test2", []string{metaKV.GetPath("test2")}, []string{"value2"}, nil},
 			{"", []string{
-				etcdKV.GetPath("test1"),
-				etcdKV.GetPath("test2"),
-				etcdKV.GetPath("test1/a"),
-				etcdKV.GetPath("test1/b")}, []string{"value1", "value2", "value_a", "value_b"}, nil},
-			{"test1/a", []string{etcdKV.GetPath("test1/a")}, []string{"value_a"}, nil},
+				metaKV.GetPath("test1"),
+				metaKV.GetPath("test2"),
+				metaKV.GetPath("test1/a"),
+				metaKV.GetPath("test1/b")}, []string{"value1", "value2", "value_a", "value_b"}, nil},
+			{"test1/a", []string{metaKV.GetPath("test1/a")}, []string{"value_a"}, nil},
 			{"a", []string{}, []string{}, nil},
 			{"root", []string{}, []string{}, nil},
 			{"/etcd/test/root", []string{}, []string{}, nil},
 		}
 
 		for _, test := range loadPrefixTests {
-			actualKeys, actualValues, err := etcdKV.LoadWithPrefix(test.prefix)
+			actualKeys, actualValues, err := metaKV.LoadWithPrefix(test.prefix)
 			assert.ElementsMatch(t, test.expectedKeys, actualKeys)
 			assert.ElementsMatch(t, test.expectedValues, actualValues)
 			assert.Equal(t, test.expectedError, err)
 
-			actualKeys, actualValues, versions, err := etcdKV.LoadWithPrefix2(test.prefix)
+			actualKeys, actualValues, versions, err := metaKV.LoadWithPrefix2(test.prefix)
 			assert.ElementsMatch(t, test.expectedKeys, actualKeys)
 			assert.ElementsMatch(t, test.expectedValues, actualValues)
 			assert.NotZero(t, versions)
@@ -137,25 +159,25 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range removeTests {
-			err = etcdKV.Remove(test.validKey)
+			err = metaKV.Remove(test.validKey)
 			assert.NoError(t, err)
 
-			_, err = etcdKV.Load(test.validKey)
+			_, err = metaKV.Load(test.validKey)
 			assert.Error(t, err)
 
-			err = etcdKV.Remove(test.validKey)
+			err = metaKV.Remove(test.validKey)
 			assert.NoError(t, err)
-			err = etcdKV.Remove(test.invalidKey)
+			err = metaKV.Remove(test.invalidKey)
 			assert.NoError(t, err)
 		}
 	})
 
-	te.Run("EtcdKV LoadWithRevision", func(t *testing.T) {
+	te.Run("MetaKV LoadWithRevision", func(t *testing.T) {
 		rootPath := "/etcd/test/root/LoadWithRevision"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+		metaKV := newMetaKV(rootPath)
 
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
 		prepareKV := []struct {
 			inKey   string
@@ -169,7 +191,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range prepareKV {
-			err = etcdKV.Save(test.inKey, test.inValue)
+			err = metaKV.Save(test.inKey, test.inValue)
 			require.NoError(t, err)
 		}
 
@@ -185,7 +207,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range loadWithRevisionTests {
-			keys, values, revision, err := etcdKV.LoadWithRevision(test.inKey)
+			keys, values, revision, err := metaKV.LoadWithRevision(test.inKey)
 			assert.NoError(t, err)
 			assert.Equal(t, test.expectedKeyNo, len(keys))
 			assert.ElementsMatch(t, test.expectedValues, values)
@@ -194,12 +216,12 @@ func TestEtcdKV_Load(te *testing.T) {
 
 	})
 
-	te.Run("EtcdKV MultiSaveAndMultiLoad", func(t *testing.T) {
+	te.Run("MetaKV MultiSaveAndMultiLoad", func(t *testing.T) {
 		rootPath := "/etcd/test/root/multi_save_and_multi_load"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+		metaKV := newMetaKV(rootPath)
 
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
 		multiSaveTests := map[string]string{
 			"key_1":      "value_1",
@@ -210,10 +232,10 @@ func TestEtcdKV_Load(te *testing.T) {
 			"_":          "other",
 		}
 
-		err = etcdKV.MultiSave(multiSaveTests)
+		err = metaKV.MultiSave(multiSaveTests)
 		assert.NoError(t, err)
 		for k, v := range multiSaveTests {
-			actualV, err := etcdKV.Load(k)
+			actualV, err := metaKV.Load(k)
 			assert.NoError(t, err)
 			assert.Equal(t, v, actualV)
 		}
@@ -229,7 +251,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range multiLoadTests {
-			vs, err := etcdKV.MultiLoad(test.inputKeys)
+			vs, err := metaKV.MultiLoad(test.inputKeys)
 			assert.NoError(t, err)
 			assert.Equal(t, test.expectedValues, vs)
 		}
@@ -245,7 +267,7 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range invalidMultiLoad {
-			vs, err := etcdKV.MultiLoad(test.invalidKeys)
+			vs, err := metaKV.MultiLoad(test.invalidKeys)
 			assert.Error(t, err)
 			assert.Equal(t, test.expectedValues, vs)
 		}
@@ -256,10 +278,10 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, k := range removeWithPrefixTests {
-			err = etcdKV.RemoveWithPrefix(k)
+			err = metaKV.RemoveWithPrefix(k)
 			assert.NoError(t, err)
 
-			ks, vs, err := etcdKV.LoadWithPrefix(k)
+			ks, vs, err := metaKV.LoadWithPrefix(k)
 			assert.Empty(t, ks)
 			assert.Empty(t, vs)
 			assert.NoError(t, err)
@@ -272,10 +294,10 @@ func TestEtcdKV_Load(te *testing.T) {
 			"_",
 		}
 
-		err = etcdKV.MultiRemove(multiRemoveTests)
+		err = metaKV.MultiRemove(multiRemoveTests)
 		assert.NoError(t, err)
 
-		ks, vs, err := etcdKV.LoadWithPrefix("")
+		ks, vs, err := metaKV.LoadWithPrefix("")
 		assert.NoError(t, err)
 		assert.Empty(t, ks)
 		assert.Empty(t, vs)
@@ -292,21 +314,21 @@ func TestEtcdKV_Load(te *testing.T) {
 			{make(map[string]string), []string{"multikey_2"}},
 		}
 		for _, test := range multiSaveAndRemoveTests {
-			err = etcdKV.MultiSaveAndRemove(test.multiSaves, test.multiRemoves)
+			err = metaKV.MultiSaveAndRemove(test.multiSaves, test.multiRemoves)
 			assert.NoError(t, err)
 		}
 
-		ks, vs, err = etcdKV.LoadWithPrefix("")
+		ks, vs, err = metaKV.LoadWithPrefix("")
 		assert.NoError(t, err)
 		assert.Empty(t, ks)
 		assert.Empty(t, vs)
 	})
 
-	te.Run("EtcdKV MultiRemoveWithPrefix", func(t *testing.T) {
+	te.Run("MetaKV MultiRemoveWithPrefix", func(t *testing.T) {
 		rootPath := "/etcd/test/root/multi_remove_with_prefix"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		metaKV := newMetaKV(rootPath)
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
 		prepareTests := map[string]string{
 			"x/abc/1": "1",
@@ -317,7 +339,7 @@ func TestEtcdKV_Load(te *testing.T) {
 			"x/den/2": "200",
 		}
 
-		err = etcdKV.MultiSave(prepareTests)
+		err = metaKV.MultiSave(prepareTests)
 		require.NoError(t, err)
 
 		multiRemoveWithPrefixTests := []struct {
@@ -343,21 +365,21 @@ func TestEtcdKV_Load(te *testing.T) {
 
 		for _, test := range multiRemoveWithPrefixTests {
 			if len(test.prefix) > 0 {
-				err = etcdKV.MultiRemoveWithPrefix(test.prefix)
+				err = metaKV.MultiRemoveWithPrefix(test.prefix)
 				assert.NoError(t, err)
 			}
 
-			v, _ := etcdKV.Load(test.testKey)
+			v, _ := metaKV.Load(test.testKey)
 			assert.Equal(t, test.expectedValue, v)
 		}
 
-		k, v, err := etcdKV.LoadWithPrefix("/")
+		k, v, err := metaKV.LoadWithPrefix("/")
 		assert.NoError(t, err)
 		assert.Zero(t, len(k))
 		assert.Zero(t, len(v))
 
 		// MultiSaveAndRemoveWithPrefix
-		err = etcdKV.MultiSave(prepareTests)
+		err = metaKV.MultiSave(prepareTests)
 		require.NoError(t, err)
 		multiSaveAndRemoveWithPrefixTests := []struct {
 			multiSave map[string]string
@@ -375,40 +397,40 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range multiSaveAndRemoveWithPrefixTests {
-			k, _, err = etcdKV.LoadWithPrefix(test.loadPrefix)
+			k, _, err = metaKV.LoadWithPrefix(test.loadPrefix)
 			assert.NoError(t, err)
 			assert.Equal(t, test.lengthBeforeRemove, len(k))
 
-			err = etcdKV.MultiSaveAndRemoveWithPrefix(test.multiSave, test.prefix)
+			err = metaKV.MultiSaveAndRemoveWithPrefix(test.multiSave, test.prefix)
 			assert.NoError(t, err)
 
-			k, _, err = etcdKV.LoadWithPrefix(test.loadPrefix)
+			k, _, err = metaKV.LoadWithPrefix(test.loadPrefix)
 			assert.NoError(t, err)
 			assert.Equal(t, test.lengthAfterRemove, len(k))
 		}
 	})
 
-	te.Run("EtcdKV Watch", func(t *testing.T) {
+	te.Run("MetaKV Watch", func(t *testing.T) {
 		rootPath := "/etcd/test/root/watch"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+		metaKV := newMetaKV(rootPath)
 
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
-		ch := etcdKV.Watch("x")
+		ch := metaKV.Watch("x")
 		resp := <-ch
 		assert.True(t, resp.Created)
 
-		ch = etcdKV.WatchWithPrefix("x")
+		ch = metaKV.WatchWithPrefix("x")
 		resp = <-ch
 		assert.True(t, resp.Created)
 	})
 
-	te.Run("Etcd Revision", func(t *testing.T) {
+	te.Run("MetaKV Revision", func(t *testing.T) {
 		rootPath := "/etcd/test/root/watch"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		metaKV := newMetaKV(rootPath)
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
 		revisionTests := []struct {
 			inKey       string
@@ -421,49 +443,49 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for _, test := range revisionTests {
-			err = etcdKV.Save(test.inKey, test.fistValue)
+			err = metaKV.Save(test.inKey, test.fistValue)
 			require.NoError(t, err)
 
-			_, _, revision, _ := etcdKV.LoadWithRevision(test.inKey)
-			ch := etcdKV.WatchWithRevision(test.inKey, revision+1)
+			_, _, revision, _ := metaKV.LoadWithRevision(test.inKey)
+			ch := metaKV.WatchWithRevision(test.inKey, revision+1)
 
-			err = etcdKV.Save(test.inKey, test.secondValue)
+			err = metaKV.Save(test.inKey, test.secondValue)
 			require.NoError(t, err)
 
 			resp := <-ch
 			assert.Equal(t, 1, len(resp.Events))
 			assert.Equal(t, test.secondValue, string(resp.Events[0].Kv.Value))
-			assert.Equal(t, revision+1, resp.Header.Revision)
+			assert.Equal(t, revision+1, resp.Revision)
 		}
 
-		err = etcdKV.CompareVersionAndSwap("a/b/c", 0, "1")
+		err = metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
 		assert.NoError(t, err)
 
-		value, err := etcdKV.Load("a/b/c")
+		value, err := metaKV.Load("a/b/c")
 		assert.NoError(t, err)
 		assert.Equal(t, value, "1")
 
-		err = etcdKV.CompareVersionAndSwap("a/b/c", 0, "1")
+		err = metaKV.CompareVersionAndSwap("a/b/c", 0, "1")
 		assert.Error(t, err)
 
-		err = etcdKV.CompareValueAndSwap("a/b/c", "1", "2")
+		err = metaKV.CompareValueAndSwap("a/b/c", "1", "2")
 		assert.NoError(t, err)
 
-		err = etcdKV.CompareValueAndSwap("a/b/c", "1", "2")
+		err = metaKV.CompareValueAndSwap("a/b/c", "1", "2")
 		assert.Error(t, err)
 	})
 
-	te.Run("Etcd Lease", func(t *testing.T) {
+	te.Run("MetaKV Lease", func(t *testing.T) {
 		rootPath := "/etcd/test/root/lease"
-		etcdKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+		metaKV := newMetaKV(rootPath)
 
-		defer etcdKV.Close()
-		defer etcdKV.RemoveWithPrefix("")
+		defer metaKV.Close()
+		defer metaKV.RemoveWithPrefix("")
 
-		leaseID, err := etcdKV.Grant(10)
+		leaseID, err := metaKV.Grant(10)
 		assert.NoError(t, err)
 
-		etcdKV.KeepAlive(leaseID)
+		metaKV.KeepAlive(context.Background(), leaseID)
 
 		tests := map[string]string{
 			"a/b":   "v1",
@@ -472,10 +494,10 @@ func TestEtcdKV_Load(te *testing.T) {
 		}
 
 		for k, v := range tests {
-			err = etcdKV.SaveWithLease(k, v, leaseID)
+			err = metaKV.SaveWithLease(k, v, leaseID)
 			assert.NoError(t, err)
 
-			err = etcdKV.SaveWithLease(k, v, clientv3.LeaseID(999))
+			err = metaKV.SaveWithLease(k, v, kv.LeaseID(999))
 			assert.Error(t, err)
 		}
 
@@ -17,8 +17,10 @@
 package kv
 
 import (
+	"context"
+	"errors"
+
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // Value is interface for kv-value, needed to support string and byte slice
@@ -95,16 +97,93 @@ type MetaKv interface {
 	LoadWithPrefix(key string) ([]string, []string, error)
 	LoadWithPrefix2(key string) ([]string, []string, []int64, error)
 	LoadWithRevision(key string) ([]string, []string, int64, error)
-	Watch(key string) clientv3.WatchChan
-	WatchWithPrefix(key string) clientv3.WatchChan
-	WatchWithRevision(key string, revision int64) clientv3.WatchChan
-	SaveWithLease(key, value string, id clientv3.LeaseID) error
-	Grant(ttl int64) (id clientv3.LeaseID, err error)
-	KeepAlive(id clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error)
-	CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) error
-	CompareVersionAndSwap(key string, version int64, target string, opts ...clientv3.OpOption) error
+	Watch(key string) WatchChan
+	WatchWithPrefix(key string) WatchChan
+	WatchWithRevision(key string, revision int64) WatchChan
+	SaveWithLease(key, value string, id LeaseID) error
+	Grant(ttl int64) (id LeaseID, err error)
+	KeepAlive(ctx context.Context, id LeaseID) (<-chan *LeaseKeepAliveResponse, error)
+	Revoke(id LeaseID) error
+	CompareValueAndSwap(key, value, target string, opts ...PutOption) error
+	CompareVersionAndSwap(key string, version int64, target string, opts ...PutOption) error
+}
+
+// ErrCompacted is the error of a watch response when the revision to watch from has been compacted.
+var ErrCompacted = errors.New("required revision has been compacted")
+
+// ErrCompareFailed is wrapped by the error returned by CompareValueAndSwap and CompareVersionAndSwap when
+// the comparison is false.
+var ErrCompareFailed = errors.New("compare failed")
+
+// LeaseID is the id of a lease granted by MetaKv, the keys saved with a lease are removed when it expires.
+type LeaseID int64
+
+// NoLease is the LeaseID of the keys saved without lease.
+const NoLease LeaseID = 0
+
+// LeaseKeepAliveResponse is sent by MetaKv each time a lease is kept alive.
+type LeaseKeepAliveResponse struct {
+	ID  LeaseID
+	TTL int64
+}
+
+// PutOptions are the options of a put in MetaKv.
+type PutOptions struct {
+	Lease LeaseID
+}
+
+// PutOption configures a put in MetaKv.
+type PutOption func(*PutOptions)
+
+// WithLease attaches the key to the lease.
+func WithLease(id LeaseID) PutOption {
+	return func(opts *PutOptions) {
+		opts.Lease = id
+	}
+}
+
+// EventType is the type of a watch event.
+type EventType int32
+
+const (
+	// EventTypePut means the key is created or updated.
+	EventTypePut EventType = iota
+	// EventTypeDelete means the key is deleted.
+	EventTypeDelete
+)
+
+// KeyValue is a key of MetaKv with its value, revisions, version and lease.
+type KeyValue struct {
+	Key            []byte
+	Value          []byte
+	CreateRevision int64
+	ModRevision    int64
+	Version        int64
+	Lease          int64
 }
 
+// Event is a change of a key watched in MetaKv. Kv is the key after the change, PrevKv is the key before it, if
+// the watch asks for it.
+type Event struct {
+	Type   EventType
+	Kv     *KeyValue
+	PrevKv *KeyValue
+}
+
+// WatchResponse is a batch of events sent to a watch channel. Revision is the revision of the kv when the response
+// is sent. If the watch is canceled, Canceled is set and Err is the reason.
+type WatchResponse struct {
+	Revision        int64
+	Events          []*Event
+	CompactRevision int64
+	Created         bool
+	Canceled        bool
+	Err             error
+}
+
+// WatchChan is the channel the responses of a watch are sent to.
+type WatchChan <-chan WatchResponse
+
 // SnapShotKV is TxnKV for snapshot data. It must save timestamp.
 type SnapShotKV interface {
 	Save(key string, value string, ts typeutil.Timestamp) error
@@ -24,6 +24,7 @@ import (
 
 	"io"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -119,6 +120,22 @@ func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	return objectsKeys, objectsValues, nil
 }
 
+// ListWithPrefix lists all the objects under @prefix recursively with their last modified time.
+func (kv *MinIOKV) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var objectsKeys []string
+	var modTimes []time.Time
+
+	for object := range kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			log.Warn("MinIO list with prefix error", zap.String("prefix", prefix), zap.Error(object.Err))
+			return nil, nil, object.Err
+		}
+		objectsKeys = append(objectsKeys, object.Key)
+		modTimes = append(modTimes, object.LastModified)
+	}
+	return objectsKeys, modTimes, nil
+}
+
 // Load loads an object with @key.
 func (kv *MinIOKV) Load(key string) (string, error) {
 	object, err := kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
@@ -441,4 +441,34 @@ func TestMinIOKV(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), size)
 	})
+
+	t.Run("test ListWithPrefix", func(t *testing.T) {
+		testListRoot := path.Join(testMinIOKVRoot, "list_with_prefix")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testKV, err := newMinIOKVClient(ctx, testBucket)
+		require.NoError(t, err)
+		defer testKV.RemoveWithPrefix(testListRoot)
+
+		err = testKV.MultiSave(map[string]string{
+			path.Join(testListRoot, "a/1"):   "1",
+			path.Join(testListRoot, "a/b/2"): "2",
+			path.Join(testListRoot, "ab/3"):  "3",
+		})
+		require.NoError(t, err)
+
+		keys, modTimes, err := testKV.ListWithPrefix(path.Join(testListRoot, "a/"))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{path.Join(testListRoot, "a/1"), path.Join(testListRoot, "a/b/2")}, keys)
+		assert.Equal(t, len(keys), len(modTimes))
+
+		keys, _, err = testKV.ListWithPrefix(path.Join(testListRoot, "a"))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(keys))
+
+		keys, _, err = testKV.ListWithPrefix(path.Join(testListRoot, "c"))
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
 }
@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"net/http"
+	"sync"
 
 	// nolint:gosec
 	_ "net/http/pprof"
@@ -32,8 +33,37 @@ import (
 
 const (
 	milvusNamespace = "milvus"
+
+	// SearchLabel and QueryLabel are the label values of the query type
+	SearchLabel = "search"
+	QueryLabel  = "query"
+
+	// SealedSegmentLabel and GrowingSegmentLabel are the label values of the segment type
+	SealedSegmentLabel  = "sealed"
+	GrowingSegmentLabel = "growing"
+
+	// EnqueueLabel, PreExecuteLabel, ExecuteLabel and PostExecuteLabel are the label values of the phases of proxy tasks
+	EnqueueLabel     = "enqueue"
+	PreExecuteLabel  = "pre_execute"
+	ExecuteLabel     = "execute"
+	PostExecuteLabel = "post_execute"
+
+	// UnissuedLabel and ActiveLabel are the label values of the status of the tasks in proxy task queues
+	UnissuedLabel = "unissued"
+	ActiveLabel   = "active"
+
+	// InsertLabel and DeleteLabel are the label values of the flush type
+	InsertLabel = "insert"
+	DeleteLabel = "delete"
+
+	// SuccessLabel and FailLabel are the label values of the status of operations
+	SuccessLabel = "success"
+	FailLabel    = "fail"
 )
 
+// buckets of the latency histograms in milliseconds, from 1ms to about 131s
+var buckets = prometheus.ExponentialBuckets(1, 2, 18)
+
 var (
 	// RootCoordProxyLister counts the num of registered proxy nodes
 	RootCoordProxyLister = prometheus.NewGaugeVec(
@@ -219,6 +249,8 @@ func RegisterRootCoord() {
 	prometheus.MustRegister(RootCoordInsertChannelTimeTick)
 	prometheus.MustRegister(RootCoordDDChannelTimeTick)
 	//prometheus.MustRegister(PanicCounter)
+
+	registerCommon()
 }
 
 var (
@@ -536,6 +568,35 @@ var (
 			Name:      "dml_channels_time_tick",
 			Help:      "Time tick of dml channels",
 		}, []string{"pchan"})
+
+	// ProxyTaskLatency records the latency of the phases of tasks, enqueue is the time waiting in the task queue
+	ProxyTaskLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "task_latency",
+			Help:      "Latency of the phases of tasks in milliseconds",
+			Buckets:   buckets,
+		}, []string{"task_type", "phase"})
+
+	// ProxyReduceResultLatency records the latency of reducing the results of query nodes
+	ProxyReduceResultLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "reduce_result_latency",
+			Help:      "Latency of reducing search or query results in milliseconds",
+			Buckets:   buckets,
+		}, []string{"query_type"})
+
+	// ProxyTaskQueueLength records the num of unissued and active tasks of the task queues
+	ProxyTaskQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "task_queue_length",
+			Help:      "Length of task queues",
+		}, []string{"queue_type", "task_status"})
 )
 
 //RegisterProxy registers Proxy metrics
@@ -585,16 +646,46 @@ func RegisterProxy() {
 	prometheus.MustRegister(ProxyReleaseDQLMessageStreamCounter)
 
 	prometheus.MustRegister(ProxyDmlChannelTimeTick)
+
+	prometheus.MustRegister(ProxyTaskLatency)
+	prometheus.MustRegister(ProxyReduceResultLatency)
+	prometheus.MustRegister(ProxyTaskQueueLength)
+
+	registerCommon()
 }
 
 //RegisterQueryCoord registers QueryCoord metrics
 func RegisterQueryCoord() {
-
+	registerCommon()
 }
 
+var (
+	// QueryNodeSQSegmentLatency records the latency of searching or retrieving the sealed or growing segments of a request
+	QueryNodeSQSegmentLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "sq_segment_latency",
+			Help:      "Latency of search or query on segments in milliseconds",
+			Buckets:   buckets,
+		}, []string{"query_type", "segment_type"})
+
+	// QueryNodeTSafeLag records how far the tSafe of vchannels falls behind the current time
+	QueryNodeTSafeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tsafe_lag",
+			Help:      "Lag of tSafe of vchannels in milliseconds",
+		}, []string{"vchannel"})
+)
+
 //RegisterQueryNode registers QueryNode metrics
 func RegisterQueryNode() {
+	prometheus.MustRegister(QueryNodeSQSegmentLatency)
+	prometheus.MustRegister(QueryNodeTSafeLag)
 
+	registerCommon()
 }
 
 var (
@@ -612,6 +703,8 @@ var (
 //RegisterDataCoord registers DataCoord metrics
 func RegisterDataCoord() {
 	prometheus.MustRegister(DataCoordDataNodeList)
+
+	registerCommon()
 }
 
 var (
@@ -632,22 +725,103 @@ var (
 			Name:      "watch_dm_channels_total",
 			Help:      "Counter of watch dm channel",
 		}, []string{"type"})
+
+	// DataNodeFlushLatency records the latency of writing the insert or delete binlogs of segments
+	DataNodeFlushLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "flush_latency",
+			Help:      "Latency of flushing binlogs in milliseconds",
+			Buckets:   buckets,
+		}, []string{"flush_type"})
+
+	// DataNodeCompactionLatency records the latency of compaction tasks
+	DataNodeCompactionLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "compaction_latency",
+			Help:      "Latency of compaction in milliseconds",
+			Buckets:   buckets,
+		}, []string{"status"})
 )
 
 //RegisterDataNode registers DataNode metrics
 func RegisterDataNode() {
 	prometheus.MustRegister(DataNodeFlushSegmentsCounter)
 	prometheus.MustRegister(DataNodeWatchDmChannelsCounter)
+	prometheus.MustRegister(DataNodeFlushLatency)
+	prometheus.MustRegister(DataNodeCompactionLatency)
+
+	registerCommon()
 }
 
 //RegisterIndexCoord registers IndexCoord metrics
 func RegisterIndexCoord() {
-
+	registerCommon()
 }
 
+var (
+	// IndexNodeBuildIndexLatency records the latency of building indexes, loading the data and saving the index files are excluded
+	IndexNodeBuildIndexLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.IndexNodeRole,
+			Name:      "build_index_latency",
+			Help:      "Latency of building index in milliseconds",
+			Buckets:   buckets,
+		})
+)
+
 //RegisterIndexNode registers IndexNode metrics
 func RegisterIndexNode() {
+	prometheus.MustRegister(IndexNodeBuildIndexLatency)
+
+	registerCommon()
+}
+
+var (
+	// MsgStreamProduceLatency records the latency of sending a message to the message queue
+	MsgStreamProduceLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "produce_latency",
+			Help:      "Latency of producing messages in milliseconds",
+			Buckets:   buckets,
+		}, []string{"msg_type"})
+
+	// MsgStreamConsumeLatency records the latency from the timestamp of a message to the time it's consumed
+	MsgStreamConsumeLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "consume_latency",
+			Help:      "Latency from producing to consuming messages in milliseconds",
+			Buckets:   buckets,
+		}, []string{"msg_type"})
+
+	// FlowGraphNodeQueueLength records the num of messages waiting in the input channels of flow graph nodes
+	FlowGraphNodeQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "flowgraph",
+			Name:      "node_queue_length",
+			Help:      "Length of input queues of flow graph nodes",
+		}, []string{"collection_id", "vchannel", "node_name"})
+
+	registerCommonOnce sync.Once
+)
 
+// registerCommon registers the metrics of the packages shared by the components,
+// it's called by every component and registers them only once in case of several components in one process.
+func registerCommon() {
+	registerCommonOnce.Do(func() {
+		prometheus.MustRegister(MsgStreamProduceLatency)
+		prometheus.MustRegister(MsgStreamConsumeLatency)
+		prometheus.MustRegister(FlowGraphNodeQueueLength)
+	})
 }
 
 //ServeHTTP serves prometheus http service
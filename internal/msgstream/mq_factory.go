@@ -133,3 +133,55 @@ func NewRmsFactory() Factory {
 	rocksmqserver.InitRocksMQ()
 	return f
 }
+
+// KmsFactory is a kafka msgstream factory that implemented Factory interface(msgstream.go)
+type KmsFactory struct {
+	dispatcherFactory ProtoUDFactory
+	// the following members must be public, so that mapstructure.Decode() can access them
+	KafkaAddress   string
+	ReceiveBufSize int64
+	KafkaBufSize   int64
+}
+
+// SetParams is used to set parameters for KmsFactory
+func (f *KmsFactory) SetParams(params map[string]interface{}) error {
+	err := mapstructure.Decode(params, f)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewMsgStream is used to generate a new Msgstream object
+func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := mqclient.NewKafkaClient(f.KafkaAddress)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewTtMsgStream is used to generate a new TtMsgstream object
+func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
+	kafkaClient, err := mqclient.NewKafkaClient(f.KafkaAddress)
+	if err != nil {
+		return nil, err
+	}
+	return NewMqTtMsgStream(ctx, f.ReceiveBufSize, f.KafkaBufSize, kafkaClient, f.dispatcherFactory.NewUnmarshalDispatcher())
+}
+
+// NewQueryMsgStream is used to generate a new QueryMsgstream object
+func (f *KmsFactory) NewQueryMsgStream(ctx context.Context) (MsgStream, error) {
+	return f.NewMsgStream(ctx)
+}
+
+// NewKmsFactory is used to generate a new KmsFactory object connected to the comma separated broker list
+func NewKmsFactory(address string) Factory {
+	f := &KmsFactory{
+		dispatcherFactory: ProtoUDFactory{},
+		KafkaAddress:      address,
+		ReceiveBufSize:    1024,
+		KafkaBufSize:      1024,
+	}
+	return f
+}
@@ -21,7 +21,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 )
 
 func TestPmsFactory(t *testing.T) {
@@ -92,3 +97,53 @@ func TestRmsFactory_SetParams(t *testing.T) {
 	err := rmsFactory.SetParams(m)
 	assert.NotNil(t, err)
 }
+
+func TestKmsFactory(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.Nil(t, err)
+	defer cluster.Close()
+
+	kmsFactory := NewKmsFactory(cluster.BootstrapServers())
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err = kmsFactory.SetParams(m)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	inputStream, err := kmsFactory.NewMsgStream(ctx)
+	assert.Nil(t, err)
+	outputStream, err := kmsFactory.NewQueryMsgStream(ctx)
+	assert.Nil(t, err)
+	_, err = kmsFactory.NewTtMsgStream(ctx)
+	assert.Nil(t, err)
+
+	channel := funcutil.RandomString(8)
+	inputStream.AsProducer([]string{channel})
+	outputStream.AsConsumer([]string{channel}, funcutil.RandomString(8))
+	outputStream.Start()
+	defer inputStream.Close()
+	defer outputStream.Close()
+
+	msgPack := MsgPack{}
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 1))
+	msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_Insert, 3))
+	err = inputStream.Produce(&msgPack)
+	assert.Nil(t, err)
+	receiveMsg(outputStream, len(msgPack.Msgs))
+
+	_, err = NewKmsFactory("").NewMsgStream(ctx)
+	assert.Error(t, err)
+}
+
+func TestKmsFactory_SetParams(t *testing.T) {
+	kmsFactory := (*KmsFactory)(nil)
+
+	m := map[string]interface{}{
+		"ReceiveBufSize": 1024,
+		"KafkaBufSize":   1024,
+	}
+	err := kmsFactory.SetParams(m)
+	assert.NotNil(t, err)
+}
@@ -28,11 +28,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/opentracing/opentracing-go"
 )
 
@@ -308,6 +310,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
 			ms.producerLock.Lock()
+			sendStart := time.Now()
 			if _, err := ms.producers[channel].Send(
 				spanCtx,
 				msg,
@@ -317,6 +320,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 				sp.Finish()
 				return err
 			}
+			metrics.MsgStreamProduceLatency.WithLabelValues(v.Msgs[i].Type().String()).Observe(float64(time.Since(sendStart).Milliseconds()))
 			sp.Finish()
 			ms.producerLock.Unlock()
 		}
@@ -374,6 +378,7 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
 
 			ms.producerLock.Lock()
+			sendStart := time.Now()
 			id, err := ms.producers[channel].Send(
 				spanCtx,
 				msg,
@@ -384,6 +389,7 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 				sp.Finish()
 				return ids, err
 			}
+			metrics.MsgStreamProduceLatency.WithLabelValues(tsMsg.Type().String()).Observe(float64(time.Since(sendStart).Milliseconds()))
 			ids[channel] = append(ids[channel], id)
 			sp.Finish()
 			ms.producerLock.Unlock()
@@ -418,6 +424,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 
 		ms.producerLock.Lock()
 		for _, producer := range ms.producers {
+			sendStart := time.Now()
 			if _, err := producer.Send(
 				spanCtx,
 				msg,
@@ -427,6 +434,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 				sp.Finish()
 				return err
 			}
+			metrics.MsgStreamProduceLatency.WithLabelValues(v.Type().String()).Observe(float64(time.Since(sendStart).Milliseconds()))
 		}
 		ms.producerLock.Unlock()
 		sp.Finish()
@@ -460,6 +468,7 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
+			sendStart := time.Now()
 			id, err := producer.Send(spanCtx, msg)
 			if err != nil {
 				ms.producerLock.Unlock()
@@ -467,6 +476,7 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 				sp.Finish()
 				return ids, err
 			}
+			metrics.MsgStreamProduceLatency.WithLabelValues(v.Type().String()).Observe(float64(time.Since(sendStart).Milliseconds()))
 			ids[channel] = append(ids[channel], id)
 		}
 		ms.producerLock.Unlock()
@@ -514,6 +524,10 @@ func (ms *mqMsgStream) getTsMsgFromConsumerMsg(msg mqclient.Message) (TsMsg, err
 		MsgID:       msg.ID().Serialize(),
 	})
 
+	// the latency from the timestamp of the msg, which is allocated before it's produced, to now
+	produceTime, _ := tsoutil.ParseTS(tsMsg.BeginTs())
+	metrics.MsgStreamConsumeLatency.WithLabelValues(tsMsg.Type().String()).Observe(float64(time.Since(produceTime).Milliseconds()))
+
 	return tsMsg, nil
 }
 
@@ -610,6 +610,36 @@ func TestStream_PulsarMsgStream_DeleteRepackFunc(t *testing.T) {
 	(*outputStream).Close()
 }
 
+func TestDeleteRepackFunc_StringPrimaryKeys(t *testing.T) {
+	deleteMsg := &DeleteMsg{
+		BaseMsg: BaseMsg{
+			HashValues: []uint32{0, 1},
+		},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_Delete,
+				MsgID:     1,
+				Timestamp: 1,
+				SourceID:  1,
+			},
+			CollectionName:    "Collection",
+			ShardName:         "chan-1",
+			Timestamps:        []Timestamp{1, 2},
+			StringPrimaryKeys: []string{"uuid-1", "uuid-2"},
+		},
+	}
+
+	result, err := DeleteRepackFunc([]TsMsg{deleteMsg}, [][]int32{{0, 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, []string{"uuid-1"}, result[0].Msgs[0].(*DeleteMsg).StringPrimaryKeys)
+	assert.Empty(t, result[0].Msgs[0].(*DeleteMsg).PrimaryKeys)
+	assert.Equal(t, []string{"uuid-2"}, result[1].Msgs[0].(*DeleteMsg).StringPrimaryKeys)
+
+	_, err = DeleteRepackFunc([]TsMsg{deleteMsg}, [][]int32{{0}})
+	assert.NotNil(t, err)
+}
+
 func TestStream_PulsarMsgStream_DefaultRepackFunc(t *testing.T) {
 	pulsarAddress, _ := Params.Load("_PulsarAddress")
 	c1, c2 := funcutil.RandomString(8), funcutil.RandomString(8)
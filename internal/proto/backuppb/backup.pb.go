@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backup.proto
+
+package backuppb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	commonpb "github.com/milvus-io/milvus/internal/proto/commonpb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+	schemapb "github.com/milvus-io/milvus/internal/proto/schemapb"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PartitionBackup struct {
+	PartitionName string `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	PartitionID   int64  `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// flushed segments of the partition, binlog paths are the ones of the source cluster
+	Segments             []*datapb.SegmentInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PartitionBackup) Reset()         { *m = PartitionBackup{} }
+func (m *PartitionBackup) String() string { return proto.CompactTextString(m) }
+func (*PartitionBackup) ProtoMessage()    {}
+func (*PartitionBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{0}
+}
+
+func (m *PartitionBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionBackup.Unmarshal(m, b)
+}
+func (m *PartitionBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionBackup.Marshal(b, m, deterministic)
+}
+func (m *PartitionBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionBackup.Merge(m, src)
+}
+func (m *PartitionBackup) XXX_Size() int {
+	return xxx_messageInfo_PartitionBackup.Size(m)
+}
+func (m *PartitionBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionBackup proto.InternalMessageInfo
+
+func (m *PartitionBackup) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *PartitionBackup) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionBackup) GetSegments() []*datapb.SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type IndexBackup struct {
+	IndexName            string                   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	FieldName            string                   `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *IndexBackup) Reset()         { *m = IndexBackup{} }
+func (m *IndexBackup) String() string { return proto.CompactTextString(m) }
+func (*IndexBackup) ProtoMessage()    {}
+func (*IndexBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{1}
+}
+
+func (m *IndexBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexBackup.Unmarshal(m, b)
+}
+func (m *IndexBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexBackup.Marshal(b, m, deterministic)
+}
+func (m *IndexBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBackup.Merge(m, src)
+}
+func (m *IndexBackup) XXX_Size() int {
+	return xxx_messageInfo_IndexBackup.Size(m)
+}
+func (m *IndexBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBackup proto.InternalMessageInfo
+
+func (m *IndexBackup) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexBackup) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *IndexBackup) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// BackupManifest describes a collection backup, the binlogs of the segments are copied
+// under the backup directory keeping their original paths
+type BackupManifest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the timestamp the backup is taken at, or the latest checkpoint of the backed up segments if no timestamp
+	// is given, the collection is flushed when the backup starts
+	BackupTimestamp     uint64                     `protobuf:"varint,2,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
+	CollectionName      string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID        int64                      `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema              *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum           int32                      `protobuf:"varint,6,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel    commonpb.ConsistencyLevel  `protobuf:"varint,7,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	VirtualChannelNames []string                   `protobuf:"bytes,8,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
+	Partitions          []*PartitionBackup         `protobuf:"bytes,9,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Indexes             []*IndexBackup             `protobuf:"bytes,10,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	// the database of the backed up collection, empty means the default database
+	DbName               string   `protobuf:"bytes,11,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupManifest) Reset()         { *m = BackupManifest{} }
+func (m *BackupManifest) String() string { return proto.CompactTextString(m) }
+func (*BackupManifest) ProtoMessage()    {}
+func (*BackupManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65240d19de191688, []int{2}
+}
+
+func (m *BackupManifest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupManifest.Unmarshal(m, b)
+}
+func (m *BackupManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupManifest.Marshal(b, m, deterministic)
+}
+func (m *BackupManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupManifest.Merge(m, src)
+}
+func (m *BackupManifest) XXX_Size() int {
+	return xxx_messageInfo_BackupManifest.Size(m)
+}
+func (m *BackupManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupManifest proto.InternalMessageInfo
+
+func (m *BackupManifest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetBackupTimestamp() uint64 {
+	if m != nil {
+		return m.BackupTimestamp
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *BackupManifest) GetVirtualChannelNames() []string {
+	if m != nil {
+		return m.VirtualChannelNames
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetPartitions() []*PartitionBackup {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetIndexes() []*IndexBackup {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PartitionBackup)(nil), "milvus.proto.backup.PartitionBackup")
+	proto.RegisterType((*IndexBackup)(nil), "milvus.proto.backup.IndexBackup")
+	proto.RegisterType((*BackupManifest)(nil), "milvus.proto.backup.BackupManifest")
+}
+
+func init() { proto.RegisterFile("backup.proto", fileDescriptor_65240d19de191688) }
+
+var fileDescriptor_65240d19de191688 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0x86, 0xe5, 0x40, 0x20, 0x1c, 0xf8, 0x80, 0x6f, 0xa2, 0xaa, 0x56, 0xa4, 0x54, 0x2e, 0x6a,
+	0x54, 0x77, 0x51, 0x23, 0x11, 0x75, 0xd1, 0x48, 0xdd, 0x04, 0x36, 0xa8, 0x6d, 0x14, 0x4d, 0xaa,
+	0x2e, 0xba, 0xb1, 0xc6, 0xf6, 0x10, 0x46, 0x9d, 0x1f, 0xcb, 0x33, 0x46, 0xcd, 0x0d, 0xf4, 0x12,
+	0xba, 0xeb, 0xbd, 0x56, 0x9e, 0x31, 0x60, 0x50, 0x76, 0x3e, 0xcf, 0xf9, 0x99, 0xd7, 0xef, 0x39,
+	0x30, 0x48, 0x48, 0xfa, 0xb3, 0xcc, 0xa3, 0xbc, 0x50, 0x46, 0xa1, 0x73, 0xc1, 0xf8, 0xa6, 0xd4,
+	0x2e, 0x8a, 0x5c, 0xea, 0x62, 0x90, 0x2a, 0x21, 0x94, 0x74, 0xf0, 0x62, 0xa0, 0xd3, 0x35, 0x15,
+	0xa4, 0x8e, 0xc6, 0x19, 0x31, 0x24, 0x4e, 0x95, 0x2a, 0x32, 0x47, 0x26, 0x7f, 0x3c, 0x18, 0xdd,
+	0x93, 0xc2, 0x30, 0xc3, 0x94, 0xbc, 0xb5, 0x13, 0xd0, 0x15, 0x0c, 0xf3, 0x2d, 0x8a, 0x25, 0x11,
+	0xd4, 0xf7, 0x02, 0x2f, 0xec, 0xe1, 0xff, 0x76, 0xf4, 0x8e, 0x08, 0x8a, 0x02, 0xe8, 0xef, 0xc0,
+	0x72, 0xe1, 0x9f, 0x04, 0x5e, 0xd8, 0xc2, 0x4d, 0x84, 0x6e, 0xe0, 0x4c, 0xd3, 0x47, 0x41, 0xa5,
+	0xd1, 0x7e, 0x2b, 0x68, 0x85, 0xfd, 0xd9, 0xab, 0xe8, 0x40, 0x72, 0x25, 0x27, 0x7a, 0x70, 0x25,
+	0x4b, 0xb9, 0x52, 0x78, 0x57, 0x3f, 0xf9, 0xed, 0x41, 0x7f, 0x29, 0x33, 0xfa, 0xab, 0x16, 0x75,
+	0x09, 0xc0, 0xaa, 0xb0, 0x29, 0xa8, 0x67, 0x89, 0x15, 0x73, 0x09, 0xb0, 0x62, 0x94, 0x67, 0x2e,
+	0x7d, 0xe2, 0xd2, 0x96, 0xd8, 0xf4, 0x47, 0xe8, 0xe4, 0xa4, 0x20, 0x62, 0xab, 0xe3, 0xf5, 0xa1,
+	0x8e, 0xda, 0xb2, 0xcf, 0xf4, 0xe9, 0x3b, 0xe1, 0x25, 0xbd, 0x27, 0xac, 0xc0, 0x75, 0xc3, 0xe4,
+	0x6f, 0x1b, 0x86, 0x4e, 0xc3, 0x57, 0x22, 0xd9, 0x8a, 0x6a, 0x83, 0x10, 0xb4, 0x1b, 0x2a, 0xec,
+	0x37, 0x7a, 0x07, 0x63, 0xb7, 0x80, 0xd8, 0x30, 0x41, 0xb5, 0x21, 0x22, 0xb7, 0x32, 0xda, 0x78,
+	0xe4, 0xf8, 0xb7, 0x2d, 0x46, 0x6f, 0x61, 0x94, 0x2a, 0xce, 0x69, 0xba, 0x37, 0xb8, 0x65, 0x27,
+	0x0d, 0xf7, 0xd8, 0xaa, 0x9e, 0xc0, 0x60, 0x4f, 0x96, 0x0b, 0xbf, 0x6d, 0x2d, 0x3e, 0x60, 0xe8,
+	0x13, 0x74, 0xdc, 0x8a, 0xfd, 0xd3, 0xc0, 0x0b, 0xfb, 0xb3, 0xab, 0xc3, 0x3f, 0xab, 0xd7, 0x3f,
+	0xdf, 0xb5, 0x3c, 0x58, 0x80, 0xeb, 0xa6, 0xca, 0x37, 0xbd, 0x26, 0x45, 0xa6, 0x63, 0x59, 0x0a,
+	0xbf, 0x13, 0x78, 0xe1, 0x29, 0xee, 0x39, 0x72, 0x57, 0x0a, 0x84, 0xe1, 0xff, 0x54, 0x49, 0xcd,
+	0xb4, 0xa1, 0x32, 0x7d, 0x8a, 0x39, 0xdd, 0x50, 0xee, 0x77, 0x03, 0x2f, 0x1c, 0x1e, 0x3f, 0x54,
+	0x5b, 0x38, 0xdf, 0x57, 0x7f, 0xa9, 0x8a, 0xf1, 0x38, 0x3d, 0x22, 0x68, 0x06, 0x2f, 0x36, 0xac,
+	0x30, 0x25, 0xe1, 0x71, 0xba, 0x26, 0x52, 0x52, 0x6e, 0x3d, 0xd0, 0xfe, 0x59, 0xd0, 0x0a, 0x7b,
+	0xf8, 0xbc, 0x4e, 0xce, 0x5d, 0xae, 0x32, 0x42, 0xa3, 0x05, 0xc0, 0xee, 0xb0, 0xb4, 0xdf, 0xb3,
+	0x3b, 0x7c, 0x13, 0x3d, 0x73, 0xfe, 0xd1, 0xd1, 0x31, 0xe3, 0x46, 0x1f, 0xba, 0x81, 0xae, 0xbd,
+	0x18, 0xaa, 0x7d, 0xb0, 0x23, 0x82, 0x67, 0x47, 0x34, 0xce, 0x0e, 0x6f, 0x1b, 0xd0, 0x4b, 0xe8,
+	0x66, 0x89, 0x5b, 0x56, 0xdf, 0x2e, 0xab, 0x93, 0x25, 0x95, 0xb6, 0xdb, 0x0f, 0x3f, 0xae, 0x1f,
+	0x99, 0x59, 0x97, 0x49, 0x65, 0xc1, 0xd4, 0xcd, 0x7b, 0xcf, 0x54, 0xfd, 0x35, 0x65, 0xd2, 0xd0,
+	0x42, 0x12, 0x3e, 0xb5, 0x4f, 0x4c, 0xdd, 0x13, 0x79, 0x92, 0x74, 0x6c, 0x7c, 0xfd, 0x2f, 0x00,
+	0x00, 0xff, 0xff, 0xa2, 0x60, 0xf5, 0xca, 0xd2, 0x03, 0x00, 0x00,
+}
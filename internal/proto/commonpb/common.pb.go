@@ -49,6 +49,8 @@ const (
 	ErrorCode_OutOfMemory           ErrorCode = 24
 	ErrorCode_IndexNotExist         ErrorCode = 25
 	ErrorCode_EmptyCollection       ErrorCode = 26
+	// the request is rejected by the rate limits or the quotas of Proxy
+	ErrorCode_RateLimit ErrorCode = 27
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,6 +82,7 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "RateLimit",
 	1000: "DDRequestRace",
 }
 
@@ -110,6 +113,7 @@ var ErrorCode_value = map[string]int32{
 	"OutOfMemory":           24,
 	"IndexNotExist":         25,
 	"EmptyCollection":       26,
+	"RateLimit":             27,
 	"DDRequestRace":         1000,
 }
 
@@ -211,6 +215,9 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_GetReplicas        MsgType = 111
+	MsgType_GetShardLeaders    MsgType = 112
+	MsgType_AddField           MsgType = 113
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -234,6 +241,7 @@ const (
 	MsgType_Insert MsgType = 400
 	MsgType_Delete MsgType = 401
 	MsgType_Flush  MsgType = 402
+	MsgType_Upsert MsgType = 403
 	// QUERY
 	MsgType_Search                   MsgType = 500
 	MsgType_SearchResult             MsgType = 501
@@ -263,6 +271,26 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// DEFINITION REQUESTS: DATABASE
+	MsgType_CreateDatabase MsgType = 1300
+	MsgType_DropDatabase   MsgType = 1301
+	MsgType_ListDatabases  MsgType = 1302
+	// Credential
+	MsgType_CreateCredential  MsgType = 1500
+	MsgType_GetCredential     MsgType = 1501
+	MsgType_DeleteCredential  MsgType = 1502
+	MsgType_UpdateCredential  MsgType = 1503
+	MsgType_ListCredUsernames MsgType = 1504
+	// RBAC
+	MsgType_CreateRole             MsgType = 1600
+	MsgType_DropRole               MsgType = 1601
+	MsgType_OperateUserRole        MsgType = 1602
+	MsgType_SelectRole             MsgType = 1603
+	MsgType_SelectUser             MsgType = 1604
+	MsgType_OperatePrivilege       MsgType = 1605
+	MsgType_SelectGrant            MsgType = 1606
+	MsgType_RefreshPolicyInfoCache MsgType = 1607
+	MsgType_ListPolicy             MsgType = 1608
 )
 
 var MsgType_name = map[int32]string{
@@ -278,6 +306,9 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "GetReplicas",
+	112:  "GetShardLeaders",
+	113:  "AddField",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -297,6 +328,7 @@ var MsgType_name = map[int32]string{
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
+	403:  "Upsert",
 	500:  "Search",
 	501:  "SearchResult",
 	502:  "GetIndexState",
@@ -323,6 +355,23 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1300: "CreateDatabase",
+	1301: "DropDatabase",
+	1302: "ListDatabases",
+	1500: "CreateCredential",
+	1501: "GetCredential",
+	1502: "DeleteCredential",
+	1503: "UpdateCredential",
+	1504: "ListCredUsernames",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "OperateUserRole",
+	1603: "SelectRole",
+	1604: "SelectUser",
+	1605: "OperatePrivilege",
+	1606: "SelectGrant",
+	1607: "RefreshPolicyInfoCache",
+	1608: "ListPolicy",
 }
 
 var MsgType_value = map[string]int32{
@@ -338,6 +387,9 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"GetReplicas":              111,
+	"GetShardLeaders":          112,
+	"AddField":                 113,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -357,6 +409,7 @@ var MsgType_value = map[string]int32{
 	"Insert":                   400,
 	"Delete":                   401,
 	"Flush":                    402,
+	"Upsert":                   403,
 	"Search":                   500,
 	"SearchResult":             501,
 	"GetIndexState":            502,
@@ -383,6 +436,23 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":        1206,
 	"SegmentFlushDone":         1207,
 	"DataNodeTt":               1208,
+	"CreateDatabase":           1300,
+	"DropDatabase":             1301,
+	"ListDatabases":            1302,
+	"CreateCredential":         1500,
+	"GetCredential":            1501,
+	"DeleteCredential":         1502,
+	"UpdateCredential":         1503,
+	"ListCredUsernames":        1504,
+	"CreateRole":               1600,
+	"DropRole":                 1601,
+	"OperateUserRole":          1602,
+	"SelectRole":               1603,
+	"SelectUser":               1604,
+	"OperatePrivilege":         1605,
+	"SelectGrant":              1606,
+	"RefreshPolicyInfoCache":   1607,
+	"ListPolicy":               1608,
 }
 
 func (x MsgType) String() string {
@@ -446,6 +516,37 @@ func (CompactionState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{5}
 }
 
+type ImportState int32
+
+const (
+	ImportState_ImportPending   ImportState = 0
+	ImportState_ImportFailed    ImportState = 1
+	ImportState_ImportStarted   ImportState = 2
+	ImportState_ImportCompleted ImportState = 3
+)
+
+var ImportState_name = map[int32]string{
+	0: "ImportPending",
+	1: "ImportFailed",
+	2: "ImportStarted",
+	3: "ImportCompleted",
+}
+
+var ImportState_value = map[string]int32{
+	"ImportPending":   0,
+	"ImportFailed":    1,
+	"ImportStarted":   2,
+	"ImportCompleted": 3,
+}
+
+func (x ImportState) String() string {
+	return proto.EnumName(ImportState_name, int32(x))
+}
+
+func (ImportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{6}
+}
+
 type ConsistencyLevel int32
 
 const (
@@ -477,7 +578,7 @@ func (x ConsistencyLevel) String() string {
 }
 
 func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_555bd8c177793206, []int{6}
+	return fileDescriptor_555bd8c177793206, []int{7}
 }
 
 type Status struct {
@@ -865,6 +966,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
 	proto.RegisterEnum("milvus.proto.common.CompactionState", CompactionState_name, CompactionState_value)
+	proto.RegisterEnum("milvus.proto.common.ImportState", ImportState_name, ImportState_value)
 	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
@@ -879,99 +981,115 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x72, 0x1b, 0x4b,
-	0x11, 0xf6, 0x6a, 0x15, 0xcb, 0x6a, 0xcb, 0xf6, 0x64, 0xfc, 0x13, 0x9f, 0x10, 0xa8, 0x94, 0xaf,
-	0x52, 0xae, 0x3a, 0x09, 0x90, 0x02, 0xae, 0xce, 0x85, 0xa5, 0xb5, 0x1d, 0x55, 0x62, 0xc7, 0x48,
-	0x4e, 0x38, 0xc5, 0x05, 0xa9, 0xf1, 0x6e, 0x5b, 0x1a, 0x32, 0x3b, 0x23, 0x66, 0x66, 0x1d, 0x8b,
-	0x2b, 0x78, 0x03, 0x38, 0xbc, 0x06, 0x50, 0xfc, 0x43, 0xf1, 0x04, 0xfc, 0x5f, 0x03, 0x4f, 0xc0,
-	0x03, 0xf0, 0x7b, 0x7e, 0xa9, 0x9e, 0x5d, 0x49, 0x7b, 0xaa, 0x4e, 0xae, 0xce, 0xdd, 0xf6, 0xd7,
-	0xdd, 0x5f, 0xf7, 0x74, 0xf7, 0xf4, 0x0e, 0x74, 0x52, 0x93, 0xe7, 0x46, 0xdf, 0x9f, 0x58, 0xe3,
-	0x0d, 0xdf, 0xcc, 0xa5, 0xba, 0x2a, 0x5c, 0x29, 0xdd, 0x2f, 0x55, 0x7b, 0x2f, 0x60, 0x79, 0xe8,
-	0x85, 0x2f, 0x1c, 0x7f, 0x0b, 0x00, 0xad, 0x35, 0xf6, 0x45, 0x6a, 0x32, 0xdc, 0x8d, 0xee, 0x46,
-	0xf7, 0xd6, 0xbf, 0xf8, 0xb9, 0xfb, 0x9f, 0xe0, 0x73, 0xff, 0x90, 0xcc, 0x7a, 0x26, 0xc3, 0x41,
-	0x1b, 0x67, 0x9f, 0x7c, 0x07, 0x96, 0x2d, 0x0a, 0x67, 0xf4, 0x6e, 0xe3, 0x6e, 0x74, 0xaf, 0x3d,
-	0xa8, 0xa4, 0xbd, 0x2f, 0x43, 0xe7, 0x31, 0x4e, 0x9f, 0x0b, 0x55, 0xe0, 0x99, 0x90, 0x96, 0x33,
-	0x88, 0x5f, 0xe2, 0x34, 0xf0, 0xb7, 0x07, 0xf4, 0xc9, 0xb7, 0xe0, 0xc6, 0x15, 0xa9, 0x2b, 0xc7,
-	0x52, 0xd8, 0x7b, 0x08, 0xab, 0x8f, 0x71, 0x9a, 0x08, 0x2f, 0x5e, 0xe3, 0xc6, 0xa1, 0x99, 0x09,
-	0x2f, 0x82, 0x57, 0x67, 0x10, 0xbe, 0xf7, 0xee, 0x40, 0xb3, 0xab, 0xcc, 0xc5, 0x82, 0x32, 0x0a,
-	0xca, 0x8a, 0xf2, 0x4d, 0x68, 0x1d, 0x64, 0x99, 0x45, 0xe7, 0xf8, 0x3a, 0x34, 0xe4, 0xa4, 0x62,
-	0x6b, 0xc8, 0x09, 0x91, 0x4d, 0x8c, 0xf5, 0x81, 0x2c, 0x1e, 0x84, 0xef, 0xbd, 0x77, 0x22, 0x68,
-	0x9d, 0xb8, 0x51, 0x57, 0x38, 0xe4, 0x5f, 0x81, 0x95, 0xdc, 0x8d, 0x5e, 0xf8, 0xe9, 0x64, 0x56,
-	0x9a, 0x3b, 0x9f, 0x58, 0x9a, 0x13, 0x37, 0x3a, 0x9f, 0x4e, 0x70, 0xd0, 0xca, 0xcb, 0x0f, 0xca,
-	0x24, 0x77, 0xa3, 0x7e, 0x52, 0x31, 0x97, 0x02, 0xbf, 0x03, 0x6d, 0x2f, 0x73, 0x74, 0x5e, 0xe4,
-	0x93, 0xdd, 0xf8, 0x6e, 0x74, 0xaf, 0x39, 0x58, 0x00, 0xfc, 0x36, 0xac, 0x38, 0x53, 0xd8, 0x14,
-	0xfb, 0xc9, 0x6e, 0x33, 0xb8, 0xcd, 0xe5, 0xbd, 0xb7, 0xa0, 0x7d, 0xe2, 0x46, 0x8f, 0x50, 0x64,
-	0x68, 0xf9, 0xe7, 0xa1, 0x79, 0x21, 0x5c, 0x99, 0xd1, 0xea, 0xeb, 0x33, 0xa2, 0x13, 0x0c, 0x82,
-	0xe5, 0xde, 0x37, 0xa0, 0x93, 0x9c, 0x3c, 0xf9, 0x14, 0x0c, 0x94, 0xba, 0x1b, 0x0b, 0x9b, 0x9d,
-	0x8a, 0x7c, 0xd6, 0xb1, 0x05, 0xb0, 0xff, 0xdb, 0x26, 0xb4, 0xe7, 0xe3, 0xc1, 0x57, 0xa1, 0x35,
-	0x2c, 0xd2, 0x14, 0x9d, 0x63, 0x4b, 0x7c, 0x13, 0x36, 0x9e, 0x69, 0xbc, 0x9e, 0x60, 0xea, 0x31,
-	0x0b, 0x36, 0x2c, 0xe2, 0x37, 0x61, 0xad, 0x67, 0xb4, 0xc6, 0xd4, 0x1f, 0x09, 0xa9, 0x30, 0x63,
-	0x0d, 0xbe, 0x05, 0xec, 0x0c, 0x6d, 0x2e, 0x9d, 0x93, 0x46, 0x27, 0xa8, 0x25, 0x66, 0x2c, 0xe6,
-	0xb7, 0x60, 0xb3, 0x67, 0x94, 0xc2, 0xd4, 0x4b, 0xa3, 0x4f, 0x8d, 0x3f, 0xbc, 0x96, 0xce, 0x3b,
-	0xd6, 0x24, 0xda, 0xbe, 0x52, 0x38, 0x12, 0xea, 0xc0, 0x8e, 0x8a, 0x1c, 0xb5, 0x67, 0x37, 0x88,
-	0xa3, 0x02, 0x13, 0x99, 0xa3, 0x26, 0x26, 0xd6, 0xaa, 0xa1, 0x7d, 0x9d, 0xe1, 0x35, 0xf5, 0x87,
-	0xad, 0xf0, 0x37, 0x60, 0xbb, 0x42, 0x6b, 0x01, 0x44, 0x8e, 0xac, 0xcd, 0x37, 0x60, 0xb5, 0x52,
-	0x9d, 0x3f, 0x3d, 0x7b, 0xcc, 0xa0, 0xc6, 0x30, 0x30, 0xaf, 0x06, 0x98, 0x1a, 0x9b, 0xb1, 0xd5,
-	0x5a, 0x0a, 0xcf, 0x31, 0xf5, 0xc6, 0xf6, 0x13, 0xd6, 0xa1, 0x84, 0x2b, 0x70, 0x88, 0xc2, 0xa6,
-	0xe3, 0x01, 0xba, 0x42, 0x79, 0xb6, 0xc6, 0x19, 0x74, 0x8e, 0xa4, 0xc2, 0x53, 0xe3, 0x8f, 0x4c,
-	0xa1, 0x33, 0xb6, 0xce, 0xd7, 0x01, 0x4e, 0xd0, 0x8b, 0xaa, 0x02, 0x1b, 0x14, 0xb6, 0x27, 0xd2,
-	0x31, 0x56, 0x00, 0xe3, 0x3b, 0xc0, 0x7b, 0x42, 0x6b, 0xe3, 0x7b, 0x16, 0x85, 0xc7, 0x23, 0xa3,
-	0x32, 0xb4, 0xec, 0x26, 0xa5, 0xf3, 0x31, 0x5c, 0x2a, 0x64, 0x7c, 0x61, 0x9d, 0xa0, 0xc2, 0xb9,
-	0xf5, 0xe6, 0xc2, 0xba, 0xc2, 0xc9, 0x7a, 0x8b, 0x92, 0xef, 0x16, 0x52, 0x65, 0xa1, 0x24, 0x65,
-	0x5b, 0xb6, 0x29, 0xc7, 0x2a, 0xf9, 0xd3, 0x27, 0xfd, 0xe1, 0x39, 0xdb, 0xe1, 0xdb, 0x70, 0xb3,
-	0x42, 0x4e, 0xd0, 0x5b, 0x99, 0x86, 0xe2, 0xdd, 0xa2, 0x54, 0x9f, 0x16, 0xfe, 0xe9, 0xe5, 0x09,
-	0xe6, 0xc6, 0x4e, 0xd9, 0x2e, 0x35, 0x34, 0x30, 0xcd, 0x5a, 0xc4, 0xde, 0xa0, 0x08, 0x87, 0xf9,
-	0xc4, 0x4f, 0x17, 0xe5, 0x65, 0xb7, 0x39, 0x87, 0xb5, 0x24, 0x19, 0xe0, 0xb7, 0x0a, 0x74, 0x7e,
-	0x20, 0x52, 0x64, 0xff, 0x68, 0xed, 0xbf, 0x0d, 0x10, 0x7c, 0x69, 0x21, 0x21, 0xe7, 0xb0, 0xbe,
-	0x90, 0x4e, 0x8d, 0x46, 0xb6, 0xc4, 0x3b, 0xb0, 0xf2, 0x4c, 0x4b, 0xe7, 0x0a, 0xcc, 0x58, 0x44,
-	0x75, 0xeb, 0xeb, 0x33, 0x6b, 0x46, 0x74, 0xa5, 0x59, 0x83, 0xb4, 0x47, 0x52, 0x4b, 0x37, 0x0e,
-	0x13, 0x03, 0xb0, 0x5c, 0x15, 0xb0, 0xb9, 0xef, 0xa0, 0x33, 0xc4, 0x11, 0x0d, 0x47, 0xc9, 0xbd,
-	0x05, 0xac, 0x2e, 0x2f, 0xd8, 0xe7, 0x69, 0x47, 0x34, 0xbc, 0xc7, 0xd6, 0xbc, 0x92, 0x7a, 0xc4,
-	0x1a, 0x44, 0x36, 0x44, 0xa1, 0x02, 0xf1, 0x2a, 0xb4, 0x8e, 0x54, 0x11, 0xa2, 0x34, 0x43, 0x4c,
-	0x12, 0xc8, 0xec, 0x06, 0xa9, 0x12, 0x6b, 0x26, 0x13, 0xcc, 0xd8, 0xf2, 0xfe, 0x0f, 0xda, 0x61,
-	0x7f, 0x84, 0x35, 0xb0, 0x06, 0xed, 0x67, 0x3a, 0xc3, 0x4b, 0xa9, 0x31, 0x63, 0x4b, 0xa1, 0x15,
-	0xa1, 0x65, 0xb5, 0x9a, 0x64, 0x74, 0x62, 0xf2, 0xae, 0x61, 0x48, 0xf5, 0x7c, 0x24, 0x5c, 0x0d,
-	0xba, 0xa4, 0xfe, 0x26, 0xe8, 0x52, 0x2b, 0x2f, 0xea, 0xee, 0x23, 0xaa, 0xf3, 0x70, 0x6c, 0x5e,
-	0x2d, 0x30, 0xc7, 0xc6, 0x14, 0xe9, 0x18, 0xfd, 0x70, 0xea, 0x3c, 0xe6, 0x3d, 0xa3, 0x2f, 0xe5,
-	0xc8, 0x31, 0x49, 0x91, 0x9e, 0x18, 0x91, 0xd5, 0xdc, 0xbf, 0x49, 0x1d, 0x1e, 0xa0, 0x42, 0xe1,
-	0xea, 0xac, 0x2f, 0xc3, 0x30, 0x86, 0x54, 0x0f, 0x94, 0x14, 0x8e, 0x29, 0x3a, 0x0a, 0x65, 0x59,
-	0x8a, 0x39, 0x35, 0xe1, 0x40, 0x79, 0xb4, 0xa5, 0xac, 0xf9, 0x16, 0x6c, 0x94, 0xf6, 0x67, 0xc2,
-	0x7a, 0x19, 0x48, 0x7e, 0x17, 0x85, 0x76, 0x5b, 0x33, 0x59, 0x60, 0xbf, 0xa7, 0xbb, 0xdf, 0x79,
-	0x24, 0xdc, 0x02, 0xfa, 0x43, 0xc4, 0x77, 0xe0, 0xe6, 0xec, 0x68, 0x0b, 0xfc, 0x8f, 0x11, 0xdf,
-	0x84, 0x75, 0x3a, 0xda, 0x1c, 0x73, 0xec, 0x4f, 0x01, 0xa4, 0x43, 0xd4, 0xc0, 0x3f, 0x07, 0x86,
-	0xea, 0x14, 0x35, 0xfc, 0x2f, 0x21, 0x18, 0x31, 0x54, 0x5d, 0x77, 0xec, 0xdd, 0x88, 0x32, 0x9d,
-	0x05, 0xab, 0x60, 0xf6, 0x5e, 0x30, 0x24, 0xd6, 0xb9, 0xe1, 0xfb, 0xc1, 0xb0, 0xe2, 0x9c, 0xa3,
-	0x1f, 0x04, 0xf4, 0x91, 0xd0, 0x99, 0xb9, 0xbc, 0x9c, 0xa3, 0x1f, 0x46, 0x7c, 0x17, 0x36, 0xc9,
-	0xbd, 0x2b, 0x94, 0xd0, 0xe9, 0xc2, 0xfe, 0xa3, 0x88, 0xb3, 0x59, 0x21, 0xc3, 0x54, 0xb3, 0x1f,
-	0x36, 0x42, 0x51, 0xaa, 0x04, 0x4a, 0xec, 0x47, 0x0d, 0xbe, 0x5e, 0x56, 0xb7, 0x94, 0x7f, 0xdc,
-	0xe0, 0xab, 0xb0, 0xdc, 0xd7, 0x0e, 0xad, 0x67, 0xdf, 0xa3, 0xc9, 0x5b, 0x2e, 0xef, 0x2e, 0xfb,
-	0x3e, 0xcd, 0xf7, 0x8d, 0x30, 0x79, 0xec, 0x9d, 0xa0, 0x28, 0xb7, 0x0c, 0xfb, 0x67, 0x1c, 0x8e,
-	0x5a, 0x5f, 0x39, 0xff, 0x8a, 0x29, 0xd2, 0x31, 0xfa, 0xc5, 0x75, 0x62, 0xff, 0x8e, 0xf9, 0x6d,
-	0xd8, 0x9e, 0x61, 0x61, 0x01, 0xcc, 0x2f, 0xd2, 0x7f, 0x62, 0x7e, 0x07, 0x6e, 0x1d, 0xa3, 0x5f,
-	0xcc, 0x01, 0x39, 0x49, 0xe7, 0x65, 0xea, 0xd8, 0x7f, 0x63, 0xfe, 0x19, 0xd8, 0x39, 0x46, 0x3f,
-	0xaf, 0x6f, 0x4d, 0xf9, 0xbf, 0x98, 0xaf, 0xc1, 0xca, 0x80, 0x36, 0x04, 0x5e, 0x21, 0x7b, 0x37,
-	0xa6, 0x26, 0xcd, 0xc4, 0x2a, 0x9d, 0xf7, 0x62, 0x2a, 0xdd, 0xd7, 0x84, 0x4f, 0xc7, 0x49, 0xde,
-	0x1b, 0x0b, 0xad, 0x51, 0x39, 0xf6, 0x7e, 0xcc, 0xb7, 0x81, 0x0d, 0x30, 0x37, 0x57, 0x58, 0x83,
-	0x3f, 0xa0, 0xcd, 0xcf, 0x83, 0xf1, 0x57, 0x0b, 0xb4, 0xd3, 0xb9, 0xe2, 0xc3, 0x98, 0x4a, 0x5d,
-	0xda, 0x7f, 0x5c, 0xf3, 0x51, 0xcc, 0x3f, 0x0b, 0xbb, 0xe5, 0x6d, 0x9d, 0xd5, 0x9f, 0x94, 0x23,
-	0xec, 0xeb, 0x4b, 0xc3, 0xbe, 0xd3, 0x9c, 0x33, 0x26, 0xa8, 0xbc, 0x98, 0xfb, 0x7d, 0xb7, 0x49,
-	0x2d, 0xaa, 0x3c, 0x82, 0xe9, 0x5f, 0x9b, 0x7c, 0x03, 0xa0, 0xbc, 0x3b, 0x01, 0xf8, 0x5b, 0x93,
-	0x52, 0x3f, 0x46, 0x4f, 0xab, 0xff, 0x0a, 0xed, 0x34, 0xa0, 0x7f, 0x6f, 0xd2, 0xa1, 0xcf, 0x65,
-	0x8e, 0xe7, 0x32, 0x7d, 0xc9, 0x7e, 0xd2, 0xa6, 0x43, 0x87, 0x9c, 0x4e, 0x4d, 0x86, 0x54, 0x1d,
-	0xc7, 0x7e, 0xda, 0xa6, 0xce, 0xd2, 0x64, 0x94, 0x9d, 0xfd, 0x59, 0x90, 0xab, 0xfd, 0xd7, 0x4f,
-	0xd8, 0xcf, 0xe9, 0x67, 0x03, 0x95, 0x7c, 0x3e, 0x7c, 0xca, 0x7e, 0xd1, 0xa6, 0x50, 0x07, 0x4a,
-	0x99, 0x54, 0xf8, 0xf9, 0x7c, 0xfe, 0xb2, 0x4d, 0x03, 0x5e, 0x5b, 0x5d, 0x55, 0xdd, 0x7f, 0xd5,
-	0xa6, 0xea, 0x55, 0x78, 0x98, 0x8a, 0x84, 0x56, 0xda, 0xaf, 0x03, 0x2b, 0xbd, 0xa1, 0x28, 0x93,
-	0x73, 0xcf, 0x7e, 0xd3, 0xde, 0xdf, 0x83, 0x56, 0xe2, 0x54, 0x58, 0x4a, 0x2d, 0x88, 0x13, 0xa7,
-	0xd8, 0x12, 0xdd, 0xe1, 0xae, 0x31, 0xea, 0xf0, 0x7a, 0x62, 0x9f, 0x7f, 0x81, 0x45, 0xfb, 0x5d,
-	0xd8, 0xe8, 0x99, 0x7c, 0x22, 0xe6, 0xbd, 0x0f, 0x7b, 0xa8, 0x5c, 0x60, 0x98, 0x95, 0x13, 0xb4,
-	0x44, 0x8b, 0xe0, 0xf0, 0x1a, 0xd3, 0xc2, 0xd3, 0xee, 0x8b, 0x48, 0x24, 0x27, 0x1a, 0xcf, 0x8c,
-	0x35, 0xf6, 0xdf, 0x06, 0xd6, 0x33, 0xda, 0x49, 0xe7, 0x51, 0xa7, 0xd3, 0x27, 0x78, 0x85, 0x2a,
-	0x6c, 0x51, 0x6f, 0x8d, 0x1e, 0xb1, 0xa5, 0xf0, 0x36, 0xc0, 0xf0, 0x8f, 0x2f, 0x77, 0x6d, 0x97,
-	0x7e, 0x86, 0xe1, 0x01, 0xb0, 0x0e, 0x70, 0x78, 0x85, 0xda, 0x17, 0x42, 0xa9, 0x29, 0x8b, 0x49,
-	0xee, 0x15, 0xce, 0x9b, 0x5c, 0x7e, 0x9b, 0x56, 0x6e, 0xf7, 0x4b, 0x5f, 0x7f, 0x38, 0x92, 0x7e,
-	0x5c, 0x5c, 0xd0, 0x03, 0xe5, 0x41, 0xf9, 0x62, 0x79, 0x53, 0x9a, 0xea, 0xeb, 0x81, 0xd4, 0x1e,
-	0xad, 0x16, 0xea, 0x41, 0x78, 0xc4, 0x3c, 0x28, 0x1f, 0x31, 0x93, 0x8b, 0x8b, 0xe5, 0x20, 0x3f,
-	0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0x0e, 0xd4, 0xcf, 0x15, 0x0b, 0x00, 0x00,
+	// 1752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x49, 0x73, 0x5c, 0x49,
+	0x11, 0x56, 0x2f, 0x56, 0xab, 0xab, 0x5b, 0x52, 0xba, 0xb4, 0x58, 0xe3, 0x31, 0x84, 0x43, 0x27,
+	0x87, 0x22, 0xc6, 0x06, 0x1c, 0xc0, 0x69, 0x0e, 0x52, 0xb7, 0x24, 0x77, 0x58, 0x1b, 0xaf, 0x25,
+	0x33, 0x31, 0x07, 0x1c, 0xa5, 0xf7, 0x52, 0xdd, 0x85, 0xeb, 0x55, 0xbd, 0xa9, 0xaa, 0x96, 0xd5,
+	0x9c, 0xe0, 0x1f, 0xc0, 0xb0, 0xfc, 0x0a, 0x20, 0x86, 0x9d, 0x23, 0x3b, 0x33, 0x6c, 0x67, 0x20,
+	0xd8, 0x8e, 0xfc, 0x00, 0xd6, 0x59, 0x89, 0xac, 0xf7, 0xba, 0xfb, 0x39, 0x62, 0xe6, 0xc4, 0xad,
+	0xf2, 0xab, 0xcc, 0x2f, 0xb3, 0x32, 0xb3, 0xb2, 0x8a, 0xb5, 0x63, 0x93, 0xa6, 0x46, 0xdf, 0xcd,
+	0xac, 0xf1, 0x86, 0xaf, 0xa4, 0x52, 0x5d, 0x8e, 0x5c, 0x2e, 0xdd, 0xcd, 0xb7, 0x36, 0x1f, 0xb3,
+	0xf9, 0xbe, 0x17, 0x7e, 0xe4, 0xf8, 0x8b, 0x8c, 0xa1, 0xb5, 0xc6, 0x3e, 0x8e, 0x4d, 0x82, 0x1b,
+	0x95, 0xdb, 0x95, 0x3b, 0x4b, 0x1f, 0xfb, 0xf0, 0xdd, 0xf7, 0xb1, 0xb9, 0xbb, 0x4b, 0x6a, 0x1d,
+	0x93, 0x60, 0xd4, 0xc4, 0xc9, 0x92, 0xaf, 0xb3, 0x79, 0x8b, 0xc2, 0x19, 0xbd, 0x51, 0xbd, 0x5d,
+	0xb9, 0xd3, 0x8c, 0x0a, 0x69, 0xf3, 0x13, 0xac, 0xfd, 0x10, 0xc7, 0x8f, 0x84, 0x1a, 0xe1, 0x89,
+	0x90, 0x96, 0x03, 0xab, 0x3d, 0xc1, 0x71, 0xe0, 0x6f, 0x46, 0xb4, 0xe4, 0xab, 0xec, 0xda, 0x25,
+	0x6d, 0x17, 0x86, 0xb9, 0xb0, 0x79, 0x9f, 0xb5, 0x1e, 0xe2, 0xb8, 0x2b, 0xbc, 0xf8, 0x00, 0x33,
+	0xce, 0xea, 0x89, 0xf0, 0x22, 0x58, 0xb5, 0xa3, 0xb0, 0xde, 0xbc, 0xc5, 0xea, 0x3b, 0xca, 0x9c,
+	0xcf, 0x28, 0x2b, 0x61, 0xb3, 0xa0, 0x7c, 0x81, 0x35, 0xb6, 0x93, 0xc4, 0xa2, 0x73, 0x7c, 0x89,
+	0x55, 0x65, 0x56, 0xb0, 0x55, 0x65, 0x46, 0x64, 0x99, 0xb1, 0x3e, 0x90, 0xd5, 0xa2, 0xb0, 0xde,
+	0x7c, 0xb5, 0xc2, 0x1a, 0x87, 0x6e, 0xb0, 0x23, 0x1c, 0xf2, 0x4f, 0xb2, 0x85, 0xd4, 0x0d, 0x1e,
+	0xfb, 0x71, 0x36, 0x49, 0xcd, 0xad, 0xf7, 0x4d, 0xcd, 0xa1, 0x1b, 0x9c, 0x8e, 0x33, 0x8c, 0x1a,
+	0x69, 0xbe, 0xa0, 0x48, 0x52, 0x37, 0xe8, 0x75, 0x0b, 0xe6, 0x5c, 0xe0, 0xb7, 0x58, 0xd3, 0xcb,
+	0x14, 0x9d, 0x17, 0x69, 0xb6, 0x51, 0xbb, 0x5d, 0xb9, 0x53, 0x8f, 0x66, 0x00, 0xbf, 0xc9, 0x16,
+	0x9c, 0x19, 0xd9, 0x18, 0x7b, 0xdd, 0x8d, 0x7a, 0x30, 0x9b, 0xca, 0x9b, 0x2f, 0xb2, 0xe6, 0xa1,
+	0x1b, 0x3c, 0x40, 0x91, 0xa0, 0xe5, 0x1f, 0x61, 0xf5, 0x73, 0xe1, 0xf2, 0x88, 0x5a, 0x1f, 0x1c,
+	0x11, 0x9d, 0x20, 0x0a, 0x9a, 0x9b, 0x9f, 0x61, 0xed, 0xee, 0xe1, 0xc1, 0xff, 0xc1, 0x40, 0xa1,
+	0xbb, 0xa1, 0xb0, 0xc9, 0x91, 0x48, 0x27, 0x15, 0x9b, 0x01, 0x5b, 0x6f, 0xd4, 0x59, 0x73, 0xda,
+	0x1e, 0xbc, 0xc5, 0x1a, 0xfd, 0x51, 0x1c, 0xa3, 0x73, 0x30, 0xc7, 0x57, 0xd8, 0xf2, 0x99, 0xc6,
+	0xab, 0x0c, 0x63, 0x8f, 0x49, 0xd0, 0x81, 0x0a, 0xbf, 0xce, 0x16, 0x3b, 0x46, 0x6b, 0x8c, 0xfd,
+	0x9e, 0x90, 0x0a, 0x13, 0xa8, 0xf2, 0x55, 0x06, 0x27, 0x68, 0x53, 0xe9, 0x9c, 0x34, 0xba, 0x8b,
+	0x5a, 0x62, 0x02, 0x35, 0x7e, 0x83, 0xad, 0x74, 0x8c, 0x52, 0x18, 0x7b, 0x69, 0xf4, 0x91, 0xf1,
+	0xbb, 0x57, 0xd2, 0x79, 0x07, 0x75, 0xa2, 0xed, 0x29, 0x85, 0x03, 0xa1, 0xb6, 0xed, 0x60, 0x94,
+	0xa2, 0xf6, 0x70, 0x8d, 0x38, 0x0a, 0xb0, 0x2b, 0x53, 0xd4, 0xc4, 0x04, 0x8d, 0x12, 0xda, 0xd3,
+	0x09, 0x5e, 0x51, 0x7d, 0x60, 0x81, 0x3f, 0xc7, 0xd6, 0x0a, 0xb4, 0xe4, 0x40, 0xa4, 0x08, 0x4d,
+	0xbe, 0xcc, 0x5a, 0xc5, 0xd6, 0xe9, 0xf1, 0xc9, 0x43, 0x60, 0x25, 0x86, 0xc8, 0x3c, 0x8d, 0x30,
+	0x36, 0x36, 0x81, 0x56, 0x29, 0x84, 0x47, 0x18, 0x7b, 0x63, 0x7b, 0x5d, 0x68, 0x53, 0xc0, 0x05,
+	0xd8, 0x47, 0x61, 0xe3, 0x61, 0x84, 0x6e, 0xa4, 0x3c, 0x2c, 0x72, 0x60, 0xed, 0x3d, 0xa9, 0xf0,
+	0xc8, 0xf8, 0x3d, 0x33, 0xd2, 0x09, 0x2c, 0xf1, 0x25, 0xc6, 0x0e, 0xd1, 0x8b, 0x22, 0x03, 0xcb,
+	0xe4, 0xb6, 0x23, 0xe2, 0x21, 0x16, 0x00, 0xf0, 0x75, 0xc6, 0x3b, 0x42, 0x6b, 0xe3, 0x3b, 0x16,
+	0x85, 0xc7, 0x3d, 0xa3, 0x12, 0xb4, 0x70, 0x9d, 0xc2, 0x79, 0x06, 0x97, 0x0a, 0x81, 0xcf, 0xb4,
+	0xbb, 0xa8, 0x70, 0xaa, 0xbd, 0x32, 0xd3, 0x2e, 0x70, 0xd2, 0x5e, 0xa5, 0xe0, 0x77, 0x46, 0x52,
+	0x25, 0x21, 0x25, 0x79, 0x59, 0xd6, 0x28, 0xc6, 0x22, 0xf8, 0xa3, 0x83, 0x5e, 0xff, 0x14, 0xd6,
+	0xf9, 0x1a, 0xbb, 0x5e, 0x20, 0x87, 0xe8, 0xad, 0x8c, 0x43, 0xf2, 0x6e, 0x50, 0xa8, 0xc7, 0x23,
+	0x7f, 0x7c, 0x71, 0x88, 0xa9, 0xb1, 0x63, 0xd8, 0xa0, 0x82, 0x06, 0xa6, 0x49, 0x89, 0xe0, 0x39,
+	0xf2, 0xb0, 0x9b, 0x66, 0x7e, 0x3c, 0x4b, 0x2f, 0xdc, 0xe4, 0x8b, 0xac, 0x19, 0x09, 0x8f, 0x07,
+	0x32, 0x95, 0x1e, 0x9e, 0xe7, 0x9c, 0x2d, 0x76, 0xbb, 0x11, 0xbe, 0x32, 0x42, 0xe7, 0x23, 0x11,
+	0x23, 0xfc, 0xbd, 0xb1, 0xf5, 0x12, 0x63, 0x81, 0x8a, 0xe6, 0x13, 0x72, 0xce, 0x96, 0x66, 0xd2,
+	0x91, 0xd1, 0x08, 0x73, 0xbc, 0xcd, 0x16, 0xce, 0xb4, 0x74, 0x6e, 0x84, 0x09, 0x54, 0x28, 0x8d,
+	0x3d, 0x7d, 0x62, 0xcd, 0x80, 0x6e, 0x38, 0x54, 0x69, 0x77, 0x4f, 0x6a, 0xe9, 0x86, 0xa1, 0x81,
+	0x18, 0x9b, 0x2f, 0xf2, 0x59, 0xdf, 0x72, 0xac, 0xdd, 0xc7, 0x01, 0xf5, 0x4a, 0xce, 0xbd, 0xca,
+	0xa0, 0x2c, 0xcf, 0xd8, 0xa7, 0xa7, 0xa8, 0x50, 0x2f, 0xef, 0x5b, 0xf3, 0x54, 0xea, 0x01, 0x54,
+	0x89, 0xac, 0x8f, 0x42, 0x05, 0xe2, 0x16, 0x6b, 0xec, 0xa9, 0x51, 0xf0, 0x52, 0x0f, 0x3e, 0x49,
+	0x20, 0xb5, 0x6b, 0xb4, 0xd5, 0xb5, 0x26, 0xcb, 0x30, 0x81, 0xf9, 0xad, 0xd7, 0xda, 0x61, 0x9c,
+	0x84, 0xa9, 0xb0, 0xc8, 0x9a, 0x67, 0x3a, 0xc1, 0x0b, 0xa9, 0x31, 0x81, 0xb9, 0x50, 0x99, 0x50,
+	0xc1, 0x52, 0x8a, 0x12, 0x3a, 0x31, 0x59, 0x97, 0x30, 0xa4, 0xf4, 0x3e, 0x10, 0xae, 0x04, 0x5d,
+	0x50, 0xb9, 0xbb, 0xe8, 0x62, 0x2b, 0xcf, 0xcb, 0xe6, 0x03, 0x4a, 0x7b, 0x7f, 0x68, 0x9e, 0xce,
+	0x30, 0x07, 0x43, 0xf2, 0xb4, 0x8f, 0xbe, 0x3f, 0x76, 0x1e, 0xd3, 0x8e, 0xd1, 0x17, 0x72, 0xe0,
+	0x40, 0x92, 0xa7, 0x03, 0x23, 0x92, 0x92, 0xf9, 0x67, 0xa9, 0xe0, 0x11, 0x2a, 0x14, 0xae, 0xcc,
+	0xfa, 0x24, 0xf4, 0x66, 0x08, 0x75, 0x5b, 0x49, 0xe1, 0x40, 0xd1, 0x51, 0x28, 0xca, 0x5c, 0x4c,
+	0xa9, 0x08, 0xdb, 0xca, 0xa3, 0xcd, 0x65, 0x4d, 0xfa, 0xfb, 0xe8, 0x23, 0xcc, 0x94, 0x8c, 0x85,
+	0x03, 0x43, 0x61, 0x51, 0x04, 0x34, 0x31, 0x0e, 0xc2, 0x0c, 0x72, 0x90, 0x51, 0xda, 0xb6, 0x93,
+	0x64, 0x4f, 0xa2, 0x4a, 0xe0, 0x15, 0xbe, 0xca, 0x96, 0x73, 0x1f, 0x27, 0xc2, 0x7a, 0x19, 0x1c,
+	0xbf, 0x5e, 0x09, 0x2d, 0x62, 0x4d, 0x36, 0xc3, 0xde, 0xa0, 0xf1, 0xd1, 0x7e, 0x20, 0xdc, 0x0c,
+	0xfa, 0x55, 0x85, 0xaf, 0xb3, 0xeb, 0x93, 0x74, 0xcc, 0xf0, 0x5f, 0x57, 0xf8, 0x0a, 0x5b, 0xa2,
+	0x74, 0x4c, 0x31, 0x07, 0xbf, 0x09, 0x20, 0x1d, 0xbc, 0x04, 0xfe, 0x36, 0x30, 0x14, 0x27, 0x2f,
+	0xe1, 0xbf, 0x0b, 0xce, 0x88, 0xa1, 0xe8, 0x14, 0x07, 0x6f, 0x56, 0x28, 0xd2, 0x89, 0xb3, 0x02,
+	0x86, 0xb7, 0x82, 0x22, 0xb1, 0x4e, 0x15, 0xdf, 0x0e, 0x8a, 0x05, 0xe7, 0x14, 0x7d, 0x27, 0xa0,
+	0x0f, 0x84, 0x4e, 0xcc, 0xc5, 0xc5, 0x14, 0x7d, 0xb7, 0xc2, 0x37, 0xd8, 0x0a, 0x99, 0xef, 0x08,
+	0x25, 0x74, 0x3c, 0xd3, 0x7f, 0xaf, 0xc2, 0x61, 0x92, 0xfc, 0x70, 0x13, 0xe0, 0xeb, 0xd5, 0x90,
+	0x94, 0x22, 0x80, 0x1c, 0xfb, 0x46, 0x95, 0x2f, 0xe5, 0x15, 0xc9, 0xe5, 0x6f, 0x56, 0x79, 0x8b,
+	0xcd, 0xf7, 0xb4, 0x43, 0xeb, 0xe1, 0x8b, 0xd4, 0xad, 0xf3, 0xf9, 0xf5, 0x87, 0x2f, 0xd1, 0x9d,
+	0xb8, 0x16, 0xba, 0x15, 0x5e, 0x0d, 0x1b, 0x67, 0x59, 0xd0, 0xfa, 0x72, 0x10, 0xf2, 0xa9, 0x05,
+	0xff, 0xa8, 0x85, 0x73, 0x97, 0x47, 0xd8, 0x3f, 0x6b, 0xe4, 0x76, 0x1f, 0xfd, 0xec, 0x3e, 0xc2,
+	0xbf, 0x6a, 0xfc, 0x26, 0x5b, 0x9b, 0x60, 0x61, 0xa0, 0x4c, 0x6f, 0xe2, 0xbf, 0x6b, 0xfc, 0x16,
+	0xbb, 0xb1, 0x8f, 0x7e, 0xd6, 0x48, 0x64, 0x24, 0x9d, 0x97, 0xb1, 0x83, 0xff, 0xd4, 0xf8, 0xf3,
+	0x6c, 0x7d, 0x1f, 0xfd, 0x34, 0xd9, 0xa5, 0xcd, 0xff, 0xd6, 0xf8, 0x22, 0x5b, 0x88, 0x68, 0xe2,
+	0xe0, 0x25, 0xc2, 0x9b, 0x35, 0xaa, 0xd8, 0x44, 0x2c, 0xc2, 0x79, 0xab, 0x46, 0x79, 0xfc, 0xb4,
+	0xf0, 0xf1, 0xb0, 0x9b, 0x76, 0x86, 0x42, 0x6b, 0x54, 0x0e, 0xde, 0xae, 0xf1, 0x35, 0x06, 0x11,
+	0xa6, 0xe6, 0x12, 0x4b, 0xf0, 0x3b, 0xf4, 0x92, 0xf0, 0xa0, 0xfc, 0xa9, 0x11, 0xda, 0xf1, 0x74,
+	0xe3, 0xdd, 0x1a, 0xe5, 0x3d, 0xd7, 0x7f, 0x76, 0xe7, 0xbd, 0x1a, 0xff, 0x10, 0xdb, 0xc8, 0xaf,
+	0xfb, 0xa4, 0x18, 0xb4, 0x39, 0xc0, 0x9e, 0xbe, 0x30, 0xf0, 0xf9, 0xfa, 0x94, 0xb1, 0x8b, 0xca,
+	0x8b, 0xa9, 0xdd, 0x17, 0xea, 0x54, 0xaf, 0xc2, 0x22, 0xa8, 0xfe, 0xbe, 0xce, 0x97, 0x19, 0xcb,
+	0x2f, 0x5f, 0x00, 0xfe, 0x50, 0xa7, 0xd0, 0xc3, 0xfd, 0x88, 0xcd, 0x25, 0xda, 0x71, 0x40, 0xff,
+	0x58, 0xa7, 0x43, 0x9f, 0xca, 0x14, 0x4f, 0x65, 0xfc, 0x04, 0x5e, 0x6b, 0xd2, 0xa1, 0x43, 0x4c,
+	0x47, 0x26, 0x41, 0xca, 0x8e, 0x83, 0x6f, 0x35, 0xa9, 0xcc, 0xd4, 0x26, 0x79, 0x99, 0xbf, 0x1d,
+	0xe4, 0x62, 0x80, 0xf6, 0xba, 0xf0, 0x1d, 0x7a, 0xbc, 0x58, 0x21, 0x9f, 0xf6, 0x8f, 0xe1, 0xbb,
+	0x4d, 0x72, 0xb5, 0xad, 0x94, 0x89, 0x85, 0x9f, 0x36, 0xeb, 0xf7, 0x9a, 0xd4, 0xed, 0xa5, 0xd9,
+	0x57, 0xe4, 0xfd, 0xfb, 0x4d, 0xca, 0x5e, 0x81, 0x87, 0x16, 0xe9, 0xd2, 0x4c, 0xfc, 0x41, 0x60,
+	0xa5, 0x3f, 0x19, 0x45, 0x72, 0xea, 0xe1, 0x87, 0x21, 0xb6, 0xbc, 0x27, 0x09, 0xa6, 0x1f, 0x02,
+	0x7c, 0x85, 0x51, 0xcb, 0x50, 0x0b, 0x4e, 0xa1, 0xaf, 0x32, 0x6a, 0x99, 0x03, 0xe9, 0xfc, 0x04,
+	0x72, 0xf0, 0x35, 0x46, 0x3e, 0x8a, 0xb9, 0x67, 0x31, 0x41, 0xed, 0xa5, 0x50, 0xf0, 0xa7, 0x56,
+	0xd1, 0x5d, 0x25, 0xec, 0xcf, 0x2d, 0x52, 0xcd, 0xfb, 0xb6, 0x04, 0xff, 0x25, 0xc0, 0x67, 0x59,
+	0xf2, 0x2c, 0xc3, 0x5f, 0x5b, 0x74, 0x28, 0x72, 0x46, 0xe0, 0x99, 0x43, 0xab, 0x45, 0x8a, 0x0e,
+	0xfe, 0xd6, 0xa2, 0xe8, 0x73, 0x87, 0x91, 0x51, 0x08, 0x3f, 0x6a, 0x53, 0xa2, 0x29, 0xd0, 0x20,
+	0xfe, 0xb8, 0x4d, 0x29, 0x3a, 0xce, 0xd0, 0x0a, 0x8f, 0x64, 0x16, 0xd0, 0x9f, 0xb4, 0x43, 0xd1,
+	0x90, 0x3a, 0x37, 0x00, 0x3f, 0x2d, 0x01, 0xa4, 0x05, 0x3f, 0x6b, 0x53, 0x18, 0x85, 0xdd, 0x89,
+	0x95, 0x97, 0x52, 0xe1, 0x00, 0xe1, 0xe7, 0xed, 0xbc, 0xfe, 0xa4, 0xb7, 0x6f, 0x85, 0xf6, 0xf0,
+	0x8b, 0x36, 0xb5, 0x7a, 0x84, 0x17, 0x16, 0xdd, 0xf0, 0xc4, 0x28, 0x19, 0x87, 0x82, 0x87, 0xb7,
+	0x1e, 0x7e, 0x19, 0x68, 0x29, 0xea, 0x7c, 0x07, 0x5e, 0x6f, 0x6f, 0x6d, 0xb2, 0x46, 0xd7, 0xa9,
+	0xf0, 0x62, 0x34, 0x58, 0xad, 0xeb, 0x14, 0xcc, 0xd1, 0x80, 0xdd, 0x31, 0x46, 0xed, 0x5e, 0x65,
+	0xf6, 0xd1, 0x47, 0xa1, 0xb2, 0xb5, 0xc3, 0x96, 0x3b, 0x26, 0xcd, 0xc4, 0xf4, 0x5e, 0x85, 0x47,
+	0x22, 0x7f, 0x5d, 0x30, 0xc9, 0x6f, 0xe7, 0x1c, 0x4d, 0xe9, 0xdd, 0x2b, 0x8c, 0x47, 0x9e, 0x1e,
+	0xa6, 0x0a, 0x89, 0x64, 0x44, 0xf9, 0x4c, 0xa0, 0xba, 0xf5, 0x32, 0x6b, 0xf5, 0x52, 0xfa, 0xf3,
+	0x4e, 0xed, 0x73, 0xf1, 0x04, 0x75, 0x42, 0x06, 0x73, 0xe1, 0x43, 0x10, 0xa0, 0xe2, 0x0d, 0xad,
+	0xcc, 0x94, 0xfa, 0x5e, 0xd8, 0x40, 0x13, 0xfe, 0x41, 0x01, 0x9a, 0x71, 0xd7, 0xb6, 0x5e, 0x62,
+	0xd0, 0x31, 0xda, 0x49, 0xe7, 0x51, 0xc7, 0xe3, 0x03, 0xbc, 0x44, 0x15, 0x9e, 0x4f, 0x6f, 0x4d,
+	0x60, 0xa6, 0x3f, 0x22, 0x86, 0xbf, 0x5e, 0xfe, 0xc8, 0xee, 0xd0, 0xa7, 0x28, 0xd0, 0x2d, 0x31,
+	0xb6, 0x7b, 0x89, 0xda, 0x8f, 0x84, 0x52, 0x63, 0xa8, 0x91, 0xdc, 0x19, 0x39, 0x6f, 0x52, 0xf9,
+	0x39, 0x7a, 0x6b, 0x77, 0x3e, 0xfe, 0xf2, 0xfd, 0x81, 0xf4, 0xc3, 0xd1, 0x39, 0x7d, 0x54, 0xef,
+	0xe5, 0x3f, 0xd7, 0x17, 0xa4, 0x29, 0x56, 0xf7, 0xa4, 0xf6, 0x54, 0x79, 0x75, 0x2f, 0x7c, 0x66,
+	0xef, 0xe5, 0x9f, 0xd9, 0xec, 0xfc, 0x7c, 0x3e, 0xc8, 0xf7, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
+	0x88, 0xc9, 0x4c, 0x27, 0x1d, 0x0d, 0x00, 0x00,
 }
@@ -59,18 +59,22 @@ const (
 	CompactionType_UndefinedCompaction CompactionType = 0
 	CompactionType_InnerCompaction     CompactionType = 1
 	CompactionType_MergeCompaction     CompactionType = 2
+	// rows of the segments are sorted by the clustering key and split into segments of disjoint key ranges
+	CompactionType_ClusteringCompaction CompactionType = 3
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	1: "InnerCompaction",
 	2: "MergeCompaction",
+	3: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"InnerCompaction":     1,
-	"MergeCompaction":     2,
+	"UndefinedCompaction":  0,
+	"InnerCompaction":      1,
+	"MergeCompaction":      2,
+	"ClusteringCompaction": 3,
 }
 
 func (x CompactionType) String() string {
@@ -204,6 +208,8 @@ type SegmentIDRequest struct {
 	ChannelName          string   `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
 	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	IsImport             bool     `protobuf:"varint,5,opt,name=is_import,json=isImport,proto3" json:"is_import,omitempty"`
+	ImportTaskId         int64    `protobuf:"varint,6,opt,name=import_task_id,json=importTaskId,proto3" json:"import_task_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -262,6 +268,20 @@ func (m *SegmentIDRequest) GetPartitionID() int64 {
 	return 0
 }
 
+func (m *SegmentIDRequest) GetIsImport() bool {
+	if m != nil {
+		return m.IsImport
+	}
+	return false
+}
+
+func (m *SegmentIDRequest) GetImportTaskId() int64 {
+	if m != nil {
+		return m.ImportTaskId
+	}
+	return 0
+}
+
 type AssignSegmentIDRequest struct {
 	NodeID               int64               `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	PeerRole             string              `protobuf:"bytes,2,opt,name=peer_role,json=peerRole,proto3" json:"peer_role,omitempty"`
@@ -1304,6 +1324,7 @@ type CollectionInfo struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	Partitions           []int64                    `protobuf:"varint,3,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 	StartPositions       []*commonpb.KeyDataPair    `protobuf:"bytes,4,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1362,6 +1383,13 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type SegmentInfo struct {
 	ID             int64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	CollectionID   int64                   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1377,13 +1405,16 @@ type SegmentInfo struct {
 	Binlogs   []*FieldBinlog `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
 	Statslogs []*FieldBinlog `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	// deltalogs consists of delete binlogs. FieldID is not used yet since delete is always applied on primary key
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CreatedByCompaction  bool           `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
-	CompactionFrom       []int64        `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	DroppedAt            uint64         `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CreatedByCompaction bool           `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	CompactionFrom      []int64        `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	DroppedAt           uint64         `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	IsImporting         bool           `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	// range of the clustering key of the segment, set if the segment is written by a clustering compaction
+	ClusteringKeyRange   *FieldRange `protobuf:"bytes,18,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1523,6 +1554,77 @@ func (m *SegmentInfo) GetDroppedAt() uint64 {
 	return 0
 }
 
+func (m *SegmentInfo) GetIsImporting() bool {
+	if m != nil {
+		return m.IsImporting
+	}
+	return false
+}
+
+func (m *SegmentInfo) GetClusteringKeyRange() *FieldRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+// FieldRange is the minimum and maximum values of a scalar field in a segment, integers are kept
+// as long_data, floating point numbers as double_data and strings as string_data
+type FieldRange struct {
+	FieldID              int64                `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Min                  *schemapb.ValueField `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *schemapb.ValueField `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FieldRange) Reset()         { *m = FieldRange{} }
+func (m *FieldRange) String() string { return proto.CompactTextString(m) }
+func (*FieldRange) ProtoMessage()    {}
+func (*FieldRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{24}
+}
+
+func (m *FieldRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldRange.Unmarshal(m, b)
+}
+func (m *FieldRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldRange.Marshal(b, m, deterministic)
+}
+func (m *FieldRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRange.Merge(m, src)
+}
+func (m *FieldRange) XXX_Size() int {
+	return xxx_messageInfo_FieldRange.Size(m)
+}
+func (m *FieldRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRange proto.InternalMessageInfo
+
+func (m *FieldRange) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldRange) GetMin() *schemapb.ValueField {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *FieldRange) GetMax() *schemapb.ValueField {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1535,7 +1637,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{24}
+	return fileDescriptor_82cd95f524594f49, []int{25}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1581,6 +1683,7 @@ type SaveBinlogPathsRequest struct {
 	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
 	Deltalogs            []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1590,7 +1693,7 @@ func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{}
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{25}
+	return fileDescriptor_82cd95f524594f49, []int{26}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1681,6 +1784,13 @@ func (m *SaveBinlogPathsRequest) GetDropped() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetImporting() bool {
+	if m != nil {
+		return m.Importing
+	}
+	return false
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -1694,7 +1804,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{26}
+	return fileDescriptor_82cd95f524594f49, []int{27}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -1751,7 +1861,7 @@ func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
 func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
 func (*DeltaLogInfo) ProtoMessage()    {}
 func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
@@ -1821,7 +1931,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -1882,7 +1992,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -1930,7 +2040,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -1985,7 +2095,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2033,6 +2143,8 @@ type SegmentBinlogs struct {
 	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
 	Statslogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *FieldRange    `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	CompactionFrom       []int64        `protobuf:"varint,7,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2042,7 +2154,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2098,6 +2210,20 @@ func (m *SegmentBinlogs) GetDeltalogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetClusteringKeyRange() *FieldRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+func (m *SegmentBinlogs) GetCompactionFrom() []int64 {
+	if m != nil {
+		return m.CompactionFrom
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64     `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []*Binlog `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2110,7 +2236,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2160,7 +2286,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2229,7 +2355,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2284,7 +2410,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2339,7 +2465,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2393,7 +2519,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2440,7 +2566,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2488,7 +2614,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2544,7 +2670,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2594,23 +2720,28 @@ func (m *CompactionSegmentBinlogs) GetDeltalogs() []*FieldBinlog {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// entities older than collection_ttl nanoseconds at start_time are dropped, 0 means never expire
+	CollectionTtl int64 `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
+	// field the rows are clustered by and the maximum number of rows of a segment, for clustering compaction only
+	ClusteringKeyFieldID int64    `protobuf:"varint,9,opt,name=clustering_key_fieldID,json=clusteringKeyFieldID,proto3" json:"clustering_key_fieldID,omitempty"`
+	MaxSegmentRows       int64    `protobuf:"varint,10,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -2680,23 +2811,46 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetCollectionTtl() int64 {
+	if m != nil {
+		return m.CollectionTtl
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetClusteringKeyFieldID() int64 {
+	if m != nil {
+		return m.ClusteringKeyFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	// segments written by a clustering compaction, the fields above are not used in this case
+	Segments             []*CompactionSegment `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -2759,6 +2913,92 @@ func (m *CompactionResult) GetDeltalogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *CompactionResult) GetSegments() []*CompactionSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type CompactionSegment struct {
+	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *FieldRange    `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompactionSegment) Reset()         { *m = CompactionSegment{} }
+func (m *CompactionSegment) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegment) ProtoMessage()    {}
+func (*CompactionSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{45}
+}
+
+func (m *CompactionSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegment.Unmarshal(m, b)
+}
+func (m *CompactionSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegment.Marshal(b, m, deterministic)
+}
+func (m *CompactionSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegment.Merge(m, src)
+}
+func (m *CompactionSegment) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegment.Size(m)
+}
+func (m *CompactionSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionSegment proto.InternalMessageInfo
+
+func (m *CompactionSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetDeltalogs() []*FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetClusteringKeyRange() *FieldRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 // Deprecated
 type SegmentFieldBinlogMeta struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -2772,7 +3012,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -2819,7 +3059,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{47}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -2865,7 +3105,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -2906,7 +3146,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{49}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -2966,7 +3206,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{48}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3054,7 +3294,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3082,243 +3322,774 @@ func (m *DropVirtualChannelResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
-	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
-	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
-	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
-}
+type PinCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TtlSeconds           int64             `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PinCollectionRequest) Reset()         { *m = PinCollectionRequest{} }
+func (m *PinCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*PinCollectionRequest) ProtoMessage()    {}
+func (*PinCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{52}
+}
+
+func (m *PinCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinCollectionRequest.Unmarshal(m, b)
+}
+func (m *PinCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *PinCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinCollectionRequest.Merge(m, src)
+}
+func (m *PinCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_PinCollectionRequest.Size(m)
+}
+func (m *PinCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinCollectionRequest proto.InternalMessageInfo
+
+func (m *PinCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PinCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PinCollectionRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+type PinCollectionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PinID                int64            `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PinCollectionResponse) Reset()         { *m = PinCollectionResponse{} }
+func (m *PinCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*PinCollectionResponse) ProtoMessage()    {}
+func (*PinCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{53}
+}
+
+func (m *PinCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinCollectionResponse.Unmarshal(m, b)
+}
+func (m *PinCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinCollectionResponse.Marshal(b, m, deterministic)
+}
+func (m *PinCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinCollectionResponse.Merge(m, src)
+}
+func (m *PinCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_PinCollectionResponse.Size(m)
+}
+func (m *PinCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinCollectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinCollectionResponse proto.InternalMessageInfo
+
+func (m *PinCollectionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PinCollectionResponse) GetPinID() int64 {
+	if m != nil {
+		return m.PinID
+	}
+	return 0
+}
+
+type UnpinCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PinID                int64             `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnpinCollectionRequest) Reset()         { *m = UnpinCollectionRequest{} }
+func (m *UnpinCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinCollectionRequest) ProtoMessage()    {}
+func (*UnpinCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{54}
+}
+
+func (m *UnpinCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinCollectionRequest.Unmarshal(m, b)
+}
+func (m *UnpinCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpinCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinCollectionRequest.Merge(m, src)
+}
+func (m *UnpinCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpinCollectionRequest.Size(m)
+}
+func (m *UnpinCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinCollectionRequest proto.InternalMessageInfo
+
+func (m *UnpinCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnpinCollectionRequest) GetPinID() int64 {
+	if m != nil {
+		return m.PinID
+	}
+	return 0
+}
+
+type ImportTask struct {
+	CollectionId         int64                    `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionId          int64                    `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	ChannelNames         []string                 `protobuf:"bytes,3,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	RowBased             bool                     `protobuf:"varint,4,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	TaskId               int64                    `protobuf:"varint,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Files                []string                 `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=infos,proto3" json:"infos,omitempty"`
+	Timestamp            uint64                   `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportTask) Reset()         { *m = ImportTask{} }
+func (m *ImportTask) String() string { return proto.CompactTextString(m) }
+func (*ImportTask) ProtoMessage()    {}
+func (*ImportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{55}
+}
+
+func (m *ImportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTask.Unmarshal(m, b)
+}
+func (m *ImportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTask.Marshal(b, m, deterministic)
+}
+func (m *ImportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTask.Merge(m, src)
+}
+func (m *ImportTask) XXX_Size() int {
+	return xxx_messageInfo_ImportTask.Size(m)
+}
+func (m *ImportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTask proto.InternalMessageInfo
+
+func (m *ImportTask) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ImportTask) GetPartitionId() int64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
+func (m *ImportTask) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *ImportTask) GetRowBased() bool {
+	if m != nil {
+		return m.RowBased
+	}
+	return false
+}
+
+func (m *ImportTask) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ImportTask) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportTask) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *ImportTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ImportTaskRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ImportTask           *ImportTask       `protobuf:"bytes,2,opt,name=import_task,json=importTask,proto3" json:"import_task,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
+func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskRequest) ProtoMessage()    {}
+func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{56}
+}
+
+func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskRequest.Unmarshal(m, b)
+}
+func (m *ImportTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskRequest.Merge(m, src)
+}
+func (m *ImportTaskRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskRequest.Size(m)
+}
+func (m *ImportTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskRequest proto.InternalMessageInfo
+
+func (m *ImportTaskRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportTaskRequest) GetImportTask() *ImportTask {
+	if m != nil {
+		return m.ImportTask
+	}
+	return nil
+}
+
+type ImportResult struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64                    `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	State                commonpb.ImportState     `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Segments             []int64                  `protobuf:"varint,5,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	RowCount             int64                    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{57}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ImportResult) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportResult) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportResult) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type ImportTaskInfo struct {
+	Id                   int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	DatanodeId           int64                    `protobuf:"varint,3,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	Files                []string                 `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	State                commonpb.ImportState     `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Segments             []int64                  `protobuf:"varint,7,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	LastUpdate           int64                    `protobuf:"varint,9,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
+func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskInfo) ProtoMessage()    {}
+func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{58}
+}
+
+func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskInfo.Unmarshal(m, b)
+}
+func (m *ImportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskInfo.Merge(m, src)
+}
+func (m *ImportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskInfo.Size(m)
+}
+func (m *ImportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskInfo proto.InternalMessageInfo
+
+func (m *ImportTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetLastUpdate() int64 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*FieldRange)(nil), "milvus.proto.data.FieldRange")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*CompactionSegment)(nil), "milvus.proto.data.CompactionSegment")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
+	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
+	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*PinCollectionRequest)(nil), "milvus.proto.data.PinCollectionRequest")
+	proto.RegisterType((*PinCollectionResponse)(nil), "milvus.proto.data.PinCollectionResponse")
+	proto.RegisterType((*UnpinCollectionRequest)(nil), "milvus.proto.data.UnpinCollectionRequest")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*ImportResult)(nil), "milvus.proto.data.ImportResult")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+}
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xdd, 0x6f, 0x1b, 0xc7,
-	0x11, 0xf7, 0xf1, 0x43, 0x22, 0x87, 0x1f, 0xa2, 0x57, 0x8e, 0x42, 0x33, 0x8e, 0x2c, 0x5f, 0x12,
-	0x47, 0x76, 0x1c, 0xc9, 0x96, 0x1b, 0x34, 0xa8, 0x93, 0x06, 0x91, 0x65, 0xc9, 0x44, 0x25, 0x57,
-	0x39, 0x2a, 0x76, 0xd1, 0x14, 0x25, 0x4e, 0xbc, 0x15, 0x75, 0x35, 0xef, 0x8e, 0xbe, 0x5b, 0xca,
-	0x56, 0x5e, 0x62, 0x34, 0x40, 0x81, 0x16, 0x41, 0x9a, 0xa2, 0xaf, 0x05, 0x5a, 0xf4, 0xa9, 0x45,
-	0x5f, 0xda, 0xc7, 0xb6, 0xff, 0x40, 0xd0, 0xbe, 0xf7, 0x5f, 0xe8, 0x5b, 0xff, 0x86, 0x62, 0x3f,
-	0x6e, 0xef, 0x83, 0x47, 0xf2, 0x28, 0xf9, 0xe3, 0x4d, 0xbb, 0x37, 0xb3, 0x33, 0x3b, 0x3b, 0xf3,
-	0x9b, 0x99, 0xe5, 0x0a, 0x6a, 0x86, 0x4e, 0xf4, 0x76, 0xc7, 0x71, 0x5c, 0x63, 0xa5, 0xef, 0x3a,
-	0xc4, 0x41, 0x67, 0x2d, 0xb3, 0x77, 0x34, 0xf0, 0xf8, 0x68, 0x85, 0x7e, 0x6e, 0x94, 0x3b, 0x8e,
-	0x65, 0x39, 0x36, 0x9f, 0x6a, 0x54, 0x4d, 0x9b, 0x60, 0xd7, 0xd6, 0x7b, 0x62, 0x5c, 0x0e, 0x33,
-	0x34, 0xca, 0x5e, 0xe7, 0x10, 0x5b, 0x3a, 0x1f, 0xa9, 0x4f, 0xa0, 0xbc, 0xd9, 0x1b, 0x78, 0x87,
-	0x1a, 0x7e, 0x34, 0xc0, 0x1e, 0x41, 0xd7, 0x21, 0xb7, 0xaf, 0x7b, 0xb8, 0xae, 0x2c, 0x29, 0xcb,
-	0xa5, 0xb5, 0x0b, 0x2b, 0x11, 0x59, 0x42, 0xca, 0x8e, 0xd7, 0x5d, 0xd7, 0x3d, 0xac, 0x31, 0x4a,
-	0x84, 0x20, 0x67, 0xec, 0x37, 0x37, 0xea, 0x99, 0x25, 0x65, 0x39, 0xab, 0xb1, 0xbf, 0x91, 0x0a,
-	0xe5, 0x8e, 0xd3, 0xeb, 0xe1, 0x0e, 0x31, 0x1d, 0xbb, 0xb9, 0x51, 0xcf, 0xb1, 0x6f, 0x91, 0x39,
-	0xf5, 0x77, 0x0a, 0x54, 0x84, 0x68, 0xaf, 0xef, 0xd8, 0x1e, 0x46, 0x37, 0x61, 0xc6, 0x23, 0x3a,
-	0x19, 0x78, 0x42, 0xfa, 0x6b, 0x89, 0xd2, 0x5b, 0x8c, 0x44, 0x13, 0xa4, 0xa9, 0xc4, 0x67, 0x87,
-	0xc5, 0xa3, 0x45, 0x00, 0x0f, 0x77, 0x2d, 0x6c, 0x93, 0xe6, 0x86, 0x57, 0xcf, 0x2d, 0x65, 0x97,
-	0xb3, 0x5a, 0x68, 0x46, 0xfd, 0x8d, 0x02, 0xb5, 0x96, 0x3f, 0xf4, 0xad, 0x73, 0x0e, 0xf2, 0x1d,
-	0x67, 0x60, 0x13, 0xa6, 0x60, 0x45, 0xe3, 0x03, 0x74, 0x09, 0xca, 0x9d, 0x43, 0xdd, 0xb6, 0x71,
-	0xaf, 0x6d, 0xeb, 0x16, 0x66, 0xaa, 0x14, 0xb5, 0x92, 0x98, 0xbb, 0xa7, 0x5b, 0x38, 0x95, 0x46,
-	0x4b, 0x50, 0xea, 0xeb, 0x2e, 0x31, 0x23, 0x36, 0x0b, 0x4f, 0xa9, 0x7f, 0x50, 0x60, 0xe1, 0x63,
-	0xcf, 0x33, 0xbb, 0xf6, 0x90, 0x66, 0x0b, 0x30, 0x63, 0x3b, 0x06, 0x6e, 0x6e, 0x30, 0xd5, 0xb2,
-	0x9a, 0x18, 0xa1, 0xd7, 0xa0, 0xd8, 0xc7, 0xd8, 0x6d, 0xbb, 0x4e, 0xcf, 0x57, 0xac, 0x40, 0x27,
-	0x34, 0xa7, 0x87, 0xd1, 0x27, 0x70, 0xd6, 0x8b, 0x2d, 0xe4, 0xd5, 0xb3, 0x4b, 0xd9, 0xe5, 0xd2,
-	0xda, 0x1b, 0x2b, 0x43, 0x5e, 0xb6, 0x12, 0x17, 0xaa, 0x0d, 0x73, 0xab, 0x4f, 0x33, 0x30, 0x2f,
-	0xe9, 0xb8, 0xae, 0xf4, 0x6f, 0x6a, 0x39, 0x0f, 0x77, 0xa5, 0x7a, 0x7c, 0x90, 0xc6, 0x72, 0xd2,
-	0xe4, 0xd9, 0xb0, 0xc9, 0x53, 0x38, 0x58, 0xdc, 0x9e, 0xf9, 0x21, 0x7b, 0xa2, 0x8b, 0x50, 0xc2,
-	0x4f, 0xfa, 0xa6, 0x8b, 0xdb, 0xc4, 0xb4, 0x70, 0x7d, 0x66, 0x49, 0x59, 0xce, 0x69, 0xc0, 0xa7,
-	0xf6, 0x4c, 0x2b, 0xec, 0x91, 0xb3, 0xa9, 0x3d, 0x52, 0xfd, 0xa3, 0x02, 0xaf, 0x0e, 0x9d, 0x92,
-	0x70, 0x71, 0x0d, 0x6a, 0x6c, 0xe7, 0x81, 0x65, 0xa8, 0xb3, 0x53, 0x83, 0x5f, 0x1e, 0x67, 0xf0,
-	0x80, 0x5c, 0x1b, 0xe2, 0x0f, 0x29, 0x99, 0x49, 0xaf, 0xe4, 0x43, 0x78, 0x75, 0x0b, 0x13, 0x21,
-	0x80, 0x7e, 0xc3, 0xde, 0xc9, 0x21, 0x20, 0x1a, 0x4b, 0x99, 0xa1, 0x58, 0xfa, 0x6b, 0x46, 0xc6,
-	0x12, 0x13, 0xd5, 0xb4, 0x0f, 0x1c, 0x74, 0x01, 0x8a, 0x92, 0x44, 0x78, 0x45, 0x30, 0x81, 0xbe,
-	0x0b, 0x79, 0xaa, 0x29, 0x77, 0x89, 0xea, 0xda, 0xa5, 0xe4, 0x3d, 0x85, 0xd6, 0xd4, 0x38, 0x3d,
-	0x6a, 0x42, 0xd5, 0x23, 0xba, 0x4b, 0xda, 0x7d, 0xc7, 0x63, 0xe7, 0xcc, 0x1c, 0xa7, 0xb4, 0xa6,
-	0x46, 0x57, 0x90, 0x10, 0xb9, 0xe3, 0x75, 0x77, 0x05, 0xa5, 0x56, 0x61, 0x9c, 0xfe, 0x10, 0xdd,
-	0x81, 0x32, 0xb6, 0x8d, 0x60, 0xa1, 0x5c, 0xea, 0x85, 0x4a, 0xd8, 0x36, 0xe4, 0x32, 0xc1, 0xf9,
-	0xe4, 0xd3, 0x9f, 0xcf, 0x57, 0x0a, 0xd4, 0x87, 0x0f, 0xe8, 0x34, 0x40, 0x79, 0x8b, 0x33, 0x61,
-	0x7e, 0x40, 0x63, 0x23, 0x5c, 0x1e, 0x92, 0x26, 0x58, 0x54, 0x13, 0x5e, 0x09, 0xb4, 0x61, 0x5f,
-	0x9e, 0x9b, 0xb3, 0x7c, 0xa9, 0xc0, 0x42, 0x5c, 0xd6, 0x69, 0xf6, 0xfd, 0x1d, 0xc8, 0x9b, 0xf6,
-	0x81, 0xe3, 0x6f, 0x7b, 0x71, 0x4c, 0x9c, 0x51, 0x59, 0x9c, 0x58, 0xb5, 0xe0, 0xb5, 0x2d, 0x4c,
-	0x9a, 0xb6, 0x87, 0x5d, 0xb2, 0x6e, 0xda, 0x3d, 0xa7, 0xbb, 0xab, 0x93, 0xc3, 0x53, 0xc4, 0x48,
-	0xc4, 0xdd, 0x33, 0x31, 0x77, 0x57, 0xff, 0xa4, 0xc0, 0x85, 0x64, 0x79, 0x62, 0xeb, 0x0d, 0x28,
-	0x1c, 0x98, 0xb8, 0x67, 0x50, 0x9b, 0x29, 0xcc, 0x66, 0x72, 0x4c, 0x63, 0xa5, 0x4f, 0x89, 0xc5,
-	0x0e, 0x2f, 0x8d, 0x70, 0xd0, 0x16, 0x71, 0x4d, 0xbb, 0xbb, 0x6d, 0x7a, 0x44, 0xe3, 0xf4, 0x21,
-	0x7b, 0x66, 0xd3, 0x7b, 0xe6, 0xaf, 0x14, 0x58, 0xdc, 0xc2, 0xe4, 0xb6, 0x84, 0x5a, 0xfa, 0xdd,
-	0xf4, 0x88, 0xd9, 0xf1, 0x9e, 0x6f, 0x11, 0x91, 0x90, 0x33, 0xd5, 0x5f, 0x2b, 0x70, 0x71, 0xa4,
-	0x32, 0xc2, 0x74, 0x02, 0x4a, 0x7c, 0xa0, 0x4d, 0x86, 0x92, 0x1f, 0xe0, 0xe3, 0xfb, 0x7a, 0x6f,
-	0x80, 0x77, 0x75, 0xd3, 0xe5, 0x50, 0x72, 0x42, 0x60, 0xfd, 0x8b, 0x02, 0xaf, 0x6f, 0x61, 0xb2,
-	0xeb, 0xa7, 0x99, 0x97, 0x68, 0x9d, 0x14, 0x15, 0xc5, 0xd7, 0xfc, 0x30, 0x13, 0xb5, 0x7d, 0x29,
-	0xe6, 0x5b, 0x64, 0x71, 0x10, 0x0a, 0xc8, 0xdb, 0xbc, 0x16, 0x10, 0xc6, 0x53, 0x9f, 0x66, 0xa1,
-	0x7c, 0x5f, 0xd4, 0x07, 0x2c, 0x8d, 0xc4, 0xed, 0xa0, 0x24, 0xdb, 0x21, 0x54, 0x52, 0x24, 0x55,
-	0x19, 0x5b, 0x50, 0xf1, 0x30, 0x7e, 0x78, 0x92, 0xa4, 0x51, 0xa6, 0x8c, 0x12, 0xec, 0xb7, 0xe1,
-	0xec, 0xc0, 0x3e, 0xa0, 0x65, 0x2d, 0x36, 0xc4, 0x2e, 0x78, 0x75, 0x39, 0x19, 0x79, 0x86, 0x19,
-	0xd1, 0x5d, 0x98, 0x8b, 0xaf, 0x95, 0x4f, 0xb5, 0x56, 0x9c, 0x0d, 0x35, 0xa1, 0x66, 0xb8, 0x4e,
-	0xbf, 0x8f, 0x8d, 0xb6, 0xe7, 0x2f, 0x35, 0x93, 0x6e, 0x29, 0xc1, 0xe7, 0x2f, 0xa5, 0xfe, 0x52,
-	0x81, 0x85, 0x07, 0x3a, 0xe9, 0x1c, 0x6e, 0x58, 0xe2, 0x70, 0x4e, 0xe1, 0xda, 0x1f, 0x42, 0xf1,
-	0x48, 0x1c, 0x84, 0x8f, 0x5f, 0x17, 0x13, 0x14, 0x0a, 0x1f, 0xb9, 0x16, 0x70, 0xa8, 0xdf, 0x2a,
-	0x70, 0x8e, 0x35, 0x11, 0xbe, 0x76, 0x2f, 0x3e, 0xc8, 0x26, 0x34, 0x12, 0xe8, 0x32, 0x54, 0x2d,
-	0xdd, 0x7d, 0xd8, 0x0a, 0x68, 0xf2, 0x8c, 0x26, 0x36, 0xab, 0x3e, 0x01, 0x10, 0xa3, 0x1d, 0xaf,
-	0x7b, 0x02, 0xfd, 0xdf, 0x87, 0x59, 0x21, 0x55, 0xc4, 0xdb, 0xa4, 0x83, 0xf5, 0xc9, 0xd5, 0x7f,
-	0x29, 0x50, 0x0d, 0x10, 0x94, 0x45, 0x55, 0x15, 0x32, 0x32, 0x96, 0x32, 0xcd, 0x0d, 0xf4, 0x21,
-	0xcc, 0xf0, 0xb6, 0x51, 0xac, 0xfd, 0x56, 0x74, 0x6d, 0xd1, 0x52, 0x86, 0x60, 0x98, 0x4d, 0x68,
-	0x82, 0x89, 0xda, 0x48, 0xa2, 0x0e, 0xef, 0x30, 0xb2, 0x5a, 0x68, 0x06, 0x35, 0x61, 0x2e, 0x5a,
-	0xb4, 0xf9, 0x31, 0xb3, 0x34, 0x0a, 0x6d, 0x36, 0x74, 0xa2, 0x33, 0xb0, 0xa9, 0x46, 0x6a, 0x36,
-	0x4f, 0xfd, 0x5f, 0x1e, 0x4a, 0xa1, 0x5d, 0x0e, 0xed, 0x24, 0x7e, 0xa4, 0x99, 0xc9, 0xb8, 0x99,
-	0x1d, 0xee, 0x1c, 0xde, 0x82, 0xaa, 0xc9, 0x72, 0x75, 0x5b, 0xb8, 0x22, 0x03, 0xd7, 0xa2, 0x56,
-	0xe1, 0xb3, 0x22, 0x2e, 0xd0, 0x22, 0x94, 0xec, 0x81, 0xd5, 0x76, 0x0e, 0xda, 0xae, 0xf3, 0xd8,
-	0x13, 0x2d, 0x48, 0xd1, 0x1e, 0x58, 0x3f, 0x3c, 0xd0, 0x9c, 0xc7, 0x5e, 0x50, 0xe5, 0xce, 0x4c,
-	0x59, 0xe5, 0x2e, 0x42, 0xc9, 0xd2, 0x9f, 0xd0, 0x55, 0xdb, 0xf6, 0xc0, 0x62, 0xdd, 0x49, 0x56,
-	0x2b, 0x5a, 0xfa, 0x13, 0xcd, 0x79, 0x7c, 0x6f, 0x60, 0xa1, 0x65, 0xa8, 0xf5, 0x74, 0x8f, 0xb4,
-	0xc3, 0xed, 0x4d, 0x81, 0xb5, 0x37, 0x55, 0x3a, 0x7f, 0x27, 0x68, 0x71, 0x86, 0xeb, 0xe5, 0xe2,
-	0x29, 0xea, 0x65, 0xc3, 0xea, 0x05, 0x0b, 0x41, 0xfa, 0x7a, 0xd9, 0xb0, 0x7a, 0x72, 0x99, 0xf7,
-	0x61, 0x76, 0x9f, 0x55, 0x40, 0x5e, 0xbd, 0x34, 0x12, 0xa1, 0x36, 0x69, 0xf1, 0xc3, 0x0b, 0x25,
-	0xcd, 0x27, 0x47, 0x1f, 0x40, 0x91, 0xa5, 0x1e, 0xc6, 0x5b, 0x4e, 0xc5, 0x1b, 0x30, 0x50, 0x6e,
-	0x03, 0xf7, 0x88, 0xce, 0xb8, 0x2b, 0xe9, 0xb8, 0x25, 0x03, 0xba, 0x0e, 0xf3, 0x1d, 0x17, 0xeb,
-	0x04, 0x1b, 0xeb, 0xc7, 0xb7, 0x1d, 0xab, 0xaf, 0x33, 0x67, 0xaa, 0x57, 0x97, 0x94, 0xe5, 0x82,
-	0x96, 0xf4, 0x89, 0x02, 0x43, 0x47, 0x8e, 0x36, 0x5d, 0xc7, 0xaa, 0xcf, 0x71, 0x60, 0x88, 0xce,
-	0xa2, 0xd7, 0x01, 0x7c, 0xe8, 0xd6, 0x49, 0xbd, 0xc6, 0x4e, 0xb1, 0x28, 0x66, 0x3e, 0x26, 0xea,
-	0x17, 0x70, 0x2e, 0xf0, 0x90, 0xd0, 0x69, 0x0c, 0x1f, 0xac, 0x72, 0xd2, 0x83, 0x1d, 0x5f, 0xbb,
-	0xfe, 0x2d, 0x07, 0x0b, 0x2d, 0xfd, 0x08, 0x3f, 0xff, 0x32, 0x39, 0x15, 0x1e, 0x6f, 0xc3, 0x59,
-	0x56, 0x19, 0xaf, 0x85, 0xf4, 0x19, 0x93, 0x81, 0xc3, 0xc7, 0x39, 0xcc, 0x88, 0x3e, 0xa2, 0xa5,
-	0x03, 0xee, 0x3c, 0xdc, 0x75, 0xcc, 0x20, 0xfb, 0xbe, 0x9e, 0xb0, 0xce, 0x6d, 0x49, 0xa5, 0x85,
-	0x39, 0xd0, 0xee, 0x30, 0xb4, 0xf1, 0xbc, 0xfb, 0xf6, 0xd8, 0xfe, 0x2b, 0xb0, 0x7e, 0x1c, 0xe1,
-	0x50, 0x1d, 0x66, 0x45, 0x76, 0x67, 0x71, 0x5f, 0xd0, 0xfc, 0x21, 0xda, 0x85, 0x79, 0xbe, 0x83,
-	0x96, 0x70, 0x6a, 0xbe, 0xf9, 0x42, 0xaa, 0xcd, 0x27, 0xb1, 0x46, 0x63, 0xa2, 0x38, 0x6d, 0x4c,
-	0xd4, 0x61, 0x56, 0xf8, 0x29, 0xc3, 0x82, 0x82, 0xe6, 0x0f, 0x69, 0x13, 0x01, 0x81, 0xc5, 0x26,
-	0xdc, 0x05, 0x7c, 0x1f, 0x0a, 0xd2, 0x87, 0x33, 0xa9, 0x7d, 0x58, 0xf2, 0xc4, 0x51, 0x38, 0x1b,
-	0x43, 0x61, 0xf5, 0xdf, 0x0a, 0x94, 0x37, 0xa8, 0xd2, 0xdb, 0x4e, 0x97, 0xe5, 0x8c, 0xb7, 0xa0,
-	0xea, 0xe2, 0x8e, 0xe3, 0x1a, 0x6d, 0x6c, 0x13, 0xd7, 0xc4, 0xbc, 0xdf, 0xcc, 0x69, 0x15, 0x3e,
-	0x7b, 0x87, 0x4f, 0x52, 0x32, 0x0a, 0xac, 0x1e, 0xd1, 0xad, 0x7e, 0xfb, 0x80, 0x06, 0x70, 0x86,
-	0x93, 0xc9, 0x59, 0x16, 0xbf, 0x97, 0xa0, 0x1c, 0x90, 0x11, 0x87, 0xc9, 0xcf, 0x69, 0x25, 0x39,
-	0xb7, 0xe7, 0xa0, 0x37, 0xa1, 0xca, 0xac, 0xd6, 0xee, 0x39, 0xdd, 0x36, 0xed, 0xcd, 0x44, 0x3a,
-	0x29, 0x1b, 0x42, 0x2d, 0x7a, 0x1a, 0x51, 0x2a, 0xcf, 0xfc, 0x1c, 0x8b, 0x84, 0x22, 0xa9, 0x5a,
-	0xe6, 0xe7, 0x98, 0x66, 0xf3, 0x0a, 0xcd, 0x8e, 0xf7, 0x1c, 0x03, 0xef, 0x9d, 0xb0, 0x96, 0x48,
-	0x71, 0x2f, 0x77, 0x01, 0x8a, 0x72, 0x07, 0x62, 0x4b, 0xc1, 0x04, 0xda, 0x84, 0xaa, 0x5f, 0x66,
-	0xb6, 0x79, 0xf7, 0x90, 0x1b, 0x59, 0xdb, 0x85, 0xf2, 0x9b, 0xa7, 0x55, 0x7c, 0x36, 0x36, 0x54,
-	0x37, 0xa1, 0x1c, 0xfe, 0x4c, 0xa5, 0xb6, 0xe2, 0x8e, 0x22, 0x27, 0xa8, 0xbf, 0xdd, 0x1b, 0x58,
-	0xf4, 0x4c, 0x05, 0x74, 0xf8, 0x43, 0xf5, 0x4b, 0x05, 0x2a, 0x22, 0x29, 0xb7, 0xe4, 0xbd, 0x31,
-	0xdb, 0x9a, 0xc2, 0xb6, 0xc6, 0xfe, 0x46, 0xdf, 0x8b, 0x5e, 0x3a, 0xbd, 0x99, 0x18, 0xe6, 0x6c,
-	0x11, 0x56, 0xff, 0x46, 0x32, 0x72, 0x9a, 0x6e, 0xf5, 0x29, 0x75, 0x34, 0x71, 0x34, 0xcc, 0xd1,
-	0xea, 0x30, 0xab, 0x1b, 0x86, 0x8b, 0x3d, 0x4f, 0xe8, 0xe1, 0x0f, 0xe9, 0x97, 0x23, 0xec, 0x7a,
-	0xbe, 0xcb, 0x67, 0x35, 0x7f, 0x88, 0x3e, 0x80, 0x82, 0x2c, 0x98, 0xb3, 0x49, 0x45, 0x52, 0x58,
-	0x4f, 0xd1, 0x5d, 0x49, 0x0e, 0xf5, 0xeb, 0x0c, 0x54, 0x85, 0xc1, 0xd6, 0x45, 0xd6, 0x1c, 0x1f,
-	0x7c, 0xeb, 0x50, 0x3e, 0x08, 0xa2, 0x7b, 0xdc, 0x2d, 0x4a, 0x18, 0x04, 0x22, 0x3c, 0x93, 0x02,
-	0x30, 0x9a, 0xb7, 0x73, 0xa7, 0xca, 0xdb, 0xf9, 0x29, 0x31, 0x4a, 0xfd, 0x09, 0x94, 0x42, 0x5f,
-	0x18, 0xb8, 0xf2, 0x7b, 0x15, 0x61, 0x0a, 0x7f, 0x88, 0x6e, 0x06, 0x65, 0x09, 0xb7, 0xc1, 0xf9,
-	0x04, 0x21, 0xb1, 0x8a, 0x44, 0xfd, 0xb3, 0x02, 0x33, 0x62, 0xe5, 0x8b, 0x50, 0x12, 0x68, 0xc2,
-	0x4a, 0x36, 0xbe, 0x3a, 0x88, 0x29, 0x5a, 0xb3, 0x3d, 0x3b, 0x38, 0x39, 0x0f, 0x85, 0x18, 0x90,
-	0xcc, 0x0a, 0x44, 0xf7, 0x3f, 0x85, 0xd0, 0x83, 0x7e, 0x62, 0xc0, 0xf1, 0xad, 0xc2, 0xee, 0x84,
-	0x35, 0xdc, 0x71, 0x8e, 0xb0, 0x7b, 0x7c, 0xfa, 0x9b, 0xb7, 0x5b, 0x21, 0x4f, 0x4d, 0xd9, 0xda,
-	0x49, 0x06, 0x74, 0x2b, 0x30, 0x77, 0x36, 0xe9, 0xe2, 0x21, 0x0c, 0x1d, 0xc2, 0xcf, 0x02, 0xb3,
-	0x7f, 0xc3, 0xef, 0x10, 0xa3, 0x5b, 0x39, 0x69, 0x49, 0xf2, 0x4c, 0x3a, 0x06, 0xf5, 0xb7, 0x0a,
-	0x9c, 0xdf, 0xc2, 0x64, 0x33, 0xda, 0x97, 0xbf, 0x6c, 0xad, 0x2c, 0x68, 0x24, 0x29, 0x75, 0x9a,
-	0x53, 0x6f, 0x40, 0x41, 0xde, 0x30, 0xf0, 0xdb, 0x5d, 0x39, 0x56, 0x7f, 0xa1, 0x40, 0x5d, 0x48,
-	0x61, 0x32, 0x69, 0x35, 0xdc, 0xc3, 0x04, 0x1b, 0x2f, 0xba, 0xe5, 0xfd, 0xbd, 0x02, 0xb5, 0x30,
-	0x94, 0x33, 0x34, 0x7e, 0x0f, 0xf2, 0xec, 0x66, 0x41, 0x68, 0x30, 0xd1, 0x59, 0x39, 0x35, 0x85,
-	0x0c, 0x56, 0xa1, 0xed, 0xc9, 0xac, 0x23, 0x86, 0x41, 0x3e, 0xc9, 0x4e, 0x9d, 0x4f, 0xd4, 0xaf,
-	0x32, 0x50, 0x0f, 0x9a, 0x85, 0x17, 0x0e, 0xd9, 0x23, 0x4a, 0xc9, 0xec, 0x33, 0x2a, 0x25, 0x73,
-	0xd3, 0xc2, 0xf4, 0x3f, 0x33, 0x50, 0x0d, 0xcc, 0xb1, 0xdb, 0xd3, 0x6d, 0xb4, 0x00, 0x33, 0xfd,
-	0x9e, 0x1e, 0xdc, 0xf9, 0x89, 0x11, 0x6a, 0xc9, 0xda, 0x23, 0x6a, 0x80, 0x77, 0x92, 0xcc, 0x3f,
-	0xc2, 0xc2, 0x5a, 0x6c, 0x09, 0xda, 0x84, 0xf1, 0x32, 0x9e, 0xb5, 0xd2, 0xa2, 0xde, 0xe1, 0xe7,
-	0x4c, 0xbb, 0xe8, 0x6b, 0x80, 0xe8, 0x07, 0x67, 0x40, 0xda, 0xa6, 0xdd, 0xf6, 0x70, 0xc7, 0xb1,
-	0x0d, 0x8f, 0x61, 0x6f, 0x5e, 0xab, 0x89, 0x2f, 0x4d, 0xbb, 0xc5, 0xe7, 0xd1, 0x7b, 0x90, 0x23,
-	0xc7, 0x7d, 0x0e, 0xc0, 0xd5, 0x44, 0x60, 0x0b, 0xf4, 0xda, 0x3b, 0xee, 0x63, 0x8d, 0x91, 0xa3,
-	0x45, 0x00, 0xba, 0x14, 0x71, 0xf5, 0x23, 0xdc, 0xf3, 0x7f, 0xad, 0x0c, 0x66, 0xa8, 0x23, 0xfa,
-	0xb7, 0x11, 0xb3, 0x1c, 0xf5, 0xc5, 0x50, 0xfd, 0x7b, 0x06, 0x6a, 0xc1, 0x92, 0x1a, 0xf6, 0x06,
-	0x3d, 0x32, 0xd2, 0x7e, 0xe3, 0x5b, 0xb0, 0x49, 0xb9, 0xfc, 0x23, 0x28, 0x89, 0x9b, 0x91, 0x29,
-	0x0e, 0x1a, 0x38, 0xcb, 0xf6, 0x18, 0xcf, 0xcb, 0x3f, 0x23, 0xcf, 0x9b, 0x99, 0xd6, 0xf3, 0x5a,
-	0xb0, 0xe0, 0x43, 0x56, 0x40, 0xb0, 0x83, 0x89, 0x3e, 0xa6, 0x56, 0xb8, 0x08, 0x25, 0x9e, 0x8a,
-	0x78, 0x0e, 0xe6, 0xe5, 0x33, 0xec, 0xcb, 0xbe, 0x52, 0xfd, 0x29, 0x9c, 0x63, 0x21, 0x1f, 0xbf,
-	0x40, 0x4d, 0x73, 0x9b, 0xad, 0xca, 0xe2, 0x9c, 0x16, 0xe2, 0xdc, 0xbb, 0x8b, 0x5a, 0x64, 0x4e,
-	0xdd, 0x86, 0x57, 0x62, 0xeb, 0x9f, 0x02, 0xd2, 0xd5, 0x7f, 0x28, 0x70, 0x7e, 0xc3, 0x75, 0xfa,
-	0xf7, 0x4d, 0x97, 0x0c, 0xf4, 0x5e, 0xf4, 0x4a, 0xfe, 0xf9, 0xb4, 0x17, 0x77, 0x43, 0x59, 0x84,
-	0x83, 0xce, 0xb5, 0x84, 0x23, 0x1b, 0x56, 0x4a, 0x1c, 0x55, 0x28, 0xe7, 0xfc, 0x37, 0x9b, 0xa4,
-	0xbc, 0xa0, 0x9b, 0x80, 0xa4, 0x69, 0x92, 0x6c, 0xe2, 0x7d, 0x43, 0xf6, 0xa4, 0xf7, 0x0d, 0x23,
-	0xbc, 0x3f, 0xf7, 0x8c, 0xbc, 0x7f, 0xda, 0xf2, 0x18, 0xdd, 0x85, 0xe8, 0x5d, 0x10, 0x83, 0x9d,
-	0x13, 0x5d, 0x22, 0xad, 0x03, 0x04, 0xf7, 0x22, 0xe2, 0x3d, 0x45, 0x9a, 0x65, 0x42, 0x5c, 0xf4,
-	0xb4, 0x24, 0xd2, 0xb0, 0xfb, 0xcc, 0x48, 0x1f, 0xff, 0x09, 0x34, 0x92, 0xbc, 0xf4, 0x14, 0x9e,
-	0x7f, 0xf5, 0x06, 0x9c, 0x1d, 0xca, 0xd0, 0xa8, 0x0a, 0xf0, 0xa9, 0xdd, 0x11, 0xa5, 0x4b, 0xed,
-	0x0c, 0x2a, 0x43, 0xc1, 0x2f, 0x64, 0x6a, 0xca, 0xd5, 0x56, 0x38, 0x51, 0x51, 0xf4, 0x46, 0xaf,
-	0xc2, 0xfc, 0xa7, 0xb6, 0x81, 0x0f, 0x4c, 0x1b, 0x1b, 0xc1, 0xa7, 0xda, 0x19, 0x34, 0x0f, 0x73,
-	0x4d, 0xdb, 0xc6, 0x6e, 0x68, 0x52, 0xa1, 0x93, 0x3b, 0xd8, 0xed, 0xe2, 0xd0, 0x64, 0x66, 0xed,
-	0x9b, 0x79, 0x28, 0xd2, 0xce, 0xf1, 0xb6, 0xe3, 0xb8, 0x06, 0xea, 0x03, 0x62, 0x3f, 0x7a, 0x5a,
-	0x7d, 0xc7, 0x96, 0xaf, 0x03, 0xd0, 0xf5, 0x11, 0xc6, 0x1c, 0x26, 0x15, 0x91, 0xdb, 0xb8, 0x3c,
-	0x82, 0x23, 0x46, 0xae, 0x9e, 0x41, 0x16, 0x93, 0x48, 0x53, 0xdd, 0x9e, 0xd9, 0x79, 0xe8, 0x5f,
-	0x6f, 0x8f, 0x91, 0x18, 0x23, 0xf5, 0x25, 0xc6, 0x1e, 0x1d, 0x88, 0x01, 0xff, 0x65, 0xda, 0x3f,
-	0x29, 0xf5, 0x0c, 0x7a, 0x04, 0xe7, 0xb6, 0x30, 0x09, 0x7e, 0x8c, 0xf4, 0x05, 0xae, 0x8d, 0x16,
-	0x38, 0x44, 0x3c, 0xa5, 0xc8, 0x6d, 0xc8, 0xb3, 0x92, 0x14, 0x25, 0x95, 0x7d, 0xe1, 0x27, 0x72,
-	0x8d, 0xa5, 0xd1, 0x04, 0x72, 0xb5, 0x9f, 0xc1, 0x5c, 0xec, 0x09, 0x10, 0xba, 0x92, 0xc0, 0x96,
-	0xfc, 0x98, 0xab, 0x71, 0x35, 0x0d, 0xa9, 0x94, 0xd5, 0x85, 0x6a, 0xf4, 0x27, 0x53, 0xb4, 0x9c,
-	0xc0, 0x9f, 0xf8, 0x7c, 0xa3, 0x71, 0x25, 0x05, 0xa5, 0x14, 0x64, 0x41, 0x2d, 0xfe, 0x24, 0x05,
-	0x5d, 0x1d, 0xbb, 0x40, 0xd4, 0xdd, 0xde, 0x49, 0x45, 0x2b, 0xc5, 0x1d, 0x33, 0x27, 0x18, 0x7a,
-	0x12, 0x81, 0x56, 0x92, 0x97, 0x19, 0xf5, 0x56, 0xa3, 0xb1, 0x9a, 0x9a, 0x5e, 0x8a, 0xfe, 0x39,
-	0x6f, 0x85, 0x93, 0x9e, 0x15, 0xa0, 0x1b, 0xc9, 0xcb, 0x8d, 0x79, 0x0f, 0xd1, 0x58, 0x9b, 0x86,
-	0x45, 0x2a, 0xf1, 0x05, 0xeb, 0x61, 0x13, 0x7e, 0x9a, 0x8f, 0xc7, 0x9d, 0xbf, 0xde, 0xe8, 0x37,
-	0x07, 0x8d, 0x1b, 0x53, 0x70, 0x48, 0x05, 0x9c, 0xf8, 0xa3, 0x1f, 0x3f, 0x0c, 0x57, 0x27, 0x7a,
-	0xcd, 0xc9, 0x62, 0xf0, 0x33, 0x98, 0x8b, 0xfd, 0x90, 0x90, 0x18, 0x35, 0xc9, 0x3f, 0x36, 0x34,
-	0xc6, 0x01, 0x3a, 0x0f, 0xc9, 0xd8, 0x95, 0x00, 0x1a, 0xe1, 0xfd, 0x09, 0xd7, 0x06, 0x8d, 0xab,
-	0x69, 0x48, 0xe5, 0x46, 0x3c, 0x06, 0x97, 0xb1, 0xb6, 0x1a, 0x5d, 0x4b, 0x5e, 0x23, 0xf9, 0x4a,
-	0xa0, 0xf1, 0x6e, 0x4a, 0x6a, 0x29, 0xb4, 0x0d, 0xb0, 0x85, 0xc9, 0x0e, 0x26, 0x2e, 0xf5, 0x91,
-	0xcb, 0x89, 0x26, 0x0f, 0x08, 0x7c, 0x31, 0x6f, 0x4f, 0xa4, 0x93, 0x02, 0x7e, 0x04, 0xc8, 0xcf,
-	0x73, 0xa1, 0x9f, 0xb1, 0xde, 0x18, 0xdb, 0xbe, 0xf0, 0x5e, 0x63, 0xd2, 0xd9, 0x3c, 0x82, 0xda,
-	0x8e, 0x6e, 0xd3, 0xa4, 0x1d, 0xac, 0x7b, 0x2d, 0x51, 0xb1, 0x38, 0xd9, 0x08, 0x6b, 0x8d, 0xa4,
-	0x96, 0x9b, 0x79, 0x2c, 0x73, 0xa8, 0x2e, 0x43, 0x10, 0xc7, 0xb1, 0x25, 0xb0, 0x46, 0x8c, 0x70,
-	0x04, 0xb6, 0x8c, 0xa1, 0x97, 0x82, 0x9f, 0x2a, 0xec, 0x69, 0x59, 0x8c, 0xe0, 0x81, 0x49, 0x0e,
-	0x69, 0x57, 0xeb, 0xa5, 0x51, 0x81, 0x11, 0x4e, 0xa1, 0x82, 0xa0, 0x97, 0x2a, 0x18, 0x50, 0x89,
-	0x74, 0x07, 0x28, 0xe9, 0xb7, 0xa8, 0xa4, 0xfe, 0xa4, 0xb1, 0x3c, 0x99, 0x50, 0x4a, 0x39, 0x84,
-	0x8a, 0xef, 0xaf, 0xdc, 0xb8, 0x57, 0x46, 0x69, 0x1a, 0xd0, 0x8c, 0x08, 0xb7, 0x64, 0xd2, 0x70,
-	0xb8, 0x0d, 0x17, 0x7e, 0x28, 0x5d, 0xc3, 0x30, 0x2e, 0xdc, 0x46, 0x57, 0x93, 0xea, 0x99, 0xb5,
-	0xff, 0xe4, 0xa0, 0xe0, 0x5f, 0xe6, 0xbf, 0x84, 0x8a, 0xec, 0x25, 0x94, 0x48, 0x9f, 0xc1, 0x5c,
-	0xec, 0xdd, 0x4f, 0x22, 0x82, 0x26, 0xbf, 0x0d, 0x9a, 0x04, 0x01, 0x0f, 0xc4, 0x7f, 0x03, 0x48,
-	0xb4, 0x7c, 0x7b, 0x54, 0x99, 0x15, 0x07, 0xca, 0x09, 0x0b, 0x3f, 0x77, 0x58, 0xbc, 0x07, 0x10,
-	0x82, 0xad, 0xf1, 0xb7, 0x39, 0x34, 0x12, 0x27, 0x28, 0xbc, 0x7e, 0xf3, 0xc7, 0x37, 0xba, 0x26,
-	0x39, 0x1c, 0xec, 0xd3, 0x2f, 0xab, 0x9c, 0xf4, 0x5d, 0xd3, 0x11, 0x7f, 0xad, 0xfa, 0x27, 0xba,
-	0xca, 0xb8, 0x57, 0xa9, 0x80, 0xfe, 0xfe, 0xfe, 0x0c, 0x1b, 0xdd, 0xfc, 0x7f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf4, 0x7a, 0xd1, 0x47, 0x2f, 0x32, 0x00, 0x00,
+	// 3504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x73, 0x1b, 0xc7,
+	0xd1, 0xd7, 0xe2, 0x41, 0x02, 0x0d, 0x10, 0x02, 0x47, 0x34, 0x05, 0x41, 0xb2, 0x48, 0xad, 0x65,
+	0x99, 0x96, 0x65, 0xc9, 0xa2, 0xec, 0xcf, 0xae, 0xcf, 0xaf, 0xcf, 0x12, 0x2d, 0x1a, 0x65, 0x51,
+	0xa6, 0x97, 0x94, 0xfd, 0x55, 0x9c, 0x0a, 0xb2, 0xc4, 0x0e, 0xc1, 0x0d, 0xb1, 0xbb, 0xf0, 0xee,
+	0x40, 0x22, 0x7d, 0xb1, 0x2a, 0xae, 0x24, 0x95, 0x94, 0x9d, 0xa4, 0x2a, 0x57, 0x57, 0x25, 0x95,
+	0xaa, 0x54, 0x92, 0xca, 0x25, 0xe7, 0x1c, 0x73, 0x89, 0x2b, 0x39, 0xe4, 0x92, 0xbf, 0x20, 0x17,
+	0xff, 0x01, 0x39, 0xe6, 0x92, 0x9a, 0xc7, 0xce, 0x3e, 0x30, 0x00, 0x96, 0x0f, 0x59, 0xb9, 0x71,
+	0x66, 0x7b, 0xa6, 0x7b, 0x7a, 0xfa, 0xf1, 0xeb, 0xc6, 0x10, 0xea, 0x96, 0x49, 0xcc, 0x76, 0xc7,
+	0xf3, 0x7c, 0xeb, 0x6a, 0xdf, 0xf7, 0x88, 0x87, 0x66, 0x1d, 0xbb, 0x77, 0x7f, 0x10, 0xf0, 0xd1,
+	0x55, 0xfa, 0xb9, 0x59, 0xed, 0x78, 0x8e, 0xe3, 0xb9, 0x7c, 0xaa, 0x59, 0xb3, 0x5d, 0x82, 0x7d,
+	0xd7, 0xec, 0x89, 0x71, 0x35, 0xbe, 0xa0, 0x59, 0x0d, 0x3a, 0x3b, 0xd8, 0x31, 0xf9, 0x48, 0xdf,
+	0x83, 0xea, 0xed, 0xde, 0x20, 0xd8, 0x31, 0xf0, 0xc7, 0x03, 0x1c, 0x10, 0xf4, 0x02, 0x14, 0xb6,
+	0xcc, 0x00, 0x37, 0xb4, 0x45, 0x6d, 0xa9, 0xb2, 0x7c, 0xee, 0x6a, 0x82, 0x97, 0xe0, 0xb2, 0x16,
+	0x74, 0x6f, 0x9a, 0x01, 0x36, 0x18, 0x25, 0x42, 0x50, 0xb0, 0xb6, 0x5a, 0x2b, 0x8d, 0xdc, 0xa2,
+	0xb6, 0x94, 0x37, 0xd8, 0xdf, 0x48, 0x87, 0x6a, 0xc7, 0xeb, 0xf5, 0x70, 0x87, 0xd8, 0x9e, 0xdb,
+	0x5a, 0x69, 0x14, 0xd8, 0xb7, 0xc4, 0x9c, 0xfe, 0xa5, 0x06, 0x33, 0x82, 0x75, 0xd0, 0xf7, 0xdc,
+	0x00, 0xa3, 0x1b, 0x30, 0x15, 0x10, 0x93, 0x0c, 0x02, 0xc1, 0xfd, 0xac, 0x92, 0xfb, 0x06, 0x23,
+	0x31, 0x04, 0x69, 0x26, 0xf6, 0xf9, 0x61, 0xf6, 0xe8, 0x3c, 0x40, 0x80, 0xbb, 0x0e, 0x76, 0x49,
+	0x6b, 0x25, 0x68, 0x14, 0x16, 0xf3, 0x4b, 0x79, 0x23, 0x36, 0xa3, 0xff, 0x43, 0x83, 0xfa, 0x46,
+	0x38, 0x0c, 0xb5, 0x33, 0x07, 0xc5, 0x8e, 0x37, 0x70, 0x09, 0x13, 0x70, 0xc6, 0xe0, 0x03, 0x74,
+	0x01, 0xaa, 0x9d, 0x1d, 0xd3, 0x75, 0x71, 0xaf, 0xed, 0x9a, 0x0e, 0x66, 0xa2, 0x94, 0x8d, 0x8a,
+	0x98, 0xbb, 0x6b, 0x3a, 0x38, 0x93, 0x44, 0x8b, 0x50, 0xe9, 0x9b, 0x3e, 0xb1, 0x13, 0x3a, 0x8b,
+	0x4f, 0xa1, 0xb3, 0x50, 0xb6, 0x83, 0xb6, 0xed, 0xf4, 0x3d, 0x9f, 0x34, 0x8a, 0x8b, 0xda, 0x52,
+	0xc9, 0x28, 0xd9, 0x41, 0x8b, 0x8d, 0xd1, 0x45, 0xa8, 0xf1, 0x2f, 0x6d, 0x62, 0x06, 0xbb, 0x6d,
+	0xdb, 0x6a, 0x4c, 0x71, 0x26, 0x7c, 0x76, 0xd3, 0x0c, 0x76, 0x5b, 0x96, 0xfe, 0x2b, 0x0d, 0xe6,
+	0xdf, 0x0a, 0x02, 0xbb, 0xeb, 0x0e, 0x1d, 0x6e, 0x1e, 0xa6, 0x5c, 0xcf, 0xc2, 0xad, 0x15, 0x76,
+	0xba, 0xbc, 0x21, 0x46, 0x94, 0x6b, 0x1f, 0x63, 0xbf, 0xed, 0x7b, 0xbd, 0xf0, 0x6c, 0x25, 0x3a,
+	0x61, 0x78, 0x3d, 0x8c, 0xde, 0x87, 0xd9, 0x20, 0xb5, 0x51, 0xd0, 0xc8, 0x2f, 0xe6, 0x97, 0x2a,
+	0xcb, 0x4f, 0x5d, 0x1d, 0x32, 0xd4, 0xab, 0x69, 0xa6, 0xc6, 0xf0, 0x6a, 0xfd, 0x61, 0x0e, 0x4e,
+	0x49, 0x3a, 0x2e, 0x2b, 0xfd, 0x9b, 0x2a, 0x3f, 0xc0, 0x5d, 0x29, 0x1e, 0x1f, 0x64, 0x51, 0xbe,
+	0xbc, 0xb5, 0x7c, 0xfc, 0xd6, 0x32, 0xd8, 0x68, 0xfa, 0x4a, 0x8a, 0xc3, 0x57, 0xb2, 0x00, 0x15,
+	0xbc, 0xd7, 0xb7, 0x7d, 0xdc, 0x26, 0xb6, 0x83, 0x99, 0xca, 0x0b, 0x06, 0xf0, 0xa9, 0x4d, 0xdb,
+	0x89, 0x1b, 0xf5, 0x74, 0x66, 0xa3, 0xd6, 0x7f, 0xad, 0xc1, 0xe9, 0xa1, 0x5b, 0x12, 0x5e, 0x62,
+	0x40, 0x9d, 0x9d, 0x3c, 0xd2, 0x0c, 0xf5, 0x17, 0xaa, 0xf0, 0x4b, 0xe3, 0x14, 0x1e, 0x91, 0x1b,
+	0x43, 0xeb, 0x63, 0x42, 0xe6, 0xb2, 0x0b, 0xb9, 0x0b, 0xa7, 0x57, 0x31, 0x11, 0x0c, 0xe8, 0x37,
+	0x1c, 0x1c, 0x3e, 0x8a, 0x24, 0xdd, 0x31, 0x37, 0xe4, 0x8e, 0x7f, 0xcc, 0x49, 0x77, 0x64, 0xac,
+	0x5a, 0xee, 0xb6, 0x87, 0xce, 0x41, 0x59, 0x92, 0x08, 0xab, 0x88, 0x26, 0xd0, 0xcb, 0x50, 0xa4,
+	0x92, 0x72, 0x93, 0xa8, 0x2d, 0x5f, 0x50, 0x9f, 0x29, 0xb6, 0xa7, 0xc1, 0xe9, 0x51, 0x0b, 0x6a,
+	0x01, 0x31, 0x7d, 0xd2, 0xee, 0x7b, 0x01, 0xbb, 0x67, 0x66, 0x38, 0x95, 0x65, 0x3d, 0xb9, 0x83,
+	0x8c, 0xb2, 0x6b, 0x41, 0x77, 0x5d, 0x50, 0x1a, 0x33, 0x6c, 0x65, 0x38, 0x44, 0x6f, 0x43, 0x15,
+	0xbb, 0x56, 0xb4, 0x51, 0x21, 0xf3, 0x46, 0x15, 0xec, 0x5a, 0x72, 0x9b, 0xe8, 0x7e, 0x8a, 0xd9,
+	0xef, 0xe7, 0x73, 0x0d, 0x1a, 0xc3, 0x17, 0x74, 0x94, 0x58, 0xfb, 0x2a, 0x5f, 0x84, 0xf9, 0x05,
+	0x8d, 0xf5, 0x70, 0x79, 0x49, 0x86, 0x58, 0xa2, 0xdb, 0xf0, 0x44, 0x24, 0x0d, 0xfb, 0xf2, 0xc8,
+	0x8c, 0xe5, 0x33, 0x0d, 0xe6, 0xd3, 0xbc, 0x8e, 0x72, 0xee, 0x17, 0xa1, 0x68, 0xbb, 0xdb, 0x5e,
+	0x78, 0xec, 0xf3, 0x63, 0xfc, 0x8c, 0xf2, 0xe2, 0xc4, 0xba, 0x03, 0x67, 0x57, 0x31, 0x69, 0xb9,
+	0x01, 0xf6, 0xc9, 0x4d, 0xdb, 0xed, 0x79, 0xdd, 0x75, 0x93, 0xec, 0x1c, 0xc1, 0x47, 0x12, 0xe6,
+	0x9e, 0x4b, 0x99, 0xbb, 0xfe, 0x3b, 0x0d, 0xce, 0xa9, 0xf9, 0x89, 0xa3, 0x37, 0xa1, 0xb4, 0x6d,
+	0xe3, 0x9e, 0x45, 0x75, 0xa6, 0x31, 0x9d, 0xc9, 0x31, 0xf5, 0x95, 0x3e, 0x25, 0x16, 0x27, 0xbc,
+	0x30, 0xc2, 0x40, 0x37, 0x88, 0x6f, 0xbb, 0xdd, 0x3b, 0x76, 0x40, 0x0c, 0x4e, 0x1f, 0xd3, 0x67,
+	0x3e, 0xbb, 0x65, 0xfe, 0x44, 0x83, 0xf3, 0xab, 0x98, 0xdc, 0x92, 0xa1, 0x96, 0x7e, 0xb7, 0x03,
+	0x62, 0x77, 0x82, 0x47, 0x8b, 0x43, 0x14, 0x69, 0x57, 0xff, 0x99, 0x06, 0x0b, 0x23, 0x85, 0x11,
+	0xaa, 0x13, 0xa1, 0x24, 0x0c, 0xb4, 0xea, 0x50, 0xf2, 0x2e, 0xde, 0xff, 0xc0, 0xec, 0x0d, 0xf0,
+	0xba, 0x69, 0xfb, 0x3c, 0x94, 0x1c, 0x32, 0xb0, 0xfe, 0x41, 0x83, 0x27, 0x57, 0x31, 0x59, 0x0f,
+	0xd3, 0xcc, 0x63, 0xd4, 0xce, 0x64, 0x50, 0xa2, 0xff, 0x94, 0x5f, 0xa6, 0x52, 0xda, 0xc7, 0xa2,
+	0xbe, 0xf3, 0xcc, 0x0f, 0x62, 0x0e, 0x79, 0x8b, 0x63, 0x01, 0xa1, 0x3c, 0xfd, 0x61, 0x1e, 0xaa,
+	0x1f, 0x08, 0x7c, 0xc0, 0xd2, 0x48, 0x5a, 0x0f, 0x9a, 0x5a, 0x0f, 0x31, 0x48, 0xa1, 0x42, 0x19,
+	0xab, 0x30, 0x13, 0x60, 0xbc, 0x7b, 0x98, 0xa4, 0x51, 0xa5, 0x0b, 0x65, 0xb0, 0xbf, 0x03, 0xb3,
+	0x03, 0x77, 0x9b, 0x22, 0x63, 0x6c, 0x89, 0x53, 0x70, 0x80, 0x3a, 0x39, 0xf2, 0x0c, 0x2f, 0x44,
+	0xef, 0xc0, 0xc9, 0xf4, 0x5e, 0xc5, 0x4c, 0x7b, 0xa5, 0x97, 0xa1, 0x16, 0xd4, 0x2d, 0xdf, 0xeb,
+	0xf7, 0xb1, 0xd5, 0x0e, 0xc2, 0xad, 0xa6, 0xb2, 0x6d, 0x25, 0xd6, 0x85, 0x5b, 0xe9, 0x3f, 0xd6,
+	0x60, 0xfe, 0x43, 0x93, 0x74, 0x76, 0x56, 0x1c, 0x71, 0x39, 0x47, 0x30, 0xed, 0xd7, 0xa1, 0x7c,
+	0x5f, 0x5c, 0x44, 0x18, 0xbf, 0x16, 0x14, 0x02, 0xc5, 0xaf, 0xdc, 0x88, 0x56, 0xe8, 0x5f, 0x69,
+	0x30, 0xc7, 0xea, 0x90, 0x50, 0xba, 0x6f, 0xde, 0xc9, 0x26, 0xd4, 0x22, 0xe8, 0x12, 0xd4, 0x1c,
+	0xd3, 0xdf, 0xdd, 0x88, 0x68, 0x8a, 0x8c, 0x26, 0x35, 0xab, 0xef, 0x01, 0x88, 0xd1, 0x5a, 0xd0,
+	0x3d, 0x84, 0xfc, 0xaf, 0xc0, 0xb4, 0xe0, 0x2a, 0xfc, 0x6d, 0xd2, 0xc5, 0x86, 0xe4, 0xfa, 0x17,
+	0x39, 0xa8, 0x45, 0x11, 0x94, 0x79, 0x55, 0x0d, 0x72, 0xd2, 0x97, 0x72, 0xad, 0x15, 0xf4, 0x3a,
+	0x4c, 0xf1, 0xca, 0x53, 0xec, 0xfd, 0x74, 0x72, 0x6f, 0x51, 0x95, 0xc6, 0xc2, 0x30, 0x9b, 0x30,
+	0xc4, 0x22, 0xaa, 0x23, 0x19, 0x75, 0x78, 0x85, 0x91, 0x37, 0x62, 0x33, 0xa8, 0x05, 0x27, 0x93,
+	0xa0, 0x2d, 0xf4, 0x99, 0xc5, 0x51, 0xd1, 0x66, 0xc5, 0x24, 0x26, 0x0b, 0x36, 0xb5, 0x04, 0x66,
+	0x0b, 0xd0, 0x5b, 0x00, 0x7d, 0xdf, 0xeb, 0x63, 0x9f, 0xd8, 0x38, 0xf4, 0x96, 0x0c, 0x31, 0x2b,
+	0xb6, 0x48, 0xff, 0xe7, 0x14, 0x54, 0x62, 0x8a, 0x1a, 0x52, 0x46, 0xda, 0x2a, 0x72, 0x93, 0x43,
+	0x6f, 0x7e, 0xb8, 0xf8, 0x78, 0x1a, 0x6a, 0x36, 0x4b, 0xf7, 0x6d, 0x61, 0xcd, 0x2c, 0x3e, 0x97,
+	0x8d, 0x19, 0x3e, 0x2b, 0x5c, 0x0b, 0x9d, 0x87, 0x8a, 0x3b, 0x70, 0xda, 0xde, 0x76, 0xdb, 0xf7,
+	0x1e, 0x04, 0xa2, 0x8a, 0x29, 0xbb, 0x03, 0xe7, 0xbd, 0x6d, 0xc3, 0x7b, 0x10, 0x44, 0x40, 0x79,
+	0xea, 0x80, 0x40, 0xf9, 0x3c, 0x54, 0x1c, 0x73, 0x8f, 0xee, 0xda, 0x76, 0x07, 0x0e, 0x2b, 0x70,
+	0xf2, 0x46, 0xd9, 0x31, 0xf7, 0x0c, 0xef, 0xc1, 0xdd, 0x81, 0x83, 0x96, 0xa0, 0xde, 0x33, 0x03,
+	0xd2, 0x8e, 0x57, 0x48, 0x25, 0x56, 0x21, 0xd5, 0xe8, 0xfc, 0xdb, 0x51, 0x95, 0x34, 0x0c, 0xb9,
+	0xcb, 0x47, 0x80, 0xdc, 0x96, 0xd3, 0x8b, 0x36, 0x82, 0xec, 0x90, 0xdb, 0x72, 0x7a, 0x72, 0x9b,
+	0x57, 0x60, 0x7a, 0x8b, 0x81, 0xa8, 0xa0, 0x51, 0x19, 0x19, 0xe4, 0x6e, 0x53, 0xfc, 0xc4, 0xb1,
+	0x96, 0x11, 0x92, 0xa3, 0xd7, 0xa0, 0xcc, 0xb2, 0x17, 0x5b, 0x5b, 0xcd, 0xb4, 0x36, 0x5a, 0x40,
+	0x57, 0x5b, 0xb8, 0x47, 0x4c, 0xb6, 0x7a, 0x26, 0xdb, 0x6a, 0xb9, 0x00, 0xbd, 0x00, 0xa7, 0x3a,
+	0x3e, 0x36, 0x09, 0xb6, 0x6e, 0xee, 0xdf, 0xf2, 0x9c, 0xbe, 0xc9, 0x8c, 0xa9, 0x51, 0x63, 0xbd,
+	0x02, 0xd5, 0x27, 0x1a, 0x5b, 0x3a, 0x72, 0x74, 0xdb, 0xf7, 0x9c, 0xc6, 0x49, 0x1e, 0x5b, 0x92,
+	0xb3, 0xe8, 0x49, 0x80, 0x30, 0xfa, 0x9b, 0xa4, 0x51, 0x67, 0xb7, 0x58, 0x16, 0x33, 0x6f, 0xb1,
+	0x1e, 0x88, 0x6c, 0x4d, 0xd8, 0x6e, 0xb7, 0x31, 0xcb, 0x38, 0x56, 0xc2, 0xee, 0x84, 0xed, 0x76,
+	0xd1, 0x7b, 0x30, 0xd7, 0xe9, 0x0d, 0x02, 0x82, 0x29, 0x86, 0x6c, 0xef, 0xe2, 0xfd, 0xb6, 0x6f,
+	0xba, 0x5d, 0xdc, 0x40, 0xec, 0x82, 0x9e, 0x1c, 0x75, 0x48, 0x83, 0x12, 0x19, 0x28, 0x5a, 0xfa,
+	0x2e, 0xde, 0x67, 0x73, 0xb4, 0xc0, 0x81, 0x88, 0x04, 0x35, 0x60, 0x5a, 0xe0, 0x59, 0xe1, 0x68,
+	0xe1, 0x10, 0x5d, 0x87, 0xbc, 0x63, 0xbb, 0x22, 0xee, 0x2c, 0x28, 0xe3, 0x0e, 0x73, 0x63, 0xbe,
+	0x19, 0xa5, 0x65, 0x4b, 0xcc, 0x3d, 0x91, 0xc3, 0x33, 0x2c, 0x31, 0xf7, 0xf4, 0x4f, 0x61, 0x2e,
+	0x72, 0x92, 0x98, 0x41, 0x0e, 0xdb, 0xb6, 0x76, 0x58, 0xdb, 0x1e, 0x5f, 0x01, 0xfc, 0xbd, 0x00,
+	0xf3, 0x1b, 0xe6, 0x7d, 0xfc, 0xe8, 0x8b, 0x8d, 0x4c, 0x59, 0xed, 0x0e, 0xcc, 0xb2, 0x0b, 0x58,
+	0x8e, 0xc9, 0x33, 0x06, 0xc7, 0xc4, 0x2d, 0x7a, 0x78, 0x21, 0x7a, 0x93, 0x02, 0x30, 0xdc, 0xd9,
+	0x5d, 0xf7, 0xec, 0x08, 0xc3, 0xa8, 0x8c, 0xe6, 0x96, 0xa4, 0x32, 0xe2, 0x2b, 0xd0, 0xfa, 0x70,
+	0x82, 0xe0, 0xe8, 0xe5, 0x99, 0xb1, 0x55, 0x6c, 0xa4, 0xfd, 0xa1, 0x3c, 0x41, 0x0d, 0x8e, 0x63,
+	0x24, 0x16, 0xfa, 0x4a, 0x46, 0x38, 0x44, 0xeb, 0x70, 0x8a, 0x9f, 0x60, 0x43, 0xf8, 0x35, 0x3f,
+	0x7c, 0x29, 0xd3, 0xe1, 0x55, 0x4b, 0x93, 0x61, 0xa1, 0x7c, 0xd0, 0xb0, 0xd0, 0x80, 0x69, 0xe1,
+	0xaa, 0x2c, 0x1c, 0x96, 0x8c, 0x70, 0x48, 0xaf, 0x39, 0x72, 0xda, 0x0a, 0xfb, 0x16, 0x4d, 0xd0,
+	0x42, 0x0d, 0x22, 0x7d, 0x4e, 0xe8, 0xb7, 0xbc, 0x01, 0x25, 0x69, 0xe1, 0xb9, 0xcc, 0x16, 0x2e,
+	0xd7, 0xa4, 0xd3, 0x54, 0x3e, 0x95, 0xa6, 0xf4, 0xbf, 0x69, 0x50, 0x5d, 0xa1, 0x47, 0xba, 0xe3,
+	0x75, 0x59, 0x52, 0x7d, 0x1a, 0x6a, 0x3e, 0xee, 0x78, 0xbe, 0xd5, 0xc6, 0x2e, 0xf1, 0x69, 0xae,
+	0xd6, 0x58, 0x58, 0x9a, 0xe1, 0xb3, 0x6f, 0xf3, 0x49, 0x4a, 0x46, 0x33, 0x4f, 0x40, 0x4c, 0xa7,
+	0xdf, 0xde, 0xa6, 0x11, 0x2e, 0xc7, 0xc9, 0xe4, 0x2c, 0x0b, 0x70, 0x17, 0xa0, 0x1a, 0x91, 0x11,
+	0x8f, 0xf1, 0x2f, 0x18, 0x15, 0x39, 0xb7, 0xe9, 0xa1, 0x8b, 0x50, 0x63, 0x3a, 0x6d, 0xf7, 0xbc,
+	0x6e, 0x9b, 0xd6, 0xbf, 0x22, 0xdf, 0x56, 0x2d, 0x21, 0x16, 0xbd, 0xab, 0x24, 0x55, 0x60, 0x7f,
+	0x82, 0x45, 0xc6, 0x95, 0x54, 0x1b, 0xf6, 0x27, 0x58, 0xff, 0xab, 0x06, 0x33, 0x14, 0x81, 0xdc,
+	0xf5, 0x2c, 0xbc, 0x79, 0x48, 0xbc, 0x96, 0xa1, 0xf7, 0x79, 0x0e, 0xca, 0xf2, 0x04, 0xe2, 0x48,
+	0xd1, 0x04, 0xba, 0x0d, 0xb5, 0x10, 0xca, 0xb7, 0x79, 0x85, 0x56, 0x18, 0x89, 0x9f, 0x63, 0x00,
+	0x20, 0x30, 0x66, 0xc2, 0x65, 0x6c, 0xa8, 0xdf, 0x86, 0x6a, 0xfc, 0x33, 0xe5, 0xba, 0x91, 0x36,
+	0x14, 0x39, 0x41, 0xad, 0xf1, 0xee, 0xc0, 0xa1, 0x77, 0x2a, 0x02, 0x4b, 0x38, 0xd4, 0x3f, 0xd3,
+	0x60, 0x46, 0xa0, 0x96, 0x0d, 0xd9, 0xde, 0x67, 0x47, 0xd3, 0xd8, 0xd1, 0xd8, 0xdf, 0xe8, 0x7f,
+	0x93, 0x8d, 0xbd, 0x8b, 0xca, 0x20, 0xc0, 0x36, 0x61, 0x35, 0x46, 0x02, 0xb2, 0x64, 0xe9, 0x08,
+	0x3c, 0xa4, 0x86, 0x26, 0xae, 0x86, 0x19, 0x5a, 0x03, 0xa6, 0x4d, 0xcb, 0xf2, 0x71, 0x10, 0x08,
+	0x39, 0xc2, 0x21, 0xfd, 0x72, 0x1f, 0xfb, 0x41, 0x68, 0xf2, 0x79, 0x23, 0x1c, 0xa2, 0xd7, 0xa0,
+	0x24, 0x8b, 0x92, 0xbc, 0x0a, 0x88, 0xc6, 0xe5, 0x14, 0x15, 0xac, 0x5c, 0xa1, 0xff, 0x28, 0x0f,
+	0x35, 0xa1, 0xb0, 0x9b, 0x02, 0x56, 0x8c, 0x77, 0xbe, 0x9b, 0x50, 0xdd, 0x8e, 0x7c, 0x7f, 0x5c,
+	0xa7, 0x2a, 0x1e, 0x22, 0x12, 0x6b, 0x26, 0x39, 0x60, 0x12, 0xd8, 0x14, 0x8e, 0x04, 0x6c, 0x8a,
+	0x07, 0x8d, 0x60, 0xa3, 0xc0, 0xc3, 0xd4, 0x21, 0xc1, 0x83, 0x02, 0xf7, 0x4c, 0xab, 0x70, 0x8f,
+	0xfe, 0x6d, 0xa8, 0xc4, 0x44, 0x1a, 0x03, 0x32, 0x6e, 0x44, 0x80, 0x91, 0x2b, 0xff, 0x8c, 0x42,
+	0xa8, 0x14, 0x56, 0xd4, 0x7f, 0xaf, 0xc1, 0x94, 0xd8, 0x79, 0x01, 0x2a, 0x22, 0x8c, 0x31, 0x30,
+	0xcd, 0x77, 0x07, 0x31, 0x45, 0xd1, 0xf4, 0xf1, 0xc5, 0xb1, 0x33, 0x50, 0x4a, 0x45, 0xb0, 0x69,
+	0x91, 0x68, 0xc2, 0x4f, 0xb1, 0xb0, 0x45, 0x3f, 0xb1, 0x88, 0xf5, 0x95, 0xc6, 0x1a, 0xfe, 0x06,
+	0xee, 0x78, 0xf7, 0xb1, 0xbf, 0x7f, 0xf4, 0xb6, 0xea, 0xab, 0x31, 0x17, 0xc9, 0x58, 0xb7, 0xcb,
+	0x05, 0xe8, 0xd5, 0x48, 0xdd, 0x79, 0x55, 0x85, 0x16, 0x8f, 0x59, 0xc2, 0xc0, 0x23, 0xb5, 0xff,
+	0x9c, 0x37, 0x88, 0x93, 0x47, 0x39, 0x2c, 0x52, 0x3a, 0x96, 0x5a, 0x4e, 0xff, 0x85, 0x06, 0x67,
+	0x56, 0x31, 0xb9, 0x9d, 0x6c, 0xba, 0x3c, 0x6e, 0xa9, 0x1c, 0x68, 0xaa, 0x84, 0x3a, 0xca, 0xad,
+	0x37, 0xa1, 0x24, 0xdb, 0x47, 0xbc, 0x75, 0x2f, 0xc7, 0xfa, 0x0f, 0x35, 0x68, 0x08, 0x2e, 0x8c,
+	0x27, 0xad, 0x53, 0x7a, 0x98, 0x60, 0xeb, 0x9b, 0xee, 0x67, 0xfc, 0x52, 0x83, 0x7a, 0x3c, 0x87,
+	0xb0, 0x34, 0xf0, 0x12, 0x14, 0x59, 0xdb, 0x48, 0x48, 0x30, 0xd1, 0x58, 0x39, 0x35, 0x0d, 0x19,
+	0x0c, 0x38, 0x6e, 0xca, 0x74, 0x27, 0x86, 0x51, 0x22, 0xcb, 0x1f, 0x38, 0x91, 0xe9, 0x9f, 0xe7,
+	0xa0, 0x11, 0x95, 0x71, 0xdf, 0x78, 0xae, 0x18, 0x81, 0x70, 0xf3, 0xc7, 0x84, 0x70, 0x0b, 0x07,
+	0xcc, 0x0f, 0xfa, 0x9f, 0xf3, 0x50, 0x8b, 0xd4, 0xb1, 0xde, 0x33, 0x5d, 0x34, 0x0f, 0x53, 0xfd,
+	0x9e, 0x19, 0x35, 0x74, 0xc5, 0x08, 0x6d, 0x48, 0xd0, 0x93, 0x54, 0xc0, 0x73, 0x2a, 0xf5, 0x8f,
+	0xd0, 0xb0, 0x91, 0xda, 0x82, 0x96, 0xc7, 0xbc, 0xba, 0x60, 0x4d, 0x0e, 0x01, 0xb4, 0xf8, 0x3d,
+	0xdb, 0x0e, 0x46, 0x57, 0x00, 0xd1, 0x0f, 0xde, 0x80, 0xb4, 0x6d, 0xb7, 0x1d, 0xe0, 0x8e, 0xe7,
+	0x5a, 0x01, 0x8b, 0xbd, 0x45, 0xa3, 0x2e, 0xbe, 0xb4, 0xdc, 0x0d, 0x3e, 0x8f, 0x5e, 0x82, 0x02,
+	0xd9, 0xef, 0xf3, 0x00, 0x5c, 0x53, 0x06, 0xb6, 0x48, 0xae, 0xcd, 0xfd, 0x3e, 0x36, 0x18, 0x39,
+	0x3a, 0x0f, 0x40, 0xb7, 0x22, 0xbe, 0x79, 0x1f, 0xf7, 0xc2, 0x9f, 0xa2, 0xa3, 0x19, 0x6a, 0x88,
+	0x61, 0x9f, 0x68, 0x9a, 0x47, 0x7d, 0x31, 0xa4, 0xa9, 0x25, 0x0a, 0x0c, 0x6d, 0x42, 0x7a, 0xac,
+	0x4d, 0x93, 0x37, 0x66, 0xa2, 0xd9, 0x4d, 0xd2, 0x43, 0x2f, 0xc2, 0x7c, 0x2a, 0x09, 0x87, 0xb9,
+	0xb0, 0xcc, 0xc8, 0xe7, 0x12, 0x79, 0xf6, 0xb6, 0x48, 0x8c, 0x4b, 0x50, 0x77, 0xcc, 0xbd, 0xb0,
+	0x67, 0xcc, 0xb1, 0x05, 0x30, 0xfa, 0x9a, 0x63, 0xee, 0x09, 0xbd, 0x32, 0xf8, 0xf7, 0xef, 0x1c,
+	0xd4, 0xa3, 0x93, 0x19, 0x38, 0x18, 0xf4, 0xc8, 0xc8, 0x6b, 0x1c, 0x5f, 0xa0, 0x4e, 0xc2, 0x32,
+	0x6f, 0x42, 0x45, 0xb4, 0xce, 0x0e, 0x60, 0x6f, 0xc0, 0x97, 0xdc, 0x19, 0xe3, 0x00, 0xc5, 0x63,
+	0x72, 0x80, 0xa9, 0x83, 0x02, 0xa4, 0xff, 0x8b, 0x85, 0xd5, 0x69, 0xb6, 0xf8, 0x62, 0x16, 0x7b,
+	0x8e, 0x05, 0xdf, 0x7f, 0xe5, 0x60, 0x76, 0xe8, 0xfb, 0x84, 0x50, 0x92, 0x52, 0x73, 0x6e, 0x82,
+	0x9a, 0xf3, 0xc7, 0xa5, 0xe6, 0xc2, 0x31, 0xa9, 0xf9, 0xb1, 0xe3, 0x50, 0x7d, 0x03, 0xe6, 0xc3,
+	0x8c, 0x17, 0x71, 0x5c, 0xc3, 0xc4, 0x1c, 0x03, 0x35, 0x17, 0xa0, 0xc2, 0x91, 0x0c, 0x87, 0x70,
+	0xbc, 0xec, 0x83, 0x2d, 0xd9, 0x2d, 0xd1, 0xbf, 0x03, 0x73, 0x2c, 0x63, 0xa4, 0x7f, 0x5c, 0xc9,
+	0xf2, 0x4b, 0x97, 0x2e, 0x8b, 0x4a, 0x5a, 0x40, 0xf2, 0xe0, 0x58, 0x36, 0x12, 0x73, 0xfa, 0x1d,
+	0x78, 0x22, 0xb5, 0xff, 0x11, 0x10, 0x81, 0xfe, 0x27, 0x0d, 0xce, 0xac, 0xf8, 0x5e, 0xff, 0x03,
+	0xdb, 0x27, 0x03, 0xb3, 0x97, 0xfc, 0xb9, 0xee, 0xd1, 0x94, 0xc5, 0xef, 0xc4, 0xbc, 0x85, 0x1b,
+	0xe5, 0x15, 0xc5, 0xd5, 0x0d, 0x0b, 0x35, 0xec, 0x35, 0x5f, 0xe7, 0x55, 0xc2, 0x67, 0xf3, 0x9e,
+	0x2c, 0x18, 0x4d, 0xd9, 0x45, 0xcb, 0x1f, 0xb6, 0x8b, 0xf6, 0xdf, 0xe6, 0x4e, 0xef, 0x40, 0xb2,
+	0xc3, 0x29, 0xfc, 0xe8, 0x10, 0xad, 0xd1, 0x9b, 0x00, 0x51, 0xb7, 0x4f, 0xbc, 0xb5, 0xca, 0xb2,
+	0x4d, 0x6c, 0x15, 0xbd, 0x2d, 0x19, 0xba, 0x44, 0x06, 0x8c, 0xf5, 0x9f, 0xde, 0x87, 0xa6, 0xca,
+	0x4a, 0x8f, 0x62, 0xf9, 0x5f, 0x68, 0x30, 0xb7, 0x6e, 0xbb, 0xd1, 0x8f, 0x5e, 0x8f, 0x16, 0xef,
+	0x2f, 0x40, 0x85, 0x90, 0x9e, 0x84, 0x1f, 0x3c, 0x29, 0x02, 0x21, 0x3d, 0x01, 0x3c, 0xf4, 0x2d,
+	0x78, 0x22, 0x25, 0xce, 0x51, 0x90, 0xfe, 0x1c, 0x14, 0xfb, 0x76, 0x24, 0x0b, 0x1f, 0xe8, 0xdf,
+	0x85, 0xf9, 0x7b, 0x6e, 0xff, 0x78, 0x0e, 0xad, 0xe6, 0xf0, 0x65, 0x0e, 0xa0, 0x25, 0x1f, 0x3d,
+	0xa2, 0xa7, 0x20, 0x06, 0x63, 0xda, 0xb6, 0xa5, 0x88, 0x7a, 0x16, 0x8d, 0x19, 0xb2, 0xee, 0xa1,
+	0x34, 0xb9, 0x74, 0x2d, 0x64, 0xb1, 0x7d, 0x62, 0x61, 0x85, 0x7b, 0x61, 0x2a, 0x32, 0xa2, 0xb3,
+	0x50, 0xf6, 0xbd, 0x07, 0x6d, 0x2a, 0x9d, 0xc5, 0xf0, 0x5d, 0xc9, 0x28, 0xf9, 0xde, 0x03, 0x2a,
+	0xb3, 0x85, 0x4e, 0xc3, 0x74, 0xf8, 0x36, 0x93, 0xd7, 0xd6, 0x53, 0x84, 0xbd, 0xca, 0xa4, 0xe7,
+	0xd8, 0xb6, 0x7b, 0x98, 0xa7, 0xfd, 0xb2, 0xc1, 0x07, 0xe8, 0xe5, 0xf0, 0xd9, 0xd1, 0x74, 0xe6,
+	0x67, 0x13, 0x8c, 0x3e, 0xd9, 0xf4, 0x2b, 0xa5, 0x9a, 0x7e, 0xfa, 0x0f, 0x34, 0x98, 0x8d, 0xd4,
+	0x73, 0x78, 0xe5, 0xbf, 0x01, 0x95, 0xd8, 0x83, 0x53, 0x51, 0x61, 0xa9, 0x32, 0x60, 0x8c, 0x19,
+	0x44, 0x8f, 0x51, 0xf5, 0xdf, 0xe6, 0xa0, 0xca, 0x3f, 0x09, 0xa4, 0x77, 0x28, 0x23, 0x8b, 0xe9,
+	0x34, 0x97, 0xd0, 0xe9, 0x02, 0x54, 0x28, 0x77, 0xd7, 0xb3, 0x30, 0xfd, 0x28, 0x8c, 0x3d, 0x9c,
+	0x6a, 0x59, 0xe8, 0x7f, 0xc2, 0xea, 0xab, 0xc0, 0x60, 0xb6, 0xfa, 0x77, 0x62, 0x2e, 0x60, 0xa2,
+	0x85, 0x18, 0x2f, 0x60, 0x8b, 0xc9, 0x02, 0x36, 0xbc, 0x7e, 0xfe, 0xdc, 0x94, 0xbf, 0xbf, 0xa5,
+	0xd7, 0x7f, 0x8b, 0xbd, 0x38, 0x3d, 0xec, 0x7d, 0xea, 0x7f, 0xc9, 0x41, 0x2d, 0x52, 0x62, 0xf8,
+	0x83, 0xb2, 0xb4, 0xe4, 0x9c, 0x6d, 0x0d, 0x1b, 0x79, 0x4e, 0x61, 0xe4, 0x13, 0x55, 0x22, 0xed,
+	0xb0, 0x10, 0xb7, 0x43, 0xa9, 0xa8, 0xe2, 0xc1, 0x14, 0x35, 0x56, 0x19, 0xcd, 0x14, 0x5e, 0x8d,
+	0x6b, 0x51, 0x2a, 0xaa, 0x74, 0x40, 0xc3, 0x5f, 0x80, 0x0a, 0xfb, 0xc1, 0x79, 0xd0, 0xb7, 0xa8,
+	0xbc, 0xbc, 0x2a, 0x01, 0x3a, 0x75, 0x8f, 0xcd, 0x5c, 0xbe, 0x0e, 0xb3, 0x43, 0x15, 0x35, 0xaa,
+	0x01, 0xdc, 0x73, 0x3b, 0xa2, 0xd5, 0x50, 0x3f, 0x81, 0xaa, 0x50, 0x0a, 0x1b, 0x0f, 0x75, 0xed,
+	0xb2, 0x13, 0x2f, 0x2c, 0x69, 0xb5, 0x85, 0x4e, 0xc3, 0xa9, 0x7b, 0xae, 0x85, 0xb7, 0x6d, 0x17,
+	0x5b, 0xd1, 0xa7, 0xfa, 0x09, 0x74, 0x0a, 0x4e, 0xb6, 0x5c, 0x17, 0xfb, 0xb1, 0x49, 0x8d, 0x4e,
+	0xae, 0x61, 0xbf, 0x8b, 0x63, 0x93, 0x39, 0xd4, 0x80, 0xb9, 0x5b, 0x12, 0x0b, 0xc6, 0xbe, 0xe4,
+	0x97, 0xbf, 0x9e, 0x87, 0xf2, 0x8a, 0x49, 0xcc, 0x5b, 0x9e, 0xe7, 0x5b, 0xa8, 0x0f, 0x88, 0xbd,
+	0x4d, 0x73, 0xfa, 0x9e, 0x2b, 0x1f, 0x71, 0xa2, 0x17, 0x46, 0xe4, 0xb5, 0x61, 0x52, 0xe1, 0xdd,
+	0xcd, 0x4b, 0x23, 0x56, 0xa4, 0xc8, 0xf5, 0x13, 0xc8, 0x61, 0x1c, 0x69, 0xd1, 0xba, 0x69, 0x77,
+	0x76, 0xc3, 0x27, 0x04, 0x63, 0x38, 0xa6, 0x48, 0x43, 0x8e, 0xa9, 0xb7, 0xa1, 0x62, 0xc0, 0x1f,
+	0x10, 0x86, 0x69, 0x45, 0x3f, 0x81, 0x3e, 0x86, 0xb9, 0x55, 0x4c, 0xa2, 0x37, 0x63, 0x21, 0xc3,
+	0xe5, 0xd1, 0x0c, 0x87, 0x88, 0x0f, 0xc8, 0xf2, 0x0e, 0x14, 0x59, 0x73, 0x09, 0xa9, 0x1a, 0x38,
+	0xf1, 0x7f, 0x86, 0x68, 0x2e, 0x8e, 0x26, 0x90, 0xbb, 0x7d, 0x0f, 0x4e, 0xa6, 0x5e, 0x6a, 0xa3,
+	0x67, 0x15, 0xcb, 0xd4, 0x6f, 0xee, 0x9b, 0x97, 0xb3, 0x90, 0x4a, 0x5e, 0x5d, 0xa8, 0x25, 0x5f,
+	0xb6, 0xa1, 0x25, 0xc5, 0x7a, 0xe5, 0x2b, 0xdb, 0xe6, 0xb3, 0x19, 0x28, 0x25, 0x23, 0x07, 0xea,
+	0xe9, 0x97, 0xc3, 0xe8, 0xf2, 0xd8, 0x0d, 0x92, 0xe6, 0xf6, 0x5c, 0x26, 0x5a, 0xc9, 0x6e, 0x9f,
+	0x19, 0xc1, 0xd0, 0xcb, 0x55, 0x74, 0x55, 0xbd, 0xcd, 0xa8, 0x27, 0xb5, 0xcd, 0x6b, 0x99, 0xe9,
+	0x25, 0xeb, 0xef, 0xf3, 0xa6, 0xb6, 0xea, 0xf5, 0x27, 0xba, 0xae, 0xde, 0x6e, 0xcc, 0xb3, 0xd5,
+	0xe6, 0xf2, 0x41, 0x96, 0x48, 0x21, 0x3e, 0x65, 0xdd, 0x68, 0xc5, 0x0b, 0xca, 0xb4, 0xdf, 0x85,
+	0xfb, 0x8d, 0x7e, 0x1a, 0xda, 0xbc, 0x7e, 0x80, 0x15, 0x52, 0x00, 0x2f, 0xfd, 0x36, 0x3b, 0x74,
+	0xc3, 0x6b, 0x13, 0xad, 0xe6, 0x70, 0x3e, 0xf8, 0x11, 0x9c, 0x4c, 0xbd, 0x54, 0x50, 0x7a, 0x8d,
+	0xfa, 0x35, 0x43, 0x73, 0x1c, 0x30, 0xe0, 0x2e, 0x99, 0x6a, 0xee, 0xa3, 0x11, 0xd6, 0xaf, 0xf8,
+	0x01, 0xa0, 0x79, 0x39, 0x0b, 0xa9, 0x3c, 0x48, 0xc0, 0xc2, 0x65, 0xaa, 0x41, 0x8e, 0xae, 0xa8,
+	0xf7, 0x50, 0x37, 0xf7, 0x9b, 0xcf, 0x67, 0xa4, 0x96, 0x4c, 0xdb, 0x00, 0xab, 0x98, 0xac, 0x61,
+	0xe2, 0x53, 0x1b, 0xb9, 0xa4, 0x54, 0x79, 0x44, 0x10, 0xb2, 0x79, 0x66, 0x22, 0x9d, 0x64, 0xf0,
+	0xff, 0x80, 0xc2, 0x0c, 0x18, 0x7b, 0x2a, 0xf4, 0xd4, 0xd8, 0x86, 0x12, 0x07, 0x71, 0x93, 0xee,
+	0xe6, 0x63, 0xa8, 0xaf, 0x99, 0x2e, 0xad, 0x9f, 0xa2, 0x7d, 0xaf, 0x28, 0x05, 0x4b, 0x93, 0x8d,
+	0xd0, 0xd6, 0x48, 0x6a, 0x79, 0x98, 0x07, 0x32, 0x87, 0x9a, 0xd2, 0x05, 0x71, 0x3a, 0xb6, 0x44,
+	0xda, 0x48, 0x11, 0x8e, 0x88, 0x2d, 0x63, 0xe8, 0x25, 0xe3, 0x87, 0x1a, 0xfb, 0x0f, 0x80, 0x14,
+	0xc1, 0x87, 0x36, 0xd9, 0x59, 0xef, 0x99, 0x6e, 0x90, 0x45, 0x04, 0x46, 0x78, 0x00, 0x11, 0x04,
+	0xbd, 0x14, 0xc1, 0x82, 0x99, 0x44, 0xa3, 0x06, 0xa9, 0x1e, 0xbb, 0xa8, 0x5a, 0x45, 0xcd, 0xa5,
+	0xc9, 0x84, 0x92, 0xcb, 0x0e, 0xcc, 0x84, 0xf6, 0xca, 0x95, 0xfb, 0xec, 0x28, 0x49, 0x23, 0x9a,
+	0x11, 0xee, 0xa6, 0x26, 0x8d, 0xbb, 0xdb, 0x70, 0x0d, 0x8e, 0xb2, 0xf5, 0x6e, 0xc6, 0xb9, 0xdb,
+	0xe8, 0xc2, 0x9e, 0x2b, 0x31, 0x51, 0x15, 0x2b, 0x95, 0xa8, 0x2a, 0xe3, 0x95, 0x4a, 0x54, 0x16,
+	0xd8, 0x3c, 0x24, 0xa6, 0xea, 0x62, 0x65, 0xd4, 0x52, 0xd7, 0xce, 0x93, 0xdc, 0xee, 0x1e, 0x4c,
+	0x85, 0xff, 0x26, 0x38, 0xbe, 0x40, 0x1b, 0x1b, 0xc6, 0x65, 0xb5, 0x16, 0xca, 0xbc, 0xcb, 0x00,
+	0x49, 0x0c, 0xfa, 0xa3, 0x91, 0xd7, 0x19, 0xaf, 0x0f, 0xd4, 0x28, 0x61, 0x04, 0xad, 0x64, 0x76,
+	0x17, 0xaa, 0x06, 0xa6, 0x1f, 0xc4, 0x49, 0x16, 0x46, 0x9e, 0x24, 0x53, 0x28, 0x5a, 0xfe, 0x4d,
+	0x11, 0x4a, 0xe1, 0x33, 0x8f, 0xc7, 0x00, 0xb4, 0x1f, 0x03, 0xf2, 0xfd, 0x08, 0x4e, 0xa6, 0x5e,
+	0xdd, 0x2b, 0x4d, 0x4c, 0xfd, 0x32, 0x7f, 0x92, 0x89, 0x7d, 0x28, 0xfe, 0x9d, 0x57, 0x26, 0xc1,
+	0x67, 0x46, 0xa1, 0xe7, 0x74, 0xfe, 0x9b, 0xb0, 0xf1, 0x23, 0xcf, 0x76, 0x77, 0x01, 0x62, 0xd9,
+	0x68, 0xfc, 0xcf, 0x6d, 0x34, 0xc0, 0x4e, 0x12, 0x78, 0xed, 0x80, 0xce, 0x36, 0x7e, 0xbb, 0x9b,
+	0x37, 0xbe, 0x75, 0xbd, 0x6b, 0x93, 0x9d, 0xc1, 0x16, 0xfd, 0x72, 0x8d, 0x93, 0x3e, 0x6f, 0x7b,
+	0xe2, 0xaf, 0x6b, 0xa1, 0x81, 0x5c, 0x63, 0xab, 0xaf, 0x51, 0x1e, 0xfd, 0xad, 0xad, 0x29, 0x36,
+	0xba, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc1, 0x5f, 0x42, 0x3f, 0x3e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3356,6 +4127,11 @@ type DataCoordClient interface {
 	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
 	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+	PinCollection(ctx context.Context, in *PinCollectionRequest, opts ...grpc.CallOption) (*PinCollectionResponse, error)
+	UnpinCollection(ctx context.Context, in *UnpinCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
+	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataCoordClient struct {
@@ -3564,6 +4340,51 @@ func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtua
 	return out, nil
 }
 
+func (c *dataCoordClient) PinCollection(ctx context.Context, in *PinCollectionRequest, opts ...grpc.CallOption) (*PinCollectionResponse, error) {
+	out := new(PinCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PinCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnpinCollection(ctx context.Context, in *UnpinCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnpinCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	out := new(milvuspb.ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	out := new(milvuspb.GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3589,6 +4410,11 @@ type DataCoordServer interface {
 	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
 	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
+	PinCollection(context.Context, *PinCollectionRequest) (*PinCollectionResponse, error)
+	UnpinCollection(context.Context, *UnpinCollectionRequest) (*commonpb.Status, error)
+	Import(context.Context, *ImportTaskRequest) (*milvuspb.ImportResponse, error)
+	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -3661,6 +4487,21 @@ func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *mil
 func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
 }
+func (*UnimplementedDataCoordServer) PinCollection(ctx context.Context, req *PinCollectionRequest) (*PinCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinCollection not implemented")
+}
+func (*UnimplementedDataCoordServer) UnpinCollection(ctx context.Context, req *UnpinCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinCollection not implemented")
+}
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -4062,6 +4903,96 @@ func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_PinCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).PinCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/PinCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).PinCollection(ctx, req.(*PinCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UnpinCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UnpinCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UnpinCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UnpinCollection(ctx, req.(*UnpinCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*ImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportImport(ctx, req.(*ImportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -4154,6 +5085,26 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropVirtualChannel",
 			Handler:    _DataCoord_DropVirtualChannel_Handler,
 		},
+		{
+			MethodName: "PinCollection",
+			Handler:    _DataCoord_PinCollection_Handler,
+		},
+		{
+			MethodName: "UnpinCollection",
+			Handler:    _DataCoord_UnpinCollection_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DataCoord_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _DataCoord_GetImportState_Handler,
+		},
+		{
+			MethodName: "ReportImport",
+			Handler:    _DataCoord_ReportImport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -4170,6 +5121,7 @@ type DataNodeClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -4234,6 +5186,15 @@ func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opt
 	return out, nil
 }
 
+func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -4243,6 +5204,7 @@ type DataNodeServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -4267,6 +5229,9 @@ func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvusp
 func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
 }
+func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -4380,6 +5345,24 @@ func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Import(ctx, req.(*ImportTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -4408,6 +5391,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Compaction",
 			Handler:    _DataNode_Compaction_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _DataNode_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -255,6 +255,8 @@ type CollectionInfo struct {
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	StartPositions             []*commonpb.KeyDataPair    `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DbId                       int64                      `protobuf:"varint,13,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	Properties                 []*commonpb.KeyValuePair   `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
 	XXX_unrecognized           []byte                     `json:"-"`
 	XXX_sizecache              int32                      `json:"-"`
@@ -369,6 +371,280 @@ func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type CredentialInfo struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// password encrypted by bcrypt
+	EncryptedPassword    string   `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+type RoleInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{7}
+}
+
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type UserRoleInfo struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRoleInfo) Reset()         { *m = UserRoleInfo{} }
+func (m *UserRoleInfo) String() string { return proto.CompactTextString(m) }
+func (*UserRoleInfo) ProtoMessage()    {}
+func (*UserRoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{8}
+}
+
+func (m *UserRoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRoleInfo.Unmarshal(m, b)
+}
+func (m *UserRoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRoleInfo.Marshal(b, m, deterministic)
+}
+func (m *UserRoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRoleInfo.Merge(m, src)
+}
+func (m *UserRoleInfo) XXX_Size() int {
+	return xxx_messageInfo_UserRoleInfo.Size(m)
+}
+func (m *UserRoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRoleInfo proto.InternalMessageInfo
+
+func (m *UserRoleInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserRoleInfo) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type GrantInfo struct {
+	RoleName             string   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	ObjectType           string   `protobuf:"bytes,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	ObjectName           string   `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            string   `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	Grantor              string   `protobuf:"bytes,5,opt,name=grantor,proto3" json:"grantor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantInfo) Reset()         { *m = GrantInfo{} }
+func (m *GrantInfo) String() string { return proto.CompactTextString(m) }
+func (*GrantInfo) ProtoMessage()    {}
+func (*GrantInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{9}
+}
+
+func (m *GrantInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantInfo.Unmarshal(m, b)
+}
+func (m *GrantInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantInfo.Marshal(b, m, deterministic)
+}
+func (m *GrantInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantInfo.Merge(m, src)
+}
+func (m *GrantInfo) XXX_Size() int {
+	return xxx_messageInfo_GrantInfo.Size(m)
+}
+func (m *GrantInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantInfo proto.InternalMessageInfo
+
+func (m *GrantInfo) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetGrantor() string {
+	if m != nil {
+		return m.Grantor
+	}
+	return ""
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -386,7 +662,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{10}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -472,7 +748,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{11}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -541,6 +817,11 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
+	proto.RegisterType((*RoleInfo)(nil), "milvus.proto.etcd.RoleInfo")
+	proto.RegisterType((*UserRoleInfo)(nil), "milvus.proto.etcd.UserRoleInfo")
+	proto.RegisterType((*GrantInfo)(nil), "milvus.proto.etcd.GrantInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -548,54 +829,67 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xeb, 0x44,
-	0x14, 0x95, 0x9b, 0x34, 0x79, 0xbe, 0x71, 0xd3, 0x76, 0xf8, 0xd0, 0xa8, 0x2a, 0xe0, 0x67, 0xe9,
-	0x3d, 0x2c, 0x21, 0x5a, 0xd1, 0x87, 0xd8, 0x21, 0x01, 0xb5, 0x9e, 0x14, 0x01, 0x55, 0x99, 0x57,
-	0xb1, 0x60, 0x63, 0x4d, 0xec, 0xdb, 0x66, 0x24, 0xcf, 0x38, 0x78, 0xc6, 0xd5, 0xcb, 0x8e, 0x35,
-	0x3f, 0x81, 0x3f, 0xc8, 0x82, 0xff, 0x80, 0x90, 0x67, 0x6c, 0xe7, 0xa3, 0xe9, 0x92, 0x5d, 0xee,
-	0xb9, 0x1f, 0xbe, 0xf7, 0xcc, 0x39, 0x81, 0x63, 0x34, 0x59, 0x9e, 0x4a, 0x34, 0xfc, 0x62, 0x59,
-	0x95, 0xa6, 0x24, 0xa7, 0x52, 0x14, 0x8f, 0xb5, 0x76, 0xd1, 0x45, 0x93, 0x3d, 0x0b, 0xb2, 0x52,
-	0xca, 0x52, 0x39, 0xe8, 0x2c, 0xd0, 0xd9, 0x02, 0x65, 0x5b, 0x1e, 0xfd, 0xe5, 0x01, 0xdc, 0xa1,
-	0xe2, 0xca, 0xfc, 0x8c, 0x86, 0x93, 0x29, 0x1c, 0xcc, 0x12, 0xea, 0x85, 0x5e, 0x3c, 0x60, 0x07,
-	0xb3, 0x84, 0xbc, 0x86, 0x63, 0x55, 0xcb, 0xf4, 0xf7, 0x1a, 0xab, 0x55, 0xaa, 0xca, 0x1c, 0x35,
-	0x3d, 0xb0, 0xc9, 0x23, 0x55, 0xcb, 0x5f, 0x1a, 0xf4, 0xa6, 0x01, 0xc9, 0x17, 0x70, 0x2a, 0x94,
-	0xc6, 0xca, 0xa4, 0xd9, 0x82, 0x2b, 0x85, 0xc5, 0x2c, 0xd1, 0x74, 0x10, 0x0e, 0x62, 0x9f, 0x9d,
-	0xb8, 0xc4, 0x75, 0x8f, 0x93, 0xcf, 0xe1, 0xd8, 0x0d, 0xec, 0x6b, 0xe9, 0x30, 0xf4, 0x62, 0x9f,
-	0x4d, 0x2d, 0xdc, 0x57, 0x46, 0x7f, 0x78, 0xe0, 0xdf, 0x56, 0xe5, 0xfb, 0xd5, 0xde, 0xdd, 0xbe,
-	0x81, 0x31, 0xcf, 0xf3, 0x0a, 0xb5, 0xdb, 0x69, 0x72, 0x75, 0x7e, 0xb1, 0x75, 0x7b, 0x7b, 0xf5,
-	0xf7, 0xae, 0x86, 0x75, 0xc5, 0xcd, 0xae, 0x15, 0xea, 0xba, 0xd8, 0xb7, 0xab, 0x4b, 0xac, 0x77,
-	0x8d, 0xfe, 0xf4, 0xc0, 0x9f, 0xa9, 0x1c, 0xdf, 0xcf, 0xd4, 0x7d, 0x49, 0x3e, 0x01, 0x10, 0x4d,
-	0x90, 0x2a, 0x2e, 0xd1, 0xae, 0xe2, 0x33, 0xdf, 0x22, 0x37, 0x5c, 0x22, 0xa1, 0x30, 0xb6, 0xc1,
-	0x2c, 0x69, 0x59, 0xea, 0x42, 0x92, 0x40, 0xe0, 0x1a, 0x97, 0xbc, 0xe2, 0xd2, 0x7d, 0x6e, 0x72,
-	0xf5, 0x72, 0xef, 0xc2, 0x3f, 0xe2, 0xea, 0x57, 0x5e, 0xd4, 0x78, 0xcb, 0x45, 0xc5, 0x26, 0xb6,
-	0xed, 0xd6, 0x76, 0x45, 0x09, 0x4c, 0xdf, 0x0a, 0x2c, 0xf2, 0xf5, 0x42, 0x14, 0xc6, 0xf7, 0xa2,
-	0xc0, 0xbc, 0x27, 0xa6, 0x0b, 0x9f, 0xdf, 0x25, 0xfa, 0x77, 0x08, 0xd3, 0xeb, 0xb2, 0x28, 0x30,
-	0x33, 0xa2, 0x54, 0x76, 0xcc, 0x2e, 0xb5, 0xdf, 0xc2, 0xc8, 0xa9, 0xa4, 0x65, 0xf6, 0xd5, 0xf6,
-	0xa2, 0xad, 0x82, 0xd6, 0x43, 0xde, 0x59, 0x80, 0xb5, 0x4d, 0xe4, 0x33, 0x98, 0x64, 0x15, 0x72,
-	0x83, 0xa9, 0x11, 0x12, 0xe9, 0x20, 0xf4, 0xe2, 0x21, 0x03, 0x07, 0xdd, 0x09, 0x89, 0x24, 0x82,
-	0x60, 0xc9, 0x2b, 0x23, 0xec, 0x02, 0x89, 0xa6, 0xc3, 0x70, 0x10, 0x0f, 0xd8, 0x16, 0x46, 0x5e,
-	0xc3, 0xb4, 0x8f, 0x1b, 0x76, 0x35, 0x3d, 0xb4, 0x6f, 0xb4, 0x83, 0x92, 0xb7, 0x70, 0x74, 0xdf,
-	0x90, 0x92, 0xda, 0xfb, 0x50, 0xd3, 0xd1, 0x3e, 0x6e, 0x1b, 0x23, 0x5c, 0x6c, 0x93, 0xc7, 0x82,
-	0xfb, 0x3e, 0x46, 0x4d, 0xae, 0xe0, 0xa3, 0x47, 0x51, 0x99, 0x9a, 0x17, 0x9d, 0x2e, 0xec, 0x2b,
-	0x6b, 0x3a, 0xb6, 0x9f, 0xfd, 0xa0, 0x4d, 0xb6, 0xda, 0x70, 0xdf, 0xfe, 0x1a, 0x3e, 0x5e, 0x2e,
-	0x56, 0x5a, 0x64, 0x4f, 0x9a, 0x5e, 0xd8, 0xa6, 0x0f, 0xbb, 0xec, 0x56, 0xd7, 0x77, 0x70, 0xde,
-	0xdf, 0x90, 0x3a, 0x56, 0x72, 0xcb, 0x94, 0x36, 0x5c, 0x2e, 0x35, 0xf5, 0xc3, 0x41, 0x3c, 0x64,
-	0x67, 0x7d, 0xcd, 0xb5, 0x2b, 0xb9, 0xeb, 0x2b, 0x1a, 0x1d, 0xea, 0x05, 0xaf, 0x72, 0x9d, 0xaa,
-	0x5a, 0x52, 0x08, 0xbd, 0xf8, 0x90, 0xf9, 0x0e, 0xb9, 0xa9, 0x25, 0x99, 0xc1, 0xb1, 0x36, 0xbc,
-	0x32, 0xe9, 0xb2, 0xd4, 0x76, 0x82, 0xa6, 0x13, 0x4b, 0x4a, 0xf8, 0x9c, 0xe0, 0x12, 0x6e, 0xb8,
-	0xd5, 0xdb, 0xd4, 0x36, 0xde, 0x76, 0x7d, 0x84, 0xc1, 0x69, 0x56, 0x2a, 0x2d, 0xb4, 0x41, 0x95,
-	0xad, 0xd2, 0x02, 0x1f, 0xb1, 0xa0, 0x41, 0xe8, 0xc5, 0xd3, 0x5d, 0x51, 0xb4, 0xc3, 0xae, 0xd7,
-	0xd5, 0x3f, 0x35, 0xc5, 0xec, 0x24, 0xdb, 0x41, 0xa2, 0xbf, 0x3d, 0x38, 0x79, 0x87, 0x0f, 0x12,
-	0x95, 0x59, 0x2b, 0x39, 0x82, 0x20, 0x5b, 0x8b, 0xb2, 0x13, 0xe3, 0x16, 0x46, 0x42, 0x98, 0x6c,
-	0x48, 0xa4, 0xd5, 0xf5, 0x26, 0x44, 0xce, 0xc1, 0xd7, 0xed, 0xe4, 0xc4, 0xea, 0x6e, 0xc0, 0xd6,
-	0x80, 0x73, 0x4b, 0xf3, 0xe4, 0xee, 0x0f, 0xc7, 0xba, 0xc5, 0x86, 0x9b, 0x6e, 0x39, 0xdc, 0x76,
-	0x2e, 0x85, 0xf1, 0xbc, 0x16, 0xb6, 0x67, 0xe4, 0x32, 0x6d, 0x48, 0x5e, 0x42, 0x80, 0x8a, 0xcf,
-	0x0b, 0x74, 0xca, 0xa3, 0xe3, 0xd0, 0x8b, 0x5f, 0xb0, 0x89, 0xc3, 0xec, 0x61, 0xd1, 0x3f, 0xde,
-	0xa6, 0xd5, 0xf6, 0xfe, 0x8b, 0xfd, 0xdf, 0x56, 0xfb, 0x14, 0xa0, 0x27, 0xa0, 0x33, 0xda, 0x06,
-	0x42, 0x5e, 0x6d, 0xd8, 0x2c, 0x35, 0xfc, 0xa1, 0xb3, 0xd9, 0x51, 0x8f, 0xde, 0xf1, 0x07, 0xfd,
-	0xc4, 0xb1, 0xa3, 0xa7, 0x8e, 0xfd, 0xe1, 0xcd, 0x6f, 0x5f, 0x3d, 0x08, 0xb3, 0xa8, 0xe7, 0x8d,
-	0x16, 0x2e, 0xdd, 0x19, 0x5f, 0x8a, 0xb2, 0xfd, 0x75, 0x29, 0x94, 0xc1, 0x4a, 0xf1, 0xe2, 0xd2,
-	0x5e, 0x76, 0xd9, 0x38, 0x72, 0x39, 0x9f, 0x8f, 0x6c, 0xf4, 0xe6, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0xd3, 0xc4, 0x4c, 0xc9, 0x06, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0x96, 0x9b, 0xa4, 0xa9, 0x4f, 0xdc, 0xb4, 0x9d, 0xfd, 0xfd, 0x90, 0x55, 0xca, 0xe2, 0xb5,
+	0xb4, 0x4b, 0x24, 0xb4, 0xad, 0xe8, 0x22, 0xee, 0x90, 0x58, 0x1a, 0xed, 0x2a, 0x02, 0xaa, 0xe2,
+	0x16, 0x2e, 0xe0, 0xc2, 0x9a, 0xd8, 0xa7, 0xc9, 0x20, 0x7b, 0x6c, 0x66, 0xc6, 0x65, 0x73, 0xc7,
+	0x35, 0x8f, 0xc0, 0x25, 0x0f, 0xc5, 0x2b, 0x70, 0xc1, 0x4b, 0xa0, 0x99, 0xb1, 0x1d, 0xa7, 0xcd,
+	0x02, 0x37, 0xdc, 0xf9, 0x7c, 0xe7, 0xcf, 0x7c, 0xe7, 0xcc, 0x99, 0xcf, 0x70, 0x80, 0x2a, 0x49,
+	0xe3, 0x1c, 0x15, 0x3d, 0x2d, 0x45, 0xa1, 0x0a, 0x72, 0x94, 0xb3, 0xec, 0xae, 0x92, 0xd6, 0x3a,
+	0xd5, 0xde, 0x63, 0x2f, 0x29, 0xf2, 0xbc, 0xe0, 0x16, 0x3a, 0xf6, 0x64, 0xb2, 0xc4, 0xbc, 0x0e,
+	0x0f, 0x7f, 0x75, 0x00, 0x6e, 0x90, 0x53, 0xae, 0xbe, 0x42, 0x45, 0xc9, 0x18, 0x76, 0x66, 0x53,
+	0xdf, 0x09, 0x9c, 0x49, 0x2f, 0xda, 0x99, 0x4d, 0xc9, 0x33, 0x38, 0xe0, 0x55, 0x1e, 0xff, 0x58,
+	0xa1, 0x58, 0xc5, 0xbc, 0x48, 0x51, 0xfa, 0x3b, 0xc6, 0xb9, 0xcf, 0xab, 0xfc, 0x6b, 0x8d, 0x5e,
+	0x6a, 0x90, 0x7c, 0x08, 0x47, 0x8c, 0x4b, 0x14, 0x2a, 0x4e, 0x96, 0x94, 0x73, 0xcc, 0x66, 0x53,
+	0xe9, 0xf7, 0x82, 0xde, 0xc4, 0x8d, 0x0e, 0xad, 0xe3, 0xa2, 0xc5, 0xc9, 0x07, 0x70, 0x60, 0x0b,
+	0xb6, 0xb1, 0x7e, 0x3f, 0x70, 0x26, 0x6e, 0x34, 0x36, 0x70, 0x1b, 0x19, 0xfe, 0xec, 0x80, 0x7b,
+	0x25, 0x8a, 0x37, 0xab, 0xad, 0xdc, 0x3e, 0x81, 0x21, 0x4d, 0x53, 0x81, 0xd2, 0x72, 0x1a, 0x9d,
+	0x9f, 0x9c, 0x6e, 0xf4, 0x5e, 0x77, 0xfd, 0xd2, 0xc6, 0x44, 0x4d, 0xb0, 0xe6, 0x2a, 0x50, 0x56,
+	0xd9, 0x36, 0xae, 0xd6, 0xb1, 0xe6, 0x1a, 0xfe, 0xe2, 0x80, 0x3b, 0xe3, 0x29, 0xbe, 0x99, 0xf1,
+	0xdb, 0x82, 0xbc, 0x07, 0xc0, 0xb4, 0x11, 0x73, 0x9a, 0xa3, 0xa1, 0xe2, 0x46, 0xae, 0x41, 0x2e,
+	0x69, 0x8e, 0xc4, 0x87, 0xa1, 0x31, 0x66, 0xd3, 0x7a, 0x4a, 0x8d, 0x49, 0xa6, 0xe0, 0xd9, 0xc4,
+	0x92, 0x0a, 0x9a, 0xdb, 0xe3, 0x46, 0xe7, 0x4f, 0xb6, 0x12, 0xfe, 0x02, 0x57, 0xdf, 0xd2, 0xac,
+	0xc2, 0x2b, 0xca, 0x44, 0x34, 0x32, 0x69, 0x57, 0x26, 0x2b, 0x9c, 0xc2, 0xf8, 0x15, 0xc3, 0x2c,
+	0x5d, 0x13, 0xf2, 0x61, 0x78, 0xcb, 0x32, 0x4c, 0xdb, 0xc1, 0x34, 0xe6, 0xdb, 0xb9, 0x84, 0xbf,
+	0x0f, 0x60, 0x7c, 0x51, 0x64, 0x19, 0x26, 0x8a, 0x15, 0xdc, 0x94, 0xb9, 0x3f, 0xda, 0x4f, 0x61,
+	0xd7, 0x6e, 0x49, 0x3d, 0xd9, 0xa7, 0x9b, 0x44, 0xeb, 0x0d, 0x5a, 0x17, 0xb9, 0x36, 0x40, 0x54,
+	0x27, 0x91, 0xf7, 0x61, 0x94, 0x08, 0xa4, 0x0a, 0x63, 0xc5, 0x72, 0xf4, 0x7b, 0x81, 0x33, 0xe9,
+	0x47, 0x60, 0xa1, 0x1b, 0x96, 0x23, 0x09, 0xc1, 0x2b, 0xa9, 0x50, 0xcc, 0x10, 0x98, 0x4a, 0xbf,
+	0x1f, 0xf4, 0x26, 0xbd, 0x68, 0x03, 0x23, 0xcf, 0x60, 0xdc, 0xda, 0x7a, 0xba, 0xd2, 0x1f, 0x98,
+	0x3b, 0xba, 0x87, 0x92, 0x57, 0xb0, 0x7f, 0xab, 0x87, 0x12, 0x9b, 0xfe, 0x50, 0xfa, 0xbb, 0xdb,
+	0x66, 0xab, 0x1f, 0xc2, 0xe9, 0xe6, 0xf0, 0x22, 0xef, 0xb6, 0xb5, 0x51, 0x92, 0x73, 0xf8, 0xff,
+	0x1d, 0x13, 0xaa, 0xa2, 0x59, 0xb3, 0x17, 0xe6, 0x96, 0xa5, 0x3f, 0x34, 0xc7, 0x3e, 0xaa, 0x9d,
+	0xf5, 0x6e, 0xd8, 0xb3, 0x3f, 0x86, 0x77, 0xca, 0xe5, 0x4a, 0xb2, 0xe4, 0x41, 0xd2, 0x9e, 0x49,
+	0xfa, 0x5f, 0xe3, 0xdd, 0xc8, 0xfa, 0x0c, 0x4e, 0xda, 0x1e, 0x62, 0x3b, 0x95, 0xd4, 0x4c, 0x4a,
+	0x2a, 0x9a, 0x97, 0xd2, 0x77, 0x83, 0xde, 0xa4, 0x1f, 0x1d, 0xb7, 0x31, 0x17, 0x36, 0xe4, 0xa6,
+	0x8d, 0xd0, 0x7b, 0x28, 0x97, 0x54, 0xa4, 0x32, 0xe6, 0x55, 0xee, 0x43, 0xe0, 0x4c, 0x06, 0x91,
+	0x6b, 0x91, 0xcb, 0x2a, 0x27, 0x33, 0x38, 0x90, 0x8a, 0x0a, 0x15, 0x97, 0x85, 0x34, 0x15, 0xa4,
+	0x3f, 0x32, 0x43, 0x09, 0xde, 0xb6, 0x70, 0x53, 0xaa, 0xa8, 0xd9, 0xb7, 0xb1, 0x49, 0xbc, 0x6a,
+	0xf2, 0x48, 0x04, 0x47, 0x49, 0xc1, 0x25, 0x93, 0x0a, 0x79, 0xb2, 0x8a, 0x33, 0xbc, 0xc3, 0xcc,
+	0xf7, 0x02, 0x67, 0x32, 0xbe, 0xbf, 0x14, 0x75, 0xb1, 0x8b, 0x75, 0xf4, 0x97, 0x3a, 0x38, 0x3a,
+	0x4c, 0xee, 0x21, 0xe4, 0x11, 0x0c, 0xd2, 0x79, 0xcc, 0x52, 0x7f, 0xdf, 0x2c, 0x5c, 0x3f, 0x9d,
+	0xcf, 0x52, 0xf2, 0x12, 0xa0, 0x14, 0x45, 0x89, 0x42, 0x31, 0x94, 0xfe, 0xf8, 0xdf, 0xbe, 0x8f,
+	0x4e, 0x52, 0x78, 0x0d, 0x9e, 0xee, 0x63, 0x4e, 0x25, 0x6e, 0xdd, 0x6a, 0x02, 0x7d, 0xf3, 0x6e,
+	0x77, 0xcc, 0xbb, 0x35, 0xdf, 0xff, 0xb8, 0xaa, 0xe1, 0xf7, 0x30, 0xbe, 0x10, 0x98, 0x22, 0x57,
+	0x8c, 0x66, 0xa6, 0xec, 0x31, 0xec, 0x55, 0x12, 0x45, 0x47, 0x02, 0x5a, 0x9b, 0x3c, 0x07, 0x82,
+	0x3c, 0x11, 0xab, 0x52, 0x5f, 0x69, 0x49, 0xa5, 0xfc, 0xa9, 0x10, 0x69, 0x7d, 0xe0, 0x51, 0xeb,
+	0xb9, 0xaa, 0x1d, 0xe1, 0x63, 0xd8, 0x8b, 0x8a, 0xcc, 0xb2, 0x6d, 0xd8, 0x39, 0x6b, 0x76, 0xe1,
+	0x6b, 0xf0, 0xbe, 0x91, 0x28, 0xda, 0x98, 0xbf, 0x3b, 0xfa, 0x5d, 0x70, 0x45, 0x91, 0x61, 0xdc,
+	0x69, 0x71, 0x4f, 0x03, 0x7a, 0xe7, 0xc2, 0xdf, 0x1c, 0x70, 0x5f, 0x0b, 0xca, 0x95, 0x29, 0xb3,
+	0x11, 0xea, 0x6c, 0x86, 0xea, 0x89, 0x14, 0xf3, 0x1f, 0x30, 0x51, 0xb1, 0x5a, 0x95, 0x4d, 0x25,
+	0xb0, 0xd0, 0xcd, 0xaa, 0xec, 0x06, 0x98, 0xfc, 0x5e, 0x37, 0xc0, 0x54, 0x38, 0x01, 0xb7, 0x14,
+	0xec, 0x8e, 0x65, 0xb8, 0xc0, 0x5a, 0xd9, 0xd7, 0x80, 0x16, 0xa6, 0x85, 0x66, 0x52, 0x08, 0x7f,
+	0x60, 0x7c, 0x8d, 0x19, 0xfe, 0xe1, 0xc0, 0xe1, 0x35, 0x2e, 0x72, 0xd4, 0x34, 0x1b, 0x85, 0x0b,
+	0xc1, 0x4b, 0xd6, 0x62, 0xd5, 0x5c, 0xe7, 0x06, 0x46, 0x02, 0x18, 0x75, 0xa4, 0xa3, 0xd6, 0xbb,
+	0x2e, 0xa4, 0x29, 0xc9, 0xba, 0xf2, 0xd4, 0x30, 0xee, 0x45, 0x6b, 0xc0, 0xaa, 0xa8, 0x96, 0x02,
+	0xfb, 0x23, 0x32, 0x2a, 0x6a, 0xcc, 0xae, 0x8a, 0x0e, 0x36, 0x15, 0xdd, 0x87, 0xe1, 0xbc, 0x62,
+	0x26, 0x67, 0xd7, 0x7a, 0x6a, 0x93, 0x3c, 0x01, 0x0f, 0x39, 0x9d, 0x67, 0x68, 0x15, 0xc9, 0x1f,
+	0x06, 0xce, 0x64, 0x2f, 0x1a, 0x59, 0xcc, 0x34, 0x16, 0xfe, 0xe9, 0x74, 0x25, 0x78, 0xeb, 0xdf,
+	0xed, 0xbf, 0x96, 0xe0, 0xc7, 0x00, 0xed, 0x00, 0x1a, 0x01, 0xee, 0x20, 0xe4, 0x69, 0x47, 0x7e,
+	0x63, 0x45, 0x17, 0x8d, 0xfc, 0xee, 0xb7, 0xe8, 0x0d, 0x5d, 0xc8, 0x07, 0x4a, 0xbe, 0xfb, 0x50,
+	0xc9, 0x3f, 0x7f, 0xf1, 0xdd, 0x47, 0x0b, 0xa6, 0x96, 0xd5, 0x5c, 0xbf, 0xe0, 0x33, 0xdb, 0xc6,
+	0x73, 0x56, 0xd4, 0x5f, 0x67, 0x8c, 0x2b, 0xbd, 0xc2, 0xd9, 0x99, 0xe9, 0xec, 0x4c, 0x2b, 0x75,
+	0x39, 0x9f, 0xef, 0x1a, 0xeb, 0xc5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xde, 0x17, 0x31,
+	0xe1, 0x08, 0x00, 0x00,
 }
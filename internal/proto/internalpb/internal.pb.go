@@ -1106,21 +1106,23 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 }
 
 type InsertRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
-	DbName               string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,5,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	DbID                 int64             `protobuf:"varint,6,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,7,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	SegmentID            int64             `protobuf:"varint,9,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	Timestamps           []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
-	RowIDs               []int64           `protobuf:"varint,11,rep,packed,name=rowIDs,proto3" json:"rowIDs,omitempty"`
-	RowData              []*commonpb.Blob  `protobuf:"bytes,12,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ShardName      string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
+	DbName         string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,5,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	DbID           int64             `protobuf:"varint,6,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID   int64             `protobuf:"varint,7,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID    int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID      int64             `protobuf:"varint,9,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Timestamps     []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	RowIDs         []int64           `protobuf:"varint,11,rep,packed,name=rowIDs,proto3" json:"rowIDs,omitempty"`
+	RowData        []*commonpb.Blob  `protobuf:"bytes,12,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	// version of the collection schema the rows were encoded with
+	SchemaVersion        int32    `protobuf:"varint,13,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
@@ -1232,6 +1234,13 @@ func (m *InsertRequest) GetRowData() []*commonpb.Blob {
 	return nil
 }
 
+func (m *InsertRequest) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 type SearchRequest struct {
 	Base            *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ResultChannelID string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
@@ -1247,6 +1256,7 @@ type SearchRequest struct {
 	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
 	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	ReplicaID            int64            `protobuf:"varint,14,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1368,6 +1378,13 @@ func (m *SearchRequest) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1507,6 +1524,8 @@ type RetrieveRequest struct {
 	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
 	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Limit                int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	ReplicaID            int64             `protobuf:"varint,12,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1607,6 +1626,20 @@ func (m *RetrieveRequest) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1702,6 +1735,62 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+// QueryCursor is the state of a paginated query, it is encoded into the cursor returned to clients
+type QueryCursor struct {
+	CollectionID         int64         `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	TravelTimestamp      uint64        `protobuf:"varint,2,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	LastPrimaryKey       *schemapb.IDs `protobuf:"bytes,3,opt,name=last_primary_key,json=lastPrimaryKey,proto3" json:"last_primary_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueryCursor) Reset()         { *m = QueryCursor{} }
+func (m *QueryCursor) String() string { return proto.CompactTextString(m) }
+func (*QueryCursor) ProtoMessage()    {}
+func (*QueryCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
+}
+
+func (m *QueryCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryCursor.Unmarshal(m, b)
+}
+func (m *QueryCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryCursor.Marshal(b, m, deterministic)
+}
+func (m *QueryCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCursor.Merge(m, src)
+}
+func (m *QueryCursor) XXX_Size() int {
+	return xxx_messageInfo_QueryCursor.Size(m)
+}
+func (m *QueryCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCursor proto.InternalMessageInfo
+
+func (m *QueryCursor) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QueryCursor) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *QueryCursor) GetLastPrimaryKey() *schemapb.IDs {
+	if m != nil {
+		return m.LastPrimaryKey
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1713,6 +1802,7 @@ type DeleteRequest struct {
 	PartitionID          int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,9,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	StringPrimaryKeys    []string          `protobuf:"bytes,11,rep,name=string_primary_keys,json=stringPrimaryKeys,proto3" json:"string_primary_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1722,7 +1812,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1813,6 +1903,13 @@ func (m *DeleteRequest) GetTimestamps() []uint64 {
 	return nil
 }
 
+func (m *DeleteRequest) GetStringPrimaryKeys() []string {
+	if m != nil {
+		return m.StringPrimaryKeys
+	}
+	return nil
+}
+
 type LoadIndex struct {
 	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentID            int64                    `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1829,7 +1926,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1904,7 +2001,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -1952,7 +2049,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2008,7 +2105,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2070,7 +2167,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2126,7 +2223,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2189,7 +2286,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2263,6 +2360,7 @@ func init() {
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
+	proto.RegisterType((*QueryCursor)(nil), "milvus.proto.internal.QueryCursor")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadIndex)(nil), "milvus.proto.internal.LoadIndex")
 	proto.RegisterType((*IndexStats)(nil), "milvus.proto.internal.IndexStats")
@@ -2276,123 +2374,130 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1c, 0x47,
-	0x15, 0x67, 0x76, 0x56, 0xda, 0xdd, 0xb7, 0x2b, 0x79, 0xdd, 0x92, 0x9d, 0xf1, 0x9f, 0xc4, 0xca,
-	0x24, 0x80, 0x88, 0x0b, 0xdb, 0x28, 0x40, 0x52, 0x14, 0x85, 0x63, 0x69, 0xc1, 0x6c, 0x39, 0x36,
-	0x62, 0x64, 0x52, 0x05, 0x97, 0xa9, 0xde, 0x9d, 0xd6, 0x6a, 0xf0, 0xcc, 0xf4, 0xa4, 0xbb, 0x47,
-	0xf2, 0xfa, 0xc4, 0x81, 0x13, 0x14, 0x54, 0x71, 0xe0, 0x08, 0x37, 0xae, 0x70, 0xe4, 0x04, 0x54,
-	0x71, 0xe2, 0x2b, 0xf0, 0x01, 0xf8, 0x12, 0x9c, 0xa8, 0x7e, 0xdd, 0x33, 0xfb, 0x47, 0x2b, 0x59,
-	0x52, 0x2a, 0xc4, 0xa9, 0xca, 0xad, 0xfb, 0xf7, 0x5e, 0x77, 0xbf, 0xf7, 0x7b, 0xef, 0x75, 0xbf,
-	0x9d, 0x85, 0xd5, 0x38, 0x53, 0x4c, 0x64, 0x34, 0xb9, 0x93, 0x0b, 0xae, 0x38, 0xb9, 0x92, 0xc6,
-	0xc9, 0x61, 0x21, 0xcd, 0xec, 0x4e, 0x29, 0xbc, 0xde, 0x19, 0xf2, 0x34, 0xe5, 0x99, 0x81, 0xaf,
-	0x77, 0xe4, 0xf0, 0x80, 0xa5, 0xd4, 0xcc, 0xfc, 0xbf, 0x3b, 0xb0, 0xb2, 0xc3, 0xd3, 0x9c, 0x67,
-	0x2c, 0x53, 0xfd, 0x6c, 0x9f, 0x93, 0xab, 0xb0, 0x9c, 0xf1, 0x88, 0xf5, 0x7b, 0x9e, 0xb3, 0xe1,
-	0x6c, 0xba, 0x81, 0x9d, 0x11, 0x02, 0x75, 0xc1, 0x13, 0xe6, 0xd5, 0x36, 0x9c, 0xcd, 0x56, 0x80,
-	0x63, 0x72, 0x1f, 0x40, 0x2a, 0xaa, 0x58, 0x38, 0xe4, 0x11, 0xf3, 0xdc, 0x0d, 0x67, 0x73, 0x75,
-	0x6b, 0xe3, 0xce, 0x42, 0x2b, 0xee, 0xec, 0x69, 0xc5, 0x1d, 0x1e, 0xb1, 0xa0, 0x25, 0xcb, 0x21,
-	0xf9, 0x00, 0x80, 0x3d, 0x57, 0x82, 0x86, 0x71, 0xb6, 0xcf, 0xbd, 0xfa, 0x86, 0xbb, 0xd9, 0xde,
-	0x7a, 0x73, 0x76, 0x03, 0x6b, 0xfc, 0x23, 0x36, 0xfe, 0x88, 0x26, 0x05, 0xdb, 0xa5, 0xb1, 0x08,
-	0x5a, 0xb8, 0x48, 0x9b, 0xeb, 0xff, 0xdb, 0x81, 0x4b, 0x95, 0x03, 0x78, 0x86, 0x24, 0xdf, 0x81,
-	0x25, 0x3c, 0x02, 0x3d, 0x68, 0x6f, 0xbd, 0x7d, 0x82, 0x45, 0x33, 0x7e, 0x07, 0x66, 0x09, 0xf9,
-	0x09, 0xac, 0xc9, 0x62, 0x30, 0x2c, 0x45, 0x21, 0xa2, 0xd2, 0xab, 0xa1, 0x69, 0x67, 0xdb, 0x89,
-	0x4c, 0x6f, 0x60, 0x4d, 0x7a, 0x17, 0x96, 0xf5, 0x4e, 0x85, 0x44, 0x96, 0xda, 0x5b, 0x37, 0x16,
-	0x3a, 0xb9, 0x87, 0x2a, 0x81, 0x55, 0xf5, 0x6f, 0xc0, 0xb5, 0x87, 0x4c, 0xcd, 0x79, 0x17, 0xb0,
-	0x8f, 0x0b, 0x26, 0x95, 0x15, 0x3e, 0x8d, 0x53, 0xf6, 0x34, 0x1e, 0x3e, 0xdb, 0x39, 0xa0, 0x59,
-	0xc6, 0x92, 0x52, 0xf8, 0x3a, 0xdc, 0x78, 0xc8, 0x70, 0x41, 0x2c, 0x55, 0x3c, 0x94, 0x73, 0xe2,
-	0x2b, 0xb0, 0xf6, 0x90, 0xa9, 0x5e, 0x34, 0x07, 0x7f, 0x04, 0xcd, 0x27, 0x3a, 0xd8, 0x3a, 0x0d,
-	0xbe, 0x0d, 0x0d, 0x1a, 0x45, 0x82, 0x49, 0x69, 0x59, 0xbc, 0xb9, 0xd0, 0xe2, 0x07, 0x46, 0x27,
-	0x28, 0x95, 0x17, 0xa5, 0x89, 0xff, 0x73, 0x80, 0x7e, 0x16, 0xab, 0x5d, 0x2a, 0x68, 0x2a, 0x4f,
-	0x4c, 0xb0, 0x1e, 0x74, 0xa4, 0xa2, 0x42, 0x85, 0x39, 0xea, 0x59, 0xca, 0xcf, 0x90, 0x0d, 0x6d,
-	0x5c, 0x66, 0x76, 0xf7, 0x7f, 0x0a, 0xb0, 0xa7, 0x44, 0x9c, 0x8d, 0x3e, 0x8c, 0xa5, 0xd2, 0x67,
-	0x1d, 0x6a, 0x3d, 0xed, 0x84, 0xbb, 0xd9, 0x0a, 0xec, 0x6c, 0x2a, 0x1c, 0xb5, 0xb3, 0x87, 0xe3,
-	0x3e, 0xb4, 0x4b, 0xba, 0x1f, 0xcb, 0x11, 0xb9, 0x07, 0xf5, 0x01, 0x95, 0xec, 0x54, 0x7a, 0x1e,
-	0xcb, 0xd1, 0x36, 0x95, 0x2c, 0x40, 0x4d, 0xff, 0x57, 0x2e, 0xbc, 0xb6, 0x23, 0x18, 0x26, 0x7f,
-	0x92, 0xb0, 0xa1, 0x8a, 0x79, 0x66, 0xb9, 0x3f, 0xff, 0x6e, 0xe4, 0x35, 0x68, 0x44, 0x83, 0x30,
-	0xa3, 0x69, 0x49, 0xf6, 0x72, 0x34, 0x78, 0x42, 0x53, 0x46, 0xbe, 0x02, 0xab, 0xc3, 0x6a, 0x7f,
-	0x8d, 0x60, 0xce, 0xb5, 0x82, 0x39, 0x94, 0xbc, 0x0d, 0x2b, 0x39, 0x15, 0x2a, 0xae, 0xd4, 0xea,
-	0xa8, 0x36, 0x0b, 0xea, 0x80, 0x46, 0x83, 0x7e, 0xcf, 0x5b, 0xc2, 0x60, 0xe1, 0x98, 0xf8, 0xd0,
-	0x99, 0xec, 0xd5, 0xef, 0x79, 0xcb, 0x28, 0x9b, 0xc1, 0xc8, 0x06, 0xb4, 0xab, 0x8d, 0xfa, 0x3d,
-	0xaf, 0x81, 0x2a, 0xd3, 0x90, 0x0e, 0x8e, 0xb9, 0x8b, 0xbc, 0xe6, 0x86, 0xb3, 0xd9, 0x09, 0xec,
-	0x8c, 0xdc, 0x83, 0xb5, 0xc3, 0x58, 0xa8, 0x82, 0x26, 0x36, 0x3f, 0xb5, 0x1d, 0xd2, 0x6b, 0x61,
-	0x04, 0x17, 0x89, 0xc8, 0x16, 0xac, 0xe7, 0x07, 0x63, 0x19, 0x0f, 0xe7, 0x96, 0x00, 0x2e, 0x59,
-	0x28, 0xf3, 0xff, 0xe9, 0xc0, 0x95, 0x9e, 0xe0, 0xf9, 0x2b, 0x11, 0x8a, 0x92, 0xe4, 0xfa, 0x29,
-	0x24, 0x2f, 0x1d, 0x27, 0xd9, 0xff, 0x4d, 0x0d, 0xae, 0x9a, 0x8c, 0xda, 0x2d, 0x89, 0xfd, 0x14,
-	0xbc, 0xf8, 0x2a, 0x5c, 0x9a, 0x9c, 0x6a, 0x14, 0x16, 0xbb, 0xf1, 0x65, 0x58, 0xad, 0x02, 0x6c,
-	0xf4, 0xfe, 0xbf, 0x29, 0xe5, 0xff, 0xba, 0x06, 0xeb, 0x3a, 0xa8, 0x5f, 0xb0, 0xa1, 0xd9, 0xf8,
-	0xa3, 0x03, 0xc4, 0x64, 0xc7, 0x83, 0x24, 0xa6, 0xf2, 0xb3, 0xe4, 0x62, 0x1d, 0x96, 0xa8, 0xb6,
-	0xc1, 0x52, 0x60, 0x26, 0xbe, 0x84, 0xae, 0x8e, 0xd6, 0xa7, 0x65, 0x5d, 0x75, 0xa8, 0x3b, 0x7d,
-	0xe8, 0x1f, 0x1c, 0xb8, 0xfc, 0x20, 0x51, 0x4c, 0xbc, 0xa2, 0xa4, 0xfc, 0xa3, 0x56, 0x46, 0xad,
-	0x9f, 0x45, 0xec, 0xf9, 0x67, 0x69, 0xe0, 0xeb, 0x00, 0xfb, 0x31, 0x4b, 0xa2, 0xe9, 0xec, 0x6d,
-	0x21, 0xf2, 0x89, 0x32, 0xd7, 0x83, 0x06, 0x6e, 0x52, 0x65, 0x6d, 0x39, 0xd5, 0x3d, 0x80, 0xe9,
-	0x07, 0x6d, 0x0f, 0xd0, 0x3c, 0x73, 0x0f, 0x80, 0xcb, 0x6c, 0x0f, 0xf0, 0x67, 0x17, 0x56, 0xfa,
-	0x99, 0x64, 0x42, 0x5d, 0x9c, 0xbc, 0x9b, 0xd0, 0x92, 0x07, 0x54, 0xa0, 0xa3, 0x96, 0xbe, 0x09,
-	0x30, 0x4d, 0xad, 0xfb, 0x32, 0x6a, 0xeb, 0x67, 0xbc, 0x1c, 0x96, 0x4e, 0xbb, 0x1c, 0x96, 0x4f,
-	0xa1, 0xb8, 0xf1, 0xf2, 0xcb, 0xa1, 0x79, 0xfc, 0xf5, 0xd5, 0x0e, 0xb2, 0x51, 0xaa, 0x9b, 0xd6,
-	0x9e, 0xd7, 0x42, 0xf9, 0x04, 0x20, 0x6f, 0x00, 0xa8, 0x38, 0x65, 0x52, 0xd1, 0x34, 0x37, 0xef,
-	0x68, 0x3d, 0x98, 0x42, 0xf4, 0xdb, 0x2d, 0xf8, 0x51, 0xbf, 0x27, 0xbd, 0xf6, 0x86, 0xab, 0x9b,
-	0x38, 0x33, 0x23, 0xdf, 0x84, 0xa6, 0xe0, 0x47, 0x61, 0x44, 0x15, 0xf5, 0x3a, 0x18, 0xbc, 0x6b,
-	0x0b, 0xc9, 0xde, 0x4e, 0xf8, 0x20, 0x68, 0x08, 0x7e, 0xd4, 0xa3, 0x8a, 0xfa, 0x7f, 0xaa, 0xc3,
-	0xca, 0x1e, 0xa3, 0x62, 0x78, 0x70, 0xf1, 0x80, 0x7d, 0x0d, 0xba, 0x82, 0xc9, 0x22, 0x51, 0xe1,
-	0xd0, 0x3c, 0xf3, 0xfd, 0x9e, 0x8d, 0xdb, 0x25, 0x83, 0xef, 0x94, 0x70, 0x45, 0xaa, 0x7b, 0x0a,
-	0xa9, 0xf5, 0x05, 0xa4, 0xfa, 0xd0, 0x99, 0x62, 0x50, 0x7a, 0x4b, 0xe8, 0xfa, 0x0c, 0x46, 0xba,
-	0xe0, 0x46, 0x32, 0xc1, 0x78, 0xb5, 0x02, 0x3d, 0x24, 0xb7, 0xe1, 0x72, 0x9e, 0xd0, 0x21, 0x3b,
-	0xe0, 0x49, 0xc4, 0x44, 0x38, 0x12, 0xbc, 0xc8, 0x31, 0x66, 0x9d, 0xa0, 0x3b, 0x25, 0x78, 0xa8,
-	0x71, 0xf2, 0x1e, 0x34, 0x23, 0x99, 0x84, 0x6a, 0x9c, 0x33, 0x0c, 0xda, 0xea, 0x09, 0xbe, 0xf7,
-	0x64, 0xf2, 0x74, 0x9c, 0xb3, 0xa0, 0x11, 0x99, 0x01, 0xb9, 0x07, 0xeb, 0x92, 0x89, 0x98, 0x26,
-	0xf1, 0x0b, 0x16, 0x85, 0xec, 0x79, 0x2e, 0xc2, 0x3c, 0xa1, 0x19, 0x46, 0xb6, 0x13, 0x90, 0x89,
-	0xec, 0xfb, 0xcf, 0x73, 0xb1, 0x9b, 0xd0, 0x8c, 0x6c, 0x42, 0x97, 0x17, 0x2a, 0x2f, 0x54, 0x88,
-	0xd5, 0x27, 0xc3, 0x38, 0xc2, 0x40, 0xbb, 0xc1, 0xaa, 0xc1, 0x7f, 0x80, 0x70, 0x3f, 0xd2, 0xd4,
-	0x2a, 0x41, 0x0f, 0x59, 0x12, 0x56, 0x19, 0xe0, 0xb5, 0x37, 0x9c, 0xcd, 0x7a, 0x70, 0xc9, 0xe0,
-	0x4f, 0x4b, 0x98, 0xdc, 0x85, 0xb5, 0x51, 0x41, 0x05, 0xcd, 0x14, 0x63, 0x53, 0xda, 0x1d, 0xd4,
-	0x26, 0x95, 0x68, 0xb2, 0xe0, 0x36, 0x5c, 0xd6, 0x6a, 0xbc, 0x50, 0x53, 0xea, 0x2b, 0xa8, 0xde,
-	0xb5, 0x82, 0x4a, 0xd9, 0xff, 0xdd, 0x54, 0x9e, 0xe8, 0x90, 0xca, 0x0b, 0xe4, 0xc9, 0x45, 0x5a,
-	0xff, 0x85, 0xc9, 0xe5, 0x2e, 0x4e, 0xae, 0x5b, 0xd0, 0x4e, 0x99, 0x12, 0xf1, 0xd0, 0x04, 0xd1,
-	0x54, 0x3f, 0x18, 0x08, 0x23, 0x75, 0x0b, 0xda, 0x59, 0x91, 0x86, 0x1f, 0x17, 0x4c, 0xc4, 0x4c,
-	0xda, 0xcb, 0x13, 0xb2, 0x22, 0xfd, 0xb1, 0x41, 0xc8, 0x1a, 0x2c, 0x29, 0x9e, 0x87, 0xcf, 0xca,
-	0xa2, 0x57, 0x3c, 0x7f, 0x44, 0xbe, 0x0b, 0xd7, 0x25, 0xa3, 0x09, 0x8b, 0xc2, 0xaa, 0x48, 0x65,
-	0x28, 0x91, 0x0b, 0x16, 0x79, 0x0d, 0x8c, 0x9b, 0x67, 0x34, 0xf6, 0x2a, 0x85, 0x3d, 0x2b, 0xd7,
-	0x61, 0xa9, 0x0c, 0x9f, 0x5a, 0xd6, 0xc4, 0xfe, 0x98, 0x4c, 0x44, 0xd5, 0x82, 0xf7, 0xc1, 0x1b,
-	0x25, 0x7c, 0x40, 0x93, 0xf0, 0xd8, 0xa9, 0xd8, 0x88, 0xbb, 0xc1, 0x55, 0x23, 0xdf, 0x9b, 0x3b,
-	0x52, 0xbb, 0x27, 0x93, 0x78, 0xc8, 0xa2, 0x70, 0x90, 0xf0, 0x81, 0x07, 0x98, 0x7f, 0x60, 0x20,
-	0x5d, 0xf5, 0x3a, 0xef, 0xac, 0x82, 0xa6, 0x61, 0xc8, 0x8b, 0x4c, 0x61, 0x36, 0xb9, 0xc1, 0xaa,
-	0xc1, 0x9f, 0x14, 0xe9, 0x8e, 0x46, 0xc9, 0x5b, 0xb0, 0x62, 0x35, 0xf9, 0xfe, 0xbe, 0x64, 0x0a,
-	0xd3, 0xc8, 0x0d, 0x3a, 0x06, 0xfc, 0x11, 0x62, 0xfe, 0x5f, 0x5c, 0xb8, 0x14, 0x68, 0x76, 0xd9,
-	0x21, 0xfb, 0xdc, 0xdf, 0x1e, 0x27, 0x55, 0xf1, 0xf2, 0xb9, 0xaa, 0xb8, 0x71, 0xe6, 0x2a, 0x6e,
-	0x9e, 0xab, 0x8a, 0x5b, 0xe7, 0xab, 0x62, 0x38, 0xa1, 0x8a, 0xff, 0x36, 0x13, 0xb1, 0x57, 0xb5,
-	0x8e, 0xdf, 0x01, 0x37, 0x8e, 0x4c, 0x6b, 0xd6, 0xde, 0xf2, 0x66, 0x37, 0xb7, 0x9f, 0xd0, 0xfa,
-	0x3d, 0x19, 0x68, 0x25, 0x72, 0x1f, 0xda, 0x96, 0x7d, 0x7c, 0xf8, 0x96, 0xf0, 0xe1, 0x7b, 0x63,
-	0xe1, 0x1a, 0x0c, 0x87, 0x7e, 0xf4, 0x02, 0xd3, 0x5a, 0x49, 0x3d, 0x26, 0xdf, 0x83, 0x1b, 0xc7,
-	0xab, 0x5b, 0x58, 0x8e, 0x22, 0x6f, 0x19, 0x03, 0x7a, 0x6d, 0xbe, 0xbc, 0x4b, 0x12, 0x23, 0xf2,
-	0x0d, 0x58, 0x9f, 0xaa, 0xef, 0xc9, 0xc2, 0x86, 0xf9, 0xcd, 0x3c, 0x91, 0x4d, 0x96, 0x9c, 0x56,
-	0xe1, 0xcd, 0xd3, 0x2a, 0xdc, 0xff, 0x4f, 0x0d, 0x56, 0x7a, 0x2c, 0x61, 0x8a, 0x7d, 0xd1, 0x5e,
-	0x9d, 0xd8, 0x5e, 0xbd, 0x09, 0x9d, 0x5c, 0xc4, 0x29, 0x15, 0xe3, 0xf0, 0x19, 0x1b, 0x97, 0x97,
-	0x66, 0xdb, 0x62, 0x8f, 0xd8, 0x58, 0xbe, 0xac, 0xc7, 0xf2, 0xff, 0xeb, 0x40, 0xeb, 0x43, 0x4e,
-	0x23, 0xfc, 0x19, 0x70, 0x41, 0x8e, 0xab, 0x0e, 0xaf, 0x36, 0xdf, 0xe1, 0xdd, 0x84, 0x49, 0x27,
-	0x6f, 0x59, 0x9e, 0x6a, 0xed, 0xa7, 0x5a, 0xf4, 0xfa, 0x6c, 0x8b, 0x7e, 0x0b, 0xda, 0xb1, 0x36,
-	0x28, 0xcc, 0xa9, 0x3a, 0x30, 0xb7, 0x58, 0x2b, 0x00, 0x84, 0x76, 0x35, 0xa2, 0x7b, 0xf8, 0x52,
-	0x01, 0x7b, 0xf8, 0xe5, 0x33, 0xf7, 0xf0, 0x76, 0x13, 0xec, 0xe1, 0x7f, 0xe9, 0x00, 0xa0, 0xe3,
-	0xba, 0x82, 0x8f, 0x6f, 0xea, 0x5c, 0x64, 0x53, 0x7d, 0xbd, 0xea, 0x37, 0x47, 0xb0, 0x84, 0xaa,
-	0x49, 0xc6, 0x4b, 0x4b, 0x0e, 0xc9, 0x8a, 0x34, 0x30, 0x22, 0x9b, 0xed, 0xd2, 0xff, 0xad, 0x03,
-	0x80, 0x25, 0x6b, 0xcc, 0x98, 0xcf, 0x0d, 0xe7, 0xf4, 0x5f, 0x37, 0xb5, 0x59, 0xea, 0xb6, 0x4b,
-	0xea, 0xf4, 0x6d, 0xa4, 0x7f, 0x95, 0x2e, 0xf0, 0xa1, 0xfa, 0xa6, 0x3c, 0x71, 0xde, 0xb2, 0x8b,
-	0x63, 0xff, 0xf7, 0x0e, 0x74, 0xac, 0x75, 0xc6, 0xa4, 0x99, 0x28, 0x3b, 0xf3, 0x51, 0xc6, 0x6e,
-	0x24, 0xe5, 0x62, 0x1c, 0xca, 0xf8, 0x05, 0xb3, 0x06, 0x81, 0x81, 0xf6, 0xe2, 0x17, 0x8c, 0x5c,
-	0x83, 0x26, 0x52, 0xc2, 0x8f, 0xa4, 0x7d, 0xd1, 0x1a, 0x9a, 0x06, 0x7e, 0x24, 0xf5, 0xa5, 0x2e,
-	0xd8, 0x90, 0x65, 0x2a, 0x19, 0x87, 0x29, 0x8f, 0xe2, 0xfd, 0x98, 0x45, 0x98, 0x0d, 0xcd, 0xa0,
-	0x5b, 0x0a, 0x1e, 0x5b, 0xdc, 0xff, 0x97, 0x03, 0xab, 0xba, 0x81, 0x19, 0x3f, 0xe1, 0x11, 0x33,
-	0x96, 0x9d, 0x3f, 0x63, 0x3f, 0x40, 0x5f, 0x2c, 0x3d, 0xe6, 0xfb, 0xef, 0x5b, 0x27, 0xfd, 0x9d,
-	0x30, 0xc5, 0x41, 0xd0, 0x94, 0x6c, 0x64, 0xce, 0xdc, 0xb6, 0x37, 0xf1, 0x99, 0x28, 0x9e, 0x04,
-	0xd6, 0x5e, 0xc6, 0x86, 0xe2, 0x5f, 0x38, 0xd0, 0x7e, 0x2c, 0x47, 0xbb, 0x5c, 0x62, 0x31, 0xeb,
-	0x52, 0xb6, 0x17, 0xa8, 0xb9, 0x49, 0x1c, 0x2c, 0x96, 0xf6, 0x70, 0xf2, 0x35, 0x51, 0xff, 0x92,
-	0x4f, 0xe5, 0xc8, 0x46, 0xbc, 0x13, 0x98, 0x09, 0xb9, 0x0e, 0xcd, 0x54, 0x8e, 0xb0, 0xb1, 0xb7,
-	0x15, 0x56, 0xcd, 0x75, 0xd8, 0x26, 0x2f, 0x65, 0x1d, 0x5f, 0xca, 0x09, 0xe0, 0xff, 0xd5, 0x01,
-	0x62, 0x9f, 0xa2, 0x4f, 0xf4, 0xc9, 0x19, 0x13, 0x76, 0xfa, 0x8b, 0x68, 0x0d, 0xcb, 0x75, 0x06,
-	0x9b, 0xbb, 0x87, 0xdc, 0x63, 0xbf, 0xf5, 0x6e, 0xc3, 0xe5, 0x88, 0xed, 0x53, 0xfd, 0x6a, 0xce,
-	0x9b, 0xdc, 0xb5, 0x82, 0xea, 0x71, 0x7f, 0xe7, 0x7d, 0x68, 0x55, 0xff, 0xf4, 0x90, 0x2e, 0x74,
-	0xfa, 0x59, 0xac, 0xb0, 0x67, 0x89, 0xb3, 0x51, 0xf7, 0x4b, 0xa4, 0x0d, 0x8d, 0x1f, 0x32, 0x9a,
-	0xa8, 0x83, 0x71, 0xd7, 0x21, 0x1d, 0x68, 0x3e, 0x18, 0x64, 0x5c, 0xa4, 0x34, 0xe9, 0xd6, 0xb6,
-	0xdf, 0xfb, 0xd9, 0xb7, 0x46, 0xb1, 0x3a, 0x28, 0x06, 0xda, 0x93, 0xbb, 0xc6, 0xb5, 0xaf, 0xc7,
-	0xdc, 0x8e, 0xee, 0x96, 0x51, 0xbb, 0x8b, 0xde, 0x56, 0xd3, 0x7c, 0x30, 0x58, 0x46, 0xe4, 0xdd,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x48, 0xeb, 0xcc, 0x3e, 0x0f, 0x1b, 0x00, 0x00,
+	// 1996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x93, 0x1b, 0x47,
+	0x15, 0x67, 0x34, 0xd2, 0x4a, 0x7a, 0xd2, 0xca, 0x72, 0xef, 0xc6, 0x19, 0xff, 0x49, 0xac, 0x4c,
+	0x02, 0x2c, 0x71, 0x61, 0x9b, 0x0d, 0x90, 0x14, 0x45, 0xe1, 0x78, 0x57, 0x60, 0x54, 0x8e, 0xcd,
+	0x32, 0x6b, 0x5c, 0x05, 0x97, 0xa9, 0x96, 0xa6, 0x57, 0xdb, 0x78, 0x66, 0x7a, 0xd2, 0xdd, 0xb3,
+	0xb6, 0x7c, 0xe2, 0xc0, 0x09, 0x0a, 0xaa, 0x38, 0xe4, 0x08, 0xc5, 0xb7, 0xe0, 0x04, 0x54, 0xc1,
+	0x85, 0x2f, 0xc0, 0x81, 0x33, 0xdf, 0x82, 0x13, 0xd5, 0x7f, 0x66, 0x34, 0xd2, 0x6a, 0xd7, 0xeb,
+	0x4d, 0x85, 0x98, 0xaa, 0xdc, 0xd4, 0xef, 0xbd, 0xee, 0x7e, 0xef, 0xf7, 0xfe, 0xf6, 0x08, 0x7a,
+	0x34, 0x95, 0x84, 0xa7, 0x38, 0xbe, 0x99, 0x71, 0x26, 0x19, 0x7a, 0x2d, 0xa1, 0xf1, 0x51, 0x2e,
+	0xcc, 0xea, 0x66, 0xc1, 0xbc, 0xd2, 0x9d, 0xb0, 0x24, 0x61, 0xa9, 0x21, 0x5f, 0xe9, 0x8a, 0xc9,
+	0x21, 0x49, 0xb0, 0x59, 0xf9, 0x7f, 0x71, 0x60, 0x7d, 0x97, 0x25, 0x19, 0x4b, 0x49, 0x2a, 0x47,
+	0xe9, 0x01, 0x43, 0x97, 0x60, 0x2d, 0x65, 0x11, 0x19, 0x0d, 0x3d, 0x67, 0xe0, 0x6c, 0xb9, 0x81,
+	0x5d, 0x21, 0x04, 0x75, 0xce, 0x62, 0xe2, 0xd5, 0x06, 0xce, 0x56, 0x3b, 0xd0, 0xbf, 0xd1, 0x1d,
+	0x00, 0x21, 0xb1, 0x24, 0xe1, 0x84, 0x45, 0xc4, 0x73, 0x07, 0xce, 0x56, 0x6f, 0x7b, 0x70, 0x73,
+	0xa5, 0x16, 0x37, 0xf7, 0x95, 0xe0, 0x2e, 0x8b, 0x48, 0xd0, 0x16, 0xc5, 0x4f, 0xf4, 0x21, 0x00,
+	0x79, 0x26, 0x39, 0x0e, 0x69, 0x7a, 0xc0, 0xbc, 0xfa, 0xc0, 0xdd, 0xea, 0x6c, 0xbf, 0xb5, 0x78,
+	0x80, 0x55, 0xfe, 0x3e, 0x99, 0x3d, 0xc6, 0x71, 0x4e, 0xf6, 0x30, 0xe5, 0x41, 0x5b, 0x6f, 0x52,
+	0xea, 0xfa, 0xff, 0x72, 0xe0, 0x42, 0x69, 0x80, 0xbe, 0x43, 0xa0, 0xef, 0x40, 0x43, 0x5f, 0xa1,
+	0x2d, 0xe8, 0x6c, 0xbf, 0x73, 0x82, 0x46, 0x0b, 0x76, 0x07, 0x66, 0x0b, 0xfa, 0x09, 0x6c, 0x88,
+	0x7c, 0x3c, 0x29, 0x58, 0xa1, 0xa6, 0x0a, 0xaf, 0xa6, 0x55, 0x3b, 0xdb, 0x49, 0xa8, 0x7a, 0x80,
+	0x55, 0xe9, 0x3d, 0x58, 0x53, 0x27, 0xe5, 0x42, 0xa3, 0xd4, 0xd9, 0xbe, 0xba, 0xd2, 0xc8, 0x7d,
+	0x2d, 0x12, 0x58, 0x51, 0xff, 0x2a, 0x5c, 0xbe, 0x47, 0xe4, 0x92, 0x75, 0x01, 0xf9, 0x38, 0x27,
+	0x42, 0x5a, 0xe6, 0x23, 0x9a, 0x90, 0x47, 0x74, 0xf2, 0x64, 0xf7, 0x10, 0xa7, 0x29, 0x89, 0x0b,
+	0xe6, 0x1b, 0x70, 0xf5, 0x1e, 0xd1, 0x1b, 0xa8, 0x90, 0x74, 0x22, 0x96, 0xd8, 0xaf, 0xc1, 0xc6,
+	0x3d, 0x22, 0x87, 0xd1, 0x12, 0xf9, 0x31, 0xb4, 0x1e, 0x2a, 0x67, 0xab, 0x30, 0xf8, 0x36, 0x34,
+	0x71, 0x14, 0x71, 0x22, 0x84, 0x45, 0xf1, 0xda, 0x4a, 0x8d, 0xef, 0x1a, 0x99, 0xa0, 0x10, 0x5e,
+	0x15, 0x26, 0xfe, 0xcf, 0x01, 0x46, 0x29, 0x95, 0x7b, 0x98, 0xe3, 0x44, 0x9c, 0x18, 0x60, 0x43,
+	0xe8, 0x0a, 0x89, 0xb9, 0x0c, 0x33, 0x2d, 0x67, 0x21, 0x3f, 0x43, 0x34, 0x74, 0xf4, 0x36, 0x73,
+	0xba, 0xff, 0x53, 0x80, 0x7d, 0xc9, 0x69, 0x3a, 0xfd, 0x88, 0x0a, 0xa9, 0xee, 0x3a, 0x52, 0x72,
+	0xca, 0x08, 0x77, 0xab, 0x1d, 0xd8, 0x55, 0xc5, 0x1d, 0xb5, 0xb3, 0xbb, 0xe3, 0x0e, 0x74, 0x0a,
+	0xb8, 0x1f, 0x88, 0x29, 0xba, 0x0d, 0xf5, 0x31, 0x16, 0xe4, 0x54, 0x78, 0x1e, 0x88, 0xe9, 0x0e,
+	0x16, 0x24, 0xd0, 0x92, 0xfe, 0xaf, 0x5c, 0x78, 0x7d, 0x97, 0x13, 0x1d, 0xfc, 0x71, 0x4c, 0x26,
+	0x92, 0xb2, 0xd4, 0x62, 0xff, 0xf2, 0xa7, 0xa1, 0xd7, 0xa1, 0x19, 0x8d, 0xc3, 0x14, 0x27, 0x05,
+	0xd8, 0x6b, 0xd1, 0xf8, 0x21, 0x4e, 0x08, 0xfa, 0x0a, 0xf4, 0x26, 0xe5, 0xf9, 0x8a, 0xa2, 0x63,
+	0xae, 0x1d, 0x2c, 0x51, 0xd1, 0x3b, 0xb0, 0x9e, 0x61, 0x2e, 0x69, 0x29, 0x56, 0xd7, 0x62, 0x8b,
+	0x44, 0xe5, 0xd0, 0x68, 0x3c, 0x1a, 0x7a, 0x0d, 0xed, 0x2c, 0xfd, 0x1b, 0xf9, 0xd0, 0x9d, 0x9f,
+	0x35, 0x1a, 0x7a, 0x6b, 0x9a, 0xb7, 0x40, 0x43, 0x03, 0xe8, 0x94, 0x07, 0x8d, 0x86, 0x5e, 0x53,
+	0x8b, 0x54, 0x49, 0xca, 0x39, 0xa6, 0x16, 0x79, 0xad, 0x81, 0xb3, 0xd5, 0x0d, 0xec, 0x0a, 0xdd,
+	0x86, 0x8d, 0x23, 0xca, 0x65, 0x8e, 0x63, 0x1b, 0x9f, 0x4a, 0x0f, 0xe1, 0xb5, 0xb5, 0x07, 0x57,
+	0xb1, 0xd0, 0x36, 0x6c, 0x66, 0x87, 0x33, 0x41, 0x27, 0x4b, 0x5b, 0x40, 0x6f, 0x59, 0xc9, 0xf3,
+	0xff, 0xe6, 0xc0, 0x6b, 0x43, 0xce, 0xb2, 0x57, 0xc2, 0x15, 0x05, 0xc8, 0xf5, 0x53, 0x40, 0x6e,
+	0x1c, 0x07, 0xd9, 0xff, 0x4d, 0x0d, 0x2e, 0x99, 0x88, 0xda, 0x2b, 0x80, 0xfd, 0x0c, 0xac, 0xf8,
+	0x2a, 0x5c, 0x98, 0xdf, 0x6a, 0x04, 0x56, 0x9b, 0xf1, 0x65, 0xe8, 0x95, 0x0e, 0x36, 0x72, 0xff,
+	0xdb, 0x90, 0xf2, 0x7f, 0x5d, 0x83, 0x4d, 0xe5, 0xd4, 0x2f, 0xd0, 0x50, 0x68, 0xfc, 0xc1, 0x01,
+	0x64, 0xa2, 0xe3, 0x6e, 0x4c, 0xb1, 0xf8, 0x3c, 0xb1, 0xd8, 0x84, 0x06, 0x56, 0x3a, 0x58, 0x08,
+	0xcc, 0xc2, 0x17, 0xd0, 0x57, 0xde, 0xfa, 0xac, 0xb4, 0x2b, 0x2f, 0x75, 0xab, 0x97, 0xfe, 0xde,
+	0x81, 0x8b, 0x77, 0x63, 0x49, 0xf8, 0x2b, 0x0a, 0xca, 0x5f, 0x6b, 0x85, 0xd7, 0x46, 0x69, 0x44,
+	0x9e, 0x7d, 0x9e, 0x0a, 0xbe, 0x01, 0x70, 0x40, 0x49, 0x1c, 0x55, 0xa3, 0xb7, 0xad, 0x29, 0x9f,
+	0x2a, 0x72, 0x3d, 0x68, 0xea, 0x43, 0xca, 0xa8, 0x2d, 0x96, 0x6a, 0x06, 0x30, 0xf3, 0xa0, 0x9d,
+	0x01, 0x5a, 0x67, 0x9e, 0x01, 0xf4, 0x36, 0x3b, 0x03, 0xfc, 0xd3, 0x85, 0xf5, 0x51, 0x2a, 0x08,
+	0x97, 0xe7, 0x07, 0xef, 0x1a, 0xb4, 0xc5, 0x21, 0xe6, 0xda, 0x50, 0x0b, 0xdf, 0x9c, 0x50, 0x85,
+	0xd6, 0x7d, 0x11, 0xb4, 0xf5, 0x33, 0x16, 0x87, 0xc6, 0x69, 0xc5, 0x61, 0xed, 0x14, 0x88, 0x9b,
+	0x2f, 0x2e, 0x0e, 0xad, 0xe3, 0xdd, 0x57, 0x19, 0x48, 0xa6, 0x89, 0x1a, 0x5a, 0x87, 0x5e, 0x5b,
+	0xf3, 0xe7, 0x04, 0xf4, 0x26, 0x80, 0xa4, 0x09, 0x11, 0x12, 0x27, 0x99, 0xe9, 0xa3, 0xf5, 0xa0,
+	0x42, 0x51, 0xbd, 0x9b, 0xb3, 0xa7, 0xa3, 0xa1, 0xf0, 0x3a, 0x03, 0x57, 0x0d, 0x71, 0x66, 0x85,
+	0xbe, 0x09, 0x2d, 0xce, 0x9e, 0x86, 0x11, 0x96, 0xd8, 0xeb, 0x6a, 0xe7, 0x5d, 0x5e, 0x09, 0xf6,
+	0x4e, 0xcc, 0xc6, 0x41, 0x93, 0xb3, 0xa7, 0x43, 0x2c, 0xb1, 0x02, 0xc3, 0xf4, 0xfe, 0xf0, 0x88,
+	0x70, 0x41, 0x59, 0xea, 0xad, 0x0f, 0x9c, 0xad, 0x46, 0xb0, 0x6e, 0xa8, 0x8f, 0x0d, 0xd1, 0xff,
+	0x7b, 0x1d, 0xd6, 0xf7, 0x09, 0xe6, 0x93, 0xc3, 0xf3, 0xfb, 0xf5, 0x6b, 0xd0, 0xe7, 0x44, 0xe4,
+	0xb1, 0x0c, 0x27, 0x66, 0x1a, 0x18, 0x0d, 0xad, 0x7b, 0x2f, 0x18, 0xfa, 0x6e, 0x41, 0x2e, 0xb1,
+	0x77, 0x4f, 0xc1, 0xbe, 0xbe, 0x02, 0x7b, 0x1f, 0xba, 0x15, 0xa0, 0x85, 0xd7, 0xd0, 0x08, 0x2d,
+	0xd0, 0x50, 0x1f, 0xdc, 0x48, 0xc4, 0xda, 0xad, 0xed, 0x40, 0xfd, 0x44, 0x37, 0xe0, 0x62, 0x16,
+	0xe3, 0x09, 0x39, 0x64, 0x71, 0x44, 0x78, 0x38, 0xe5, 0x2c, 0xcf, 0xb4, 0x6b, 0xbb, 0x41, 0xbf,
+	0xc2, 0xb8, 0xa7, 0xe8, 0xe8, 0x7d, 0x68, 0x45, 0x22, 0x0e, 0xe5, 0x2c, 0x23, 0xda, 0xb7, 0xbd,
+	0x13, 0x6c, 0x1f, 0x8a, 0xf8, 0xd1, 0x2c, 0x23, 0x41, 0x33, 0x32, 0x3f, 0xd0, 0x6d, 0xd8, 0x14,
+	0x84, 0x53, 0x1c, 0xd3, 0xe7, 0x24, 0x0a, 0xc9, 0xb3, 0x8c, 0x87, 0x59, 0x8c, 0x53, 0x1d, 0x00,
+	0xdd, 0x00, 0xcd, 0x79, 0xdf, 0x7f, 0x96, 0xf1, 0xbd, 0x18, 0xa7, 0x68, 0x0b, 0xfa, 0x2c, 0x97,
+	0x59, 0x2e, 0x43, 0x9d, 0xa4, 0x22, 0xa4, 0x91, 0x8e, 0x07, 0x37, 0xe8, 0x19, 0xfa, 0x0f, 0x34,
+	0x79, 0x14, 0x29, 0x68, 0x25, 0xc7, 0x47, 0x24, 0x0e, 0xcb, 0x40, 0xf1, 0x3a, 0x03, 0x67, 0xab,
+	0x1e, 0x5c, 0x30, 0xf4, 0x47, 0x05, 0x19, 0xdd, 0x82, 0x8d, 0x69, 0x8e, 0x39, 0x4e, 0x25, 0x21,
+	0x15, 0xe9, 0xae, 0x96, 0x46, 0x25, 0x6b, 0xbe, 0xe1, 0x06, 0x5c, 0x54, 0x62, 0x2c, 0x97, 0x15,
+	0xf1, 0x75, 0x2d, 0xde, 0xb7, 0x8c, 0xb9, 0xf0, 0x35, 0x68, 0x73, 0x92, 0xc5, 0x74, 0x82, 0x47,
+	0x43, 0xaf, 0x67, 0x42, 0xbb, 0x24, 0xf8, 0xbf, 0xab, 0x44, 0x91, 0x72, 0xb8, 0x38, 0x47, 0x14,
+	0x9d, 0xe7, 0xfd, 0xb0, 0x32, 0xf4, 0xdc, 0xd5, 0xa1, 0x77, 0x1d, 0x3a, 0x09, 0x91, 0x9c, 0x4e,
+	0x8c, 0x8b, 0x4d, 0x09, 0x01, 0x43, 0xd2, 0x7e, 0xbc, 0x0e, 0x9d, 0x34, 0x4f, 0xc2, 0x8f, 0x73,
+	0xc2, 0x29, 0x11, 0xb6, 0x02, 0x43, 0x9a, 0x27, 0x3f, 0x36, 0x14, 0xb4, 0x01, 0x0d, 0xc9, 0xb2,
+	0xf0, 0x49, 0x51, 0x39, 0x24, 0xcb, 0xee, 0xa3, 0xef, 0xc2, 0x15, 0x41, 0x70, 0x4c, 0xa2, 0xb0,
+	0xcc, 0x74, 0x11, 0x0a, 0x8d, 0x05, 0x89, 0xbc, 0xa6, 0xf6, 0xaa, 0x67, 0x24, 0xf6, 0x4b, 0x81,
+	0x7d, 0xcb, 0x57, 0x4e, 0x2b, 0x15, 0xaf, 0x6c, 0x6b, 0xe9, 0x21, 0x1b, 0xcd, 0x59, 0xe5, 0x86,
+	0x0f, 0xc0, 0x9b, 0xc6, 0x6c, 0x8c, 0xe3, 0xf0, 0xd8, 0xad, 0x7a, 0x9a, 0x77, 0x83, 0x4b, 0x86,
+	0xbf, 0xbf, 0x74, 0xa5, 0x32, 0x4f, 0xc4, 0x74, 0x42, 0xa2, 0x70, 0x1c, 0xb3, 0xb1, 0x07, 0x3a,
+	0x3a, 0xc1, 0x90, 0x54, 0xe9, 0x50, 0x51, 0x69, 0x05, 0x14, 0x0c, 0x13, 0x96, 0xa7, 0x52, 0xc7,
+	0x9a, 0x1b, 0xf4, 0x0c, 0xfd, 0x61, 0x9e, 0xec, 0x2a, 0x2a, 0x7a, 0x1b, 0xd6, 0xad, 0x24, 0x3b,
+	0x38, 0x10, 0x44, 0xea, 0x20, 0x73, 0x83, 0xae, 0x21, 0xfe, 0x48, 0xd3, 0xfc, 0x7f, 0xbb, 0x70,
+	0x21, 0x50, 0xe8, 0x92, 0x23, 0xf2, 0x7f, 0x5f, 0x5b, 0x4e, 0xca, 0xf1, 0xb5, 0x97, 0xca, 0xf1,
+	0xe6, 0x99, 0x73, 0xbc, 0xf5, 0x52, 0x39, 0xde, 0x7e, 0xb9, 0x1c, 0x87, 0x13, 0x72, 0x7c, 0x13,
+	0x1a, 0x31, 0x4d, 0x68, 0xe1, 0x75, 0xb3, 0x58, 0xcc, 0xfc, 0xee, 0x72, 0xe6, 0xff, 0x79, 0xc1,
+	0xcb, 0xaf, 0x6a, 0xee, 0xbf, 0x0b, 0x2e, 0x8d, 0xcc, 0x4c, 0xd8, 0xd9, 0xf6, 0x16, 0x0f, 0xb7,
+	0xdf, 0xee, 0x46, 0x43, 0x11, 0x28, 0x21, 0x74, 0x07, 0x3a, 0xd6, 0x63, 0xba, 0xe3, 0x36, 0x74,
+	0xc7, 0x7d, 0x73, 0xe5, 0x1e, 0xed, 0x42, 0xd5, 0x6d, 0x03, 0x33, 0xd3, 0x09, 0xdd, 0x79, 0xbf,
+	0x07, 0x57, 0x8f, 0x57, 0x04, 0x6e, 0x31, 0x8a, 0xbc, 0x35, 0x1d, 0x04, 0x97, 0x97, 0x4b, 0x42,
+	0x01, 0x62, 0x84, 0xbe, 0x01, 0x9b, 0x95, 0x9a, 0x30, 0xdf, 0xd8, 0x34, 0x8f, 0xf5, 0x39, 0x6f,
+	0xbe, 0xe5, 0xb4, 0xaa, 0xd0, 0x3a, 0xad, 0x2a, 0xf8, 0x7f, 0x74, 0xa0, 0xa3, 0xea, 0xdb, 0x6c,
+	0x37, 0xe7, 0x82, 0xf1, 0x63, 0x09, 0xe3, 0xac, 0x48, 0x98, 0x55, 0x01, 0x5b, 0x5b, 0x1d, 0xb0,
+	0x3b, 0xd0, 0x8f, 0xb1, 0x90, 0x61, 0xc6, 0x69, 0x82, 0xf9, 0x2c, 0x7c, 0x42, 0x66, 0xf6, 0x6b,
+	0xdd, 0xc9, 0x5e, 0xe8, 0xa9, 0x1d, 0x7b, 0x66, 0xc3, 0x7d, 0x32, 0xf3, 0x3f, 0x71, 0x61, 0x7d,
+	0x48, 0x62, 0x22, 0xc9, 0x17, 0xa3, 0xe7, 0x89, 0xa3, 0xe7, 0x5b, 0xd0, 0xad, 0x60, 0x5c, 0xf4,
+	0x82, 0x4e, 0x56, 0xc2, 0x28, 0x5e, 0x38, 0x7f, 0xde, 0x84, 0x0d, 0xa1, 0x3f, 0xf3, 0x85, 0x0b,
+	0x27, 0x75, 0x74, 0xd8, 0x5d, 0x34, 0xac, 0xb9, 0x5b, 0x84, 0xff, 0x1f, 0x07, 0xda, 0x1f, 0x31,
+	0x1c, 0xe9, 0x27, 0xd5, 0x39, 0x7d, 0x52, 0x4e, 0xcb, 0xb5, 0xe5, 0x69, 0xf9, 0x1a, 0xcc, 0x5f,
+	0x45, 0xd6, 0x2b, 0x95, 0x67, 0x52, 0xe5, 0xb9, 0x53, 0x5f, 0x7c, 0xee, 0x5c, 0x87, 0x0e, 0x55,
+	0x0a, 0x85, 0x19, 0x96, 0x87, 0xa6, 0x98, 0xb7, 0x03, 0xd0, 0xa4, 0x3d, 0x45, 0x51, 0xef, 0xa1,
+	0x42, 0x40, 0xbf, 0x87, 0xd6, 0xce, 0xfc, 0x1e, 0xb2, 0x87, 0xe8, 0xf7, 0xd0, 0x2f, 0x1d, 0x00,
+	0x6d, 0xb8, 0x2a, 0x4a, 0xc7, 0x0f, 0x75, 0xce, 0x73, 0xa8, 0xea, 0x32, 0xaa, 0xf5, 0x72, 0x12,
+	0x63, 0x39, 0x4f, 0x62, 0x61, 0xc1, 0x41, 0x69, 0x9e, 0x04, 0x86, 0x65, 0x13, 0x58, 0xf8, 0xbf,
+	0x75, 0x00, 0x74, 0x15, 0x32, 0x6a, 0x9c, 0x25, 0x7b, 0x2b, 0xd0, 0xd5, 0x16, 0xa1, 0xdb, 0x29,
+	0xa0, 0x53, 0x05, 0x56, 0xbd, 0xf0, 0x57, 0xd8, 0x50, 0x7e, 0x9f, 0x9f, 0x1b, 0x6f, 0xd1, 0xd5,
+	0xbf, 0xfd, 0x4f, 0x1c, 0xe8, 0x5a, 0xed, 0x8c, 0x4a, 0x0b, 0x5e, 0x76, 0x96, 0xbd, 0xac, 0x87,
+	0xb2, 0x84, 0xf1, 0x59, 0x28, 0xe8, 0x73, 0x62, 0x15, 0x02, 0x43, 0xda, 0xa7, 0xcf, 0x09, 0xba,
+	0x0c, 0x2d, 0x0d, 0x09, 0x7b, 0x2a, 0x6c, 0x63, 0x6f, 0x2a, 0x18, 0xd8, 0x53, 0xa1, 0x7a, 0x1b,
+	0x27, 0x13, 0x92, 0xca, 0x78, 0x16, 0x26, 0x2c, 0xa2, 0x07, 0x94, 0x44, 0x3a, 0x1a, 0x5a, 0x41,
+	0xbf, 0x60, 0x3c, 0xb0, 0x74, 0xff, 0x1f, 0x0e, 0xf4, 0x74, 0x9d, 0x7b, 0xc8, 0x22, 0x62, 0x34,
+	0x7b, 0xf9, 0x88, 0xfd, 0x50, 0xdb, 0x62, 0xe1, 0x31, 0xdf, 0xd2, 0xdf, 0x3e, 0xe9, 0xaf, 0x99,
+	0x0a, 0x06, 0x41, 0x4b, 0x90, 0xa9, 0xb9, 0x73, 0xc7, 0x36, 0x97, 0x33, 0x41, 0x3c, 0x77, 0xac,
+	0xed, 0x2f, 0x06, 0xe2, 0x5f, 0x38, 0xd0, 0x79, 0x20, 0xa6, 0x7b, 0x4c, 0xe8, 0xe4, 0x57, 0xa9,
+	0x6f, 0x7b, 0x82, 0xa9, 0x3c, 0x8e, 0x4e, 0x96, 0xce, 0x64, 0xfe, 0x65, 0x56, 0x75, 0xf6, 0x44,
+	0x4c, 0xad, 0xc7, 0xbb, 0x81, 0x59, 0xa0, 0x2b, 0xd0, 0x4a, 0xc4, 0x54, 0xbf, 0x7e, 0x6c, 0x86,
+	0x95, 0x6b, 0xe5, 0xb6, 0x79, 0x71, 0xaf, 0xeb, 0xe2, 0x3e, 0x27, 0xf8, 0x7f, 0x72, 0x00, 0xd9,
+	0xee, 0xfa, 0xa9, 0x3e, 0xdf, 0xeb, 0x80, 0xad, 0x7e, 0x5d, 0xae, 0xe9, 0x74, 0x5d, 0xa0, 0x2d,
+	0xd5, 0x2d, 0xf7, 0x58, 0xdd, 0xba, 0x01, 0x17, 0x23, 0x72, 0x80, 0xd5, 0x20, 0xb0, 0xac, 0x72,
+	0xdf, 0x32, 0xca, 0x86, 0xf4, 0xee, 0x07, 0xd0, 0x2e, 0xff, 0x35, 0x43, 0x7d, 0xe8, 0x8e, 0x52,
+	0x2a, 0xf5, 0xe8, 0x46, 0xd3, 0x69, 0xff, 0x4b, 0xa8, 0x03, 0xcd, 0x1f, 0x12, 0x1c, 0xcb, 0xc3,
+	0x59, 0xdf, 0x41, 0x5d, 0x68, 0xdd, 0x1d, 0xa7, 0x8c, 0x27, 0x38, 0xee, 0xd7, 0x76, 0xde, 0xff,
+	0xd9, 0xb7, 0xa6, 0x54, 0x1e, 0xe6, 0x63, 0x65, 0xc9, 0x2d, 0x63, 0xda, 0xd7, 0x29, 0xb3, 0xbf,
+	0x6e, 0x15, 0x5e, 0xbb, 0xa5, 0xad, 0x2d, 0x97, 0xd9, 0x78, 0xbc, 0xa6, 0x29, 0xef, 0xfd, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0xfe, 0xbe, 0x26, 0x73, 0x5b, 0x1c, 0x00, 0x00,
 }
@@ -26,6 +26,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{0}
+}
+
+type OperatePrivilegeType int32
+
+const (
+	OperatePrivilegeType_Grant  OperatePrivilegeType = 0
+	OperatePrivilegeType_Revoke OperatePrivilegeType = 1
+)
+
+var OperatePrivilegeType_name = map[int32]string{
+	0: "Grant",
+	1: "Revoke",
+}
+
+var OperatePrivilegeType_value = map[string]int32{
+	"Grant":  0,
+	"Revoke": 1,
+}
+
+func (x OperatePrivilegeType) String() string {
+	return proto.EnumName(OperatePrivilegeType_name, int32(x))
+}
+
+func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
 //
 // This is for ShowCollectionsRequest type field.
 type ShowType int32
@@ -52,7 +102,7 @@ func (x ShowType) String() string {
 }
 
 func (ShowType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{0}
+	return fileDescriptor_02345ba45cc0e303, []int{2}
 }
 
 type PlaceholderType int32
@@ -80,7 +130,7 @@ func (x PlaceholderType) String() string {
 }
 
 func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
 type CreateAliasRequest struct {
@@ -265,322 +315,1573 @@ func (m *AlterAliasRequest) GetAlias() string {
 }
 
 //*
-// Create collection in milvus
-type CreateCollectionRequest struct {
-	// Not useful for now
+// Create a database, collections in different databases are isolated from each other.
+type CreateDatabaseRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The serialized `schema.CollectionSchema`(Required)
-	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	// Once set, no modification is allowed (Optional)
-	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	// The unique database name in milvus.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
-func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCollectionRequest) ProtoMessage()    {}
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
-func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
 }
-func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
 }
-func (m *CreateCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
 }
-func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetDbName() string {
+func (m *CreateDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetCollectionName() string {
+//*
+// Drop a database, all collections in it are dropped as well.
+type DropDatabaseRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name to drop, the default database can not be dropped.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{4}
+}
+
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CollectionName
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetSchema() []byte {
+//*
+// List all databases.
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{5}
+}
+
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Schema
+		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetShardsNum() int32 {
+type ListDatabasesResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Database name array
+	DbNames []string `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps    []uint64 `protobuf:"varint,3,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{6}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.ShardsNum
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.ConsistencyLevel
+		return m.DbNames
 	}
-	return commonpb.ConsistencyLevel_Strong
+	return nil
 }
 
-//*
-// Drop collection in milvus, also will drop data in collection.
-type DropCollectionRequest struct {
+func (m *ListDatabasesResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
+}
+
+type CreateCredentialRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password, encoded by base64
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
-func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropCollectionRequest) ProtoMessage()    {}
-func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{4}
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
-func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-func (m *DropCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropCollectionRequest.Size(m)
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
 }
-func (m *DropCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropCollectionRequest) GetDbName() string {
+func (m *CreateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.DbName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *DropCollectionRequest) GetCollectionName() string {
+func (m *CreateCredentialRequest) GetPassword() string {
 	if m != nil {
-		return m.CollectionName
+		return m.Password
 	}
 	return ""
 }
 
-//*
-// Check collection exist in milvus or not.
-type HasCollectionRequest struct {
+type UpdateCredentialRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to check.
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
-	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// old password, encoded by base64
+	OldPassword string `protobuf:"bytes,3,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	// new password, encoded by base64
+	NewPassword          string   `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
-func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasCollectionRequest) ProtoMessage()    {}
-func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{5}
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
 
-func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
 }
-func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
 }
-func (m *HasCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasCollectionRequest.Size(m)
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
 }
-func (m *HasCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
 
-func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasCollectionRequest) GetDbName() string {
+func (m *UpdateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.DbName
+		return m.Username
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetCollectionName() string {
+func (m *UpdateCredentialRequest) GetOldPassword() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldPassword
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+func (m *UpdateCredentialRequest) GetNewPassword() string {
 	if m != nil {
-		return m.TimeStamp
+		return m.NewPassword
 	}
-	return 0
+	return ""
 }
 
-type BoolResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type DeleteCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
-func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
-func (*BoolResponse) ProtoMessage()    {}
-func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
 }
 
-func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
 }
-func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *BoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BoolResponse.Merge(m, src)
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
 }
-func (m *BoolResponse) XXX_Size() int {
-	return xxx_messageInfo_BoolResponse.Size(m)
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
 }
-func (m *BoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
 
-func (m *BoolResponse) GetStatus() *commonpb.Status {
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *BoolResponse) GetValue() bool {
+func (m *DeleteCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.Value
+		return m.Username
 	}
-	return false
+	return ""
 }
 
-type StringResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ListCredUsersRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *StringResponse) Reset()         { *m = StringResponse{} }
-func (m *StringResponse) String() string { return proto.CompactTextString(m) }
-func (*StringResponse) ProtoMessage()    {}
-func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
+}
+
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
+}
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
+}
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
+}
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
+
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListCredUsersResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username array
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{11}
+}
+
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+}
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCredUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{12}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type DropRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{13}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type OperateUserRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// role name
+	RoleName string `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// operation type
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{14}
+}
+
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
+}
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
+}
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
+}
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
+
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateUserRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
+	if m != nil {
+		return m.Type
+	}
+	return OperateUserRoleType_AddUserToRole
+}
+
+type SelectRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name, empty means all roles
+	RoleName string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// include the users of the role
+	IncludeUserInfo      bool     `protobuf:"varint,3,opt,name=include_user_info,json=includeUserInfo,proto3" json:"include_user_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectRoleRequest) Reset()         { *m = SelectRoleRequest{} }
+func (m *SelectRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectRoleRequest) ProtoMessage()    {}
+func (*SelectRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{15}
+}
+
+func (m *SelectRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectRoleRequest.Unmarshal(m, b)
+}
+func (m *SelectRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectRoleRequest.Merge(m, src)
+}
+func (m *SelectRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectRoleRequest.Size(m)
+}
+func (m *SelectRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectRoleRequest proto.InternalMessageInfo
+
+func (m *SelectRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *SelectRoleRequest) GetIncludeUserInfo() bool {
+	if m != nil {
+		return m.IncludeUserInfo
+	}
+	return false
+}
+
+type RoleResult struct {
+	RoleName             string   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleResult) Reset()         { *m = RoleResult{} }
+func (m *RoleResult) String() string { return proto.CompactTextString(m) }
+func (*RoleResult) ProtoMessage()    {}
+func (*RoleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{16}
+}
+
+func (m *RoleResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleResult.Unmarshal(m, b)
+}
+func (m *RoleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleResult.Marshal(b, m, deterministic)
+}
+func (m *RoleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleResult.Merge(m, src)
+}
+func (m *RoleResult) XXX_Size() int {
+	return xxx_messageInfo_RoleResult.Size(m)
+}
+func (m *RoleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleResult proto.InternalMessageInfo
+
+func (m *RoleResult) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *RoleResult) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+type SelectRoleResponse struct {
+	// Contain error_code and reason
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*RoleResult    `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SelectRoleResponse) Reset()         { *m = SelectRoleResponse{} }
+func (m *SelectRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectRoleResponse) ProtoMessage()    {}
+func (*SelectRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
+}
+
+func (m *SelectRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectRoleResponse.Unmarshal(m, b)
+}
+func (m *SelectRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectRoleResponse.Merge(m, src)
+}
+func (m *SelectRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectRoleResponse.Size(m)
+}
+func (m *SelectRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectRoleResponse proto.InternalMessageInfo
+
+func (m *SelectRoleResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectRoleResponse) GetResults() []*RoleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SelectUserRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username, empty means all users
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// include the roles of the user
+	IncludeRoleInfo      bool     `protobuf:"varint,3,opt,name=include_role_info,json=includeRoleInfo,proto3" json:"include_role_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectUserRequest) Reset()         { *m = SelectUserRequest{} }
+func (m *SelectUserRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectUserRequest) ProtoMessage()    {}
+func (*SelectUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
+}
+
+func (m *SelectUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectUserRequest.Unmarshal(m, b)
+}
+func (m *SelectUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectUserRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectUserRequest.Merge(m, src)
+}
+func (m *SelectUserRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectUserRequest.Size(m)
+}
+func (m *SelectUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectUserRequest proto.InternalMessageInfo
+
+func (m *SelectUserRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SelectUserRequest) GetIncludeRoleInfo() bool {
+	if m != nil {
+		return m.IncludeRoleInfo
+	}
+	return false
+}
+
+type UserResult struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	RoleNames            []string `protobuf:"bytes,2,rep,name=role_names,json=roleNames,proto3" json:"role_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserResult) Reset()         { *m = UserResult{} }
+func (m *UserResult) String() string { return proto.CompactTextString(m) }
+func (*UserResult) ProtoMessage()    {}
+func (*UserResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{19}
+}
+
+func (m *UserResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserResult.Unmarshal(m, b)
+}
+func (m *UserResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserResult.Marshal(b, m, deterministic)
+}
+func (m *UserResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserResult.Merge(m, src)
+}
+func (m *UserResult) XXX_Size() int {
+	return xxx_messageInfo_UserResult.Size(m)
+}
+func (m *UserResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserResult proto.InternalMessageInfo
+
+func (m *UserResult) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserResult) GetRoleNames() []string {
+	if m != nil {
+		return m.RoleNames
+	}
+	return nil
+}
+
+type SelectUserResponse struct {
+	// Contain error_code and reason
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*UserResult    `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SelectUserResponse) Reset()         { *m = SelectUserResponse{} }
+func (m *SelectUserResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectUserResponse) ProtoMessage()    {}
+func (*SelectUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
+}
+
+func (m *SelectUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectUserResponse.Unmarshal(m, b)
+}
+func (m *SelectUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectUserResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectUserResponse.Merge(m, src)
+}
+func (m *SelectUserResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectUserResponse.Size(m)
+}
+func (m *SelectUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectUserResponse proto.InternalMessageInfo
+
+func (m *SelectUserResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectUserResponse) GetResults() []*UserResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type GrantEntity struct {
+	// role name
+	RoleName string `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// object type, Global, Collection or User
+	ObjectType string `protobuf:"bytes,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// object name, the collection name or username, * means all objects of the type
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// privilege name
+	Privilege string `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	// the user who granted the privilege, filled by server
+	Grantor              string   `protobuf:"bytes,5,opt,name=grantor,proto3" json:"grantor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
+}
+
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
+}
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
+}
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
+}
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
+
+func (m *GrantEntity) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetGrantor() string {
+	if m != nil {
+		return m.Grantor
+	}
+	return ""
+}
+
+type OperatePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant entity, the grantor is ignored
+	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// operation type
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+type SelectGrantRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name is required, object type and object name are optional filters
+	Entity               *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
+}
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectGrantRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+type SelectGrantResponse struct {
+	// Contain error_code and reason
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entities             []*GrantEntity   `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
+}
+
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+}
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+//*
+// Create collection in milvus
+type CreateCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, empty means the default database
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The serialized `schema.CollectionSchema`(Required)
+	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Once set, no modification is allowed (Optional)
+	// https://github.com/milvus-io/milvus/issues/6690
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The consistency level that the collection used, modification is not supported now.
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The collection properties, e.g. "ttl_seconds" to expire entities (Optional)
+	Properties []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
+	// The number of partitions created for a collection with a partition key field, no modification is allowed (Optional)
+	NumPartitions        int64    `protobuf:"varint,8,opt,name=num_partitions,json=numPartitions,proto3" json:"num_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
+func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionRequest) ProtoMessage()    {}
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
+}
+
+func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+}
+func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+}
+func (m *CreateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+}
+func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+
+func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreateCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreateCollectionRequest) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *CreateCollectionRequest) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CreateCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CreateCollectionRequest) GetNumPartitions() int64 {
+	if m != nil {
+		return m.NumPartitions
+	}
+	return 0
+}
+
+//*
+// Drop collection in milvus, also will drop data in collection.
+type DropCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, empty means the default database
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
+func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropCollectionRequest) ProtoMessage()    {}
+func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
+}
+
+func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
+}
+func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
+}
+func (m *DropCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropCollectionRequest.Size(m)
+}
+func (m *DropCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
+
+func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DropCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+//*
+// Check collection exist in milvus or not.
+type HasCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, empty means the default database
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name you want to check.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
+	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
+func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasCollectionRequest) ProtoMessage()    {}
+func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
+}
+
+func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
+}
+func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
+}
+func (m *HasCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasCollectionRequest.Size(m)
+}
+func (m *HasCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
+
+func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HasCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HasCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
+}
+
+type BoolResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
+func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
+func (*BoolResponse) ProtoMessage()    {}
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
+}
+
+func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
+}
+func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
+}
+func (m *BoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolResponse.Merge(m, src)
+}
+func (m *BoolResponse) XXX_Size() int {
+	return xxx_messageInfo_BoolResponse.Size(m)
+}
+func (m *BoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
+
+func (m *BoolResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *BoolResponse) GetValue() bool {
+	if m != nil {
+		return m.Value
+	}
+	return false
+}
+
+type StringResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StringResponse) Reset()         { *m = StringResponse{} }
+func (m *StringResponse) String() string { return proto.CompactTextString(m) }
+func (*StringResponse) ProtoMessage()    {}
+func (*StringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
+}
+
+func (m *StringResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+}
+func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+}
+func (m *StringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringResponse.Merge(m, src)
+}
+func (m *StringResponse) XXX_Size() int {
+	return xxx_messageInfo_StringResponse.Size(m)
+}
+func (m *StringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+
+func (m *StringResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *StringResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+//*
+// Append a scalar field to the schema of an existing collection.
+type AddFieldRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, empty means the default database
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The field to append, it must be a scalar field with a default value.(Required)
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
-func (m *StringResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
 }
-func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
 }
-func (m *StringResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringResponse.Merge(m, src)
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
 }
-func (m *StringResponse) XXX_Size() int {
-	return xxx_messageInfo_StringResponse.Size(m)
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
 }
-func (m *StringResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
 
-func (m *StringResponse) GetStatus() *commonpb.Status {
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *StringResponse) GetValue() string {
+func (m *AddFieldRequest) GetDbName() string {
 	if m != nil {
-		return m.Value
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
 	}
 	return ""
 }
 
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
 //*
 // Get collection meta datas like: schema, collectionID, shards number ...
 type DescribeCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name you want to describe, you can pass collection_name or collectionID
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -597,7 +1898,7 @@ func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionReq
 func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionRequest) ProtoMessage()    {}
 func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -677,17 +1978,19 @@ type DescribeCollectionResponse struct {
 	// The message ID/posititon when collection is created
 	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The collection properties set when the collection is created
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,12,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
 func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionResponse) ProtoMessage()    {}
 func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
@@ -785,15 +2088,24 @@ func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyL
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *DescribeCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 //*
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name you want to load
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The number of in-memory replicas, each replica is placed on a disjoint group of query nodes
+	ReplicaNumber        int32    `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -803,7 +2115,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -845,12 +2157,19 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
 //*
 // Release collection data from query nodes, then you can't do vector search on this collection.
 type ReleaseCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name you want to release
 	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -863,7 +2182,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -910,7 +2229,7 @@ func (m *ReleaseCollectionRequest) GetCollectionName() string {
 type GetCollectionStatisticsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name you want get statistics
 	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -923,7 +2242,7 @@ func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionSta
 func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
 func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -981,7 +2300,7 @@ func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionSt
 func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
 func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1021,7 +2340,7 @@ func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 type ShowCollectionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// Not useful for now
 	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
@@ -1038,7 +2357,7 @@ func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{}
 func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsRequest) ProtoMessage()    {}
 func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1118,7 +2437,7 @@ func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse
 func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsResponse) ProtoMessage()    {}
 func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1186,7 +2505,7 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 type CreatePartitionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1201,7 +2520,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1255,7 +2574,7 @@ func (m *CreatePartitionRequest) GetPartitionName() string {
 type DropPartitionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1270,7 +2589,7 @@ func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
 func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropPartitionRequest) ProtoMessage()    {}
 func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1324,7 +2643,7 @@ func (m *DropPartitionRequest) GetPartitionName() string {
 type HasPartitionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1339,7 +2658,7 @@ func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
 func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasPartitionRequest) ProtoMessage()    {}
 func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1394,7 +2713,7 @@ func (m *HasPartitionRequest) GetPartitionName() string {
 type LoadPartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1409,7 +2728,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1464,7 +2783,7 @@ func (m *LoadPartitionsRequest) GetPartitionNames() []string {
 type ReleasePartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1479,7 +2798,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1533,7 +2852,7 @@ func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 type GetPartitionStatisticsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1548,7 +2867,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1609,7 +2928,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +2968,7 @@ func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
 type ShowPartitionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name you want to describe, you can pass collection_name or collectionID
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -1668,7 +2987,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1756,7 +3075,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1832,7 +3151,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1889,7 +3208,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1958,7 +3277,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2012,7 +3331,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2052,7 +3371,7 @@ func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
 type CreateIndexRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The particular collection name you want to create index.
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -2069,7 +3388,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2131,7 +3450,7 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 type DescribeIndexRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, empty means the default database
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The particular collection name in Milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
@@ -2148,7 +3467,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2224,7 +3543,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -2289,7 +3608,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -2346,7 +3665,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2415,7 +3734,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2472,7 +3791,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2541,7 +3860,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2598,7 +3917,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2671,7 +3990,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2760,7 +4079,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2860,7 +4179,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -2923,6 +4242,93 @@ func (m *DeleteRequest) GetHashKeys() []uint32 {
 	return nil
 }
 
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
+}
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
+}
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
+
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
 type PlaceholderValue struct {
 	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
@@ -2937,7 +4343,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2990,7 +4396,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -3040,7 +4446,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -3151,7 +4557,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -3205,7 +4611,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -3224,22 +4630,129 @@ func (m *SearchResults) XXX_DiscardUnknown() {
 	xxx_messageInfo_SearchResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+
+func (m *SearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// HybridSearchRequest searches several vector fields of a collection, one ANN search per request,
+// the results are fused by the reranker specified in rank_params.
+type HybridSearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// the collection, partitions, output fields and timestamps of the requests are overridden by the ones above
+	Requests []*SearchRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// strategy, params, limit, offset
+	RankParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=rank_params,json=rankParams,proto3" json:"rank_params,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,7,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *HybridSearchRequest) Reset()         { *m = HybridSearchRequest{} }
+func (m *HybridSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*HybridSearchRequest) ProtoMessage()    {}
+func (*HybridSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
+}
+
+func (m *HybridSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HybridSearchRequest.Unmarshal(m, b)
+}
+func (m *HybridSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HybridSearchRequest.Marshal(b, m, deterministic)
+}
+func (m *HybridSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HybridSearchRequest.Merge(m, src)
+}
+func (m *HybridSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_HybridSearchRequest.Size(m)
+}
+func (m *HybridSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HybridSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HybridSearchRequest proto.InternalMessageInfo
+
+func (m *HybridSearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HybridSearchRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *HybridSearchRequest) GetRequests() []*SearchRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
 
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *HybridSearchRequest) GetRankParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.Status
+		return m.RankParams
 	}
 	return nil
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *HybridSearchRequest) GetOutputFields() []string {
 	if m != nil {
-		return m.Results
+		return m.OutputFields
 	}
 	return nil
 }
 
+func (m *HybridSearchRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *HybridSearchRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -3253,7 +4766,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -3308,7 +4821,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3359,6 +4872,9 @@ type QueryRequest struct {
 	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Offset               int64             `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64             `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string            `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -3368,7 +4884,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3445,9 +4961,31 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	NextCursor           string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -3457,7 +4995,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3492,6 +5030,13 @@ func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
 	return nil
 }
 
+func (m *QueryResults) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type VectorIDs struct {
 	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
@@ -3506,7 +5051,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3569,7 +5114,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3649,7 +5194,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3715,7 +5260,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3803,7 +5348,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3872,7 +5417,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3926,7 +5471,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3980,7 +5525,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4077,7 +5622,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4131,7 +5676,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4177,7 +5722,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -4216,7 +5761,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -4254,7 +5799,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -4287,7 +5832,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -4334,7 +5879,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -4382,7 +5927,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4440,7 +5985,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4501,7 +6046,7 @@ func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest
 func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionRequest) ProtoMessage()    {}
 func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+	return fileDescriptor_02345ba45cc0e303, []int{92}
 }
 
 func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
@@ -4548,7 +6093,7 @@ func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionRespon
 func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionResponse) ProtoMessage()    {}
 func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+	return fileDescriptor_02345ba45cc0e303, []int{93}
 }
 
 func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
@@ -4594,7 +6139,7 @@ func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateReq
 func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateRequest) ProtoMessage()    {}
 func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+	return fileDescriptor_02345ba45cc0e303, []int{94}
 }
 
 func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4637,7 +6182,7 @@ func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateRe
 func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateResponse) ProtoMessage()    {}
 func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+	return fileDescriptor_02345ba45cc0e303, []int{95}
 }
 
 func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -4704,7 +6249,7 @@ func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansReq
 func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansRequest) ProtoMessage()    {}
 func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+	return fileDescriptor_02345ba45cc0e303, []int{96}
 }
 
 func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
@@ -4745,7 +6290,7 @@ func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansRe
 func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansResponse) ProtoMessage()    {}
 func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+	return fileDescriptor_02345ba45cc0e303, []int{97}
 }
 
 func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
@@ -4757,180 +6302,465 @@ func (m *GetCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) (
 func (m *GetCompactionPlansResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_GetCompactionPlansResponse.Merge(m, src)
 }
-func (m *GetCompactionPlansResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
+func (m *GetCompactionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
+}
+func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+
+func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+	if m != nil {
+		return m.MergeInfos
+	}
+	return nil
+}
+
+type CompactionMergeInfo struct {
+	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
+func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionMergeInfo) ProtoMessage()    {}
+func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{98}
+}
+
+func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+}
+func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+}
+func (m *CompactionMergeInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+}
+func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+
+func (m *CompactionMergeInfo) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompactionMergeInfo) GetTarget() int64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type GetFlushStateRequest struct {
+	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
+func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateRequest) ProtoMessage()    {}
+func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{99}
+}
+
+func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+}
+func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+}
+func (m *GetFlushStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+}
+func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+
+func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetFlushStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
+func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateResponse) ProtoMessage()    {}
+func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{100}
+}
+
+func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+}
+func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+}
+func (m *GetFlushStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+}
+func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+
+func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFlushStateResponse) GetFlushed() bool {
+	if m != nil {
+		return m.Flushed
+	}
+	return false
+}
+
+type ImportRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	RowBased             bool                     `protobuf:"varint,5,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	Files                []string                 `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{101}
+}
+
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ImportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
-func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+
+func (m *ImportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+func (m *ImportRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
 
-func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+func (m *ImportRequest) GetRowBased() bool {
 	if m != nil {
-		return m.Status
+		return m.RowBased
 	}
-	return nil
+	return false
 }
 
-func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
+func (m *ImportRequest) GetFiles() []string {
 	if m != nil {
-		return m.State
+		return m.Files
 	}
-	return commonpb.CompactionState_UndefiedState
+	return nil
 }
 
-func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+func (m *ImportRequest) GetOptions() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.MergeInfos
+		return m.Options
 	}
 	return nil
 }
 
-type CompactionMergeInfo struct {
-	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
-	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ImportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []int64          `protobuf:"varint,2,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
-func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
-func (*CompactionMergeInfo) ProtoMessage()    {}
-func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{102}
 }
 
-func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
 }
-func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
 }
-func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
 }
-func (m *CompactionMergeInfo) XXX_Size() int {
-	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
 }
-func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
 
-func (m *CompactionMergeInfo) GetSources() []int64 {
+func (m *ImportResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Sources
+		return m.Status
 	}
 	return nil
 }
 
-func (m *CompactionMergeInfo) GetTarget() int64 {
+func (m *ImportResponse) GetTasks() []int64 {
 	if m != nil {
-		return m.Target
+		return m.Tasks
 	}
-	return 0
+	return nil
 }
 
-type GetFlushStateRequest struct {
-	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+type GetImportStateRequest struct {
+	Task                 int64    `protobuf:"varint,1,opt,name=task,proto3" json:"task,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
-func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateRequest) ProtoMessage()    {}
-func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
+func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateRequest) ProtoMessage()    {}
+func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{103}
 }
 
-func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
 }
-func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
 }
-func (m *GetFlushStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+func (m *GetImportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateRequest.Size(m)
 }
-func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+func (m *GetImportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
 
-func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+func (m *GetImportStateRequest) GetTask() int64 {
 	if m != nil {
-		return m.SegmentIDs
+		return m.Task
 	}
-	return nil
+	return 0
 }
 
-type GetFlushStateResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type GetImportStateResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ImportState     `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                    `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	IdList               []int64                  `protobuf:"varint,4,rep,packed,name=id_list,json=idList,proto3" json:"id_list,omitempty"`
+	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
+	Id                   int64                    `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId         int64                    `protobuf:"varint,7,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
-func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateResponse) ProtoMessage()    {}
-func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
+func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateResponse) ProtoMessage()    {}
+func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{104}
 }
 
-func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
 }
-func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
 }
-func (m *GetFlushStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+func (m *GetImportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateResponse.Size(m)
 }
-func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+func (m *GetImportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
 
-func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetFlushStateResponse) GetFlushed() bool {
+func (m *GetImportStateResponse) GetState() commonpb.ImportState {
 	if m != nil {
-		return m.Flushed
+		return m.State
 	}
-	return false
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *GetImportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetIdList() []int64 {
+	if m != nil {
+		return m.IdList
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetInfos() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *GetImportStateResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetImportStateResponse) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
 }
 
 func init() {
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*SelectRoleRequest)(nil), "milvus.proto.milvus.SelectRoleRequest")
+	proto.RegisterType((*RoleResult)(nil), "milvus.proto.milvus.RoleResult")
+	proto.RegisterType((*SelectRoleResponse)(nil), "milvus.proto.milvus.SelectRoleResponse")
+	proto.RegisterType((*SelectUserRequest)(nil), "milvus.proto.milvus.SelectUserRequest")
+	proto.RegisterType((*UserResult)(nil), "milvus.proto.milvus.UserResult")
+	proto.RegisterType((*SelectUserResponse)(nil), "milvus.proto.milvus.SelectUserResponse")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
+	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
 	proto.RegisterType((*BoolResponse)(nil), "milvus.proto.milvus.BoolResponse")
 	proto.RegisterType((*StringResponse)(nil), "milvus.proto.milvus.StringResponse")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
 	proto.RegisterType((*DescribeCollectionRequest)(nil), "milvus.proto.milvus.DescribeCollectionRequest")
 	proto.RegisterType((*DescribeCollectionResponse)(nil), "milvus.proto.milvus.DescribeCollectionResponse")
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.milvus.LoadCollectionRequest")
@@ -4964,11 +6794,13 @@ func init() {
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
 	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
 	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
+	proto.RegisterType((*HybridSearchRequest)(nil), "milvus.proto.milvus.HybridSearchRequest")
 	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.milvus.FlushRequest")
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
@@ -5000,237 +6832,316 @@ func init() {
 	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
 	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.milvus.GetFlushStateRequest")
 	proto.RegisterType((*GetFlushStateResponse)(nil), "milvus.proto.milvus.GetFlushStateResponse")
+	proto.RegisterType((*ImportRequest)(nil), "milvus.proto.milvus.ImportRequest")
+	proto.RegisterType((*ImportResponse)(nil), "milvus.proto.milvus.ImportResponse")
+	proto.RegisterType((*GetImportStateRequest)(nil), "milvus.proto.milvus.GetImportStateRequest")
+	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
 }
 
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x73, 0x1b, 0xc7,
-	0xd1, 0x5c, 0x80, 0x78, 0x35, 0x00, 0x12, 0x1c, 0x52, 0x14, 0x04, 0xbd, 0xa8, 0xb5, 0x65, 0x51,
-	0x92, 0x25, 0x59, 0x94, 0x5f, 0x9f, 0xfc, 0x25, 0xb6, 0x24, 0xc6, 0x12, 0xcb, 0x92, 0x42, 0x2f,
-	0x6d, 0xa7, 0x1c, 0x97, 0x0a, 0xb5, 0xc4, 0x0e, 0xc1, 0x2d, 0x2d, 0x76, 0xe1, 0x9d, 0x81, 0x24,
-	0xfa, 0x94, 0x2a, 0xc7, 0x49, 0xa5, 0xec, 0xd8, 0x95, 0x4a, 0x2a, 0x8f, 0x43, 0x72, 0xc8, 0xe3,
-	0x90, 0x5b, 0x1c, 0xa7, 0x92, 0x54, 0x2e, 0xc9, 0x21, 0x87, 0x1c, 0x52, 0x95, 0xc7, 0xc5, 0x87,
-	0x5c, 0xf2, 0x07, 0xfc, 0x0f, 0x72, 0x48, 0xcd, 0x63, 0x17, 0xbb, 0x8b, 0x59, 0x10, 0x14, 0xac,
-	0x90, 0xbc, 0xed, 0xf4, 0x74, 0xf7, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0x42, 0xa5, 0x63,
-	0x3b, 0xf7, 0x7a, 0xe4, 0x7c, 0xd7, 0xf7, 0xa8, 0x87, 0x66, 0xa3, 0xad, 0xf3, 0xa2, 0xd1, 0xa8,
-	0xb4, 0xbc, 0x4e, 0xc7, 0x73, 0x05, 0xb0, 0x51, 0x21, 0xad, 0x4d, 0xdc, 0x31, 0x45, 0x4b, 0xff,
-	0x89, 0x06, 0xe8, 0x9a, 0x8f, 0x4d, 0x8a, 0xaf, 0x38, 0xb6, 0x49, 0x0c, 0xfc, 0x76, 0x0f, 0x13,
-	0x8a, 0x9e, 0x82, 0xc9, 0x75, 0x93, 0xe0, 0xba, 0xb6, 0xa0, 0x2d, 0x96, 0x97, 0x8e, 0x9c, 0x8f,
-	0xb1, 0x95, 0xec, 0x6e, 0x91, 0xf6, 0x55, 0x93, 0x60, 0x83, 0x63, 0xa2, 0x83, 0x50, 0xb0, 0xd6,
-	0x9b, 0xae, 0xd9, 0xc1, 0xf5, 0xcc, 0x82, 0xb6, 0x58, 0x32, 0xf2, 0xd6, 0xfa, 0x6d, 0xb3, 0x83,
-	0xd1, 0x29, 0x98, 0x6e, 0x79, 0x8e, 0x83, 0x5b, 0xd4, 0xf6, 0x5c, 0x81, 0x90, 0xe5, 0x08, 0x53,
-	0x7d, 0x30, 0x47, 0x9c, 0x83, 0x9c, 0xc9, 0x64, 0xa8, 0x4f, 0xf2, 0x6e, 0xd1, 0xd0, 0x09, 0xd4,
-	0x96, 0x7d, 0xaf, 0xfb, 0xa8, 0xa4, 0x0b, 0x07, 0xcd, 0x46, 0x07, 0xfd, 0xb1, 0x06, 0x33, 0x57,
-	0x1c, 0x8a, 0xfd, 0x3d, 0xaa, 0x94, 0x1f, 0x66, 0xe0, 0xa0, 0x58, 0xb5, 0x6b, 0x21, 0xfa, 0x6e,
-	0x4a, 0x39, 0x0f, 0x79, 0x61, 0x55, 0x5c, 0xcc, 0x8a, 0x21, 0x5b, 0xe8, 0x28, 0x00, 0xd9, 0x34,
-	0x7d, 0x8b, 0x34, 0xdd, 0x5e, 0xa7, 0x9e, 0x5b, 0xd0, 0x16, 0x73, 0x46, 0x49, 0x40, 0x6e, 0xf7,
-	0x3a, 0xc8, 0x80, 0x99, 0x96, 0xe7, 0x12, 0x9b, 0x50, 0xec, 0xb6, 0xb6, 0x9a, 0x0e, 0xbe, 0x87,
-	0x9d, 0x7a, 0x7e, 0x41, 0x5b, 0x9c, 0x5a, 0x3a, 0xa9, 0x94, 0xfb, 0x5a, 0x1f, 0xfb, 0x26, 0x43,
-	0x36, 0x6a, 0xad, 0x04, 0x44, 0x7f, 0x5f, 0x83, 0x03, 0xcc, 0x60, 0xf6, 0x84, 0x62, 0xf4, 0x5f,
-	0x6a, 0x30, 0x77, 0xc3, 0x24, 0x7b, 0x63, 0x95, 0x8e, 0x02, 0x50, 0xbb, 0x83, 0x9b, 0x84, 0x9a,
-	0x9d, 0x2e, 0x5f, 0xa9, 0x49, 0xa3, 0xc4, 0x20, 0x6b, 0x0c, 0xa0, 0xbf, 0x09, 0x95, 0xab, 0x9e,
-	0xe7, 0x18, 0x98, 0x74, 0x3d, 0x97, 0x60, 0x74, 0x09, 0xf2, 0x84, 0x9a, 0xb4, 0x47, 0xa4, 0x90,
-	0x87, 0x95, 0x42, 0xae, 0x71, 0x14, 0x43, 0xa2, 0x32, 0x7b, 0xbd, 0x67, 0x3a, 0x3d, 0x21, 0x63,
-	0xd1, 0x10, 0x0d, 0xfd, 0x2d, 0x98, 0x5a, 0xa3, 0xbe, 0xed, 0xb6, 0x3f, 0x47, 0xe6, 0xa5, 0x80,
-	0xf9, 0x3f, 0x35, 0x38, 0xb4, 0x8c, 0x49, 0xcb, 0xb7, 0xd7, 0xf7, 0xc8, 0x76, 0xd0, 0xa1, 0xd2,
-	0x87, 0xac, 0x2c, 0x73, 0x55, 0x67, 0x8d, 0x18, 0x2c, 0xb1, 0x18, 0xb9, 0xe4, 0x62, 0x7c, 0x3a,
-	0x09, 0x0d, 0xd5, 0xa4, 0xc6, 0x51, 0xdf, 0x17, 0xc2, 0x5d, 0x9a, 0xe1, 0x44, 0x89, 0x3d, 0x26,
-	0xcf, 0x85, 0xfe, 0x68, 0x6b, 0x1c, 0x10, 0x6e, 0xe6, 0xe4, 0xac, 0xb2, 0x8a, 0x59, 0x2d, 0xc1,
-	0x81, 0x7b, 0xb6, 0x4f, 0x7b, 0xa6, 0xd3, 0x6c, 0x6d, 0x9a, 0xae, 0x8b, 0x1d, 0xae, 0x27, 0xe6,
-	0xbe, 0xb2, 0x8b, 0x25, 0x63, 0x56, 0x76, 0x5e, 0x13, 0x7d, 0x4c, 0x59, 0x04, 0x3d, 0x0d, 0xf3,
-	0xdd, 0xcd, 0x2d, 0x62, 0xb7, 0x06, 0x88, 0x72, 0x9c, 0x68, 0x2e, 0xe8, 0x8d, 0x51, 0x9d, 0x85,
-	0x99, 0x16, 0xf7, 0x80, 0x56, 0x93, 0x69, 0x4d, 0xa8, 0x31, 0xcf, 0xd5, 0x58, 0x93, 0x1d, 0xaf,
-	0x05, 0x70, 0x26, 0x56, 0x80, 0xdc, 0xa3, 0xad, 0x08, 0x41, 0x81, 0x13, 0xcc, 0xca, 0xce, 0xd7,
-	0x69, 0xab, 0x4f, 0x13, 0xf7, 0x5d, 0xc5, 0xa4, 0xef, 0xaa, 0x43, 0x81, 0xfb, 0x62, 0x4c, 0xea,
-	0x25, 0x2e, 0x66, 0xd0, 0x44, 0x2b, 0x30, 0x4d, 0xa8, 0xe9, 0xd3, 0x66, 0xd7, 0x23, 0x36, 0xd3,
-	0x0b, 0xa9, 0xc3, 0x42, 0x76, 0xb1, 0xbc, 0xb4, 0xa0, 0x5c, 0xa4, 0x57, 0xf0, 0xd6, 0xb2, 0x49,
-	0xcd, 0x55, 0xd3, 0xf6, 0x8d, 0x29, 0x4e, 0xb8, 0x1a, 0xd0, 0xa9, 0x1d, 0x64, 0x79, 0x7c, 0x07,
-	0x79, 0xd3, 0x33, 0xad, 0xbd, 0xe1, 0x20, 0x3f, 0xd4, 0xa0, 0x6e, 0x60, 0x07, 0x9b, 0x64, 0x6f,
-	0xec, 0x5d, 0xfd, 0x7b, 0x1a, 0x1c, 0xbb, 0x8e, 0x69, 0x64, 0x17, 0x50, 0x93, 0xda, 0x84, 0xda,
-	0xad, 0xdd, 0x8c, 0x03, 0xf4, 0x8f, 0x34, 0x38, 0x9e, 0x2a, 0xd6, 0x38, 0x4e, 0xe1, 0x39, 0xc8,
-	0xb1, 0x2f, 0x52, 0xcf, 0x70, 0x1b, 0x3d, 0x91, 0x66, 0xa3, 0x6f, 0x30, 0x5f, 0xcb, 0x8d, 0x54,
-	0xe0, 0xeb, 0xff, 0xd6, 0x60, 0x7e, 0x6d, 0xd3, 0xbb, 0xdf, 0x17, 0xe9, 0x51, 0x28, 0x28, 0xee,
-	0x26, 0xb3, 0x09, 0x37, 0x89, 0x2e, 0xc2, 0x24, 0xdd, 0xea, 0x62, 0xee, 0x61, 0xa7, 0x96, 0x8e,
-	0x9e, 0x57, 0x84, 0xbf, 0xe7, 0x99, 0x90, 0xaf, 0x6d, 0x75, 0xb1, 0xc1, 0x51, 0xd1, 0x69, 0xa8,
-	0x25, 0x54, 0x1e, 0x38, 0x9a, 0xe9, 0xb8, 0xce, 0x89, 0xfe, 0xfb, 0x0c, 0x1c, 0x1c, 0x98, 0xe2,
-	0x38, 0xca, 0x56, 0x8d, 0x9d, 0x51, 0x8e, 0x8d, 0x4e, 0x42, 0xc4, 0x04, 0x9a, 0xb6, 0xc5, 0x22,
-	0xd4, 0xec, 0x62, 0xd6, 0xa8, 0x46, 0xfc, 0xad, 0x45, 0xd0, 0x39, 0x40, 0x03, 0x6e, 0x50, 0x78,
-	0xdb, 0x49, 0x63, 0x26, 0xe9, 0x07, 0xb9, 0xaf, 0x55, 0x3a, 0x42, 0xa1, 0x82, 0x49, 0x63, 0x4e,
-	0xe1, 0x09, 0x09, 0xba, 0x08, 0x73, 0xb6, 0x7b, 0x0b, 0x77, 0x3c, 0x7f, 0xab, 0xd9, 0xc5, 0x7e,
-	0x0b, 0xbb, 0xd4, 0x6c, 0x63, 0x52, 0xcf, 0x73, 0x89, 0x66, 0x83, 0xbe, 0xd5, 0x7e, 0x97, 0xfe,
-	0x89, 0x06, 0xf3, 0x22, 0x42, 0x5d, 0x35, 0x7d, 0x6a, 0xef, 0xf6, 0x89, 0x7c, 0x12, 0xa6, 0xba,
-	0x81, 0x1c, 0x02, 0x4f, 0xc4, 0xd3, 0xd5, 0x10, 0xca, 0x77, 0xd9, 0xc7, 0x1a, 0xcc, 0xb1, 0xe0,
-	0x71, 0x3f, 0xc9, 0xfc, 0x2b, 0x0d, 0x66, 0x6f, 0x98, 0x64, 0x3f, 0x89, 0xfc, 0x1b, 0x79, 0x04,
-	0x85, 0x32, 0xef, 0xea, 0x15, 0xeb, 0x14, 0x4c, 0xc7, 0x85, 0x0e, 0xa2, 0x95, 0xa9, 0x98, 0xd4,
-	0x44, 0xff, 0x5d, 0xff, 0xac, 0xda, 0x67, 0x92, 0xff, 0x41, 0x83, 0xa3, 0xd7, 0x31, 0x0d, 0xa5,
-	0xde, 0x13, 0x67, 0xda, 0xa8, 0xd6, 0xf2, 0xa1, 0x38, 0x91, 0x95, 0xc2, 0xef, 0xca, 0xc9, 0xf7,
-	0x7e, 0x06, 0x0e, 0xb0, 0x63, 0x61, 0x6f, 0x18, 0xc1, 0x28, 0x97, 0x0d, 0x85, 0xa1, 0xe4, 0x54,
-	0x86, 0x12, 0x9e, 0xa7, 0xf9, 0x91, 0xcf, 0x53, 0xfd, 0xd7, 0x19, 0x11, 0x07, 0x44, 0xb5, 0x31,
-	0xce, 0xb2, 0x28, 0x64, 0xcd, 0x28, 0x65, 0xd5, 0xa1, 0x12, 0x42, 0x56, 0x96, 0x83, 0xf3, 0x31,
-	0x06, 0xdb, 0xb3, 0xc7, 0xe3, 0x07, 0x1a, 0xcc, 0x07, 0xd7, 0xbb, 0x35, 0xdc, 0xee, 0x60, 0x97,
-	0x3e, 0xbc, 0x0d, 0x25, 0x2d, 0x20, 0xa3, 0xb0, 0x80, 0x23, 0x50, 0x22, 0x62, 0x9c, 0xf0, 0xe6,
-	0xd6, 0x07, 0xe8, 0x7f, 0xd4, 0xe0, 0xe0, 0x80, 0x38, 0xe3, 0x2c, 0x62, 0x1d, 0x0a, 0xb6, 0x6b,
-	0xe1, 0x07, 0xa1, 0x34, 0x41, 0x93, 0xf5, 0xac, 0xf7, 0x6c, 0xc7, 0x0a, 0xc5, 0x08, 0x9a, 0xe8,
-	0x04, 0x54, 0xb0, 0x6b, 0xae, 0x3b, 0xb8, 0xc9, 0x71, 0xb9, 0x21, 0x17, 0x8d, 0xb2, 0x80, 0xad,
-	0x30, 0x10, 0x23, 0xde, 0xb0, 0x31, 0x27, 0xce, 0x09, 0x62, 0xd9, 0xd4, 0xbf, 0xad, 0xc1, 0x2c,
-	0xb3, 0x42, 0x29, 0x3d, 0x79, 0xb4, 0xda, 0x5c, 0x80, 0x72, 0xc4, 0xcc, 0xe4, 0x44, 0xa2, 0x20,
-	0xfd, 0x2e, 0xcc, 0xc5, 0xc5, 0x19, 0x47, 0x9b, 0xc7, 0x00, 0xc2, 0xb5, 0x12, 0xbb, 0x21, 0x6b,
-	0x44, 0x20, 0xfa, 0x67, 0x61, 0x12, 0x97, 0xab, 0x69, 0x97, 0x73, 0x4c, 0x7c, 0x49, 0xa2, 0xfe,
-	0xbc, 0xc4, 0x21, 0xbc, 0x7b, 0x19, 0x2a, 0xf8, 0x01, 0xf5, 0xcd, 0x66, 0xd7, 0xf4, 0xcd, 0x8e,
-	0xd8, 0x56, 0x23, 0xb9, 0xde, 0x32, 0x27, 0x5b, 0xe5, 0x54, 0xfa, 0x5f, 0x58, 0x98, 0x26, 0xcd,
-	0x75, 0xaf, 0xcf, 0xf8, 0x28, 0x00, 0x37, 0x67, 0xd1, 0x9d, 0x13, 0xdd, 0x1c, 0xc2, 0x0f, 0xb7,
-	0x5f, 0x68, 0x50, 0xe3, 0x53, 0x10, 0xf3, 0xe9, 0x32, 0xb6, 0x09, 0x1a, 0x2d, 0x41, 0x33, 0x64,
-	0x73, 0xfd, 0x1f, 0xe4, 0xa5, 0x62, 0xb3, 0xa3, 0x2a, 0x56, 0x12, 0x6c, 0x33, 0x0d, 0xfd, 0xa7,
-	0x1a, 0x1c, 0x48, 0xa8, 0x7c, 0x1c, 0x8b, 0x7e, 0x0d, 0x90, 0x98, 0xa1, 0xd5, 0x9f, 0x76, 0x70,
-	0x10, 0x9f, 0x54, 0x9e, 0x3a, 0x49, 0x25, 0x19, 0x33, 0x76, 0x02, 0x42, 0xf4, 0xbf, 0x6b, 0x70,
-	0xe4, 0x3a, 0xa6, 0x1c, 0xf5, 0x2a, 0xf3, 0x2a, 0xab, 0xbe, 0xd7, 0xf6, 0x31, 0x21, 0xfb, 0xd7,
-	0x3e, 0xbe, 0x2f, 0x22, 0x37, 0xd5, 0x94, 0xc6, 0xd1, 0xff, 0x09, 0xa8, 0xf0, 0x31, 0xb0, 0xd5,
-	0xf4, 0xbd, 0xfb, 0x44, 0xda, 0x51, 0x59, 0xc2, 0x0c, 0xef, 0x3e, 0x37, 0x08, 0xea, 0x51, 0xd3,
-	0x11, 0x08, 0xf2, 0xc8, 0xe0, 0x10, 0xd6, 0xcd, 0xf7, 0x60, 0x20, 0x18, 0x63, 0x8e, 0xf7, 0xaf,
-	0x8e, 0x7f, 0xae, 0xc1, 0x81, 0xc4, 0x54, 0xc6, 0xd1, 0xed, 0x33, 0x22, 0xae, 0x14, 0x93, 0x99,
-	0x5a, 0x3a, 0xae, 0xa4, 0x89, 0x0c, 0x26, 0xb0, 0xd1, 0x71, 0x28, 0x6f, 0x98, 0xb6, 0xd3, 0xf4,
-	0xb1, 0x49, 0x3c, 0x57, 0x4e, 0x14, 0x18, 0xc8, 0xe0, 0x10, 0xfd, 0xcf, 0x9a, 0x78, 0x0a, 0xdb,
-	0xe7, 0x1e, 0xef, 0x67, 0x19, 0xa8, 0xae, 0xb8, 0x04, 0xfb, 0x74, 0xef, 0xdf, 0x3d, 0xd0, 0x8b,
-	0x50, 0xe6, 0x13, 0x23, 0x4d, 0xcb, 0xa4, 0xa6, 0x3c, 0xae, 0x8e, 0x29, 0xf3, 0xe6, 0x2f, 0x33,
-	0xbc, 0x65, 0x93, 0x9a, 0x86, 0xd0, 0x0e, 0x61, 0xdf, 0xe8, 0x30, 0x94, 0x36, 0x4d, 0xb2, 0xd9,
-	0xbc, 0x8b, 0xb7, 0x44, 0x40, 0x58, 0x35, 0x8a, 0x0c, 0xf0, 0x0a, 0xde, 0x22, 0xe8, 0x10, 0x14,
-	0xdd, 0x5e, 0x47, 0x6c, 0xb0, 0xc2, 0x82, 0xb6, 0x58, 0x35, 0x0a, 0x6e, 0xaf, 0xc3, 0xb7, 0xd7,
-	0x5f, 0x33, 0x30, 0x75, 0xab, 0xc7, 0x6e, 0x3a, 0x3c, 0xeb, 0xdf, 0x73, 0xe8, 0xc3, 0x19, 0xe3,
-	0x19, 0xc8, 0x8a, 0x98, 0x81, 0x51, 0xd4, 0x95, 0x82, 0xaf, 0x2c, 0x13, 0x83, 0x21, 0xf1, 0x8c,
-	0x77, 0xaf, 0xd5, 0x92, 0xe1, 0x57, 0x96, 0x0b, 0x5b, 0x62, 0x10, 0x11, 0x7c, 0x1d, 0x86, 0x12,
-	0xf6, 0xfd, 0x30, 0x38, 0xe3, 0x53, 0xc1, 0xbe, 0x2f, 0x3a, 0x75, 0xa8, 0x98, 0xad, 0xbb, 0xae,
-	0x77, 0xdf, 0xc1, 0x56, 0x1b, 0x5b, 0x7c, 0xd9, 0x8b, 0x46, 0x0c, 0x26, 0x0c, 0x83, 0x2d, 0x7c,
-	0xb3, 0xe5, 0x52, 0x7e, 0xc5, 0xc8, 0x32, 0xc3, 0x60, 0x90, 0x6b, 0x2e, 0x65, 0xdd, 0x16, 0x76,
-	0x30, 0xc5, 0xbc, 0xbb, 0x20, 0xba, 0x05, 0x44, 0x76, 0xf7, 0xba, 0x21, 0x75, 0x51, 0x74, 0x0b,
-	0x08, 0xeb, 0x3e, 0x02, 0xa5, 0x7e, 0x5a, 0xbf, 0xd4, 0xcf, 0x13, 0x72, 0x80, 0xfe, 0x2f, 0x0d,
-	0xaa, 0xcb, 0x9c, 0xd5, 0x3e, 0x30, 0x3a, 0x04, 0x93, 0xf8, 0x41, 0xd7, 0x97, 0x5b, 0x87, 0x7f,
-	0x0f, 0xb5, 0x23, 0xfd, 0x1e, 0xd4, 0x56, 0x1d, 0xb3, 0x85, 0x37, 0x3d, 0xc7, 0xc2, 0x3e, 0x3f,
-	0xdb, 0x51, 0x0d, 0xb2, 0xd4, 0x6c, 0xcb, 0xe0, 0x81, 0x7d, 0xa2, 0xe7, 0xe5, 0xdd, 0x4e, 0xb8,
-	0xa5, 0xc7, 0x95, 0xa7, 0x6c, 0x84, 0x4d, 0x24, 0x65, 0x3a, 0x0f, 0x79, 0xfe, 0xd4, 0x26, 0xc2,
-	0x8a, 0x8a, 0x21, 0x5b, 0xfa, 0x9d, 0xd8, 0xb8, 0xd7, 0x7d, 0xaf, 0xd7, 0x45, 0x2b, 0x50, 0xe9,
-	0xf6, 0x61, 0xcc, 0x56, 0xd3, 0xcf, 0xf4, 0xa4, 0xd0, 0x46, 0x8c, 0x54, 0xff, 0x2c, 0x0b, 0xd5,
-	0x35, 0x6c, 0xfa, 0xad, 0xcd, 0xfd, 0x90, 0x64, 0x61, 0x1a, 0xb7, 0x88, 0x23, 0x57, 0x8d, 0x7d,
-	0xa2, 0xb3, 0x30, 0x13, 0x99, 0x50, 0xb3, 0xcd, 0x14, 0xc4, 0xed, 0xbe, 0x62, 0xd4, 0xba, 0x49,
-	0xc5, 0x3d, 0x07, 0x45, 0x8b, 0x38, 0x4d, 0xbe, 0x44, 0x05, 0xbe, 0x44, 0xea, 0xf9, 0x2d, 0x13,
-	0x87, 0x2f, 0x4d, 0xc1, 0x12, 0x1f, 0xe8, 0x31, 0xa8, 0x7a, 0x3d, 0xda, 0xed, 0xd1, 0xa6, 0xf0,
-	0x3b, 0xf5, 0x22, 0x17, 0xaf, 0x22, 0x80, 0xdc, 0x2d, 0x11, 0xf4, 0x32, 0x54, 0x09, 0x57, 0x65,
-	0x10, 0x79, 0x97, 0x46, 0x0d, 0x10, 0x2b, 0x82, 0x4e, 0x84, 0xde, 0xe8, 0x34, 0xd4, 0xa8, 0x6f,
-	0xde, 0xc3, 0x4e, 0xe4, 0x11, 0x0d, 0xf8, 0x6e, 0x9b, 0x16, 0xf0, 0xfe, 0x03, 0xda, 0x05, 0x98,
-	0x6d, 0xf7, 0x4c, 0xdf, 0x74, 0x29, 0xc6, 0x11, 0xec, 0x32, 0xc7, 0x46, 0x61, 0x57, 0x48, 0xa0,
-	0xbf, 0x02, 0x93, 0x37, 0x6c, 0xca, 0x15, 0xc9, 0x7c, 0x96, 0xc6, 0xef, 0x39, 0xdc, 0x33, 0x1d,
-	0x82, 0xa2, 0xef, 0xdd, 0x17, 0x3e, 0x38, 0xc3, 0x4d, 0xb0, 0xe0, 0x7b, 0xf7, 0xb9, 0x83, 0xe5,
-	0xa5, 0x07, 0x9e, 0x2f, 0x6d, 0x33, 0x63, 0xc8, 0x96, 0xfe, 0x9e, 0xd6, 0x37, 0x1e, 0xe6, 0x3e,
-	0xc9, 0xc3, 0xf9, 0xcf, 0x17, 0xa1, 0xe0, 0x0b, 0xfa, 0xa1, 0x8f, 0xa6, 0xd1, 0x91, 0xf8, 0x19,
-	0x10, 0x50, 0xe9, 0x5f, 0xd7, 0xa0, 0xf2, 0xb2, 0xd3, 0x23, 0x8f, 0xc2, 0x86, 0x55, 0xcf, 0x09,
-	0x59, 0xf5, 0x53, 0xc6, 0x77, 0x32, 0x50, 0x95, 0x62, 0x8c, 0x13, 0xdb, 0xa4, 0x8a, 0xb2, 0x06,
-	0x65, 0x36, 0x64, 0x93, 0xe0, 0x76, 0x90, 0x8b, 0x29, 0x2f, 0x2d, 0x29, 0x77, 0x7d, 0x4c, 0x0c,
-	0xfe, 0xdc, 0xbc, 0xc6, 0x89, 0xbe, 0xe4, 0x52, 0x7f, 0xcb, 0x80, 0x56, 0x08, 0x68, 0xdc, 0x81,
-	0xe9, 0x44, 0x37, 0xb3, 0x8d, 0xbb, 0x78, 0x2b, 0x70, 0x6b, 0x77, 0xf1, 0x16, 0x7a, 0x3a, 0x5a,
-	0x14, 0x90, 0x76, 0x38, 0xdf, 0xf4, 0xdc, 0xf6, 0x15, 0xdf, 0x37, 0xb7, 0x64, 0xd1, 0xc0, 0xe5,
-	0xcc, 0xf3, 0x9a, 0xfe, 0xa7, 0x0c, 0x54, 0x5e, 0xed, 0x61, 0x7f, 0x6b, 0x37, 0xdd, 0x4b, 0xe0,
-	0xec, 0x27, 0x23, 0xce, 0x7e, 0x60, 0x47, 0xe7, 0x14, 0x3b, 0x5a, 0xe1, 0x97, 0xf2, 0x4a, 0xbf,
-	0xa4, 0xda, 0xb2, 0x85, 0x1d, 0x6d, 0xd9, 0x62, 0xea, 0x96, 0x65, 0xd6, 0x2d, 0x55, 0x38, 0xd6,
-	0x26, 0x8b, 0x45, 0x59, 0x99, 0x9d, 0x46, 0x59, 0xfa, 0xc7, 0x1a, 0x94, 0xde, 0xc0, 0x2d, 0xea,
-	0xf9, 0xcc, 0x5b, 0x28, 0x74, 0xaf, 0x8d, 0x10, 0xc8, 0x66, 0x92, 0x81, 0xec, 0x25, 0x28, 0xda,
-	0x56, 0xd3, 0x64, 0x66, 0xc3, 0x17, 0x6f, 0x58, 0x00, 0x55, 0xb0, 0x2d, 0x6e, 0x5f, 0xa3, 0xe7,
-	0xe4, 0x7f, 0xa0, 0x41, 0x45, 0xc8, 0x4c, 0x04, 0xe5, 0x0b, 0x91, 0xe1, 0x34, 0x95, 0x2d, 0xcb,
-	0x46, 0x38, 0xd1, 0x1b, 0x13, 0xfd, 0x61, 0xaf, 0x00, 0x30, 0xdd, 0x49, 0x72, 0xb1, 0x15, 0x16,
-	0x94, 0xd2, 0x0a, 0x72, 0xae, 0xc7, 0x1b, 0x13, 0x46, 0x89, 0x51, 0x71, 0x16, 0x57, 0x0b, 0x90,
-	0xe3, 0xd4, 0xfa, 0x7f, 0x34, 0x98, 0xbd, 0x66, 0x3a, 0xad, 0x65, 0x9b, 0x50, 0xd3, 0x6d, 0x8d,
-	0x11, 0x32, 0x5d, 0x86, 0x82, 0xd7, 0x6d, 0x3a, 0x78, 0x83, 0x4a, 0x91, 0x4e, 0x0c, 0x99, 0x91,
-	0x50, 0x83, 0x91, 0xf7, 0xba, 0x37, 0xf1, 0x06, 0x45, 0xff, 0x0f, 0x45, 0xaf, 0xdb, 0xf4, 0xed,
-	0xf6, 0x26, 0x95, 0xda, 0x1f, 0x81, 0xb8, 0xe0, 0x75, 0x0d, 0x46, 0x11, 0xc9, 0x84, 0x4c, 0xee,
-	0x30, 0x13, 0xa2, 0xff, 0x63, 0x60, 0xfa, 0x63, 0x98, 0xf6, 0x65, 0x28, 0xda, 0x2e, 0x6d, 0x5a,
-	0x36, 0x09, 0x54, 0x70, 0x54, 0x6d, 0x43, 0x2e, 0xe5, 0x33, 0xe0, 0x6b, 0xea, 0x52, 0x36, 0x36,
-	0x7a, 0x09, 0x60, 0xc3, 0xf1, 0x4c, 0x49, 0x2d, 0x74, 0x70, 0x5c, 0xbd, 0x2b, 0x18, 0x5a, 0x40,
-	0x5f, 0xe2, 0x44, 0x8c, 0x43, 0x7f, 0x49, 0xff, 0xa6, 0xc1, 0x81, 0x55, 0xec, 0x8b, 0x4a, 0x10,
-	0x2a, 0xb3, 0x92, 0x2b, 0xee, 0x86, 0x17, 0x4f, 0x0c, 0x6b, 0x89, 0xc4, 0xf0, 0xe7, 0x93, 0x0c,
-	0x8d, 0xdd, 0x73, 0xc4, 0xf3, 0x44, 0x70, 0xcf, 0x09, 0x1e, 0x61, 0xc4, 0x3d, 0x71, 0x2a, 0x65,
-	0x99, 0xa4, 0xbc, 0xd1, 0xeb, 0xb2, 0xfe, 0x5d, 0x51, 0x10, 0xa1, 0x9c, 0xd4, 0xc3, 0x1b, 0xec,
-	0x3c, 0x48, 0x07, 0x9e, 0x70, 0xe7, 0x4f, 0x40, 0xc2, 0x77, 0xa4, 0x94, 0x69, 0xfc, 0x48, 0x83,
-	0x85, 0x74, 0xa9, 0xc6, 0x39, 0x79, 0x5f, 0x82, 0x9c, 0xed, 0x6e, 0x78, 0x41, 0x92, 0xec, 0x8c,
-	0x3a, 0xa0, 0x56, 0x8e, 0x2b, 0x08, 0xf5, 0xdf, 0x66, 0xa0, 0xc6, 0x7d, 0xf5, 0x2e, 0x2c, 0x7f,
-	0x07, 0x77, 0x9a, 0xc4, 0x7e, 0x07, 0x07, 0xcb, 0xdf, 0xc1, 0x9d, 0x35, 0xfb, 0x1d, 0x1c, 0xb3,
-	0x8c, 0x5c, 0xdc, 0x32, 0xe2, 0x69, 0x84, 0xfc, 0x90, 0x24, 0x68, 0x21, 0x9e, 0x04, 0x9d, 0x87,
-	0xbc, 0xeb, 0x59, 0x78, 0x65, 0x59, 0x5e, 0x12, 0x65, 0xab, 0x6f, 0x6a, 0xa5, 0x1d, 0x9a, 0xda,
-	0x87, 0x1a, 0x34, 0xae, 0x63, 0x9a, 0xd4, 0xdd, 0xee, 0x59, 0xd9, 0x47, 0x1a, 0x1c, 0x56, 0x0a,
-	0x34, 0x8e, 0x81, 0xbd, 0x10, 0x37, 0x30, 0xf5, 0x8d, 0x6d, 0x60, 0x48, 0x69, 0x5b, 0x17, 0xa1,
-	0xb2, 0xdc, 0xeb, 0x74, 0xc2, 0x48, 0xea, 0x04, 0x54, 0x7c, 0xf1, 0x29, 0x2e, 0x34, 0xe2, 0xfc,
-	0x2d, 0x4b, 0x18, 0xbb, 0xb6, 0xe8, 0x67, 0xa1, 0x2a, 0x49, 0xa4, 0xd4, 0x0d, 0x28, 0xfa, 0xf2,
-	0x5b, 0xe2, 0x87, 0x6d, 0xfd, 0x00, 0xcc, 0x1a, 0xb8, 0xcd, 0x4c, 0xdb, 0xbf, 0x69, 0xbb, 0x77,
-	0xe5, 0x30, 0xfa, 0xbb, 0x1a, 0xcc, 0xc5, 0xe1, 0x92, 0xd7, 0xb3, 0x50, 0x30, 0x2d, 0xcb, 0xc7,
-	0x84, 0x0c, 0x5d, 0x96, 0x2b, 0x02, 0xc7, 0x08, 0x90, 0x23, 0x9a, 0xcb, 0x8c, 0xac, 0x39, 0xbd,
-	0x09, 0x33, 0xd7, 0x31, 0xbd, 0x85, 0xa9, 0x3f, 0xd6, 0x83, 0x7a, 0x9d, 0x5d, 0x35, 0x38, 0xb1,
-	0x34, 0x8b, 0xa0, 0xa9, 0x7f, 0xa0, 0x01, 0x8a, 0x8e, 0x30, 0xce, 0x32, 0x47, 0xb5, 0x9c, 0x89,
-	0x6b, 0x59, 0xd4, 0x1c, 0x75, 0xba, 0x9e, 0x8b, 0x5d, 0x1a, 0x8d, 0x59, 0xab, 0x21, 0x94, 0x9b,
-	0xdf, 0x27, 0x1a, 0xa0, 0x9b, 0x9e, 0x69, 0x5d, 0x35, 0x9d, 0xf1, 0xc2, 0x83, 0xa3, 0x00, 0xc4,
-	0x6f, 0x35, 0xe5, 0x6e, 0xcd, 0x48, 0xef, 0xe3, 0xb7, 0x6e, 0x8b, 0x0d, 0x7b, 0x1c, 0xca, 0x16,
-	0xa1, 0xb2, 0x3b, 0x78, 0xdf, 0x05, 0x8b, 0x50, 0xd1, 0xcf, 0x6b, 0x40, 0x09, 0x36, 0x1d, 0x6c,
-	0x35, 0x23, 0xcf, 0x63, 0x93, 0x1c, 0xad, 0x26, 0x3a, 0xd6, 0xfa, 0x8f, 0x64, 0x77, 0xe0, 0xe0,
-	0x2d, 0xd3, 0xed, 0x99, 0xce, 0x35, 0xaf, 0xd3, 0x35, 0x63, 0x75, 0x86, 0x49, 0x37, 0xa7, 0x29,
-	0xdc, 0xdc, 0x31, 0x51, 0x88, 0x26, 0x22, 0x66, 0x2e, 0xeb, 0xa4, 0x11, 0x81, 0xe8, 0x04, 0xea,
-	0x83, 0xec, 0xc7, 0x59, 0x28, 0x2e, 0x54, 0xc0, 0x2a, 0xea, 0x7b, 0xfb, 0x30, 0xfd, 0x45, 0x38,
-	0xc4, 0x8b, 0x02, 0x03, 0x50, 0x2c, 0x11, 0x9f, 0x64, 0xa0, 0x29, 0x18, 0x7c, 0x33, 0xc3, 0x5d,
-	0xdb, 0x00, 0x87, 0x71, 0x04, 0xbf, 0x1c, 0xcf, 0x7f, 0x3f, 0x9e, 0x52, 0xa8, 0x1a, 0x1f, 0x51,
-	0x26, 0xc1, 0x17, 0x61, 0x1a, 0x3f, 0xc0, 0xad, 0x1e, 0xb5, 0xdd, 0xf6, 0xaa, 0x63, 0xba, 0xb7,
-	0x3d, 0x79, 0xa0, 0x24, 0xc1, 0xe8, 0x71, 0xa8, 0x32, 0xed, 0x7b, 0x3d, 0x2a, 0xf1, 0xc4, 0xc9,
-	0x12, 0x07, 0x32, 0x7e, 0x6c, 0xbe, 0x0e, 0xa6, 0xd8, 0x92, 0x78, 0xe2, 0x98, 0x49, 0x82, 0x07,
-	0x54, 0xc9, 0xc0, 0x64, 0x27, 0xaa, 0xfc, 0x54, 0x4b, 0xa8, 0x52, 0x72, 0xd8, 0x2d, 0x55, 0xde,
-	0x00, 0xe8, 0x60, 0xbf, 0x8d, 0x57, 0xb8, 0x53, 0x17, 0x17, 0xf2, 0x45, 0xa5, 0x53, 0xef, 0x33,
-	0xb8, 0x15, 0x10, 0x18, 0x11, 0x5a, 0xfd, 0x3a, 0xcc, 0x2a, 0x50, 0x98, 0xbf, 0x22, 0x5e, 0xcf,
-	0x6f, 0xe1, 0x20, 0x55, 0x13, 0x34, 0xd9, 0xf9, 0x46, 0x4d, 0xbf, 0x8d, 0xa9, 0x34, 0x5a, 0xd9,
-	0xd2, 0x9f, 0xe5, 0x4f, 0x46, 0xfc, 0xfe, 0x1f, 0xb3, 0xd4, 0xf8, 0xfb, 0xb6, 0x36, 0xf0, 0xbe,
-	0xbd, 0xc1, 0xdf, 0x67, 0xa2, 0x74, 0x63, 0xd6, 0x26, 0x6c, 0x30, 0x56, 0xd8, 0x92, 0x3f, 0x29,
-	0x04, 0xcd, 0x33, 0x27, 0xa0, 0x18, 0x14, 0xb7, 0xa0, 0x02, 0x64, 0xaf, 0x38, 0x4e, 0x6d, 0x02,
-	0x55, 0xa0, 0xb8, 0x22, 0x2b, 0x38, 0x6a, 0xda, 0x99, 0x2f, 0xc2, 0x74, 0x22, 0x47, 0x8a, 0x8a,
-	0x30, 0x79, 0xdb, 0x73, 0x71, 0x6d, 0x02, 0xd5, 0xa0, 0x72, 0xd5, 0x76, 0x4d, 0x7f, 0x4b, 0xdc,
-	0x49, 0x6a, 0x16, 0x9a, 0x86, 0x32, 0x8f, 0xcd, 0x25, 0x00, 0x2f, 0xbd, 0x77, 0x1c, 0xaa, 0xb7,
-	0xb8, 0x8c, 0x6b, 0xd8, 0xbf, 0x67, 0xb7, 0x30, 0x6a, 0x42, 0x2d, 0xf9, 0x2b, 0x0f, 0x7a, 0x52,
-	0xbd, 0x4e, 0xea, 0x3f, 0x7e, 0x1a, 0xc3, 0x66, 0xad, 0x4f, 0xa0, 0xb7, 0x60, 0x2a, 0xfe, 0x43,
-	0x0c, 0x52, 0x07, 0x8f, 0xca, 0xbf, 0x66, 0xb6, 0x63, 0xde, 0x84, 0x6a, 0xec, 0xff, 0x16, 0x74,
-	0x5a, 0xc9, 0x5b, 0xf5, 0x0f, 0x4c, 0x43, 0x7d, 0x9f, 0x8b, 0xfe, 0x83, 0x22, 0xa4, 0x8f, 0x57,
-	0xab, 0xa7, 0x48, 0xaf, 0x2c, 0x69, 0xdf, 0x4e, 0x7a, 0x13, 0x66, 0x06, 0x8a, 0xcf, 0xd1, 0x39,
-	0x25, 0xff, 0xb4, 0x22, 0xf5, 0xed, 0x86, 0xb8, 0x0f, 0x68, 0xf0, 0x3f, 0x0e, 0x74, 0x5e, 0xbd,
-	0x02, 0x69, 0x7f, 0xb1, 0x34, 0x2e, 0x8c, 0x8c, 0x1f, 0x2a, 0xee, 0x1b, 0x1a, 0x1c, 0x4c, 0xa9,
-	0x18, 0x47, 0x97, 0x94, 0xec, 0x86, 0x97, 0xbd, 0x37, 0x9e, 0xde, 0x19, 0x51, 0x28, 0x88, 0x0b,
-	0xd3, 0x89, 0x22, 0x6a, 0x74, 0x36, 0xb5, 0xb0, 0x6c, 0xb0, 0x9a, 0xbc, 0xf1, 0xe4, 0x68, 0xc8,
-	0xe1, 0x78, 0x77, 0x60, 0x3a, 0x51, 0x79, 0x9c, 0x32, 0x9e, 0xba, 0x3e, 0x79, 0xbb, 0x05, 0x7d,
-	0x13, 0xaa, 0xb1, 0x12, 0xe1, 0x14, 0x8b, 0x57, 0x95, 0x11, 0x6f, 0xc7, 0xfa, 0x0e, 0x54, 0xa2,
-	0x95, 0xbc, 0x68, 0x31, 0x6d, 0x2f, 0x0d, 0x30, 0xde, 0xc9, 0x56, 0xea, 0x17, 0xea, 0x0d, 0xd9,
-	0x4a, 0x03, 0xb5, 0x8d, 0xa3, 0x6f, 0xa5, 0x08, 0xff, 0xa1, 0x5b, 0x69, 0xc7, 0x43, 0xbc, 0xab,
-	0xc1, 0xbc, 0xba, 0x10, 0x14, 0x2d, 0xa5, 0xd9, 0x66, 0x7a, 0xc9, 0x6b, 0xe3, 0xd2, 0x8e, 0x68,
-	0x42, 0x2d, 0xde, 0x85, 0xa9, 0x78, 0xb9, 0x63, 0x8a, 0x16, 0x95, 0x15, 0xa2, 0x8d, 0xb3, 0x23,
-	0xe1, 0x86, 0x83, 0xbd, 0x0e, 0xe5, 0xc8, 0xdf, 0xb9, 0xe8, 0xd4, 0x10, 0x3b, 0x8e, 0xfe, 0xaa,
-	0xba, 0x9d, 0x26, 0x5f, 0x85, 0x52, 0xf8, 0x53, 0x2d, 0x3a, 0x99, 0x6a, 0xbf, 0x3b, 0x61, 0xb9,
-	0x06, 0xd0, 0xff, 0x63, 0x16, 0x3d, 0xa1, 0xe4, 0x39, 0xf0, 0x4b, 0xed, 0x76, 0x4c, 0xc3, 0xe9,
-	0x8b, 0x47, 0xe6, 0x61, 0xd3, 0x8f, 0x56, 0x45, 0x6c, 0xc7, 0x76, 0x13, 0xaa, 0xb1, 0x5a, 0xa6,
-	0xb4, 0x2d, 0xac, 0x28, 0x31, 0x6b, 0x9c, 0x19, 0x05, 0x35, 0x5c, 0xbf, 0x4d, 0xa8, 0xc6, 0x2a,
-	0x4b, 0x52, 0x46, 0x52, 0x15, 0xd2, 0xa4, 0x8c, 0xa4, 0x2c, 0x54, 0xd1, 0x27, 0xd0, 0xd7, 0x22,
-	0x45, 0x2c, 0xb1, 0x42, 0x21, 0x74, 0x71, 0x28, 0x1f, 0x55, 0x9d, 0x54, 0x63, 0x69, 0x27, 0x24,
-	0xa1, 0x08, 0xd2, 0xaa, 0x84, 0x4a, 0xd3, 0xad, 0x6a, 0x27, 0x2b, 0xb5, 0x06, 0x79, 0x51, 0x2b,
-	0x82, 0xf4, 0x94, 0xaa, 0xb0, 0x48, 0x21, 0x49, 0xe3, 0x31, 0x25, 0x4e, 0xbc, 0x8c, 0x42, 0x30,
-	0x15, 0xb5, 0x00, 0x29, 0x4c, 0x63, 0x85, 0x02, 0xa3, 0x32, 0x35, 0x20, 0x2f, 0x1e, 0x01, 0x53,
-	0x98, 0xc6, 0x1e, 0xb2, 0x1b, 0xc3, 0x71, 0xc4, 0xcb, 0xe1, 0x04, 0x5a, 0x85, 0x1c, 0x0f, 0x7a,
-	0xd1, 0x89, 0x61, 0x0f, 0x69, 0xc3, 0x38, 0xc6, 0xde, 0xda, 0xf4, 0x09, 0xf4, 0x65, 0xc8, 0xf1,
-	0x14, 0x4e, 0x0a, 0xc7, 0xe8, 0x6b, 0x58, 0x63, 0x28, 0x4a, 0x20, 0xa2, 0x05, 0x95, 0x68, 0xae,
-	0x3c, 0xe5, 0xc8, 0x52, 0xbc, 0x26, 0x34, 0x46, 0xc1, 0x0c, 0x46, 0x11, 0xdb, 0xa8, 0x7f, 0x01,
-	0x48, 0xdf, 0x46, 0x03, 0x97, 0x8b, 0xf4, 0x6d, 0x34, 0x78, 0x9f, 0xd0, 0x27, 0xd0, 0xb7, 0x34,
-	0xa8, 0xa7, 0x25, 0x70, 0x51, 0x6a, 0x04, 0x34, 0x2c, 0x0b, 0xdd, 0x78, 0x66, 0x87, 0x54, 0xa1,
-	0x2c, 0xef, 0xc0, 0xac, 0x22, 0xcb, 0x87, 0x2e, 0xa4, 0xf1, 0x4b, 0x49, 0x50, 0x36, 0x9e, 0x1a,
-	0x9d, 0x20, 0x1c, 0x7b, 0x15, 0x72, 0x3c, 0x3b, 0x97, 0x62, 0x28, 0xd1, 0x64, 0x5f, 0x8a, 0xe9,
-	0xc5, 0x92, 0x7b, 0xfa, 0x04, 0xc2, 0x50, 0x89, 0xa6, 0xea, 0x52, 0x2c, 0x45, 0x91, 0xe5, 0x6b,
-	0x9c, 0x1e, 0x01, 0x33, 0x1c, 0xa6, 0x09, 0xd0, 0x4f, 0x95, 0xa5, 0x9c, 0x43, 0x03, 0xd9, 0xba,
-	0xc6, 0xa9, 0x6d, 0xf1, 0xa2, 0x47, 0x72, 0x24, 0xf9, 0x95, 0x72, 0x26, 0x0d, 0xa6, 0xc7, 0x46,
-	0xb8, 0x27, 0x0c, 0x26, 0x62, 0x52, 0xee, 0x09, 0xa9, 0x39, 0x9f, 0xc6, 0x85, 0x91, 0xf1, 0xc3,
-	0xf9, 0xbc, 0x0d, 0xb5, 0x64, 0xe2, 0x2a, 0xe5, 0xfe, 0x99, 0x92, 0x3e, 0x6b, 0x9c, 0x1b, 0x11,
-	0x3b, 0x7a, 0x56, 0x1d, 0x1e, 0x94, 0xe9, 0x2b, 0x36, 0xdd, 0xe4, 0x39, 0x93, 0x51, 0x66, 0x1d,
-	0x4d, 0xcf, 0x8c, 0x32, 0xeb, 0x58, 0x32, 0x46, 0x9f, 0x58, 0xea, 0x41, 0x65, 0xd5, 0xf7, 0x1e,
-	0x6c, 0x05, 0xb7, 0xf0, 0xff, 0x8d, 0x75, 0x5e, 0x7d, 0xe6, 0xab, 0x97, 0xda, 0x36, 0xdd, 0xec,
-	0xad, 0xb3, 0xf5, 0xbf, 0x20, 0x70, 0xcf, 0xd9, 0x9e, 0xfc, 0xba, 0x60, 0xbb, 0x14, 0xfb, 0xae,
-	0xe9, 0x5c, 0xe0, 0xbc, 0x24, 0xb4, 0xbb, 0xbe, 0x9e, 0xe7, 0xed, 0x4b, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x86, 0x01, 0x16, 0x1c, 0xed, 0x45, 0x00, 0x00,
+	// 4800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6f, 0x24, 0xc7,
+	0x75, 0xec, 0x19, 0xce, 0xd7, 0x9b, 0x19, 0x72, 0x58, 0xfc, 0xd8, 0xd1, 0xac, 0x56, 0xbb, 0xdb,
+	0xd2, 0x5a, 0xd4, 0xae, 0x77, 0xd7, 0xe2, 0xea, 0xcb, 0x92, 0x6c, 0x69, 0x77, 0x69, 0x71, 0x09,
+	0xed, 0xae, 0xe8, 0xa6, 0xd6, 0x86, 0x23, 0x28, 0x93, 0xe6, 0x74, 0x71, 0xd8, 0x66, 0x4f, 0xf7,
+	0xb8, 0xab, 0x86, 0x5c, 0xea, 0x64, 0x44, 0x8e, 0x92, 0xc0, 0xb6, 0x8c, 0x20, 0x81, 0x83, 0x18,
+	0x48, 0x0e, 0x89, 0x7d, 0xf0, 0x21, 0x40, 0x6c, 0x07, 0x71, 0x10, 0x20, 0x08, 0x02, 0xf8, 0x90,
+	0x43, 0x80, 0x7c, 0x5c, 0x72, 0xc8, 0x25, 0x7f, 0xc0, 0xff, 0x20, 0x87, 0xa0, 0x3e, 0xfa, 0x73,
+	0xaa, 0x87, 0xc3, 0x1d, 0xd1, 0x24, 0x81, 0xdc, 0xba, 0x5e, 0xbf, 0xaa, 0x7a, 0xf5, 0xea, 0x7d,
+	0x54, 0xd5, 0x7b, 0x55, 0x50, 0xeb, 0xd9, 0xce, 0xde, 0x80, 0xdc, 0xe8, 0xfb, 0x1e, 0xf5, 0xd0,
+	0x7c, 0xbc, 0x74, 0x43, 0x14, 0x5a, 0xb5, 0x8e, 0xd7, 0xeb, 0x79, 0xae, 0x00, 0xb6, 0x6a, 0xa4,
+	0xb3, 0x83, 0x7b, 0xa6, 0x28, 0xe9, 0x7f, 0xa1, 0x01, 0xba, 0xeb, 0x63, 0x93, 0xe2, 0xdb, 0x8e,
+	0x6d, 0x12, 0x03, 0x7f, 0x6b, 0x80, 0x09, 0x45, 0x5f, 0x80, 0xe9, 0x2d, 0x93, 0xe0, 0xa6, 0x76,
+	0x49, 0x5b, 0xae, 0xae, 0x3c, 0x7d, 0x23, 0xd1, 0xac, 0x6c, 0xee, 0x01, 0xe9, 0xde, 0x31, 0x09,
+	0x36, 0x38, 0x26, 0x3a, 0x07, 0x25, 0x6b, 0xab, 0xed, 0x9a, 0x3d, 0xdc, 0xcc, 0x5d, 0xd2, 0x96,
+	0x2b, 0x46, 0xd1, 0xda, 0x7a, 0x68, 0xf6, 0x30, 0x7a, 0x1e, 0x66, 0x3b, 0x9e, 0xe3, 0xe0, 0x0e,
+	0xb5, 0x3d, 0x57, 0x20, 0xe4, 0x39, 0xc2, 0x4c, 0x04, 0xe6, 0x88, 0x0b, 0x50, 0x30, 0x19, 0x0d,
+	0xcd, 0x69, 0xfe, 0x5b, 0x14, 0x74, 0x02, 0x8d, 0x55, 0xdf, 0xeb, 0x1f, 0x17, 0x75, 0x61, 0xa7,
+	0xf9, 0x78, 0xa7, 0x7f, 0xae, 0xc1, 0xdc, 0x6d, 0x87, 0x62, 0xff, 0x94, 0x32, 0x65, 0x0b, 0x16,
+	0xc5, 0xa4, 0xad, 0x9a, 0xd4, 0x64, 0x3d, 0x7d, 0xf6, 0x24, 0xea, 0xbf, 0x03, 0xf3, 0x8c, 0xf1,
+	0xc7, 0xd8, 0xc3, 0x3d, 0x58, 0xb8, 0x6f, 0x13, 0x1a, 0xf4, 0xf0, 0xe4, 0x7c, 0xd6, 0x7f, 0xa8,
+	0xc1, 0x62, 0xaa, 0x29, 0xd2, 0xf7, 0x5c, 0x82, 0xd1, 0x2d, 0x28, 0x12, 0x6a, 0xd2, 0x01, 0x91,
+	0xad, 0x9d, 0x57, 0xb6, 0xb6, 0xc9, 0x51, 0x0c, 0x89, 0x8a, 0x9e, 0x82, 0xb2, 0xa4, 0x98, 0x34,
+	0x73, 0x97, 0xf2, 0xcb, 0x15, 0xa3, 0x24, 0x48, 0x26, 0xe8, 0x3a, 0xa0, 0x0e, 0xe7, 0xbc, 0xd5,
+	0xa6, 0x76, 0x0f, 0x13, 0x6a, 0xf6, 0xfa, 0x4c, 0x78, 0xf2, 0xcb, 0xd3, 0xc6, 0x9c, 0xfc, 0xf3,
+	0x7e, 0xf8, 0x43, 0xff, 0x58, 0x83, 0x73, 0x62, 0xa6, 0xee, 0xfa, 0xd8, 0xc2, 0x2e, 0xb5, 0x4d,
+	0xe7, 0xc9, 0x39, 0xd9, 0x82, 0xf2, 0x80, 0x60, 0x3f, 0xc6, 0xca, 0xb0, 0xcc, 0xfe, 0xf5, 0x4d,
+	0x42, 0xf6, 0x3d, 0xdf, 0x92, 0xa2, 0x14, 0x96, 0xf5, 0xbf, 0xd6, 0xe0, 0xdc, 0xa3, 0xbe, 0xf5,
+	0x1b, 0xa0, 0xe2, 0x32, 0xd4, 0x3c, 0xc7, 0x6a, 0xa7, 0x28, 0xa9, 0x7a, 0x8e, 0xb5, 0x21, 0x41,
+	0x0c, 0xc5, 0xc5, 0xfb, 0x11, 0x8a, 0x10, 0xec, 0xaa, 0x8b, 0xf7, 0x03, 0x14, 0xbd, 0x0b, 0xe7,
+	0x56, 0xb1, 0x83, 0x8f, 0x9d, 0xdc, 0x40, 0x02, 0x59, 0x37, 0x8f, 0x08, 0xf6, 0x27, 0x90, 0xc0,
+	0x6f, 0x0a, 0x01, 0x8c, 0xb5, 0x34, 0x89, 0x00, 0x3e, 0x0d, 0x95, 0x80, 0xc6, 0x40, 0x02, 0x23,
+	0x80, 0xbe, 0x05, 0x73, 0x42, 0xa6, 0x0c, 0xcf, 0x99, 0x40, 0x2f, 0xcf, 0x43, 0xc5, 0xf7, 0x1c,
+	0x1c, 0xd7, 0xcc, 0x32, 0x03, 0x48, 0xed, 0x9f, 0x65, 0xda, 0x7f, 0x8c, 0x3d, 0xfc, 0xb3, 0x06,
+	0x4b, 0xef, 0xf5, 0xb1, 0x6f, 0x52, 0xcc, 0x38, 0x36, 0x59, 0x4f, 0xa3, 0x64, 0x32, 0x41, 0x45,
+	0x3e, 0x49, 0x05, 0x7a, 0x13, 0xa6, 0xe9, 0x41, 0x1f, 0x73, 0x29, 0x9c, 0x59, 0x59, 0xbe, 0xa1,
+	0xf0, 0x9f, 0x37, 0x52, 0x54, 0xbe, 0x7f, 0xd0, 0xc7, 0x06, 0xaf, 0xa5, 0x7f, 0xaa, 0xc1, 0xdc,
+	0x26, 0x66, 0xf6, 0xfa, 0xf8, 0x18, 0x85, 0xae, 0xc2, 0x9c, 0xed, 0x76, 0x9c, 0x81, 0x85, 0xdb,
+	0x6c, 0x4c, 0x6d, 0xdb, 0xdd, 0xf6, 0xf8, 0x38, 0xca, 0xc6, 0xac, 0xfc, 0xc1, 0x48, 0x5b, 0x77,
+	0xb7, 0x3d, 0x7d, 0x0d, 0x40, 0x50, 0x42, 0x06, 0x0e, 0x4d, 0x36, 0xab, 0xa5, 0x9a, 0x1d, 0x2d,
+	0x63, 0xdf, 0xd1, 0x00, 0xc5, 0x47, 0x36, 0x89, 0x34, 0x7f, 0x11, 0x4a, 0x3e, 0x27, 0x48, 0xf4,
+	0x53, 0x5d, 0xb9, 0xa8, 0x64, 0x73, 0x44, 0xb8, 0x11, 0xe0, 0xeb, 0xdf, 0x0f, 0x19, 0xcc, 0xb9,
+	0x7f, 0x2c, 0xf2, 0x11, 0xe3, 0x2f, 0xe7, 0x96, 0x82, 0xbf, 0x8c, 0xb4, 0x80, 0xbf, 0x82, 0x10,
+	0xce, 0xdf, 0x78, 0xab, 0x5a, 0xaa, 0xd5, 0x0b, 0x00, 0x21, 0xef, 0x43, 0xfe, 0x06, 0xcc, 0x8f,
+	0xf3, 0x57, 0xb6, 0x77, 0xfc, 0xfc, 0x8d, 0x08, 0x8f, 0xf8, 0xfb, 0x63, 0x0d, 0xaa, 0x6b, 0xbe,
+	0xe9, 0xd2, 0xaf, 0xb8, 0xd4, 0xa6, 0x07, 0xa3, 0x25, 0xe6, 0x22, 0x54, 0xbd, 0xad, 0x6f, 0xe2,
+	0x0e, 0x6d, 0x73, 0x95, 0x11, 0x7c, 0x04, 0x01, 0x62, 0x4a, 0x11, 0x43, 0x88, 0xe9, 0x9a, 0x44,
+	0x08, 0x64, 0xae, 0xef, 0xdb, 0x7b, 0xb6, 0x83, 0xbb, 0x58, 0x1a, 0xfe, 0x08, 0x80, 0x9a, 0x50,
+	0xea, 0x32, 0x5a, 0x3c, 0xbf, 0x59, 0xe0, 0xff, 0x82, 0xa2, 0xfe, 0x2b, 0x0d, 0xce, 0x49, 0x2d,
+	0xdc, 0x08, 0xd0, 0x9f, 0x5c, 0x18, 0x5e, 0x83, 0x22, 0xe6, 0xc3, 0xe5, 0x43, 0xa8, 0xae, 0x5c,
+	0x52, 0xb2, 0x2b, 0xc6, 0x16, 0x43, 0xe2, 0xa3, 0x2f, 0x49, 0x6b, 0x91, 0xe7, 0xd6, 0xe2, 0x85,
+	0x51, 0xd6, 0x22, 0xa4, 0x33, 0x66, 0x2e, 0xbe, 0x1d, 0x4e, 0x3a, 0x6f, 0xfc, 0x04, 0x46, 0xa0,
+	0xff, 0x81, 0x06, 0xf3, 0x09, 0x12, 0x26, 0x11, 0xbc, 0x37, 0xa1, 0xcc, 0x9b, 0xb5, 0x71, 0x20,
+	0x79, 0x87, 0x13, 0x12, 0xd6, 0xd0, 0x7f, 0x37, 0x1f, 0xae, 0x8d, 0xc2, 0x35, 0xef, 0x49, 0x2e,
+	0xb5, 0x97, 0xa0, 0x28, 0xb6, 0x46, 0x5c, 0x32, 0x6b, 0x86, 0x2c, 0x31, 0x4d, 0x26, 0x3b, 0xa6,
+	0x6f, 0x91, 0xb6, 0x3b, 0xe8, 0x71, 0xc9, 0x2c, 0x18, 0x15, 0x01, 0x79, 0x38, 0xe8, 0x21, 0x03,
+	0xe6, 0x3a, 0x9e, 0x4b, 0x6c, 0x42, 0xb1, 0xdb, 0x39, 0x68, 0x3b, 0x78, 0x0f, 0x3b, 0xcd, 0x22,
+	0x17, 0x90, 0x2b, 0x4a, 0xba, 0xef, 0x46, 0xd8, 0xf7, 0x19, 0xb2, 0xd1, 0xe8, 0xa4, 0x20, 0xe8,
+	0x36, 0x40, 0xdf, 0xf7, 0xfa, 0xd8, 0xe7, 0xac, 0x2d, 0x71, 0xd6, 0x5e, 0x56, 0x36, 0xf6, 0x2e,
+	0x3e, 0xf8, 0x9a, 0xe9, 0x0c, 0xf0, 0x86, 0x69, 0xfb, 0x46, 0xac, 0x12, 0xba, 0x02, 0x33, 0xee,
+	0xa0, 0xd7, 0xee, 0x9b, 0x3e, 0x63, 0xb7, 0xe7, 0x92, 0x66, 0xf9, 0x92, 0xb6, 0x9c, 0x37, 0xea,
+	0xee, 0xa0, 0xb7, 0x11, 0x02, 0xf5, 0xef, 0x6a, 0xb0, 0xc8, 0x1c, 0xfd, 0xa9, 0x98, 0x02, 0xfd,
+	0xa7, 0x1a, 0x2c, 0xdc, 0x33, 0xc9, 0xe9, 0x90, 0x87, 0x0b, 0x00, 0x6c, 0x89, 0xdf, 0xe6, 0x4b,
+	0x79, 0x2e, 0x13, 0xd3, 0x46, 0x85, 0x41, 0x36, 0x19, 0x40, 0xff, 0x06, 0xd4, 0xee, 0x78, 0x9e,
+	0x33, 0x99, 0x06, 0x2d, 0x40, 0x61, 0x8f, 0x4d, 0x1f, 0xa7, 0xb1, 0x6c, 0x88, 0x82, 0xfe, 0x01,
+	0xcc, 0x6c, 0x52, 0xdf, 0x76, 0xbb, 0x9f, 0x61, 0xe3, 0x95, 0xa0, 0xf1, 0x7f, 0xd4, 0x60, 0xf6,
+	0xb6, 0x65, 0xbd, 0x63, 0x63, 0xc7, 0x3a, 0x49, 0xf6, 0xbe, 0x02, 0x85, 0x6d, 0x46, 0x03, 0xe7,
+	0xec, 0x90, 0xe5, 0x90, 0x87, 0x14, 0x9c, 0xca, 0x4d, 0xfe, 0x6d, 0x08, 0x74, 0xfd, 0x3f, 0x35,
+	0x78, 0x6a, 0x15, 0x93, 0x8e, 0x6f, 0x6f, 0x9d, 0x12, 0xc3, 0xa1, 0x43, 0x2d, 0x82, 0xac, 0xaf,
+	0xf2, 0x01, 0xe5, 0x8d, 0x04, 0x2c, 0x25, 0x4c, 0x85, 0xb4, 0x30, 0xfd, 0xa8, 0x00, 0x2d, 0xd5,
+	0xa0, 0x26, 0x99, 0xfe, 0x2f, 0x85, 0xf6, 0x4c, 0x38, 0x89, 0x2b, 0x4a, 0x0e, 0x47, 0xbd, 0x49,
+	0x36, 0x07, 0x66, 0x2f, 0x3d, 0xaa, 0xbc, 0x62, 0x54, 0x2b, 0xb0, 0xb8, 0x67, 0xfb, 0x74, 0x60,
+	0x3a, 0xed, 0xce, 0x8e, 0xe9, 0xba, 0xd8, 0x91, 0xeb, 0x9d, 0x69, 0xbe, 0xde, 0x99, 0x97, 0x3f,
+	0xef, 0x8a, 0x7f, 0x62, 0x07, 0xfd, 0x12, 0x2c, 0xf5, 0x77, 0x0e, 0x88, 0xdd, 0x19, 0xaa, 0x54,
+	0xe0, 0x95, 0x16, 0x82, 0xbf, 0x89, 0x5a, 0xd7, 0x60, 0x6e, 0x68, 0xdf, 0xcd, 0xad, 0xec, 0xb4,
+	0xd1, 0x48, 0x6f, 0xbb, 0x19, 0x59, 0x01, 0xf2, 0x80, 0x76, 0x62, 0x15, 0x4a, 0xbc, 0xc2, 0xbc,
+	0xfc, 0xf9, 0x88, 0x76, 0xa2, 0x3a, 0x49, 0x2b, 0x5f, 0x4e, 0x5b, 0xf9, 0x26, 0x94, 0xf8, 0xd1,
+	0x0b, 0x26, 0xcd, 0x8a, 0x38, 0x11, 0x90, 0x45, 0xb4, 0x0e, 0xb3, 0x84, 0x9a, 0x3e, 0x6d, 0xf7,
+	0x3d, 0x22, 0x2d, 0x2d, 0xa8, 0x7c, 0x61, 0x64, 0xb0, 0x57, 0x4d, 0x6a, 0x72, 0x7b, 0x3d, 0xc3,
+	0x2b, 0x6e, 0x04, 0xf5, 0xd4, 0xae, 0xa4, 0xfa, 0x59, 0xba, 0x92, 0xda, 0x13, 0xb8, 0x12, 0xfd,
+	0xe7, 0x1a, 0x2c, 0xde, 0xf7, 0x4c, 0xeb, 0x74, 0x68, 0xdb, 0x15, 0x98, 0xf1, 0x71, 0xdf, 0xb1,
+	0x3b, 0x26, 0x9b, 0xa9, 0x2d, 0xec, 0x73, 0x7d, 0x2b, 0x18, 0x75, 0x09, 0x7d, 0xc8, 0x81, 0x6c,
+	0x6b, 0xd6, 0x34, 0xb0, 0x83, 0x4d, 0x72, 0x3a, 0xac, 0x84, 0xfe, 0x27, 0x1a, 0x3c, 0xb3, 0x86,
+	0x69, 0x4c, 0xdf, 0xa8, 0x49, 0x6d, 0x42, 0xed, 0xce, 0x49, 0x1e, 0x30, 0xea, 0x3f, 0xd0, 0xe0,
+	0x62, 0x26, 0x59, 0x93, 0x98, 0x9f, 0x57, 0xa1, 0xc0, 0xbe, 0x82, 0x95, 0xe1, 0x18, 0x32, 0x27,
+	0xf0, 0xf5, 0xff, 0xd1, 0x60, 0x69, 0x73, 0xc7, 0xdb, 0x8f, 0x48, 0x3a, 0x0e, 0x06, 0x25, 0x0d,
+	0x72, 0x3e, 0x65, 0x90, 0xd1, 0x8b, 0x89, 0x73, 0x81, 0x0b, 0xca, 0x65, 0x2d, 0x23, 0x32, 0x5a,
+	0xdd, 0xa3, 0x17, 0xa0, 0x91, 0x62, 0x79, 0x60, 0xd2, 0x66, 0x93, 0x3c, 0x27, 0xfa, 0xdf, 0xe7,
+	0xe0, 0xdc, 0xd0, 0x10, 0x27, 0x61, 0xb6, 0xaa, 0xef, 0x9c, 0xb2, 0x6f, 0xa6, 0x3f, 0x31, 0x54,
+	0xdb, 0x12, 0xa7, 0x97, 0x79, 0xa3, 0x1e, 0xb3, 0xec, 0x56, 0xd6, 0x41, 0xe7, 0x74, 0xc6, 0x41,
+	0x27, 0xb3, 0xea, 0x4a, 0x93, 0x2b, 0x58, 0x30, 0x6d, 0x2c, 0x28, 0x6c, 0x2e, 0x41, 0x2f, 0xc2,
+	0x82, 0xed, 0x3e, 0xc0, 0x3d, 0xcf, 0x3f, 0x68, 0xf7, 0xb1, 0xdf, 0xc1, 0x2e, 0x35, 0xbb, 0x98,
+	0x34, 0x8b, 0x9c, 0xa2, 0xf9, 0xe0, 0xdf, 0x46, 0xf4, 0x4b, 0xff, 0x85, 0x06, 0x4b, 0x62, 0xd7,
+	0x10, 0xae, 0x62, 0x4f, 0xd8, 0x1a, 0x85, 0x4b, 0x6c, 0x81, 0x27, 0xb6, 0xb5, 0xf5, 0x10, 0xca,
+	0xb5, 0xec, 0x67, 0x1a, 0x2c, 0xb0, 0x65, 0xf6, 0x59, 0xa2, 0xf9, 0x6f, 0x34, 0x98, 0xbf, 0x67,
+	0x92, 0xb3, 0x44, 0xf2, 0xdf, 0x4a, 0x4f, 0x15, 0x6d, 0x70, 0x4e, 0x92, 0xe8, 0xe7, 0x61, 0x36,
+	0x49, 0x74, 0xb0, 0x2e, 0x9a, 0x49, 0x50, 0x4d, 0xf4, 0x5f, 0x46, 0xbe, 0xea, 0x8c, 0x51, 0xfe,
+	0x0f, 0x1a, 0x5c, 0x58, 0xc3, 0x34, 0xa4, 0xfa, 0x54, 0xf8, 0xb4, 0x71, 0xa5, 0xe5, 0x53, 0xe1,
+	0x91, 0x95, 0xc4, 0x9f, 0x88, 0xe7, 0xfb, 0x6e, 0x0e, 0x16, 0x99, 0x5b, 0x38, 0x1d, 0x42, 0x30,
+	0xce, 0xb6, 0x46, 0x21, 0x28, 0x05, 0x95, 0xa0, 0x84, 0xfe, 0xb4, 0x38, 0xb6, 0x3f, 0xd5, 0x7f,
+	0x9e, 0x13, 0xeb, 0x80, 0x38, 0x37, 0x26, 0x99, 0x16, 0x05, 0xad, 0x39, 0x25, 0xad, 0x3a, 0xd4,
+	0x42, 0xc8, 0xfa, 0x6a, 0xe0, 0x1f, 0x13, 0xb0, 0x53, 0xeb, 0x1e, 0xbf, 0xa7, 0xc1, 0x52, 0xb0,
+	0x91, 0xdc, 0xc4, 0xdd, 0x1e, 0x9e, 0xe4, 0x98, 0x31, 0x2d, 0x01, 0x39, 0x85, 0x04, 0x3c, 0x0d,
+	0x15, 0x22, 0xfa, 0x09, 0xf7, 0x88, 0x11, 0x40, 0xff, 0x27, 0x0d, 0xce, 0x0d, 0x91, 0x33, 0xc9,
+	0x24, 0x36, 0xa1, 0x64, 0xbb, 0x16, 0x7e, 0x1c, 0x52, 0x13, 0x14, 0xd9, 0x9f, 0xad, 0x81, 0xed,
+	0x58, 0x21, 0x19, 0x41, 0x11, 0x5d, 0x86, 0x1a, 0x76, 0xcd, 0x2d, 0x7e, 0xb4, 0x6f, 0xe1, 0xc7,
+	0x5c, 0x90, 0xcb, 0x46, 0x55, 0xc0, 0xd6, 0x19, 0x88, 0x55, 0xe6, 0xa7, 0x0b, 0xeb, 0xab, 0x7c,
+	0x6f, 0x9e, 0x37, 0x82, 0xa2, 0xfe, 0x7d, 0x0d, 0xe6, 0x99, 0x14, 0x4a, 0xea, 0xc9, 0xf1, 0x72,
+	0xf3, 0x12, 0x54, 0x63, 0x62, 0x26, 0x07, 0x12, 0x07, 0xe9, 0xbb, 0xb0, 0x90, 0x24, 0x67, 0x12,
+	0x6e, 0x3e, 0x03, 0x10, 0xce, 0x95, 0xd0, 0x86, 0xbc, 0x11, 0x83, 0xe8, 0xbf, 0x0e, 0xb3, 0x43,
+	0x38, 0x9b, 0x4e, 0xf8, 0x34, 0x8e, 0x4f, 0x49, 0xdc, 0x9e, 0x57, 0x38, 0x84, 0xff, 0x5e, 0x85,
+	0x1a, 0x7e, 0x4c, 0x7d, 0xb3, 0xdd, 0x37, 0x7d, 0xb3, 0x27, 0xd4, 0x6a, 0x2c, 0xd3, 0x5b, 0xe5,
+	0xd5, 0x36, 0x78, 0x2d, 0xfd, 0x5f, 0xd8, 0x32, 0x4d, 0x8a, 0xeb, 0x69, 0x1f, 0xf1, 0x05, 0x00,
+	0x2e, 0xce, 0xe2, 0xb7, 0x08, 0x98, 0x54, 0x38, 0x84, 0x3b, 0xb7, 0x9f, 0x68, 0xd0, 0xe0, 0x43,
+	0x10, 0xe3, 0xe9, 0xb3, 0x66, 0x53, 0x75, 0xb4, 0x54, 0x9d, 0x11, 0xca, 0xf5, 0x45, 0x28, 0x4a,
+	0xc6, 0xe6, 0xc7, 0x65, 0xac, 0xac, 0x70, 0xc8, 0x30, 0xf4, 0xbf, 0xd4, 0x60, 0x31, 0xc5, 0xf2,
+	0x49, 0x24, 0xfa, 0x7d, 0x40, 0x62, 0x84, 0x56, 0x34, 0xec, 0xc0, 0x11, 0x5f, 0x51, 0x7a, 0x9d,
+	0x34, 0x93, 0x8c, 0x39, 0x3b, 0x05, 0x21, 0xfa, 0xbf, 0x6b, 0xf0, 0xf4, 0x1a, 0xa6, 0x1c, 0xf5,
+	0x0e, 0xb3, 0x2a, 0x1b, 0xbe, 0xd7, 0xf5, 0x31, 0x21, 0x67, 0x57, 0x3e, 0x7e, 0x28, 0x56, 0x6e,
+	0xaa, 0x21, 0x4d, 0xc2, 0xff, 0xcb, 0x50, 0xe3, 0x7d, 0x60, 0xab, 0xed, 0x7b, 0xfb, 0x44, 0xca,
+	0x51, 0x55, 0xc2, 0x0c, 0x6f, 0x9f, 0x0b, 0x04, 0xf5, 0xa8, 0xe9, 0x08, 0x04, 0xe9, 0x32, 0x38,
+	0x84, 0xfd, 0xe6, 0x3a, 0x18, 0x10, 0xc6, 0x1a, 0xc7, 0x67, 0x97, 0xc7, 0x3f, 0xd6, 0x60, 0x31,
+	0x35, 0x94, 0x49, 0x78, 0xfb, 0xb2, 0x58, 0x57, 0x8a, 0xc1, 0xcc, 0xa4, 0xa3, 0xbc, 0xb2, 0x4e,
+	0xac, 0x33, 0x81, 0x8d, 0x2e, 0x42, 0x75, 0xdb, 0xb4, 0x9d, 0xb6, 0x8f, 0x4d, 0xe2, 0xb9, 0x41,
+	0x54, 0x96, 0x81, 0x0c, 0x0e, 0xd1, 0x7f, 0xa5, 0x89, 0x1c, 0xbb, 0x33, 0x6e, 0xf1, 0xfe, 0x2a,
+	0x07, 0xf5, 0x75, 0x97, 0x60, 0x9f, 0x9e, 0xfe, 0xbd, 0x07, 0x7a, 0x0b, 0xaa, 0x7c, 0x60, 0xa4,
+	0x6d, 0x99, 0xd4, 0x94, 0xee, 0xea, 0x99, 0xec, 0x18, 0xc8, 0xaa, 0x49, 0x4d, 0x43, 0x70, 0x87,
+	0xb0, 0x6f, 0x74, 0x1e, 0x2a, 0x3b, 0x26, 0xd9, 0x69, 0xef, 0xe2, 0x03, 0xb1, 0x20, 0xac, 0x1b,
+	0x65, 0x06, 0x78, 0x17, 0x1f, 0xf0, 0x04, 0x36, 0x77, 0xd0, 0x13, 0x0a, 0x56, 0xba, 0xa4, 0x2d,
+	0xd7, 0x8d, 0x92, 0x3b, 0xe8, 0x71, 0xf5, 0xfa, 0xd7, 0x1c, 0xcc, 0x3c, 0x18, 0xb0, 0x9d, 0x0e,
+	0x8f, 0x2f, 0x0c, 0x1c, 0xfa, 0x64, 0xc2, 0x78, 0x15, 0xf2, 0x62, 0xcd, 0xc0, 0x6a, 0x34, 0x95,
+	0x84, 0xaf, 0xaf, 0x12, 0x83, 0x21, 0xf1, 0xb3, 0xf5, 0x41, 0xa7, 0x23, 0x97, 0x5f, 0x79, 0x4e,
+	0x6c, 0x85, 0x41, 0xc4, 0xe2, 0xeb, 0x3c, 0x54, 0xb0, 0xef, 0x87, 0x8b, 0x33, 0x3e, 0x14, 0xec,
+	0xfb, 0xe2, 0xa7, 0x0e, 0x35, 0xb3, 0xb3, 0xeb, 0x7a, 0xfb, 0x0e, 0xb6, 0xba, 0xd8, 0xe2, 0xd3,
+	0x5e, 0x36, 0x12, 0x30, 0x21, 0x18, 0x6c, 0xe2, 0xdb, 0x1d, 0x97, 0xf2, 0x2d, 0x46, 0x9e, 0x09,
+	0x06, 0x83, 0xdc, 0x75, 0x29, 0xfb, 0x6d, 0xf1, 0x74, 0x32, 0xfe, 0xbb, 0x24, 0x7e, 0x0b, 0x88,
+	0xfc, 0x3d, 0xe8, 0x87, 0xb5, 0x45, 0x94, 0xb4, 0x22, 0x20, 0xec, 0xf7, 0xd3, 0x50, 0x89, 0x02,
+	0x08, 0x95, 0xe8, 0x9c, 0x90, 0x03, 0xf4, 0xff, 0xd6, 0xa0, 0x2e, 0x72, 0xd5, 0xce, 0x80, 0xd0,
+	0x21, 0x98, 0xc6, 0x8f, 0xfb, 0x41, 0x76, 0x05, 0xff, 0x1e, 0x29, 0x47, 0x5c, 0xa5, 0x1e, 0xf5,
+	0xff, 0x5f, 0xa5, 0x46, 0xab, 0xd4, 0x1e, 0x34, 0x36, 0x1c, 0xb3, 0x83, 0x77, 0x3c, 0xc7, 0xc2,
+	0x3e, 0x5f, 0x01, 0xa1, 0x06, 0xe4, 0xa9, 0xd9, 0x95, 0x4b, 0x2c, 0xf6, 0x89, 0x5e, 0x93, 0x3b,
+	0x60, 0x61, 0xbc, 0x9f, 0x53, 0xae, 0x45, 0x62, 0xcd, 0xc4, 0x0e, 0x96, 0x97, 0xa0, 0xc8, 0x43,
+	0xb7, 0x62, 0xf1, 0x55, 0x33, 0x64, 0x49, 0xff, 0x30, 0xd1, 0xef, 0x9a, 0xef, 0x0d, 0xfa, 0x68,
+	0x1d, 0x6a, 0xfd, 0x08, 0xc6, 0x34, 0x3a, 0x7b, 0xe5, 0x93, 0x26, 0xda, 0x48, 0x54, 0xd5, 0x7f,
+	0x9d, 0x87, 0xfa, 0x26, 0x36, 0xfd, 0xce, 0xce, 0x59, 0x38, 0x8a, 0x62, 0x1c, 0xb7, 0x88, 0x23,
+	0x65, 0x9b, 0x7d, 0xa2, 0x6b, 0x30, 0x17, 0x1b, 0x50, 0xbb, 0xcb, 0x18, 0xc4, 0xad, 0x43, 0xcd,
+	0x68, 0xf4, 0xd3, 0x8c, 0x7b, 0x15, 0xca, 0x16, 0x71, 0x44, 0x66, 0x53, 0x89, 0x4f, 0x91, 0x7a,
+	0x7c, 0xab, 0xc4, 0xe1, 0x53, 0x53, 0xb2, 0xc4, 0x07, 0x7a, 0x16, 0xea, 0xde, 0x80, 0xf6, 0x07,
+	0xb4, 0x2d, 0x44, 0xa9, 0x59, 0xe6, 0xe4, 0xd5, 0x04, 0x90, 0x4b, 0x1a, 0x41, 0xef, 0x40, 0x9d,
+	0x70, 0x56, 0x06, 0xfb, 0x93, 0xca, 0xb8, 0xcb, 0xe8, 0x9a, 0xa8, 0x27, 0x36, 0x28, 0xe8, 0x05,
+	0x68, 0x50, 0xdf, 0xdc, 0xc3, 0x4e, 0x2c, 0xa8, 0x09, 0xdc, 0x26, 0xcd, 0x0a, 0x78, 0x14, 0xd0,
+	0xbc, 0x09, 0xf3, 0xdd, 0x81, 0xe9, 0x9b, 0x2e, 0xc5, 0x38, 0x86, 0x5d, 0xe5, 0xd8, 0x28, 0xfc,
+	0x15, 0x56, 0xd0, 0xdf, 0x85, 0xe9, 0x7b, 0x36, 0xe5, 0x8c, 0x64, 0x96, 0x5d, 0xe3, 0xbb, 0x41,
+	0x6e, 0xbf, 0x9f, 0x82, 0xb2, 0xef, 0xed, 0x0b, 0xb5, 0xca, 0x71, 0x11, 0x2c, 0xf9, 0xde, 0x3e,
+	0xd7, 0x19, 0x9e, 0x34, 0xe3, 0xf9, 0x52, 0x36, 0x73, 0x86, 0x2c, 0xe9, 0xbf, 0xa7, 0x45, 0xc2,
+	0xc3, 0x53, 0xcd, 0x9e, 0xcc, 0xcb, 0xbc, 0x15, 0x4f, 0x6d, 0xcb, 0x0e, 0x62, 0xc7, 0x7b, 0xe2,
+	0x6a, 0x1d, 0x26, 0xb8, 0xfd, 0x32, 0x0f, 0xf3, 0xf7, 0x0e, 0xb6, 0x7c, 0xdb, 0x3a, 0x43, 0xa2,
+	0xfc, 0x65, 0x28, 0xfb, 0x82, 0xce, 0x60, 0x23, 0xab, 0xab, 0x0f, 0xcc, 0xe2, 0x43, 0x32, 0xc2,
+	0x3a, 0xe8, 0x0e, 0x54, 0x7d, 0xd3, 0xdd, 0x0d, 0x64, 0xad, 0x38, 0x76, 0xd0, 0x97, 0xd5, 0x92,
+	0x92, 0x36, 0x24, 0xd6, 0x25, 0x85, 0x58, 0xab, 0xc4, 0xb1, 0x7c, 0x24, 0x71, 0xac, 0x64, 0x8a,
+	0xe3, 0x77, 0x34, 0xa8, 0xbd, 0xe3, 0x0c, 0xc8, 0x71, 0x4c, 0x99, 0x2a, 0x5c, 0x96, 0x57, 0x87,
+	0xea, 0xfe, 0x28, 0x07, 0x75, 0x49, 0xc6, 0x24, 0x6b, 0xf7, 0x4c, 0x52, 0x36, 0xa1, 0xca, 0xba,
+	0x6c, 0x13, 0xdc, 0x0d, 0xce, 0x1a, 0xab, 0x2b, 0x2b, 0xca, 0xe9, 0x4e, 0x90, 0xc1, 0x13, 0x37,
+	0x36, 0x79, 0xa5, 0xaf, 0xb8, 0xd4, 0x3f, 0x30, 0xa0, 0x13, 0x02, 0x5a, 0x1f, 0xc2, 0x6c, 0xea,
+	0x37, 0xd3, 0xea, 0x5d, 0x7c, 0x10, 0x38, 0xa4, 0x5d, 0x7c, 0x80, 0x5e, 0x8a, 0xa7, 0x07, 0x65,
+	0x79, 0xca, 0xfb, 0x9e, 0xdb, 0xbd, 0xed, 0xfb, 0xe6, 0x81, 0x4c, 0x1f, 0x7a, 0x3d, 0xf7, 0x9a,
+	0xa6, 0x7f, 0x92, 0x87, 0xda, 0x57, 0x07, 0xd8, 0x3f, 0x38, 0x49, 0x6d, 0x0a, 0x16, 0x33, 0xd3,
+	0xb1, 0xc5, 0xcc, 0x90, 0xd0, 0x16, 0x14, 0x42, 0xab, 0x50, 0xc3, 0xa2, 0x52, 0x0d, 0x55, 0xd2,
+	0x5d, 0x3a, 0x92, 0x74, 0x97, 0xb3, 0xa4, 0x9b, 0xd9, 0x4d, 0x6f, 0x7b, 0x9b, 0x60, 0xca, 0x35,
+	0x20, 0x6f, 0xc8, 0x12, 0x5a, 0x80, 0x82, 0x63, 0xf7, 0x6c, 0xca, 0xad, 0x7a, 0xde, 0x10, 0x05,
+	0x86, 0xdd, 0x19, 0xf8, 0xc4, 0xf3, 0xb9, 0xf9, 0xae, 0x18, 0xb2, 0xa4, 0xff, 0x44, 0x0b, 0x27,
+	0x62, 0x22, 0x23, 0x9b, 0x58, 0x38, 0xe5, 0x8e, 0xbc, 0x70, 0xba, 0x08, 0x55, 0x17, 0x3f, 0xa6,
+	0x6d, 0x49, 0xa3, 0xdc, 0x61, 0x32, 0xd0, 0x5d, 0x41, 0xe7, 0xcf, 0x34, 0xa8, 0x7c, 0x0d, 0x77,
+	0xa8, 0xe7, 0x33, 0x77, 0xa2, 0x98, 0x62, 0x6d, 0x8c, 0xfd, 0x60, 0x2e, 0xbd, 0x1f, 0xbc, 0x05,
+	0x65, 0xdb, 0x6a, 0x9b, 0x4c, 0x3a, 0x79, 0x9f, 0xa3, 0xf6, 0x21, 0x25, 0xdb, 0xe2, 0x62, 0x3c,
+	0x7e, 0x68, 0xeb, 0x4f, 0x35, 0xa8, 0x09, 0x9a, 0x89, 0xa8, 0xf9, 0x46, 0xac, 0x3b, 0x4d, 0xa5,
+	0x32, 0xb2, 0x10, 0x0e, 0xf4, 0xde, 0x54, 0xd4, 0xed, 0x6d, 0x00, 0xc6, 0x5c, 0x59, 0x3d, 0x37,
+	0x22, 0xe5, 0x4d, 0x54, 0xe7, 0x8c, 0xbe, 0x37, 0x65, 0x54, 0x58, 0x2d, 0xde, 0xc4, 0x9d, 0x12,
+	0x14, 0x78, 0x6d, 0xfd, 0x7f, 0x35, 0x98, 0xbf, 0x6b, 0x3a, 0x9d, 0x55, 0x9b, 0x50, 0xd3, 0xed,
+	0x4c, 0xb0, 0xf3, 0x78, 0x1d, 0x4a, 0x5e, 0xbf, 0xed, 0xe0, 0x6d, 0x2a, 0x49, 0xba, 0x3c, 0x62,
+	0x44, 0x82, 0x0d, 0x46, 0xd1, 0xeb, 0xdf, 0xc7, 0xdb, 0x14, 0xbd, 0x09, 0x65, 0xaf, 0xdf, 0xf6,
+	0xed, 0xee, 0x0e, 0x95, 0xdc, 0x1f, 0xa3, 0x72, 0xc9, 0xeb, 0x1b, 0xac, 0x46, 0xec, 0x40, 0x71,
+	0xfa, 0x88, 0x07, 0x8a, 0xfa, 0x7f, 0x0c, 0x0d, 0x7f, 0x02, 0xd9, 0x7f, 0x1d, 0xca, 0xb6, 0x4b,
+	0xdb, 0x96, 0x4d, 0x02, 0x16, 0x5c, 0x50, 0xcb, 0x90, 0x4b, 0xf9, 0x08, 0xf8, 0x9c, 0xba, 0x94,
+	0xf5, 0x8d, 0xde, 0x06, 0xd8, 0x76, 0x3c, 0x53, 0xd6, 0x16, 0x3c, 0xb8, 0xa8, 0x56, 0x1b, 0x86,
+	0x16, 0xd4, 0xaf, 0xf0, 0x4a, 0xac, 0x85, 0x68, 0x4a, 0xff, 0x4d, 0x83, 0xc5, 0x0d, 0xec, 0x8b,
+	0xd4, 0x2d, 0x2a, 0x0f, 0xf7, 0xd7, 0xdd, 0x6d, 0x2f, 0x19, 0x5f, 0xd1, 0x52, 0xf1, 0x95, 0xcf,
+	0x26, 0xa6, 0x90, 0xd8, 0xdb, 0x88, 0x28, 0x5f, 0xb0, 0xb7, 0x09, 0x62, 0x99, 0xe2, 0xb8, 0x65,
+	0x26, 0x63, 0x9a, 0x24, 0xbd, 0xf1, 0x53, 0x27, 0xfd, 0x8f, 0x45, 0x5e, 0x91, 0x72, 0x50, 0x4f,
+	0x2e, 0xb0, 0x4b, 0x20, 0xfd, 0x44, 0xca, 0x6b, 0x7c, 0x0e, 0x52, 0xb6, 0x23, 0x23, 0xdb, 0xe9,
+	0xcf, 0x34, 0xb8, 0x94, 0x4d, 0xd5, 0x24, 0x0e, 0xfe, 0x6d, 0x28, 0xd8, 0xee, 0xb6, 0x17, 0x9c,
+	0x35, 0x5f, 0x55, 0xef, 0xb8, 0x94, 0xfd, 0x8a, 0x8a, 0xfa, 0xdf, 0xe5, 0xa0, 0xc1, 0x8d, 0xf9,
+	0x09, 0x4c, 0x7f, 0x0f, 0xf7, 0xda, 0xc4, 0xfe, 0x08, 0x07, 0xd3, 0xdf, 0xc3, 0xbd, 0x4d, 0xfb,
+	0x23, 0x9c, 0x90, 0x8c, 0x42, 0x52, 0x32, 0x92, 0xa7, 0x71, 0xc5, 0x11, 0xb1, 0x84, 0x52, 0x32,
+	0x96, 0xb0, 0x04, 0x45, 0xd7, 0xb3, 0xf0, 0xfa, 0xaa, 0x3c, 0x6b, 0x91, 0xa5, 0x48, 0xd4, 0x2a,
+	0x47, 0x14, 0xb5, 0x4f, 0x35, 0x68, 0xad, 0x61, 0x9a, 0xe6, 0xdd, 0xc9, 0x49, 0xd9, 0x0f, 0x34,
+	0x38, 0xaf, 0x24, 0x68, 0x12, 0x01, 0x7b, 0x23, 0x29, 0x60, 0xea, 0x2d, 0xfd, 0x50, 0x97, 0x52,
+	0xb6, 0x5e, 0x84, 0xda, 0xea, 0xa0, 0xd7, 0x0b, 0x17, 0x6c, 0x97, 0xa1, 0x26, 0x77, 0x0b, 0x62,
+	0xc7, 0x2b, 0xfc, 0x6f, 0x55, 0xc2, 0xd8, 0xbe, 0x56, 0xbf, 0x06, 0x75, 0x59, 0x45, 0x52, 0xdd,
+	0x62, 0xbb, 0x12, 0xf1, 0x1d, 0x5e, 0x0d, 0x92, 0x65, 0x7d, 0x11, 0xe6, 0x0d, 0xdc, 0x65, 0xa2,
+	0xed, 0xdf, 0xb7, 0xdd, 0x5d, 0xd9, 0x8d, 0xfe, 0xb1, 0x06, 0x0b, 0x49, 0xb8, 0x6c, 0xeb, 0x15,
+	0x28, 0x99, 0x96, 0xe5, 0x63, 0x42, 0x46, 0x4e, 0xcb, 0x6d, 0x81, 0x63, 0x04, 0xc8, 0x31, 0xce,
+	0xe5, 0xc6, 0xe6, 0x9c, 0xde, 0x86, 0xb9, 0x35, 0x4c, 0x1f, 0x60, 0xea, 0x4f, 0x94, 0x97, 0xd2,
+	0x64, 0x7b, 0x51, 0x5e, 0x59, 0x8a, 0x45, 0x50, 0xd4, 0xbf, 0xa7, 0x01, 0x8a, 0xf7, 0x30, 0xc9,
+	0x34, 0xc7, 0xb9, 0x9c, 0x4b, 0x72, 0x59, 0xa4, 0xee, 0xf5, 0xfa, 0x9e, 0x8b, 0xdd, 0xc4, 0x15,
+	0xab, 0x7a, 0x08, 0xe5, 0xe2, 0xf7, 0x0b, 0x0d, 0xd0, 0x7d, 0xcf, 0xb4, 0xee, 0x98, 0xce, 0x64,
+	0xcb, 0x83, 0x0b, 0x00, 0xc4, 0xef, 0xb4, 0xa5, 0xb6, 0xe6, 0xa4, 0xf5, 0xf1, 0x3b, 0x0f, 0x85,
+	0xc2, 0x5e, 0x84, 0xaa, 0x45, 0xa8, 0xfc, 0x1d, 0xa4, 0x49, 0x80, 0x45, 0xa8, 0xf8, 0xcf, 0x93,
+	0xb6, 0x09, 0x36, 0x1d, 0x6c, 0xb5, 0x63, 0x51, 0xe6, 0x69, 0x8e, 0xd6, 0x10, 0x3f, 0x36, 0xa3,
+	0x58, 0xf3, 0x87, 0x70, 0xee, 0x81, 0xe9, 0x0e, 0x4c, 0xe7, 0xae, 0xd7, 0xeb, 0x9b, 0x89, 0x74,
+	0xdd, 0xb4, 0x99, 0xd3, 0x14, 0x66, 0xee, 0x19, 0x91, 0xcf, 0x29, 0x16, 0xe6, 0x9c, 0xd6, 0x69,
+	0x23, 0x06, 0xd1, 0x09, 0x34, 0x87, 0x9b, 0x9f, 0x64, 0xa2, 0x38, 0x51, 0x41, 0x53, 0x71, 0xdb,
+	0x1b, 0xc1, 0xf4, 0xb7, 0xe0, 0x29, 0x9e, 0x5b, 0x1b, 0x80, 0x12, 0xf1, 0xac, 0x74, 0x03, 0x9a,
+	0xa2, 0x81, 0xdf, 0xcf, 0x71, 0xd3, 0x36, 0xd4, 0xc2, 0x24, 0x84, 0xbf, 0x9e, 0x0c, 0x23, 0x3d,
+	0x97, 0x91, 0x59, 0x9e, 0xec, 0x51, 0xc6, 0x92, 0x96, 0x61, 0x16, 0x3f, 0xc6, 0x9d, 0x01, 0xb5,
+	0xdd, 0xee, 0x86, 0x63, 0xba, 0x0f, 0x3d, 0xe9, 0x50, 0xd2, 0x60, 0xf4, 0x1c, 0xd4, 0x19, 0xf7,
+	0xbd, 0x01, 0x95, 0x78, 0xc2, 0xb3, 0x24, 0x81, 0xac, 0x3d, 0x36, 0x5e, 0x07, 0x53, 0x6c, 0x49,
+	0x3c, 0xe1, 0x66, 0xd2, 0xe0, 0x21, 0x56, 0x32, 0x30, 0x39, 0x0a, 0x2b, 0xff, 0x4b, 0x4b, 0xb1,
+	0x52, 0xb6, 0x70, 0x52, 0xac, 0xbc, 0x07, 0xd0, 0xc3, 0x7e, 0x97, 0xdf, 0x2b, 0x0d, 0xf6, 0xfd,
+	0xea, 0xfb, 0xc7, 0x51, 0x03, 0x0f, 0x82, 0x0a, 0x46, 0xac, 0xae, 0xbe, 0x06, 0xf3, 0x0a, 0x14,
+	0x66, 0xaf, 0x88, 0x37, 0xf0, 0x3b, 0x38, 0x38, 0xcb, 0x0b, 0x8a, 0xcc, 0xbf, 0x51, 0xd3, 0xef,
+	0x62, 0x2a, 0x85, 0x56, 0x96, 0xf4, 0x57, 0x78, 0xe4, 0x95, 0x1f, 0x33, 0x24, 0x24, 0x35, 0x99,
+	0x26, 0xa2, 0x0d, 0xa5, 0x89, 0x6c, 0xf3, 0x30, 0x67, 0xbc, 0xde, 0x84, 0x29, 0x3e, 0xdb, 0xac,
+	0x29, 0x6c, 0xc9, 0x5b, 0x51, 0x41, 0x51, 0xff, 0x51, 0x0e, 0xea, 0xeb, 0xbd, 0xbe, 0x77, 0x26,
+	0xc2, 0x11, 0xfc, 0x2a, 0xed, 0x7e, 0x9b, 0x75, 0x1a, 0x44, 0xad, 0xca, 0xbe, 0xb7, 0xcf, 0x48,
+	0xb1, 0xd8, 0x36, 0x7f, 0xdb, 0x76, 0xc2, 0x93, 0x07, 0x51, 0x40, 0x6f, 0xb0, 0xed, 0x98, 0xc8,
+	0x58, 0x18, 0xfb, 0xce, 0x5f, 0x50, 0x43, 0xff, 0x00, 0x66, 0x02, 0xde, 0x4c, 0x78, 0x69, 0x8c,
+	0x9a, 0x64, 0x37, 0xc8, 0x06, 0x12, 0x05, 0xfd, 0x9a, 0x08, 0x64, 0xf3, 0xf6, 0x13, 0xa2, 0x81,
+	0x60, 0x9a, 0x61, 0x48, 0x8d, 0xe3, 0xdf, 0xfa, 0x4f, 0x73, 0xb0, 0x94, 0xc6, 0x9e, 0x84, 0xa4,
+	0x57, 0x92, 0x5a, 0xa6, 0xbe, 0x57, 0x13, 0xef, 0x4d, 0x6a, 0x98, 0x9c, 0x81, 0x8e, 0x37, 0x70,
+	0xa9, 0x34, 0x53, 0x6c, 0x06, 0xee, 0xb2, 0x32, 0x93, 0x03, 0xdb, 0x6a, 0x3b, 0x6c, 0xe7, 0x26,
+	0x3c, 0x52, 0xd1, 0xb6, 0xee, 0xb3, 0x5d, 0xdd, 0xab, 0xc1, 0x3a, 0x6b, 0xec, 0x14, 0x22, 0x81,
+	0x8f, 0x66, 0x20, 0x67, 0x5b, 0x32, 0xfa, 0x98, 0xb3, 0x2d, 0xf4, 0x2c, 0xd4, 0x13, 0x89, 0xf6,
+	0x72, 0x1d, 0x1c, 0x77, 0x5b, 0xd6, 0xd5, 0xb7, 0x61, 0x5e, 0xf1, 0xbc, 0x00, 0x9a, 0x83, 0xfa,
+	0x6d, 0x8b, 0xbf, 0x24, 0xf1, 0xbe, 0xc7, 0x80, 0x8d, 0x29, 0xb4, 0x04, 0xc8, 0xc0, 0x3d, 0x6f,
+	0x8f, 0x23, 0xbe, 0xe3, 0x7b, 0x3d, 0x0e, 0xd7, 0xae, 0x5e, 0x87, 0x05, 0xd5, 0x95, 0x63, 0x54,
+	0x81, 0x02, 0xbf, 0x77, 0xdb, 0x98, 0x42, 0x00, 0x45, 0x03, 0xef, 0x79, 0xbb, 0x0c, 0xfd, 0x32,
+	0x94, 0x83, 0x3c, 0x4b, 0x54, 0x82, 0xfc, 0x6d, 0xc7, 0x69, 0x4c, 0xa1, 0x1a, 0x94, 0xd7, 0x65,
+	0x32, 0x61, 0x43, 0xbb, 0xfa, 0x65, 0x98, 0x4d, 0x05, 0xa2, 0x50, 0x19, 0xa6, 0x1f, 0x7a, 0x2e,
+	0x23, 0xa3, 0x01, 0xb5, 0x3b, 0xb6, 0x6b, 0xfa, 0x07, 0x62, 0x5f, 0xdf, 0xb0, 0xd0, 0x2c, 0x54,
+	0xf9, 0xfe, 0x56, 0x02, 0xf0, 0xca, 0x27, 0xd7, 0xa1, 0xfe, 0x80, 0x33, 0x6d, 0x13, 0xfb, 0x7b,
+	0x76, 0x07, 0xa3, 0x36, 0x34, 0xd2, 0x37, 0x7d, 0xd1, 0xe7, 0xd5, 0xb6, 0x4e, 0x7d, 0x21, 0xb8,
+	0x35, 0x4a, 0x50, 0xf4, 0x29, 0xf4, 0x01, 0xcc, 0x24, 0x6f, 0xb1, 0x22, 0xf5, 0x06, 0x4c, 0x79,
+	0xd5, 0xf5, 0xb0, 0xc6, 0xdb, 0x50, 0x4f, 0x5c, 0x4a, 0x45, 0xea, 0x8b, 0xdf, 0xaa, 0x8b, 0xab,
+	0x2d, 0xf5, 0x99, 0x48, 0xfc, 0xe2, 0xa8, 0xa0, 0x3e, 0x79, 0xbf, 0x2a, 0x83, 0x7a, 0xe5, 0x25,
+	0xac, 0xc3, 0xa8, 0x37, 0x61, 0x6e, 0xe8, 0x1e, 0x14, 0xba, 0xae, 0x7e, 0x81, 0x21, 0xe3, 0xbe,
+	0xd4, 0x61, 0x5d, 0xec, 0x03, 0x1a, 0xbe, 0xbc, 0x88, 0x6e, 0xa8, 0x67, 0x20, 0xeb, 0xea, 0x66,
+	0xeb, 0xe6, 0xd8, 0xf8, 0x21, 0xe3, 0x3e, 0xd1, 0xe0, 0x5c, 0xc6, 0xe5, 0x25, 0x74, 0x4b, 0x7d,
+	0x15, 0x7d, 0xe4, 0x0d, 0xac, 0xd6, 0x4b, 0x47, 0xab, 0x14, 0x12, 0xe2, 0xc2, 0x6c, 0xea, 0x3e,
+	0x0f, 0xba, 0x96, 0x99, 0xe3, 0x3c, 0x7c, 0xb1, 0xa9, 0xf5, 0xf9, 0xf1, 0x90, 0xc3, 0xfe, 0xde,
+	0x83, 0x72, 0x70, 0x87, 0x17, 0xa9, 0x43, 0xc9, 0xa9, 0x2b, 0xbe, 0x87, 0x4d, 0xe1, 0x87, 0x30,
+	0x9b, 0xba, 0x55, 0x93, 0x31, 0x00, 0xf5, 0xdd, 0x9b, 0xc3, 0x9a, 0xff, 0x06, 0xd4, 0x13, 0xd7,
+	0x5f, 0x32, 0x54, 0x48, 0x75, 0x45, 0xe6, 0x70, 0xca, 0x6b, 0xf1, 0x5b, 0x2a, 0x68, 0x39, 0x4b,
+	0x39, 0x87, 0x1a, 0x3e, 0x8a, 0x6e, 0x46, 0x49, 0xe8, 0x23, 0x74, 0x73, 0x28, 0x6f, 0x7f, 0x7c,
+	0xdd, 0x8c, 0xb5, 0x3f, 0x52, 0x37, 0x8f, 0xdc, 0xc5, 0xc7, 0x1a, 0x77, 0xc6, 0x8a, 0x4b, 0x0e,
+	0x68, 0x25, 0x4b, 0xd8, 0xb3, 0xaf, 0x73, 0xb4, 0x6e, 0x1d, 0xa9, 0x4e, 0xc8, 0xc5, 0x5d, 0x98,
+	0x49, 0xa6, 0xf2, 0x67, 0x70, 0x51, 0x79, 0xfb, 0xa1, 0x75, 0x6d, 0x2c, 0xdc, 0xb0, 0xb3, 0x47,
+	0x50, 0x8d, 0x3d, 0x69, 0x87, 0x9e, 0x1f, 0x21, 0xc7, 0xf1, 0xf7, 0xdd, 0x0e, 0xe3, 0xe4, 0x57,
+	0xa1, 0x12, 0xbe, 0x44, 0x87, 0xae, 0x64, 0xca, 0xef, 0x51, 0x9a, 0xdc, 0x04, 0x88, 0x9e, 0x99,
+	0x43, 0x9f, 0x53, 0x2b, 0x72, 0xfa, 0x1d, 0xba, 0x31, 0x7c, 0x61, 0xf2, 0x71, 0xb8, 0x0c, 0x5e,
+	0x2b, 0x5f, 0x90, 0x3b, 0xac, 0xf1, 0xaf, 0x43, 0x2d, 0xfe, 0x2a, 0x5c, 0x86, 0xb6, 0x29, 0x1e,
+	0x8e, 0x3b, 0xac, 0xe1, 0x1d, 0xa8, 0x27, 0x5e, 0x70, 0xcb, 0xb0, 0x10, 0xaa, 0x07, 0xe3, 0x5a,
+	0x57, 0xc7, 0x41, 0x0d, 0xc5, 0x23, 0x5a, 0x8c, 0x84, 0xaf, 0x8b, 0x8d, 0x5e, 0x8c, 0xa4, 0x1f,
+	0x21, 0x3b, 0x7c, 0xbd, 0xd0, 0x48, 0xbf, 0xb6, 0x96, 0xd1, 0x41, 0xc6, 0xa3, 0x6c, 0x63, 0x74,
+	0x90, 0x7e, 0x1f, 0x2d, 0xa3, 0x83, 0x8c, 0x67, 0xd4, 0xc6, 0x9c, 0x8c, 0xf0, 0x35, 0xb3, 0x11,
+	0x93, 0x91, 0x7e, 0x3b, 0x6d, 0xc4, 0x64, 0x0c, 0x3d, 0x8e, 0x26, 0x34, 0x20, 0x7a, 0xcb, 0x2c,
+	0x43, 0x03, 0x86, 0x1e, 0x3b, 0x3b, 0x8c, 0xfc, 0xf7, 0xa0, 0x1c, 0x3c, 0x5e, 0x96, 0xe1, 0x1d,
+	0x53, 0x6f, 0x9b, 0x8d, 0xe1, 0x1d, 0x53, 0xab, 0xf4, 0x0c, 0xef, 0xa8, 0x7e, 0xd0, 0xec, 0xf0,
+	0xf9, 0x84, 0xe8, 0xad, 0xad, 0x0c, 0x26, 0x0c, 0x3d, 0x33, 0xd6, 0x7a, 0xfe, 0x50, 0xbc, 0x98,
+	0xc8, 0x43, 0xf4, 0xd8, 0xd4, 0xc8, 0x0e, 0x62, 0xcf, 0x6c, 0x8d, 0xec, 0x20, 0xfe, 0x6a, 0x95,
+	0x90, 0xc8, 0xf4, 0x26, 0x24, 0x43, 0x22, 0x33, 0x9e, 0x71, 0x3a, 0x8c, 0x45, 0x5b, 0x50, 0x8d,
+	0x3d, 0x5b, 0x84, 0x46, 0x91, 0x16, 0x7f, 0x5b, 0xa9, 0xb5, 0x7c, 0x38, 0xe2, 0xb0, 0xdf, 0x10,
+	0x99, 0xa7, 0xa3, 0xfc, 0x46, 0x3c, 0x55, 0x7a, 0x0c, 0x65, 0x4a, 0x5c, 0x70, 0xc8, 0x5a, 0xfb,
+	0x28, 0xee, 0x9d, 0x64, 0x28, 0x93, 0xf2, 0xbe, 0x84, 0xe8, 0x29, 0x91, 0x6e, 0x9e, 0xd1, 0x93,
+	0x2a, 0xbb, 0x3e, 0xa3, 0x27, 0x65, 0xf6, 0xba, 0x3e, 0x85, 0xbe, 0x1d, 0xcb, 0x6c, 0x4f, 0xdc,
+	0x1e, 0x40, 0x2f, 0x8e, 0x6c, 0x47, 0x75, 0x79, 0xa2, 0xb5, 0x72, 0x94, 0x2a, 0x21, 0x09, 0xd2,
+	0x1d, 0x0b, 0x96, 0x66, 0xbb, 0xe3, 0xa3, 0xcc, 0xd4, 0x26, 0x14, 0x45, 0x02, 0x39, 0xd2, 0x33,
+	0xae, 0x8a, 0xc4, 0x52, 0x61, 0x5b, 0xcf, 0x2a, 0x71, 0x92, 0xb9, 0xd5, 0xa2, 0x51, 0x61, 0x85,
+	0x33, 0x1a, 0x4d, 0x64, 0x0f, 0x1f, 0xa1, 0x51, 0x91, 0x97, 0x9b, 0xd1, 0x68, 0x22, 0x69, 0x77,
+	0xdc, 0x46, 0x0d, 0x28, 0x8a, 0x8c, 0x32, 0x34, 0x46, 0xba, 0x59, 0x6b, 0x34, 0x8e, 0xc8, 0xbe,
+	0x9b, 0x42, 0xbf, 0x0d, 0xb5, 0x78, 0xfa, 0x5d, 0xd6, 0xea, 0x7c, 0x38, 0x43, 0x6f, 0xcc, 0xf6,
+	0x37, 0xa0, 0xc0, 0xcf, 0x1d, 0xd1, 0xe5, 0x51, 0x29, 0x53, 0xa3, 0x5a, 0x4c, 0x64, 0x55, 0x71,
+	0xe7, 0x51, 0xe0, 0x51, 0xb4, 0x8c, 0x16, 0xe3, 0x79, 0x4f, 0xad, 0x91, 0x28, 0x01, 0x89, 0x16,
+	0xd4, 0xe2, 0xe9, 0x0a, 0x19, 0x2c, 0x50, 0x24, 0x74, 0xb4, 0xc6, 0xc1, 0x0c, 0x7a, 0x11, 0xba,
+	0x1f, 0x9d, 0xc1, 0x66, 0xeb, 0xfe, 0xd0, 0xf9, 0x6e, 0xb6, 0xee, 0x0f, 0x1f, 0xe9, 0xea, 0x53,
+	0xe8, 0x0f, 0x35, 0x68, 0x66, 0xc5, 0xd0, 0x51, 0xe6, 0x06, 0x7a, 0x54, 0x22, 0x40, 0xeb, 0xe5,
+	0x23, 0xd6, 0x0a, 0x69, 0xf9, 0x08, 0xe6, 0x15, 0x81, 0x56, 0x74, 0x33, 0xab, 0xbd, 0x8c, 0x18,
+	0x71, 0xeb, 0x0b, 0xe3, 0x57, 0x08, 0xfb, 0xde, 0x80, 0x02, 0x0f, 0x90, 0x66, 0x08, 0x4a, 0x3c,
+	0xde, 0x9a, 0x21, 0x7a, 0x89, 0xf8, 0xaa, 0x3e, 0x85, 0x30, 0xd4, 0xe2, 0xd1, 0xd2, 0x0c, 0x49,
+	0x51, 0x04, 0x5a, 0x5b, 0x2f, 0x8c, 0x81, 0x19, 0x5f, 0x0d, 0x44, 0xd1, 0xca, 0x8c, 0xd5, 0xc0,
+	0x50, 0xc0, 0x34, 0x63, 0x35, 0x30, 0x1c, 0xf6, 0x14, 0x8e, 0x34, 0x16, 0x7f, 0xcc, 0x70, 0xa4,
+	0xc3, 0x11, 0xca, 0x31, 0x8e, 0x99, 0x86, 0x63, 0x61, 0x19, 0xc7, 0x4c, 0x99, 0x61, 0xb7, 0xd6,
+	0xcd, 0xb1, 0xf1, 0xc3, 0xf1, 0x7c, 0x0b, 0x1a, 0xe9, 0xd8, 0x61, 0xc6, 0xea, 0x26, 0x23, 0x82,
+	0xd9, 0xba, 0x3e, 0x26, 0x76, 0xdc, 0xc1, 0x9e, 0x1f, 0xa6, 0xe9, 0xeb, 0x36, 0xdd, 0xe1, 0x61,
+	0xab, 0x71, 0x46, 0x1d, 0x8f, 0x90, 0x8d, 0x33, 0xea, 0x44, 0x3c, 0x4c, 0x7a, 0x43, 0x7e, 0xa8,
+	0x9e, 0xe5, 0x0d, 0xe3, 0x91, 0x98, 0x0c, 0x1f, 0x93, 0x8c, 0x48, 0x88, 0x83, 0x80, 0x64, 0x68,
+	0x00, 0x65, 0x2f, 0x3c, 0x86, 0xa2, 0x0d, 0x19, 0x07, 0x01, 0xea, 0x58, 0x83, 0x3e, 0xb5, 0x32,
+	0x80, 0xda, 0x86, 0xef, 0x3d, 0x3e, 0x08, 0x8e, 0xa1, 0x7f, 0x33, 0xfa, 0x75, 0xe7, 0xe5, 0xdf,
+	0xba, 0xd5, 0xb5, 0xe9, 0xce, 0x60, 0x8b, 0x49, 0xf0, 0x4d, 0x81, 0x7b, 0xdd, 0xf6, 0xe4, 0xd7,
+	0x4d, 0xdb, 0xa5, 0xd8, 0x77, 0x4d, 0xe7, 0x26, 0x6f, 0x4b, 0x42, 0xfb, 0x5b, 0x5b, 0x45, 0x5e,
+	0xbe, 0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x9c, 0x32, 0x2e, 0xd2, 0x5f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5253,6 +7164,7 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -5263,6 +7175,20 @@ type MilvusServiceClient interface {
 	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, in *SelectRoleRequest, opts ...grpc.CallOption) (*SelectRoleResponse, error)
+	SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error)
+	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
@@ -5270,7 +7196,9 @@ type MilvusServiceClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+	HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
@@ -5286,6 +7214,9 @@ type MilvusServiceClient interface {
 	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -5368,6 +7299,15 @@ func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowColle
 	return out, nil
 }
 
+func (c *milvusServiceClient) AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
@@ -5458,6 +7398,132 @@ func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SelectRole(ctx context.Context, in *SelectRoleRequest, opts ...grpc.CallOption) (*SelectRoleResponse, error) {
+	out := new(SelectRoleResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error) {
+	out := new(SelectUserResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error) {
+	out := new(SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -5521,9 +7587,27 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
-func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) HybridSearch(ctx context.Context, in *HybridSearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
-	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HybridSearch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5647,6 +7731,24 @@ func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, i
 	return out, nil
 }
 
+func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -5657,6 +7759,7 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	AddField(context.Context, *AddFieldRequest) (*commonpb.Status, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
@@ -5667,6 +7770,20 @@ type MilvusServiceServer interface {
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	SelectRole(context.Context, *SelectRoleRequest) (*SelectRoleResponse, error)
+	SelectUser(context.Context, *SelectUserRequest) (*SelectUserResponse, error)
+	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
@@ -5674,7 +7791,9 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	HybridSearch(context.Context, *HybridSearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
@@ -5690,6 +7809,9 @@ type MilvusServiceServer interface {
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5720,6 +7842,9 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AddField(ctx context.Context, req *AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -5750,6 +7875,48 @@ func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectRole(ctx context.Context, req *SelectRoleRequest) (*SelectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectUser(ctx context.Context, req *SelectUserRequest) (*SelectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectUser not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, req *OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -5771,9 +7938,15 @@ func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *Insert
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (*UnimplementedMilvusServiceServer) HybridSearch(ctx context.Context, req *HybridSearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HybridSearch not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
 }
@@ -5807,337 +7980,613 @@ func (*UnimplementedMilvusServiceServer) LoadBalance(ctx context.Context, req *L
 func (*UnimplementedMilvusServiceServer) GetCompactionState(ctx context.Context, req *GetCompactionStateRequest) (*GetCompactionStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionState not implemented")
 }
-func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, req *ManualCompactionRequest) (*ManualCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, req *ManualCompactionRequest) (*ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompaction not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+}
+func (*UnimplementedMilvusServiceServer) Import(ctx context.Context, req *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req *GetImportStateRequest) (*GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+
+func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
+	s.RegisterService(&_MilvusService_serviceDesc, srv)
+}
+
+func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AddField(ctx, req.(*AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
+
+func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
-	s.RegisterService(&_MilvusService_serviceDesc, srv)
+func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowPartitionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, in)
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropCollectionRequest)
+func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropCollection(ctx, in)
+		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropCollection(ctx, req.(*DropCollectionRequest))
+		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasCollectionRequest)
+func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasCollection(ctx, in)
+		return srv.(MilvusServiceServer).DropAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasCollection(ctx, req.(*HasCollectionRequest))
+		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadCollectionRequest)
+func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, in)
+		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadCollection(ctx, req.(*LoadCollectionRequest))
+		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleaseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseCollectionRequest)
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, in)
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleaseCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleaseCollection(ctx, req.(*ReleaseCollectionRequest))
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeCollectionRequest)
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, in)
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DescribeCollection(ctx, req.(*DescribeCollectionRequest))
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetCollectionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionStatisticsRequest)
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetCollectionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetCollectionStatistics(ctx, req.(*GetCollectionStatisticsRequest))
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowCollectionsRequest)
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, in)
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowCollections",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowCollections(ctx, req.(*ShowCollectionsRequest))
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePartitionRequest)
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, in)
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreatePartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreatePartition(ctx, req.(*CreatePartitionRequest))
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropPartitionRequest)
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropPartition(ctx, in)
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropPartition(ctx, req.(*DropPartitionRequest))
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HasPartitionRequest)
+func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListCredUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, req.(*ListCredUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadPartitionsRequest)
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleasePartitionsRequest)
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowPartitionsRequest)
+func _MilvusService_SelectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+		return srv.(MilvusServiceServer).SelectRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+		return srv.(MilvusServiceServer).SelectRole(ctx, req.(*SelectRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAliasRequest)
+func _MilvusService_SelectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+		return srv.(MilvusServiceServer).SelectUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+		return srv.(MilvusServiceServer).SelectUser(ctx, req.(*SelectUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropAliasRequest)
+func _MilvusService_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePrivilegeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, req.(*OperatePrivilegeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterAliasRequest)
+func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+		return srv.(MilvusServiceServer).SelectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+		return srv.(MilvusServiceServer).SelectGrant(ctx, req.(*SelectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6268,6 +8717,24 @@ func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -6286,6 +8753,24 @@ func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_HybridSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HybridSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HybridSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HybridSearch(ctx, req.(*HybridSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FlushRequest)
 	if err := dec(in); err != nil {
@@ -6520,6 +9005,42 @@ func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetImportState(ctx, req.(*GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -6556,6 +9077,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "AddField",
+			Handler:    _MilvusService_AddField_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -6596,6 +9121,62 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _MilvusService_AlterAlias_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _MilvusService_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "SelectRole",
+			Handler:    _MilvusService_SelectRole_Handler,
+		},
+		{
+			MethodName: "SelectUser",
+			Handler:    _MilvusService_SelectUser_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _MilvusService_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _MilvusService_SelectGrant_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -6624,10 +9205,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
 		},
+		{
+			MethodName: "HybridSearch",
+			Handler:    _MilvusService_HybridSearch_Handler,
+		},
 		{
 			MethodName: "Flush",
 			Handler:    _MilvusService_Flush_Handler,
@@ -6680,6 +9269,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _MilvusService_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _MilvusService_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _MilvusService_GetImportState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
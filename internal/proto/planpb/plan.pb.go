@@ -31,6 +31,8 @@ const (
 	OpType_LessEqual    OpType = 4
 	OpType_Equal        OpType = 5
 	OpType_NotEqual     OpType = 6
+	OpType_PrefixMatch  OpType = 7
+	OpType_PostfixMatch OpType = 8
 )
 
 var OpType_name = map[int32]string{
@@ -41,6 +43,8 @@ var OpType_name = map[int32]string{
 	4: "LessEqual",
 	5: "Equal",
 	6: "NotEqual",
+	7: "PrefixMatch",
+	8: "PostfixMatch",
 }
 
 var OpType_value = map[string]int32{
@@ -51,6 +55,8 @@ var OpType_value = map[string]int32{
 	"LessEqual":    4,
 	"Equal":        5,
 	"NotEqual":     6,
+	"PrefixMatch":  7,
+	"PostfixMatch": 8,
 }
 
 func (x OpType) String() string {
@@ -61,6 +67,43 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type ArithOpType int32
+
+const (
+	ArithOpType_Unknown ArithOpType = 0
+	ArithOpType_Add     ArithOpType = 1
+	ArithOpType_Sub     ArithOpType = 2
+	ArithOpType_Mul     ArithOpType = 3
+	ArithOpType_Div     ArithOpType = 4
+	ArithOpType_Mod     ArithOpType = 5
+)
+
+var ArithOpType_name = map[int32]string{
+	0: "Unknown",
+	1: "Add",
+	2: "Sub",
+	3: "Mul",
+	4: "Div",
+	5: "Mod",
+}
+
+var ArithOpType_value = map[string]int32{
+	"Unknown": 0,
+	"Add":     1,
+	"Sub":     2,
+	"Mul":     3,
+	"Div":     4,
+	"Mod":     5,
+}
+
+func (x ArithOpType) String() string {
+	return proto.EnumName(ArithOpType_name, int32(x))
+}
+
+func (ArithOpType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -83,7 +126,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -111,7 +154,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{12, 0}
 }
 
 type GenericValue struct {
@@ -119,6 +162,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +210,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,30 +250,95 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
-type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+// RangeSearchParams limits the hits of a search to the distances between radius and range_filter
+type RangeSearchParams struct {
+	Radius               float32  `protobuf:"fixed32,1,opt,name=radius,proto3" json:"radius,omitempty"`
+	RangeFilter          float32  `protobuf:"fixed32,2,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
+	HasRangeFilter       bool     `protobuf:"varint,3,opt,name=has_range_filter,json=hasRangeFilter,proto3" json:"has_range_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *RangeSearchParams) Reset()         { *m = RangeSearchParams{} }
+func (m *RangeSearchParams) String() string { return proto.CompactTextString(m) }
+func (*RangeSearchParams) ProtoMessage()    {}
+func (*RangeSearchParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{1}
+}
+
+func (m *RangeSearchParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeSearchParams.Unmarshal(m, b)
+}
+func (m *RangeSearchParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeSearchParams.Marshal(b, m, deterministic)
+}
+func (m *RangeSearchParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeSearchParams.Merge(m, src)
+}
+func (m *RangeSearchParams) XXX_Size() int {
+	return xxx_messageInfo_RangeSearchParams.Size(m)
+}
+func (m *RangeSearchParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeSearchParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeSearchParams proto.InternalMessageInfo
+
+func (m *RangeSearchParams) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *RangeSearchParams) GetRangeFilter() float32 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
+func (m *RangeSearchParams) GetHasRangeFilter() bool {
+	if m != nil {
+		return m.HasRangeFilter
+	}
+	return false
+}
+
+type QueryInfo struct {
+	Topk                 int64              `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType           string             `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams         string             `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	RoundDecimal         int64              `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	RangeSearchParams    *RangeSearchParams `protobuf:"bytes,6,opt,name=range_search_params,json=rangeSearchParams,proto3" json:"range_search_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
 func (m *QueryInfo) Reset()         { *m = QueryInfo{} }
 func (m *QueryInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryInfo) ProtoMessage()    {}
 func (*QueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{1}
+	return fileDescriptor_2d655ab2f7683c23, []int{2}
 }
 
 func (m *QueryInfo) XXX_Unmarshal(b []byte) error {
@@ -272,21 +387,30 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetRangeSearchParams() *RangeSearchParams {
+	if m != nil {
+		return m.RangeSearchParams
+	}
+	return nil
+}
+
 type ColumnInfo struct {
-	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FieldId      int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	DataType     schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IsPrimaryKey bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	IsAutoID     bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	// key path into a JSON field, empty for other fields
+	NestedPath           []string `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
 func (m *ColumnInfo) String() string { return proto.CompactTextString(m) }
 func (*ColumnInfo) ProtoMessage()    {}
 func (*ColumnInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{2}
+	return fileDescriptor_2d655ab2f7683c23, []int{3}
 }
 
 func (m *ColumnInfo) XXX_Unmarshal(b []byte) error {
@@ -335,6 +459,13 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
 type UnaryRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Op                   OpType        `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
@@ -348,7 +479,7 @@ func (m *UnaryRangeExpr) Reset()         { *m = UnaryRangeExpr{} }
 func (m *UnaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryRangeExpr) ProtoMessage()    {}
 func (*UnaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{3}
+	return fileDescriptor_2d655ab2f7683c23, []int{4}
 }
 
 func (m *UnaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -405,7 +536,7 @@ func (m *BinaryRangeExpr) Reset()         { *m = BinaryRangeExpr{} }
 func (m *BinaryRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryRangeExpr) ProtoMessage()    {}
 func (*BinaryRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{4}
+	return fileDescriptor_2d655ab2f7683c23, []int{5}
 }
 
 func (m *BinaryRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -474,7 +605,7 @@ func (m *CompareExpr) Reset()         { *m = CompareExpr{} }
 func (m *CompareExpr) String() string { return proto.CompactTextString(m) }
 func (*CompareExpr) ProtoMessage()    {}
 func (*CompareExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{5}
+	return fileDescriptor_2d655ab2f7683c23, []int{6}
 }
 
 func (m *CompareExpr) XXX_Unmarshal(b []byte) error {
@@ -516,6 +647,212 @@ func (m *CompareExpr) GetOp() OpType {
 	return OpType_Invalid
 }
 
+// ArithExpr is a numeric operand, a column, a constant or an arithmetic of two operands
+type ArithExpr struct {
+	// Types that are valid to be assigned to Expr:
+	//	*ArithExpr_ColumnInfo
+	//	*ArithExpr_Value
+	//	*ArithExpr_BinaryArithExpr
+	Expr                 isArithExpr_Expr `protobuf_oneof:"expr"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ArithExpr) Reset()         { *m = ArithExpr{} }
+func (m *ArithExpr) String() string { return proto.CompactTextString(m) }
+func (*ArithExpr) ProtoMessage()    {}
+func (*ArithExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
+}
+
+func (m *ArithExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArithExpr.Unmarshal(m, b)
+}
+func (m *ArithExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArithExpr.Marshal(b, m, deterministic)
+}
+func (m *ArithExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithExpr.Merge(m, src)
+}
+func (m *ArithExpr) XXX_Size() int {
+	return xxx_messageInfo_ArithExpr.Size(m)
+}
+func (m *ArithExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArithExpr proto.InternalMessageInfo
+
+type isArithExpr_Expr interface {
+	isArithExpr_Expr()
+}
+
+type ArithExpr_ColumnInfo struct {
+	ColumnInfo *ColumnInfo `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3,oneof"`
+}
+
+type ArithExpr_Value struct {
+	Value *GenericValue `protobuf:"bytes,2,opt,name=value,proto3,oneof"`
+}
+
+type ArithExpr_BinaryArithExpr struct {
+	BinaryArithExpr *BinaryArithExpr `protobuf:"bytes,3,opt,name=binary_arith_expr,json=binaryArithExpr,proto3,oneof"`
+}
+
+func (*ArithExpr_ColumnInfo) isArithExpr_Expr() {}
+
+func (*ArithExpr_Value) isArithExpr_Expr() {}
+
+func (*ArithExpr_BinaryArithExpr) isArithExpr_Expr() {}
+
+func (m *ArithExpr) GetExpr() isArithExpr_Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *ArithExpr) GetColumnInfo() *ColumnInfo {
+	if x, ok := m.GetExpr().(*ArithExpr_ColumnInfo); ok {
+		return x.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArithExpr) GetValue() *GenericValue {
+	if x, ok := m.GetExpr().(*ArithExpr_Value); ok {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *ArithExpr) GetBinaryArithExpr() *BinaryArithExpr {
+	if x, ok := m.GetExpr().(*ArithExpr_BinaryArithExpr); ok {
+		return x.BinaryArithExpr
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ArithExpr) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ArithExpr_ColumnInfo)(nil),
+		(*ArithExpr_Value)(nil),
+		(*ArithExpr_BinaryArithExpr)(nil),
+	}
+}
+
+type BinaryArithExpr struct {
+	Op                   ArithOpType `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.ArithOpType" json:"op,omitempty"`
+	Left                 *ArithExpr  `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *ArithExpr  `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
+func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
+func (*BinaryArithExpr) ProtoMessage()    {}
+func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
+}
+
+func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BinaryArithExpr.Unmarshal(m, b)
+}
+func (m *BinaryArithExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BinaryArithExpr.Marshal(b, m, deterministic)
+}
+func (m *BinaryArithExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryArithExpr.Merge(m, src)
+}
+func (m *BinaryArithExpr) XXX_Size() int {
+	return xxx_messageInfo_BinaryArithExpr.Size(m)
+}
+func (m *BinaryArithExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryArithExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryArithExpr proto.InternalMessageInfo
+
+func (m *BinaryArithExpr) GetOp() ArithOpType {
+	if m != nil {
+		return m.Op
+	}
+	return ArithOpType_Unknown
+}
+
+func (m *BinaryArithExpr) GetLeft() *ArithExpr {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *BinaryArithExpr) GetRight() *ArithExpr {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+type ArithCompareExpr struct {
+	Left                 *ArithExpr `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *ArithExpr `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	Op                   OpType     `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ArithCompareExpr) Reset()         { *m = ArithCompareExpr{} }
+func (m *ArithCompareExpr) String() string { return proto.CompactTextString(m) }
+func (*ArithCompareExpr) ProtoMessage()    {}
+func (*ArithCompareExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *ArithCompareExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArithCompareExpr.Unmarshal(m, b)
+}
+func (m *ArithCompareExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArithCompareExpr.Marshal(b, m, deterministic)
+}
+func (m *ArithCompareExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithCompareExpr.Merge(m, src)
+}
+func (m *ArithCompareExpr) XXX_Size() int {
+	return xxx_messageInfo_ArithCompareExpr.Size(m)
+}
+func (m *ArithCompareExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithCompareExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArithCompareExpr proto.InternalMessageInfo
+
+func (m *ArithCompareExpr) GetLeft() *ArithExpr {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetRight() *ArithExpr {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
 type TermExpr struct {
 	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Values               []*GenericValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
@@ -528,7 +865,7 @@ func (m *TermExpr) Reset()         { *m = TermExpr{} }
 func (m *TermExpr) String() string { return proto.CompactTextString(m) }
 func (*TermExpr) ProtoMessage()    {}
 func (*TermExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{6}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *TermExpr) XXX_Unmarshal(b []byte) error {
@@ -575,7 +912,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -623,7 +960,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -673,6 +1010,7 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_ArithCompareExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -683,7 +1021,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -732,6 +1070,10 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_ArithCompareExpr struct {
+	ArithCompareExpr *ArithCompareExpr `protobuf:"bytes,7,opt,name=arith_compare_expr,json=arithCompareExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -744,6 +1086,8 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_ArithCompareExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -793,6 +1137,13 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetArithCompareExpr() *ArithCompareExpr {
+	if x, ok := m.GetExpr().(*Expr_ArithCompareExpr); ok {
+		return x.ArithCompareExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -802,6 +1153,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_ArithCompareExpr)(nil),
 	}
 }
 
@@ -820,7 +1172,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -880,18 +1232,22 @@ type PlanNode struct {
 	// Types that are valid to be assigned to Node:
 	//	*PlanNode_VectorAnns
 	//	*PlanNode_Predicates
-	Node                 isPlanNode_Node `protobuf_oneof:"node"`
-	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Node           isPlanNode_Node `protobuf_oneof:"node"`
+	OutputFieldIds []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	// entities inserted before expire_timestamp are expired and filtered out, 0 means never expire
+	ExpireTimestamp uint64 `protobuf:"varint,4,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty"`
+	// a retrieve keeps only the limit entities with the smallest primary keys of each segment, 0 means no limit
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -956,6 +1312,20 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetExpireTimestamp() uint64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
+func (m *PlanNode) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -966,14 +1336,19 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
+	proto.RegisterType((*RangeSearchParams)(nil), "milvus.proto.plan.RangeSearchParams")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
 	proto.RegisterType((*UnaryRangeExpr)(nil), "milvus.proto.plan.UnaryRangeExpr")
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
+	proto.RegisterType((*ArithExpr)(nil), "milvus.proto.plan.ArithExpr")
+	proto.RegisterType((*BinaryArithExpr)(nil), "milvus.proto.plan.BinaryArithExpr")
+	proto.RegisterType((*ArithCompareExpr)(nil), "milvus.proto.plan.ArithCompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
@@ -985,74 +1360,96 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0x6a, 0x25, 0x79, 0xb7, 0xa5, 0x48, 0xca, 0x5e, 0xfe, 0xce, 0x3f, 0x04, 0x9b, 0x25,
-	0x45, 0x0c, 0x54, 0xec, 0x22, 0x09, 0x4e, 0x11, 0x0a, 0xca, 0x5f, 0xc1, 0x52, 0x11, 0x6c, 0xb3,
-	0x18, 0x1f, 0xb8, 0x6c, 0x8d, 0x76, 0xc7, 0xd2, 0x54, 0x66, 0x77, 0xd6, 0xb3, 0xb3, 0x22, 0x3a,
-	0x73, 0xe3, 0xc6, 0x4b, 0xc0, 0x19, 0x6e, 0xbc, 0x03, 0x0f, 0xc0, 0x9d, 0x17, 0xa1, 0xa6, 0x67,
-	0xad, 0x8f, 0x94, 0xec, 0x98, 0xaa, 0xdc, 0x66, 0x7e, 0xd3, 0xdd, 0xd3, 0xbf, 0xfe, 0x9a, 0x01,
-	0xc8, 0x38, 0x49, 0x37, 0x33, 0x29, 0x94, 0xf0, 0x6e, 0x27, 0x8c, 0x8f, 0x8b, 0xdc, 0xec, 0x36,
-	0xf5, 0xc1, 0xff, 0x5b, 0x79, 0x34, 0xa2, 0x09, 0x31, 0x90, 0x9f, 0x41, 0xeb, 0x90, 0xa6, 0x54,
-	0xb2, 0xe8, 0x8c, 0xf0, 0x82, 0x7a, 0x77, 0xc1, 0x19, 0x08, 0xc1, 0xc3, 0x31, 0xe1, 0xab, 0xd6,
-	0xba, 0xb5, 0xe1, 0xf4, 0x2a, 0xc1, 0x8a, 0x46, 0xce, 0x08, 0xf7, 0xee, 0x81, 0xcb, 0x52, 0xb5,
-	0xfd, 0x04, 0x4f, 0xab, 0xeb, 0xd6, 0x86, 0xdd, 0xab, 0x04, 0x0e, 0x42, 0xe5, 0xf1, 0x39, 0x17,
-	0x44, 0xe1, 0xb1, 0xbd, 0x6e, 0x6d, 0x58, 0xfa, 0x18, 0xa1, 0x33, 0xc2, 0xf7, 0xea, 0x60, 0x8f,
-	0x09, 0xf7, 0x7f, 0xb6, 0xc0, 0xfd, 0xb6, 0xa0, 0x72, 0xd2, 0x4f, 0xcf, 0x85, 0xe7, 0x41, 0x4d,
-	0x89, 0xec, 0x25, 0xde, 0x65, 0x07, 0xb8, 0xf6, 0xd6, 0xa0, 0x99, 0x50, 0x25, 0x59, 0x14, 0xaa,
-	0x49, 0x46, 0xd1, 0x92, 0x1b, 0x80, 0x81, 0x4e, 0x27, 0x19, 0xf5, 0xde, 0x87, 0x5b, 0x39, 0x25,
-	0x32, 0x1a, 0x85, 0x19, 0x91, 0x24, 0xc9, 0x57, 0x6b, 0x28, 0xd2, 0x32, 0xe0, 0x09, 0x62, 0x5a,
-	0x48, 0x8a, 0x22, 0x8d, 0xc3, 0x98, 0x46, 0x2c, 0x21, 0x7c, 0xb5, 0x8e, 0x57, 0xb4, 0x10, 0x3c,
-	0x30, 0x98, 0xff, 0xab, 0x05, 0xb0, 0x2f, 0x78, 0x91, 0xa4, 0xe8, 0xcd, 0x1d, 0x70, 0xce, 0x19,
-	0xe5, 0x71, 0xc8, 0xe2, 0xd2, 0xa3, 0x15, 0xdc, 0xf7, 0x63, 0xef, 0x19, 0xb8, 0x31, 0x51, 0xc4,
-	0xb8, 0xa4, 0xb9, 0xb7, 0x1f, 0xdd, 0xdb, 0x5c, 0x88, 0x6e, 0x19, 0xd7, 0x03, 0xa2, 0x88, 0xf6,
-	0x32, 0x70, 0xe2, 0x72, 0xe5, 0xdd, 0x87, 0x36, 0xcb, 0xc3, 0x4c, 0xb2, 0x84, 0xc8, 0x49, 0xf8,
-	0x92, 0x4e, 0x90, 0x93, 0x13, 0xb4, 0x58, 0x7e, 0x62, 0xc0, 0xaf, 0xe9, 0xc4, 0xbb, 0x0b, 0x2e,
-	0xcb, 0x43, 0x52, 0x28, 0xd1, 0x3f, 0x40, 0x46, 0x4e, 0xe0, 0xb0, 0x7c, 0x17, 0xf7, 0xfe, 0x1f,
-	0x16, 0xb4, 0xbf, 0x4f, 0x89, 0x9c, 0x04, 0x24, 0x1d, 0xd2, 0xe7, 0xaf, 0x32, 0xe9, 0x7d, 0x09,
-	0xcd, 0x08, 0x5d, 0x0f, 0x59, 0x7a, 0x2e, 0xd0, 0xdf, 0xe6, 0xeb, 0x3e, 0x61, 0x29, 0xcc, 0x08,
-	0x06, 0x10, 0xcd, 0xc8, 0x7e, 0x08, 0x55, 0x91, 0x95, 0x54, 0xee, 0x2c, 0x51, 0x3b, 0xce, 0x90,
-	0x46, 0x55, 0x64, 0xde, 0xa7, 0x50, 0x1f, 0xeb, 0xf2, 0x40, 0xbf, 0x9b, 0x8f, 0xd6, 0x96, 0x48,
-	0xcf, 0x57, 0x51, 0x60, 0xa4, 0xfd, 0xdf, 0xaa, 0xd0, 0xd9, 0x63, 0x6f, 0xd7, 0xeb, 0x07, 0xd0,
-	0xe1, 0xe2, 0x47, 0x2a, 0x43, 0x96, 0x46, 0xbc, 0xc8, 0xd9, 0xd8, 0x64, 0xc3, 0x09, 0xda, 0x08,
-	0xf7, 0x2f, 0x51, 0x2d, 0x58, 0x64, 0xd9, 0x82, 0xa0, 0x89, 0x7a, 0x1b, 0xe1, 0x99, 0xe0, 0x0e,
-	0x34, 0x8d, 0x45, 0x43, 0xb1, 0x76, 0x33, 0x8a, 0x80, 0x3a, 0xa6, 0x69, 0x76, 0xa0, 0x69, 0xae,
-	0x32, 0x16, 0xea, 0x37, 0xb4, 0x80, 0x3a, 0xb8, 0xf6, 0xff, 0xb2, 0xa0, 0xb9, 0x2f, 0x92, 0x8c,
-	0x48, 0x13, 0xa5, 0x43, 0xe8, 0x72, 0x7a, 0xae, 0xc2, 0xff, 0x1c, 0xaa, 0xb6, 0x56, 0x9b, 0xab,
-	0xe8, 0x3e, 0xdc, 0x96, 0x6c, 0x38, 0x5a, 0xb4, 0x54, 0xbd, 0x89, 0xa5, 0x0e, 0xea, 0xed, 0xbf,
-	0x5e, 0x2f, 0xf6, 0x0d, 0xea, 0xc5, 0xff, 0xc9, 0x02, 0xe7, 0x94, 0xca, 0xe4, 0xad, 0x64, 0xfc,
-	0x29, 0x34, 0x30, 0xae, 0xf9, 0x6a, 0x75, 0xdd, 0xbe, 0x49, 0x60, 0x4b, 0x71, 0xff, 0x17, 0x0b,
-	0x5c, 0xec, 0x19, 0x74, 0xe3, 0x09, 0xba, 0x6f, 0xa1, 0xfb, 0xf7, 0x97, 0x98, 0x98, 0x4a, 0x9a,
-	0xd5, 0x71, 0x86, 0x95, 0xff, 0x10, 0xea, 0xd1, 0x88, 0xf1, 0xb8, 0x8c, 0xd9, 0xff, 0x96, 0x28,
-	0x6a, 0x9d, 0xc0, 0x48, 0xf9, 0x6b, 0xb0, 0x52, 0x6a, 0x7b, 0x4d, 0x58, 0xe9, 0xa7, 0x63, 0xc2,
-	0x59, 0xdc, 0xad, 0x78, 0x2b, 0x60, 0x1f, 0x09, 0xd5, 0xb5, 0xfc, 0xbf, 0x2d, 0x00, 0xd3, 0x12,
-	0xe8, 0xd4, 0xf6, 0x9c, 0x53, 0x1f, 0x2c, 0xb1, 0x3d, 0x13, 0x2d, 0x97, 0xa5, 0x5b, 0x1f, 0x43,
-	0x4d, 0x27, 0xfa, 0x4d, 0x5e, 0xa1, 0x90, 0xe6, 0x80, 0xb9, 0x2c, 0xbb, 0xf7, 0x6a, 0x0e, 0x28,
-	0xe5, 0x6f, 0x83, 0x73, 0x79, 0xd7, 0x22, 0x89, 0x36, 0xc0, 0x0b, 0x31, 0x64, 0x11, 0xe1, 0xbb,
-	0x69, 0xdc, 0xb5, 0xbc, 0x5b, 0xe0, 0x96, 0xfb, 0x63, 0xd9, 0xad, 0xfa, 0xbf, 0xdb, 0x50, 0x43,
-	0x52, 0xcf, 0xc0, 0x55, 0x54, 0x26, 0x21, 0x7d, 0x95, 0xc9, 0x32, 0xdd, 0x77, 0x97, 0xdc, 0x79,
-	0x59, 0x20, 0xfa, 0x91, 0x50, 0x97, 0xc5, 0xf2, 0x05, 0x40, 0xa1, 0xef, 0x36, 0xca, 0x86, 0xde,
-	0x3b, 0xd7, 0x65, 0xab, 0x57, 0x09, 0xdc, 0x62, 0x1a, 0xcf, 0x1d, 0x68, 0x0e, 0xd8, 0x4c, 0xdf,
-	0xbe, 0xb2, 0xd6, 0x66, 0x81, 0xed, 0x55, 0x02, 0x18, 0xcc, 0x32, 0xb2, 0x0f, 0xad, 0xc8, 0x34,
-	0xa2, 0x31, 0x61, 0xc6, 0xc1, 0xbb, 0x4b, 0xcb, 0x75, 0xda, 0xaf, 0xbd, 0x4a, 0xd0, 0x8c, 0xe6,
-	0xda, 0xf7, 0x1b, 0xe8, 0x1a, 0x16, 0x52, 0xcf, 0x3d, 0x63, 0xc8, 0x4c, 0x85, 0xf7, 0xae, 0xe2,
-	0x32, 0x9d, 0x90, 0xbd, 0x4a, 0xd0, 0x2e, 0x16, 0x67, 0xe6, 0x09, 0xdc, 0x2e, 0x59, 0xcd, 0xd9,
-	0x6b, 0xa0, 0x3d, 0xff, 0x4a, 0x6e, 0xf3, 0x06, 0x3b, 0x83, 0x45, 0x68, 0xaf, 0x01, 0x35, 0x6d,
-	0xc4, 0xff, 0xc7, 0x02, 0x38, 0xa3, 0x91, 0x12, 0x72, 0xf7, 0xe8, 0xe8, 0xbb, 0xf2, 0x09, 0x32,
-	0xc2, 0xe6, 0xf9, 0xd7, 0x4f, 0x90, 0xb1, 0xb7, 0xf0, 0x38, 0x56, 0x17, 0x1f, 0xc7, 0xa7, 0x00,
-	0x99, 0xa4, 0x31, 0x8b, 0x88, 0xa2, 0xf9, 0x9b, 0xca, 0x6c, 0x4e, 0xd4, 0xfb, 0x1c, 0xe0, 0x42,
-	0xff, 0x05, 0xcc, 0x68, 0xa8, 0x5d, 0x99, 0xee, 0xe9, 0x87, 0x21, 0x70, 0x2f, 0xa6, 0x7f, 0x87,
-	0x07, 0xd0, 0xc9, 0x38, 0x89, 0xe8, 0x48, 0xf0, 0x98, 0xca, 0x50, 0x91, 0x21, 0x06, 0xd9, 0x0d,
-	0xda, 0x73, 0xf0, 0x29, 0x19, 0xfa, 0x7f, 0x5a, 0xe0, 0x9c, 0x70, 0x92, 0x1e, 0x89, 0x18, 0x87,
-	0xf5, 0x18, 0x19, 0x87, 0x24, 0x4d, 0xf3, 0x6b, 0xc6, 0xd1, 0x2c, 0x2e, 0xba, 0x44, 0x8c, 0xce,
-	0x6e, 0x9a, 0xe6, 0xde, 0x67, 0x0b, 0x6c, 0xaf, 0x6f, 0x41, 0xad, 0x3a, 0xc7, 0x77, 0x03, 0xba,
-	0xa2, 0x50, 0x59, 0xa1, 0xc2, 0xcb, 0x50, 0xea, 0x70, 0xd9, 0x1b, 0x76, 0xd0, 0x36, 0xf8, 0x57,
-	0x26, 0xa2, 0xb9, 0xce, 0x50, 0x2a, 0x62, 0xfa, 0x51, 0x0a, 0x0d, 0x33, 0x58, 0x17, 0x7b, 0xb1,
-	0x03, 0xcd, 0x43, 0x49, 0x89, 0xa2, 0xf2, 0x74, 0x44, 0xd2, 0xae, 0xe5, 0x75, 0xa1, 0x55, 0x02,
-	0xcf, 0x2f, 0x0a, 0xc2, 0xbb, 0x55, 0xaf, 0x05, 0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6e, 0x63, 0xb3,
-	0xd2, 0x3c, 0x37, 0x87, 0x35, 0xcf, 0x85, 0xba, 0x59, 0xd6, 0xb5, 0xdc, 0x91, 0x50, 0x66, 0xd7,
-	0xd8, 0x7b, 0xfc, 0xc3, 0x27, 0x43, 0xa6, 0x46, 0xc5, 0x60, 0x33, 0x12, 0xc9, 0x96, 0x21, 0xf5,
-	0x90, 0x89, 0x72, 0xb5, 0xc5, 0x52, 0x45, 0x65, 0x4a, 0xf8, 0x16, 0xf2, 0xdc, 0xd2, 0x3c, 0xb3,
-	0xc1, 0xa0, 0x81, 0xbb, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x21, 0x9d, 0x5f, 0x11, 0x7b,
-	0x0a, 0x00, 0x00,
+	// 1444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x73, 0x13, 0xc7,
+	0x12, 0xd7, 0xea, 0xbf, 0x5a, 0x42, 0x5e, 0xcf, 0x7b, 0xf5, 0x9e, 0x79, 0x3c, 0xb0, 0x11, 0x54,
+	0x10, 0xa4, 0xb0, 0x13, 0x43, 0xa0, 0x42, 0x2a, 0x29, 0xff, 0x03, 0xcb, 0x15, 0x30, 0xce, 0xda,
+	0xf8, 0x90, 0xcb, 0xd6, 0x68, 0x77, 0x6c, 0x4d, 0xb1, 0xff, 0x98, 0x9d, 0x15, 0xf6, 0x39, 0x55,
+	0xb9, 0xf3, 0x19, 0x72, 0xe0, 0x9e, 0x2f, 0x91, 0x4b, 0x3e, 0x40, 0x8e, 0xa9, 0xca, 0xb7, 0xc8,
+	0x29, 0x35, 0x3d, 0x2b, 0x69, 0xd7, 0x48, 0xc6, 0xae, 0xe2, 0x36, 0xf3, 0x9b, 0xee, 0x9e, 0xfe,
+	0x75, 0xf7, 0xf4, 0x34, 0x40, 0xe4, 0xd1, 0x60, 0x39, 0x12, 0xa1, 0x0c, 0xc9, 0xbc, 0xcf, 0xbd,
+	0x61, 0x12, 0xeb, 0xdd, 0xb2, 0x3a, 0xf8, 0x5f, 0x2b, 0x76, 0x06, 0xcc, 0xa7, 0x1a, 0xea, 0xbc,
+	0x33, 0xa0, 0xb5, 0xcd, 0x02, 0x26, 0xb8, 0x73, 0x48, 0xbd, 0x84, 0x91, 0x6b, 0x50, 0xef, 0x87,
+	0xa1, 0x67, 0x0f, 0xa9, 0xb7, 0x60, 0x2c, 0x19, 0xdd, 0x7a, 0xaf, 0x60, 0xd5, 0x14, 0x72, 0x48,
+	0x3d, 0x72, 0x1d, 0x1a, 0x3c, 0x90, 0x8f, 0x1e, 0xe2, 0x69, 0x71, 0xc9, 0xe8, 0x96, 0x7a, 0x05,
+	0xab, 0x8e, 0x50, 0x7a, 0x7c, 0xe4, 0x85, 0x54, 0xe2, 0x71, 0x69, 0xc9, 0xe8, 0x1a, 0xea, 0x18,
+	0x21, 0x75, 0xbc, 0x08, 0x10, 0x4b, 0xc1, 0x83, 0x63, 0x3c, 0x2f, 0x2f, 0x19, 0xdd, 0x46, 0xaf,
+	0x60, 0x35, 0x34, 0x76, 0x48, 0xbd, 0x8d, 0x0a, 0x94, 0x86, 0xd4, 0xeb, 0x9c, 0xc0, 0xbc, 0x45,
+	0x83, 0x63, 0xb6, 0xcf, 0xa8, 0x70, 0x06, 0x7b, 0x54, 0x50, 0x3f, 0x26, 0xff, 0x81, 0xaa, 0xa0,
+	0x2e, 0x4f, 0x62, 0xf4, 0xaa, 0x68, 0xa5, 0x3b, 0x72, 0x13, 0x5a, 0x42, 0x09, 0xdb, 0x47, 0xdc,
+	0x93, 0x4c, 0xa0, 0x57, 0x45, 0xab, 0x89, 0xd8, 0x33, 0x84, 0x48, 0x17, 0xcc, 0x01, 0x8d, 0xed,
+	0x9c, 0x98, 0xf2, 0xae, 0x6e, 0xb5, 0x07, 0x34, 0xb6, 0x26, 0x92, 0x9d, 0x3f, 0x0d, 0x68, 0xfc,
+	0x90, 0x30, 0x71, 0xba, 0x13, 0x1c, 0x85, 0x84, 0x40, 0x59, 0x86, 0xd1, 0x6b, 0xbc, 0xb0, 0x64,
+	0xe1, 0x9a, 0x2c, 0x42, 0xd3, 0x67, 0x52, 0x70, 0xc7, 0x96, 0xa7, 0x11, 0x43, 0x33, 0x0d, 0x0b,
+	0x34, 0x74, 0x70, 0x1a, 0x31, 0x72, 0x0b, 0xae, 0xc4, 0xe8, 0xb7, 0x1d, 0xa1, 0xe3, 0x9a, 0xa7,
+	0xd5, 0x8a, 0xb3, 0x64, 0x6e, 0xc1, 0x15, 0x11, 0x26, 0x81, 0x6b, 0xbb, 0xcc, 0xe1, 0x3e, 0xf5,
+	0x16, 0x2a, 0x78, 0x45, 0x0b, 0xc1, 0x2d, 0x8d, 0x91, 0x03, 0xf8, 0x97, 0x76, 0x39, 0x6f, 0xaf,
+	0xba, 0x64, 0x74, 0x9b, 0xab, 0xb7, 0x97, 0x3f, 0xc8, 0xec, 0xf2, 0x07, 0x41, 0xb3, 0xe6, 0xc5,
+	0x59, 0xa8, 0xf3, 0x9b, 0x01, 0xb0, 0x19, 0x7a, 0x89, 0x1f, 0x20, 0xc7, 0xab, 0x50, 0x3f, 0xe2,
+	0xcc, 0x73, 0x6d, 0xee, 0xa6, 0x3c, 0x6b, 0xb8, 0xdf, 0x71, 0xc9, 0x13, 0x68, 0xb8, 0x54, 0x52,
+	0x4d, 0x54, 0x85, 0xb5, 0xbd, 0x7a, 0x3d, 0x7f, 0x6b, 0x5a, 0x49, 0x5b, 0x54, 0x52, 0xc5, 0xdd,
+	0xaa, 0xbb, 0xe9, 0x8a, 0xdc, 0x86, 0x36, 0x8f, 0xed, 0x48, 0x70, 0x9f, 0x8a, 0x53, 0xfb, 0x35,
+	0x3b, 0x4d, 0x03, 0xde, 0xe2, 0xf1, 0x9e, 0x06, 0xbf, 0x67, 0xa7, 0xe4, 0x1a, 0x34, 0x78, 0x6c,
+	0xd3, 0x44, 0x86, 0x3b, 0x5b, 0x18, 0xa7, 0xba, 0x55, 0xe7, 0xf1, 0x3a, 0xee, 0x55, 0xa4, 0x03,
+	0x16, 0x4b, 0xe6, 0xda, 0x11, 0x95, 0x83, 0x85, 0xca, 0x52, 0x49, 0x45, 0x5a, 0x43, 0x7b, 0x54,
+	0x0e, 0x3a, 0xbf, 0x1a, 0xd0, 0x7e, 0x15, 0x50, 0x71, 0x8a, 0xbc, 0x9f, 0x9e, 0x44, 0x82, 0x7c,
+	0x07, 0x4d, 0x07, 0xb9, 0xd9, 0x3c, 0x38, 0x0a, 0x91, 0x50, 0xf3, 0xac, 0xd3, 0x18, 0xaa, 0x49,
+	0x04, 0x2c, 0x70, 0x26, 0xd1, 0xb8, 0x0b, 0xc5, 0x30, 0x4a, 0xb9, 0x5e, 0x9d, 0xa2, 0xf6, 0x32,
+	0x42, 0x9e, 0xc5, 0x30, 0x22, 0x5f, 0x41, 0x65, 0xa8, 0x1e, 0x0c, 0x12, 0x6b, 0xae, 0x2e, 0x4e,
+	0x91, 0xce, 0xbe, 0x2b, 0x4b, 0x4b, 0x77, 0xde, 0x17, 0x61, 0x6e, 0x83, 0x7f, 0x5a, 0xaf, 0xef,
+	0xc0, 0x9c, 0x17, 0xbe, 0x65, 0xc2, 0xe6, 0x81, 0xe3, 0x25, 0x31, 0x1f, 0xea, 0x74, 0xd5, 0xad,
+	0x36, 0xc2, 0x3b, 0x23, 0x54, 0x09, 0x26, 0x51, 0x94, 0x13, 0x4c, 0xdf, 0x01, 0xc2, 0x13, 0xc1,
+	0x35, 0x68, 0x6a, 0x8b, 0x9a, 0x62, 0xf9, 0x62, 0x14, 0x01, 0x75, 0x74, 0x1b, 0x59, 0x83, 0xa6,
+	0xbe, 0x4a, 0x5b, 0xa8, 0x5c, 0xd0, 0x02, 0xea, 0xe0, 0xba, 0xf3, 0xbb, 0x01, 0xcd, 0xcd, 0xd0,
+	0x8f, 0xa8, 0xd0, 0x51, 0xda, 0x06, 0xd3, 0x63, 0x47, 0xd2, 0xbe, 0x74, 0xa8, 0xda, 0x4a, 0x2d,
+	0x53, 0xf2, 0x3b, 0x30, 0x2f, 0xf8, 0xf1, 0x20, 0x6f, 0xa9, 0x78, 0x11, 0x4b, 0x73, 0xa8, 0xb7,
+	0x79, 0xb6, 0x5e, 0x4a, 0x17, 0xa8, 0x17, 0x6c, 0x2d, 0xeb, 0x82, 0xcb, 0x01, 0x92, 0x59, 0xbb,
+	0x7c, 0xca, 0x7b, 0x85, 0x5c, 0xd2, 0x1f, 0x8f, 0xea, 0xaf, 0x78, 0xa1, 0xd0, 0xf6, 0x0a, 0x69,
+	0x05, 0x92, 0x3d, 0x98, 0xef, 0x63, 0x01, 0xda, 0x54, 0xb9, 0x63, 0xb3, 0x93, 0x48, 0xa4, 0x45,
+	0xdc, 0x99, 0x62, 0x44, 0x17, 0xeb, 0xd8, 0xf3, 0x5e, 0xc1, 0x9a, 0xeb, 0xe7, 0xa1, 0x8d, 0x2a,
+	0x94, 0x95, 0x91, 0xce, 0x7b, 0x63, 0x54, 0xdb, 0x13, 0xa2, 0xcb, 0x18, 0x21, 0x03, 0x23, 0x74,
+	0x63, 0x8a, 0x79, 0x94, 0xcc, 0x3c, 0xab, 0x2f, 0xa0, 0xac, 0xd2, 0x95, 0xb2, 0xfa, 0xff, 0x2c,
+	0x0d, 0x65, 0xdb, 0x42, 0x49, 0xb2, 0x0a, 0x15, 0x4c, 0x4b, 0xca, 0xe1, 0x7c, 0x15, 0x2d, 0xda,
+	0xf9, 0xc5, 0x00, 0x13, 0xc1, 0x6c, 0x81, 0x8d, 0xae, 0x36, 0x2e, 0x7f, 0x75, 0xf1, 0xc2, 0x57,
+	0x5f, 0xa6, 0x64, 0x7e, 0x32, 0xa0, 0x7e, 0xc0, 0x84, 0xff, 0x49, 0x9a, 0xc4, 0x63, 0xa8, 0x62,
+	0xfe, 0xe3, 0x85, 0xe2, 0x52, 0xe9, 0x22, 0x6f, 0x31, 0x15, 0x57, 0x13, 0x42, 0x03, 0xdb, 0x2c,
+	0xba, 0xf1, 0x30, 0x93, 0xcf, 0x69, 0x7f, 0xd0, 0x58, 0x52, 0xaf, 0x5e, 0x46, 0x98, 0xd5, 0xfb,
+	0x50, 0x71, 0x06, 0xdc, 0x73, 0xd3, 0x40, 0xfd, 0x77, 0x8a, 0xa2, 0x8e, 0x11, 0x4a, 0x75, 0x16,
+	0xa1, 0x96, 0x6a, 0x93, 0x26, 0xd4, 0x76, 0x82, 0x21, 0xf5, 0xb8, 0x6b, 0x16, 0x48, 0x0d, 0x4a,
+	0xbb, 0xa1, 0x34, 0x8d, 0xce, 0x1f, 0x06, 0x80, 0xae, 0x34, 0x74, 0xea, 0x51, 0xc6, 0xa9, 0xcf,
+	0x66, 0xd6, 0x30, 0x7a, 0xa5, 0x97, 0xa9, 0x5b, 0x9f, 0xe7, 0x8a, 0x6d, 0xa6, 0x57, 0x3a, 0xd9,
+	0xf7, 0xf3, 0x75, 0x36, 0x9b, 0x83, 0x2e, 0xb1, 0x47, 0x50, 0x1f, 0xdd, 0x95, 0x27, 0xd1, 0x06,
+	0x78, 0x1e, 0x1e, 0x73, 0x87, 0x7a, 0xeb, 0x81, 0x6b, 0x1a, 0xe4, 0x0a, 0x34, 0xd2, 0xfd, 0x4b,
+	0x61, 0x16, 0x3b, 0x3f, 0x97, 0xa1, 0x8c, 0xa4, 0x9e, 0x40, 0x43, 0x32, 0xe1, 0xeb, 0xf7, 0xa9,
+	0xd3, 0x7d, 0x6d, 0xca, 0x9d, 0xa3, 0x02, 0x51, 0x93, 0x96, 0x1c, 0x15, 0xcb, 0xb7, 0x00, 0x09,
+	0x3e, 0x71, 0x54, 0x9e, 0x5d, 0x9d, 0xe3, 0x6c, 0xa9, 0x39, 0x2c, 0x19, 0xc7, 0x73, 0x0d, 0x9a,
+	0x69, 0x8b, 0xc8, 0x34, 0x87, 0xeb, 0xe7, 0x06, 0x56, 0x75, 0xa7, 0xfe, 0x24, 0x23, 0x9b, 0xd0,
+	0x72, 0xf4, 0xd3, 0xd2, 0x26, 0xf4, 0x0f, 0x72, 0x63, 0x6a, 0xb9, 0x8e, 0x5f, 0x60, 0xaf, 0x60,
+	0x35, 0x9d, 0xcc, 0x83, 0x7c, 0x01, 0xa6, 0x66, 0xa1, 0xc7, 0x20, 0x34, 0xa4, 0x3f, 0x92, 0x9b,
+	0xb3, 0xb8, 0x8c, 0x3f, 0xd5, 0x5e, 0xc1, 0x6a, 0x27, 0xf9, 0x6f, 0x76, 0xd2, 0xf8, 0x32, 0xf6,
+	0xaa, 0x1f, 0x69, 0x7c, 0x59, 0x83, 0x69, 0xe3, 0x9b, 0x58, 0xdc, 0x07, 0xa2, 0x7b, 0x68, 0x8e,
+	0x6b, 0x0d, 0x4d, 0xde, 0x9a, 0xd5, 0x0c, 0xf2, 0x84, 0x4d, 0x7a, 0x06, 0x1b, 0x77, 0xd3, 0xbf,
+	0x0c, 0x80, 0x43, 0xe6, 0xc8, 0x50, 0xac, 0xef, 0xee, 0xee, 0xa7, 0xb3, 0x92, 0xf6, 0x40, 0x0f,
+	0xe6, 0x6a, 0x56, 0xd2, 0x4e, 0xe6, 0xa6, 0xb8, 0x62, 0x7e, 0x8a, 0x7b, 0x0c, 0x10, 0x09, 0xe6,
+	0x72, 0x87, 0x4a, 0x16, 0x7f, 0xac, 0x76, 0x33, 0xa2, 0xe4, 0x1b, 0x80, 0x37, 0x6a, 0x14, 0xd6,
+	0xfd, 0xa6, 0x3c, 0xb3, 0x86, 0xc6, 0xf3, 0xb2, 0xd5, 0x78, 0x33, 0x1e, 0x9d, 0xef, 0xc0, 0x5c,
+	0xe4, 0x51, 0x87, 0x0d, 0x42, 0xcf, 0x65, 0xc2, 0x96, 0xf4, 0x18, 0x33, 0xd7, 0xb0, 0xda, 0x19,
+	0xf8, 0x80, 0x1e, 0x77, 0xfe, 0x36, 0xa0, 0xbe, 0xe7, 0xd1, 0x60, 0x37, 0x74, 0x71, 0x68, 0x18,
+	0x22, 0x63, 0x9b, 0x06, 0x41, 0x7c, 0x4e, 0x8f, 0x9b, 0xc4, 0x45, 0xd5, 0x9d, 0xd6, 0x59, 0x0f,
+	0x82, 0x98, 0x7c, 0x9d, 0x63, 0x7b, 0xfe, 0xbb, 0x56, 0xaa, 0x19, 0xbe, 0x5d, 0x30, 0xc3, 0x44,
+	0x46, 0x89, 0xb4, 0x47, 0xa1, 0x54, 0xe1, 0x2a, 0x75, 0x4b, 0x56, 0x5b, 0xe3, 0xcf, 0x74, 0x44,
+	0x63, 0x72, 0x17, 0x4c, 0x76, 0x12, 0x71, 0xc1, 0x6c, 0xc9, 0x7d, 0x16, 0x4b, 0xea, 0x47, 0x18,
+	0x9f, 0xb2, 0x35, 0xa7, 0xf1, 0x83, 0x11, 0x4c, 0xfe, 0x0d, 0x15, 0x8f, 0xfb, 0x5c, 0xa6, 0x03,
+	0xbe, 0xde, 0xa8, 0x14, 0x07, 0xa1, 0xcb, 0xee, 0xbd, 0x33, 0xa0, 0xaa, 0xfb, 0x7d, 0xbe, 0x45,
+	0xcc, 0x41, 0x73, 0x5b, 0x30, 0x2a, 0x99, 0x38, 0x18, 0xd0, 0xc0, 0x34, 0x88, 0x09, 0xad, 0x14,
+	0x78, 0xfa, 0x26, 0xa1, 0x9e, 0x59, 0x24, 0x2d, 0xa8, 0x3f, 0x67, 0x71, 0x8c, 0xe7, 0x25, 0xec,
+	0x21, 0x2c, 0x8e, 0xf5, 0x61, 0x99, 0x34, 0xa0, 0xa2, 0x97, 0x15, 0x25, 0xb7, 0x1b, 0x4a, 0xbd,
+	0xab, 0x2a, 0xc3, 0x7b, 0x82, 0x1d, 0xf1, 0x93, 0x17, 0x54, 0x3a, 0x03, 0xb3, 0xa6, 0x0c, 0xef,
+	0x85, 0xb1, 0x1c, 0x23, 0xf5, 0x7b, 0xdb, 0xd0, 0xcc, 0xfc, 0xc9, 0xca, 0xaf, 0x57, 0xc1, 0xeb,
+	0x20, 0x7c, 0x1b, 0xe8, 0xfe, 0xbb, 0xee, 0xaa, 0x9e, 0x55, 0x83, 0xd2, 0x7e, 0xd2, 0x37, 0x8b,
+	0x6a, 0xf1, 0x22, 0xf1, 0xcc, 0x92, 0x5a, 0x6c, 0xf1, 0xa1, 0x59, 0x46, 0x24, 0x74, 0xcd, 0xca,
+	0xc6, 0x83, 0x1f, 0xbf, 0x3c, 0xe6, 0x72, 0x90, 0xf4, 0x97, 0x9d, 0xd0, 0x5f, 0xd1, 0x29, 0xb8,
+	0xcf, 0xc3, 0x74, 0xb5, 0xc2, 0x03, 0xc9, 0x44, 0x40, 0xbd, 0x15, 0xcc, 0xca, 0x8a, 0xca, 0x4a,
+	0xd4, 0xef, 0x57, 0x71, 0xf7, 0xe0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0x98, 0xb0, 0xbb,
+	0xc4, 0x0e, 0x00, 0x00,
 }
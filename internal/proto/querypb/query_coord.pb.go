@@ -367,6 +367,7 @@ type LoadCollectionRequest struct {
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -425,6 +426,13 @@ func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -944,7 +952,359 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+}
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
+}
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
+}
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
+
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetReplicasRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetReplicasResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// only the replicas whose query nodes are all online
+	Replicas             []*ReplicaInfo `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type GetShardLeadersRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 if the collection is loaded without replicas
+	ReplicaID            int64    `protobuf:"varint,3,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{} }
+func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersRequest) ProtoMessage()    {}
+func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+}
+
+func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersRequest.Unmarshal(m, b)
+}
+func (m *GetShardLeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersRequest.Merge(m, src)
+}
+func (m *GetShardLeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersRequest.Size(m)
+}
+func (m *GetShardLeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersRequest proto.InternalMessageInfo
+
+func (m *GetShardLeadersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardLeadersRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetShardLeadersRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+type GetShardLeadersResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards []*ShardLeader   `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	// all the query nodes holding the segments or watching the dmChannels of the collection in the replica,
+	// the shard leaders are included
+	NodeIds              []int64  `protobuf:"varint,3,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	NodeAddrs            []string `protobuf:"bytes,4,rep,name=node_addrs,json=nodeAddrs,proto3" json:"node_addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse{} }
+func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersResponse) ProtoMessage()    {}
+func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+}
+
+func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersResponse.Unmarshal(m, b)
+}
+func (m *GetShardLeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersResponse.Merge(m, src)
+}
+func (m *GetShardLeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersResponse.Size(m)
+}
+func (m *GetShardLeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersResponse proto.InternalMessageInfo
+
+func (m *GetShardLeadersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetShards() []*ShardLeader {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetNodeAddrs() []string {
+	if m != nil {
+		return m.NodeAddrs
+	}
+	return nil
+}
+
+// ShardLeader is the query node watching the dmChannel of a shard
+type ShardLeader struct {
+	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardLeader) Reset()         { *m = ShardLeader{} }
+func (m *ShardLeader) String() string { return proto.CompactTextString(m) }
+func (*ShardLeader) ProtoMessage()    {}
+func (*ShardLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+}
+
+func (m *ShardLeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLeader.Unmarshal(m, b)
+}
+func (m *ShardLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLeader.Marshal(b, m, deterministic)
+}
+func (m *ShardLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLeader.Merge(m, src)
+}
+func (m *ShardLeader) XXX_Size() int {
+	return xxx_messageInfo_ShardLeader.Size(m)
+}
+func (m *ShardLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLeader proto.InternalMessageInfo
+
+func (m *ShardLeader) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ShardLeader) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ShardLeader) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 //-----------------query node grpc request and response proto----------------
+// SearchRequest searches the sealed segments and dmChannels of the query node directly
+type SearchRequest struct {
+	Req                  *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetReq() *internalpb.SearchRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+// QueryRequest retrieves the sealed segments and dmChannels of the query node directly
+type QueryRequest struct {
+	Req                  *internalpb.RetrieveRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetReq() *internalpb.RetrieveRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
 type AddQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                   `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -962,7 +1322,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1047,7 +1407,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1111,6 +1471,7 @@ type WatchDmChannelsRequest struct {
 	Infos                []*datapb.VchannelInfo     `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ExcludeInfos         []*datapb.SegmentInfo      `protobuf:"bytes,7,rep,name=exclude_infos,json=excludeInfos,proto3" json:"exclude_infos,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,8,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1120,7 +1481,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1190,6 +1551,13 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
+func (m *WatchDmChannelsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type WatchDeltaChannelsRequest struct {
 	Base                 *commonpb.MsgBase      `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                  `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1204,7 +1572,7 @@ func (m *WatchDeltaChannelsRequest) Reset()         { *m = WatchDeltaChannelsReq
 func (m *WatchDeltaChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDeltaChannelsRequest) ProtoMessage()    {}
 func (*WatchDeltaChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *WatchDeltaChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1266,6 +1634,7 @@ type SegmentLoadInfo struct {
 	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
 	IndexInfos           []*VecFieldIndexInfo  `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
 	SegmentSize          int64                 `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	ClusteringKeyRange   *datapb.FieldRange    `protobuf:"bytes,13,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1275,7 +1644,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1380,6 +1749,13 @@ func (m *SegmentLoadInfo) GetSegmentSize() int64 {
 	return 0
 }
 
+func (m *SegmentLoadInfo) GetClusteringKeyRange() *datapb.FieldRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type VecFieldIndexInfo struct {
 	FieldID              int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
@@ -1398,7 +1774,7 @@ func (m *VecFieldIndexInfo) Reset()         { *m = VecFieldIndexInfo{} }
 func (m *VecFieldIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*VecFieldIndexInfo) ProtoMessage()    {}
 func (*VecFieldIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
 
 func (m *VecFieldIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -1482,6 +1858,7 @@ type LoadSegmentsRequest struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	SourceNodeID         int64                      `protobuf:"varint,5,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,7,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1491,7 +1868,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1554,6 +1931,13 @@ func (m *LoadSegmentsRequest) GetCollectionID() int64 {
 	return 0
 }
 
+func (m *LoadSegmentsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type ReleaseSegmentsRequest struct {
 	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1571,7 +1955,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1647,7 +2031,7 @@ func (m *HandoffSegmentsRequest) Reset()         { *m = HandoffSegmentsRequest{}
 func (m *HandoffSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*HandoffSegmentsRequest) ProtoMessage()    {}
 func (*HandoffSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
 }
 
 func (m *HandoffSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1697,7 +2081,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -1757,6 +2141,7 @@ type DmChannelWatchInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	DmChannel            string   `protobuf:"bytes,2,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
 	NodeIDLoaded         int64    `protobuf:"varint,3,opt,name=nodeID_loaded,json=nodeIDLoaded,proto3" json:"nodeID_loaded,omitempty"`
+	ReplicaID            int64    `protobuf:"varint,4,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1766,7 +2151,7 @@ func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
 func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelWatchInfo) ProtoMessage()    {}
 func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -1808,6 +2193,13 @@ func (m *DmChannelWatchInfo) GetNodeIDLoaded() int64 {
 	return 0
 }
 
+func (m *DmChannelWatchInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type QueryChannelInfo struct {
 	CollectionID         int64                   `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	QueryChannel         string                  `protobuf:"bytes,2,opt,name=query_channel,json=queryChannel,proto3" json:"query_channel,omitempty"`
@@ -1823,7 +2215,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1892,7 +2284,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -1935,29 +2327,32 @@ func (m *PartitionStates) GetInMemoryPercentage() int64 {
 }
 
 type SegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NodeID               int64                 `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	MemSize              int64                 `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64                 `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string                `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                 `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	DmChannel            string                `protobuf:"bytes,9,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
-	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	CreatedByCompaction  bool                  `protobuf:"varint,11,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
-	SegmentState         commonpb.SegmentState `protobuf:"varint,12,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
-	IndexInfos           []*VecFieldIndexInfo  `protobuf:"bytes,13,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID           int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID        int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID         int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID              int64                 `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize             int64                 `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows             int64                 `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName           string                `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID             int64                 `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	DmChannel           string                `protobuf:"bytes,9,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
+	CompactionFrom      []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	CreatedByCompaction bool                  `protobuf:"varint,11,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	SegmentState        commonpb.SegmentState `protobuf:"varint,12,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
+	IndexInfos          []*VecFieldIndexInfo  `protobuf:"bytes,13,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	// placements of a replicated segment, node_ids[i] holds the segment for replica_ids[i]
+	ReplicaIds           []int64  `protobuf:"varint,14,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,15,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -2069,6 +2464,20 @@ func (m *SegmentInfo) GetIndexInfos() []*VecFieldIndexInfo {
 	return nil
 }
 
+func (m *SegmentInfo) GetReplicaIds() []int64 {
+	if m != nil {
+		return m.ReplicaIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
 type CollectionInfo struct {
 	CollectionID         int64                      `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionIDs         []int64                    `protobuf:"varint,2,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
@@ -2086,7 +2495,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -2156,6 +2565,61 @@ func (m *CollectionInfo) GetInMemoryPercentage() int64 {
 	return 0
 }
 
+type ReplicaInfo struct {
+	ReplicaID            int64    `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,3,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+}
+
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+}
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+}
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
+}
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+
+func (m *ReplicaInfo) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
 //---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
@@ -2171,7 +2635,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2232,7 +2696,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2285,6 +2749,13 @@ func init() {
 	proto.RegisterType((*GetPartitionStatesResponse)(nil), "milvus.proto.query.GetPartitionStatesResponse")
 	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.query.GetSegmentInfoRequest")
 	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.query.GetSegmentInfoResponse")
+	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.query.GetReplicasRequest")
+	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.query.GetReplicasResponse")
+	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
+	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
+	proto.RegisterType((*ShardLeader)(nil), "milvus.proto.query.ShardLeader")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
 	proto.RegisterType((*AddQueryChannelRequest)(nil), "milvus.proto.query.AddQueryChannelRequest")
 	proto.RegisterType((*RemoveQueryChannelRequest)(nil), "milvus.proto.query.RemoveQueryChannelRequest")
 	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.query.WatchDmChannelsRequest")
@@ -2300,6 +2771,7 @@ func init() {
 	proto.RegisterType((*PartitionStates)(nil), "milvus.proto.query.PartitionStates")
 	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.query.SegmentInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
+	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.query.ReplicaInfo")
 	proto.RegisterType((*SegmentChangeInfo)(nil), "milvus.proto.query.SegmentChangeInfo")
 	proto.RegisterType((*SealedSegmentsChangeInfo)(nil), "milvus.proto.query.SealedSegmentsChangeInfo")
 }
@@ -2307,150 +2779,175 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 2282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4f, 0x73, 0x1b, 0x49,
-	0xf5, 0x1e, 0xfd, 0xb3, 0xf5, 0x24, 0x4b, 0xe3, 0x8e, 0xed, 0x9f, 0xac, 0x5f, 0x36, 0x9b, 0x9d,
-	0x5d, 0x67, 0x83, 0x97, 0xb5, 0x83, 0x03, 0x55, 0x6c, 0x01, 0x87, 0xd8, 0xc6, 0x5e, 0x93, 0x8d,
-	0xd7, 0xc8, 0x4e, 0x28, 0x52, 0xa9, 0x12, 0x23, 0x4d, 0x4b, 0x9e, 0xca, 0xcc, 0xb4, 0x32, 0x3d,
-	0x5a, 0xdb, 0xe1, 0xc2, 0x81, 0xc3, 0x72, 0xa0, 0xb8, 0x53, 0x14, 0x27, 0x28, 0xc8, 0x61, 0x2f,
-	0x7c, 0x82, 0x5c, 0xf8, 0x1a, 0x14, 0x07, 0xf8, 0x08, 0x1c, 0xa9, 0xa2, 0xfa, 0xcf, 0x8c, 0x66,
-	0x46, 0x3d, 0xb6, 0x6c, 0x97, 0x37, 0x29, 0x8a, 0xdb, 0xcc, 0xeb, 0xd7, 0xef, 0xbd, 0x7e, 0xff,
-	0xbb, 0x1f, 0xcc, 0xbd, 0x18, 0x62, 0xff, 0xb4, 0xdd, 0x25, 0xc4, 0xb7, 0x56, 0x07, 0x3e, 0x09,
-	0x08, 0x42, 0xae, 0xed, 0x7c, 0x31, 0xa4, 0xe2, 0x6f, 0x95, 0xaf, 0x37, 0xab, 0x5d, 0xe2, 0xba,
-	0xc4, 0x13, 0xb0, 0x66, 0x35, 0x8e, 0xd1, 0xac, 0xd9, 0x5e, 0x80, 0x7d, 0xcf, 0x74, 0xc2, 0x55,
-	0xda, 0x3d, 0xc2, 0xae, 0x29, 0xff, 0x74, 0xcb, 0x0c, 0xcc, 0x38, 0x7d, 0xe3, 0x97, 0x1a, 0x2c,
-	0x1e, 0x1c, 0x91, 0xe3, 0x4d, 0xe2, 0x38, 0xb8, 0x1b, 0xd8, 0xc4, 0xa3, 0x2d, 0xfc, 0x62, 0x88,
-	0x69, 0x80, 0xee, 0x41, 0xa1, 0x63, 0x52, 0xdc, 0xd0, 0x6e, 0x6b, 0x77, 0x2b, 0xeb, 0x37, 0x57,
-	0x13, 0x92, 0x48, 0x11, 0x1e, 0xd1, 0xfe, 0x86, 0x49, 0x71, 0x8b, 0x63, 0x22, 0x04, 0x05, 0xab,
-	0xb3, 0xbb, 0xd5, 0xc8, 0xdd, 0xd6, 0xee, 0xe6, 0x5b, 0xfc, 0x1b, 0x7d, 0x00, 0xb3, 0xdd, 0x88,
-	0xf6, 0xee, 0x16, 0x6d, 0xe4, 0x6f, 0xe7, 0xef, 0xe6, 0x5b, 0x49, 0xa0, 0xf1, 0x27, 0x0d, 0xfe,
-	0x6f, 0x4c, 0x0c, 0x3a, 0x20, 0x1e, 0xc5, 0xe8, 0x3e, 0x94, 0x68, 0x60, 0x06, 0x43, 0x2a, 0x25,
-	0xf9, 0x7f, 0xa5, 0x24, 0x07, 0x1c, 0xa5, 0x25, 0x51, 0xc7, 0xd9, 0xe6, 0x14, 0x6c, 0xd1, 0xb7,
-	0x60, 0xde, 0xf6, 0x1e, 0x61, 0x97, 0xf8, 0xa7, 0xed, 0x01, 0xf6, 0xbb, 0xd8, 0x0b, 0xcc, 0x3e,
-	0x0e, 0x65, 0xbc, 0x11, 0xae, 0xed, 0x8f, 0x96, 0x8c, 0x3f, 0x6a, 0xb0, 0xc0, 0x24, 0xdd, 0x37,
-	0xfd, 0xc0, 0xbe, 0x06, 0x7d, 0x19, 0x50, 0x8d, 0xcb, 0xd8, 0xc8, 0xf3, 0xb5, 0x04, 0x8c, 0xe1,
-	0x0c, 0x42, 0xf6, 0xec, 0x6c, 0x05, 0x2e, 0x6e, 0x02, 0x66, 0xfc, 0x41, 0x1a, 0x36, 0x2e, 0xe7,
-	0x55, 0x14, 0x9a, 0xe6, 0x99, 0x1b, 0xe7, 0x79, 0x19, 0x75, 0xbe, 0xd6, 0x60, 0xe1, 0x33, 0x62,
-	0x5a, 0x23, 0xc3, 0x7f, 0xfd, 0xea, 0xfc, 0x01, 0x94, 0x44, 0x94, 0x34, 0x0a, 0x9c, 0xd7, 0x72,
-	0x92, 0x97, 0x8c, 0xa0, 0x91, 0x84, 0x07, 0x1c, 0xd0, 0x92, 0x9b, 0x8c, 0xdf, 0x69, 0xd0, 0x68,
-	0x61, 0x07, 0x9b, 0x14, 0xbf, 0xc9, 0x53, 0x2c, 0x42, 0xc9, 0x23, 0x16, 0xde, 0xdd, 0xe2, 0xa7,
-	0xc8, 0xb7, 0xe4, 0x9f, 0xf1, 0x0f, 0xa9, 0xe1, 0xb7, 0xdc, 0x61, 0x63, 0x56, 0x28, 0x5e, 0xc6,
-	0x0a, 0xaf, 0x47, 0x56, 0x78, 0xdb, 0x4f, 0x3a, 0xb2, 0x54, 0x31, 0x61, 0xa9, 0x9f, 0xc2, 0xd2,
-	0xa6, 0x8f, 0xcd, 0x00, 0xff, 0x98, 0xa5, 0xf9, 0xcd, 0x23, 0xd3, 0xf3, 0xb0, 0x13, 0x1e, 0x21,
-	0xcd, 0x5c, 0x53, 0x30, 0x6f, 0xc0, 0xf4, 0xc0, 0x27, 0x27, 0xa7, 0x91, 0xdc, 0xe1, 0xaf, 0xf1,
-	0x67, 0x0d, 0x9a, 0x2a, 0xda, 0x57, 0xc9, 0x08, 0xef, 0xc3, 0xac, 0xac, 0x57, 0x82, 0x1a, 0xe7,
-	0x59, 0x6e, 0x55, 0x5f, 0xc4, 0x38, 0xa0, 0x7b, 0x30, 0x2f, 0x90, 0x7c, 0x4c, 0x87, 0x4e, 0x10,
-	0xe1, 0xe6, 0x39, 0x2e, 0xe2, 0x6b, 0x2d, 0xbe, 0x24, 0x77, 0x18, 0xaf, 0x34, 0x58, 0xda, 0xc1,
-	0x41, 0x64, 0x44, 0xc6, 0x15, 0xbf, 0xa5, 0x49, 0xf6, 0x2b, 0x0d, 0x9a, 0x2a, 0x59, 0xaf, 0xa2,
-	0xd6, 0xa7, 0xb0, 0x18, 0xf1, 0x68, 0x5b, 0x98, 0x76, 0x7d, 0x7b, 0xc0, 0x9d, 0x99, 0xa7, 0xdc,
-	0xca, 0xfa, 0xfb, 0xab, 0xe3, 0x2d, 0xc1, 0x6a, 0x5a, 0x82, 0x85, 0x88, 0xc4, 0x56, 0x8c, 0x82,
-	0xf1, 0x6b, 0x0d, 0x16, 0x76, 0x70, 0x70, 0x80, 0xfb, 0x2e, 0xf6, 0x82, 0x5d, 0xaf, 0x47, 0x2e,
-	0xaf, 0xd7, 0x5b, 0x00, 0x54, 0xd2, 0x89, 0xca, 0x41, 0x0c, 0x32, 0x89, 0x8e, 0x79, 0xf7, 0x91,
-	0x96, 0xe7, 0x2a, 0xba, 0xfb, 0x0e, 0x14, 0x6d, 0xaf, 0x47, 0x42, 0x55, 0xbd, 0xab, 0x52, 0x55,
-	0x9c, 0x99, 0xc0, 0x36, 0xfe, 0x9d, 0x83, 0xc5, 0x07, 0x96, 0xa5, 0x0a, 0xbb, 0x8b, 0xeb, 0x65,
-	0x14, 0xdd, 0xb9, 0x78, 0x74, 0x4f, 0xe4, 0x73, 0x63, 0x21, 0x55, 0xb8, 0x40, 0x48, 0x15, 0xb3,
-	0x42, 0x0a, 0xed, 0xc0, 0x2c, 0xc5, 0xf8, 0x79, 0x7b, 0x40, 0x28, 0xf7, 0x89, 0x46, 0x89, 0x9f,
-	0xc6, 0x48, 0x9e, 0x26, 0xea, 0x1c, 0x1f, 0xd1, 0xfe, 0xbe, 0xc4, 0x6c, 0x55, 0xd9, 0xc6, 0xf0,
-	0x0f, 0x3d, 0x86, 0xc5, 0xbe, 0x43, 0x3a, 0xa6, 0xd3, 0xa6, 0xd8, 0x74, 0xb0, 0xd5, 0x96, 0xf6,
-	0xa6, 0x8d, 0xe9, 0xc9, 0x14, 0x3e, 0x2f, 0xb6, 0x1f, 0xf0, 0xdd, 0x72, 0x81, 0x1a, 0x7f, 0xd7,
-	0x60, 0xa9, 0x85, 0x5d, 0xf2, 0x05, 0xfe, 0x6f, 0x35, 0x81, 0xf1, 0xcf, 0x1c, 0x2c, 0xfe, 0xc4,
-	0x0c, 0xba, 0x47, 0x5b, 0xae, 0x04, 0xd1, 0x37, 0x73, 0xbe, 0x49, 0x0a, 0x54, 0x14, 0x46, 0x45,
-	0x95, 0x55, 0xd9, 0x1d, 0x62, 0xf5, 0x89, 0x3c, 0x72, 0x2c, 0x8c, 0x62, 0x15, 0xbc, 0x74, 0x89,
-	0x0a, 0x8e, 0x36, 0x61, 0x16, 0x9f, 0x74, 0x9d, 0xa1, 0x85, 0xdb, 0x82, 0xbb, 0xf0, 0xa9, 0x5b,
-	0x0a, 0xee, 0x71, 0x97, 0xaa, 0xca, 0x4d, 0xbb, 0x3c, 0x94, 0x5f, 0x6b, 0xb0, 0x24, 0xf4, 0x8c,
-	0x9d, 0xc0, 0x7c, 0xb3, 0xaa, 0x8e, 0xd4, 0x58, 0xb8, 0x88, 0x1a, 0x8d, 0xdf, 0x16, 0xa0, 0x2e,
-	0x0f, 0xc8, 0xfa, 0x36, 0xb6, 0x84, 0x6e, 0x42, 0x39, 0x4a, 0xad, 0xb2, 0xf4, 0x8f, 0x00, 0xe8,
-	0x36, 0x54, 0x62, 0xf6, 0x93, 0x92, 0xc6, 0x41, 0x13, 0x89, 0x1b, 0x16, 0xca, 0x42, 0xac, 0x50,
-	0xbe, 0x03, 0xd0, 0x73, 0x86, 0xf4, 0xa8, 0x1d, 0xd8, 0x2e, 0x96, 0xed, 0x4a, 0x99, 0x43, 0x0e,
-	0x6d, 0x17, 0xa3, 0x07, 0x50, 0xed, 0xd8, 0x9e, 0x43, 0xfa, 0xed, 0x81, 0x19, 0x1c, 0xd1, 0x46,
-	0x29, 0xd3, 0x62, 0xdb, 0x36, 0x76, 0xac, 0x0d, 0x8e, 0xdb, 0xaa, 0x88, 0x3d, 0xfb, 0x6c, 0x0b,
-	0xba, 0x05, 0x15, 0x6f, 0xe8, 0xb6, 0x49, 0xaf, 0xed, 0x93, 0x63, 0x66, 0x73, 0xce, 0xc2, 0x1b,
-	0xba, 0x9f, 0xf7, 0x5a, 0xe4, 0x98, 0xa2, 0xef, 0x43, 0x99, 0x25, 0x77, 0xea, 0x90, 0x3e, 0x6d,
-	0xcc, 0x4c, 0x44, 0x7f, 0xb4, 0x81, 0xed, 0xb6, 0x98, 0x23, 0xf0, 0xdd, 0xe5, 0xc9, 0x76, 0x47,
-	0x1b, 0xd0, 0x1d, 0xa8, 0x75, 0x89, 0x3b, 0x30, 0xb9, 0x86, 0xb6, 0x7d, 0xe2, 0x36, 0x80, 0x47,
-	0x4b, 0x0a, 0x8a, 0xb6, 0xa1, 0x62, 0x7b, 0x16, 0x3e, 0x91, 0x7e, 0x5b, 0xe1, 0x7c, 0x96, 0x55,
-	0xb9, 0xf0, 0x09, 0xee, 0x72, 0x5e, 0xbb, 0x0c, 0x9d, 0x1b, 0x1d, 0xec, 0xf0, 0x93, 0xa2, 0xf7,
-	0xa0, 0x2a, 0x8d, 0xda, 0xa6, 0xf6, 0x4b, 0xdc, 0xa8, 0x0a, 0x43, 0x4a, 0xd8, 0x81, 0xfd, 0x12,
-	0x1b, 0x7f, 0xc9, 0xc1, 0xdc, 0x18, 0x11, 0xd6, 0xf8, 0xf5, 0x38, 0x24, 0x74, 0x8e, 0xf0, 0x97,
-	0x91, 0xc4, 0x9e, 0xd9, 0x71, 0x58, 0x4c, 0x59, 0xf8, 0x84, 0xfb, 0xc6, 0x4c, 0xab, 0x22, 0x60,
-	0x9c, 0x00, 0xb3, 0xb1, 0x90, 0xde, 0x33, 0x5d, 0x2c, 0x1b, 0xb3, 0x32, 0x87, 0xec, 0x99, 0x2e,
-	0x66, 0xb4, 0x85, 0x88, 0xa1, 0x67, 0x84, 0xbf, 0x6c, 0xa5, 0x33, 0xb4, 0x39, 0x57, 0xe1, 0x19,
-	0xe1, 0x2f, 0xda, 0x82, 0xaa, 0x20, 0x39, 0x30, 0x7d, 0xd3, 0x0d, 0xfd, 0xe2, 0x3d, 0x65, 0xbc,
-	0x3d, 0xc4, 0xa7, 0x4f, 0x4c, 0x67, 0x88, 0xf7, 0x4d, 0xdb, 0x6f, 0x09, 0x3d, 0xee, 0xf3, 0x5d,
-	0xe8, 0x2e, 0xe8, 0x82, 0x4a, 0xcf, 0x76, 0xb0, 0xf4, 0x30, 0x96, 0x13, 0xca, 0xad, 0x1a, 0x87,
-	0x6f, 0xdb, 0x0e, 0x16, 0x4e, 0x14, 0x1d, 0x81, 0xab, 0x6d, 0x46, 0xf8, 0x10, 0x87, 0x70, 0xa5,
-	0xbd, 0xca, 0xc1, 0x0d, 0x16, 0x4a, 0x61, 0xc1, 0xb9, 0x7c, 0x3a, 0x78, 0x07, 0xc0, 0xa2, 0x41,
-	0x3b, 0x91, 0x12, 0xca, 0x16, 0x0d, 0xf6, 0x44, 0x56, 0xf8, 0x24, 0x8c, 0xf8, 0x7c, 0x76, 0xab,
-	0x96, 0x0a, 0xed, 0xf1, 0xe4, 0x79, 0x99, 0x4b, 0x28, 0x2b, 0x5b, 0x94, 0x0c, 0xfd, 0x2e, 0x6e,
-	0x27, 0xae, 0x16, 0x55, 0x01, 0xdc, 0x53, 0x27, 0xad, 0x92, 0xa2, 0x25, 0xfb, 0x9b, 0x06, 0x8b,
-	0xf2, 0x1e, 0x75, 0x75, 0x75, 0x65, 0x65, 0xcf, 0x30, 0xd5, 0xe4, 0xcf, 0xe8, 0xc9, 0x0b, 0x13,
-	0x14, 0xaf, 0xa2, 0xa2, 0x78, 0x25, 0xfb, 0xd2, 0x52, 0xba, 0x2f, 0x35, 0x7e, 0xa3, 0xc1, 0xe2,
-	0xa7, 0xa6, 0x67, 0x91, 0x5e, 0xef, 0xea, 0x07, 0xdc, 0x8c, 0x22, 0x76, 0xf7, 0x22, 0x7d, 0x67,
-	0x62, 0x93, 0xf1, 0x65, 0x0e, 0x10, 0x73, 0x87, 0x0d, 0xd3, 0x31, 0xbd, 0x2e, 0xbe, 0xbc, 0x34,
-	0xcb, 0x50, 0x4b, 0x38, 0x41, 0xf4, 0xea, 0x15, 0xf7, 0x02, 0x8a, 0x1e, 0x42, 0xad, 0x23, 0x58,
-	0xb5, 0x7d, 0x6c, 0x52, 0xe2, 0x71, 0x3b, 0xd4, 0xd6, 0x3f, 0x50, 0x89, 0x7d, 0xe8, 0xdb, 0xfd,
-	0x3e, 0xf6, 0x37, 0x89, 0x67, 0x89, 0x8e, 0x70, 0xb6, 0x13, 0x8a, 0xc9, 0xb6, 0xa2, 0x77, 0xa1,
-	0x32, 0x8a, 0x88, 0xb0, 0x9d, 0x80, 0x28, 0x24, 0x28, 0xfa, 0x08, 0xe6, 0x92, 0xcd, 0xe2, 0xc8,
-	0x70, 0x3a, 0x8d, 0xf7, 0x81, 0xcc, 0x38, 0x3f, 0x07, 0x14, 0x35, 0x48, 0xbc, 0x8c, 0xf3, 0xf4,
-	0x36, 0xc9, 0xdd, 0xf7, 0x26, 0x94, 0xad, 0x70, 0xa7, 0xbc, 0x89, 0x8e, 0x00, 0x2c, 0x3c, 0x84,
-	0x84, 0x6d, 0x87, 0x98, 0x16, 0xb6, 0xc2, 0x02, 0x28, 0x80, 0x9f, 0x71, 0x98, 0xf1, 0x55, 0x0e,
-	0xf4, 0x78, 0x03, 0x3a, 0x31, 0xef, 0xeb, 0xb9, 0x09, 0x9f, 0xd1, 0x6d, 0x17, 0xae, 0xd0, 0x6d,
-	0x8f, 0xdf, 0x06, 0x8a, 0x97, 0xbb, 0x0d, 0x18, 0xbf, 0xd7, 0xa0, 0x9e, 0xba, 0x78, 0xa6, 0x5b,
-	0x11, 0x6d, 0xbc, 0x15, 0xf9, 0x2e, 0x14, 0x59, 0x7d, 0xc6, 0x5c, 0x49, 0xb5, 0x34, 0x5b, 0xd5,
-	0x75, 0xb6, 0x25, 0x36, 0xa0, 0x35, 0xb8, 0xa1, 0x78, 0x5e, 0x94, 0xa6, 0x44, 0xe3, 0xaf, 0x8b,
-	0xc6, 0x2f, 0x0a, 0x50, 0x89, 0xe9, 0xe3, 0x9c, 0x2e, 0x2a, 0x6d, 0xe9, 0x9c, 0xc2, 0xd2, 0xa9,
-	0xe3, 0xe5, 0xc7, 0x8f, 0x97, 0xf1, 0x0c, 0x87, 0x96, 0x60, 0xc6, 0xc5, 0xae, 0x28, 0x50, 0xb2,
-	0x5a, 0xba, 0xd8, 0x65, 0xe5, 0x89, 0x2d, 0xb1, 0x16, 0x88, 0xf7, 0x3f, 0x22, 0x25, 0x4f, 0x7b,
-	0x43, 0x97, 0x77, 0x3f, 0xc9, 0xda, 0x3c, 0x7d, 0x46, 0x6d, 0x9e, 0x49, 0xd6, 0xe6, 0x44, 0x38,
-	0x94, 0xd3, 0xe1, 0x30, 0x69, 0x63, 0x73, 0x0f, 0x6e, 0x74, 0xf9, 0xab, 0x91, 0xb5, 0x71, 0xba,
-	0x19, 0x2d, 0x35, 0x2a, 0xbc, 0x89, 0x50, 0x2d, 0xa1, 0x6d, 0xe6, 0x5c, 0xb2, 0x85, 0xe1, 0x56,
-	0xae, 0x72, 0x2b, 0xab, 0x4b, 0xbf, 0xb4, 0x8d, 0x30, 0x72, 0x98, 0x13, 0xf9, 0x5f, 0xba, 0xa5,
-	0x9a, 0xbd, 0x64, 0x4b, 0x65, 0x7c, 0x99, 0x87, 0xda, 0xa8, 0x68, 0x4e, 0x1c, 0xd1, 0x93, 0xbc,
-	0x76, 0xef, 0x81, 0x3e, 0x7a, 0xa8, 0xe1, 0x87, 0x3d, 0xb3, 0xee, 0xa7, 0x9f, 0x68, 0xea, 0x83,
-	0x54, 0xe8, 0x7c, 0x02, 0x65, 0x96, 0x9c, 0xda, 0xc1, 0xe9, 0x00, 0x73, 0xe7, 0xa9, 0xa5, 0x93,
-	0xbe, 0x20, 0xc4, 0xb2, 0xd5, 0xe1, 0xe9, 0x00, 0xb7, 0x66, 0x1c, 0xf9, 0x75, 0xc5, 0xb7, 0x53,
-	0x74, 0x1f, 0x16, 0x7c, 0x51, 0xf2, 0xad, 0x76, 0xe2, 0xd8, 0xa2, 0x7a, 0xce, 0x87, 0x8b, 0xfb,
-	0xf1, 0xe3, 0x67, 0x44, 0xe3, 0x74, 0x66, 0x34, 0xfe, 0x4b, 0x83, 0x39, 0x69, 0x71, 0xe6, 0x87,
-	0x7d, 0x7e, 0x63, 0x63, 0xb9, 0x93, 0x78, 0x8e, 0xed, 0x45, 0x8d, 0x8b, 0x34, 0x87, 0x00, 0xca,
-	0xc6, 0xe5, 0x53, 0xa8, 0x4b, 0xa4, 0x28, 0x05, 0x4e, 0x58, 0x69, 0x6b, 0x62, 0x5f, 0x94, 0xfc,
-	0x96, 0xa1, 0x46, 0x7a, 0xbd, 0x38, 0x3f, 0x11, 0xc3, 0xb3, 0x12, 0x2a, 0x19, 0xfe, 0x08, 0xf4,
-	0x10, 0xed, 0xa2, 0x49, 0xb7, 0x2e, 0x37, 0x46, 0xaf, 0x1b, 0xbf, 0xd2, 0xa0, 0x91, 0x4c, 0xc1,
-	0xb1, 0xe3, 0x5f, 0xbc, 0xc8, 0x7f, 0x2f, 0xf9, 0xc6, 0xb5, 0x7c, 0x86, 0x3c, 0x23, 0x3e, 0xb2,
-	0xcb, 0x5c, 0x79, 0x09, 0xb5, 0xa4, 0x1f, 0xa2, 0x2a, 0xcc, 0xec, 0x91, 0xe0, 0x87, 0x27, 0x36,
-	0x0d, 0xf4, 0x29, 0x54, 0x03, 0xd8, 0x23, 0xc1, 0xbe, 0x8f, 0x29, 0xf6, 0x02, 0x5d, 0x43, 0x00,
-	0xa5, 0xcf, 0xbd, 0x2d, 0x9b, 0x3e, 0xd7, 0x73, 0xe8, 0x86, 0xcc, 0xf6, 0xa6, 0xb3, 0x2b, 0x8d,
-	0xab, 0xe7, 0xd9, 0xf6, 0xe8, 0xaf, 0x80, 0x74, 0xa8, 0x46, 0x28, 0x3b, 0xfb, 0x8f, 0xf5, 0x22,
-	0x2a, 0x43, 0x51, 0x7c, 0x96, 0x56, 0x2c, 0xd0, 0xd3, 0xcd, 0x04, 0xa3, 0xf9, 0xd8, 0x7b, 0xe8,
-	0x91, 0xe3, 0x08, 0xa4, 0x4f, 0xa1, 0x0a, 0x4c, 0xcb, 0x06, 0x4d, 0xd7, 0x50, 0x1d, 0x2a, 0xb1,
-	0xde, 0x48, 0xcf, 0x31, 0xc0, 0x8e, 0x3f, 0xe8, 0xca, 0x2e, 0x49, 0x88, 0xc0, 0xac, 0xb6, 0x45,
-	0x8e, 0x3d, 0xbd, 0xb0, 0xf2, 0x00, 0x66, 0xc2, 0x00, 0x61, 0xa7, 0x11, 0xd4, 0xd9, 0x9f, 0x3e,
-	0x85, 0xe6, 0x60, 0x36, 0x31, 0x09, 0xd1, 0x35, 0x84, 0xa0, 0xe6, 0x24, 0xc6, 0x4f, 0x7a, 0x6e,
-	0xfd, 0xaf, 0x15, 0x00, 0xd1, 0x07, 0x10, 0xe2, 0x5b, 0x68, 0x00, 0x68, 0x07, 0x07, 0x2c, 0xc7,
-	0x11, 0x2f, 0xcc, 0x4f, 0x14, 0xdd, 0xcb, 0x28, 0x97, 0xe3, 0xa8, 0x52, 0xd2, 0xe6, 0x9d, 0x8c,
-	0x1d, 0x29, 0x74, 0x63, 0x0a, 0xb9, 0x9c, 0x23, 0xbb, 0x61, 0x1f, 0xda, 0xdd, 0xe7, 0x51, 0x03,
-	0x91, 0xcd, 0x31, 0x85, 0x1a, 0x72, 0x4c, 0xe5, 0x21, 0xf9, 0x73, 0x10, 0xf8, 0xb6, 0xd7, 0x0f,
-	0x1f, 0x5a, 0x8d, 0x29, 0xf4, 0x02, 0xe6, 0x77, 0x30, 0xe7, 0x6e, 0xd3, 0xc0, 0xee, 0xd2, 0x90,
-	0xe1, 0x7a, 0x36, 0xc3, 0x31, 0xe4, 0x0b, 0xb2, 0x74, 0xa0, 0x9e, 0x1a, 0xf7, 0xa2, 0x15, 0xa5,
-	0x23, 0x2b, 0x47, 0xd3, 0xcd, 0x8f, 0x26, 0xc2, 0x8d, 0xb8, 0xd9, 0x50, 0x4b, 0x8e, 0x42, 0xd1,
-	0x37, 0xb2, 0x08, 0x8c, 0xcd, 0x8e, 0x9a, 0x2b, 0x93, 0xa0, 0x46, 0xac, 0x9e, 0x42, 0x2d, 0x39,
-	0x6c, 0x53, 0xb3, 0x52, 0x0e, 0xe4, 0x9a, 0x67, 0xbd, 0x71, 0x1b, 0x53, 0xe8, 0x67, 0x30, 0x37,
-	0x36, 0xe1, 0x42, 0xdf, 0x54, 0x91, 0xcf, 0x1a, 0x84, 0x9d, 0xc7, 0x41, 0x4a, 0x3f, 0xd2, 0x62,
-	0xb6, 0xf4, 0x63, 0xa3, 0xce, 0xc9, 0xa5, 0x8f, 0x91, 0x3f, 0x4b, 0xfa, 0x0b, 0x73, 0x18, 0x02,
-	0x1a, 0x9f, 0x71, 0xa1, 0x8f, 0x55, 0x2c, 0x32, 0xe7, 0x6c, 0xcd, 0xd5, 0x49, 0xd1, 0x23, 0x93,
-	0x0f, 0x79, 0xb4, 0xa6, 0x1b, 0x61, 0x25, 0xdb, 0xcc, 0xb9, 0x96, 0x9a, 0x6d, 0xf6, 0x68, 0x49,
-	0x38, 0x75, 0x72, 0x74, 0xa2, 0xb6, 0x95, 0x72, 0xdc, 0xa3, 0x76, 0x6a, 0xf5, 0x24, 0xc6, 0x98,
-	0x42, 0x87, 0x89, 0x1c, 0x8c, 0xee, 0x64, 0xf9, 0x44, 0xf2, 0x02, 0x7b, 0x9e, 0xb9, 0xda, 0x00,
-	0x3b, 0x38, 0x78, 0x84, 0x03, 0xdf, 0xee, 0xd2, 0x34, 0x51, 0xf9, 0x33, 0x42, 0x08, 0x89, 0x7e,
-	0x78, 0x2e, 0x5e, 0x28, 0xf6, 0xfa, 0x2b, 0x80, 0x32, 0xb7, 0x19, 0x2b, 0x0f, 0xff, 0x4b, 0xe3,
-	0xd7, 0x90, 0xc6, 0x9f, 0x41, 0x3d, 0x35, 0x37, 0x53, 0xa7, 0x71, 0xf5, 0x70, 0xed, 0x3c, 0x07,
-	0xe9, 0x00, 0x1a, 0x9f, 0x0a, 0xa9, 0x03, 0x2b, 0x73, 0x7a, 0x74, 0x1e, 0x8f, 0x67, 0x50, 0x4f,
-	0x8d, 0x65, 0xd4, 0x27, 0x50, 0xcf, 0x6e, 0x26, 0x38, 0xc1, 0xf8, 0x30, 0x42, 0x7d, 0x82, 0xcc,
-	0xa1, 0xc5, 0x79, 0x3c, 0x9e, 0x40, 0x35, 0xfe, 0xb6, 0x89, 0x3e, 0xcc, 0x8a, 0xce, 0xd4, 0x6b,
-	0xd7, 0x9b, 0xcf, 0xd7, 0xd7, 0x5f, 0xcf, 0x9e, 0x41, 0x3d, 0xf5, 0x96, 0xa9, 0xb6, 0xae, 0xfa,
-	0xc1, 0xf3, 0x3c, 0xea, 0x5f, 0x63, 0x06, 0xbe, 0xee, 0x5c, 0xb9, 0xf1, 0xed, 0xa7, 0xeb, 0x7d,
-	0x3b, 0x38, 0x1a, 0x76, 0xd8, 0x29, 0xd7, 0x04, 0xe6, 0xc7, 0x36, 0x91, 0x5f, 0x6b, 0x61, 0xd2,
-	0x58, 0xe3, 0x94, 0xd6, 0xb8, 0xb4, 0x83, 0x4e, 0xa7, 0xc4, 0x7f, 0xef, 0xff, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x7b, 0x37, 0x4e, 0x07, 0xb9, 0x28, 0x00, 0x00,
+	// 2675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6f, 0x1c, 0x49,
+	0xd5, 0x3d, 0x5f, 0xf6, 0xbc, 0xf9, 0xf0, 0xb8, 0xec, 0x78, 0xc7, 0xc3, 0x66, 0xd7, 0xe9, 0xac,
+	0xb3, 0x21, 0xcb, 0x3a, 0xc1, 0xe1, 0x63, 0x57, 0x0b, 0x87, 0xc4, 0x26, 0x8e, 0x49, 0xe2, 0x78,
+	0xdb, 0x4e, 0x10, 0x51, 0xa4, 0xa6, 0x67, 0xba, 0x3c, 0xee, 0x4d, 0x7f, 0x4c, 0xba, 0x7a, 0x92,
+	0x38, 0x57, 0x90, 0x58, 0x24, 0x10, 0x27, 0x0e, 0x48, 0x88, 0x13, 0x08, 0x38, 0xec, 0x85, 0x33,
+	0x87, 0xe5, 0xc4, 0x0f, 0xe0, 0x8e, 0x38, 0x20, 0x71, 0xe0, 0xcc, 0x11, 0x09, 0xd5, 0x47, 0xf7,
+	0xf4, 0x47, 0xb5, 0x3d, 0xf6, 0xc8, 0x9b, 0x08, 0x71, 0xeb, 0x7a, 0xf5, 0xea, 0x7d, 0xd4, 0x7b,
+	0xf5, 0xde, 0xab, 0x7a, 0x0d, 0x73, 0x4f, 0x87, 0xd8, 0x3f, 0xd4, 0x7b, 0x9e, 0xe7, 0x9b, 0xab,
+	0x03, 0xdf, 0x0b, 0x3c, 0x84, 0x1c, 0xcb, 0x7e, 0x36, 0x24, 0x7c, 0xb4, 0xca, 0xe6, 0x3b, 0xf5,
+	0x9e, 0xe7, 0x38, 0x9e, 0xcb, 0x61, 0x9d, 0x7a, 0x1c, 0xa3, 0xd3, 0xb4, 0xdc, 0x00, 0xfb, 0xae,
+	0x61, 0x87, 0xb3, 0xa4, 0x77, 0x80, 0x1d, 0x43, 0x8c, 0x5a, 0xa6, 0x11, 0x18, 0x71, 0xfa, 0xea,
+	0x8f, 0x14, 0x58, 0xdc, 0x3d, 0xf0, 0x9e, 0xaf, 0x7b, 0xb6, 0x8d, 0x7b, 0x81, 0xe5, 0xb9, 0x44,
+	0xc3, 0x4f, 0x87, 0x98, 0x04, 0xe8, 0x1a, 0x94, 0xba, 0x06, 0xc1, 0x6d, 0x65, 0x59, 0xb9, 0x5c,
+	0x5b, 0x7b, 0x73, 0x35, 0x21, 0x89, 0x10, 0xe1, 0x1e, 0xe9, 0xdf, 0x34, 0x08, 0xd6, 0x18, 0x26,
+	0x42, 0x50, 0x32, 0xbb, 0x5b, 0x1b, 0xed, 0xc2, 0xb2, 0x72, 0xb9, 0xa8, 0xb1, 0x6f, 0xf4, 0x0e,
+	0x34, 0x7a, 0x11, 0xed, 0xad, 0x0d, 0xd2, 0x2e, 0x2e, 0x17, 0x2f, 0x17, 0xb5, 0x24, 0x50, 0xfd,
+	0x9d, 0x02, 0x6f, 0x64, 0xc4, 0x20, 0x03, 0xcf, 0x25, 0x18, 0x5d, 0x87, 0x0a, 0x09, 0x8c, 0x60,
+	0x48, 0x84, 0x24, 0x5f, 0x92, 0x4a, 0xb2, 0xcb, 0x50, 0x34, 0x81, 0x9a, 0x65, 0x5b, 0x90, 0xb0,
+	0x45, 0x5f, 0x85, 0x05, 0xcb, 0xbd, 0x87, 0x1d, 0xcf, 0x3f, 0xd4, 0x07, 0xd8, 0xef, 0x61, 0x37,
+	0x30, 0xfa, 0x38, 0x94, 0x71, 0x3e, 0x9c, 0xdb, 0x19, 0x4d, 0xa9, 0xbf, 0x55, 0xe0, 0x1c, 0x95,
+	0x74, 0xc7, 0xf0, 0x03, 0xeb, 0x0c, 0xf6, 0x4b, 0x85, 0x7a, 0x5c, 0xc6, 0x76, 0x91, 0xcd, 0x25,
+	0x60, 0x14, 0x67, 0x10, 0xb2, 0xa7, 0xba, 0x95, 0x98, 0xb8, 0x09, 0x98, 0xfa, 0x1b, 0x61, 0xd8,
+	0xb8, 0x9c, 0x93, 0x6c, 0x68, 0x9a, 0x67, 0x21, 0xcb, 0xf3, 0x34, 0xdb, 0xf9, 0x4f, 0x05, 0xce,
+	0xdd, 0xf5, 0x0c, 0x73, 0x64, 0xf8, 0x2f, 0x7e, 0x3b, 0xbf, 0x0d, 0x15, 0x7e, 0x4a, 0xda, 0x25,
+	0xc6, 0x6b, 0x25, 0xc9, 0x4b, 0x9c, 0xa0, 0x91, 0x84, 0xbb, 0x0c, 0xa0, 0x89, 0x45, 0x68, 0x05,
+	0x9a, 0x3e, 0x1e, 0xd8, 0x56, 0xcf, 0xd0, 0xdd, 0xa1, 0xd3, 0xc5, 0x7e, 0xbb, 0xbc, 0xac, 0x5c,
+	0x2e, 0x6b, 0x0d, 0x01, 0xdd, 0x66, 0x40, 0xf5, 0x57, 0x0a, 0xb4, 0x35, 0x6c, 0x63, 0x83, 0xe0,
+	0x57, 0xa9, 0xec, 0x22, 0x54, 0x5c, 0xcf, 0xc4, 0x5b, 0x1b, 0x4c, 0xd9, 0xa2, 0x26, 0x46, 0xea,
+	0x3f, 0x84, 0x21, 0x5e, 0x73, 0xbf, 0x8e, 0x19, 0xab, 0x7c, 0x0a, 0x63, 0xa9, 0x9f, 0x8f, 0xac,
+	0xf0, 0xba, 0x6b, 0x3a, 0xb2, 0x54, 0x39, 0x61, 0xa9, 0xef, 0xc3, 0xd2, 0xba, 0x8f, 0x8d, 0x00,
+	0x7f, 0x4c, 0xb3, 0xc1, 0xfa, 0x81, 0xe1, 0xba, 0xd8, 0x0e, 0x55, 0x48, 0x33, 0x57, 0x24, 0xcc,
+	0xdb, 0x30, 0x3d, 0xf0, 0xbd, 0x17, 0x87, 0x91, 0xdc, 0xe1, 0x50, 0xfd, 0xbd, 0x02, 0x1d, 0x19,
+	0xed, 0x49, 0x02, 0xc7, 0x45, 0x68, 0x88, 0xb4, 0xc6, 0xa9, 0x31, 0x9e, 0x55, 0xad, 0xfe, 0x34,
+	0xc6, 0x01, 0x5d, 0x83, 0x05, 0x8e, 0xe4, 0x63, 0x32, 0xb4, 0x83, 0x08, 0xb7, 0xc8, 0x70, 0x11,
+	0x9b, 0xd3, 0xd8, 0x94, 0x58, 0xa1, 0xfe, 0x41, 0x81, 0xa5, 0x4d, 0x1c, 0x44, 0x46, 0xa4, 0x5c,
+	0xf1, 0x6b, 0x1a, 0x8b, 0x3f, 0x53, 0xa0, 0x23, 0x93, 0x75, 0x92, 0x6d, 0x7d, 0x04, 0x8b, 0x11,
+	0x0f, 0xdd, 0xc4, 0xa4, 0xe7, 0x5b, 0x03, 0xe6, 0xcc, 0x2c, 0x32, 0xd7, 0xd6, 0x2e, 0xae, 0x66,
+	0x2b, 0x87, 0xd5, 0xb4, 0x04, 0xe7, 0x22, 0x12, 0x1b, 0x31, 0x0a, 0xea, 0xcf, 0x14, 0x38, 0xb7,
+	0x89, 0x83, 0x5d, 0xdc, 0x77, 0xb0, 0x1b, 0x6c, 0xb9, 0xfb, 0xde, 0xe9, 0xf7, 0xf5, 0x2d, 0x00,
+	0x22, 0xe8, 0x44, 0x59, 0x23, 0x06, 0x19, 0x67, 0x8f, 0x59, 0x91, 0x92, 0x96, 0x67, 0x92, 0xbd,
+	0xfb, 0x3a, 0x94, 0x2d, 0x77, 0xdf, 0x0b, 0xb7, 0xea, 0x6d, 0xd9, 0x56, 0xc5, 0x99, 0x71, 0x6c,
+	0xf5, 0x13, 0x40, 0x9b, 0x38, 0xd0, 0x78, 0x54, 0x9f, 0xc0, 0xd5, 0xd2, 0x2a, 0x17, 0x24, 0x2a,
+	0xff, 0x58, 0x81, 0xf9, 0x04, 0xb3, 0x49, 0xf4, 0xfd, 0x08, 0x66, 0x44, 0x2e, 0x3a, 0x52, 0x65,
+	0xc1, 0x8c, 0xa9, 0x1c, 0x2d, 0x50, 0x7f, 0x2a, 0x36, 0xff, 0xc0, 0xf0, 0xcd, 0xbb, 0xd8, 0x30,
+	0xb1, 0x7f, 0xb6, 0xaa, 0xa3, 0x37, 0xa1, 0x2a, 0x98, 0x47, 0xee, 0x30, 0x02, 0xa8, 0x7f, 0x56,
+	0xe0, 0x8d, 0x8c, 0x38, 0x93, 0x6c, 0xce, 0x37, 0xa1, 0x42, 0x28, 0xb1, 0xa3, 0xbd, 0x61, 0xc4,
+	0x4e, 0x13, 0xe8, 0x68, 0x09, 0x66, 0x68, 0x44, 0xd6, 0x2d, 0x33, 0xac, 0x70, 0xa6, 0x59, 0x84,
+	0x36, 0x09, 0x3a, 0x0f, 0xc0, 0xa6, 0x0c, 0xd3, 0xf4, 0x79, 0x48, 0xa8, 0x6a, 0x55, 0x0a, 0xb9,
+	0x41, 0x01, 0x6a, 0x17, 0x6a, 0x31, 0x82, 0xe8, 0x02, 0xd4, 0x45, 0xbc, 0xd3, 0x5d, 0xc3, 0xe1,
+	0xdb, 0x59, 0xd5, 0x6a, 0x02, 0xb6, 0x6d, 0x38, 0x38, 0x96, 0x0b, 0x0a, 0xf1, 0x5c, 0x40, 0x43,
+	0x39, 0xe5, 0x81, 0x09, 0x11, 0xa1, 0x32, 0x1c, 0xaa, 0x9b, 0xd0, 0xd8, 0xc5, 0x86, 0xdf, 0x3b,
+	0x08, 0x8d, 0xf5, 0x0d, 0x28, 0xfa, 0xf8, 0xa9, 0xd8, 0x99, 0x77, 0x92, 0x4a, 0x46, 0x97, 0x86,
+	0xc4, 0x12, 0x8d, 0x2e, 0x50, 0x6f, 0x43, 0xfd, 0x63, 0x1e, 0x7e, 0x39, 0x9d, 0x0f, 0xe2, 0x74,
+	0x2e, 0xe5, 0xd0, 0xd1, 0x70, 0xe0, 0x5b, 0xf8, 0x19, 0x4e, 0x50, 0xfa, 0x4f, 0x01, 0x16, 0x6f,
+	0x98, 0xa6, 0x2c, 0x6d, 0x9d, 0xdc, 0x93, 0xf2, 0x76, 0x64, 0x9c, 0x98, 0x9d, 0x49, 0x49, 0xa5,
+	0x13, 0xa4, 0xa4, 0x72, 0x5e, 0x4a, 0x42, 0x9b, 0xd0, 0x20, 0x18, 0x3f, 0xd1, 0x07, 0x1e, 0x61,
+	0x31, 0xb5, 0x5d, 0x61, 0xda, 0xa8, 0x39, 0x7b, 0x74, 0x8f, 0xf4, 0x77, 0x04, 0xa6, 0x56, 0xa7,
+	0x0b, 0xc3, 0x11, 0x7a, 0x00, 0x8b, 0x7d, 0xdb, 0xeb, 0x1a, 0xb6, 0x4e, 0xb0, 0x61, 0x63, 0x53,
+	0x17, 0xf1, 0x92, 0xb4, 0xa7, 0xc7, 0x0b, 0x58, 0x0b, 0x7c, 0xf9, 0x2e, 0x5b, 0x2d, 0x26, 0x88,
+	0xfa, 0x77, 0x05, 0x96, 0x34, 0xec, 0x78, 0xcf, 0xf0, 0xff, 0xaa, 0x09, 0xd4, 0x1f, 0x16, 0x61,
+	0xf1, 0x7b, 0x46, 0xd0, 0x3b, 0xd8, 0x70, 0x04, 0x88, 0xbc, 0x1a, 0xfd, 0xc6, 0x29, 0xf0, 0xa2,
+	0x34, 0x54, 0x96, 0x59, 0x95, 0x5e, 0xd5, 0x57, 0x1f, 0x0a, 0x95, 0x63, 0x69, 0x28, 0x56, 0x01,
+	0x57, 0x4e, 0x73, 0x5d, 0x59, 0x87, 0x06, 0x7e, 0xd1, 0xb3, 0x87, 0x34, 0x72, 0x31, 0xee, 0xdc,
+	0xa7, 0xde, 0x92, 0x70, 0x8f, 0xbb, 0x54, 0x5d, 0x2c, 0xda, 0x62, 0x32, 0x24, 0x62, 0xf4, 0x4c,
+	0x3a, 0x46, 0x7f, 0xae, 0xc0, 0x12, 0xb7, 0x02, 0xb6, 0x03, 0xe3, 0xd5, 0x1a, 0x22, 0xda, 0xe4,
+	0xd2, 0x49, 0x36, 0x59, 0xfd, 0x57, 0x09, 0x66, 0x85, 0xfa, 0xf4, 0x56, 0x44, 0xa7, 0xa8, 0xd2,
+	0x51, 0xe1, 0x22, 0x0a, 0xeb, 0x11, 0x00, 0x2d, 0x43, 0x2d, 0x66, 0x5d, 0x21, 0x69, 0x1c, 0x34,
+	0x96, 0xb8, 0x61, 0x19, 0x5a, 0x8a, 0x95, 0xa1, 0xe7, 0x01, 0xf6, 0xed, 0x21, 0x39, 0xd0, 0x03,
+	0xcb, 0xc1, 0xe2, 0x32, 0x50, 0x65, 0x90, 0x3d, 0xcb, 0xc1, 0xe8, 0x06, 0xd4, 0xbb, 0x96, 0x6b,
+	0x7b, 0x7d, 0x7d, 0x60, 0x04, 0x07, 0xa4, 0x5d, 0xc9, 0xb5, 0xe7, 0x2d, 0x0b, 0xdb, 0xe6, 0x4d,
+	0x86, 0xab, 0xd5, 0xf8, 0x9a, 0x1d, 0xba, 0x04, 0xbd, 0x05, 0x35, 0x77, 0xe8, 0xe8, 0xde, 0xbe,
+	0xee, 0x7b, 0xcf, 0xa9, 0x47, 0x30, 0x16, 0xee, 0xd0, 0xb9, 0xbf, 0xaf, 0x79, 0xcf, 0x09, 0xfa,
+	0x16, 0x54, 0x69, 0xb6, 0x24, 0xb6, 0xd7, 0x27, 0xed, 0x99, 0xb1, 0xe8, 0x8f, 0x16, 0xd0, 0xd5,
+	0x26, 0x75, 0x04, 0xb6, 0xba, 0x3a, 0xde, 0xea, 0x68, 0x01, 0xba, 0x04, 0xcd, 0x9e, 0xe7, 0x0c,
+	0x0c, 0xb6, 0x43, 0xb7, 0x7c, 0xcf, 0x69, 0x03, 0x3b, 0x4b, 0x29, 0x28, 0xba, 0x05, 0x35, 0xcb,
+	0x35, 0xf1, 0x0b, 0xe1, 0xd5, 0x35, 0xc6, 0x67, 0x45, 0x16, 0x29, 0x1f, 0xe2, 0x1e, 0xe3, 0xb5,
+	0x45, 0xd1, 0x99, 0xd1, 0xc1, 0x0a, 0x3f, 0x09, 0xcd, 0xc6, 0xc2, 0xa8, 0x3a, 0xb1, 0x5e, 0xe2,
+	0x76, 0x9d, 0x1b, 0x52, 0xc0, 0x76, 0xad, 0x97, 0x18, 0xdd, 0x87, 0x85, 0x9e, 0x3d, 0x24, 0x01,
+	0xf6, 0x2d, 0xb7, 0xaf, 0x3f, 0xc1, 0x87, 0xba, 0x6f, 0xb8, 0x7d, 0xdc, 0x6e, 0x30, 0x8f, 0x3e,
+	0x9f, 0xa7, 0x9b, 0x46, 0x91, 0x34, 0x34, 0x5a, 0x7a, 0x07, 0x1f, 0x32, 0x98, 0xfa, 0xc7, 0x02,
+	0xcc, 0x65, 0xa4, 0xa2, 0xc9, 0x7d, 0x9f, 0x41, 0x42, 0x6f, 0x0b, 0x87, 0x54, 0x46, 0xec, 0x1a,
+	0x5d, 0x9b, 0x1e, 0x61, 0x13, 0xbf, 0x60, 0xce, 0x36, 0xa3, 0xd5, 0x38, 0x8c, 0x11, 0xa0, 0x4e,
+	0xc3, 0xb7, 0x83, 0x95, 0x14, 0xbc, 0x38, 0xa8, 0x32, 0x08, 0x2b, 0x28, 0xda, 0x30, 0xcd, 0x75,
+	0x0e, 0x5d, 0x2d, 0x1c, 0xd2, 0x99, 0xee, 0xd0, 0x62, 0x5c, 0xb9, 0xab, 0x85, 0x43, 0xb4, 0x01,
+	0x75, 0x4e, 0x72, 0x60, 0xf8, 0x86, 0x13, 0x3a, 0xda, 0x05, 0xe9, 0x01, 0xbe, 0x83, 0x0f, 0x1f,
+	0x1a, 0xf6, 0x10, 0xef, 0x18, 0x96, 0xaf, 0x71, 0xc3, 0xec, 0xb0, 0x55, 0xe8, 0x32, 0xb4, 0x38,
+	0x95, 0x7d, 0xcb, 0xc6, 0xc2, 0x65, 0xa7, 0x59, 0x85, 0xd4, 0x64, 0xf0, 0x5b, 0x96, 0x8d, 0xb9,
+	0x57, 0x46, 0x2a, 0x30, 0x3b, 0x88, 0x28, 0xc3, 0x20, 0xd4, 0x0a, 0xea, 0x5f, 0x0a, 0x30, 0x4f,
+	0xcf, 0x66, 0x98, 0xdf, 0x4e, 0x1f, 0x5f, 0xce, 0x03, 0x98, 0x24, 0xd0, 0x13, 0x31, 0xa6, 0x6a,
+	0x92, 0x60, 0x9b, 0x87, 0x99, 0x0f, 0xc3, 0x10, 0x52, 0xcc, 0xbf, 0x59, 0xa5, 0x62, 0x45, 0x36,
+	0x56, 0x9f, 0xea, 0x69, 0xe9, 0x22, 0x34, 0x88, 0x37, 0xf4, 0x7b, 0x58, 0x4f, 0xbc, 0x04, 0xd4,
+	0x39, 0x70, 0x5b, 0x1e, 0x05, 0x2b, 0xc7, 0xd5, 0xd4, 0xd3, 0xe9, 0x78, 0xfd, 0x37, 0x05, 0x16,
+	0xc5, 0xa3, 0xc8, 0xe4, 0x9b, 0x99, 0x17, 0xac, 0xc3, 0xc8, 0x56, 0x3c, 0xe2, 0x82, 0x5d, 0x1a,
+	0x23, 0x93, 0x96, 0x25, 0x99, 0x34, 0x79, 0xc9, 0xac, 0xa4, 0x2f, 0x99, 0xea, 0xcf, 0x15, 0x58,
+	0xbc, 0x6d, 0xb8, 0xa6, 0xb7, 0xbf, 0x3f, 0xb9, 0x82, 0xeb, 0x51, 0x80, 0xd8, 0x3a, 0xc9, 0x25,
+	0x32, 0xb1, 0x48, 0xfd, 0xb4, 0x00, 0x88, 0x3a, 0xcb, 0x4d, 0xc3, 0x36, 0xdc, 0x1e, 0x3e, 0xbd,
+	0x34, 0x2b, 0xd0, 0x4c, 0xb8, 0x48, 0xf4, 0xd2, 0x1d, 0xf7, 0x11, 0x82, 0xee, 0x40, 0xb3, 0xcb,
+	0x59, 0xe9, 0x3e, 0x36, 0x88, 0xe7, 0x32, 0x3b, 0x34, 0xd3, 0x17, 0x01, 0x2e, 0xf6, 0x9e, 0x6f,
+	0xf5, 0xfb, 0xd8, 0x5f, 0xf7, 0x5c, 0x93, 0x97, 0xa7, 0x8d, 0x6e, 0x28, 0x26, 0x5d, 0x8a, 0xde,
+	0x86, 0xda, 0xe8, 0xbc, 0x84, 0xb5, 0x0d, 0x44, 0x07, 0x86, 0xa0, 0xf7, 0x60, 0x2e, 0x59, 0xb9,
+	0x8e, 0x0c, 0xd7, 0x22, 0xf1, 0xa2, 0x94, 0x1a, 0xe7, 0x97, 0x0a, 0xa0, 0xa8, 0x5c, 0x63, 0x65,
+	0x03, 0x8b, 0x7e, 0xe3, 0xbc, 0x64, 0xbd, 0x09, 0x55, 0x33, 0x5c, 0x29, 0xde, 0x95, 0x46, 0x00,
+	0x7a, 0x7a, 0xb8, 0x88, 0xba, 0xed, 0x19, 0x26, 0x36, 0xc3, 0x84, 0xcb, 0x81, 0x77, 0x19, 0x2c,
+	0x79, 0x32, 0x4a, 0xe9, 0x93, 0xf1, 0x59, 0x01, 0x5a, 0xf1, 0x62, 0x79, 0x6c, 0xc9, 0xce, 0xe6,
+	0xd5, 0xeb, 0x88, 0x9b, 0x41, 0x69, 0x82, 0x9b, 0x41, 0xf6, 0xe6, 0x52, 0x3e, 0xdd, 0xcd, 0x45,
+	0xfd, 0xb5, 0x02, 0xb3, 0xa9, 0x47, 0xa6, 0x74, 0x61, 0xa4, 0x64, 0x0b, 0xa3, 0x0f, 0xa0, 0x4c,
+	0xab, 0x05, 0xcc, 0x36, 0xa9, 0x99, 0x66, 0x2b, 0x7b, 0xba, 0xd2, 0xf8, 0x02, 0x74, 0x15, 0xe6,
+	0x25, 0x1d, 0x07, 0x61, 0x68, 0x94, 0x6d, 0x38, 0xa8, 0x7f, 0x2a, 0x41, 0x2d, 0xb6, 0x1f, 0xc7,
+	0xd4, 0x74, 0xe3, 0x3c, 0x57, 0xa4, 0xd4, 0x2b, 0x66, 0xd5, 0xcb, 0x79, 0x72, 0x47, 0x4b, 0x30,
+	0xe3, 0x60, 0x87, 0x67, 0x37, 0x91, 0x6a, 0x1d, 0xec, 0xb0, 0x0a, 0x63, 0x09, 0x66, 0x68, 0x41,
+	0xc6, 0xaa, 0x31, 0x1e, 0xcf, 0xa7, 0xdd, 0xa1, 0xc3, 0x6a, 0xb1, 0x64, 0x62, 0x9f, 0x3e, 0x22,
+	0xb1, 0xcf, 0x24, 0x13, 0x7b, 0xe2, 0xb0, 0x54, 0xd3, 0x87, 0x65, 0xdc, 0x32, 0xeb, 0x1a, 0xcc,
+	0xf7, 0xd8, 0x0b, 0xb1, 0x79, 0xf3, 0x70, 0x3d, 0x9a, 0x6a, 0xd7, 0x58, 0x05, 0x22, 0x9b, 0x42,
+	0xb7, 0xa8, 0x73, 0x89, 0x82, 0x8a, 0x59, 0xb9, 0xce, 0xac, 0x2c, 0xaf, 0x1b, 0x84, 0x6d, 0xb8,
+	0x91, 0xc3, 0x90, 0xc9, 0x46, 0xe9, 0x02, 0xaf, 0x71, 0xda, 0x02, 0xef, 0x6d, 0xa8, 0x85, 0xfd,
+	0x1a, 0xcb, 0x24, 0xed, 0x26, 0x8f, 0x5e, 0xe1, 0x99, 0x4f, 0x3d, 0xec, 0xcc, 0x26, 0x1e, 0x76,
+	0xd4, 0x4f, 0x8b, 0xd0, 0x1c, 0x65, 0xeb, 0xb1, 0xa3, 0xc1, 0x38, 0xcd, 0xb3, 0x6d, 0x68, 0x8d,
+	0x1e, 0x74, 0xd9, 0x46, 0x1d, 0x59, 0x70, 0xa4, 0x9f, 0x72, 0x67, 0x07, 0xa9, 0x63, 0xf7, 0x21,
+	0x54, 0x69, 0xd8, 0xd3, 0x83, 0xc3, 0x01, 0x66, 0x8e, 0xd7, 0x4c, 0xe7, 0x13, 0x4e, 0x88, 0xc6,
+	0xc1, 0xbd, 0xc3, 0x01, 0xd6, 0x66, 0x6c, 0xf1, 0x35, 0x61, 0x8f, 0x05, 0x5d, 0x87, 0x73, 0x3e,
+	0xaf, 0x26, 0x4c, 0x3d, 0xa1, 0x36, 0x4f, 0xcc, 0x0b, 0xe1, 0xe4, 0x4e, 0x5c, 0xfd, 0x9c, 0x93,
+	0x3c, 0x9d, 0x7b, 0x92, 0x3f, 0x81, 0x5a, 0xec, 0xc1, 0x32, 0x19, 0xc7, 0x95, 0x54, 0x1c, 0x1f,
+	0xeb, 0x20, 0xe7, 0xbf, 0xe7, 0xa9, 0xff, 0x56, 0x60, 0x4e, 0x78, 0x26, 0x3d, 0x2f, 0x7d, 0x76,
+	0x0b, 0xa6, 0x31, 0xde, 0x73, 0x6d, 0xcb, 0x8d, 0xaa, 0x33, 0x61, 0x7a, 0x0e, 0x14, 0xd5, 0xd9,
+	0x6d, 0x98, 0x15, 0x48, 0x51, 0xa8, 0x1e, 0xb3, 0x60, 0x68, 0xf2, 0x75, 0x51, 0x90, 0x5e, 0x81,
+	0xa6, 0xb7, 0xbf, 0x1f, 0xe7, 0xc7, 0x63, 0x4d, 0x43, 0x40, 0x05, 0xc3, 0xef, 0x42, 0x2b, 0x44,
+	0x3b, 0x69, 0x72, 0x98, 0x15, 0x0b, 0xa3, 0x17, 0xa3, 0x9f, 0x28, 0xd0, 0x4e, 0xa6, 0x8a, 0x98,
+	0xfa, 0x27, 0xaf, 0x55, 0x3e, 0x4a, 0xbe, 0xbb, 0xaf, 0x1c, 0x21, 0xcf, 0x88, 0x8f, 0x28, 0xa5,
+	0xaf, 0xbc, 0x84, 0x66, 0xd2, 0xe7, 0x51, 0x1d, 0x66, 0xb6, 0xbd, 0xe0, 0x3b, 0x2f, 0x2c, 0x12,
+	0xb4, 0xa6, 0x50, 0x13, 0x60, 0xdb, 0x0b, 0x76, 0x7c, 0x4c, 0xb0, 0x1b, 0xb4, 0x14, 0x04, 0x50,
+	0xb9, 0xef, 0x6e, 0x58, 0xe4, 0x49, 0xab, 0x80, 0xe6, 0x45, 0x56, 0x32, 0xec, 0x2d, 0xe1, 0x48,
+	0xad, 0x22, 0x5d, 0x1e, 0x8d, 0x4a, 0xa8, 0x05, 0xf5, 0x08, 0x65, 0x73, 0xe7, 0x41, 0xab, 0x8c,
+	0xaa, 0x50, 0xe6, 0x9f, 0x95, 0x2b, 0x26, 0xb4, 0xd2, 0x35, 0x11, 0xa5, 0xf9, 0xc0, 0xbd, 0xe3,
+	0x7a, 0xcf, 0x23, 0x50, 0x6b, 0x0a, 0xd5, 0x60, 0x5a, 0xd4, 0x99, 0x2d, 0x05, 0xcd, 0x42, 0x2d,
+	0x56, 0xe2, 0xb5, 0x0a, 0x14, 0xb0, 0xe9, 0x0f, 0x7a, 0xa2, 0xd8, 0xe3, 0x22, 0x50, 0xab, 0x6d,
+	0x78, 0xcf, 0xdd, 0x56, 0xe9, 0xca, 0x0d, 0x98, 0x09, 0x0f, 0x23, 0xd5, 0x86, 0x53, 0xa7, 0xa3,
+	0xd6, 0x14, 0x9a, 0x83, 0x46, 0xa2, 0x3b, 0xdb, 0x52, 0x10, 0x82, 0xa6, 0x9d, 0xe8, 0x9c, 0xb7,
+	0x0a, 0x6b, 0xbf, 0x68, 0x00, 0xf0, 0x7a, 0xc5, 0xf3, 0x7c, 0x13, 0x0d, 0x58, 0xc7, 0x82, 0xc6,
+	0x62, 0xcf, 0x0d, 0xe3, 0x28, 0x41, 0xd7, 0x72, 0xd2, 0x7a, 0x16, 0x55, 0x48, 0xda, 0xc9, 0x7b,
+	0xe6, 0x4d, 0xa1, 0xab, 0x53, 0xc8, 0x61, 0x1c, 0xf7, 0x2c, 0x07, 0xef, 0x59, 0xbd, 0x27, 0x51,
+	0xa1, 0x93, 0xcf, 0x31, 0x85, 0x1a, 0x72, 0x4c, 0xc5, 0x3c, 0x31, 0xd8, 0x0d, 0xe8, 0xb5, 0x39,
+	0x7c, 0xef, 0x57, 0xa7, 0xd0, 0x53, 0x58, 0xd8, 0xc4, 0x8c, 0xbb, 0x45, 0x02, 0xab, 0x47, 0x42,
+	0x86, 0x6b, 0xf9, 0x0c, 0x33, 0xc8, 0x27, 0x64, 0x69, 0xc3, 0x6c, 0xea, 0x4f, 0x15, 0x74, 0x45,
+	0xde, 0x32, 0x90, 0xfd, 0x55, 0xd3, 0x79, 0x6f, 0x2c, 0xdc, 0x88, 0x9b, 0x05, 0xcd, 0xe4, 0x5f,
+	0x1c, 0xe8, 0xcb, 0x79, 0x04, 0x32, 0xfd, 0xec, 0xce, 0x95, 0x71, 0x50, 0x23, 0x56, 0x8f, 0xa0,
+	0x99, 0xfc, 0x01, 0x40, 0xce, 0x4a, 0xfa, 0x93, 0x40, 0xe7, 0xa8, 0x56, 0x8b, 0x3a, 0x85, 0x7e,
+	0x00, 0x73, 0x99, 0xae, 0x3b, 0xfa, 0x8a, 0xbc, 0x09, 0x25, 0x6f, 0xce, 0x1f, 0xc7, 0x41, 0x48,
+	0x3f, 0xda, 0xc5, 0x7c, 0xe9, 0x33, 0xbf, 0x5f, 0x8c, 0x2f, 0x7d, 0x8c, 0xfc, 0x51, 0xd2, 0x9f,
+	0x98, 0xc3, 0x10, 0x50, 0xb6, 0xef, 0x8e, 0xde, 0x97, 0xb1, 0xc8, 0xed, 0xfd, 0x77, 0x56, 0xc7,
+	0x45, 0x8f, 0x4c, 0x3e, 0x64, 0xa7, 0x35, 0x5d, 0xb0, 0x4b, 0xd9, 0xe6, 0xf6, 0xda, 0xe5, 0x6c,
+	0xf3, 0xdb, 0xdd, 0xdc, 0xa9, 0x93, 0xed, 0x5c, 0xb9, 0xad, 0xa4, 0x2d, 0x68, 0xb9, 0x53, 0xcb,
+	0xbb, 0xc3, 0xea, 0x14, 0xda, 0x4b, 0xc4, 0x60, 0x74, 0x29, 0xcf, 0x27, 0x92, 0xf7, 0xf0, 0xe3,
+	0x1d, 0xa2, 0x16, 0x6b, 0xce, 0xca, 0xa9, 0x66, 0x5b, 0xc5, 0x9d, 0x77, 0x8f, 0xc5, 0x8b, 0x47,
+	0x99, 0x54, 0x97, 0x13, 0xe5, 0x2a, 0x9e, 0xed, 0xcc, 0xca, 0xa3, 0x4c, 0x4e, 0xdb, 0x54, 0x9d,
+	0x42, 0x3a, 0xc0, 0x26, 0x0e, 0xee, 0xe1, 0xc0, 0xb7, 0x7a, 0x19, 0x75, 0xc4, 0x60, 0x84, 0x90,
+	0xa3, 0x8e, 0x04, 0x2f, 0x64, 0xb0, 0xf6, 0xd7, 0x1a, 0x54, 0x99, 0x0f, 0xd2, 0x74, 0xf7, 0xff,
+	0xb4, 0x74, 0x06, 0x69, 0xe9, 0x31, 0xcc, 0xa6, 0x7a, 0xab, 0x72, 0x87, 0x91, 0x37, 0x60, 0x8f,
+	0x73, 0xf8, 0x2e, 0xa0, 0x6c, 0xe7, 0x50, 0x1e, 0x28, 0x72, 0x3b, 0x8c, 0xc7, 0xf1, 0x78, 0x0c,
+	0xb3, 0xa9, 0xd6, 0x9d, 0x5c, 0x03, 0x79, 0x7f, 0x6f, 0x0c, 0x0d, 0xb2, 0x2d, 0x29, 0xb9, 0x06,
+	0xb9, 0xad, 0xab, 0xe3, 0x78, 0x3c, 0x84, 0x7a, 0xfc, 0x41, 0x1a, 0xbd, 0x9b, 0x17, 0x6d, 0x52,
+	0x8f, 0x90, 0xaf, 0x3e, 0xff, 0x9c, 0x7d, 0x7e, 0x7e, 0x0c, 0xb3, 0xa9, 0x27, 0x66, 0xb9, 0x75,
+	0xe5, 0xef, 0xd0, 0xc7, 0x51, 0xff, 0x02, 0x33, 0xca, 0x2e, 0x54, 0xf8, 0x5f, 0x12, 0xe8, 0x82,
+	0xfc, 0xfe, 0x12, 0xfb, 0x83, 0xa2, 0x73, 0xdc, 0x7f, 0x16, 0x64, 0x68, 0x07, 0x84, 0x11, 0x2d,
+	0xb3, 0x13, 0x83, 0x96, 0x65, 0x34, 0xe3, 0xff, 0x5f, 0x74, 0x8e, 0xff, 0xe5, 0x22, 0x24, 0x7a,
+	0xd6, 0x51, 0xfd, 0xe6, 0xd7, 0x1e, 0xad, 0xf5, 0xad, 0xe0, 0x60, 0xd8, 0xa5, 0xf6, 0xb8, 0xca,
+	0x31, 0xdf, 0xb7, 0x3c, 0xf1, 0x75, 0x35, 0x14, 0xed, 0x2a, 0xa3, 0x74, 0x95, 0xe9, 0x32, 0xe8,
+	0x76, 0x2b, 0x6c, 0x78, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xd4, 0x57, 0x18, 0xee,
+	0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2478,6 +2975,8 @@ type QueryCoordClient interface {
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
+	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2607,6 +3106,24 @@ func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceReque
 	return out, nil
 }
 
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error) {
+	out := new(GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
+	out := new(GetShardLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
@@ -2631,6 +3148,8 @@ type QueryCoordServer interface {
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
+	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2678,6 +3197,12 @@ func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *G
 func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
 }
+func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *GetShardLeadersRequest) (*GetShardLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeaders not implemented")
+}
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -2920,6 +3445,42 @@ func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_GetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReplicas(ctx, req.(*GetReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetShardLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetShardLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, req.(*GetShardLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -2994,6 +3555,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadBalance",
 			Handler:    _QueryCoord_LoadBalance_Handler,
 		},
+		{
+			MethodName: "GetReplicas",
+			Handler:    _QueryCoord_GetReplicas_Handler,
+		},
+		{
+			MethodName: "GetShardLeaders",
+			Handler:    _QueryCoord_GetShardLeaders_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
@@ -3019,6 +3588,8 @@ type QueryNodeClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -3139,6 +3710,24 @@ func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfo
 	return out, nil
 }
 
+func (c *queryNodeClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	out := new(internalpb.SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	out := new(internalpb.RetrieveResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetMetrics", in, out, opts...)
@@ -3162,6 +3751,8 @@ type QueryNodeServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	Search(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
+	Query(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -3206,6 +3797,12 @@ func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *R
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryNodeServer) Search(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedQueryNodeServer) Query(ctx context.Context, req *QueryRequest) (*internalpb.RetrieveResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
 func (*UnimplementedQueryNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -3430,6 +4027,42 @@ func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3500,6 +4133,14 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _QueryNode_Search_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QueryNode_Query_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryNode_GetMetrics_Handler,
@@ -35,6 +35,7 @@ const (
 	DataType_Float        DataType = 10
 	DataType_Double       DataType = 11
 	DataType_String       DataType = 20
+	DataType_JSON         DataType = 23
 	DataType_BinaryVector DataType = 100
 	DataType_FloatVector  DataType = 101
 )
@@ -49,6 +50,7 @@ var DataType_name = map[int32]string{
 	10:  "Float",
 	11:  "Double",
 	20:  "String",
+	23:  "JSON",
 	100: "BinaryVector",
 	101: "FloatVector",
 }
@@ -63,6 +65,7 @@ var DataType_value = map[string]int32{
 	"Float":        10,
 	"Double":       11,
 	"String":       20,
+	"JSON":         23,
 	"BinaryVector": 100,
 	"FloatVector":  101,
 }
@@ -78,17 +81,24 @@ func (DataType) EnumDescriptor() ([]byte, []int) {
 //*
 // @brief Field schema
 type FieldSchema struct {
-	FieldID              int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
-	Name                 string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsPrimaryKey         bool                     `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	Description          string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	DataType             DataType                 `protobuf:"varint,5,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	FieldID      int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Name         string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsPrimaryKey bool                     `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	Description  string                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DataType     DataType                 `protobuf:"varint,5,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	TypeParams   []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	IndexParams  []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	AutoID       bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	// value of the field for entities written before the field was added
+	DefaultValue *ValueField `protobuf:"bytes,9,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// entities are routed to the partitions of the collection by hashing the value of this field
+	IsPartitionKey bool `protobuf:"varint,10,opt,name=is_partition_key,json=isPartitionKey,proto3" json:"is_partition_key,omitempty"`
+	// segments are reorganized by the value of this field by the clustering compaction, so that the segments
+	// which can't match a filter on it are skipped by the searches and queries
+	IsClusteringKey      bool     `protobuf:"varint,11,opt,name=is_clustering_key,json=isClusteringKey,proto3" json:"is_clustering_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldSchema) Reset()         { *m = FieldSchema{} }
@@ -172,16 +182,39 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *FieldSchema) GetIsPartitionKey() bool {
+	if m != nil {
+		return m.IsPartitionKey
+	}
+	return false
+}
+
+func (m *FieldSchema) GetIsClusteringKey() bool {
+	if m != nil {
+		return m.IsClusteringKey
+	}
+	return false
+}
+
 //*
 // @brief Collection schema
 type CollectionSchema struct {
-	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
-	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Name        string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AutoID      bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	Fields      []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// increased by one each time a field is added to the collection
+	Version              int32    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
@@ -237,6 +270,155 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+//*
+// @brief Single scalar value
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+	}
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -248,7 +430,7 @@ func (m *BoolArray) Reset()         { *m = BoolArray{} }
 func (m *BoolArray) String() string { return proto.CompactTextString(m) }
 func (*BoolArray) ProtoMessage()    {}
 func (*BoolArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
 }
 
 func (m *BoolArray) XXX_Unmarshal(b []byte) error {
@@ -287,7 +469,7 @@ func (m *IntArray) Reset()         { *m = IntArray{} }
 func (m *IntArray) String() string { return proto.CompactTextString(m) }
 func (*IntArray) ProtoMessage()    {}
 func (*IntArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
 }
 
 func (m *IntArray) XXX_Unmarshal(b []byte) error {
@@ -326,7 +508,7 @@ func (m *LongArray) Reset()         { *m = LongArray{} }
 func (m *LongArray) String() string { return proto.CompactTextString(m) }
 func (*LongArray) ProtoMessage()    {}
 func (*LongArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
 }
 
 func (m *LongArray) XXX_Unmarshal(b []byte) error {
@@ -365,7 +547,7 @@ func (m *FloatArray) Reset()         { *m = FloatArray{} }
 func (m *FloatArray) String() string { return proto.CompactTextString(m) }
 func (*FloatArray) ProtoMessage()    {}
 func (*FloatArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
 }
 
 func (m *FloatArray) XXX_Unmarshal(b []byte) error {
@@ -404,7 +586,7 @@ func (m *DoubleArray) Reset()         { *m = DoubleArray{} }
 func (m *DoubleArray) String() string { return proto.CompactTextString(m) }
 func (*DoubleArray) ProtoMessage()    {}
 func (*DoubleArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
 }
 
 func (m *DoubleArray) XXX_Unmarshal(b []byte) error {
@@ -444,7 +626,7 @@ func (m *BytesArray) Reset()         { *m = BytesArray{} }
 func (m *BytesArray) String() string { return proto.CompactTextString(m) }
 func (*BytesArray) ProtoMessage()    {}
 func (*BytesArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
 }
 
 func (m *BytesArray) XXX_Unmarshal(b []byte) error {
@@ -483,7 +665,7 @@ func (m *StringArray) Reset()         { *m = StringArray{} }
 func (m *StringArray) String() string { return proto.CompactTextString(m) }
 func (*StringArray) ProtoMessage()    {}
 func (*StringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
 }
 
 func (m *StringArray) XXX_Unmarshal(b []byte) error {
@@ -530,7 +712,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -681,7 +863,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -771,7 +953,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -872,7 +1054,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1136,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1021,6 +1203,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
 	proto.RegisterType((*CollectionSchema)(nil), "milvus.proto.schema.CollectionSchema")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
 	proto.RegisterType((*BoolArray)(nil), "milvus.proto.schema.BoolArray")
 	proto.RegisterType((*IntArray)(nil), "milvus.proto.schema.IntArray")
 	proto.RegisterType((*LongArray)(nil), "milvus.proto.schema.LongArray")
@@ -1038,66 +1221,75 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0x8e, 0xe3, 0xfc, 0xb1, 0x8f, 0xb3, 0xbb, 0xfe, 0xcd, 0xae, 0x7e, 0x18, 0x50, 0xb7, 0x69,
+	0x05, 0x52, 0x54, 0x89, 0x56, 0xdb, 0xc2, 0xb2, 0xac, 0x58, 0x01, 0x69, 0x54, 0x35, 0x14, 0x95,
+	0xe2, 0xa2, 0xbd, 0xe0, 0xc6, 0x72, 0xe2, 0x69, 0x3b, 0xaa, 0x63, 0x07, 0xcf, 0xb8, 0x22, 0x0f,
+	0xc0, 0x1b, 0x70, 0xc3, 0x7b, 0xf0, 0x2c, 0xdc, 0x71, 0xc5, 0x73, 0xac, 0x84, 0xce, 0x99, 0xc9,
+	0xbf, 0x26, 0x59, 0xf5, 0xee, 0xcc, 0x99, 0xef, 0x1c, 0x9f, 0x73, 0xbe, 0x6f, 0x66, 0x0c, 0x2d,
+	0x39, 0xbc, 0xe1, 0xa3, 0x78, 0x7f, 0x5c, 0xe4, 0x2a, 0x67, 0x4f, 0x47, 0x22, 0xbd, 0x2b, 0xa5,
+	0x5e, 0xed, 0xeb, 0xad, 0x8f, 0x5a, 0xc3, 0x7c, 0x34, 0xca, 0x33, 0xed, 0xdc, 0x7d, 0x67, 0x83,
+	0x77, 0x22, 0x78, 0x9a, 0x5c, 0xd2, 0x2e, 0x0b, 0xa0, 0x79, 0x85, 0xcb, 0x7e, 0x2f, 0xb0, 0xda,
+	0x56, 0xc7, 0x0e, 0xa7, 0x4b, 0xc6, 0xa0, 0x96, 0xc5, 0x23, 0x1e, 0x54, 0xdb, 0x56, 0xc7, 0x0d,
+	0xc9, 0x66, 0x9f, 0xc0, 0x63, 0x21, 0xa3, 0x71, 0x21, 0x46, 0x71, 0x31, 0x89, 0x6e, 0xf9, 0x24,
+	0xb0, 0xdb, 0x56, 0xc7, 0x09, 0x5b, 0x42, 0x5e, 0x68, 0xe7, 0x19, 0x9f, 0xb0, 0x36, 0x78, 0x09,
+	0x97, 0xc3, 0x42, 0x8c, 0x95, 0xc8, 0xb3, 0xa0, 0x46, 0x09, 0x16, 0x5d, 0xec, 0x35, 0xb8, 0x49,
+	0xac, 0xe2, 0x48, 0x4d, 0xc6, 0x3c, 0xa8, 0xb7, 0xad, 0xce, 0xe3, 0xc3, 0xad, 0xfd, 0x35, 0xc5,
+	0xef, 0xf7, 0x62, 0x15, 0xff, 0x3c, 0x19, 0xf3, 0xd0, 0x49, 0x8c, 0xc5, 0xba, 0xe0, 0x61, 0x58,
+	0x34, 0x8e, 0x8b, 0x78, 0x24, 0x83, 0x46, 0xdb, 0xee, 0x78, 0x87, 0x3b, 0xcb, 0xd1, 0xa6, 0xe5,
+	0x33, 0x3e, 0x79, 0x1b, 0xa7, 0x25, 0xbf, 0x88, 0x45, 0x11, 0x02, 0x46, 0x5d, 0x50, 0x10, 0xeb,
+	0x41, 0x4b, 0x64, 0x09, 0xff, 0x6d, 0x9a, 0xa4, 0xf9, 0xd0, 0x24, 0x1e, 0x85, 0x99, 0x2c, 0xff,
+	0x87, 0x46, 0x5c, 0xaa, 0xbc, 0xdf, 0x0b, 0x1c, 0x9a, 0x82, 0x59, 0xb1, 0x1e, 0x3c, 0x4a, 0xf8,
+	0x55, 0x5c, 0xa6, 0x2a, 0xba, 0xc3, 0xc8, 0xc0, 0x6d, 0x5b, 0x1d, 0xef, 0x70, 0x7b, 0x6d, 0x87,
+	0x94, 0x9b, 0x18, 0x09, 0x5b, 0x26, 0x8a, 0x5c, 0xac, 0x03, 0x3e, 0xce, 0x3a, 0x2e, 0x94, 0xc0,
+	0x99, 0xd1, 0xb4, 0x81, 0xbe, 0xf3, 0x58, 0xc8, 0x8b, 0xa9, 0x1b, 0xe7, 0xbd, 0x07, 0xff, 0x13,
+	0x32, 0x1a, 0xa6, 0xa5, 0x54, 0xbc, 0x10, 0xd9, 0x35, 0x41, 0x3d, 0x82, 0x3e, 0x11, 0xf2, 0x78,
+	0xe6, 0x3f, 0xe3, 0x93, 0xdd, 0xbf, 0x2c, 0xf0, 0x8f, 0xf3, 0x34, 0xe5, 0x43, 0x8c, 0x36, 0x22,
+	0x98, 0x52, 0x6d, 0x2d, 0x50, 0x7d, 0x8f, 0xc4, 0xea, 0x2a, 0x89, 0xf3, 0xf6, 0xed, 0xa5, 0xf6,
+	0x5f, 0x41, 0x83, 0x34, 0x24, 0x83, 0x1a, 0x8d, 0xb5, 0xbd, 0xb6, 0xef, 0x05, 0x11, 0x86, 0x06,
+	0x8f, 0x62, 0xbc, 0xe3, 0x85, 0xc4, 0xef, 0xa1, 0x28, 0xea, 0xe1, 0x74, 0xb9, 0xfb, 0xb7, 0x05,
+	0x30, 0x9f, 0x14, 0xdb, 0x02, 0x77, 0x90, 0xe7, 0x69, 0x84, 0xa2, 0xa0, 0xaa, 0x9d, 0xd3, 0x4a,
+	0xe8, 0xa0, 0x0b, 0x05, 0xc3, 0x3e, 0x06, 0x47, 0x64, 0x4a, 0xef, 0x62, 0xe1, 0xf5, 0xd3, 0x4a,
+	0xd8, 0x14, 0x99, 0xa2, 0xcd, 0x2d, 0x70, 0xd3, 0x3c, 0xbb, 0xd6, 0xbb, 0x58, 0xb9, 0x8d, 0xb1,
+	0xe8, 0xa2, 0xed, 0x6d, 0x80, 0xab, 0x34, 0x8f, 0x4d, 0x34, 0x6a, 0xb7, 0x7a, 0x5a, 0x09, 0x5d,
+	0xf2, 0x11, 0x60, 0x07, 0xbc, 0x24, 0x2f, 0x07, 0x29, 0xd7, 0x08, 0x2c, 0xd4, 0x3a, 0xad, 0x84,
+	0xa0, 0x9d, 0x53, 0x88, 0x54, 0xc4, 0x04, 0x41, 0x1a, 0x38, 0x3b, 0x84, 0x68, 0x27, 0x42, 0xba,
+	0x0d, 0xa8, 0xe1, 0xde, 0xee, 0x36, 0xb8, 0xdd, 0x3c, 0x4f, 0xbf, 0x2b, 0x8a, 0x78, 0x82, 0x3c,
+	0x98, 0x8e, 0xec, 0x8e, 0x13, 0x6a, 0xc0, 0x73, 0x70, 0xfa, 0x99, 0x5a, 0xdd, 0xaf, 0x87, 0xb3,
+	0x04, 0x3f, 0xe4, 0xd9, 0xf5, 0x2a, 0xc0, 0x36, 0x80, 0x36, 0xc0, 0x09, 0x16, 0xbf, 0x8a, 0xa8,
+	0x1a, 0xc4, 0x0e, 0x78, 0x3d, 0x2a, 0x7e, 0x15, 0x62, 0xcd, 0x93, 0x74, 0x27, 0x8a, 0xcb, 0x55,
+	0x44, 0x6b, 0x9e, 0xe4, 0x92, 0xda, 0x5b, 0x85, 0xb8, 0x06, 0xf2, 0x8f, 0x0d, 0xde, 0xe5, 0x30,
+	0x4e, 0xe3, 0x42, 0xb3, 0xf8, 0xe6, 0x3e, 0x8b, 0xde, 0xe1, 0xf3, 0xb5, 0x5a, 0x99, 0x4d, 0x68,
+	0x89, 0xe5, 0xd7, 0xf7, 0x58, 0xf6, 0x36, 0xdc, 0x21, 0xd3, 0xf1, 0x2d, 0x8a, 0xe0, 0xcd, 0x7d,
+	0x11, 0x6c, 0xfa, 0xf4, 0x6c, 0xb6, 0x4b, 0x22, 0xf9, 0x76, 0x45, 0x24, 0x9b, 0x8e, 0xf7, 0x7c,
+	0xf4, 0xcb, 0x2a, 0x3a, 0x5e, 0x55, 0xd1, 0xa6, 0x93, 0xb2, 0xc0, 0xcd, 0x3d, 0x9d, 0x1d, 0xaf,
+	0xea, 0x6c, 0x53, 0x92, 0x05, 0x6e, 0x96, 0x95, 0x88, 0xbd, 0x0c, 0x90, 0x5a, 0x9d, 0xa3, 0xf9,
+	0x9e, 0x5e, 0xe6, 0x0a, 0xc0, 0x5e, 0x28, 0x68, 0x49, 0xcb, 0x7f, 0x58, 0xe0, 0xbd, 0xe5, 0x43,
+	0x95, 0x1b, 0x7e, 0x7d, 0xb0, 0x13, 0x31, 0x32, 0xef, 0x0a, 0x9a, 0x78, 0xef, 0xea, 0xb9, 0xdd,
+	0x11, 0xcc, 0xd0, 0xf6, 0x80, 0xc9, 0x79, 0x14, 0xa6, 0x93, 0xb3, 0x4f, 0xe1, 0xd1, 0x40, 0x64,
+	0xf8, 0x02, 0x99, 0x34, 0x48, 0x60, 0xeb, 0xb4, 0x12, 0xb6, 0xb4, 0x5b, 0xc3, 0x66, 0x65, 0xbd,
+	0xb3, 0xc0, 0xa5, 0x82, 0xa8, 0xdd, 0x17, 0x50, 0xa3, 0x57, 0xc7, 0x7a, 0xc8, 0xab, 0x43, 0x50,
+	0xb6, 0x05, 0x40, 0x17, 0x54, 0xb4, 0xf0, 0x1e, 0xba, 0xe4, 0x39, 0xc7, 0x9b, 0xf2, 0x6b, 0x68,
+	0x4a, 0x52, 0xb5, 0x34, 0x4a, 0xda, 0xc0, 0xc0, 0x5c, 0xf9, 0xa8, 0x44, 0x13, 0x82, 0xd1, 0xba,
+	0x0b, 0x69, 0x74, 0xb4, 0x3e, 0x7a, 0x61, 0xae, 0x18, 0x6d, 0x42, 0xd8, 0x87, 0xe0, 0xe8, 0xd2,
+	0x44, 0x42, 0x1a, 0x9a, 0xbd, 0xdf, 0x49, 0xb7, 0x09, 0x75, 0x32, 0x77, 0x7f, 0xb7, 0xc0, 0xee,
+	0xf7, 0x24, 0xfb, 0x12, 0x1a, 0x78, 0x5e, 0x44, 0xf2, 0xde, 0xb3, 0xb6, 0x28, 0xf8, 0xba, 0xc8,
+	0x54, 0x3f, 0x61, 0x5f, 0x41, 0x43, 0xaa, 0x02, 0x03, 0xab, 0x0f, 0x56, 0x58, 0x5d, 0xaa, 0xa2,
+	0x9f, 0x74, 0x01, 0x1c, 0x91, 0x44, 0xba, 0x8e, 0x7f, 0x2d, 0xf0, 0x2f, 0x79, 0x5c, 0x0c, 0x6f,
+	0x42, 0x2e, 0xcb, 0x54, 0x99, 0xeb, 0xd6, 0xcb, 0xca, 0x51, 0xf4, 0x6b, 0xc9, 0x0b, 0xc1, 0xa5,
+	0xd1, 0x0a, 0x64, 0xe5, 0xe8, 0x27, 0xed, 0x61, 0x4f, 0xa1, 0xae, 0xf2, 0x71, 0x74, 0x4b, 0xdf,
+	0xb6, 0xc3, 0x9a, 0xca, 0xc7, 0x67, 0xec, 0x1b, 0xf0, 0xf4, 0x93, 0x31, 0x3d, 0xc0, 0xf6, 0xc6,
+	0x7e, 0x66, 0xcc, 0x87, 0x9a, 0x44, 0x92, 0x2c, 0xbe, 0x5d, 0x72, 0x98, 0x17, 0x5c, 0xbf, 0x51,
+	0xd5, 0xd0, 0xac, 0xd8, 0x1e, 0xd8, 0x22, 0x91, 0xe6, 0x38, 0x06, 0xeb, 0xaf, 0x93, 0x9e, 0x0c,
+	0x11, 0xc4, 0x9e, 0x51, 0x65, 0xb7, 0xfa, 0x17, 0xc4, 0x0e, 0xf5, 0x62, 0xef, 0x4f, 0x0b, 0x9c,
+	0xa9, 0x7e, 0x98, 0x03, 0xb5, 0xf3, 0x3c, 0xe3, 0x7e, 0x05, 0x2d, 0xbc, 0xc5, 0x7c, 0x0b, 0xad,
+	0x7e, 0xa6, 0x5e, 0xf9, 0x55, 0xe6, 0x42, 0xbd, 0x9f, 0xa9, 0x17, 0x2f, 0x7d, 0xdb, 0x98, 0x47,
+	0x87, 0x7e, 0xcd, 0x98, 0x2f, 0x3f, 0xf7, 0xeb, 0x68, 0xd2, 0x29, 0xf0, 0x81, 0x01, 0x34, 0xf4,
+	0x3d, 0xe0, 0x7b, 0x68, 0xeb, 0x61, 0xfb, 0xcf, 0x30, 0xdb, 0xf7, 0x97, 0x3f, 0x9e, 0xfb, 0x1f,
+	0x30, 0x1f, 0x5a, 0xdd, 0x05, 0xf9, 0xfb, 0x09, 0x7b, 0x02, 0xde, 0xc9, 0xfc, 0xd8, 0xf8, 0xbc,
+	0xfb, 0xc5, 0x2f, 0x47, 0xd7, 0x42, 0xdd, 0x94, 0x03, 0xfc, 0xb7, 0x39, 0xd0, 0xcd, 0x7d, 0x26,
+	0x72, 0x63, 0x1d, 0x88, 0x4c, 0xf1, 0x22, 0x8b, 0xd3, 0x03, 0xea, 0xf7, 0x40, 0xf7, 0x3b, 0x1e,
+	0x0c, 0x1a, 0xb4, 0x3e, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x3d, 0xe0, 0x6d, 0x6d, 0x0a,
+	0x00, 0x00,
 }
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+type ctxUserKey struct{}
+
+// validAuth parses the base64 encoded "username:password" credential and verifies it,
+// the username is returned if the credential is valid.
+func validAuth(ctx context.Context, authorization []string) (string, bool) {
+	if len(authorization) < 1 {
+		return "", false
+	}
+	// the first element is the credential
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		log.Warn("fail to decode the token", zap.Error(err))
+		return "", false
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		log.Warn("invalid token format, the separator is missing")
+		return "", false
+	}
+	username, password := secrets[0], secrets[1]
+	return username, passwordVerify(ctx, username, password, globalMetaCache)
+}
+
+// passwordVerify verifies the password of the user against the encrypted password from RootCoord,
+// the sha256 of a verified password is cached so that the following requests skip the bcrypt comparison.
+func passwordVerify(ctx context.Context, username, rawPwd string, cache Cache) bool {
+	if cache == nil {
+		log.Warn("meta cache is not initialized, the credential can't be verified", zap.String("username", username))
+		return false
+	}
+	credInfo, err := cache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Warn("fail to get the credential", zap.String("username", username), zap.Error(err))
+		return false
+	}
+
+	sha256Pwd := crypto.SHA256(rawPwd, username)
+	if credInfo.sha256Password != "" && credInfo.sha256Password == sha256Pwd {
+		return true
+	}
+
+	if !crypto.PasswordVerify(rawPwd, credInfo.encryptedPassword) {
+		return false
+	}
+	cache.UpdateCredential(&credentialInfo{
+		username:          credInfo.username,
+		encryptedPassword: credInfo.encryptedPassword,
+		sha256Password:    sha256Pwd,
+	})
+	return true
+}
+
+// AuthenticationInterceptor verifies the credential carried by the "authorization" metadata of the request,
+// the authenticated username is put into the returned context.
+// It's a no-op if authorization is not enabled.
+func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return ctx, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	// the keys of metadata are always lowercase
+	username, ok := validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)])
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "auth check failure, please check username and password are correct")
+	}
+	return context.WithValue(ctx, ctxUserKey{}, username), nil
+}
+
+// GetCurUserFromContext returns the username authenticated by AuthenticationInterceptor
+func GetCurUserFromContext(ctx context.Context) (string, error) {
+	username, ok := ctx.Value(ctxUserKey{}).(string)
+	if !ok || username == "" {
+		return "", errors.New("fail to get the current user from context")
+	}
+	return username, nil
+}
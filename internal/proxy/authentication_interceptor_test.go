@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func TestValidAuth(t *testing.T) {
+	ctx := context.Background()
+	rc := NewRootCoordMock()
+	encryptedPassword, err := crypto.PasswordEncrypt("password")
+	assert.Nil(t, err)
+	rc.SetGetCredentialFunc(func(ctx context.Context, request *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username:          request.Username,
+			EncryptedPassword: encryptedPassword,
+		}, nil
+	})
+	err = InitMetaCache(rc)
+	assert.Nil(t, err)
+
+	_, ok := validAuth(ctx, nil)
+	assert.False(t, ok)
+	_, ok = validAuth(ctx, []string{"not base64"})
+	assert.False(t, ok)
+	_, ok = validAuth(ctx, []string{crypto.Base64Encode("no separator")})
+	assert.False(t, ok)
+	_, ok = validAuth(ctx, []string{crypto.Base64Encode("user1:wrong")})
+	assert.False(t, ok)
+
+	username, ok := validAuth(ctx, []string{crypto.Base64Encode("user1:password")})
+	assert.True(t, ok)
+	assert.Equal(t, "user1", username)
+
+	// the sha256 of the verified password is cached
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.SHA256("password", "user1"), credInfo.sha256Password)
+	_, ok = validAuth(ctx, []string{crypto.Base64Encode("user1:password")})
+	assert.True(t, ok)
+	_, ok = validAuth(ctx, []string{crypto.Base64Encode("user1:wrong")})
+	assert.False(t, ok)
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	// authorization disabled
+	Params.CommonCfg.AuthorizationEnabled = false
+	_, err := AuthenticationInterceptor(ctx)
+	assert.Nil(t, err)
+
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+	}()
+	rc := NewRootCoordMock()
+	encryptedPassword, err := crypto.PasswordEncrypt(util.DefaultRootPassword)
+	assert.Nil(t, err)
+	rc.SetGetCredentialFunc(func(ctx context.Context, request *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username:          request.Username,
+			EncryptedPassword: encryptedPassword,
+		}, nil
+	})
+	err = InitMetaCache(rc)
+	assert.Nil(t, err)
+
+	// no metadata
+	_, err = AuthenticationInterceptor(ctx)
+	assert.NotNil(t, err)
+
+	// wrong password
+	md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("root:wrong"))
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.NotNil(t, err)
+
+	md = metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(util.UserRoot+util.CredentialSeperator+util.DefaultRootPassword))
+	newCtx, err := AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+	assert.Nil(t, err)
+	username, err := GetCurUserFromContext(newCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, util.UserRoot, username)
+
+	_, err = GetCurUserFromContext(ctx)
+	assert.NotNil(t, err)
+}
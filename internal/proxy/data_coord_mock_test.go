@@ -216,3 +216,29 @@ func NewDataCoordMock() *DataCoordMock {
 		timeTickChannel:   funcutil.GenRandomStr(),
 	}
 }
+
+func (coord *DataCoordMock) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error) {
+	return &milvuspb.ImportResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Tasks:  []int64{1},
+	}, nil
+}
+
+func (coord *DataCoordMock) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Id:     req.GetTask(),
+	}, nil
+}
+
+func (coord *DataCoordMock) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error) {
+	return &datapb.PinCollectionResponse{}, nil
+}
+
+func (coord *DataCoordMock) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
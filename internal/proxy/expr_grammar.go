@@ -0,0 +1,280 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ant_ast "github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/file"
+	ant_lexer "github.com/antonmedv/expr/parser/lexer"
+)
+
+// exprOperator is the precedence and associativity of an operator of the expression grammar
+type exprOperator struct {
+	precedence     int
+	rightAssociate bool
+}
+
+var exprUnaryOperators = map[string]exprOperator{
+	"not": {precedence: 50},
+	"!":   {precedence: 50},
+	"-":   {precedence: 500},
+	"+":   {precedence: 500},
+}
+
+// exprBinaryOperators follows the precedences of the expr parser, with `like` added as a comparison
+var exprBinaryOperators = map[string]exprOperator{
+	"or":         {precedence: 10},
+	"||":         {precedence: 10},
+	"and":        {precedence: 15},
+	"&&":         {precedence: 15},
+	"==":         {precedence: 20},
+	"!=":         {precedence: 20},
+	"<":          {precedence: 20},
+	">":          {precedence: 20},
+	">=":         {precedence: 20},
+	"<=":         {precedence: 20},
+	"not in":     {precedence: 20},
+	"in":         {precedence: 20},
+	"like":       {precedence: 20},
+	"startsWith": {precedence: 20},
+	"endsWith":   {precedence: 20},
+	"+":          {precedence: 30},
+	"-":          {precedence: 30},
+	"*":          {precedence: 60},
+	"/":          {precedence: 60},
+	"%":          {precedence: 60},
+	"**":         {precedence: 70, rightAssociate: true},
+}
+
+// likeKeyword is lexed as an identifier by the expr lexer, it's an operator only when it follows an operand,
+// so a field can still be named `like`
+const likeKeyword = "like"
+
+// exprParser parses the tokens of the expr lexer into the ast of the expr parser.
+// It accepts the subset of the expr grammar that can be turned into a plan, plus the `like` operator.
+type exprParser struct {
+	tokens  []ant_lexer.Token
+	pos     int
+	current ant_lexer.Token
+	err     *file.Error
+}
+
+// parseExprAST parses exprStr into an ast
+func parseExprAST(exprStr string) (ant_ast.Node, error) {
+	source := file.NewSource(exprStr)
+	tokens, err := ant_lexer.Lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &exprParser{
+		tokens:  tokens,
+		current: tokens[0],
+	}
+	node := p.parseExpression(0)
+	if !p.current.Is(ant_lexer.EOF) {
+		p.error("unexpected token %v", p.current)
+	}
+	if p.err != nil {
+		return nil, p.err.Bind(source)
+	}
+	return node, nil
+}
+
+func (p *exprParser) error(format string, args ...interface{}) {
+	// keep the first error
+	if p.err == nil {
+		p.err = &file.Error{
+			Location: p.current.Location,
+			Message:  fmt.Sprintf(format, args...),
+		}
+	}
+}
+
+func (p *exprParser) next() {
+	p.pos++
+	if p.pos >= len(p.tokens) {
+		p.error("unexpected end of expression")
+		return
+	}
+	p.current = p.tokens[p.pos]
+}
+
+func (p *exprParser) expect(kind ant_lexer.Kind, values ...string) {
+	if p.current.Is(kind, values...) {
+		p.next()
+		return
+	}
+	p.error("unexpected token %v", p.current)
+}
+
+// binaryOperator returns the binary operator of the current token if it is one
+func (p *exprParser) binaryOperator() (exprOperator, bool) {
+	if !p.current.Is(ant_lexer.Operator) && !p.current.Is(ant_lexer.Identifier, likeKeyword) {
+		return exprOperator{}, false
+	}
+	op, ok := exprBinaryOperators[p.current.Value]
+	return op, ok
+}
+
+func (p *exprParser) parseExpression(precedence int) ant_ast.Node {
+	nodeLeft := p.parsePrimary()
+
+	for p.err == nil {
+		op, ok := p.binaryOperator()
+		if !ok || op.precedence < precedence {
+			break
+		}
+		token := p.current
+		p.next()
+
+		var nodeRight ant_ast.Node
+		if op.rightAssociate {
+			nodeRight = p.parseExpression(op.precedence)
+		} else {
+			nodeRight = p.parseExpression(op.precedence + 1)
+		}
+		node := &ant_ast.BinaryNode{
+			Operator: token.Value,
+			Left:     nodeLeft,
+			Right:    nodeRight,
+		}
+		node.SetLocation(token.Location)
+		nodeLeft = node
+	}
+	return nodeLeft
+}
+
+func (p *exprParser) parsePrimary() ant_ast.Node {
+	token := p.current
+
+	if token.Is(ant_lexer.Operator) {
+		if op, ok := exprUnaryOperators[token.Value]; ok {
+			p.next()
+			expr := p.parseExpression(op.precedence)
+			node := &ant_ast.UnaryNode{
+				Operator: token.Value,
+				Node:     expr,
+			}
+			node.SetLocation(token.Location)
+			return p.parsePostfixExpression(node)
+		}
+	}
+
+	if token.Is(ant_lexer.Bracket, "(") {
+		p.next()
+		expr := p.parseExpression(0)
+		p.expect(ant_lexer.Bracket, ")")
+		return p.parsePostfixExpression(expr)
+	}
+
+	var node ant_ast.Node
+	switch token.Kind {
+	case ant_lexer.Identifier:
+		p.next()
+		switch token.Value {
+		case "true", "false":
+			boolNode := &ant_ast.BoolNode{Value: token.Value == "true"}
+			boolNode.SetLocation(token.Location)
+			return boolNode
+		}
+		if p.current.Is(ant_lexer.Bracket, "(") {
+			p.error("function %s is not supported", token.Value)
+		}
+		node = &ant_ast.IdentifierNode{Value: token.Value}
+	case ant_lexer.Number:
+		p.next()
+		node = p.parseNumber(token)
+		node.SetLocation(token.Location)
+		return node
+	case ant_lexer.String:
+		p.next()
+		node = &ant_ast.StringNode{Value: token.Value}
+		node.SetLocation(token.Location)
+		return node
+	default:
+		if !token.Is(ant_lexer.Bracket, "[") {
+			p.error("unexpected token %v", token)
+			return &ant_ast.NilNode{}
+		}
+		node = p.parseArrayExpression()
+	}
+	node.SetLocation(token.Location)
+	return p.parsePostfixExpression(node)
+}
+
+func (p *exprParser) parseNumber(token ant_lexer.Token) ant_ast.Node {
+	value := strings.Replace(token.Value, "_", "", -1)
+	if strings.ContainsAny(value, ".eE") && !strings.ContainsAny(value, "xX") {
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			p.error("invalid float literal: %v", err)
+		}
+		return &ant_ast.FloatNode{Value: number}
+	}
+	base := 10
+	if len(value) > 1 && value[0] == '0' && strings.ContainsAny(value[1:2], "xXoObB") {
+		// the base is implied by the prefix
+		base = 0
+	}
+	number, err := strconv.ParseInt(value, base, 64)
+	if err != nil {
+		p.error("invalid integer literal: %v", err)
+	}
+	return &ant_ast.IntegerNode{Value: int(number)}
+}
+
+func (p *exprParser) parseArrayExpression() ant_ast.Node {
+	nodes := make([]ant_ast.Node, 0)
+
+	p.expect(ant_lexer.Bracket, "[")
+	for !p.current.Is(ant_lexer.Bracket, "]") && p.err == nil {
+		if len(nodes) > 0 {
+			p.expect(ant_lexer.Operator, ",")
+			// trailing comma
+			if p.current.Is(ant_lexer.Bracket, "]") {
+				break
+			}
+		}
+		nodes = append(nodes, p.parseExpression(0))
+	}
+	p.expect(ant_lexer.Bracket, "]")
+
+	return &ant_ast.ArrayNode{Nodes: nodes}
+}
+
+// parsePostfixExpression parses the keys following a node, such as `meta["color"]["name"]`
+func (p *exprParser) parsePostfixExpression(node ant_ast.Node) ant_ast.Node {
+	for p.current.Is(ant_lexer.Bracket, "[") && p.err == nil {
+		token := p.current
+		p.next()
+		index := p.parseExpression(0)
+		p.expect(ant_lexer.Bracket, "]")
+
+		indexNode := &ant_ast.IndexNode{
+			Node:  node,
+			Index: index,
+		}
+		indexNode.SetLocation(token.Location)
+		node = indexNode
+	}
+	return node
+}
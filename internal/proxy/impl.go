@@ -26,6 +26,8 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/logutil"
 
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -105,7 +107,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
 	}
 	logutil.Logger(ctx).Debug("complete to invalidate collection meta cache",
 		zap.String("role", typeutil.ProxyRole),
@@ -118,6 +120,42 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+// InvalidateCredentialCache removes the cached credential of the user.
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	ctx = logutil.WithModule(ctx, moduleName)
+	logutil.Logger(ctx).Debug("received request to invalidate credential cache",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", request.Username))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveCredential(request.Username) // no need to return error, though credential may be not cached
+	}
+	logutil.Logger(ctx).Debug("complete to invalidate credential cache",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// RefreshPolicyInfoCache marks the cached grants and role bindings as stale, they are reloaded from RootCoord on next access.
+func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	ctx = logutil.WithModule(ctx, moduleName)
+	logutil.Logger(ctx).Debug("received request to refresh policy info cache",
+		zap.String("role", typeutil.ProxyRole))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RefreshPolicyInfo()
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 // ReleaseDQLMessageStream release the query message stream of specific collection.
 func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
@@ -149,6 +187,10 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	// the collection has no id yet, only the limits of proxy apply
+	if status := node.rateLimiter.check(0, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateCollection")
 	defer sp.Finish()
@@ -249,6 +291,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropCollection")
 	defer sp.Finish()
@@ -318,6 +363,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
+	if dct.result.GetErrorCode() == commonpb.ErrorCode_Success {
+		node.rateLimiter.removeCollection(dct.collectionID)
+	}
 	return dct.result, nil
 }
 
@@ -407,6 +455,9 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-LoadCollection")
 	defer sp.Finish()
@@ -482,6 +533,9 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ReleaseCollection")
 	defer sp.Finish()
@@ -811,6 +865,9 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreatePartition")
 	defer sp.Finish()
@@ -897,6 +954,9 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropPartition")
 	defer sp.Finish()
@@ -1081,6 +1141,9 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-LoadPartitions")
 	defer sp.Finish()
@@ -1168,6 +1231,9 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ReleasePartitions")
 	defer sp.Finish()
@@ -1439,6 +1505,9 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ShowPartitions")
 	defer sp.Finish()
@@ -1635,6 +1704,9 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropIndex")
 	defer sp.Finish()
@@ -1936,6 +2008,11 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, dmlCosts(int(request.NumRows), request)...); status != nil {
+		return &milvuspb.MutationResult{
+			Status: status,
+		}, nil
+	}
 
 	it := &insertTask{
 		ctx:       ctx,
@@ -2043,6 +2120,12 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	// the number of deleted rows is unknown until the expression is evaluated, only the bytes are limited
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, dmlCosts(0, request)...); status != nil {
+		return &milvuspb.MutationResult{
+			Status: status,
+		}, nil
+	}
 
 	deleteReq := &milvuspb.DeleteRequest{
 		DbName:         request.DbName,
@@ -2114,6 +2197,75 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return dt.result, nil
 }
 
+// Upsert replaces the records which share primary keys with the request, inserting the absent ones.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	log.Info("Start processing upsert request in Proxy", zap.String("traceID", traceID))
+	defer log.Info("Finish processing upsert request in Proxy", zap.String("traceID", traceID))
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, dmlCosts(int(request.NumRows), request)...); status != nil {
+		return &milvuspb.MutationResult{
+			Status: status,
+		}, nil
+	}
+
+	ut := newUpsertTask(ctx, request, node.idAllocator, node.segAssigner, node.chMgr, node.chTicker)
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("len(FieldsData)", len(request.FieldsData)),
+		zap.Int("len(HashKeys)", len(request.HashKeys)),
+		zap.Uint32("NumRows", request.NumRows),
+		zap.String("traceID", traceID))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Debug("Failed to enqueue upsert task: " + err.Error())
+		return constructFailedResponse(err), nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("msgID", ut.ID()),
+		zap.Uint64("timestamp", ut.BeginTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows),
+		zap.String("traceID", traceID))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Debug("Failed to execute upsert task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		return constructFailedResponse(err), nil
+	}
+
+	return ut.result, nil
+}
+
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
@@ -2121,26 +2273,17 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: dqlNQRate, cost: getNq(request.PlaceholderGroup)}); status != nil {
+		return &milvuspb.SearchResults{
+			Status: status,
+		}, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	qt := &searchTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		SearchRequest: &internalpb.SearchRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_Search,
-				SourceID: Params.ProxyCfg.ProxyID,
-			},
-			ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
-		},
-		resultBuf: make(chan []*internalpb.SearchResults),
-		query:     request,
-		chMgr:     node.chMgr,
-		qc:        node.queryCoord,
-	}
+	qt := node.newSearchTask(ctx, request)
 
 	method := "Search"
 	travelTs := request.TravelTimestamp
@@ -2243,6 +2386,157 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+func (node *Proxy) newSearchTask(ctx context.Context, request *milvuspb.SearchRequest) *searchTask {
+	return &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Search,
+				SourceID: Params.ProxyCfg.ProxyID,
+			},
+			ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
+		},
+		resultBuf: make(chan []*internalpb.SearchResults),
+		query:     request,
+		chMgr:     node.chMgr,
+		qc:        node.queryCoord,
+		shardMgr:  node.shardMgr,
+	}
+}
+
+// HybridSearch searches several vector fields of a collection, one search task per request,
+// and fuses the results of them by the reranker specified in the rank params.
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.SearchResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	nq := 0
+	for _, subReq := range request.GetRequests() {
+		nq += getNq(subReq.GetPlaceholderGroup())
+	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: dqlNQRate, cost: nq}); status != nil {
+		return &milvuspb.SearchResults{
+			Status: status,
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-HybridSearch")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	method := "HybridSearch"
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Any("partitions", request.GetPartitionNames()),
+		zap.Int("len(requests)", len(request.GetRequests())),
+		zap.Any("rank_params", request.GetRankParams()),
+		zap.Any("OutputFields", request.GetOutputFields()),
+		zap.Uint64("travel_timestamp", request.GetTravelTimestamp()),
+		zap.Uint64("guarantee_timestamp", request.GetGuaranteeTimestamp()))
+
+	result, err := node.hybridSearch(ctx, request)
+	if err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.GetDbName()),
+			zap.String("collection", request.GetCollectionName()))
+
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Int64s("topks", result.GetResults().GetTopks()))
+
+	return result, nil
+}
+
+func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if len(request.GetRequests()) == 0 {
+		return nil, errors.New("no search request in hybrid search")
+	}
+	ranker, err := newReranker(request.GetRankParams(), len(request.GetRequests()))
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parseRankLimit(request.GetRankParams())
+	if err != nil {
+		return nil, err
+	}
+
+	// all the requests search the same snapshot of the collection
+	travelTs := request.GetTravelTimestamp()
+	if travelTs == 0 {
+		travelTs, err = node.tsoAllocator.AllocOne()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := make([]*searchTask, 0, len(request.GetRequests()))
+	metricTypes := make([]string, 0, len(request.GetRequests()))
+	for _, subReq := range request.GetRequests() {
+		if subReq == nil {
+			return nil, errors.New("search request in hybrid search is nil")
+		}
+		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, subReq.GetSearchParams())
+		if err != nil {
+			return nil, errors.New(MetricTypeKey + " not found in search_params")
+		}
+		metricTypes = append(metricTypes, metricType)
+
+		qt := node.newSearchTask(ctx, &milvuspb.SearchRequest{
+			Base:               subReq.GetBase(),
+			DbName:             request.GetDbName(),
+			CollectionName:     request.GetCollectionName(),
+			PartitionNames:     request.GetPartitionNames(),
+			Dsl:                subReq.GetDsl(),
+			PlaceholderGroup:   subReq.GetPlaceholderGroup(),
+			DslType:            subReq.GetDslType(),
+			OutputFields:       request.GetOutputFields(),
+			SearchParams:       subReq.GetSearchParams(),
+			TravelTimestamp:    travelTs,
+			GuaranteeTimestamp: request.GetGuaranteeTimestamp(),
+		})
+		if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, qt)
+	}
+
+	results := make([]*schemapb.SearchResultData, 0, len(tasks))
+	for _, qt := range tasks {
+		if err := qt.WaitToFinish(); err != nil {
+			return nil, err
+		}
+		if qt.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, errors.New(qt.result.GetStatus().GetReason())
+		}
+		results = append(results, qt.result.GetResults())
+	}
+
+	return rerankSearchResults(ranker, results, metricTypes, limit, offset)
+}
+
 // Flush notify data nodes to persist the data of collection.
 func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	resp := &milvuspb.FlushResponse{
@@ -2255,6 +2549,11 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 		resp.Status.Reason = "proxy is not healthy"
 		return resp, nil
 	}
+	// a flush may cover several collections, only the limit of the whole Proxy applies
+	if status := node.rateLimiter.check(0, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		resp.Status = status
+		return resp, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Flush")
 	defer sp.Finish()
@@ -2336,6 +2635,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: dqlNQRate, cost: 1}); status != nil {
+		return &milvuspb.QueryResults{
+			Status: status,
+		}, nil
+	}
 
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Query")
 	defer sp.Finish()
@@ -2355,6 +2659,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		query:     request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		shardMgr:  node.shardMgr,
 	}
 
 	method := "Query"
@@ -2690,60 +2995,684 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
-// CalcDistance calculates the distances between vectors.
-func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+// AddField append a scalar field with a default value to the schema of collection.
+func (node *Proxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
-		return &milvuspb.CalcDistanceResults{
-			Status: unhealthyStatus(),
-		}, nil
+		return unhealthyStatus(), nil
 	}
-
-	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
-	metric, err := distance.ValidateMetricType(param)
-	if err != nil {
-		return &milvuspb.CalcDistanceResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
-		}, nil
+	if status := node.checkRateLimit(ctx, request.DbName, request.CollectionName, rateCost{rt: ddlOpRate, cost: 1}); status != nil {
+		return status, nil
 	}
 
-	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AddField")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
-		outputFields := []string{ids.FieldName}
-
-		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
-			CollectionName: ids.CollectionName,
-			PartitionNames: ids.PartitionNames,
-			OutputFields:   outputFields,
-		}
+	aft := &AddFieldTask{
+		ctx:             ctx,
+		Condition:       NewTaskCondition(ctx),
+		AddFieldRequest: request,
+		rootCoord:       node.rootCoord,
+		queryCoord:      node.queryCoord,
+	}
 
-		qt := &queryTask{
-			ctx:       ctx,
-			Condition: NewTaskCondition(ctx),
-			RetrieveRequest: &internalpb.RetrieveRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:  commonpb.MsgType_Retrieve,
-					SourceID: Params.ProxyCfg.ProxyID,
-				},
-				ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
-			},
-			resultBuf: make(chan []*internalpb.RetrieveResults),
-			query:     queryRequest,
-			chMgr:     node.chMgr,
-			qc:        node.queryCoord,
-			ids:       ids.IdArray,
-		}
+	method := "AddField"
 
-		err := node.sched.dqQueue.Enqueue(qt)
-		if err != nil {
-			log.Debug("CalcDistance queryTask failed to enqueue",
-				zap.Error(err),
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := node.sched.ddQueue.Enqueue(aft); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := aft.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", aft.ID()),
+			zap.Uint64("BeginTs", aft.BeginTs()),
+			zap.Uint64("EndTs", aft.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	return aft.result, nil
+}
+
+// CreateDatabase create a new database.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	method := "CreateDatabase"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	if err := node.sched.ddQueue.Enqueue(cdt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName))
+
+	if err := cdt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", cdt.ID()),
+			zap.Uint64("BeginTs", cdt.BeginTs()),
+			zap.Uint64("EndTs", cdt.EndTs()),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName))
+
+	return cdt.result, nil
+}
+
+// DropDatabase drop a database and all collections in it.
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	method := "DropDatabase"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ddt.ID()),
+			zap.Uint64("BeginTs", ddt.BeginTs()),
+			zap.Uint64("EndTs", ddt.EndTs()),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	return ddt.result, nil
+}
+
+// ListDatabases list all databases.
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{Status: unhealthyStatus()}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListDatabases")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	method := "ListDatabases"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole))
+
+	if err := node.sched.ddQueue.Enqueue(ldt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	if err := ldt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ldt.ID()),
+			zap.Uint64("BeginTs", ldt.BeginTs()),
+			zap.Uint64("EndTs", ldt.EndTs()))
+
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	return ldt.result, nil
+}
+
+// CreateCredential creates a new user, the password in the request is encoded by base64.
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	rawPassword, err := crypto.Base64Decode(req.Password)
+	if err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, fmt.Errorf("decode password failed: %w", err)), nil
+	}
+	if err := ValidatePassword(rawPassword); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_CreateCredential
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.CreateCredential(ctx, req)
+	if err != nil {
+		log.Error("CreateCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// UpdateCredential updates the password of a user, the passwords in the request are encoded by base64.
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	rawNewPassword, err := crypto.Base64Decode(req.NewPassword)
+	if err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, fmt.Errorf("decode new password failed: %w", err)), nil
+	}
+	if err := ValidatePassword(rawNewPassword); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_UpdateCredential
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.UpdateCredential(ctx, req)
+	if err != nil {
+		log.Error("UpdateCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// DeleteCredential deletes a user, the root user can't be deleted.
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Username == util.UserRoot {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, fmt.Errorf("user %s can't be deleted", util.UserRoot)), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_DeleteCredential
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.DeleteCredential(ctx, req)
+	if err != nil {
+		log.Error("DeleteCredential failed", zap.String("username", req.Username), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// ListCredUsers lists the names of all users.
+func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	log.Debug("ListCredUsers", zap.String("role", typeutil.ProxyRole))
+	if !node.checkHealthy() {
+		return &milvuspb.ListCredUsersResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_ListCredUsernames
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.ListCredUsers(ctx, req)
+	if err != nil {
+		log.Error("ListCredUsers failed", zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: failedStatus(commonpb.ErrorCode_UnexpectedError, err),
+		}, nil
+	}
+	return result, nil
+}
+
+// CreateRole creates a new role.
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole", zap.String("role", typeutil.ProxyRole), zap.String("role name", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_CreateRole
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.CreateRole(ctx, req)
+	if err != nil {
+		log.Error("CreateRole failed", zap.String("role name", req.RoleName), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// DropRole drops a role together with its user bindings and grants.
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", typeutil.ProxyRole), zap.String("role name", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if req.RoleName == util.RoleAdmin || req.RoleName == util.RolePublic {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, fmt.Errorf("built-in role %s can't be dropped", req.RoleName)), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_DropRole
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil {
+		log.Error("DropRole failed", zap.String("role name", req.RoleName), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// OperateUserRole adds a user to a role or removes a user from a role.
+func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username),
+		zap.String("role name", req.RoleName), zap.String("type", req.Type.String()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if err := ValidateUsername(req.Username); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if err := ValidateRoleName(req.RoleName); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_OperateUserRole
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.OperateUserRole(ctx, req)
+	if err != nil {
+		log.Error("OperateUserRole failed", zap.String("username", req.Username),
+			zap.String("role name", req.RoleName), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// SelectRole gets one or all roles.
+func (node *Proxy) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	log.Debug("SelectRole", zap.String("role", typeutil.ProxyRole), zap.String("role name", req.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectRoleResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.RoleName != "" {
+		if err := ValidateRoleName(req.RoleName); err != nil {
+			return &milvuspb.SelectRoleResponse{
+				Status: failedStatus(commonpb.ErrorCode_IllegalArgument, err),
+			}, nil
+		}
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_SelectRole
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.SelectRole(ctx, req)
+	if err != nil {
+		log.Error("SelectRole failed", zap.String("role name", req.RoleName), zap.Error(err))
+		return &milvuspb.SelectRoleResponse{
+			Status: failedStatus(commonpb.ErrorCode_UnexpectedError, err),
+		}, nil
+	}
+	return result, nil
+}
+
+// SelectUser gets one or all users.
+func (node *Proxy) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	log.Debug("SelectUser", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectUserResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.Username != "" {
+		if err := ValidateUsername(req.Username); err != nil {
+			return &milvuspb.SelectUserResponse{
+				Status: failedStatus(commonpb.ErrorCode_IllegalArgument, err),
+			}, nil
+		}
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_SelectUser
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.SelectUser(ctx, req)
+	if err != nil {
+		log.Error("SelectUser failed", zap.String("username", req.Username), zap.Error(err))
+		return &milvuspb.SelectUserResponse{
+			Status: failedStatus(commonpb.ErrorCode_UnexpectedError, err),
+		}, nil
+	}
+	return result, nil
+}
+
+// OperatePrivilege grants a privilege to a role or revokes it, the grantor is the current user.
+func (node *Proxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("OperatePrivilege", zap.String("role", typeutil.ProxyRole), zap.Any("entity", req.Entity),
+		zap.String("type", req.Type.String()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.Entity == nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, errors.New("grant entity should not be empty")), nil
+	}
+	if err := ValidateRoleName(req.Entity.RoleName); err != nil {
+		return failedStatus(commonpb.ErrorCode_IllegalArgument, err), nil
+	}
+	if username, err := GetCurUserFromContext(ctx); err == nil {
+		req.Entity.Grantor = username
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_OperatePrivilege
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.OperatePrivilege(ctx, req)
+	if err != nil {
+		log.Error("OperatePrivilege failed", zap.Any("entity", req.Entity), zap.Error(err))
+		return failedStatus(commonpb.ErrorCode_UnexpectedError, err), nil
+	}
+	return result, nil
+}
+
+// SelectGrant lists the grants of a role.
+func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	log.Debug("SelectGrant", zap.String("role", typeutil.ProxyRole), zap.Any("entity", req.Entity))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectGrantResponse{Status: unhealthyStatus()}, nil
+	}
+	if req.Entity == nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: failedStatus(commonpb.ErrorCode_IllegalArgument, errors.New("grant entity should not be empty")),
+		}, nil
+	}
+	if err := ValidateRoleName(req.Entity.RoleName); err != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: failedStatus(commonpb.ErrorCode_IllegalArgument, err),
+		}, nil
+	}
+	if req.Base == nil {
+		req.Base = &commonpb.MsgBase{}
+	}
+	req.Base.MsgType = commonpb.MsgType_SelectGrant
+	req.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	result, err := node.rootCoord.SelectGrant(ctx, req)
+	if err != nil {
+		log.Error("SelectGrant failed", zap.Any("entity", req.Entity), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: failedStatus(commonpb.ErrorCode_UnexpectedError, err),
+		}, nil
+	}
+	return result, nil
+}
+
+// CalcDistance calculates the distances between vectors.
+func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.CalcDistanceResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
+	metric, err := distance.ValidateMetricType(param)
+	if err != nil {
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		outputFields := []string{ids.FieldName}
+
+		queryRequest := &milvuspb.QueryRequest{
+			DbName:         "",
+			CollectionName: ids.CollectionName,
+			PartitionNames: ids.PartitionNames,
+			OutputFields:   outputFields,
+		}
+
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyCfg.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
+			},
+			resultBuf: make(chan []*internalpb.RetrieveResults),
+			query:     queryRequest,
+			chMgr:     node.chMgr,
+			qc:        node.queryCoord,
+			shardMgr:  node.shardMgr,
+			ids:       ids.IdArray,
+		}
+
+		err := node.sched.dqQueue.Enqueue(qt)
+		if err != nil {
+			log.Debug("CalcDistance queryTask failed to enqueue",
+				zap.Error(err),
 				zap.String("traceID", traceID),
 				zap.String("role", typeutil.ProxyRole),
 				zap.String("db", queryRequest.DbName),
@@ -3210,7 +4139,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.DbName, req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
 		return resp, nil
@@ -3573,6 +4502,91 @@ func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStat
 	return resp, err
 }
 
+// Import imports files from the object storage into the collection, every file must be a row-based json file
+// or a column-based numpy file, the ids of the import tasks are returned to track the progress.
+func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	log.Info("received import request", zap.String("collection", req.GetCollectionName()),
+		zap.String("partition", req.GetPartitionName()), zap.Bool("rowBased", req.GetRowBased()),
+		zap.Strings("files", req.GetFiles()))
+	resp := &milvuspb.ImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	if len(req.GetFiles()) == 0 {
+		resp.Status.Reason = "import request has no file"
+		return resp, nil
+	}
+
+	describeResp, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeCollection,
+			SourceID: Params.ProxyCfg.ProxyID,
+		},
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if describeResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		resp.Status = describeResp.GetStatus()
+		return resp, nil
+	}
+
+	partitionName := req.GetPartitionName()
+	if partitionName == "" {
+		partitionName = Params.CommonCfg.DefaultPartitionName
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetDbName(), req.GetCollectionName(), partitionName)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp, err = node.dataCoord.Import(ctx, &datapb.ImportTaskRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.ProxyCfg.ProxyID,
+		},
+		ImportTask: &datapb.ImportTask{
+			CollectionId: describeResp.GetCollectionID(),
+			PartitionId:  partitionID,
+			ChannelNames: describeResp.GetVirtualChannelNames(),
+			RowBased:     req.GetRowBased(),
+			Files:        req.GetFiles(),
+			Infos:        req.GetOptions(),
+		},
+	})
+	if err != nil {
+		log.Info("failed to import", zap.Error(err))
+		return nil, err
+	}
+	log.Info("received import response", zap.Int64s("tasks", resp.GetTasks()))
+	return resp, nil
+}
+
+// GetImportState gets the state of an import task
+func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("received get import state request", zap.Int64("taskID", req.GetTask()))
+	resp := &milvuspb.GetImportStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetImportState(ctx, req)
+	if err != nil {
+		log.Info("failed to get import state", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
 // checkHealthy checks proxy state is Healthy
 func (node *Proxy) checkHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -3586,3 +4600,10 @@ func unhealthyStatus() *commonpb.Status {
 		Reason:    "proxy not healthy",
 	}
 }
+
+func failedStatus(code commonpb.ErrorCode, err error) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: code,
+		Reason:    err.Error(),
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,27 +31,53 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-// Cache is the interface for system meta data cache
+// Cache is the interface for system meta data cache, collections are scoped by database name,
+// an empty database name refers to the default database.
 type Cache interface {
 	// GetCollectionID get collection's id by name.
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionID(ctx context.Context, database string, collectionName string) (typeutil.UniqueID, error)
 	// GetCollectionInfo get collection's information by name, such as collection id, schema, and etc.
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
+	GetCollectionInfo(ctx context.Context, database string, collectionName string) (*collectionInfo, error)
 	// GetPartitionID get partition's identifier of specific collection.
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitionID(ctx context.Context, database string, collectionName string, partitionName string) (typeutil.UniqueID, error)
 	// GetPartitions get all partitions' id of specific collection.
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, database string, collectionName string) (map[string]typeutil.UniqueID, error)
 	// GetPartitionInfo get partition's info.
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
+	GetPartitionInfo(ctx context.Context, database string, collectionName string, partitionName string) (*partitionInfo, error)
 	// GetCollectionSchema get collection's schema.
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionSchema(ctx context.Context, database string, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, database string, collectionName string)
+	RemovePartition(ctx context.Context, database string, collectionName string, partitionName string)
+	// RemoveDatabase removes all cached collections of the database.
+	RemoveDatabase(ctx context.Context, database string)
+
+	// GetCredentialInfo get the credential of a user, it is fetched from RootCoord if not cached.
+	GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error)
+	// UpdateCredential update the cached credential of a user.
+	UpdateCredential(credInfo *credentialInfo)
+	// RemoveCredential remove the cached credential of a user.
+	RemoveCredential(username string)
+	// GetUserRoles get the roles bound to a user, the public role is not included.
+	GetUserRoles(ctx context.Context, username string) ([]string, error)
+	// HasGrant check whether the privilege on the object is granted to the role.
+	HasGrant(ctx context.Context, roleName, objectType, objectName, privilege string) (bool, error)
+	// RefreshPolicyInfo mark the cached grants and role bindings as stale, they are reloaded on next access.
+	RefreshPolicyInfo()
+
+	// GetReplicas get the replicas of a loaded collection, they are fetched from QueryCoord if not cached.
+	GetReplicas(ctx context.Context, qc types.QueryCoord, collectionID typeutil.UniqueID) ([]*querypb.ReplicaInfo, error)
+	// GetShardLeaders get the query nodes serving a replica of a loaded collection, they are fetched from QueryCoord if not cached.
+	GetShardLeaders(ctx context.Context, qc types.QueryCoord, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) (*querypb.GetShardLeadersResponse, error)
+	// ClearReplicas remove the cached replicas and shard leaders of a collection, they are refetched on next access.
+	ClearReplicas(collectionID typeutil.UniqueID)
 }
 
 type collectionInfo struct {
@@ -59,6 +86,15 @@ type collectionInfo struct {
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	ttl                 time.Duration
+}
+
+type credentialInfo struct {
+	username          string
+	encryptedPassword string
+	// sha256Password is the sha256 of the password which was verified against encryptedPassword,
+	// it saves the bcrypt comparison for the following requests of the same user
+	sha256Password string
 }
 
 type partitionInfo struct {
@@ -74,8 +110,20 @@ var _ Cache = (*MetaCache)(nil)
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database name -> collection name -> collection info
 	mu       sync.RWMutex
+
+	credMap map[string]*credentialInfo // username -> credential info
+	credMut sync.RWMutex
+
+	policyLoaded bool
+	userRoles    map[string][]string // username -> role names
+	grants       map[string]struct{} // policyKey(role, object type, object name, privilege)
+	policyMu     sync.RWMutex
+
+	replicas     map[typeutil.UniqueID][]*querypb.ReplicaInfo                                 // collection id -> replicas
+	shardLeaders map[typeutil.UniqueID]map[typeutil.UniqueID]*querypb.GetShardLeadersResponse // collection id -> replica id -> shard leaders
+	replicaMu    sync.RWMutex
 }
 
 // globalMetaCache is singleton instance of Cache
@@ -95,25 +143,53 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
+		credMap:  map[string]*credentialInfo{},
+		replicas: map[typeutil.UniqueID][]*querypb.ReplicaInfo{},
+
+		shardLeaders: map[typeutil.UniqueID]map[typeutil.UniqueID]*querypb.GetShardLeadersResponse{},
 	}, nil
 }
 
+func normalizeDatabase(database string) string {
+	if database == "" {
+		return common.DefaultDBName
+	}
+	return database
+}
+
+// getCollection returns the cached collection info, the caller must hold m.mu
+func (m *MetaCache) getCollection(database, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[normalizeDatabase(database)][collectionName]
+	return collInfo, ok
+}
+
+// addCollection adds an empty collection info into the cache, the caller must hold m.mu
+func (m *MetaCache) addCollection(database, collectionName string) *collectionInfo {
+	database = normalizeDatabase(database)
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = map[string]*collectionInfo{}
+	}
+	collInfo := &collectionInfo{}
+	m.collInfo[database][collectionName] = collInfo
+	return collInfo
+}
+
 // GetCollectionID returns the corresponding collection id for provided collection name
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *MetaCache) GetCollectionID(ctx context.Context, database string, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo, _ = m.getCollection(database, collectionName)
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -123,21 +199,21 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 
 // GetCollectionInfo returns the collection information related to provided collection name
 // If the information is not found, proxy will try to fetch information for other source (RootCoord for now)
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, database string, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo, _ = m.getCollection(database, collectionName)
 	}
 
 	return &collectionInfo{
@@ -146,17 +222,18 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		ttl:                 collInfo.ttl,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, database string, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 
 	if !ok {
 		t0 := time.Now()
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
 				zap.String("collection name ", collectionName),
@@ -165,8 +242,8 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		m.updateCollection(coll, database, collectionName)
+		collInfo, _ = m.getCollection(database, collectionName)
 		log.Debug("Reload collection from rootcoord ",
 			zap.String("collection name ", collectionName),
 			zap.Any("time take ", time.Since(t0)))
@@ -177,34 +254,36 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database string, collectionName string) {
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = m.addCollection(database, collectionName)
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	// the properties are validated when the collection is created
+	collInfo.ttl, _ = funcutil.GetCollectionTTL(coll.Properties)
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, database string, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, database, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, database string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -213,7 +292,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -221,13 +300,14 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
+		collInfo, _ = m.getCollection(database, collectionName)
+		partInfo := collInfo.partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -237,7 +317,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	for k, v := range partInfo {
 		ret[k] = v.partitionID
 	}
@@ -245,15 +325,15 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, database string, collectionName string, partitionName string) (*partitionInfo, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -264,20 +344,21 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		collInfo, _ = m.getCollection(database, collectionName)
+		partInfo, ok = collInfo.partInfo[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -290,11 +371,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 }
 
 // Get the collection information from rootcoord.
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, database string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -311,6 +393,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 			Description: coll.Schema.Description,
 			AutoID:      coll.Schema.AutoID,
 			Fields:      make([]*schemapb.FieldSchema, 0),
+			Version:     coll.Schema.Version,
 		},
 		CollectionID:         coll.CollectionID,
 		VirtualChannelNames:  coll.VirtualChannelNames,
@@ -326,11 +409,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, database string, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 
@@ -350,14 +434,12 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database string, collectionName string) error {
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
-			partInfo: map[string]*partitionInfo{},
-		}
+		collInfo = m.addCollection(database, collectionName)
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -376,26 +458,233 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
 	return nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+// RemoveCollection removes the cached collection along with its replicas, since the collection
+// may have been dropped, renamed or released by another proxy
+func (m *MetaCache) RemoveCollection(ctx context.Context, database string, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	if collInfo, ok := m.getCollection(database, collectionName); ok {
+		m.ClearReplicas(collInfo.collID)
+	}
+	delete(m.collInfo[normalizeDatabase(database)], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, database string, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollection(database, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, collInfo := range m.collInfo[normalizeDatabase(database)] {
+		m.ClearReplicas(collInfo.collID)
+	}
+	delete(m.collInfo, normalizeDatabase(database))
+}
+
+// GetCredentialInfo returns the credential of the user, it is fetched from RootCoord on a cache miss
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*credentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+	if ok {
+		return credInfo, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_GetCredential,
+		},
+		Username: username,
+	}
+	resp, err := m.client.GetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	credInfo = &credentialInfo{
+		username:          resp.Username,
+		encryptedPassword: resp.EncryptedPassword,
+	}
+	m.UpdateCredential(credInfo)
+	return credInfo, nil
+}
+
+// UpdateCredential replaces the cached credential of the user
+func (m *MetaCache) UpdateCredential(credInfo *credentialInfo) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap[credInfo.username] = credInfo
+}
+
+// RemoveCredential removes the cached credential of the user
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	delete(m.credMap, username)
+}
+
+func policyKey(roleName, objectType, objectName, privilege string) string {
+	return strings.Join([]string{roleName, objectType, objectName, privilege}, "/")
+}
+
+// loadPolicyInfo fetches all grants and role bindings from RootCoord if they are stale
+func (m *MetaCache) loadPolicyInfo(ctx context.Context) error {
+	m.policyMu.RLock()
+	loaded := m.policyLoaded
+	m.policyMu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	req := &rootcoordpb.ListPolicyRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListPolicy,
+		},
+	}
+	resp, err := m.client.ListPolicy(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	userRoles := make(map[string][]string, len(resp.UserRoles))
+	for _, user := range resp.UserRoles {
+		userRoles[user.Username] = user.RoleNames
+	}
+	grants := make(map[string]struct{}, len(resp.Grants))
+	for _, grant := range resp.Grants {
+		grants[policyKey(grant.RoleName, grant.ObjectType, grant.ObjectName, grant.Privilege)] = struct{}{}
+	}
+
+	m.policyMu.Lock()
+	defer m.policyMu.Unlock()
+	m.userRoles = userRoles
+	m.grants = grants
+	m.policyLoaded = true
+	return nil
+}
+
+// GetUserRoles returns the roles bound to the user
+func (m *MetaCache) GetUserRoles(ctx context.Context, username string) ([]string, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return nil, err
+	}
+	m.policyMu.RLock()
+	defer m.policyMu.RUnlock()
+	return m.userRoles[username], nil
+}
+
+// HasGrant returns true if the privilege on the object is granted to the role
+func (m *MetaCache) HasGrant(ctx context.Context, roleName, objectType, objectName, privilege string) (bool, error) {
+	if err := m.loadPolicyInfo(ctx); err != nil {
+		return false, err
+	}
+	m.policyMu.RLock()
+	defer m.policyMu.RUnlock()
+	_, ok := m.grants[policyKey(roleName, objectType, objectName, privilege)]
+	return ok, nil
+}
+
+// RefreshPolicyInfo marks the cached grants and role bindings as stale
+func (m *MetaCache) RefreshPolicyInfo() {
+	m.policyMu.Lock()
+	defer m.policyMu.Unlock()
+	m.policyLoaded = false
+}
+
+// GetReplicas returns the replicas of the loaded collection, they are fetched from QueryCoord on a cache miss.
+// An empty slice means the collection is loaded without replicas.
+func (m *MetaCache) GetReplicas(ctx context.Context, qc types.QueryCoord, collectionID typeutil.UniqueID) ([]*querypb.ReplicaInfo, error) {
+	m.replicaMu.RLock()
+	replicas, ok := m.replicas[collectionID]
+	m.replicaMu.RUnlock()
+	if ok {
+		return replicas, nil
+	}
+
+	resp, err := qc.GetReplicas(ctx, &querypb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetReplicas,
+			SourceID: Params.ProxyCfg.ProxyID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	replicas = resp.GetReplicas()
+	if replicas == nil {
+		replicas = []*querypb.ReplicaInfo{}
+	}
+
+	m.replicaMu.Lock()
+	defer m.replicaMu.Unlock()
+	m.replicas[collectionID] = replicas
+	return replicas, nil
+}
+
+// GetShardLeaders returns the query nodes serving the replica of the collection and their addresses,
+// they are fetched from QueryCoord on a cache miss
+func (m *MetaCache) GetShardLeaders(ctx context.Context, qc types.QueryCoord, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID) (*querypb.GetShardLeadersResponse, error) {
+	m.replicaMu.RLock()
+	leaders, ok := m.shardLeaders[collectionID][replicaID]
+	m.replicaMu.RUnlock()
+	if ok {
+		return leaders, nil
+	}
+
+	leaders, err := qc.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetShardLeaders,
+			SourceID: Params.ProxyCfg.ProxyID,
+		},
+		CollectionID: collectionID,
+		ReplicaID:    replicaID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if leaders.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(leaders.Status.Reason)
+	}
+	if len(leaders.NodeAddrs) == 0 || len(leaders.NodeAddrs) != len(leaders.NodeIds) {
+		return nil, fmt.Errorf("no available query node serves replica %d of collection %d", replicaID, collectionID)
+	}
+
+	m.replicaMu.Lock()
+	defer m.replicaMu.Unlock()
+	if _, ok := m.shardLeaders[collectionID]; !ok {
+		m.shardLeaders[collectionID] = map[typeutil.UniqueID]*querypb.GetShardLeadersResponse{}
+	}
+	m.shardLeaders[collectionID][replicaID] = leaders
+	return leaders, nil
+}
+
+// ClearReplicas removes the cached replicas and shard leaders of the collection
+func (m *MetaCache) ClearReplicas(collectionID typeutil.UniqueID) {
+	m.replicaMu.Lock()
+	defer m.replicaMu.Unlock()
+	delete(m.replicas, collectionID)
+	delete(m.shardLeaders, collectionID)
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -90,6 +92,17 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 		return nil, errors.New("mocked error")
 	}
 	m.AccessCount++
+	if in.DbName == "db1" && in.CollectionName == "collection1" {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			CollectionID: typeutil.UniqueID(11),
+			Schema: &schemapb.CollectionSchema{
+				AutoID: true,
+			},
+		}, nil
+	}
 	if in.CollectionName == "collection1" {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
@@ -134,6 +147,54 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 	}, nil
 }
 
+func (m *MockRootCoordClientInterface) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if req.Username == "user1" {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username:          "user1",
+			EncryptedPassword: "encrypted",
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "user not found",
+		},
+	}, nil
+}
+
+func (m *MockRootCoordClientInterface) ListPolicy(ctx context.Context, req *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	return &rootcoordpb.ListPolicyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Grants: []*milvuspb.GrantEntity{
+			{
+				RoleName:   "role1",
+				ObjectType: "Collection",
+				ObjectName: "collection1",
+				Privilege:  "Insert",
+			},
+		},
+		UserRoles: []*milvuspb.UserResult{
+			{
+				Username:  "user1",
+				RoleNames: []string{"role1"},
+			},
+		},
+	}, nil
+}
+
 //Simulate the cache path and the
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
@@ -141,24 +202,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, client.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -167,11 +228,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -188,13 +249,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	client.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	client.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -216,10 +277,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -230,16 +291,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -251,25 +312,238 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, "", "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+func TestMetaCache_Database(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// the default database can be referred by its name as well
+	id, err = globalMetaCache.GetCollectionID(ctx, "default", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 1, client.AccessCount)
+
+	id, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), id)
+	assert.Equal(t, 2, client.AccessCount)
+
+	globalMetaCache.RemoveCollection(ctx, "db1", "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 2, client.AccessCount)
+	id, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), id)
+	assert.Equal(t, 3, client.AccessCount)
+
+	globalMetaCache.RemoveDatabase(ctx, "db1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 3, client.AccessCount)
+	id, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), id)
+	assert.Equal(t, 4, client.AccessCount)
+}
+
+func TestMetaCache_Credential(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "encrypted", credInfo.encryptedPassword)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// cached
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.UpdateCredential(&credentialInfo{username: "user1", encryptedPassword: "encrypted2"})
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "encrypted2", credInfo.encryptedPassword)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.RemoveCredential("user1")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "encrypted", credInfo.encryptedPassword)
+	assert.Equal(t, 2, client.AccessCount)
+
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user2")
+	assert.NotNil(t, err)
+
+	client.Error = true
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "user3")
+	assert.NotNil(t, err)
+}
+
+func TestMetaCache_PolicyInfo(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	roles, err := globalMetaCache.GetUserRoles(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"role1"}, roles)
+	assert.Equal(t, 1, client.AccessCount)
+
+	ok, err := globalMetaCache.HasGrant(ctx, "role1", "Collection", "collection1", "Insert")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = globalMetaCache.HasGrant(ctx, "role1", "Collection", "collection1", "Search")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.RefreshPolicyInfo()
+	roles, err = globalMetaCache.GetUserRoles(ctx, "user2")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(roles))
+	assert.Equal(t, 2, client.AccessCount)
+
+	client.Error = true
+	globalMetaCache.RefreshPolicyInfo()
+	_, err = globalMetaCache.HasGrant(ctx, "role1", "Collection", "collection1", "Insert")
+	assert.NotNil(t, err)
+}
+
+func TestMetaCache_RemoveCollectionClearsReplicas(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	cache, err := NewMetaCache(client)
+	assert.Nil(t, err)
+
+	calls := 0
+	qc := NewQueryCoordMock(SetQueryCoordGetReplicasFunc(func(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+		calls++
+		return &querypb.GetReplicasResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: []*querypb.ReplicaInfo{{ReplicaID: 1, CollectionID: req.GetCollectionID()}},
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+
+	for _, database := range []string{"", "db1"} {
+		collID, err := cache.GetCollectionID(ctx, database, "collection1")
+		assert.Nil(t, err)
+		_, err = cache.GetReplicas(ctx, qc, collID)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, calls)
+
+	// the replicas are refetched once the collection is invalidated
+	cache.RemoveCollection(ctx, "db1", "collection1")
+	_, err = cache.GetReplicas(ctx, qc, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	_, err = cache.GetReplicas(ctx, qc, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	_, err = cache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	cache.RemoveDatabase(ctx, "db1")
+	_, err = cache.GetReplicas(ctx, qc, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestMetaCache_GetShardLeaders(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewMetaCache(nil)
+	assert.Nil(t, err)
+
+	calls := 0
+	qc := NewQueryCoordMock(SetQueryCoordGetShardLeadersFunc(func(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		calls++
+		return &querypb.GetShardLeadersResponse{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Shards:    []*querypb.ShardLeader{{ChannelName: "dml_0", NodeID: 1, Address: "localhost:1"}},
+			NodeIds:   []int64{1},
+			NodeAddrs: []string{"localhost:1"},
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+	resp, err := cache.GetShardLeaders(ctx, qc, 1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"localhost:1"}, resp.NodeAddrs)
+
+	// the shard leaders are cached until the replicas of the collection are cleared
+	_, err = cache.GetShardLeaders(ctx, qc, 1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	cache.ClearReplicas(1)
+	_, err = cache.GetShardLeaders(ctx, qc, 1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+
+	// no query node
+	emptyQc := NewQueryCoordMock()
+	emptyQc.Start()
+	defer emptyQc.Stop()
+	_, err = cache.GetShardLeaders(ctx, emptyQc, 2, 0)
+	assert.NotNil(t, err)
+
+	errQc := NewQueryCoordMock(SetQueryCoordGetShardLeadersFunc(func(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		return nil, errors.New("mock")
+	}))
+	errQc.Start()
+	defer errQc.Stop()
+	_, err = cache.GetShardLeaders(ctx, errQc, 2, 0)
+	assert.NotNil(t, err)
+
+	failedQc := NewQueryCoordMock(SetQueryCoordGetShardLeadersFunc(func(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		return &querypb.GetShardLeadersResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock"},
+		}, nil
+	}))
+	failedQc.Start()
+	defer failedQc.Stop()
+	_, err = cache.GetShardLeaders(ctx, failedQc, 2, 0)
+	assert.NotNil(t, err)
+
+	// failures are not cached
+	_, err = cache.GetShardLeaders(ctx, qc, 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getPartitionKeyPartitions returns the names of the partitions created for the partition key in order,
+// the idx-th partition holds the entities whose hash of partition key modulo the number of partitions is idx.
+func getPartitionKeyPartitions(ctx context.Context, dbName string, collectionName string) ([]string, map[string]UniqueID, error) {
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	partitionNames := make([]string, len(partitionsMap))
+	for i := range partitionNames {
+		name := typeutil.PartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, int64(i))
+		if _, ok := partitionsMap[name]; !ok {
+			return nil, nil, fmt.Errorf("partition %s of collection %s with partition key not found", name, collectionName)
+		}
+		partitionNames[i] = name
+	}
+	return partitionNames, partitionsMap, nil
+}
+
+// hashPartitionKeyData returns the hash values of the partition key of every row
+func hashPartitionKeyData(fieldData *schemapb.FieldData) ([]uint32, error) {
+	var keys []interface{}
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int64:
+		for _, key := range fieldData.GetScalars().GetLongData().GetData() {
+			keys = append(keys, key)
+		}
+	case schemapb.DataType_String:
+		for _, key := range fieldData.GetScalars().GetStringData().GetData() {
+			keys = append(keys, key)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported partition key type %s", fieldData.GetType().String())
+	}
+	hashes := make([]uint32, 0, len(keys))
+	for _, key := range keys {
+		hash, err := typeutil.Hash32PartitionKey(key)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// getPartitionKeyValues returns the values of the partition key which the entities matching the expr must have,
+// false if the expr doesn't pin the partition key.
+func getPartitionKeyValues(expr *planpb.Expr, fieldID int64) ([]*planpb.GenericValue, bool) {
+	isKeyColumn := func(column *planpb.ColumnInfo) bool {
+		return column.GetFieldId() == fieldID && len(column.GetNestedPath()) == 0
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryRangeExpr:
+		if isKeyColumn(e.UnaryRangeExpr.GetColumnInfo()) && e.UnaryRangeExpr.GetOp() == planpb.OpType_Equal {
+			return []*planpb.GenericValue{e.UnaryRangeExpr.GetValue()}, true
+		}
+	case *planpb.Expr_TermExpr:
+		if isKeyColumn(e.TermExpr.GetColumnInfo()) {
+			return e.TermExpr.GetValues(), true
+		}
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := getPartitionKeyValues(e.BinaryExpr.GetLeft(), fieldID)
+		right, rightOk := getPartitionKeyValues(e.BinaryExpr.GetRight(), fieldID)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			// either side is enough to pin the key, the smaller one prunes more partitions
+			if leftOk && (!rightOk || len(left) <= len(right)) {
+				return left, true
+			}
+			return right, rightOk
+		case planpb.BinaryExpr_LogicalOr:
+			if leftOk && rightOk {
+				return append(append([]*planpb.GenericValue{}, left...), right...), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// prunePartitionsByPartitionKey returns the names of the partitions which may hold the entities matching the expr,
+// nil if the expr doesn't pin the partition key and all partitions are required.
+func prunePartitionsByPartitionKey(expr *planpb.Expr, keyField *schemapb.FieldSchema, partitionNames []string) ([]string, error) {
+	if expr == nil || len(partitionNames) == 0 {
+		return nil, nil
+	}
+	values, ok := getPartitionKeyValues(expr, keyField.GetFieldID())
+	if !ok {
+		return nil, nil
+	}
+	selected := make(map[string]struct{})
+	for _, value := range values {
+		var key interface{}
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			key = v.Int64Val
+		case *planpb.GenericValue_StringVal:
+			key = v.StringVal
+		default:
+			return nil, fmt.Errorf("invalid value %s of partition key %s", value.String(), keyField.GetName())
+		}
+		hash, err := typeutil.Hash32PartitionKey(key)
+		if err != nil {
+			return nil, err
+		}
+		selected[partitionNames[hash%uint32(len(partitionNames))]] = struct{}{}
+	}
+	result := make([]string, 0, len(selected))
+	for name := range selected {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPartitionKeyData(t *testing.T) {
+	hashes, err := hashPartitionKeyData(&schemapb.FieldData{
+		Type: schemapb.DataType_String,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(hashes))
+	h, err := typeutil.Hash32PartitionKey("b")
+	assert.NoError(t, err)
+	assert.Equal(t, h, hashes[1])
+
+	hashes, err = hashPartitionKeyData(&schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{7}}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	h, err = typeutil.Hash32PartitionKey(int64(7))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{h}, hashes)
+
+	_, err = hashPartitionKeyData(&schemapb.FieldData{Type: schemapb.DataType_Float})
+	assert.Error(t, err)
+}
+
+func TestPrunePartitionsByPartitionKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_String, IsPartitionKey: true},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	keyField := schema.Fields[1]
+	partitionNames := make([]string, 16)
+	for i := range partitionNames {
+		partitionNames[i] = typeutil.PartitionKeyPartitionName("_default", int64(i))
+	}
+	partitionOf := func(key string) string {
+		h, err := typeutil.Hash32PartitionKey(key)
+		assert.NoError(t, err)
+		return partitionNames[h%uint32(len(partitionNames))]
+	}
+	prune := func(expr string) []string {
+		plan, err := createExprPlan(schema, expr)
+		assert.NoError(t, err)
+		names, err := prunePartitionsByPartitionKey(plan.GetPredicates(), keyField, partitionNames)
+		assert.NoError(t, err)
+		return names
+	}
+
+	assert.Equal(t, []string{partitionOf("x")}, prune(`tenant == "x"`))
+	assert.Equal(t, []string{partitionOf("x")}, prune(`tenant == "x" && age > 10`))
+	assert.Equal(t, []string{partitionOf("x")}, prune(`age > 10 && tenant in ["x"]`))
+	assert.ElementsMatch(t, dedupStrings(partitionOf("x"), partitionOf("y")), prune(`tenant in ["x", "y"]`))
+	assert.ElementsMatch(t, dedupStrings(partitionOf("x"), partitionOf("y")), prune(`tenant == "x" || tenant == "y"`))
+
+	// the expr doesn't pin the partition key
+	assert.Nil(t, prune(`age > 10`))
+	assert.Nil(t, prune(`tenant == "x" || age > 10`))
+	assert.Nil(t, prune(`tenant != "x"`))
+	assert.Nil(t, prune(`not (tenant == "x")`))
+
+	names, err := prunePartitionsByPartitionKey(nil, keyField, partitionNames)
+	assert.NoError(t, err)
+	assert.Nil(t, names)
+
+	_, err = prunePartitionsByPartitionKey(&planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_String},
+				Values:     []*planpb.GenericValue{{Val: &planpb.GenericValue_FloatVal{FloatVal: 1.5}}},
+			},
+		},
+	}, keyField, partitionNames)
+	assert.Error(t, err)
+}
+
+func dedupStrings(strs ...string) []string {
+	set := make(map[string]struct{})
+	result := make([]string, 0, len(strs))
+	for _, s := range strs {
+		if _, ok := set[s]; !ok {
+			set[s] = struct{}{}
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -22,7 +22,6 @@ import (
 	"strings"
 
 	ant_ast "github.com/antonmedv/expr/ast"
-	ant_parser "github.com/antonmedv/expr/parser"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -51,7 +50,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 				patch(&ant_ast.IntegerNode{Value: -i.Value})
 			} else if i, ok := node.Node.(*ant_ast.FloatNode); ok {
 				patch(&ant_ast.FloatNode{Value: -i.Value})
-			} else {
+			} else if !isArithOperand(node.Node) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -60,7 +59,7 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 				patch(&ant_ast.IntegerNode{Value: i.Value})
 			} else if i, ok := node.Node.(*ant_ast.FloatNode); ok {
 				patch(&ant_ast.FloatNode{Value: i.Value})
-			} else {
+			} else if !isArithOperand(node.Node) {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
 			}
@@ -72,6 +71,14 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 		floatNodeRight, rightFloat := node.Right.(*ant_ast.FloatNode)
 		integerNodeRight, rightInteger := node.Right.(*ant_ast.IntegerNode)
 
+		if isArithOperator(node.Operator) && (!(leftFloat || leftInteger) || !(rightFloat || rightInteger)) {
+			// arithmetic on fields is evaluated by segcore, only check the operands here
+			if !isArithOperand(node.Left) || !isArithOperand(node.Right) {
+				optimizer.err = fmt.Errorf("invalid data type")
+			}
+			return
+		}
+
 		switch node.Operator {
 		case "+":
 			if leftFloat && rightFloat {
@@ -81,6 +88,10 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 			} else if leftInteger && rightFloat {
 				patch(&ant_ast.FloatNode{Value: float64(integerNodeLeft.Value) + floatNodeRight.Value})
 			} else if leftInteger && rightInteger {
+				if addOverflows(integerNodeLeft.Value, integerNodeRight.Value) {
+					optimizer.err = fmt.Errorf("integer overflow")
+					return
+				}
 				patch(&ant_ast.IntegerNode{Value: integerNodeLeft.Value + integerNodeRight.Value})
 			} else {
 				optimizer.err = fmt.Errorf("invalid data type")
@@ -94,6 +105,10 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 			} else if leftInteger && rightFloat {
 				patch(&ant_ast.FloatNode{Value: float64(integerNodeLeft.Value) - floatNodeRight.Value})
 			} else if leftInteger && rightInteger {
+				if subOverflows(integerNodeLeft.Value, integerNodeRight.Value) {
+					optimizer.err = fmt.Errorf("integer overflow")
+					return
+				}
 				patch(&ant_ast.IntegerNode{Value: integerNodeLeft.Value - integerNodeRight.Value})
 			} else {
 				optimizer.err = fmt.Errorf("invalid data type")
@@ -107,6 +122,10 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 			} else if leftInteger && rightFloat {
 				patch(&ant_ast.FloatNode{Value: float64(integerNodeLeft.Value) * floatNodeRight.Value})
 			} else if leftInteger && rightInteger {
+				if mulOverflows(integerNodeLeft.Value, integerNodeRight.Value) {
+					optimizer.err = fmt.Errorf("integer overflow")
+					return
+				}
 				patch(&ant_ast.IntegerNode{Value: integerNodeLeft.Value * integerNodeRight.Value})
 			} else {
 				optimizer.err = fmt.Errorf("invalid data type")
@@ -136,6 +155,10 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 					optimizer.err = fmt.Errorf("divide by zero")
 					return
 				}
+				if integerNodeLeft.Value == math.MinInt64 && integerNodeRight.Value == -1 {
+					optimizer.err = fmt.Errorf("integer overflow")
+					return
+				}
 				patch(&ant_ast.IntegerNode{Value: integerNodeLeft.Value / integerNodeRight.Value})
 			} else {
 				optimizer.err = fmt.Errorf("invalid data type")
@@ -160,7 +183,12 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 			} else if leftInteger && rightFloat {
 				patch(&ant_ast.FloatNode{Value: math.Pow(float64(integerNodeLeft.Value), floatNodeRight.Value)})
 			} else if leftInteger && rightInteger {
-				patch(&ant_ast.IntegerNode{Value: int(math.Pow(float64(integerNodeLeft.Value), float64(integerNodeRight.Value)))})
+				v := math.Pow(float64(integerNodeLeft.Value), float64(integerNodeRight.Value))
+				if v >= math.MaxInt64 || v < math.MinInt64 {
+					optimizer.err = fmt.Errorf("integer overflow")
+					return
+				}
+				patch(&ant_ast.IntegerNode{Value: int(v)})
 			} else {
 				optimizer.err = fmt.Errorf("invalid data type")
 				return
@@ -169,23 +197,70 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 	}
 }
 
+// isArithOperator returns true if op is an arithmetic operator
+func isArithOperator(op string) bool {
+	switch op {
+	case "+", "-", "*", "/", "%", "**":
+		return true
+	default:
+		return false
+	}
+}
+
+// isArithOperand returns true if node can be an operand of arithmetic, a number, a field or an arithmetic
+func isArithOperand(node ant_ast.Node) bool {
+	switch n := node.(type) {
+	case *ant_ast.IntegerNode, *ant_ast.FloatNode:
+		return true
+	case *ant_ast.BinaryNode:
+		return isArithOperator(n.Operator)
+	case *ant_ast.UnaryNode:
+		return n.Operator == "-" || n.Operator == "+"
+	default:
+		return isColumnNode(node)
+	}
+}
+
+// addOverflows returns true if a + b overflows int64
+func addOverflows(a, b int) bool {
+	c := a + b
+	return (b > 0 && c < a) || (b < 0 && c > a)
+}
+
+// subOverflows returns true if a - b overflows int64
+func subOverflows(a, b int) bool {
+	c := a - b
+	return (b > 0 && c > a) || (b < 0 && c < a)
+}
+
+// mulOverflows returns true if a * b overflows int64
+func mulOverflows(a, b int) bool {
+	if a == 0 || b == 0 {
+		return false
+	}
+	if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return true
+	}
+	return (a*b)/b != a
+}
+
 func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
 	if exprStr == "" {
 		return nil, nil
 	}
-	ast, err := ant_parser.Parse(exprStr)
+	ast, err := parseExprAST(exprStr)
 	if err != nil {
 		return nil, err
 	}
 
 	optimizer := &optimizer{}
-	ant_ast.Walk(&ast.Node, optimizer)
+	ant_ast.Walk(&ast, optimizer)
 	if optimizer.err != nil {
 		return nil, optimizer.err
 	}
 
 	pc := parserContext{schema}
-	expr, err := pc.handleExpr(&ast.Node)
+	expr, err := pc.handleExpr(&ast)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +276,16 @@ func createColumnInfo(field *schemapb.FieldSchema) *planpb.ColumnInfo {
 	}
 }
 
+// isRangeOperator returns true if op is one of `<`, `<=`, `>`, `>=`
+func isRangeOperator(op string) bool {
+	switch op {
+	case "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
 func isSameOrder(opStr1, opStr2 string) bool {
 	isLess1 := (opStr1 == "<") || (opStr1 == "<=")
 	isLess2 := (opStr2 == "<") || (opStr2 == "<=")
@@ -282,18 +367,25 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	if boolNode := parseBoolNode(&right); boolNode != nil {
 		right = boolNode
 	}
-	idNodeLeft, okLeft := left.(*ant_ast.IdentifierNode)
-	idNodeRight, okRight := right.(*ant_ast.IdentifierNode)
+	if isArithNode(left) || isArithNode(right) {
+		return pc.createArithCmpExpr(left, right, operator)
+	}
+
+	okLeft := isColumnNode(left)
+	okRight := isColumnNode(right)
 
 	if okLeft && okRight {
-		leftField, err := pc.handleIdentifier(idNodeLeft)
+		leftColumn, err := pc.handleColumn(left)
 		if err != nil {
 			return nil, err
 		}
-		rightField, err := pc.handleIdentifier(idNodeRight)
+		rightColumn, err := pc.handleColumn(right)
 		if err != nil {
 			return nil, err
 		}
+		if typeutil.IsJSONType(leftColumn.DataType) || typeutil.IsJSONType(rightColumn.DataType) {
+			return nil, fmt.Errorf("compare expr between json keys is not supported")
+		}
 		op := getCompareOpType(operator, false)
 		if op == planpb.OpType_Invalid {
 			return nil, fmt.Errorf("invalid binary operator(%s)", operator)
@@ -301,8 +393,8 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		expr := &planpb.Expr{
 			Expr: &planpb.Expr_CompareExpr{
 				CompareExpr: &planpb.CompareExpr{
-					LeftColumnInfo:  createColumnInfo(leftField),
-					RightColumnInfo: createColumnInfo(rightField),
+					LeftColumnInfo:  leftColumn,
+					RightColumnInfo: rightColumn,
 					Op:              op,
 				},
 			},
@@ -310,27 +402,27 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		return expr, nil
 	}
 
-	var idNode *ant_ast.IdentifierNode
+	var columnNode ant_ast.Node
 	var reverse bool
 	var valueNode *ant_ast.Node
 	if okLeft {
-		idNode = idNodeLeft
+		columnNode = left
 		reverse = false
 		valueNode = &right
 	} else if okRight {
-		idNode = idNodeRight
+		columnNode = right
 		reverse = true
 		valueNode = &left
 	} else {
 		return nil, fmt.Errorf("compare expr has no identifier")
 	}
 
-	field, err := pc.handleIdentifier(idNode)
+	column, err := pc.handleColumn(columnNode)
 	if err != nil {
 		return nil, err
 	}
 
-	val, err := pc.handleLeafValue(valueNode, field.DataType)
+	val, err := pc.handleLeafValue(valueNode, column.DataType)
 	if err != nil {
 		return nil, err
 	}
@@ -343,7 +435,7 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_UnaryRangeExpr{
 			UnaryRangeExpr: &planpb.UnaryRangeExpr{
-				ColumnInfo: createColumnInfo(field),
+				ColumnInfo: column,
 				Op:         op,
 				Value:      val,
 			},
@@ -352,6 +444,139 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	return expr, nil
 }
 
+// isArithNode returns true if node is an arithmetic on fields, such as `price * 0.9` or `-stock`
+func isArithNode(node ant_ast.Node) bool {
+	switch n := node.(type) {
+	case *ant_ast.BinaryNode:
+		return isArithOperator(n.Operator)
+	case *ant_ast.UnaryNode:
+		return n.Operator == "-" || n.Operator == "+"
+	default:
+		return false
+	}
+}
+
+func getArithOpType(opStr string) planpb.ArithOpType {
+	switch opStr {
+	case "+":
+		return planpb.ArithOpType_Add
+	case "-":
+		return planpb.ArithOpType_Sub
+	case "*":
+		return planpb.ArithOpType_Mul
+	case "/":
+		return planpb.ArithOpType_Div
+	case "%":
+		return planpb.ArithOpType_Mod
+	default:
+		return planpb.ArithOpType_Unknown
+	}
+}
+
+// handleArithExpr converts an arithmetic operand to planpb.ArithExpr, it also returns
+// whether the result is a floating number, otherwise the result is an int64.
+func (pc *parserContext) handleArithExpr(node ant_ast.Node) (*planpb.ArithExpr, bool, error) {
+	switch n := node.(type) {
+	case *ant_ast.IntegerNode:
+		value := &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(n.Value)}}
+		return &planpb.ArithExpr{Expr: &planpb.ArithExpr_Value{Value: value}}, false, nil
+	case *ant_ast.FloatNode:
+		value := &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: n.Value}}
+		return &planpb.ArithExpr{Expr: &planpb.ArithExpr_Value{Value: value}}, true, nil
+	case *ant_ast.UnaryNode:
+		if n.Operator == "+" {
+			return pc.handleArithExpr(n.Node)
+		}
+		if n.Operator != "-" {
+			return nil, false, fmt.Errorf("invalid unary operator(%s) in arithmetic expr", n.Operator)
+		}
+		// -x is evaluated as 0 - x
+		child, isFloat, err := pc.handleArithExpr(n.Node)
+		if err != nil {
+			return nil, false, err
+		}
+		zero := &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 0}}
+		expr := &planpb.ArithExpr{
+			Expr: &planpb.ArithExpr_BinaryArithExpr{
+				BinaryArithExpr: &planpb.BinaryArithExpr{
+					Op:    planpb.ArithOpType_Sub,
+					Left:  &planpb.ArithExpr{Expr: &planpb.ArithExpr_Value{Value: zero}},
+					Right: child,
+				},
+			},
+		}
+		return expr, isFloat, nil
+	case *ant_ast.BinaryNode:
+		op := getArithOpType(n.Operator)
+		if op == planpb.ArithOpType_Unknown {
+			return nil, false, fmt.Errorf("unsupported arithmetic operator(%s) on fields", n.Operator)
+		}
+		left, leftFloat, err := pc.handleArithExpr(n.Left)
+		if err != nil {
+			return nil, false, err
+		}
+		right, rightFloat, err := pc.handleArithExpr(n.Right)
+		if err != nil {
+			return nil, false, err
+		}
+		if op == planpb.ArithOpType_Mod && (leftFloat || rightFloat) {
+			return nil, false, fmt.Errorf("operands of %% must be integers")
+		}
+		if op == planpb.ArithOpType_Div || op == planpb.ArithOpType_Mod {
+			if v := right.GetValue(); v != nil && v.GetInt64Val() == 0 && v.GetFloatVal() == 0 {
+				return nil, false, fmt.Errorf("divide by zero")
+			}
+		}
+		expr := &planpb.ArithExpr{
+			Expr: &planpb.ArithExpr_BinaryArithExpr{
+				BinaryArithExpr: &planpb.BinaryArithExpr{
+					Op:    op,
+					Left:  left,
+					Right: right,
+				},
+			},
+		}
+		return expr, leftFloat || rightFloat, nil
+	default:
+		if !isColumnNode(node) {
+			return nil, false, fmt.Errorf("invalid operand of arithmetic expr")
+		}
+		column, err := pc.handleColumn(node)
+		if err != nil {
+			return nil, false, err
+		}
+		if !typeutil.IsIntegerType(column.DataType) && !typeutil.IsFloatingType(column.DataType) {
+			return nil, false, fmt.Errorf("arithmetic expr on %s field is not supported", column.DataType.String())
+		}
+		return &planpb.ArithExpr{Expr: &planpb.ArithExpr_ColumnInfo{ColumnInfo: column}}, typeutil.IsFloatingType(column.DataType), nil
+	}
+}
+
+func (pc *parserContext) createArithCmpExpr(left, right ant_ast.Node, operator string) (*planpb.Expr, error) {
+	leftExpr, _, err := pc.handleArithExpr(left)
+	if err != nil {
+		return nil, err
+	}
+	rightExpr, _, err := pc.handleArithExpr(right)
+	if err != nil {
+		return nil, err
+	}
+	op := getCompareOpType(operator, false)
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("invalid binary operator(%s)", operator)
+	}
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_ArithCompareExpr{
+			ArithCompareExpr: &planpb.ArithCompareExpr{
+				Left:  leftExpr,
+				Right: rightExpr,
+				Op:    op,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *parserContext) handleCmpExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
 	return pc.createCmpExpr(node.Left, node.Right, node.Operator)
 }
@@ -406,15 +631,14 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	if node.Operator != "in" && node.Operator != "not in" {
 		return nil, fmt.Errorf("invalid operator(%s)", node.Operator)
 	}
-	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
-	if !ok {
+	if !isColumnNode(node.Left) {
 		return nil, fmt.Errorf("left operand of the InExpr must be identifier")
 	}
-	field, err := pc.handleIdentifier(idNode)
+	column, err := pc.handleColumn(node.Left)
 	if err != nil {
 		return nil, err
 	}
-	arrayData, err := pc.handleArrayExpr(&node.Right, field.DataType)
+	arrayData, err := pc.handleArrayExpr(&node.Right, column.DataType)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +646,7 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_TermExpr{
 			TermExpr: &planpb.TermExpr{
-				ColumnInfo: createColumnInfo(field),
+				ColumnInfo: column,
 				Values:     arrayData,
 			},
 		},
@@ -463,7 +687,7 @@ func (pc *parserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	// handle multiple relational operators
 	for {
 		binNodeLeft, LeftOk := curNode.Left.(*ant_ast.BinaryNode)
-		if !LeftOk {
+		if !LeftOk || !isRangeOperator(binNodeLeft.Operator) {
 			expr, err := pc.handleCmpExpr(curNode)
 			if err != nil {
 				return nil, err
@@ -487,7 +711,7 @@ func (pc *parserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	var lastExpr *planpb.UnaryRangeExpr
 	for i := len(exprs) - 1; i >= 0; i-- {
 		if expr, ok := exprs[i].Expr.(*planpb.Expr_UnaryRangeExpr); ok {
-			if lastExpr != nil && expr.UnaryRangeExpr.ColumnInfo.FieldId == lastExpr.ColumnInfo.FieldId {
+			if lastExpr != nil && isSameColumn(expr.UnaryRangeExpr.ColumnInfo, lastExpr.ColumnInfo) {
 				binaryRangeExpr := pc.combineUnaryRangeExpr(expr.UnaryRangeExpr, lastExpr)
 				exprs = append(exprs[0:i], append([]*planpb.Expr{binaryRangeExpr}, exprs[i+2:]...)...)
 				lastExpr = nil
@@ -526,10 +750,75 @@ func (pc *parserContext) handleBinaryExpr(node *ant_ast.BinaryNode) (*planpb.Exp
 		return pc.handleLogicalExpr(node)
 	case "in", "not in":
 		return pc.handleInExpr(node)
+	case "like", "startsWith", "endsWith":
+		return pc.handleMatchExpr(node)
 	}
 	return nil, fmt.Errorf("unsupported binary operator %s", node.Operator)
 }
 
+// parseLikePattern converts a like pattern into a match op and its operand,
+// only `abc%` (prefix match), `%abc` (postfix match) and patterns without `%` (equal) are supported.
+func parseLikePattern(pattern string) (planpb.OpType, string, error) {
+	switch strings.Count(pattern, "%") {
+	case 0:
+		return planpb.OpType_Equal, pattern, nil
+	case 1:
+		if strings.HasSuffix(pattern, "%") {
+			return planpb.OpType_PrefixMatch, strings.TrimSuffix(pattern, "%"), nil
+		}
+		if strings.HasPrefix(pattern, "%") {
+			return planpb.OpType_PostfixMatch, strings.TrimPrefix(pattern, "%"), nil
+		}
+	}
+	return planpb.OpType_Invalid, "", fmt.Errorf("invalid like pattern(%s), only prefix or postfix match is supported", pattern)
+}
+
+func (pc *parserContext) handleMatchExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	if !isColumnNode(node.Left) {
+		return nil, fmt.Errorf("left operand of %s must be identifier", node.Operator)
+	}
+	column, err := pc.handleColumn(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsStringType(column.DataType) && !typeutil.IsJSONType(column.DataType) {
+		return nil, fmt.Errorf("%s can only be applied to string field", node.Operator)
+	}
+	patternNode, ok := node.Right.(*ant_ast.StringNode)
+	if !ok {
+		return nil, fmt.Errorf("right operand of %s must be string", node.Operator)
+	}
+
+	var op planpb.OpType
+	var operand string
+	switch node.Operator {
+	case "startsWith":
+		op, operand = planpb.OpType_PrefixMatch, patternNode.Value
+	case "endsWith":
+		op, operand = planpb.OpType_PostfixMatch, patternNode.Value
+	default:
+		op, operand, err = parseLikePattern(patternNode.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: column,
+				Op:         op,
+				Value: &planpb.GenericValue{
+					Val: &planpb.GenericValue_StringVal{
+						StringVal: operand,
+					},
+				},
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *parserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Expr, error) {
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_UnaryExpr{
@@ -545,7 +834,7 @@ func (pc *parserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Expr, er
 func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemapb.DataType) (gv *planpb.GenericValue, err error) {
 	switch node := (*nodeRaw).(type) {
 	case *ant_ast.FloatNode:
-		if typeutil.IsFloatingType(dataType) {
+		if typeutil.IsFloatingType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_FloatVal{
 					FloatVal: node.Value,
@@ -561,7 +850,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 					FloatVal: float64(node.Value),
 				},
 			}
-		} else if typeutil.IsIntegerType(dataType) {
+		} else if typeutil.IsIntegerType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_Int64Val{
 					Int64Val: int64(node.Value),
@@ -571,7 +860,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 			return nil, fmt.Errorf("type mismatch")
 		}
 	case *ant_ast.BoolNode:
-		if typeutil.IsBoolType(dataType) {
+		if typeutil.IsBoolType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_BoolVal{
 					BoolVal: node.Value,
@@ -580,6 +869,16 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if typeutil.IsStringType(dataType) || typeutil.IsJSONType(dataType) {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -593,6 +892,66 @@ func (pc *parserContext) handleIdentifier(node *ant_ast.IdentifierNode) (*schema
 	return field, err
 }
 
+// isColumnNode returns true if node refers to a field, either by name or by a key path into a json field
+func isColumnNode(node ant_ast.Node) bool {
+	switch n := node.(type) {
+	case *ant_ast.IdentifierNode:
+		return true
+	case *ant_ast.IndexNode:
+		return isColumnNode(n.Node)
+	default:
+		return false
+	}
+}
+
+// isSameColumn returns true if a and b refer to the same field and the same json key path
+func isSameColumn(a, b *planpb.ColumnInfo) bool {
+	if a.FieldId != b.FieldId || len(a.NestedPath) != len(b.NestedPath) {
+		return false
+	}
+	for i := range a.NestedPath {
+		if a.NestedPath[i] != b.NestedPath[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// handleColumn resolves a column node, such as `age` or `meta["color"]["name"]`, to its column info.
+// Json fields can only be accessed with a key path, keys of other fields are rejected.
+func (pc *parserContext) handleColumn(node ant_ast.Node) (*planpb.ColumnInfo, error) {
+	var path []string
+	for {
+		indexNode, ok := node.(*ant_ast.IndexNode)
+		if !ok {
+			break
+		}
+		key, ok := indexNode.Index.(*ant_ast.StringNode)
+		if !ok {
+			return nil, fmt.Errorf("key of json field must be a string")
+		}
+		path = append([]string{key.Value}, path...)
+		node = indexNode.Node
+	}
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, fmt.Errorf("invalid column expr")
+	}
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if typeutil.IsJSONType(field.DataType) && len(path) == 0 {
+		return nil, fmt.Errorf("json field %s must be accessed with a key, such as %s[\"key\"]", field.Name, field.Name)
+	}
+	if !typeutil.IsJSONType(field.DataType) && len(path) != 0 {
+		return nil, fmt.Errorf("field %s of type %s can't be accessed with a key", field.Name, field.DataType.String())
+	}
+	column := createColumnInfo(field)
+	column.NestedPath = path
+	return column, nil
+}
+
 func (pc *parserContext) handleUnaryExpr(node *ant_ast.UnaryNode) (*planpb.Expr, error) {
 	switch node.Operator {
 	case "!", "not":
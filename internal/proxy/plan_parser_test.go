@@ -330,6 +330,299 @@ func TestExprFieldCompare_Str(t *testing.T) {
 	}
 }
 
+func TestExprStringTerm_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_String},
+		{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      false,
+		Fields:      fields,
+	}
+
+	planProto, err := createExprPlan(schema, `pk in ["a", "b,c"]`)
+	assert.Nil(t, err)
+	termExpr := planProto.GetPredicates().GetTermExpr()
+	assert.NotNil(t, termExpr)
+	assert.Equal(t, 2, len(termExpr.Values))
+	assert.Equal(t, "a", termExpr.Values[0].GetStringVal())
+	assert.Equal(t, "b,c", termExpr.Values[1].GetStringVal())
+
+	_, err = createExprPlan(schema, `pk in [1, 2]`)
+	assert.NotNil(t, err)
+
+	_, err = createExprPlan(schema, `age in ["a"]`)
+	assert.NotNil(t, err)
+}
+
+func TestExprArith_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "a", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "b", DataType: schemapb.DataType_Int32},
+		{FieldID: 103, Name: "c", DataType: schemapb.DataType_Int64},
+		{FieldID: 104, Name: "price", DataType: schemapb.DataType_Double},
+		{FieldID: 105, Name: "title", DataType: schemapb.DataType_String},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      false,
+		Fields:      fields,
+	}
+
+	planProto, err := createExprPlan(schema, "price * 0.9 < 100")
+	assert.Nil(t, err)
+	arithCompareExpr := planProto.GetPredicates().GetArithCompareExpr()
+	assert.NotNil(t, arithCompareExpr)
+	assert.Equal(t, planpb.OpType_LessThan, arithCompareExpr.GetOp())
+	binaryArithExpr := arithCompareExpr.GetLeft().GetBinaryArithExpr()
+	assert.Equal(t, planpb.ArithOpType_Mul, binaryArithExpr.GetOp())
+	assert.Equal(t, int64(104), binaryArithExpr.GetLeft().GetColumnInfo().GetFieldId())
+	assert.Equal(t, 0.9, binaryArithExpr.GetRight().GetValue().GetFloatVal())
+	assert.Equal(t, int64(100), arithCompareExpr.GetRight().GetValue().GetInt64Val())
+
+	planProto, err = createExprPlan(schema, "a + b > c")
+	assert.Nil(t, err)
+	arithCompareExpr = planProto.GetPredicates().GetArithCompareExpr()
+	assert.NotNil(t, arithCompareExpr)
+	assert.Equal(t, planpb.OpType_GreaterThan, arithCompareExpr.GetOp())
+	assert.Equal(t, planpb.ArithOpType_Add, arithCompareExpr.GetLeft().GetBinaryArithExpr().GetOp())
+	assert.Equal(t, int64(103), arithCompareExpr.GetRight().GetColumnInfo().GetFieldId())
+
+	planProto, err = createExprPlan(schema, "c % 2 == 0")
+	assert.Nil(t, err)
+	arithCompareExpr = planProto.GetPredicates().GetArithCompareExpr()
+	assert.NotNil(t, arithCompareExpr)
+	assert.Equal(t, planpb.ArithOpType_Mod, arithCompareExpr.GetLeft().GetBinaryArithExpr().GetOp())
+
+	// constants are folded
+	planProto, err = createExprPlan(schema, "c % (1 + 1) == 2 * 3 - 6")
+	assert.Nil(t, err)
+	arithCompareExpr = planProto.GetPredicates().GetArithCompareExpr()
+	assert.Equal(t, int64(2), arithCompareExpr.GetLeft().GetBinaryArithExpr().GetRight().GetValue().GetInt64Val())
+	assert.Equal(t, int64(0), arithCompareExpr.GetRight().GetValue().GetInt64Val())
+
+	validExprs := []string{
+		"-a < 10",
+		"1 < a + b < 5",
+		"a * 2 >= b and price / 2 != 1",
+		"(a - b) * price <= 1.5",
+	}
+	for _, exprStr := range validExprs {
+		_, err = createExprPlan(schema, exprStr)
+		assert.Nil(t, err, exprStr)
+	}
+
+	invalidExprs := []string{
+		"price % 2 == 0",
+		"c % 2.0 == 0",
+		"a / 0 > 1",
+		"a % (1 - 1) > 1",
+		"a ** 2 > 1",
+		"title + 1 > 1",
+		"a + \"x\" > 1",
+		"9223372036854775807 + 1 > a",
+		"-9223372036854775807 - 2 > a",
+		"3037000500 * 3037000500 > a",
+		"2 ** 64 > a",
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = createExprPlan(schema, exprStr)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestExprStringMatch_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "title", DataType: schemapb.DataType_String},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      false,
+		Fields:      fields,
+	}
+
+	cases := []struct {
+		exprStr string
+		op      planpb.OpType
+		value   string
+	}{
+		{`title like "abc%"`, planpb.OpType_PrefixMatch, "abc"},
+		{`title like '%abc'`, planpb.OpType_PostfixMatch, "abc"},
+		{`title like "abc"`, planpb.OpType_Equal, "abc"},
+		{`title startsWith "a%c"`, planpb.OpType_PrefixMatch, "a%c"},
+		{`title endsWith "abc"`, planpb.OpType_PostfixMatch, "abc"},
+		{`title == "like"`, planpb.OpType_Equal, "like"},
+		{`title >= "abc"`, planpb.OpType_GreaterEqual, "abc"},
+	}
+	for _, c := range cases {
+		planProto, err := createExprPlan(schema, c.exprStr)
+		assert.Nil(t, err, c.exprStr)
+		unaryRangeExpr := planProto.GetPredicates().GetUnaryRangeExpr()
+		assert.NotNil(t, unaryRangeExpr, c.exprStr)
+		assert.Equal(t, c.op, unaryRangeExpr.GetOp(), c.exprStr)
+		assert.Equal(t, c.value, unaryRangeExpr.GetValue().GetStringVal(), c.exprStr)
+	}
+
+	planProto, err := createExprPlan(schema, `not (title like "a%") && pk > 1`)
+	assert.Nil(t, err)
+	assert.NotNil(t, planProto.GetPredicates().GetBinaryExpr())
+
+	invalidExprs := []string{
+		`title like "%abc%"`,
+		`title like "a%c"`,
+		`title like 1`,
+		`pk like "1%"`,
+		`"abc%" like title`,
+		`title startsWith 1`,
+		`title == 1`,
+		`unknown like "a%"`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = createExprPlan(schema, exprStr)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestParseExprAST(t *testing.T) {
+	node, err := parseExprAST(`title like "a%" && like like "b%"`)
+	assert.Nil(t, err)
+	and, ok := node.(*ant_ast.BinaryNode)
+	assert.True(t, ok)
+	assert.Equal(t, "&&", and.Operator)
+	left, ok := and.Left.(*ant_ast.BinaryNode)
+	assert.True(t, ok)
+	assert.Equal(t, "like", left.Operator)
+	assert.Equal(t, "title", left.Left.(*ant_ast.IdentifierNode).Value)
+	assert.Equal(t, "a%", left.Right.(*ant_ast.StringNode).Value)
+	// a field can be named like
+	right, ok := and.Right.(*ant_ast.BinaryNode)
+	assert.True(t, ok)
+	assert.Equal(t, "like", right.Operator)
+	assert.Equal(t, "like", right.Left.(*ant_ast.IdentifierNode).Value)
+
+	// the keyword in a string literal is a string
+	node, err = parseExprAST(`title == 'it\'s like'`)
+	assert.Nil(t, err)
+	assert.Equal(t, "it's like", node.(*ant_ast.BinaryNode).Right.(*ant_ast.StringNode).Value)
+
+	// precedences
+	node, err = parseExprAST(`a + 2 * 3 ** 2 ** 1 > 1 or not b in [1, 2,] and c < -0x10`)
+	assert.Nil(t, err)
+	or := node.(*ant_ast.BinaryNode)
+	assert.Equal(t, "or", or.Operator)
+	assert.Equal(t, ">", or.Left.(*ant_ast.BinaryNode).Operator)
+	assert.Equal(t, "and", or.Right.(*ant_ast.BinaryNode).Operator)
+	pow := or.Left.(*ant_ast.BinaryNode).Left.(*ant_ast.BinaryNode).Right.(*ant_ast.BinaryNode).Right.(*ant_ast.BinaryNode)
+	assert.Equal(t, "**", pow.Operator)
+	// ** is right associative
+	assert.Equal(t, "**", pow.Right.(*ant_ast.BinaryNode).Operator)
+	in := or.Right.(*ant_ast.BinaryNode).Left.(*ant_ast.BinaryNode)
+	assert.Equal(t, "in", in.Operator)
+	assert.Equal(t, "not", in.Left.(*ant_ast.UnaryNode).Operator)
+	assert.Equal(t, 2, len(in.Right.(*ant_ast.ArrayNode).Nodes))
+	neg := or.Right.(*ant_ast.BinaryNode).Right.(*ant_ast.BinaryNode).Right.(*ant_ast.UnaryNode)
+	assert.Equal(t, 16, neg.Node.(*ant_ast.IntegerNode).Value)
+
+	node, err = parseExprAST(`(meta["a"]["b"] + 1.5e1) != 010`)
+	assert.Nil(t, err)
+	assert.IsType(t, &ant_ast.IndexNode{}, node.(*ant_ast.BinaryNode).Left.(*ant_ast.BinaryNode).Left)
+	assert.Equal(t, 15.0, node.(*ant_ast.BinaryNode).Left.(*ant_ast.BinaryNode).Right.(*ant_ast.FloatNode).Value)
+	assert.Equal(t, 10, node.(*ant_ast.BinaryNode).Right.(*ant_ast.IntegerNode).Value)
+
+	invalidExprs := []string{
+		`title contains "a"`,
+		`title matches "a"`,
+		`len(title) > 1`,
+		`meta.a > 1`,
+		`(a > 1`,
+		`a > 1)`,
+		`a >`,
+		`a > 1 ? 1 : 2`,
+		`title like`,
+		`like "a%"`,
+		`a in [1, 2`,
+		`a == 1_000_000_000_000_000_000_000`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExprAST(exprStr)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
+func TestExprJSON_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        "default-collection",
+		Description: "",
+		AutoID:      false,
+		Fields:      fields,
+	}
+
+	planProto, err := createExprPlan(schema, `meta["color"] == "red"`)
+	assert.Nil(t, err)
+	unaryRangeExpr := planProto.GetPredicates().GetUnaryRangeExpr()
+	assert.NotNil(t, unaryRangeExpr)
+	assert.Equal(t, int64(102), unaryRangeExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, schemapb.DataType_JSON, unaryRangeExpr.GetColumnInfo().GetDataType())
+	assert.Equal(t, []string{"color"}, unaryRangeExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_Equal, unaryRangeExpr.GetOp())
+	assert.Equal(t, "red", unaryRangeExpr.GetValue().GetStringVal())
+
+	planProto, err = createExprPlan(schema, `meta["price"] < 10`)
+	assert.Nil(t, err)
+	unaryRangeExpr = planProto.GetPredicates().GetUnaryRangeExpr()
+	assert.NotNil(t, unaryRangeExpr)
+	assert.Equal(t, planpb.OpType_LessThan, unaryRangeExpr.GetOp())
+	assert.Equal(t, int64(10), unaryRangeExpr.GetValue().GetInt64Val())
+
+	planProto, err = createExprPlan(schema, `1.5 < meta["size"]["width"] <= 3`)
+	assert.Nil(t, err)
+	binaryRangeExpr := planProto.GetPredicates().GetBinaryRangeExpr()
+	assert.NotNil(t, binaryRangeExpr)
+	assert.Equal(t, []string{"size", "width"}, binaryRangeExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, 1.5, binaryRangeExpr.GetLowerValue().GetFloatVal())
+	assert.Equal(t, int64(3), binaryRangeExpr.GetUpperValue().GetInt64Val())
+
+	planProto, err = createExprPlan(schema, `meta["price"] > 1 && meta["weight"] < 2`)
+	assert.Nil(t, err)
+	assert.NotNil(t, planProto.GetPredicates().GetBinaryExpr())
+
+	planProto, err = createExprPlan(schema, `meta["tag"] in ["a", "b"]`)
+	assert.Nil(t, err)
+	termExpr := planProto.GetPredicates().GetTermExpr()
+	assert.NotNil(t, termExpr)
+	assert.Equal(t, []string{"tag"}, termExpr.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, 2, len(termExpr.Values))
+
+	invalidExprs := []string{
+		`meta == "red"`,
+		`meta[0] == 1`,
+		`pk["a"] == 1`,
+		`meta["a"] < meta["b"]`,
+		`meta["a"] < pk`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = createExprPlan(schema, exprStr)
+		assert.NotNil(t, err, exprStr)
+	}
+}
+
 func TestPlanParseAPIs(t *testing.T) {
 	t.Run("get compare op type", func(t *testing.T) {
 		var op planpb.OpType
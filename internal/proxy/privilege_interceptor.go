@@ -0,0 +1,299 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// InternalServiceMethodPrefix is the method prefix of the internal Proxy service, which is only called by
+// other components of the cluster and requires neither authentication nor privilege.
+const InternalServiceMethodPrefix = "/milvus.proto.proxy.Proxy/"
+
+// getObjectPrivilege returns the object type and the privilege which the request requires, the privilege
+// is empty if the request is open to every authenticated user. ok is false if the request is unknown,
+// such requests are denied.
+func getObjectPrivilege(req interface{}) (objectType string, privilege string, ok bool) {
+	switch req.(type) {
+	case *milvuspb.RegisterLinkRequest:
+		return "", "", true
+
+	case *milvuspb.CreateCollectionRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeCreateCollection, true
+	case *milvuspb.ShowCollectionsRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeShowCollections, true
+	case *milvuspb.CreateDatabaseRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeCreateDatabase, true
+	case *milvuspb.DropDatabaseRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeDropDatabase, true
+	case *milvuspb.ListDatabasesRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeListDatabases, true
+	case *milvuspb.CreateCredentialRequest, *milvuspb.CreateRoleRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeCreateOwnership, true
+	case *milvuspb.DeleteCredentialRequest, *milvuspb.DropRoleRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeDropOwnership, true
+	case *milvuspb.ListCredUsersRequest, *milvuspb.SelectRoleRequest, *milvuspb.SelectGrantRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeSelectOwnership, true
+	case *milvuspb.OperateUserRoleRequest, *milvuspb.OperatePrivilegeRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeManageOwnership, true
+	case *milvuspb.CreateAliasRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeCreateAlias, true
+	case *milvuspb.DropAliasRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeDropAlias, true
+	case *milvuspb.AlterAliasRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeAlterAlias, true
+	case *milvuspb.LoadBalanceRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeLoadBalance, true
+	case *milvuspb.GetMetricsRequest:
+		return util.ObjectTypeGlobal, util.PrivilegeGetMetrics, true
+
+	case *milvuspb.UpdateCredentialRequest:
+		return util.ObjectTypeUser, util.PrivilegeUpdateUser, true
+	case *milvuspb.SelectUserRequest:
+		return util.ObjectTypeUser, util.PrivilegeSelectUser, true
+
+	case *milvuspb.DropCollectionRequest:
+		return util.ObjectTypeCollection, util.PrivilegeDropCollection, true
+	case *milvuspb.DescribeCollectionRequest, *milvuspb.HasCollectionRequest:
+		return util.ObjectTypeCollection, util.PrivilegeDescribeCollection, true
+	case *milvuspb.AddFieldRequest:
+		return util.ObjectTypeCollection, util.PrivilegeAddField, true
+	case *milvuspb.LoadCollectionRequest, *milvuspb.LoadPartitionsRequest:
+		return util.ObjectTypeCollection, util.PrivilegeLoad, true
+	case *milvuspb.ReleaseCollectionRequest, *milvuspb.ReleasePartitionsRequest:
+		return util.ObjectTypeCollection, util.PrivilegeRelease, true
+	case *milvuspb.ManualCompactionRequest, *milvuspb.GetCompactionStateRequest, *milvuspb.GetCompactionPlansRequest:
+		return util.ObjectTypeCollection, util.PrivilegeCompaction, true
+	case *milvuspb.InsertRequest:
+		return util.ObjectTypeCollection, util.PrivilegeInsert, true
+	case *milvuspb.DeleteRequest:
+		return util.ObjectTypeCollection, util.PrivilegeDelete, true
+	case *milvuspb.UpsertRequest:
+		return util.ObjectTypeCollection, util.PrivilegeUpsert, true
+	case *milvuspb.GetCollectionStatisticsRequest, *milvuspb.GetPartitionStatisticsRequest,
+		*milvuspb.GetPersistentSegmentInfoRequest, *milvuspb.GetQuerySegmentInfoRequest:
+		return util.ObjectTypeCollection, util.PrivilegeGetStatistics, true
+	case *milvuspb.CreateIndexRequest:
+		return util.ObjectTypeCollection, util.PrivilegeCreateIndex, true
+	case *milvuspb.DescribeIndexRequest, *milvuspb.GetIndexStateRequest, *milvuspb.GetIndexBuildProgressRequest:
+		return util.ObjectTypeCollection, util.PrivilegeIndexDetail, true
+	case *milvuspb.DropIndexRequest:
+		return util.ObjectTypeCollection, util.PrivilegeDropIndex, true
+	case *milvuspb.SearchRequest, *milvuspb.HybridSearchRequest:
+		return util.ObjectTypeCollection, util.PrivilegeSearch, true
+	case *milvuspb.FlushRequest, *milvuspb.GetFlushStateRequest:
+		return util.ObjectTypeCollection, util.PrivilegeFlush, true
+	case *milvuspb.QueryRequest, *milvuspb.CalcDistanceRequest, *milvuspb.DummyRequest:
+		return util.ObjectTypeCollection, util.PrivilegeQuery, true
+	case *milvuspb.CreatePartitionRequest:
+		return util.ObjectTypeCollection, util.PrivilegeCreatePartition, true
+	case *milvuspb.DropPartitionRequest:
+		return util.ObjectTypeCollection, util.PrivilegeDropPartition, true
+	case *milvuspb.ShowPartitionsRequest:
+		return util.ObjectTypeCollection, util.PrivilegeShowPartitions, true
+	case *milvuspb.HasPartitionRequest:
+		return util.ObjectTypeCollection, util.PrivilegeHasPartition, true
+	case *milvuspb.ImportRequest, *milvuspb.GetImportStateRequest:
+		return util.ObjectTypeCollection, util.PrivilegeImport, true
+	default:
+		return "", "", false
+	}
+}
+
+// collectionObjectName returns the object name of a collection in the grants, the collections of the
+// default database are named by themselves, and the others are named as "database.collection".
+func collectionObjectName(dbName, collectionName string) string {
+	if dbName == "" || dbName == common.DefaultDBName {
+		return collectionName
+	}
+	return dbName + "." + collectionName
+}
+
+// getObjectNames returns the names of the objects which the request operates on, requests which don't
+// name an object, such as the global ones and the ones which only carry ids, operate on "*".
+func getObjectNames(req interface{}, objectType string) []string {
+	switch r := req.(type) {
+	case *milvuspb.CalcDistanceRequest:
+		// only the vectors fetched by ids are read from collections
+		var names []string
+		for _, op := range []*milvuspb.VectorsArray{r.GetOpLeft(), r.GetOpRight()} {
+			if ids := op.GetIdArray(); ids != nil {
+				names = append(names, collectionObjectName("", ids.GetCollectionName()))
+			}
+		}
+		return names
+	case *milvuspb.DummyRequest:
+		if drr, err := parseDummyQueryRequest(r.GetRequestType()); err == nil && drr.CollectionName != "" {
+			return []string{collectionObjectName(drr.DbName, drr.CollectionName)}
+		}
+		return []string{util.AnyWord}
+	}
+
+	switch objectType {
+	case util.ObjectTypeCollection:
+		var dbName string
+		if r, ok := req.(interface{ GetDbName() string }); ok {
+			dbName = r.GetDbName()
+		}
+		if r, ok := req.(interface{ GetCollectionNames() []string }); ok {
+			names := make([]string, 0, len(r.GetCollectionNames()))
+			for _, collectionName := range r.GetCollectionNames() {
+				names = append(names, collectionObjectName(dbName, collectionName))
+			}
+			return names
+		}
+		if r, ok := req.(interface{ GetCollectionName() string }); ok && r.GetCollectionName() != "" {
+			return []string{collectionObjectName(dbName, r.GetCollectionName())}
+		}
+	case util.ObjectTypeUser:
+		if r, ok := req.(interface{ GetUsername() string }); ok && r.GetUsername() != "" {
+			return []string{r.GetUsername()}
+		}
+	}
+	return []string{util.AnyWord}
+}
+
+// hasPrivilege checks whether any of the roles is granted the privilege on the object, either directly,
+// by the All privilege, on all collections of the database, or on all objects of the type.
+func hasPrivilege(ctx context.Context, cache Cache, roles []string, objectType, objectName, privilege string) (bool, error) {
+	names := []string{objectName}
+	if idx := strings.Index(objectName, "."); objectType == util.ObjectTypeCollection && idx >= 0 {
+		names = append(names, objectName[:idx+1]+util.AnyWord)
+	}
+	names = append(names, util.AnyWord)
+	for _, role := range roles {
+		for _, name := range names {
+			for _, priv := range []string{privilege, util.PrivilegeAll} {
+				ok, err := cache.HasGrant(ctx, role, objectType, name, priv)
+				if err != nil {
+					return false, err
+				}
+				if ok {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// PrivilegeInterceptor checks whether the current user is granted the privilege which the request requires.
+// The root user and the users of the admin role own all privileges, every user is treated as a member
+// of the public role, and a user can always operate on itself. Unknown requests are denied.
+// It's a no-op if authorization is not enabled.
+func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context, error) {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return ctx, nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+	objectType, privilege, ok := getObjectPrivilege(req)
+	if !ok {
+		log.Warn("permission denied for unknown request", zap.String("username", username), zap.String("request", fmt.Sprintf("%T", req)))
+		return ctx, status.Error(codes.PermissionDenied, fmt.Sprintf("permission denied, unknown request %T", req))
+	}
+	if privilege == "" || username == util.UserRoot {
+		return ctx, nil
+	}
+	if globalMetaCache == nil {
+		return ctx, status.Error(codes.Unavailable, "meta cache is not initialized")
+	}
+
+	roles, err := globalMetaCache.GetUserRoles(ctx, username)
+	if err != nil {
+		log.Warn("fail to get the roles of user", zap.String("username", username), zap.Error(err))
+		return ctx, status.Error(codes.Internal, err.Error())
+	}
+	roles = append(roles, util.RolePublic)
+	for _, role := range roles {
+		if role == util.RoleAdmin {
+			return ctx, nil
+		}
+	}
+
+	for _, objectName := range getObjectNames(req, objectType) {
+		if objectType == util.ObjectTypeUser && objectName == username {
+			continue
+		}
+		ok, err := hasPrivilege(ctx, globalMetaCache, roles, objectType, objectName, privilege)
+		if err != nil {
+			log.Warn("fail to check the privilege", zap.String("username", username), zap.Error(err))
+			return ctx, status.Error(codes.Internal, err.Error())
+		}
+		if !ok {
+			log.Debug("permission denied", zap.String("username", username), zap.String("object type", objectType),
+				zap.String("object name", objectName), zap.String("privilege", privilege))
+			return ctx, status.Error(codes.PermissionDenied,
+				fmt.Sprintf("permission denied, user %s has no privilege %s on %s %s", username, privilege, objectType, objectName))
+		}
+	}
+	return ctx, nil
+}
+
+// UnaryServerPrivilegeInterceptor returns a grpc unary interceptor which calls PrivilegeInterceptor
+// before handling the request, it must be chained after the authentication interceptor.
+func UnaryServerPrivilegeInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, InternalServiceMethodPrefix) {
+			return handler(ctx, req)
+		}
+		newCtx, err := PrivilegeInterceptor(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(newCtx, req)
+	}
+}
+
+// privilegeServerStream checks the privilege of every message received from the stream
+type privilegeServerStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives a message and returns a grpc error if the privilege which it requires is not granted
+func (s *privilegeServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := PrivilegeInterceptor(s.Context(), m)
+	return err
+}
+
+// StreamServerPrivilegeInterceptor returns a grpc stream interceptor which calls PrivilegeInterceptor
+// on every received message, it must be chained after the authentication interceptor.
+func StreamServerPrivilegeInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, InternalServiceMethodPrefix) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &privilegeServerStream{ServerStream: ss})
+	}
+}
@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func TestPrivilegeInterceptor(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	// authorization disabled
+	Params.CommonCfg.AuthorizationEnabled = false
+	_, err := PrivilegeInterceptor(ctx, &milvuspb.InsertRequest{})
+	assert.Nil(t, err)
+
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+	}()
+	rc := NewRootCoordMock()
+	rc.SetListPolicyFunc(func(ctx context.Context, request *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Grants: []*milvuspb.GrantEntity{
+				{RoleName: "role1", ObjectType: util.ObjectTypeCollection, ObjectName: "coll1", Privilege: util.PrivilegeInsert},
+				{RoleName: "role1", ObjectType: util.ObjectTypeCollection, ObjectName: util.AnyWord, Privilege: util.PrivilegeQuery},
+				{RoleName: "role2", ObjectType: util.ObjectTypeCollection, ObjectName: "coll1", Privilege: util.PrivilegeAll},
+				{RoleName: util.RolePublic, ObjectType: util.ObjectTypeGlobal, ObjectName: util.AnyWord, Privilege: util.PrivilegeShowCollections},
+				{RoleName: "role3", ObjectType: util.ObjectTypeCollection, ObjectName: "db1.coll1", Privilege: util.PrivilegeInsert},
+				{RoleName: "role3", ObjectType: util.ObjectTypeCollection, ObjectName: "db1.*", Privilege: util.PrivilegeSearch},
+			},
+			UserRoles: []*milvuspb.UserResult{
+				{Username: "user1", RoleNames: []string{"role1"}},
+				{Username: "user2", RoleNames: []string{"role2"}},
+				{Username: "admin1", RoleNames: []string{util.RoleAdmin}},
+				{Username: "user4", RoleNames: []string{"role3"}},
+			},
+		}, nil
+	})
+	err = InitMetaCache(rc)
+	assert.Nil(t, err)
+
+	withUser := func(username string) context.Context {
+		return context.WithValue(ctx, ctxUserKey{}, username)
+	}
+	isPermissionDenied := func(err error) bool {
+		return status.Code(err) == codes.PermissionDenied
+	}
+
+	// not authenticated
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.InsertRequest{CollectionName: "coll1"})
+	assert.NotNil(t, err)
+
+	// requests which don't require any privilege
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.RegisterLinkRequest{})
+	assert.Nil(t, err)
+
+	// unknown requests are denied
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.BoolResponse{})
+	assert.True(t, isPermissionDenied(err))
+
+	// requests which are not mapped before
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.GetMetricsRequest{})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.CreateAliasRequest{CollectionName: "coll1", Alias: "alias1"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.HasCollectionRequest{CollectionName: "coll1"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.CalcDistanceRequest{
+		OpLeft: &milvuspb.VectorsArray{Array: &milvuspb.VectorsArray_IdArray{IdArray: &milvuspb.VectorIDs{CollectionName: "coll2"}}},
+	})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.CalcDistanceRequest{
+		OpLeft: &milvuspb.VectorsArray{Array: &milvuspb.VectorsArray_IdArray{IdArray: &milvuspb.VectorIDs{CollectionName: "coll2"}}},
+	})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.CalcDistanceRequest{})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.DummyRequest{RequestType: `{"request_type": "query", "collection_name": "coll1"}`})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.DummyRequest{RequestType: `{"request_type": "query", "collection_name": "coll2"}`})
+	assert.True(t, isPermissionDenied(err))
+
+	// the collections of other databases are named with the database
+	_, err = PrivilegeInterceptor(withUser("user4"), &milvuspb.InsertRequest{DbName: "db1", CollectionName: "coll1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user4"), &milvuspb.InsertRequest{CollectionName: "coll1"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user4"), &milvuspb.InsertRequest{DbName: "db1", CollectionName: "coll2"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user4"), &milvuspb.SearchRequest{DbName: "db1", CollectionName: "coll2"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user4"), &milvuspb.SearchRequest{DbName: "db2", CollectionName: "coll2"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.InsertRequest{DbName: "db1", CollectionName: "coll1"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.InsertRequest{DbName: common.DefaultDBName, CollectionName: "coll1"})
+	assert.Nil(t, err)
+
+	// root and admin own all privileges
+	_, err = PrivilegeInterceptor(withUser(util.UserRoot), &milvuspb.DropCollectionRequest{CollectionName: "coll1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("admin1"), &milvuspb.DropCollectionRequest{CollectionName: "coll1"})
+	assert.Nil(t, err)
+
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.InsertRequest{CollectionName: "coll1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.InsertRequest{CollectionName: "coll2"})
+	assert.True(t, isPermissionDenied(err))
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.DropCollectionRequest{CollectionName: "coll1"})
+	assert.True(t, isPermissionDenied(err))
+
+	// granted on all collections
+	_, err = PrivilegeInterceptor(withUser("user1"), &milvuspb.QueryRequest{CollectionName: "coll2"})
+	assert.Nil(t, err)
+
+	// granted all privileges
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.DropCollectionRequest{CollectionName: "coll1"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user2"), &milvuspb.FlushRequest{CollectionNames: []string{"coll1", "coll2"}})
+	assert.True(t, isPermissionDenied(err))
+
+	// granted to the public role
+	_, err = PrivilegeInterceptor(withUser("user3"), &milvuspb.ShowCollectionsRequest{})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user3"), &milvuspb.CreateCollectionRequest{CollectionName: "coll3"})
+	assert.True(t, isPermissionDenied(err))
+
+	// a user can always operate on itself
+	_, err = PrivilegeInterceptor(withUser("user3"), &milvuspb.UpdateCredentialRequest{Username: "user3"})
+	assert.Nil(t, err)
+	_, err = PrivilegeInterceptor(withUser("user3"), &milvuspb.UpdateCredentialRequest{Username: "user1"})
+	assert.True(t, isPermissionDenied(err))
+}
+
+func TestGetObjectPrivilege_AllRequests(t *testing.T) {
+	// every request of MilvusService must be mapped, the unknown ones are denied
+	serviceType := reflect.TypeOf((*milvuspb.MilvusServiceServer)(nil)).Elem()
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i)
+		req := reflect.New(method.Type.In(1).Elem()).Interface()
+		_, _, ok := getObjectPrivilege(req)
+		assert.True(t, ok, "request of %s is not mapped", method.Name)
+	}
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg proto.Message
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestServerPrivilegeInterceptor(t *testing.T) {
+	Params.Init()
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+	}()
+	ctx := context.Background()
+
+	// the internal service is not checked
+	unary := UnaryServerPrivilegeInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ret, err := unary(ctx, &milvuspb.BoolResponse{}, &grpc.UnaryServerInfo{FullMethod: InternalServiceMethodPrefix + "GetComponentStates"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", ret)
+	_, err = unary(ctx, &milvuspb.InsertRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Insert"}, handler)
+	assert.NotNil(t, err)
+
+	stream := StreamServerPrivilegeInterceptor()
+	streamHandler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&milvuspb.InsertRequest{})
+	}
+	ss := &mockServerStream{ctx: ctx, msg: &milvuspb.InsertRequest{CollectionName: "coll1"}}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: InternalServiceMethodPrefix + "Stream"}, streamHandler)
+	assert.Nil(t, err)
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Stream"}, streamHandler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	ss.ctx = context.WithValue(ctx, ctxUserKey{}, util.UserRoot)
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Stream"}, streamHandler)
+	assert.Nil(t, err)
+}
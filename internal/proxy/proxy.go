@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -76,6 +77,9 @@ type Proxy struct {
 
 	chMgr channelsMgr
 
+	// shardMgr caches the clients of query nodes when search and query are sent by grpc
+	shardMgr *shardClientMgr
+
 	sched *taskScheduler
 
 	chTicker channelsTimeTicker
@@ -86,6 +90,10 @@ type Proxy struct {
 
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	// rateLimiter rejects the requests beyond the rate limits, its dml limits are adjusted by quotaCenter
+	rateLimiter *multiRateLimiter
+	quotaCenter *quotaCenter
+
 	session *sessionutil.Session
 
 	msFactory msgstream.Factory
@@ -103,6 +111,7 @@ func NewProxy(ctx context.Context, factory msgstream.Factory) (*Proxy, error) {
 		ctx:       ctx1,
 		cancel:    cancel,
 		msFactory: factory,
+		shardMgr:  newShardClientMgr(nil),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -129,7 +138,7 @@ func (node *Proxy) Register() error {
 
 // initSession initialize the session of Proxy.
 func (node *Proxy) initSession() error {
-	node.session = sessionutil.NewSession(node.ctx, Params.BaseParams.MetaRootPath, node.etcdCli)
+	node.session = metastore.NewSession(node.ctx, node.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if node.session == nil {
 		return errors.New("new session failed, maybe etcd cannot be connected")
 	}
@@ -248,6 +257,9 @@ func (node *Proxy) Init() error {
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 	log.Debug("create metrics cache manager done", zap.String("role", typeutil.ProxyRole))
 
+	node.rateLimiter = newMultiRateLimiter()
+	node.quotaCenter = newQuotaCenter(node.dataCoord, node.queryCoord, node.rateLimiter)
+
 	log.Debug("init meta cache", zap.String("role", typeutil.ProxyRole))
 	if err := InitMetaCache(node.rootCoord); err != nil {
 		log.Warn("failed to init meta cache", zap.Error(err), zap.String("role", typeutil.ProxyRole))
@@ -361,6 +373,14 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	if Params.QuotaCfg.QuotaAndLimitsEnabled {
+		node.wg.Add(1)
+		go func() {
+			defer node.wg.Done()
+			node.quotaCenter.run(node.ctx)
+		}()
+	}
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -405,6 +425,10 @@ func (node *Proxy) Stop() error {
 
 	node.wg.Wait()
 
+	if node.shardMgr != nil {
+		node.shardMgr.close()
+	}
+
 	for _, cb := range node.closeCallbacks {
 		cb()
 	}
@@ -456,3 +480,8 @@ func (node *Proxy) SetDataCoordClient(cli types.DataCoord) {
 func (node *Proxy) SetQueryCoordClient(cli types.QueryCoord) {
 	node.queryCoord = cli
 }
+
+// SetQueryNodeCreator sets the function creating QueryNode clients for proxy.
+func (node *Proxy) SetQueryNodeCreator(creator func(ctx context.Context, addr string) (types.QueryNode, error)) {
+	node.shardMgr.creator = creator
+}
@@ -59,6 +59,7 @@ import (
 
 	grpcdatacoordclient2 "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -72,6 +73,7 @@ import (
 	grpcdatanode "github.com/milvus-io/milvus/internal/distributed/datanode"
 
 	grpcquerynode "github.com/milvus-io/milvus/internal/distributed/querynode"
+	grpcquerynodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
 
 	grpcquerycoord "github.com/milvus-io/milvus/internal/distributed/querycoord"
 
@@ -451,6 +453,9 @@ func TestProxy(t *testing.T) {
 	err = funcutil.WaitForComponentHealthy(ctx, queryCoordClient, typeutil.QueryCoordRole, attempts, sleepDuration)
 	assert.NoError(t, err)
 	proxy.SetQueryCoordClient(queryCoordClient)
+	proxy.SetQueryNodeCreator(func(ctx context.Context, addr string) (types.QueryNode, error) {
+		return grpcquerynodeclient.NewClient(ctx, addr)
+	})
 	log.Info("Proxy set query coordinator client")
 
 	indexCoordClient, err := grpcindexcoordclient.NewClient(ctx, Params.BaseParams.MetaRootPath, etcdcli)
@@ -800,6 +805,43 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("database", func(t *testing.T) {
+		defer wg.Done()
+		otherDBName := "test_db"
+
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			DbName: otherDBName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// invalid database name
+		resp, err = proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			DbName: "$invalid",
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		listResp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Contains(t, listResp.DbNames, otherDBName)
+
+		// the default database can't be dropped
+		resp, err = proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			DbName: common.DefaultDBName,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			DbName: otherDBName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("has collection", func(t *testing.T) {
 		defer wg.Done()
@@ -828,7 +870,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -997,7 +1039,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1476,7 +1518,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1548,7 +1590,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1605,7 +1647,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1701,7 +1743,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1748,7 +1790,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -2002,6 +2044,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Search fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2010,6 +2060,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("HybridSearch fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.HybridSearch(ctx, &milvuspb.HybridSearchRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Flush fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2050,6 +2108,38 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AddField fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("GetPersistentSegmentInfo fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2289,6 +2379,38 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AddField fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.sched.ddQueue.setMaxTaskNum(ddParallel)
 
 	dmParallelism := proxy.sched.dmQueue.getMaxTaskNum()
@@ -2310,6 +2432,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, dm queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.sched.dmQueue.setMaxTaskNum(dmParallelism)
 
 	dqParallelism := proxy.sched.dqQueue.getMaxTaskNum()
@@ -2323,6 +2453,19 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("HybridSearch fail, dq queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.HybridSearch(ctx, &milvuspb.HybridSearchRequest{
+			Requests: []*milvuspb.SearchRequest{
+				{SearchParams: []*commonpb.KeyValuePair{{Key: MetricTypeKey, Value: distance.L2}}},
+			},
+			RankParams: []*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Query fail, dq queue full", func(t *testing.T) {
 		defer wg.Done()
@@ -2525,6 +2668,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(shortCtx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Search fail, timeout", func(t *testing.T) {
 		defer wg.Done()
@@ -2565,6 +2716,38 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AddField fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(shortCtx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(shortCtx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(shortCtx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(shortCtx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Wait()
 	cancel()
 }
@@ -2647,6 +2830,57 @@ func Test_GetFlushState(t *testing.T) {
 	})
 }
 
+func Test_Import(t *testing.T) {
+	t.Run("test import with unhealthy proxy", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.Import(context.TODO(), &milvuspb.ImportRequest{Files: []string{"rows.json"}})
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test import without files", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.Import(context.TODO(), &milvuspb.ImportRequest{CollectionName: "test"})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	t.Run("test import into a non-existent collection", func(t *testing.T) {
+		rc := NewRootCoordMock()
+		rc.Start()
+		defer rc.Stop()
+		proxy := &Proxy{dataCoord: &DataCoordMock{}, rootCoord: rc}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "not_exist",
+			Files:          []string{"rows.json"},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+}
+
+func Test_GetImportState(t *testing.T) {
+	t.Run("normal test", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{Task: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(1), resp.GetId())
+	})
+
+	t.Run("test get import state with unhealthy proxy", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{Task: 1})
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
 func TestProxy_GetComponentStates(t *testing.T) {
 	n := &Proxy{}
 	n.stateCode.Store(internalpb.StateCode_Healthy)
@@ -42,6 +42,22 @@ func SetQueryCoordShowCollectionsFunc(f queryCoordShowCollectionsFuncType) Query
 	}
 }
 
+type queryCoordGetReplicasFuncType func(ctx context.Context, request *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error)
+
+func SetQueryCoordGetReplicasFunc(f queryCoordGetReplicasFuncType) QueryCoordMockOption {
+	return func(mock *QueryCoordMock) {
+		mock.getReplicasFunc = f
+	}
+}
+
+type queryCoordGetShardLeadersFuncType func(ctx context.Context, request *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)
+
+func SetQueryCoordGetShardLeadersFunc(f queryCoordGetShardLeadersFuncType) QueryCoordMockOption {
+	return func(mock *QueryCoordMock) {
+		mock.getShardLeadersFunc = f
+	}
+}
+
 type QueryCoordMock struct {
 	nodeID  typeutil.UniqueID
 	address string
@@ -53,6 +69,8 @@ type QueryCoordMock struct {
 	colMtx              sync.RWMutex
 
 	showCollectionsFunc queryCoordShowCollectionsFuncType
+	getReplicasFunc     queryCoordGetReplicasFuncType
+	getShardLeadersFunc queryCoordGetShardLeadersFuncType
 	getMetricsFunc      getMetricsFuncType
 
 	statisticsChannel string
@@ -308,6 +326,49 @@ func (coord *QueryCoordMock) LoadBalance(ctx context.Context, req *querypb.LoadB
 	panic("implement me")
 }
 
+func (coord *QueryCoordMock) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	if !coord.healthy() {
+		return &querypb.GetReplicasResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+
+	if coord.getReplicasFunc != nil {
+		return coord.getReplicasFunc(ctx, req)
+	}
+
+	// collections loaded by the mock have no replica
+	return &querypb.GetReplicasResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
+func (coord *QueryCoordMock) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	if !coord.healthy() {
+		return &querypb.GetShardLeadersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "unhealthy",
+			},
+		}, nil
+	}
+
+	if coord.getShardLeadersFunc != nil {
+		return coord.getShardLeadersFunc(ctx, req)
+	}
+
+	return &querypb.GetShardLeadersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+	}, nil
+}
+
 func (coord *QueryCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if !coord.healthy() {
 		return &milvuspb.GetMetricsResponse{
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// encodeQueryCursor encodes the state of a paginated query into an opaque cursor returned to clients.
+func encodeQueryCursor(cursor *internalpb.QueryCursor) (string, error) {
+	bs, err := proto.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(bs), nil
+}
+
+// decodeQueryCursor decodes the cursor passed by clients.
+func decodeQueryCursor(s string) (*internalpb.QueryCursor, error) {
+	bs, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query cursor: %s", err.Error())
+	}
+	cursor := &internalpb.QueryCursor{}
+	if err := proto.Unmarshal(bs, cursor); err != nil {
+		return nil, fmt.Errorf("invalid query cursor: %s", err.Error())
+	}
+	if cursor.GetLastPrimaryKey().GetIdField() == nil {
+		return nil, fmt.Errorf("invalid query cursor: no primary key")
+	}
+	return cursor, nil
+}
+
+// cursorExpr returns the expr matching the entities after the last primary key of cursor.
+func cursorExpr(pkField *schemapb.FieldSchema, cursor *internalpb.QueryCursor) (string, error) {
+	switch pks := cursor.GetLastPrimaryKey().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		if pkField.DataType != schemapb.DataType_Int64 || len(pks.IntId.GetData()) != 1 {
+			return "", fmt.Errorf("query cursor mismatches primary field %s", pkField.Name)
+		}
+		return fmt.Sprintf("%s > %d", pkField.Name, pks.IntId.Data[0]), nil
+	case *schemapb.IDs_StrId:
+		if pkField.DataType != schemapb.DataType_String || len(pks.StrId.GetData()) != 1 {
+			return "", fmt.Errorf("query cursor mismatches primary field %s", pkField.Name)
+		}
+		return fmt.Sprintf("%s > %s", pkField.Name, strconv.Quote(pks.StrId.Data[0])), nil
+	default:
+		return "", fmt.Errorf("query cursor mismatches primary field %s", pkField.Name)
+	}
+}
+
+// getLastPrimaryKey returns the number of entities in fieldsData and the primary key of the last one,
+// the primary key is nil if there is no entity.
+func getLastPrimaryKey(fieldsData []*schemapb.FieldData, pkField *schemapb.FieldSchema) (int64, *schemapb.IDs) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != pkField.FieldID {
+			continue
+		}
+		if data := fieldData.GetScalars().GetLongData().GetData(); len(data) > 0 {
+			return int64(len(data)), &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: []int64{data[len(data)-1]}},
+				},
+			}
+		}
+		if data := fieldData.GetScalars().GetStringData().GetData(); len(data) > 0 {
+			return int64(len(data)), &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{Data: []string{data[len(data)-1]}},
+				},
+			}
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCursor_EncodeDecode(t *testing.T) {
+	cursor := &internalpb.QueryCursor{
+		CollectionID:    1,
+		TravelTimestamp: 100,
+		LastPrimaryKey: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: []int64{10}},
+			},
+		},
+	}
+	s, err := encodeQueryCursor(cursor)
+	assert.NoError(t, err)
+
+	decoded, err := decodeQueryCursor(s)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), decoded.CollectionID)
+	assert.Equal(t, uint64(100), decoded.TravelTimestamp)
+	assert.Equal(t, []int64{10}, decoded.LastPrimaryKey.GetIntId().GetData())
+
+	_, err = decodeQueryCursor("invalid cursor")
+	assert.Error(t, err)
+
+	s, err = encodeQueryCursor(&internalpb.QueryCursor{CollectionID: 1})
+	assert.NoError(t, err)
+	_, err = decodeQueryCursor(s)
+	assert.Error(t, err)
+}
+
+func TestQueryCursor_cursorExpr(t *testing.T) {
+	int64Field := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}
+	stringField := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_String}
+	intCursor := &internalpb.QueryCursor{
+		LastPrimaryKey: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: []int64{10}},
+			},
+		},
+	}
+	strCursor := &internalpb.QueryCursor{
+		LastPrimaryKey: &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{Data: []string{`a"b`}},
+			},
+		},
+	}
+
+	expr, err := cursorExpr(int64Field, intCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "pk > 10", expr)
+
+	expr, err = cursorExpr(stringField, strCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, `pk > "a\"b"`, expr)
+
+	_, err = cursorExpr(stringField, intCursor)
+	assert.Error(t, err)
+
+	_, err = cursorExpr(int64Field, strCursor)
+	assert.Error(t, err)
+
+	_, err = cursorExpr(int64Field, &internalpb.QueryCursor{})
+	assert.Error(t, err)
+}
+
+func TestQueryCursor_getLastPrimaryKey(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{Data: []int64{7, 8, 9}},
+					},
+				},
+			},
+		},
+		{
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}},
+					},
+				},
+			},
+		},
+	}
+	numRows, lastPK := getLastPrimaryKey(fieldsData, pkField)
+	assert.Equal(t, int64(3), numRows)
+	assert.Equal(t, []int64{3}, lastPK.GetIntId().GetData())
+
+	fieldsData[1].Field = &schemapb.FieldData_Scalars{
+		Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{
+				StringData: &schemapb.StringArray{Data: []string{"a", "b"}},
+			},
+		},
+	}
+	numRows, lastPK = getLastPrimaryKey(fieldsData, pkField)
+	assert.Equal(t, int64(2), numRows)
+	assert.Equal(t, []string{"b"}, lastPK.GetStrId().GetData())
+
+	numRows, lastPK = getLastPrimaryKey(fieldsData[:1], pkField)
+	assert.Equal(t, int64(0), numRows)
+	assert.Nil(t, lastPK)
+}
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// clusterQuotaMetrics are the metrics of the cluster the dml quota is calculated from
+type clusterQuotaMetrics struct {
+	flushLag      time.Duration
+	maxTSafeDelay time.Duration
+	// memory usage ratio of each data node and query node, by component name
+	memoryUsages map[string]float64
+}
+
+// quotaCenter collects the metrics of DataCoord and QueryCoord periodically, and slows down or rejects
+// the writes of Proxy when the flush lag, the tsafe delay or the memory usage of the nodes exceed the quotas
+type quotaCenter struct {
+	dataCoord  types.DataCoord
+	queryCoord types.QueryCoord
+	limiter    *multiRateLimiter
+}
+
+func newQuotaCenter(dataCoord types.DataCoord, queryCoord types.QueryCoord, limiter *multiRateLimiter) *quotaCenter {
+	return &quotaCenter{
+		dataCoord:  dataCoord,
+		queryCoord: queryCoord,
+		limiter:    limiter,
+	}
+}
+
+// run updates the dml quota every collect interval until ctx is done
+func (q *quotaCenter) run(ctx context.Context) {
+	ticker := time.NewTicker(Params.QuotaCfg.QuotaCenterCollectInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("quota center exit")
+			return
+		case <-ticker.C:
+			metrics, err := q.collectMetrics(ctx)
+			if err != nil {
+				// the quota is kept as it is when the metrics are not available
+				log.Warn("quota center failed to collect metrics", zap.Error(err))
+				continue
+			}
+			factor, denyReason := calculateDMLQuota(metrics)
+			if denyReason != "" {
+				log.Warn("quota center denies writing", zap.String("reason", denyReason))
+			}
+			q.limiter.setDMLQuota(factor, denyReason)
+		}
+	}
+}
+
+func (q *quotaCenter) collectMetrics(ctx context.Context) (*clusterQuotaMetrics, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return nil, err
+	}
+	metrics := &clusterQuotaMetrics{memoryUsages: make(map[string]float64)}
+
+	dataResp, err := q.dataCoord.GetMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if dataResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(dataResp.GetStatus().GetReason())
+	}
+	dataTopology := metricsinfo.DataCoordTopology{}
+	if err := metricsinfo.UnmarshalTopology(dataResp.GetResponse(), &dataTopology); err != nil {
+		return nil, err
+	}
+	metrics.flushLag = dataTopology.Cluster.Self.QuotaMetrics.FlushLag
+	for _, node := range dataTopology.Cluster.ConnectedNodes {
+		addMemoryUsage(metrics, node.BaseComponentInfos)
+	}
+
+	queryResp, err := q.queryCoord.GetMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if queryResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(queryResp.GetStatus().GetReason())
+	}
+	queryTopology := metricsinfo.QueryCoordTopology{}
+	if err := metricsinfo.UnmarshalTopology(queryResp.GetResponse(), &queryTopology); err != nil {
+		return nil, err
+	}
+	for _, node := range queryTopology.Cluster.ConnectedNodes {
+		addMemoryUsage(metrics, node.BaseComponentInfos)
+		if node.QuotaMetrics.MaxTSafeDelay > metrics.maxTSafeDelay {
+			metrics.maxTSafeDelay = node.QuotaMetrics.MaxTSafeDelay
+		}
+	}
+	return metrics, nil
+}
+
+func addMemoryUsage(metrics *clusterQuotaMetrics, infos metricsinfo.BaseComponentInfos) {
+	if infos.HasError || infos.HardwareInfos.Memory == 0 {
+		return
+	}
+	metrics.memoryUsages[infos.Name] = float64(infos.HardwareInfos.MemoryUsage) / float64(infos.HardwareInfos.Memory)
+}
+
+// calculateDMLQuota returns the factor the dml limits are scaled by, and the reason to reject all the writes
+// if any of the quotas is exceeded
+func calculateDMLQuota(metrics *clusterQuotaMetrics) (float64, string) {
+	cfg := &Params.QuotaCfg
+	if cfg.MaxFlushLag > 0 && metrics.flushLag > cfg.MaxFlushLag {
+		return 0, fmt.Sprintf("flush lag %v exceeds %v", metrics.flushLag, cfg.MaxFlushLag)
+	}
+	if cfg.MaxTSafeDelay > 0 && metrics.maxTSafeDelay > cfg.MaxTSafeDelay {
+		return 0, fmt.Sprintf("tsafe delay %v exceeds %v", metrics.maxTSafeDelay, cfg.MaxTSafeDelay)
+	}
+	factor := 1.0
+	for name, usage := range metrics.memoryUsages {
+		if usage >= cfg.MemoryHighWaterLevel {
+			return 0, fmt.Sprintf("memory usage %.2f of %s exceeds the high water level %.2f", usage, name, cfg.MemoryHighWaterLevel)
+		}
+		if usage > cfg.MemoryLowWaterLevel {
+			// linearly from 1 at the low water level to 0 at the high water level
+			f := (cfg.MemoryHighWaterLevel - usage) / (cfg.MemoryHighWaterLevel - cfg.MemoryLowWaterLevel)
+			if f < factor {
+				factor = f
+			}
+		}
+	}
+	return factor, ""
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestCalculateDMLQuota(t *testing.T) {
+	Params.Init()
+	cfg := &Params.QuotaCfg
+	old := *cfg
+	defer func() { *cfg = old }()
+	cfg.MaxFlushLag = time.Minute
+	cfg.MaxTSafeDelay = time.Minute
+	cfg.MemoryLowWaterLevel = 0.8
+	cfg.MemoryHighWaterLevel = 0.9
+
+	metrics := &clusterQuotaMetrics{memoryUsages: map[string]float64{"querynode1": 0.5}}
+	factor, reason := calculateDMLQuota(metrics)
+	assert.Equal(t, 1.0, factor)
+	assert.Equal(t, "", reason)
+
+	metrics.memoryUsages["datanode1"] = 0.85
+	factor, reason = calculateDMLQuota(metrics)
+	assert.InDelta(t, 0.5, factor, 1e-9)
+	assert.Equal(t, "", reason)
+
+	metrics.memoryUsages["datanode1"] = 0.95
+	_, reason = calculateDMLQuota(metrics)
+	assert.Contains(t, reason, "datanode1")
+
+	metrics.memoryUsages = map[string]float64{}
+	metrics.flushLag = 2 * time.Minute
+	_, reason = calculateDMLQuota(metrics)
+	assert.Contains(t, reason, "flush lag")
+
+	metrics.flushLag = 0
+	metrics.maxTSafeDelay = 2 * time.Minute
+	_, reason = calculateDMLQuota(metrics)
+	assert.Contains(t, reason, "tsafe delay")
+
+	// no limit
+	cfg.MaxTSafeDelay = 0
+	_, reason = calculateDMLQuota(metrics)
+	assert.Equal(t, "", reason)
+}
+
+func TestAddMemoryUsage(t *testing.T) {
+	metrics := &clusterQuotaMetrics{memoryUsages: make(map[string]float64)}
+	infos := metricsinfo.BaseComponentInfos{Name: "querynode1"}
+	infos.HardwareInfos.Memory = 100
+	infos.HardwareInfos.MemoryUsage = 25
+	addMemoryUsage(metrics, infos)
+	assert.Equal(t, 0.25, metrics.memoryUsages["querynode1"])
+
+	infos.Name = "querynode2"
+	infos.HasError = true
+	addMemoryUsage(metrics, infos)
+	assert.NotContains(t, metrics.memoryUsages, "querynode2")
+
+	infos.HasError = false
+	infos.HardwareInfos.Memory = 0
+	addMemoryUsage(metrics, infos)
+	assert.NotContains(t, metrics.memoryUsages, "querynode2")
+}
@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// rateType is the kind of cost limited by multiRateLimiter
+type rateType int
+
+const (
+	dmlRowRate rateType = iota
+	dmlByteRate
+	dqlNQRate
+	ddlOpRate
+)
+
+func (rt rateType) String() string {
+	switch rt {
+	case dmlRowRate:
+		return "dml row rate"
+	case dmlByteRate:
+		return "dml byte rate"
+	case dqlNQRate:
+		return "dql nq rate"
+	case ddlOpRate:
+		return "ddl op rate"
+	default:
+		return fmt.Sprintf("rate type %d", int(rt))
+	}
+}
+
+func (rt rateType) isDML() bool {
+	return rt == dmlRowRate || rt == dmlByteRate
+}
+
+// maxRates returns the configured limits of rt, for the whole Proxy and for each collection
+func (rt rateType) maxRates() (float64, float64) {
+	cfg := &Params.QuotaCfg
+	switch rt {
+	case dmlRowRate:
+		return cfg.DMLMaxRowRate, cfg.DMLMaxRowRatePerCollection
+	case dmlByteRate:
+		return cfg.DMLMaxByteRate, cfg.DMLMaxByteRatePerCollection
+	case dqlNQRate:
+		return cfg.DQLMaxNQRate, cfg.DQLMaxNQRatePerCollection
+	case ddlOpRate:
+		return cfg.DDLMaxOpRate, cfg.DDLMaxOpRatePerCollection
+	default:
+		return math.MaxFloat64, math.MaxFloat64
+	}
+}
+
+var rateTypes = []rateType{dmlRowRate, dmlByteRate, dqlNQRate, ddlOpRate}
+
+// rateCost is the cost of a request for one rate type
+type rateCost struct {
+	rt   rateType
+	cost int
+}
+
+// multiRateLimiter limits the requests of Proxy with a token bucket for each rate type, both for the
+// whole Proxy and for each collection. The dml limits are scaled down by the quota center under pressure.
+// The limiters of collections are keyed by collection id, so that collections of the same name in different
+// databases, or a collection recreated under the name of a dropped one, don't share their limits.
+type multiRateLimiter struct {
+	mu          sync.Mutex
+	global      map[rateType]*ratelimitutil.Limiter
+	collections map[UniqueID]map[rateType]*ratelimitutil.Limiter
+
+	dmlFactor   float64 // the dml limits are multiplied by dmlFactor
+	denyWriting string  // reason why writes are rejected, empty if they are allowed
+}
+
+func newMultiRateLimiter() *multiRateLimiter {
+	m := &multiRateLimiter{
+		collections: make(map[UniqueID]map[rateType]*ratelimitutil.Limiter),
+		dmlFactor:   1,
+	}
+	m.global = m.newLimiters(func(rt rateType) float64 {
+		max, _ := rt.maxRates()
+		return max
+	})
+	return m
+}
+
+// newLimiters creates a limiter for each rate type with the rate returned by maxRate
+func (m *multiRateLimiter) newLimiters(maxRate func(rt rateType) float64) map[rateType]*ratelimitutil.Limiter {
+	limiters := make(map[rateType]*ratelimitutil.Limiter, len(rateTypes))
+	for _, rt := range rateTypes {
+		limit := m.scaledLimit(rt, maxRate(rt))
+		// a burst of one second
+		limiters[rt] = ratelimitutil.NewLimiter(limit, maxRate(rt))
+	}
+	return limiters
+}
+
+func (m *multiRateLimiter) scaledLimit(rt rateType, rate float64) ratelimitutil.Limit {
+	if rate == math.MaxFloat64 {
+		return ratelimitutil.Inf
+	}
+	if rt.isDML() {
+		rate *= m.dmlFactor
+	}
+	return ratelimitutil.Limit(rate)
+}
+
+// check takes the costs of a request to the collection from the limiters, a rate limited status is returned
+// if any of the limits is exceeded, nothing is taken in this case. Only the limits of the whole Proxy apply
+// if collectionID is 0.
+func (m *multiRateLimiter) check(collectionID UniqueID, costs ...rateCost) *commonpb.Status {
+	if m == nil || !Params.QuotaCfg.QuotaAndLimitsEnabled {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	type taken struct {
+		limiter *ratelimitutil.Limiter
+		cost    int
+	}
+	takens := make([]taken, 0, 2*len(costs))
+	rollback := func() {
+		for _, t := range takens {
+			t.limiter.Cancel(t.cost)
+		}
+	}
+	now := time.Now()
+	for _, c := range costs {
+		if c.rt.isDML() && m.denyWriting != "" {
+			rollback()
+			return rateLimitedStatus(fmt.Sprintf("writing is denied: %s", m.denyWriting))
+		}
+		if !m.global[c.rt].AllowN(now, c.cost) {
+			rollback()
+			return rateLimitedStatus(fmt.Sprintf("%s exceeds the limit of proxy", c.rt))
+		}
+		takens = append(takens, taken{m.global[c.rt], c.cost})
+		if collectionID == 0 {
+			continue
+		}
+		limiter := m.getCollectionLimiters(collectionID)[c.rt]
+		if !limiter.AllowN(now, c.cost) {
+			rollback()
+			return rateLimitedStatus(fmt.Sprintf("%s exceeds the limit of collection %d", c.rt, collectionID))
+		}
+		takens = append(takens, taken{limiter, c.cost})
+	}
+	return nil
+}
+
+func (m *multiRateLimiter) getCollectionLimiters(collectionID UniqueID) map[rateType]*ratelimitutil.Limiter {
+	limiters, ok := m.collections[collectionID]
+	if !ok {
+		limiters = m.newLimiters(func(rt rateType) float64 {
+			_, max := rt.maxRates()
+			return max
+		})
+		m.collections[collectionID] = limiters
+	}
+	return limiters
+}
+
+// removeCollection drops the limiters of a dropped collection
+func (m *multiRateLimiter) removeCollection(collectionID UniqueID) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.collections, collectionID)
+}
+
+// setDMLQuota scales the dml limits by factor, and rejects all the writes if denyReason isn't empty
+func (m *multiRateLimiter) setDMLQuota(factor float64, denyReason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.denyWriting = denyReason
+	if factor == m.dmlFactor {
+		return
+	}
+	m.dmlFactor = factor
+	for _, rt := range rateTypes {
+		if !rt.isDML() {
+			continue
+		}
+		max, maxPerCollection := rt.maxRates()
+		m.global[rt].SetLimit(m.scaledLimit(rt, max))
+		for _, limiters := range m.collections {
+			limiters[rt].SetLimit(m.scaledLimit(rt, maxPerCollection))
+		}
+	}
+}
+
+// checkRateLimit checks the limits of a request to the collection collectionName of the database dbName,
+// the collection is looked up only if the limits are enabled. Only the limits of the whole Proxy apply
+// if collectionName is empty or the collection isn't found, the request fails later in this case.
+func (node *Proxy) checkRateLimit(ctx context.Context, dbName, collectionName string, costs ...rateCost) *commonpb.Status {
+	if node.rateLimiter == nil || !Params.QuotaCfg.QuotaAndLimitsEnabled {
+		return nil
+	}
+	var collectionID UniqueID
+	if collectionName != "" {
+		var err error
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+		if err != nil {
+			log.Debug("collection not found, only the limits of proxy are checked",
+				zap.String("db", dbName), zap.String("collection", collectionName), zap.Error(err))
+			collectionID = 0
+		}
+	}
+	return node.rateLimiter.check(collectionID, costs...)
+}
+
+func rateLimitedStatus(reason string) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_RateLimit,
+		Reason:    reason,
+	}
+}
+
+// dmlCosts returns the costs of an insert or delete request
+func dmlCosts(rows int, req proto.Message) []rateCost {
+	return []rateCost{
+		{rt: dmlRowRate, cost: rows},
+		{rt: dmlByteRate, cost: proto.Size(req)},
+	}
+}
+
+// getNq returns the number of queries of a search request by its placeholder group, 1 if it can't be parsed
+func getNq(placeholderGroup []byte) int {
+	group := &milvuspb.PlaceholderGroup{}
+	if err := proto.Unmarshal(placeholderGroup, group); err != nil || len(group.GetPlaceholders()) == 0 {
+		return 1
+	}
+	return len(group.GetPlaceholders()[0].GetValues())
+}
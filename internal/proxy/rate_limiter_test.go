@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func setQuotaCfg(t *testing.T, dmlRowRate, dmlRowRatePerCollection float64) {
+	Params.Init()
+	cfg := &Params.QuotaCfg
+	old := *cfg
+	t.Cleanup(func() { *cfg = old })
+	cfg.QuotaAndLimitsEnabled = true
+	cfg.DMLMaxRowRate = dmlRowRate
+	cfg.DMLMaxRowRatePerCollection = dmlRowRatePerCollection
+	cfg.DMLMaxByteRate = math.MaxFloat64
+	cfg.DMLMaxByteRatePerCollection = math.MaxFloat64
+	cfg.DQLMaxNQRate = math.MaxFloat64
+	cfg.DQLMaxNQRatePerCollection = math.MaxFloat64
+	cfg.DDLMaxOpRate = 0
+	cfg.DDLMaxOpRatePerCollection = math.MaxFloat64
+}
+
+func TestMultiRateLimiter_Check(t *testing.T) {
+	setQuotaCfg(t, 100, 50)
+	limiter := newMultiRateLimiter()
+
+	assert.Nil(t, limiter.check(1, rateCost{rt: dmlRowRate, cost: 50}))
+	// the limit of c1 is exceeded
+	status := limiter.check(1, rateCost{rt: dmlRowRate, cost: 10})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+	// the rows taken from the limiter of proxy are given back
+	assert.Nil(t, limiter.check(2, rateCost{rt: dmlRowRate, cost: 50}))
+	// the limit of proxy is exceeded
+	status = limiter.check(3, rateCost{rt: dmlRowRate, cost: 10})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+
+	// unlimited
+	assert.Nil(t, limiter.check(1, rateCost{rt: dqlNQRate, cost: 1000000}))
+	// zero rate rejects everything
+	status = limiter.check(0, rateCost{rt: ddlOpRate, cost: 1})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+
+	limiter.removeCollection(1)
+	assert.NotContains(t, limiter.collections, UniqueID(1))
+
+	var nilLimiter *multiRateLimiter
+	assert.Nil(t, nilLimiter.check(1, rateCost{rt: ddlOpRate, cost: 1}))
+	nilLimiter.removeCollection(1)
+
+	Params.QuotaCfg.QuotaAndLimitsEnabled = false
+	assert.Nil(t, limiter.check(0, rateCost{rt: ddlOpRate, cost: 1}))
+}
+
+// collectionIDCache resolves the ids of collections by database and name
+type collectionIDCache struct {
+	Cache
+	ids map[string]UniqueID // "db.collection" -> id
+}
+
+func (c *collectionIDCache) GetCollectionID(ctx context.Context, database string, collectionName string) (UniqueID, error) {
+	id, ok := c.ids[database+"."+collectionName]
+	if !ok {
+		return 0, errors.New("collection not found")
+	}
+	return id, nil
+}
+
+func TestProxy_CheckRateLimit(t *testing.T) {
+	setQuotaCfg(t, 100, 50)
+	oldCache := globalMetaCache
+	defer func() { globalMetaCache = oldCache }()
+	globalMetaCache = &collectionIDCache{ids: map[string]UniqueID{"db1.c": 1, "db2.c": 2}}
+	node := &Proxy{rateLimiter: newMultiRateLimiter()}
+	ctx := context.Background()
+
+	assert.Nil(t, node.checkRateLimit(ctx, "db1", "c", rateCost{rt: dmlRowRate, cost: 50}))
+	status := node.checkRateLimit(ctx, "db1", "c", rateCost{rt: dmlRowRate, cost: 1})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+	// the collection of the same name in another database has its own limits
+	assert.Nil(t, node.checkRateLimit(ctx, "db2", "c", rateCost{rt: dmlRowRate, cost: 40}))
+	// only the limit of proxy applies to an unknown collection
+	assert.Nil(t, node.checkRateLimit(ctx, "db3", "c", rateCost{rt: dmlRowRate, cost: 10}))
+	status = node.checkRateLimit(ctx, "db3", "c", rateCost{rt: dmlRowRate, cost: 1})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+	assert.Contains(t, status.GetReason(), "proxy")
+	assert.Equal(t, 2, len(node.rateLimiter.collections))
+
+	node.rateLimiter.removeCollection(1)
+	assert.NotContains(t, node.rateLimiter.collections, UniqueID(1))
+}
+
+func TestMultiRateLimiter_Rollback(t *testing.T) {
+	setQuotaCfg(t, 100, math.MaxFloat64)
+	Params.QuotaCfg.DMLMaxByteRate = 10
+	limiter := newMultiRateLimiter()
+
+	assert.Nil(t, limiter.check(1, rateCost{rt: dmlByteRate, cost: 10}))
+	// the rows are given back when the bytes exceed the limit
+	status := limiter.check(1, rateCost{rt: dmlRowRate, cost: 100}, rateCost{rt: dmlByteRate, cost: 5})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+	assert.Nil(t, limiter.check(1, rateCost{rt: dmlRowRate, cost: 100}))
+}
+
+func TestMultiRateLimiter_SetDMLQuota(t *testing.T) {
+	setQuotaCfg(t, 100, 100)
+	limiter := newMultiRateLimiter()
+	assert.Nil(t, limiter.check(1, rateCost{rt: dmlRowRate, cost: 1}))
+
+	limiter.setDMLQuota(0.5, "")
+	assert.Equal(t, float64(50), float64(limiter.global[dmlRowRate].Limit()))
+	assert.Equal(t, float64(50), float64(limiter.collections[1][dmlRowRate].Limit()))
+	assert.Equal(t, float64(50), float64(limiter.getCollectionLimiters(2)[dmlRowRate].Limit()))
+
+	limiter.setDMLQuota(0.5, "memory")
+	status := limiter.check(1, rateCost{rt: dmlRowRate, cost: 1})
+	assert.Equal(t, commonpb.ErrorCode_RateLimit, status.GetErrorCode())
+	assert.Contains(t, status.GetReason(), "memory")
+	// reads are not affected
+	assert.Nil(t, limiter.check(1, rateCost{rt: dqlNQRate, cost: 1}))
+
+	limiter.setDMLQuota(1, "")
+	assert.Nil(t, limiter.check(1, rateCost{rt: dmlRowRate, cost: 1}))
+	assert.Equal(t, float64(100), float64(limiter.global[dmlRowRate].Limit()))
+}
+
+func TestGetNq(t *testing.T) {
+	group := &milvuspb.PlaceholderGroup{
+		Placeholders: []*milvuspb.PlaceholderValue{
+			{
+				Tag:    "$0",
+				Type:   milvuspb.PlaceholderType_FloatVector,
+				Values: [][]byte{{1}, {2}, {3}},
+			},
+		},
+	}
+	bs, err := proto.Marshal(group)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, getNq(bs))
+	assert.Equal(t, 1, getNq([]byte{1, 2, 3}))
+	assert.Equal(t, 1, getNq(nil))
+}
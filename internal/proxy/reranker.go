@@ -0,0 +1,292 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// RankStrategyKey is the key of the reranker in the rank params of a hybrid search
+	RankStrategyKey = "strategy"
+	// RankParamsKey is the key of the json params of the reranker in the rank params of a hybrid search
+	RankParamsKey = "params"
+	// LimitKey is the key of the number of fused hits per query in the rank params of a hybrid search
+	LimitKey = "limit"
+
+	// RRFRankerName is the reranker of reciprocal rank fusion, it's used if no strategy is specified
+	RRFRankerName = "rrf"
+	// WeightedRankerName is the reranker of weighted score
+	WeightedRankerName = "weighted"
+
+	rrfKKey     = "k"
+	weightsKey  = "weights"
+	defaultRRFK = 60
+	maxRRFK     = 16384
+)
+
+// reranker fuses the results of the search requests of a hybrid search, the fused score of a hit
+// is the sum of the scores it gets from the results which it appears in.
+type reranker interface {
+	name() string
+	// score returns the score of the hit at rank of the idx-th result, rank starts from 0,
+	// distance is the score returned by the search with the metric type.
+	score(idx int, rank int, distance float32, metricType string) float32
+}
+
+// rrfRanker scores a hit by 1/(k+rank), the scores returned by the searches are ignored
+type rrfRanker struct {
+	k float64
+}
+
+func (r *rrfRanker) name() string {
+	return RRFRankerName
+}
+
+func (r *rrfRanker) score(idx int, rank int, distance float32, metricType string) float32 {
+	return float32(1 / (r.k + float64(rank+1)))
+}
+
+// weightedRanker scores a hit by the weighted score returned by the search, the score is normalized
+// into [0, 1] first, the larger the more similar, so that the scores of different metric types are comparable.
+type weightedRanker struct {
+	weights []float32
+}
+
+func (r *weightedRanker) name() string {
+	return WeightedRankerName
+}
+
+func (r *weightedRanker) score(idx int, rank int, distance float32, metricType string) float32 {
+	return r.weights[idx] * normalizeScore(distance, metricType)
+}
+
+// normalizeScore maps the similarity of positively related metric types from (-inf, +inf) and
+// the distance of the others from [0, +inf) into [0, 1] by arctan.
+func normalizeScore(score float32, metricType string) float32 {
+	if distance.PositivelyRelated(metricType) {
+		return float32(0.5 + math.Atan(float64(score))/math.Pi)
+	}
+	return float32(1 - 2*math.Atan(float64(score))/math.Pi)
+}
+
+// newReranker creates the reranker specified by the rank params for n search requests.
+func newReranker(rankParams []*commonpb.KeyValuePair, n int) (reranker, error) {
+	strategy, err := funcutil.GetAttrByKeyFromRepeatedKV(RankStrategyKey, rankParams)
+	if err != nil {
+		strategy = RRFRankerName
+	}
+	params := make(map[string]interface{})
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankParamsKey, rankParams)
+	if err == nil {
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, fmt.Errorf("invalid rank %s: %s, error: %s", RankParamsKey, paramsStr, err.Error())
+		}
+	}
+
+	switch strategy {
+	case RRFRankerName:
+		k := float64(defaultRRFK)
+		if value, ok := params[rrfKKey]; ok {
+			k, ok = value.(float64)
+			if !ok || k <= 0 || k >= maxRRFK {
+				return nil, fmt.Errorf("%s of %s ranker should be in range (0, %d), got %v", rrfKKey, RRFRankerName, maxRRFK, value)
+			}
+		}
+		return &rrfRanker{k: k}, nil
+	case WeightedRankerName:
+		values, ok := params[weightsKey].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s of %s ranker should be an array of numbers", weightsKey, WeightedRankerName)
+		}
+		if len(values) != n {
+			return nil, fmt.Errorf("the number of %s of %s ranker should be the number of search requests %d, got %d",
+				weightsKey, WeightedRankerName, n, len(values))
+		}
+		weights := make([]float32, 0, n)
+		for _, value := range values {
+			weight, ok := value.(float64)
+			if !ok || weight < 0 || weight > 1 {
+				return nil, fmt.Errorf("%s of %s ranker should be in range [0, 1], got %v", weightsKey, WeightedRankerName, value)
+			}
+			weights = append(weights, float32(weight))
+		}
+		return &weightedRanker{weights: weights}, nil
+	default:
+		return nil, fmt.Errorf("unknown rank %s %s, only %s and %s are supported", RankStrategyKey, strategy, RRFRankerName, WeightedRankerName)
+	}
+}
+
+// parseRankLimit parses limit and offset of the fused results from the rank params.
+func parseRankLimit(rankParams []*commonpb.KeyValuePair) (int64, int64, error) {
+	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, rankParams)
+	if err != nil {
+		return 0, 0, errors.New(LimitKey + " not found in rank_params")
+	}
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil || limit <= 0 {
+		return 0, 0, errors.New(LimitKey + " " + limitStr + " is invalid")
+	}
+	var offset int64
+	offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, rankParams)
+	if err == nil {
+		offset, err = strconv.ParseInt(offsetStr, 10, 64)
+		if err != nil || offset < 0 {
+			return 0, 0, errors.New(OffsetKey + " " + offsetStr + " is invalid")
+		}
+	}
+	if limit+offset > maxPaginationWindow {
+		return 0, 0, fmt.Errorf("%s+%s(%d) should not be larger than %d", LimitKey, OffsetKey, limit+offset, maxPaginationWindow)
+	}
+	return limit, offset, nil
+}
+
+// rerankSearchResults fuses the results of the search requests by the reranker, the fused hits of each query
+// are sorted by the fused score in descending order, the first offset hits are skipped and at most limit hits are kept.
+// The hits with the same fused score keep the order in which they appear in the results. Hits are identified by
+// their int64 or string primary keys, the output fields of a hit are gathered from the results it appears in.
+func rerankSearchResults(ranker reranker, results []*schemapb.SearchResultData, metricTypes []string, limit int64, offset int64) (*milvuspb.SearchResults, error) {
+	if len(results) == 0 {
+		return nil, errors.New("no search result to rerank")
+	}
+	nq := results[0].GetNumQueries()
+	// the output fields are matched by field id, a result without hits may carry no fields data
+	var fieldIDs []int64
+	fieldIndexes := make(map[int64]int)
+	resultFields := make([]map[int64]*schemapb.FieldData, len(results))
+	for sel, result := range results {
+		if result.GetNumQueries() != nq {
+			return nil, fmt.Errorf("the number of queries of the search requests should be the same, got %d and %d", nq, result.GetNumQueries())
+		}
+		resultFields[sel] = make(map[int64]*schemapb.FieldData)
+		for _, fieldData := range result.GetFieldsData() {
+			resultFields[sel][fieldData.GetFieldId()] = fieldData
+			if _, ok := fieldIndexes[fieldData.GetFieldId()]; !ok {
+				fieldIndexes[fieldData.GetFieldId()] = len(fieldIDs)
+				fieldIDs = append(fieldIDs, fieldData.GetFieldId())
+			}
+		}
+	}
+
+	ret := &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       limit,
+			FieldsData: make([]*schemapb.FieldData, len(fieldIDs)),
+			Scores:     make([]float32, 0),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: make([]int64, 0),
+					},
+				},
+			},
+			Topks: make([]int64, 0),
+		},
+	}
+
+	// the positions of a hit in the results
+	type position struct {
+		sel int
+		idx int64
+	}
+	type hit struct {
+		id        interface{}
+		score     float32
+		positions []position
+	}
+	starts := make([]int64, len(results))
+	for i := int64(0); i < nq; i++ {
+		hits := make([]*hit, 0)
+		hitMap := make(map[interface{}]*hit)
+		for sel, result := range results {
+			if int64(len(result.GetTopks())) != nq {
+				continue
+			}
+			idsSize := int64(typeutil.GetSizeOfIDs(result.GetIds()))
+			scores := result.GetScores()
+			topk := result.GetTopks()[i]
+			if starts[sel]+topk > idsSize || starts[sel]+topk > int64(len(scores)) {
+				return nil, fmt.Errorf("the search result %d is incomplete, expect at least %d hits, got %d", sel, starts[sel]+topk, idsSize)
+			}
+			for rank := int64(0); rank < topk; rank++ {
+				idx := starts[sel] + rank
+				id := typeutil.GetPK(result.GetIds(), idx)
+				score := ranker.score(sel, int(rank), scores[idx], metricTypes[sel])
+				if h, ok := hitMap[id]; ok {
+					h.score += score
+					h.positions = append(h.positions, position{sel: sel, idx: idx})
+					continue
+				}
+				h := &hit{id: id, score: score, positions: []position{{sel: sel, idx: idx}}}
+				hitMap[id] = h
+				hits = append(hits, h)
+			}
+			starts[sel] += topk
+		}
+		sort.SliceStable(hits, func(a, b int) bool {
+			return hits[a].score > hits[b].score
+		})
+
+		var realTopK int64
+		for j := offset; j < int64(len(hits)) && j < offset+limit; j++ {
+			h := hits[j]
+			for k, fieldID := range fieldIDs {
+				found := false
+				for _, pos := range h.positions {
+					if fieldData, ok := resultFields[pos.sel][fieldID]; ok {
+						typeutil.AppendFieldData(ret.Results.FieldsData[k:k+1], []*schemapb.FieldData{fieldData}, pos.idx)
+						found = true
+						break
+					}
+				}
+				if !found {
+					return nil, fmt.Errorf("field %d of the hit %v is not found in the search results", fieldID, h.id)
+				}
+			}
+			typeutil.AppendPKs(ret.Results.Ids, h.id)
+			ret.Results.Scores = append(ret.Results.Scores, h.score)
+			realTopK++
+		}
+		ret.Results.Topks = append(ret.Results.Topks, realTopK)
+	}
+
+	// AppendFieldData only copies the name and the id of the fields
+	for _, result := range results {
+		for _, fieldData := range result.GetFieldsData() {
+			if dst := ret.Results.FieldsData[fieldIndexes[fieldData.GetFieldId()]]; dst != nil {
+				dst.Type = fieldData.GetType()
+			}
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,236 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func TestNewReranker(t *testing.T) {
+	ranker, err := newReranker(nil, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, RRFRankerName, ranker.name())
+	assert.Equal(t, float64(defaultRRFK), ranker.(*rrfRanker).k)
+
+	ranker, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RankStrategyKey, Value: RRFRankerName},
+		{Key: RankParamsKey, Value: `{"k": 10}`},
+	}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(10), ranker.(*rrfRanker).k)
+
+	ranker, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RankStrategyKey, Value: WeightedRankerName},
+		{Key: RankParamsKey, Value: `{"weights": [0.3, 0.7]}`},
+	}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, WeightedRankerName, ranker.name())
+	assert.Equal(t, []float32{0.3, 0.7}, ranker.(*weightedRanker).weights)
+
+	invalidParams := [][]*commonpb.KeyValuePair{
+		{{Key: RankStrategyKey, Value: "unknown"}},
+		{{Key: RankParamsKey, Value: "not json"}},
+		{{Key: RankParamsKey, Value: `{"k": 0}`}},
+		{{Key: RankParamsKey, Value: `{"k": "10"}`}},
+		{{Key: RankParamsKey, Value: `{"k": 16384}`}},
+		{{Key: RankStrategyKey, Value: WeightedRankerName}},
+		{{Key: RankStrategyKey, Value: WeightedRankerName}, {Key: RankParamsKey, Value: `{"weights": [0.3]}`}},
+		{{Key: RankStrategyKey, Value: WeightedRankerName}, {Key: RankParamsKey, Value: `{"weights": [0.3, 1.5]}`}},
+		{{Key: RankStrategyKey, Value: WeightedRankerName}, {Key: RankParamsKey, Value: `{"weights": [0.3, "a"]}`}},
+	}
+	for _, params := range invalidParams {
+		_, err = newReranker(params, 2)
+		assert.Error(t, err)
+	}
+}
+
+func TestParseRankLimit(t *testing.T) {
+	limit, offset, err := parseRankLimit([]*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), limit)
+	assert.Equal(t, int64(0), offset)
+
+	limit, offset, err = parseRankLimit([]*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "5"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), limit)
+	assert.Equal(t, int64(5), offset)
+
+	invalidParams := [][]*commonpb.KeyValuePair{
+		nil,
+		{{Key: LimitKey, Value: "a"}},
+		{{Key: LimitKey, Value: "0"}},
+		{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "-1"}},
+		{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "16384"}},
+	}
+	for _, params := range invalidParams {
+		_, _, err = parseRankLimit(params)
+		assert.Error(t, err)
+	}
+}
+
+func TestNormalizeScore(t *testing.T) {
+	assert.Equal(t, float32(0.5), normalizeScore(0, distance.IP))
+	assert.True(t, normalizeScore(10, distance.IP) > normalizeScore(1, distance.IP))
+	assert.Equal(t, float32(1), normalizeScore(0, distance.L2))
+	assert.True(t, normalizeScore(10, distance.L2) < normalizeScore(1, distance.L2))
+	assert.True(t, normalizeScore(1e10, distance.L2) >= 0)
+}
+
+func constructRerankSearchResult(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+	data := make([]int64, len(ids))
+	copy(data, ids)
+	return &schemapb.SearchResultData{
+		NumQueries: int64(len(topks)),
+		Topks:      topks,
+		Scores:     scores,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: data,
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:      schemapb.DataType_Int64,
+				FieldName: "id",
+				FieldId:   100,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{
+								Data: ids,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRerankSearchResults(t *testing.T) {
+	// two queries, the first result has hits 1, 2, 3 and 4, 5, the second one has hits 2, 1 and 6
+	results := []*schemapb.SearchResultData{
+		constructRerankSearchResult([]int64{1, 2, 3, 4, 5}, []float32{0.9, 0.8, 0.7, 0.9, 0.8}, []int64{3, 2}),
+		constructRerankSearchResult([]int64{2, 1, 6}, []float32{1, 2, 1}, []int64{2, 1}),
+	}
+	metricTypes := []string{distance.IP, distance.L2}
+
+	t.Run("rrf", func(t *testing.T) {
+		ret, err := rerankSearchResults(&rrfRanker{k: 60}, results, metricTypes, 2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, ret.Status.ErrorCode)
+		assert.Equal(t, int64(2), ret.Results.NumQueries)
+		assert.Equal(t, []int64{2, 2}, ret.Results.Topks)
+		// 1 and 2 both get 1/61+1/62, 1 appears first
+		assert.Equal(t, []int64{1, 2, 4, 6}, ret.Results.Ids.GetIntId().Data)
+		assert.InDelta(t, 1.0/61+1.0/62, ret.Results.Scores[0], 1e-6)
+		assert.InDelta(t, 1.0/61, ret.Results.Scores[2], 1e-6)
+		assert.Equal(t, []int64{1, 2, 4, 6}, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+		assert.Equal(t, schemapb.DataType_Int64, ret.Results.FieldsData[0].Type)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		ret, err := rerankSearchResults(&weightedRanker{weights: []float32{0, 1}}, results, metricTypes, 2, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 2}, ret.Results.Topks)
+		// only the second result counts, the smaller the distance the higher the score
+		assert.Equal(t, []int64{1, 3, 4, 5}, ret.Results.Ids.GetIntId().Data)
+		assert.InDelta(t, normalizeScore(2, distance.L2), ret.Results.Scores[0], 1e-6)
+		assert.InDelta(t, 0, ret.Results.Scores[1], 1e-6)
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		empty := &schemapb.SearchResultData{NumQueries: 2, Topks: []int64{0, 0}}
+		ret, err := rerankSearchResults(&rrfRanker{k: 60}, []*schemapb.SearchResultData{empty, results[1]}, metricTypes, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, ret.Results.Topks)
+		assert.Equal(t, []int64{2, 1, 6}, ret.Results.Ids.GetIntId().Data)
+	})
+
+	t.Run("string ids", func(t *testing.T) {
+		strResults := []*schemapb.SearchResultData{
+			constructRerankSearchResult([]int64{1, 2, 3, 4, 5}, []float32{0.9, 0.8, 0.7, 0.9, 0.8}, []int64{3, 2}),
+			constructRerankSearchResult([]int64{2, 1, 6}, []float32{1, 2, 1}, []int64{2, 1}),
+		}
+		for _, result := range strResults {
+			strIDs := make([]string, 0)
+			for _, id := range result.GetIds().GetIntId().GetData() {
+				strIDs = append(strIDs, fmt.Sprintf("pk%d", id))
+			}
+			result.Ids = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: strIDs}}}
+		}
+		ret, err := rerankSearchResults(&rrfRanker{k: 60}, strResults, metricTypes, 2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 2}, ret.Results.Topks)
+		assert.Equal(t, []string{"pk1", "pk2", "pk4", "pk6"}, ret.Results.Ids.GetStrId().Data)
+		assert.InDelta(t, 1.0/61+1.0/62, ret.Results.Scores[0], 1e-6)
+		assert.Equal(t, []int64{1, 2, 4, 6}, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+	})
+
+	t.Run("fields in different order", func(t *testing.T) {
+		genField := func(id int64, data []int64) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type:    schemapb.DataType_Int64,
+				FieldId: id,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+					},
+				},
+			}
+		}
+		first := constructRerankSearchResult([]int64{1, 2}, []float32{0.9, 0.8}, []int64{2})
+		first.FieldsData = []*schemapb.FieldData{genField(100, []int64{1, 2}), genField(101, []int64{10, 20})}
+		second := constructRerankSearchResult([]int64{3, 1}, []float32{1, 2}, []int64{2})
+		second.FieldsData = []*schemapb.FieldData{genField(101, []int64{30, 10}), genField(100, []int64{3, 1})}
+
+		ret, err := rerankSearchResults(&rrfRanker{k: 60}, []*schemapb.SearchResultData{first, second}, metricTypes, 10, 0)
+		assert.NoError(t, err)
+		// 1 gets 1/61+1/62, 3 gets 1/61 and 2 gets 1/62
+		assert.Equal(t, []int64{1, 3, 2}, ret.Results.Ids.GetIntId().Data)
+		assert.Equal(t, int64(100), ret.Results.FieldsData[0].FieldId)
+		assert.Equal(t, []int64{1, 3, 2}, ret.Results.FieldsData[0].GetScalars().GetLongData().Data)
+		assert.Equal(t, int64(101), ret.Results.FieldsData[1].FieldId)
+		assert.Equal(t, []int64{10, 30, 20}, ret.Results.FieldsData[1].GetScalars().GetLongData().Data)
+
+		// a hit must carry all the output fields
+		second.FieldsData = second.FieldsData[:1]
+		_, err = rerankSearchResults(&rrfRanker{k: 60}, []*schemapb.SearchResultData{first, second}, metricTypes, 10, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid results", func(t *testing.T) {
+		_, err := rerankSearchResults(&rrfRanker{k: 60}, nil, nil, 10, 0)
+		assert.Error(t, err)
+
+		_, err = rerankSearchResults(&rrfRanker{k: 60}, []*schemapb.SearchResultData{results[0], {NumQueries: 1}}, metricTypes, 10, 0)
+		assert.Error(t, err)
+
+		incomplete := constructRerankSearchResult([]int64{1}, []float32{0.9}, []int64{1, 1})
+		_, err = rerankSearchResults(&rrfRanker{k: 60}, []*schemapb.SearchResultData{incomplete}, metricTypes, 10, 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// queryNodeCreatorFunc creates the client of the query node listening on the address
+type queryNodeCreatorFunc func(ctx context.Context, addr string) (types.QueryNode, error)
+
+// shardClientMgr caches the clients of the query nodes which search and query are sent to by grpc
+type shardClientMgr struct {
+	mu      sync.Mutex
+	clients map[string]types.QueryNode
+	creator queryNodeCreatorFunc
+}
+
+func newShardClientMgr(creator queryNodeCreatorFunc) *shardClientMgr {
+	return &shardClientMgr{
+		clients: make(map[string]types.QueryNode),
+		creator: creator,
+	}
+}
+
+// getClient returns the client of the query node, the client is created on first use
+func (mgr *shardClientMgr) getClient(ctx context.Context, addr string) (types.QueryNode, error) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if client, ok := mgr.clients[addr]; ok {
+		return client, nil
+	}
+	if mgr.creator == nil {
+		return nil, errors.New("the creator of query node clients is not set")
+	}
+	client, err := mgr.creator(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Init(); err != nil {
+		return nil, err
+	}
+	if err = client.Start(); err != nil {
+		return nil, err
+	}
+	mgr.clients[addr] = client
+	return client, nil
+}
+
+// removeClient stops and removes the cached client of the query node, e.g. when the query node goes offline
+func (mgr *shardClientMgr) removeClient(addr string) {
+	mgr.mu.Lock()
+	client, ok := mgr.clients[addr]
+	delete(mgr.clients, addr)
+	mgr.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	if err := client.Stop(); err != nil {
+		log.Warn("failed to stop query node client", zap.String("address", addr), zap.Error(err))
+	}
+}
+
+// close stops all the cached clients
+func (mgr *shardClientMgr) close() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for addr, client := range mgr.clients {
+		if err := client.Stop(); err != nil {
+			log.Warn("failed to stop query node client", zap.String("address", addr), zap.Error(err))
+		}
+	}
+	mgr.clients = make(map[string]types.QueryNode)
+}
@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// queryNodeMock serves search and query by the mocked functions, the other methods of types.QueryNode are not implemented
+type queryNodeMock struct {
+	types.QueryNode
+	stopped   bool
+	searchErr error
+	queryErr  error
+
+	searchFunc func(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error)
+	queryFunc  func(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error)
+}
+
+func (m *queryNodeMock) Init() error {
+	return nil
+}
+
+func (m *queryNodeMock) Start() error {
+	return nil
+}
+
+func (m *queryNodeMock) Stop() error {
+	m.stopped = true
+	return nil
+}
+
+func (m *queryNodeMock) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	if m.searchErr != nil {
+		return nil, m.searchErr
+	}
+	return m.searchFunc(ctx, req)
+}
+
+func (m *queryNodeMock) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	if m.queryErr != nil {
+		return nil, m.queryErr
+	}
+	return m.queryFunc(ctx, req)
+}
+
+func newMockShardClientMgr(nodes map[string]*queryNodeMock) *shardClientMgr {
+	return newShardClientMgr(func(ctx context.Context, addr string) (types.QueryNode, error) {
+		node, ok := nodes[addr]
+		if !ok {
+			return nil, errors.New("query node not found")
+		}
+		return node, nil
+	})
+}
+
+func TestShardClientMgr(t *testing.T) {
+	ctx := context.Background()
+	created := 0
+	node := &queryNodeMock{}
+	mgr := newShardClientMgr(func(ctx context.Context, addr string) (types.QueryNode, error) {
+		if addr == "" {
+			return nil, errors.New("addr is empty")
+		}
+		created++
+		return node, nil
+	})
+
+	client, err := mgr.getClient(ctx, "localhost:21123")
+	assert.NoError(t, err)
+	assert.Equal(t, node, client)
+
+	// the client is cached
+	_, err = mgr.getClient(ctx, "localhost:21123")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created)
+
+	_, err = mgr.getClient(ctx, "")
+	assert.Error(t, err)
+
+	// the removed client is stopped and recreated on next use
+	mgr.removeClient("localhost:21123")
+	assert.True(t, node.stopped)
+	assert.Equal(t, 0, len(mgr.clients))
+	mgr.removeClient("localhost:21123")
+	node.stopped = false
+	_, err = mgr.getClient(ctx, "localhost:21123")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created)
+
+	mgr.close()
+	assert.True(t, node.stopped)
+	assert.Equal(t, 0, len(mgr.clients))
+
+	// the creator is set by the grpc server of proxy
+	_, err = newShardClientMgr(nil).getClient(ctx, "localhost:21123")
+	assert.Error(t, err)
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -28,6 +29,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -37,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -68,6 +72,7 @@ const (
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	RoundDecimalKey                 = "round_decimal"
+	OffsetKey                       = "offset"
 	HasCollectionTaskName           = "HasCollectionTask"
 	DescribeCollectionTaskName      = "DescribeCollectionTask"
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
@@ -88,12 +93,20 @@ const (
 	LoadPartitionTaskName           = "LoadPartitionsTask"
 	ReleasePartitionTaskName        = "ReleasePartitionsTask"
 	deleteTaskName                  = "DeleteTask"
+	upsertTaskName                  = "UpsertTask"
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	AddFieldTaskName                = "AddFieldTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
+
+	// maxPaginationWindow is the upper limit of offset+limit of a query or offset+topk of a search.
+	maxPaginationWindow = 16384
 )
 
 type task interface {
@@ -135,6 +148,8 @@ type insertTask struct {
 	vChannels      []vChan
 	pChannels      []pChan
 	schema         *schemapb.CollectionSchema
+	// hash values of the partition key of the rows, nil if the collection has no partition key
+	partitionKeyHashes []uint32
 }
 
 // TraceCtx returns insertTask context
@@ -192,7 +207,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +258,32 @@ func getNumRowsOfBinaryVectorField(bDatas []byte, dim int64) (uint32, error) {
 	return uint32(int((8 * int64(l)) / dim)), nil
 }
 
+// fillDefaultFieldsData appends the default values of the fields which are added after
+// the collection is created and absent in the request
+func (it *insertTask) fillDefaultFieldsData() error {
+	for _, field := range it.schema.Fields {
+		if field.GetDefaultValue() == nil {
+			continue
+		}
+		exist := false
+		for _, fieldData := range it.req.FieldsData {
+			if fieldData.FieldName == field.Name {
+				exist = true
+				break
+			}
+		}
+		if exist {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(it.req.NumRows))
+		if err != nil {
+			return err
+		}
+		it.req.FieldsData = append(it.req.FieldsData, fieldData)
+	}
+	return nil
+}
+
 func (it *insertTask) checkLengthOfFieldsData() error {
 	neededFieldsNum := 0
 	for _, field := range it.schema.Fields {
@@ -300,9 +341,18 @@ func (it *insertTask) checkRowNums() error {
 					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
 				}
 			case *schemapb.ScalarField_BytesData:
-				return errUnsupportedDType("bytes")
+				if field.Type != schemapb.DataType_JSON {
+					return errUnsupportedDType("bytes")
+				}
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetBytesData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case *schemapb.ScalarField_StringData:
-				return errUnsupportedDType("string")
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetStringData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case nil:
 				continue
 			default:
@@ -453,9 +503,22 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					return err
 				}
 			case *schemapb.ScalarField_BytesData:
-				return errors.New("bytes field is not supported now")
+				if field.Type != schemapb.DataType_JSON {
+					return errors.New("bytes field is not supported now")
+				}
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetBytesData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetStringData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case nil:
 				continue
 			default:
@@ -554,6 +617,24 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_String:
+				// string is encoded as its uint32 byte length followed by the bytes
+				d := datas[j][i].(string)
+				err := binary.Write(&buffer, endian, uint32(len(d)))
+				if err != nil {
+					log.Warn("ConvertData", zap.Error(err))
+				}
+				buffer.WriteString(d)
+				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_JSON:
+				// json is encoded the same way as string
+				d := datas[j][i].([]byte)
+				err := binary.Write(&buffer, endian, uint32(len(d)))
+				if err != nil {
+					log.Warn("ConvertData", zap.Error(err))
+				}
+				buffer.Write(d)
+				blob.Value = append(blob.Value, buffer.Bytes()...)
 			case schemapb.DataType_FloatVector:
 				d := datas[j][i].([]float32)
 				err := binary.Write(&buffer, endian, d)
@@ -621,6 +702,7 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 
 	var primaryField *schemapb.FieldData
 	var primaryData []int64
+	var strPrimaryData []string
 	for _, field := range it.req.FieldsData {
 		if field.FieldName == autoIDFieldName {
 			return fmt.Errorf("autoID field (%v) does not require data", autoIDFieldName)
@@ -631,8 +713,8 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	}
 
 	if primaryField != nil {
-		if primaryField.Type != schemapb.DataType_Int64 {
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		if primaryField.Type != schemapb.DataType_Int64 && primaryField.Type != schemapb.DataType_String {
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 		switch primaryField.Field.(type) {
 		case *schemapb.FieldData_Scalars:
@@ -640,16 +722,23 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 			switch scalarField.Data.(type) {
 			case *schemapb.ScalarField_LongData:
 				primaryData = scalarField.GetLongData().Data
+				it.result.IDs.IdField = &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: primaryData,
+					},
+				}
+			case *schemapb.ScalarField_StringData:
+				strPrimaryData = scalarField.GetStringData().Data
+				it.result.IDs.IdField = &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: strPrimaryData,
+					},
+				}
 			default:
-				return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+				return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 			}
 		default:
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-		}
-		it.result.IDs.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: primaryData,
-			},
+			return fmt.Errorf("currently only support DataType Int64 or String as PrimaryField")
 		}
 	}
 
@@ -692,6 +781,8 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 			},
 		}
 		it.HashPK(it.BaseInsertTask.RowIDs)
+	} else if strPrimaryData != nil {
+		it.HashStringPK(strPrimaryData)
 	} else {
 		it.HashPK(primaryData)
 	}
@@ -716,6 +807,169 @@ func (it *insertTask) HashPK(pks []int64) {
 	}
 }
 
+// HashStringPK computes the hash values of string primary keys
+func (it *insertTask) HashStringPK(pks []string) {
+	if len(it.HashValues) != 0 {
+		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
+	}
+	it.HashValues = make([]uint32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32String(pk)
+		it.HashValues = append(it.HashValues, uint32(hash))
+	}
+}
+
+// checkMaxLengthOfStringFields checks the byte length of string values don't exceed max_length of the fields
+func (it *insertTask) checkMaxLengthOfStringFields() error {
+	for _, field := range it.schema.Fields {
+		if field.DataType != schemapb.DataType_String {
+			continue
+		}
+		maxLength, err := getMaxLength(field)
+		if err != nil {
+			return err
+		}
+		for _, fieldData := range it.req.FieldsData {
+			if fieldData.FieldName != field.Name {
+				continue
+			}
+			for _, str := range fieldData.GetScalars().GetStringData().GetData() {
+				if int64(len(str)) > maxLength {
+					return fmt.Errorf("the length (%d) of string exceeds max_length (%d) of field %s", len(str), maxLength, field.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkJSONFields checks every value of json fields is a valid json object
+func (it *insertTask) checkJSONFields() error {
+	for _, field := range it.schema.Fields {
+		if field.DataType != schemapb.DataType_JSON {
+			continue
+		}
+		for _, fieldData := range it.req.FieldsData {
+			if fieldData.FieldName != field.Name {
+				continue
+			}
+			for i, doc := range fieldData.GetScalars().GetBytesData().GetData() {
+				var obj map[string]interface{}
+				if err := json.Unmarshal(doc, &obj); err != nil {
+					return fmt.Errorf("the value of row %d of field %s is not a valid json object: %w", i, field.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hashPartitionKeys computes the hash values of the partition key of the rows if the collection has partition key
+func (it *insertTask) hashPartitionKeys() error {
+	keyField := typeutil.GetPartitionKeyField(it.schema)
+	if keyField == nil {
+		return nil
+	}
+	if it.req.GetPartitionName() != "" {
+		return fmt.Errorf("partition name %s can't be specified for collection %s with partition key",
+			it.req.GetPartitionName(), it.CollectionName)
+	}
+	for _, fieldData := range it.req.FieldsData {
+		if fieldData.FieldName != keyField.Name {
+			continue
+		}
+		hashes, err := hashPartitionKeyData(fieldData)
+		if err != nil {
+			return err
+		}
+		if len(hashes) != int(it.req.NumRows) {
+			return fmt.Errorf("the number of values (%d) of partition key %s is not equal to the number of rows (%d)",
+				len(hashes), keyField.Name, it.req.NumRows)
+		}
+		it.partitionKeyHashes = hashes
+		return nil
+	}
+	return fmt.Errorf("partition key field %s is not provided", keyField.Name)
+}
+
+// repackByPartitionKey splits the rows into insert messages by the partitions their partition key hashed to,
+// the rows go to a single message of the target partition if the collection has no partition key.
+func (it *insertTask) repackByPartitionKey(ctx context.Context) ([]*msgstream.InsertMsg, error) {
+	if it.partitionKeyHashes == nil {
+		return []*msgstream.InsertMsg{&it.BaseInsertTask}, nil
+	}
+	partitionNames, partitionsMap, err := getPartitionKeyPartitions(ctx, it.DbName, it.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	return it.splitByPartitionKey(partitionNames, partitionsMap)
+}
+
+// splitByPartitionKey splits the rows into insert messages, one for each partition
+func (it *insertTask) splitByPartitionKey(partitionNames []string, partitionsMap map[string]UniqueID) ([]*msgstream.InsertMsg, error) {
+	if len(partitionNames) == 0 {
+		return nil, fmt.Errorf("collection %s has no partition", it.CollectionName)
+	}
+	msgs := make([]*msgstream.InsertMsg, len(partitionNames))
+	for i, hash := range it.partitionKeyHashes {
+		idx := hash % uint32(len(partitionNames))
+		msg := msgs[idx]
+		if msg == nil {
+			msg = &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx: it.BaseMsg.Ctx,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           it.Base,
+					DbName:         it.DbName,
+					CollectionName: it.CollectionName,
+					PartitionName:  partitionNames[idx],
+					DbID:           it.DbID,
+					CollectionID:   it.CollectionID,
+					PartitionID:    partitionsMap[partitionNames[idx]],
+					SchemaVersion:  it.SchemaVersion,
+				},
+			}
+			msgs[idx] = msg
+		}
+		msg.HashValues = append(msg.HashValues, it.HashValues[i])
+		msg.Timestamps = append(msg.Timestamps, it.Timestamps[i])
+		msg.RowIDs = append(msg.RowIDs, it.RowIDs[i])
+		msg.RowData = append(msg.RowData, it.RowData[i])
+	}
+	result := make([]*msgstream.InsertMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg != nil {
+			result = append(result, msg)
+		}
+	}
+	return result, nil
+}
+
+// assignSegmentIDByPartition assigns segments to the rows partition by partition
+func (it *insertTask) assignSegmentIDByPartition(ctx context.Context, stream msgstream.MsgStream) (*msgstream.MsgPack, error) {
+	msgs, err := it.repackByPartitionKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pack := &msgstream.MsgPack{
+		BeginTs: it.BeginTs(),
+		EndTs:   it.EndTs(),
+	}
+	for _, msg := range msgs {
+		assigned, err := it._assignSegmentID(stream, &msgstream.MsgPack{
+			BeginTs: it.BeginTs(),
+			EndTs:   it.EndTs(),
+			Msgs:    []msgstream.TsMsg{msg},
+		})
+		if err != nil {
+			return nil, err
+		}
+		pack.Msgs = append(pack.Msgs, assigned.Msgs...)
+	}
+	return pack, nil
+}
+
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-PreExecute")
 	defer sp.Finish()
@@ -742,23 +996,44 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.BaseInsertTask.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
 	}
 	it.schema = collSchema
+	it.SchemaVersion = collSchema.GetVersion()
+
+	err = it.fillDefaultFieldsData()
+	if err != nil {
+		return err
+	}
 
 	err = it.checkRowNums()
 	if err != nil {
 		return err
 	}
 
+	err = it.checkMaxLengthOfStringFields()
+	if err != nil {
+		return err
+	}
+
+	err = it.checkJSONFields()
+	if err != nil {
+		return err
+	}
+
 	err = it.checkFieldAutoIDAndHashPK()
 	if err != nil {
 		return err
 	}
 
+	err = it.hashPartitionKeys()
+	if err != nil {
+		return err
+	}
+
 	err = it.transferColumnBasedRequestToRowBasedData()
 	if err != nil {
 		return err
@@ -792,6 +1067,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 	}
 	log.Debug("_assignSemgentID, produceChannels:", zap.Any("Channels", channelNames))
 
+	partitionID := it.PartitionID
 	for i, request := range tsMsgs {
 		if request.Type() != commonpb.MsgType_Insert {
 			return nil, fmt.Errorf("msg's must be Insert")
@@ -800,6 +1076,11 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if !ok {
 			return nil, fmt.Errorf("msg's must be Insert")
 		}
+		if i == 0 {
+			partitionID = insertRequest.PartitionID
+		} else if insertRequest.PartitionID != partitionID {
+			return nil, fmt.Errorf("msg's must belong to the same partition")
+		}
 
 		keys := hashKeys[i]
 		timestampLen := len(insertRequest.Timestamps)
@@ -833,7 +1114,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 		if channelName == "" {
 			return nil, fmt.Errorf("proxy, repack_func, can not found channelName")
 		}
-		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, it.PartitionID, channelName, count, ts)
+		mapInfo, err := it.segIDAssigner.GetSegmentID(it.CollectionID, partitionID, channelName, count, ts)
 		if err != nil {
 			log.Debug("insertTask.go", zap.Any("MapInfo", mapInfo),
 				zap.Error(err))
@@ -945,6 +1226,7 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 					PartitionName:  partitionName,
 					SegmentID:      segmentID,
 					ShardName:      channelNames[key],
+					SchemaVersion:  it.SchemaVersion,
 				}
 				insertMsg := &msgstream.InsertMsg{
 					BaseMsg: msgstream.BaseMsg{
@@ -991,35 +1273,30 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("done")
 
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.BaseInsertTask.DbName, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
-	var partitionID UniqueID
-	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
-		if err != nil {
-			return err
-		}
-	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName)
-		if err != nil {
-			return err
+	// the partitions of a collection with partition key are chosen row by row
+	if it.partitionKeyHashes == nil {
+		var partitionID UniqueID
+		if len(it.PartitionName) > 0 {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, it.BaseInsertTask.DbName, collectionName, it.PartitionName)
+			if err != nil {
+				return err
+			}
+		} else {
+			partitionID, err = globalMetaCache.GetPartitionID(ctx, it.BaseInsertTask.DbName, collectionName, Params.CommonCfg.DefaultPartitionName)
+			if err != nil {
+				return err
+			}
 		}
+		it.PartitionID = partitionID
 	}
-	it.PartitionID = partitionID
 	tr.Record("get collection id & partition id from cache")
 
-	var tsMsg msgstream.TsMsg = &it.BaseInsertTask
 	it.BaseMsg.Ctx = ctx
-	msgPack := msgstream.MsgPack{
-		BeginTs: it.BeginTs(),
-		EndTs:   it.EndTs(),
-		Msgs:    make([]msgstream.TsMsg, 1),
-	}
-
-	msgPack.Msgs[0] = tsMsg
 
 	stream, err := it.chMgr.getDMLStream(collID)
 	if err != nil {
@@ -1040,7 +1317,7 @@ func (it *insertTask) Execute(ctx context.Context) error {
 
 	// Assign SegmentID
 	var pack *msgstream.MsgPack
-	pack, err = it._assignSegmentID(stream, &msgPack)
+	pack, err = it.assignSegmentIDByPartition(ctx, stream)
 	if err != nil {
 		return err
 	}
@@ -1149,11 +1426,24 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := validatePartitionKey(cct.schema, cct.NumPartitions); err != nil {
+		return err
+	}
+
+	if err := validateClusteringKey(cct.schema); err != nil {
+		return err
+	}
+
 	// validate field name
 	for _, field := range cct.schema.Fields {
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
+		if field.DataType == schemapb.DataType_String {
+			if err := validateMaxLength(field); err != nil {
+				return err
+			}
+		}
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			exist := false
 			var dim int64
@@ -1187,6 +1477,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if _, err := funcutil.GetCollectionTTL(cct.Properties); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1208,6 +1502,8 @@ type dropCollectionTask struct {
 	result    *commonpb.Status
 	chMgr     channelsMgr
 	chTicker  channelsTimeTicker
+
+	collectionID UniqueID // id of the dropped collection, set by Execute
 }
 
 func (dct *dropCollectionTask) TraceCtx() context.Context {
@@ -1258,10 +1554,11 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
 	if err != nil {
 		return err
 	}
+	dct.collectionID = collID
 
 	dct.result, err = dct.rootCoord.DropCollection(ctx, dct.DropCollectionRequest)
 	if err != nil {
@@ -1270,12 +1567,13 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 
 	_ = dct.chMgr.removeDMLStream(collID)
 	_ = dct.chMgr.removeDQLStream(collID)
+	globalMetaCache.ClearReplicas(collID)
 
 	return nil
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
 	return nil
 }
 
@@ -1340,6 +1638,10 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
+
+	rangeSearchParams *planpb.RangeSearchParams
+	offset            int64
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1382,7 +1684,7 @@ func (st *searchTask) OnEnqueue() error {
 }
 
 func (st *searchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1401,7 +1703,7 @@ func (st *searchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *searchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1426,7 +1728,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyCfg.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1477,7 +1779,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, collectionName)
 
 	outputFields, err := translateOutputFields(st.query.OutputFields, schema, false)
 	if err != nil {
@@ -1501,6 +1803,19 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
 
+		offset := 0
+		offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, st.query.SearchParams)
+		if err == nil {
+			offset, err = strconv.Atoi(offsetStr)
+			if err != nil || offset < 0 {
+				return errors.New(OffsetKey + " " + offsetStr + " is not invalid")
+			}
+			if topK+offset > maxPaginationWindow {
+				return fmt.Errorf("%s+%s(%d) should not be larger than %d", TopKKey, OffsetKey, topK+offset, maxPaginationWindow)
+			}
+		}
+		st.offset = int64(offset)
+
 		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
 		if err != nil {
 			return errors.New(MetricTypeKey + " not found in search_params")
@@ -1523,11 +1838,19 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(RoundDecimalKey + " " + roundDecimalStr + " is not invalid")
 		}
 
+		rangeSearchParams, err := parseRangeSearchParams(searchParams, metricType)
+		if err != nil {
+			return err
+		}
+		st.rangeSearchParams = rangeSearchParams
+
+		// query nodes search the hits skipped by offset as well
 		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
-			MetricType:   metricType,
-			SearchParams: searchParams,
-			RoundDecimal: int64(roundDecimal),
+			Topk:              int64(topK + offset),
+			MetricType:        metricType,
+			SearchParams:      searchParams,
+			RoundDecimal:      int64(roundDecimal),
+			RangeSearchParams: rangeSearchParams,
 		}
 
 		log.Debug("create query plan",
@@ -1547,6 +1870,9 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 			return fmt.Errorf("failed to create query plan: %v", err)
 		}
+		if err := st.prunePartitionsByPartitionKey(ctx, schema, plan.GetVectorAnns().GetPredicates()); err != nil {
+			return err
+		}
 		for _, name := range st.query.OutputFields {
 			hitField := false
 			for _, field := range schema.Fields {
@@ -1567,6 +1893,11 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			}
 		}
 
+		plan.ExpireTimestamp, err = getExpireTimestamp(ctx, st.query.DbName, collectionName, st.BeginTs())
+		if err != nil {
+			return err
+		}
+
 		st.SearchRequest.DslType = commonpb.DslType_BoolExprV1
 		st.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
 		if err != nil {
@@ -1600,8 +1931,12 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	st.SearchRequest.DbID = 0 // todo
 	st.SearchRequest.CollectionID = collID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
+	st.SearchRequest.ReplicaID, err = selectReplica(ctx, st.qc, collID)
+	if err != nil {
+		return err
+	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -1635,6 +1970,23 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// prunePartitionsByPartitionKey searches only the partitions the partition key pinned by the expr hashed to
+func (st *searchTask) prunePartitionsByPartitionKey(ctx context.Context, schema *schemapb.CollectionSchema, expr *planpb.Expr) error {
+	keyField := typeutil.GetPartitionKeyField(schema)
+	if keyField == nil {
+		return nil
+	}
+	if len(st.query.PartitionNames) > 0 {
+		return fmt.Errorf("partition names can't be specified for collection %s with partition key", st.query.CollectionName)
+	}
+	partitionNames, _, err := getPartitionKeyPartitions(ctx, st.query.DbName, st.query.CollectionName)
+	if err != nil {
+		return err
+	}
+	st.query.PartitionNames, err = prunePartitionsByPartitionKey(expr, keyField, partitionNames)
+	return err
+}
+
 func (st *searchTask) Execute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-Execute")
 	defer sp.Finish()
@@ -1642,6 +1994,20 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", st.ID()))
 	defer tr.Elapse("done")
 
+	if Params.ProxyCfg.SearchByGrpc {
+		err := st.searchShards(ctx)
+		if err == nil {
+			return nil
+		}
+		// the cached replicas or shard leaders may be stale, they are refetched and the request is sent by msgstream
+		log.Warn("failed to search by grpc, fall back to msgstream",
+			zap.Int64("collectionID", st.CollectionID), zap.Int64("msgID", st.ID()), zap.Error(err))
+		globalMetaCache.ClearReplicas(st.CollectionID)
+		if st.SearchRequest.ReplicaID, err = selectReplica(ctx, st.qc, st.CollectionID); err != nil {
+			return err
+		}
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1659,7 +2025,7 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1703,59 +2069,218 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	return err
 }
 
-func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
-	tr := timerecord.NewTimeRecorder("decodeSearchResults")
-	log.Debug("decodeSearchResults", zap.Any("lenOfSearchResults", len(searchResults)))
+// searchShards sends the request to the query nodes serving the replica by grpc, the results are passed to
+// PostExecute only if they cover all the dmChannels and sealed segments of the collection.
+func (st *searchTask) searchShards(ctx context.Context) error {
+	leaders, err := globalMetaCache.GetShardLeaders(ctx, st.qc, st.CollectionID, st.ReplicaID)
+	if err != nil {
+		return err
+	}
+	vchans, err := st.getVChannels()
+	if err != nil {
+		return err
+	}
 
-	results := make([]*schemapb.SearchResultData, 0)
-	// necessary to parallel this?
-	for i, partialSearchResult := range searchResults {
-		log.Debug("decodeSearchResults", zap.Any("i", i), zap.Any("len(SlicedBob)", len(partialSearchResult.SlicedBlob)))
-		if partialSearchResult.SlicedBlob == nil {
-			continue
-		}
+	results := make([]*internalpb.SearchResults, len(leaders.NodeAddrs))
+	errs := make([]error, len(leaders.NodeAddrs))
+	var wg sync.WaitGroup
+	for i, addr := range leaders.NodeAddrs {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+			client, err := st.shardMgr.getClient(ctx, addr)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i], errs[i] = client.Search(ctx, &querypb.SearchRequest{Req: st.SearchRequest})
+			if errs[i] != nil {
+				// the query node may be offline, the client is recreated on next use
+				st.shardMgr.removeClient(addr)
+			}
+		}(i, addr)
+	}
+	wg.Wait()
 
-		var partialResultData schemapb.SearchResultData
-		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
-		log.Debug("decodeSearchResults, Unmarshal partitalSearchResult.SliceBlob", zap.Error(err))
-		if err != nil {
-			return nil, err
+	resultBuf := newSearchResultBuf(st.ID())
+	for _, vchan := range vchans {
+		resultBuf.usedVChans[vchan] = struct{}{}
+	}
+	for i, result := range results {
+		if errs[i] != nil {
+			return fmt.Errorf("failed to search on query node %d: %s", leaders.NodeIds[i], errs[i].Error())
 		}
-
-		results = append(results, &partialResultData)
+		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("failed to search on query node %d: %s", leaders.NodeIds[i], result.GetStatus().GetReason())
+		}
+		resultBuf.addPartialResult(result)
 	}
-	log.Debug("decodeSearchResults", zap.Any("lenOfResults", len(results)))
-	tr.Elapse("done")
+	if !resultBuf.readyToReduce() {
+		return fmt.Errorf("search results don't cover all the shards of collection %d", st.CollectionID)
+	}
+	log.Debug("proxy searched by grpc",
+		zap.Int64("collectionID", st.CollectionID),
+		zap.Int64("msgID", st.ID()),
+		zap.Int64("replicaID", st.ReplicaID),
+		zap.Int64s("nodeIDs", leaders.NodeIds))
 
-	return results, nil
+	// resultBuf is unbuffered and read by PostExecute
+	go func() {
+		select {
+		case st.resultBuf <- resultBuf.resultBuf:
+		case <-st.TraceCtx().Done():
+		}
+	}()
+	return nil
 }
 
-func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64) error {
-	if data.NumQueries != nq {
-		return fmt.Errorf("search result's nq(%d) mis-match with %d", data.NumQueries, nq)
+// getExpireTimestamp returns the timestamp before which the entities of the collection are expired at ts,
+// it returns 0 if the collection has no ttl.
+func getExpireTimestamp(ctx context.Context, database string, collectionName string, ts Timestamp) (Timestamp, error) {
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, database, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	if collInfo.ttl <= 0 {
+		return 0, nil
+	}
+	physicalTime, _ := tsoutil.ParseTS(ts)
+	return tsoutil.ComposeTSByTime(physicalTime.Add(-collInfo.ttl), 0), nil
+}
+
+// parseRangeSearchParams parses radius and range_filter from the search params json,
+// it returns nil if radius is not specified, which means a top-k search.
+func parseRangeSearchParams(searchParams string, metricType string) (*planpb.RangeSearchParams, error) {
+	params := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(searchParams), &params); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s, error: %s", SearchParamsKey, searchParams, err.Error())
+	}
+	radiusValue, ok := params[indexparamcheck.RadiusKey]
+	if !ok {
+		if _, ok := params[indexparamcheck.RangeFilterKey]; ok {
+			return nil, fmt.Errorf("%s is specified without %s", indexparamcheck.RangeFilterKey, indexparamcheck.RadiusKey)
+		}
+		return nil, nil
+	}
+	radius, err := strconv.ParseFloat(fmt.Sprint(radiusValue), 32)
+	if err != nil {
+		return nil, fmt.Errorf("%s %v is invalid", indexparamcheck.RadiusKey, radiusValue)
+	}
+	var rangeFilter float64
+	rangeFilterValue, hasRangeFilter := params[indexparamcheck.RangeFilterKey]
+	if hasRangeFilter {
+		rangeFilter, err = strconv.ParseFloat(fmt.Sprint(rangeFilterValue), 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s %v is invalid", indexparamcheck.RangeFilterKey, rangeFilterValue)
+		}
+	}
+	if err := indexparamcheck.CheckRangeSearchParams(metricType, radius, rangeFilter, hasRangeFilter); err != nil {
+		return nil, err
+	}
+	return &planpb.RangeSearchParams{
+		Radius:         float32(radius),
+		RangeFilter:    float32(rangeFilter),
+		HasRangeFilter: hasRangeFilter,
+	}, nil
+}
+
+func decodeSearchResults(searchResults []*internalpb.SearchResults) ([]*schemapb.SearchResultData, error) {
+	tr := timerecord.NewTimeRecorder("decodeSearchResults")
+	log.Debug("decodeSearchResults", zap.Any("lenOfSearchResults", len(searchResults)))
+
+	results := make([]*schemapb.SearchResultData, 0)
+	// necessary to parallel this?
+	for i, partialSearchResult := range searchResults {
+		log.Debug("decodeSearchResults", zap.Any("i", i), zap.Any("len(SlicedBob)", len(partialSearchResult.SlicedBlob)))
+		if partialSearchResult.SlicedBlob == nil {
+			continue
+		}
+
+		var partialResultData schemapb.SearchResultData
+		err := proto.Unmarshal(partialSearchResult.SlicedBlob, &partialResultData)
+		log.Debug("decodeSearchResults, Unmarshal partitalSearchResult.SliceBlob", zap.Error(err))
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &partialResultData)
+	}
+	log.Debug("decodeSearchResults", zap.Any("lenOfResults", len(results)))
+	tr.Elapse("done")
+
+	return results, nil
+}
+
+func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64, rangeSearch bool) error {
+	if data.NumQueries != nq {
+		return fmt.Errorf("search result's nq(%d) mis-match with %d", data.NumQueries, nq)
 	}
 	if data.TopK != topk {
 		return fmt.Errorf("search result's topk(%d) mis-match with %d", data.TopK, topk)
 	}
-	if len(data.Ids.GetIntId().Data) != (int)(nq*topk) {
-		return fmt.Errorf("search result's id length %d invalid", len(data.Ids.GetIntId().Data))
+	numHits := nq * topk
+	if rangeSearch {
+		if int64(len(data.Topks)) != nq {
+			return fmt.Errorf("search result's topks length %d invalid", len(data.Topks))
+		}
+		numHits = 0
+		for _, k := range data.Topks {
+			if k < 0 || k > topk {
+				return fmt.Errorf("search result's topks %d invalid", k)
+			}
+			numHits += k
+		}
+	}
+	if typeutil.GetSizeOfIDs(data.Ids) != (int)(numHits) {
+		return fmt.Errorf("search result's id length %d invalid", typeutil.GetSizeOfIDs(data.Ids))
 	}
-	if len(data.Scores) != (int)(nq*topk) {
+	if len(data.Scores) != (int)(numHits) {
 		return fmt.Errorf("search result's score length %d invalid", len(data.Scores))
 	}
 	return nil
 }
 
-func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []int64, topk int64, qi int64) int {
+// getSearchResultTopks returns the start offset and the number of hits of each query in data.
+// The hits of top-k search are padded to topk per query, while the hits of range search are
+// packed with the number of hits of each query kept in data.Topks.
+func getSearchResultTopks(data *schemapb.SearchResultData, nq int64, topk int64, rangeSearch bool) (starts []int64, topks []int64) {
+	starts = make([]int64, nq)
+	topks = make([]int64, nq)
+	var start int64
+	for i := int64(0); i < nq; i++ {
+		starts[i] = start
+		if rangeSearch {
+			topks[i] = data.Topks[i]
+		} else {
+			topks[i] = topk
+		}
+		start += topks[i]
+	}
+	return starts, topks
+}
+
+// isInvalidPK returns whether pk fills a hit that query nodes found no entity for,
+// which is -1 for int64 primary keys and an empty string for string primary keys
+func isInvalidPK(pk interface{}) bool {
+	switch realPK := pk.(type) {
+	case int64:
+		return realPK == -1
+	case string:
+		return realPK == ""
+	}
+	return true
+}
+
+func selectSearchResultData(dataArray []*schemapb.SearchResultData, starts [][]int64, topks [][]int64, offsets []int64, qi int64) int {
 	sel := -1
 	maxDistance := minFloat32
 	for i, offset := range offsets { // query num, the number of ways to merge
-		if offset >= topk {
+		if offset >= topks[i][qi] {
 			continue
 		}
-		idx := qi*topk + offset
-		id := dataArray[i].Ids.GetIntId().Data[idx]
-		if id != -1 {
+		idx := starts[i][qi] + offset
+		id := typeutil.GetPK(dataArray[i].Ids, idx)
+		if !isInvalidPK(id) {
 			distance := dataArray[i].Scores[idx]
 			if distance > maxDistance {
 				sel = i
@@ -1778,7 +2303,10 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []in
 //	}
 //}
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string) (*milvuspb.SearchResults, error) {
+// reduceSearchResultData merges the search results of query nodes into at most topk hits per query,
+// and drops the first offset hits of each query, the query nodes return topk hits including them.
+// For range search the results hold only the hits within range, so the number of hits differs between queries.
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, offset int64, metricType string, rangeSearch bool) (*milvuspb.SearchResults, error) {
 
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
@@ -1786,7 +2314,8 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}()
 
 	log.Debug("reduceSearchResultData", zap.Int("len(searchResultData)", len(searchResultData)),
-		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType))
+		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.Int64("offset", offset), zap.String("metricType", metricType),
+		zap.Bool("rangeSearch", rangeSearch))
 
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
@@ -1797,16 +2326,24 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			TopK:       topk,
 			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
 			Scores:     make([]float32, 0),
-			Ids: &schemapb.IDs{
-				IdField: &schemapb.IDs_IntId{
-					IntId: &schemapb.LongArray{
-						Data: make([]int64, 0),
-					},
-				},
-			},
-			Topks: make([]int64, 0),
+			Ids:        &schemapb.IDs{},
+			Topks:      make([]int64, 0),
 		},
 	}
+	// the results of a collection with string primary keys hold string ids
+	if searchResultData[0].GetIds().GetStrId() != nil {
+		ret.Results.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	} else {
+		ret.Results.Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	}
 
 	for i, sData := range searchResultData {
 		log.Debug("reduceSearchResultData",
@@ -1814,38 +2351,46 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			zap.Int64("nq", sData.NumQueries),
 			zap.Int64("topk", sData.TopK),
 			zap.Any("len(FieldsData)", len(sData.FieldsData)))
-		if err := checkSearchResultData(sData, nq, topk); err != nil {
+		if err := checkSearchResultData(sData, nq, topk, rangeSearch); err != nil {
 			return ret, err
 		}
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
+	starts := make([][]int64, len(searchResultData))
+	topks := make([][]int64, len(searchResultData))
+	for i, sData := range searchResultData {
+		starts[i], topks[i] = getSearchResultTopks(sData, nq, topk, rangeSearch)
+	}
+
 	var skipDupCnt int64
 	var realTopK int64 = -1
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
-		var idSet = make(map[int64]struct{})
+		var idSet = make(map[interface{}]struct{})
 		var j int64
 		for j = 0; j < topk; {
-			sel := selectSearchResultData(searchResultData, offsets, topk, i)
+			sel := selectSearchResultData(searchResultData, starts, topks, offsets, i)
 			if sel == -1 {
 				break
 			}
-			idx := i*topk + offsets[sel]
+			idx := starts[sel][i] + offsets[sel]
 
-			id := searchResultData[sel].Ids.GetIntId().Data[idx]
+			id := typeutil.GetPK(searchResultData[sel].Ids, idx)
 			score := searchResultData[sel].Scores[idx]
 			// ignore invalid search result
-			if id == -1 {
+			if isInvalidPK(id) {
 				continue
 			}
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
-				ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
+				if j >= offset {
+					typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
+					typeutil.AppendPKs(ret.Results.Ids, id)
+					ret.Results.Scores = append(ret.Results.Scores, score)
+				}
 				idSet[id] = struct{}{}
 				j++
 			} else {
@@ -1854,11 +2399,14 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			}
 			offsets[sel]++
 		}
-		if realTopK != -1 && realTopK != j {
+		if !rangeSearch && realTopK != -1 && realTopK != j-offset {
 			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
 		}
-		realTopK = j
+		realTopK = j - offset
+		if realTopK < 0 {
+			realTopK = 0
+		}
 		ret.Results.Topks = append(ret.Results.Topks, realTopK)
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
@@ -1896,6 +2444,8 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 		select {
 		case <-st.TraceCtx().Done():
 			log.Debug("Proxy searchTask PostExecute Loop exit caused by ctx.Done", zap.Int64("taskID", st.ID()))
+			// the selected replica may be gone, select from the latest replicas next time
+			globalMetaCache.ClearReplicas(st.CollectionID)
 			return fmt.Errorf("searchTask:wait to finish failed, timeout: %d", st.ID())
 		case searchResults := <-st.resultBuf:
 			// fmt.Println("searchResults: ", searchResults)
@@ -1921,6 +2471,7 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 						Reason:    filterReason,
 					},
 				}
+				globalMetaCache.ClearReplicas(st.CollectionID)
 				return fmt.Errorf("no Available QueryNode result, filter reason %s: id %d", filterReason, st.ID())
 			}
 
@@ -1947,12 +2498,15 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 				return nil
 			}
 
-			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
+			reduceStart := time.Now()
+			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK,
+				st.offset, searchResults[0].MetricType, st.rangeSearchParams != nil)
 			if err != nil {
 				return err
 			}
+			metrics.ProxyReduceResultLatency.WithLabelValues(metrics.SearchLabel).Observe(float64(time.Since(reduceStart).Milliseconds()))
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
 			if err != nil {
 				return err
 			}
@@ -1981,7 +2535,9 @@ type queryTask struct {
 	query     *milvuspb.QueryRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
 	ids       *schemapb.IDs
+	pkField   *schemapb.FieldSchema
 }
 
 func (qt *queryTask) TraceCtx() context.Context {
@@ -2022,7 +2578,7 @@ func (qt *queryTask) OnEnqueue() error {
 }
 
 func (qt *queryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2041,7 +2597,7 @@ func (qt *queryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *queryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2065,6 +2621,29 @@ func IDs2Expr(fieldName string, ids []int64) string {
 	return fieldName + " in [ " + idsStr + " ]"
 }
 
+// replicaSelector is increased by every search and query, the requests are spread over the replicas in turn
+var replicaSelector uint64
+
+// selectReplica picks an available replica of the collection to serve the request, it returns 0 if the collection
+// is loaded without replicas, then the request is served by all the query nodes holding the collection.
+// The replicas are cached by globalMetaCache, they are refetched after the requests on them fail.
+func selectReplica(ctx context.Context, qc types.QueryCoord, collectionID UniqueID) (UniqueID, error) {
+	replicas, err := globalMetaCache.GetReplicas(ctx, qc, collectionID)
+	if err != nil {
+		return 0, err
+	}
+	if len(replicas) == 0 {
+		return 0, nil
+	}
+
+	replica := replicas[atomic.AddUint64(&replicaSelector, 1)%uint64(len(replicas))]
+	log.Debug("select replica to serve the request",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("replicaID", replica.ReplicaID),
+		zap.Int64s("nodeIDs", replica.NodeIds))
+	return replica.ReplicaID, nil
+}
+
 func (qt *queryTask) PreExecute(ctx context.Context) error {
 	qt.Base.MsgType = commonpb.MsgType_Retrieve
 	qt.Base.SourceID = Params.ProxyCfg.ProxyID
@@ -2079,7 +2658,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2129,7 +2708,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 
 	if qt.ids != nil {
 		pkField := ""
@@ -2146,10 +2725,49 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf(errMsg)
 	}
 
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			qt.pkField = field
+		}
+	}
+	if err := qt.checkPagination(); err != nil {
+		return err
+	}
+	if qt.query.Cursor != "" {
+		cursor, err := decodeQueryCursor(qt.query.Cursor)
+		if err != nil {
+			return err
+		}
+		if cursor.CollectionID != collectionID {
+			return fmt.Errorf("query cursor doesn't belong to collection %s", collectionName)
+		}
+		expr, err := cursorExpr(qt.pkField, cursor)
+		if err != nil {
+			return err
+		}
+		// the pages of a query share the snapshot of the first page
+		qt.query.Expr = "(" + qt.query.Expr + ") and " + expr
+		qt.query.TravelTimestamp = cursor.TravelTimestamp
+	}
+
 	plan, err := createExprPlan(schema, qt.query.Expr)
 	if err != nil {
 		return err
 	}
+	if keyField := typeutil.GetPartitionKeyField(schema); keyField != nil {
+		if len(qt.query.PartitionNames) > 0 {
+			return fmt.Errorf("partition names can't be specified for collection %s with partition key", collectionName)
+		}
+		partitionNames, _, err := getPartitionKeyPartitions(ctx, qt.query.DbName, collectionName)
+		if err != nil {
+			return err
+		}
+		// only the partitions the partition key pinned by the expr hashed to are queried
+		qt.query.PartitionNames, err = prunePartitionsByPartitionKey(plan.GetPredicates(), keyField, partitionNames)
+		if err != nil {
+			return err
+		}
+	}
 	qt.query.OutputFields, err = translateOutputFields(qt.query.OutputFields, schema, true)
 	if err != nil {
 		return err
@@ -2189,6 +2807,15 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	}
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
+	plan.ExpireTimestamp, err = getExpireTimestamp(ctx, qt.query.DbName, collectionName, qt.BeginTs())
+	if err != nil {
+		return err
+	}
+	if qt.query.Limit > 0 {
+		// every segment only needs to return the offset+limit entities with the smallest primary keys
+		plan.Limit = qt.query.Offset + qt.query.Limit
+	}
+
 	qt.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(plan)
 	if err != nil {
 		return err
@@ -2209,6 +2836,9 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	}
 	qt.TravelTimestamp = travelTimestamp
 	qt.GuaranteeTimestamp = guaranteeTimestamp
+	if qt.query.Limit > 0 {
+		qt.RetrieveRequest.Limit = qt.query.Offset + qt.query.Limit
+	}
 	deadline, ok := qt.TraceCtx().Deadline()
 	if ok {
 		qt.RetrieveRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
@@ -2219,8 +2849,12 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
+	qt.ReplicaID, err = selectReplica(ctx, qt.qc, collectionID)
+	if err != nil {
+		return err
+	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2260,10 +2894,45 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// checkPagination checks offset, limit and cursor of the query request
+func (qt *queryTask) checkPagination() error {
+	offset, limit := qt.query.Offset, qt.query.Limit
+	if offset < 0 || limit < 0 {
+		return fmt.Errorf("offset(%d) and limit(%d) of query should not be negative", offset, limit)
+	}
+	if offset > 0 && limit == 0 {
+		return errors.New("offset of query requires limit")
+	}
+	if offset+limit > maxPaginationWindow {
+		return fmt.Errorf("offset+limit(%d) of query should not be larger than %d", offset+limit, maxPaginationWindow)
+	}
+	if qt.query.Cursor != "" && offset > 0 {
+		return errors.New("offset of query can't be used with cursor")
+	}
+	if (limit > 0 || qt.query.Cursor != "") && qt.pkField == nil {
+		return errors.New("paginated query requires primary field")
+	}
+	return nil
+}
+
 func (qt *queryTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute query %d", qt.ID()))
 	defer tr.Elapse("done")
 
+	if Params.ProxyCfg.SearchByGrpc {
+		err := qt.queryShards(ctx)
+		if err == nil {
+			return nil
+		}
+		// the cached replicas or shard leaders may be stale, they are refetched and the request is sent by msgstream
+		log.Warn("failed to query by grpc, fall back to msgstream",
+			zap.Int64("collectionID", qt.CollectionID), zap.Int64("msgID", qt.ID()), zap.Error(err))
+		globalMetaCache.ClearReplicas(qt.CollectionID)
+		if qt.ReplicaID, err = selectReplica(ctx, qt.qc, qt.CollectionID); err != nil {
+			return err
+		}
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.RetrieveMsg{
 		RetrieveRequest: *qt.RetrieveRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -2314,15 +2983,86 @@ func (qt *queryTask) Execute(ctx context.Context) error {
 	return err
 }
 
-func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+// queryShards sends the request to the query nodes serving the replica by grpc, the results are passed to
+// PostExecute only if they cover all the dmChannels and sealed segments of the collection.
+func (qt *queryTask) queryShards(ctx context.Context) error {
+	leaders, err := globalMetaCache.GetShardLeaders(ctx, qt.qc, qt.CollectionID, qt.ReplicaID)
+	if err != nil {
+		return err
+	}
+	vchans, err := qt.getVChannels()
+	if err != nil {
+		return err
+	}
+
+	results := make([]*internalpb.RetrieveResults, len(leaders.NodeAddrs))
+	errs := make([]error, len(leaders.NodeAddrs))
+	var wg sync.WaitGroup
+	for i, addr := range leaders.NodeAddrs {
+		wg.Add(1)
+		go func(i int, addr string) {
+			defer wg.Done()
+			client, err := qt.shardMgr.getClient(ctx, addr)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i], errs[i] = client.Query(ctx, &querypb.QueryRequest{Req: qt.RetrieveRequest})
+			if errs[i] != nil {
+				// the query node may be offline, the client is recreated on next use
+				qt.shardMgr.removeClient(addr)
+			}
+		}(i, addr)
+	}
+	wg.Wait()
+
+	resultBuf := newQueryResultBuf(qt.ID())
+	for _, vchan := range vchans {
+		resultBuf.usedVChans[vchan] = struct{}{}
+	}
+	for i, result := range results {
+		if errs[i] != nil {
+			return fmt.Errorf("failed to query on query node %d: %s", leaders.NodeIds[i], errs[i].Error())
+		}
+		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("failed to query on query node %d: %s", leaders.NodeIds[i], result.GetStatus().GetReason())
+		}
+		resultBuf.addPartialResult(result)
+	}
+	if !resultBuf.readyToReduce() {
+		return fmt.Errorf("query results don't cover all the shards of collection %d", qt.CollectionID)
+	}
+	log.Debug("proxy queried by grpc",
+		zap.Int64("collectionID", qt.CollectionID),
+		zap.Int64("msgID", qt.ID()),
+		zap.Int64("replicaID", qt.ReplicaID),
+		zap.Int64s("nodeIDs", leaders.NodeIds))
+
+	// resultBuf is unbuffered and read by PostExecute
+	go func() {
+		select {
+		case qt.resultBuf <- resultBuf.resultBuf:
+		case <-qt.TraceCtx().Done():
+		}
+	}()
+	return nil
+}
+
+// mergeRetrieveResults merges the results of query nodes and removes duplicates. If limit is positive,
+// the entities are ordered by primary key and only the ones in [offset, offset+limit) are kept.
+func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults, offset, limit int64) (*milvuspb.QueryResults, error) {
+	if limit > 0 {
+		return mergeRetrieveResultsWithLimit(retrieveResults, offset, limit)
+	}
+
 	var ret *milvuspb.QueryResults
 	var skipDupCnt int64
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
 		// skip empty result, it will break merge result
-		if rr == nil || rr.Ids == nil || rr.Ids.GetIntId() == nil || len(rr.Ids.GetIntId().Data) == 0 {
+		if rr == nil || typeutil.GetSizeOfIDs(rr.Ids) == 0 {
 			continue
 		}
 
@@ -2336,10 +3076,12 @@ func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvu
 			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", len(ret.FieldsData), len(rr.FieldsData))
 		}
 
-		for i, id := range rr.Ids.GetIntId().GetData() {
-			if _, ok := idSet[id]; !ok {
+		numPks := typeutil.GetSizeOfIDs(rr.Ids)
+		for i := 0; i < numPks; i++ {
+			pk := typeutil.GetPK(rr.Ids, int64(i))
+			if _, ok := idSet[pk]; !ok {
 				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
-				idSet[id] = struct{}{}
+				idSet[pk] = struct{}{}
 			} else {
 				// primary keys duplicate
 				skipDupCnt++
@@ -2357,6 +3099,66 @@ func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvu
 	return ret, nil
 }
 
+func mergeRetrieveResultsWithLimit(retrieveResults []*internalpb.RetrieveResults, offset, limit int64) (*milvuspb.QueryResults, error) {
+	type entity struct {
+		pk        interface{}
+		resultIdx int
+		offset    int64
+	}
+	var entities []entity
+	var skipDupCnt int64
+	var idSet = make(map[interface{}]struct{})
+	numFields := -1
+	for i, rr := range retrieveResults {
+		// skip empty result, it will break merge result
+		if rr == nil || typeutil.GetSizeOfIDs(rr.Ids) == 0 {
+			continue
+		}
+		if numFields == -1 {
+			numFields = len(rr.FieldsData)
+		} else if numFields != len(rr.FieldsData) {
+			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", numFields, len(rr.FieldsData))
+		}
+		numPks := typeutil.GetSizeOfIDs(rr.Ids)
+		for j := 0; j < numPks; j++ {
+			pk := typeutil.GetPK(rr.Ids, int64(j))
+			if _, ok := idSet[pk]; ok {
+				// primary keys duplicate
+				skipDupCnt++
+				continue
+			}
+			idSet[pk] = struct{}{}
+			entities = append(entities, entity{pk: pk, resultIdx: i, offset: int64(j)})
+		}
+	}
+	log.Debug("skip duplicated query result", zap.Int64("count", skipDupCnt))
+
+	sort.Slice(entities, func(i, j int) bool {
+		return typeutil.ComparePK(entities[i].pk, entities[j].pk)
+	})
+	if int64(len(entities)) <= offset {
+		entities = nil
+	} else {
+		entities = entities[offset:]
+		if int64(len(entities)) > limit {
+			entities = entities[:limit]
+		}
+	}
+	if len(entities) == 0 {
+		return &milvuspb.QueryResults{
+			FieldsData: []*schemapb.FieldData{},
+		}, nil
+	}
+
+	ret := &milvuspb.QueryResults{
+		FieldsData: make([]*schemapb.FieldData, numFields),
+	}
+	for _, e := range entities {
+		typeutil.AppendFieldData(ret.FieldsData, retrieveResults[e.resultIdx].FieldsData, e.offset)
+	}
+	return ret, nil
+}
+
 func (qt *queryTask) PostExecute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder("queryTask PostExecute")
 	defer func() {
@@ -2365,6 +3167,8 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 	select {
 	case <-qt.TraceCtx().Done():
 		log.Debug("proxy", zap.Int64("Query: wait to finish failed, timeout!, taskID:", qt.ID()))
+		// the selected replica may be gone, select from the latest replicas next time
+		globalMetaCache.ClearReplicas(qt.CollectionID)
 		return fmt.Errorf("queryTask:wait to finish failed, timeout : %d", qt.ID())
 	case retrieveResults := <-qt.resultBuf:
 		filterRetrieveResults := make([]*internalpb.RetrieveResults, 0)
@@ -2386,14 +3190,17 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			}
 			log.Debug("Query failed on all querynodes.",
 				zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
+			globalMetaCache.ClearReplicas(qt.CollectionID)
 			return errors.New(reason)
 		}
 
 		var err error
-		qt.result, err = mergeRetrieveResults(filterRetrieveResults)
+		reduceStart := time.Now()
+		qt.result, err = mergeRetrieveResults(filterRetrieveResults, qt.query.Offset, qt.query.Limit)
 		if err != nil {
 			return err
 		}
+		metrics.ProxyReduceResultLatency.WithLabelValues(metrics.QueryLabel).Observe(float64(time.Since(reduceStart).Milliseconds()))
 
 		if len(qt.result.FieldsData) > 0 {
 			qt.result.Status = &commonpb.Status{
@@ -2408,7 +3215,7 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2421,6 +3228,21 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+
+		// a full page may be followed by more entities, so return a cursor to continue after its last entity
+		if qt.query.Limit > 0 {
+			numRows, lastPK := getLastPrimaryKey(qt.result.FieldsData, qt.pkField)
+			if numRows == qt.query.Limit {
+				qt.result.NextCursor, err = encodeQueryCursor(&internalpb.QueryCursor{
+					CollectionID:    qt.CollectionID,
+					TravelTimestamp: qt.TravelTimestamp,
+					LastPrimaryKey:  lastPK,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	log.Info("Query PostExecute done", zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2586,6 +3408,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.Schema.Name = result.Schema.Name
 		dct.result.Schema.Description = result.Schema.Description
 		dct.result.Schema.AutoID = result.Schema.AutoID
+		dct.result.Schema.Version = result.Schema.Version
 		dct.result.CollectionID = result.CollectionID
 		dct.result.VirtualChannelNames = result.VirtualChannelNames
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
@@ -2593,17 +3416,21 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
 		dct.result.ShardsNum = result.ShardsNum
 		dct.result.ConsistencyLevel = result.ConsistencyLevel
+		dct.result.Properties = result.Properties
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= common.StartOfUserFieldID {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
-					FieldID:      field.FieldID,
-					Name:         field.Name,
-					IsPrimaryKey: field.IsPrimaryKey,
-					AutoID:       field.AutoID,
-					Description:  field.Description,
-					DataType:     field.DataType,
-					TypeParams:   field.TypeParams,
-					IndexParams:  field.IndexParams,
+					FieldID:         field.FieldID,
+					Name:            field.Name,
+					IsPrimaryKey:    field.IsPrimaryKey,
+					AutoID:          field.AutoID,
+					Description:     field.Description,
+					DataType:        field.DataType,
+					TypeParams:      field.TypeParams,
+					IndexParams:     field.IndexParams,
+					DefaultValue:    field.DefaultValue,
+					IsPartitionKey:  field.IsPartitionKey,
+					IsClusteringKey: field.IsClusteringKey,
 				})
 			}
 		}
@@ -2667,7 +3494,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2754,11 +3581,11 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2877,7 +3704,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2926,7 +3753,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -3260,7 +4087,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.DbName, collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3273,7 +4100,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3321,7 +4148,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3677,7 +4504,7 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3898,7 +4725,7 @@ func (gist *getIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -4100,7 +4927,7 @@ func (ft *flushTask) PreExecute(ctx context.Context) error {
 func (ft *flushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
@@ -4199,11 +5026,11 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", typeutil.ProxyRole), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4215,14 +5042,17 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: lct.Base.Timestamp,
 			SourceID:  lct.Base.SourceID,
 		},
-		DbID:         0,
-		CollectionID: collID,
-		Schema:       collSchema,
+		DbID:          0,
+		CollectionID:  collID,
+		Schema:        collSchema,
+		ReplicaNumber: lct.ReplicaNumber,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", typeutil.ProxyRole),
 		zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
 		zap.Any("schema", request.Schema))
 	lct.result, err = lct.queryCoord.LoadCollection(ctx, request)
+	// the collection may be reloaded with another number of replicas
+	globalMetaCache.ClearReplicas(collID)
 	if err != nil {
 		return fmt.Errorf("call query coordinator LoadCollection: %s", err)
 	}
@@ -4295,7 +5125,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4313,6 +5143,7 @@ func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
 	rct.result, err = rct.queryCoord.ReleaseCollection(ctx, request)
 
 	_ = rct.chMgr.removeDQLStream(collID)
+	globalMetaCache.ClearReplicas(collID)
 
 	return err
 }
@@ -4381,16 +5212,16 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4409,6 +5240,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 		Schema:       collSchema,
 	}
 	lpt.result, err = lpt.queryCoord.LoadPartitions(ctx, request)
+	globalMetaCache.ClearReplicas(collID)
 	return err
 }
 
@@ -4476,12 +5308,12 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4499,6 +5331,7 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 		PartitionIDs: partitionIDs,
 	}
 	rpt.result, err = rpt.queryCoord.ReleasePartitions(ctx, request)
+	globalMetaCache.ClearReplicas(collID)
 	return err
 }
 
@@ -4578,7 +5411,7 @@ func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (dt *deleteTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.DbName, dt.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -4594,7 +5427,8 @@ func (dt *deleteTask) getChannels() ([]pChan, error) {
 	return channels, err
 }
 
-func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res []int64, err error) {
+// getPrimaryKeysFromExpr returns the primary keys in the delete expr "pk in [a, b]" and the number of them
+func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res *schemapb.IDs, rowNum int64, err error) {
 	if len(expr) == 0 {
 		log.Warn("empty expr")
 		return
@@ -4602,20 +5436,43 @@ func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res
 
 	plan, err := createExprPlan(schema, expr)
 	if err != nil {
-		return res, fmt.Errorf("failed to create expr plan, expr = %s", expr)
+		return res, 0, fmt.Errorf("failed to create expr plan, expr = %s", expr)
 	}
 
 	// delete request only support expr "id in [a, b]"
 	termExpr, ok := plan.Node.(*planpb.PlanNode_Predicates).Predicates.Expr.(*planpb.Expr_TermExpr)
 	if !ok {
-		return res, fmt.Errorf("invalid plan node type")
+		return res, 0, fmt.Errorf("invalid plan node type")
 	}
 
-	for _, v := range termExpr.TermExpr.Values {
-		res = append(res, v.GetInt64Val())
+	res = &schemapb.IDs{}
+	rowNum = int64(len(termExpr.TermExpr.Values))
+	switch termExpr.TermExpr.ColumnInfo.GetDataType() {
+	case schemapb.DataType_Int64:
+		ids := make([]int64, 0, rowNum)
+		for _, v := range termExpr.TermExpr.Values {
+			ids = append(ids, v.GetInt64Val())
+		}
+		res.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: ids,
+			},
+		}
+	case schemapb.DataType_String:
+		ids := make([]string, 0, rowNum)
+		for _, v := range termExpr.TermExpr.Values {
+			ids = append(ids, v.GetStringVal())
+		}
+		res.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: ids,
+			},
+		}
+	default:
+		return res, 0, fmt.Errorf("invalid field data type specifyed in delete expr")
 	}
 
-	return res, nil
+	return res, rowNum, nil
 }
 
 func (dt *deleteTask) PreExecute(ctx context.Context) error {
@@ -4637,7 +5494,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Error("Invalid collection name", zap.String("collectionName", collName))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.req.DbName, collName)
 	if err != nil {
 		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
 		return err
@@ -4651,7 +5508,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Error("Invalid partition name", zap.String("partitionName", partName))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.req.DbName, collName, partName)
 		if err != nil {
 			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
 			return err
@@ -4661,31 +5518,30 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.DeleteRequest.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.DbName, dt.req.CollectionName)
 	if err != nil {
 		log.Error("Failed to get collection schema", zap.String("collectionName", dt.req.CollectionName))
 		return err
 	}
 
-	primaryKeys, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
+	primaryKeys, rowNum, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
 	if err != nil {
 		log.Error("Failed to get primary keys from expr", zap.Error(err))
 		return err
 	}
-	log.Debug("get primary keys from expr", zap.Int("len of primary keys", len(primaryKeys)))
-	dt.DeleteRequest.PrimaryKeys = primaryKeys
-
-	// set result
-	dt.result.IDs.IdField = &schemapb.IDs_IntId{
-		IntId: &schemapb.LongArray{
-			Data: primaryKeys,
-		},
+	log.Debug("get primary keys from expr", zap.Int64("len of primary keys", rowNum))
+	if strIDs := primaryKeys.GetStrId(); strIDs != nil {
+		dt.DeleteRequest.StringPrimaryKeys = strIDs.GetData()
+		dt.HashStringPK(strIDs.GetData())
+	} else {
+		dt.DeleteRequest.PrimaryKeys = primaryKeys.GetIntId().GetData()
+		dt.HashPK(dt.DeleteRequest.PrimaryKeys)
 	}
-	dt.result.DeleteCnt = int64(len(primaryKeys))
 
-	dt.HashPK(primaryKeys)
+	// set result
+	dt.result.IDs = primaryKeys
+	dt.result.DeleteCnt = rowNum
 
-	rowNum := len(primaryKeys)
 	dt.Timestamps = make([]uint64, rowNum)
 	for index := range dt.Timestamps {
 		dt.Timestamps[index] = dt.BeginTs()
@@ -4722,10 +5578,58 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			return err
 		}
 	}
-	result := make(map[int32]msgstream.TsMsg)
-	hashKeys := stream.ComputeProduceChannelIndexes(msgPack.Msgs)
+
 	// For each msg, assign PK to different message buckets by hash value of PK.
-	for i, request := range msgPack.Msgs {
+	newPack := &msgstream.MsgPack{
+		BeginTs:        msgPack.BeginTs,
+		EndTs:          msgPack.EndTs,
+		StartPositions: msgPack.StartPositions,
+		EndPositions:   msgPack.EndPositions,
+		Msgs:           repackDeleteMsgByHash(ctx, stream, msgPack.Msgs),
+	}
+
+	err = stream.Produce(newPack)
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+	return nil
+}
+
+func (dt *deleteTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+func (dt *deleteTask) HashPK(pks []int64) {
+	if len(dt.HashValues) != 0 {
+		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
+	}
+	dt.HashValues = make([]uint32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32Int64(pk)
+		dt.HashValues = append(dt.HashValues, hash)
+	}
+}
+
+// HashStringPK computes the hash values of string primary keys
+func (dt *deleteTask) HashStringPK(pks []string) {
+	if len(dt.HashValues) != 0 {
+		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
+	}
+	dt.HashValues = make([]uint32, 0, len(pks))
+	for _, pk := range pks {
+		hash, _ := typeutil.Hash32String(pk)
+		dt.HashValues = append(dt.HashValues, uint32(hash))
+	}
+}
+
+// repackDeleteMsgByHash assigns the primary keys of delete messages to different message buckets
+// by the hash value of each primary key, one bucket per produce channel.
+func repackDeleteMsgByHash(ctx context.Context, stream msgstream.MsgStream, msgs []msgstream.TsMsg) []msgstream.TsMsg {
+	result := make(map[int32]msgstream.TsMsg)
+	hashKeys := stream.ComputeProduceChannelIndexes(msgs)
+	for i, request := range msgs {
 		deleteRequest := request.(*msgstream.DeleteMsg)
 		keys := hashKeys[i]
 		collectionName := deleteRequest.CollectionName
@@ -4735,13 +5639,12 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		proxyID := deleteRequest.Base.SourceID
 		for index, key := range keys {
 			ts := deleteRequest.Timestamps[index]
-			pks := deleteRequest.PrimaryKeys[index]
 			_, ok := result[key]
 			if !ok {
 				sliceRequest := internalpb.DeleteRequest{
 					Base: &commonpb.MsgBase{
 						MsgType:   commonpb.MsgType_Delete,
-						MsgID:     dt.Base.MsgID,
+						MsgID:     deleteRequest.Base.MsgID,
 						Timestamp: ts,
 						SourceID:  proxyID,
 					},
@@ -4761,46 +5664,308 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			curMsg := result[key].(*msgstream.DeleteMsg)
 			curMsg.HashValues = append(curMsg.HashValues, deleteRequest.HashValues[index])
 			curMsg.Timestamps = append(curMsg.Timestamps, ts)
-			curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, pks)
+			if len(deleteRequest.StringPrimaryKeys) > 0 {
+				curMsg.StringPrimaryKeys = append(curMsg.StringPrimaryKeys, deleteRequest.StringPrimaryKeys[index])
+			} else {
+				curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, deleteRequest.PrimaryKeys[index])
+			}
 		}
 	}
 
-	newPack := &msgstream.MsgPack{
-		BeginTs:        msgPack.BeginTs,
-		EndTs:          msgPack.EndTs,
-		StartPositions: msgPack.StartPositions,
-		EndPositions:   msgPack.EndPositions,
-		Msgs:           make([]msgstream.TsMsg, 0),
-	}
-
+	ret := make([]msgstream.TsMsg, 0, len(result))
 	for _, msg := range result {
 		if msg != nil {
-			newPack.Msgs = append(newPack.Msgs, msg)
+			ret = append(ret, msg)
 		}
 	}
+	return ret
+}
 
-	err = stream.Produce(newPack)
-	if err != nil {
-		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-		dt.result.Status.Reason = err.Error()
-		return err
+// upsertTask replaces the entities sharing primary keys with the request rows. The delete and the
+// insert are produced in one message pack under the same timestamp, so the flow graphs of query
+// nodes and data nodes observe the replacement as a whole.
+type upsertTask struct {
+	Condition
+	ctx    context.Context
+	req    *milvuspb.UpsertRequest
+	result *milvuspb.MutationResult
+
+	// insertTask and deleteMsg carry the two halves of the upsert.
+	insertTask *insertTask
+	deleteMsg  *BaseDeleteTask
+
+	chMgr    channelsMgr
+	chTicker channelsTimeTicker
+}
+
+func newUpsertTask(ctx context.Context, req *milvuspb.UpsertRequest, rowIDAllocator *allocator.IDAllocator,
+	segIDAssigner *segIDAssigner, chMgr channelsMgr, chTicker channelsTimeTicker) *upsertTask {
+	partitionName := req.PartitionName
+	if len(partitionName) <= 0 {
+		partitionName = Params.CommonCfg.DefaultPartitionName
+	}
+	return &upsertTask{
+		Condition: NewTaskCondition(ctx),
+		ctx:       ctx,
+		req:       req,
+		insertTask: &insertTask{
+			ctx: ctx,
+			req: &milvuspb.InsertRequest{
+				Base:           req.Base,
+				DbName:         req.DbName,
+				CollectionName: req.CollectionName,
+				PartitionName:  req.PartitionName,
+				FieldsData:     req.FieldsData,
+				HashKeys:       req.HashKeys,
+				NumRows:        req.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: req.HashKeys,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+					},
+					CollectionName: req.CollectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			rowIDAllocator: rowIDAllocator,
+			segIDAssigner:  segIDAssigner,
+			chMgr:          chMgr,
+			chTicker:       chTicker,
+		},
+		deleteMsg: &BaseDeleteTask{
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Delete,
+				},
+				CollectionName: req.CollectionName,
+			},
+		},
+		chMgr:    chMgr,
+		chTicker: chTicker,
 	}
+}
+
+func (ut *upsertTask) TraceCtx() context.Context {
+	return ut.ctx
+}
+
+func (ut *upsertTask) ID() UniqueID {
+	return ut.insertTask.ID()
+}
+
+func (ut *upsertTask) SetID(uid UniqueID) {
+	ut.insertTask.SetID(uid)
+	ut.deleteMsg.Base.MsgID = uid
+}
+
+func (ut *upsertTask) Name() string {
+	return upsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return commonpb.MsgType_Upsert
+}
+
+func (ut *upsertTask) BeginTs() Timestamp {
+	return ut.insertTask.BeginTs()
+}
+
+func (ut *upsertTask) EndTs() Timestamp {
+	return ut.insertTask.EndTs()
+}
+
+// SetTs sets the same timestamp to both halves of the upsert.
+func (ut *upsertTask) SetTs(ts Timestamp) {
+	ut.insertTask.SetTs(ts)
+	ut.deleteMsg.Base.Timestamp = ts
+}
+
+func (ut *upsertTask) OnEnqueue() error {
+	ut.insertTask.Base = &commonpb.MsgBase{}
+	ut.deleteMsg.Base = &commonpb.MsgBase{}
 	return nil
 }
 
-func (dt *deleteTask) PostExecute(ctx context.Context) error {
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	return ut.insertTask.getPChanStats()
+}
+
+func (ut *upsertTask) getChannels() ([]pChan, error) {
+	return ut.insertTask.getChannels()
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	it := ut.insertTask
+	it.Base.MsgType = commonpb.MsgType_Insert
+	it.Base.SourceID = Params.ProxyCfg.ProxyID
+	ut.deleteMsg.Base.MsgType = commonpb.MsgType_Delete
+	ut.deleteMsg.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	ut.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: ut.EndTs(),
+	}
+	it.result = ut.result
+
+	collectionName := ut.req.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	if err := validatePartitionTag(it.PartitionName, true); err != nil {
+		return err
+	}
+
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.req.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+	it.schema = collSchema
+
+	for _, field := range collSchema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("upsert is not supported on collection %s whose primary field %s is autoID",
+				collectionName, field.Name)
+		}
+	}
+
+	if err := it.checkRowNums(); err != nil {
+		return err
+	}
+	if err := it.checkMaxLengthOfStringFields(); err != nil {
+		return err
+	}
+	if err := it.checkFieldAutoIDAndHashPK(); err != nil {
+		return err
+	}
+	if err := it.hashPartitionKeys(); err != nil {
+		return err
+	}
+	if err := it.transferColumnBasedRequestToRowBasedData(); err != nil {
+		return err
+	}
+
+	primaryKeys := ut.result.IDs.GetIntId().GetData()
+	strPrimaryKeys := ut.result.IDs.GetStrId().GetData()
+	numPKs := len(primaryKeys) + len(strPrimaryKeys)
+	if numPKs != int(ut.req.NumRows) {
+		return fmt.Errorf("the number of primary keys (%d) is not equal to the number of rows (%d)",
+			numPKs, ut.req.NumRows)
+	}
+	existed := make(map[interface{}]struct{}, numPKs)
+	for _, pk := range primaryKeys {
+		if _, ok := existed[pk]; ok {
+			return fmt.Errorf("duplicate primary key %d in upsert request", pk)
+		}
+		existed[pk] = struct{}{}
+	}
+	for _, pk := range strPrimaryKeys {
+		if _, ok := existed[pk]; ok {
+			return fmt.Errorf("duplicate primary key %s in upsert request", pk)
+		}
+		existed[pk] = struct{}{}
+	}
+
+	rowNum := len(it.RowData)
+	it.Timestamps = make([]uint64, rowNum)
+	for index := range it.Timestamps {
+		it.Timestamps[index] = it.BeginTimestamp
+	}
+
+	// The old entities may live in any partition, so the delete half is not bound to a partition.
+	ut.deleteMsg.PartitionID = common.InvalidPartitionID
+	ut.deleteMsg.PrimaryKeys = primaryKeys
+	ut.deleteMsg.StringPrimaryKeys = strPrimaryKeys
+	ut.deleteMsg.HashValues = it.HashValues
+	ut.deleteMsg.Timestamps = make([]uint64, numPKs)
+	for index := range ut.deleteMsg.Timestamps {
+		ut.deleteMsg.Timestamps[index] = ut.BeginTs()
+	}
+
+	ut.result.UpsertCnt = int64(ut.req.NumRows)
 	return nil
 }
 
-func (dt *deleteTask) HashPK(pks []int64) {
-	if len(dt.HashValues) != 0 {
-		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute upsert %d", ut.ID()))
+	defer tr.Elapse("done")
+
+	it := ut.insertTask
+	collectionName := ut.req.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, ut.req.DbName, collectionName)
+	if err != nil {
+		return err
 	}
-	dt.HashValues = make([]uint32, 0, len(pks))
-	for _, pk := range pks {
-		hash, _ := typeutil.Hash32Int64(pk)
-		dt.HashValues = append(dt.HashValues, hash)
+	it.CollectionID = collID
+	ut.deleteMsg.CollectionID = collID
+	if it.partitionKeyHashes == nil {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, ut.req.DbName, collectionName, it.PartitionName)
+		if err != nil {
+			return err
+		}
+		it.PartitionID = partitionID
 	}
+	tr.Record("get collection id & partition id from cache")
+
+	stream, err := ut.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = ut.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+		stream, err = ut.chMgr.getDMLStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+	tr.Record("get used message stream")
+
+	it.BaseMsg.Ctx = ctx
+	insertPack, err := it.assignSegmentIDByPartition(ctx, stream)
+	if err != nil {
+		return err
+	}
+	tr.Record("assign segment id")
+
+	// Deletes go first so that consumers never observe both the old and the new entities.
+	ut.deleteMsg.BaseMsg.Ctx = ctx
+	msgs := repackDeleteMsgByHash(ctx, stream, []msgstream.TsMsg{ut.deleteMsg})
+	msgs = append(msgs, insertPack.Msgs...)
+	err = stream.Produce(&msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    msgs,
+	})
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	tr.Record("send upsert request to message stream")
+
+	return nil
+}
+
+func (ut *upsertTask) PostExecute(ctx context.Context) error {
+	return nil
 }
 
 // CreateAliasTask contains task information of CreateAlias
@@ -5031,3 +6196,335 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// AddFieldTask is the task to append a scalar field to the schema of a collection
+type AddFieldTask struct {
+	Condition
+	*milvuspb.AddFieldRequest
+	ctx        context.Context
+	rootCoord  types.RootCoord
+	queryCoord types.QueryCoord
+	result     *commonpb.Status
+}
+
+func (a *AddFieldTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AddFieldTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AddFieldTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AddFieldTask) Name() string {
+	return AddFieldTaskName
+}
+
+func (a *AddFieldTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AddFieldTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddFieldTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddFieldTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AddFieldTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AddFieldTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AddField
+	a.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+
+	field := a.GetField()
+	if field == nil {
+		return errors.New("field schema is empty")
+	}
+	if err := validateFieldName(field.Name); err != nil {
+		return err
+	}
+	if field.IsPrimaryKey || field.AutoID || field.IsPartitionKey || field.IsClusteringKey {
+		return fmt.Errorf("primary key, auto id, partition key or clustering key field %s can't be added to an existing collection", field.Name)
+	}
+	if field.DataType == schemapb.DataType_String {
+		if err := validateMaxLength(field); err != nil {
+			return err
+		}
+		maxLength, err := getMaxLength(field)
+		if err != nil {
+			return err
+		}
+		if int64(len(field.GetDefaultValue().GetStringData())) > maxLength {
+			return fmt.Errorf("the length of default value exceeds max_length (%d) of field %s", maxLength, field.Name)
+		}
+	}
+	if err := typeutil.CheckDefaultValue(field); err != nil {
+		return err
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, a.DbName, a.CollectionName)
+	if err != nil {
+		return err
+	}
+	if int64(len(schema.Fields)) >= Params.ProxyCfg.MaxFieldNum {
+		return fmt.Errorf("maximum field's number should be limited to %d", Params.ProxyCfg.MaxFieldNum)
+	}
+
+	// segments already loaded by query nodes are not aware of the new field,
+	// so the collection must be released before its schema changes
+	collID, err := globalMetaCache.GetCollectionID(ctx, a.DbName, a.CollectionName)
+	if err != nil {
+		return err
+	}
+	resp, err := a.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     a.Base.MsgID,
+			Timestamp: a.Base.Timestamp,
+			SourceID:  a.Base.SourceID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	for _, id := range resp.CollectionIDs {
+		if id == collID {
+			return fmt.Errorf("collection %s is loaded, release it before adding field %s", a.CollectionName, field.Name)
+		}
+	}
+	return nil
+}
+
+func (a *AddFieldTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AddField(ctx, a.AddFieldRequest)
+	return err
+}
+
+func (a *AddFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// CreateDatabaseTask is the task to create database
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+// TraceCtx returns the context for trace
+func (c *CreateDatabaseTask) TraceCtx() context.Context {
+	return c.ctx
+}
+
+// ID returns the MsgID
+func (c *CreateDatabaseTask) ID() UniqueID {
+	return c.Base.MsgID
+}
+
+// SetID sets the MsgID
+func (c *CreateDatabaseTask) SetID(uid UniqueID) {
+	c.Base.MsgID = uid
+}
+
+// Name returns the name of the task
+func (c *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (c *CreateDatabaseTask) Type() commonpb.MsgType {
+	return c.Base.MsgType
+}
+
+func (c *CreateDatabaseTask) BeginTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) EndTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) SetTs(ts Timestamp) {
+	c.Base.Timestamp = ts
+}
+
+func (c *CreateDatabaseTask) OnEnqueue() error {
+	c.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (c *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	c.Base.MsgType = commonpb.MsgType_CreateDatabase
+	c.Base.SourceID = Params.ProxyCfg.ProxyID
+	return validateDatabaseName(c.DbName)
+}
+
+func (c *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	c.result, err = c.rootCoord.CreateDatabase(ctx, c.CreateDatabaseRequest)
+	return err
+}
+
+func (c *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// DropDatabaseTask is the task to drop database
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+// TraceCtx returns the context for trace
+func (d *DropDatabaseTask) TraceCtx() context.Context {
+	return d.ctx
+}
+
+// ID returns the MsgID
+func (d *DropDatabaseTask) ID() UniqueID {
+	return d.Base.MsgID
+}
+
+// SetID sets the MsgID
+func (d *DropDatabaseTask) SetID(uid UniqueID) {
+	d.Base.MsgID = uid
+}
+
+// Name returns the name of the task
+func (d *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (d *DropDatabaseTask) Type() commonpb.MsgType {
+	return d.Base.MsgType
+}
+
+func (d *DropDatabaseTask) BeginTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) EndTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) SetTs(ts Timestamp) {
+	d.Base.Timestamp = ts
+}
+
+func (d *DropDatabaseTask) OnEnqueue() error {
+	d.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (d *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	d.Base.MsgType = commonpb.MsgType_DropDatabase
+	d.Base.SourceID = Params.ProxyCfg.ProxyID
+	if err := validateDatabaseName(d.DbName); err != nil {
+		return err
+	}
+	if d.DbName == common.DefaultDBName {
+		return errors.New("default database cannot be dropped")
+	}
+	return nil
+}
+
+func (d *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	d.result, err = d.rootCoord.DropDatabase(ctx, d.DropDatabaseRequest)
+	return err
+}
+
+func (d *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	globalMetaCache.RemoveDatabase(ctx, d.DbName)
+	return nil
+}
+
+// ListDatabasesTask is the task to list databases
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+// TraceCtx returns the context for trace
+func (l *ListDatabasesTask) TraceCtx() context.Context {
+	return l.ctx
+}
+
+// ID returns the MsgID
+func (l *ListDatabasesTask) ID() UniqueID {
+	return l.Base.MsgID
+}
+
+// SetID sets the MsgID
+func (l *ListDatabasesTask) SetID(uid UniqueID) {
+	l.Base.MsgID = uid
+}
+
+// Name returns the name of the task
+func (l *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (l *ListDatabasesTask) Type() commonpb.MsgType {
+	return l.Base.MsgType
+}
+
+func (l *ListDatabasesTask) BeginTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) EndTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) SetTs(ts Timestamp) {
+	l.Base.Timestamp = ts
+}
+
+func (l *ListDatabasesTask) OnEnqueue() error {
+	l.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (l *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	l.Base.MsgType = commonpb.MsgType_ListDatabases
+	l.Base.SourceID = Params.ProxyCfg.ProxyID
+	return nil
+}
+
+func (l *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	l.result, err = l.rootCoord.ListDatabases(ctx, l.ListDatabasesRequest)
+	return err
+}
+
+func (l *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
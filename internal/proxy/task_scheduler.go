@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -31,11 +32,14 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
 )
@@ -58,8 +62,17 @@ type taskQueue interface {
 // make sure baseTaskQueue implements taskQueue.
 var _ taskQueue = (*baseTaskQueue)(nil)
 
+const (
+	ddQueueType = "ddl"
+	dmQueueType = "dml"
+	dqQueueType = "dql"
+)
+
 // baseTaskQueue implements taskQueue.
 type baseTaskQueue struct {
+	// queueType is the label of the queue in metrics
+	queueType string
+
 	unissuedTasks *list.List
 	activeTasks   map[UniqueID]task
 	utLock        sync.RWMutex
@@ -97,6 +110,7 @@ func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 		return errors.New("task queue is full")
 	}
 	queue.unissuedTasks.PushBack(t)
+	metrics.ProxyTaskQueueLength.WithLabelValues(queue.queueType, metrics.UnissuedLabel).Set(float64(queue.unissuedTasks.Len()))
 	queue.utBufChan <- 1
 	return nil
 }
@@ -122,6 +136,7 @@ func (queue *baseTaskQueue) PopUnissuedTask() task {
 
 	ft := queue.unissuedTasks.Front()
 	queue.unissuedTasks.Remove(ft)
+	metrics.ProxyTaskQueueLength.WithLabelValues(queue.queueType, metrics.UnissuedLabel).Set(float64(queue.unissuedTasks.Len()))
 
 	return ft.Value.(task)
 }
@@ -136,6 +151,7 @@ func (queue *baseTaskQueue) AddActiveTask(t task) {
 	}
 
 	queue.activeTasks[tID] = t
+	metrics.ProxyTaskQueueLength.WithLabelValues(queue.queueType, metrics.ActiveLabel).Set(float64(len(queue.activeTasks)))
 }
 
 func (queue *baseTaskQueue) PopActiveTask(tID UniqueID) task {
@@ -144,6 +160,7 @@ func (queue *baseTaskQueue) PopActiveTask(tID UniqueID) task {
 	t, ok := queue.activeTasks[tID]
 	if ok {
 		delete(queue.activeTasks, tID)
+		metrics.ProxyTaskQueueLength.WithLabelValues(queue.queueType, metrics.ActiveLabel).Set(float64(len(queue.activeTasks)))
 		return t
 	}
 
@@ -207,8 +224,9 @@ func (queue *baseTaskQueue) getMaxTaskNum() int64 {
 	return queue.maxTaskNum
 }
 
-func newBaseTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *baseTaskQueue {
+func newBaseTaskQueue(queueType string, tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *baseTaskQueue {
 	return &baseTaskQueue{
+		queueType:       queueType,
 		unissuedTasks:   list.New(),
 		activeTasks:     make(map[UniqueID]task),
 		utLock:          sync.RWMutex{},
@@ -262,6 +280,7 @@ func (queue *dmTaskQueue) PopActiveTask(tID UniqueID) task {
 		defer queue.statsLock.Unlock()
 
 		delete(queue.activeTasks, tID)
+		metrics.ProxyTaskQueueLength.WithLabelValues(queue.queueType, metrics.ActiveLabel).Set(float64(len(queue.activeTasks)))
 		log.Debug("Proxy dmTaskQueue popPChanStats", zap.Any("tID", t.ID()))
 		queue.popPChanStats(t)
 	} else {
@@ -359,20 +378,20 @@ func (queue *ddTaskQueue) Enqueue(t task) error {
 
 func newDdTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *ddTaskQueue {
 	return &ddTaskQueue{
-		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+		baseTaskQueue: newBaseTaskQueue(ddQueueType, tsoAllocatorIns, idAllocatorIns),
 	}
 }
 
 func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dmTaskQueue {
 	return &dmTaskQueue{
-		baseTaskQueue:        newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+		baseTaskQueue:        newBaseTaskQueue(dmQueueType, tsoAllocatorIns, idAllocatorIns),
 		pChanStatisticsInfos: make(map[pChan]*pChanStatInfo),
 	}
 }
 
 func newDqTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dqTaskQueue {
 	return &dqTaskQueue{
-		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
+		baseTaskQueue: newBaseTaskQueue(dqQueueType, tsoAllocatorIns, idAllocatorIns),
 	}
 }
 
@@ -440,6 +459,11 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	defer span.Finish()
 	traceID, _, _ := trace.InfoFromSpan(span)
 
+	// the timestamp of the task is allocated when it's enqueued
+	enqueueTime, _ := tsoutil.ParseTS(t.BeginTs())
+	metrics.ProxyTaskLatency.WithLabelValues(t.Name(), metrics.EnqueueLabel).Observe(float64(time.Since(enqueueTime).Milliseconds()))
+	tr := timerecord.NewTimeRecorder(t.Name())
+
 	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
 	q.AddActiveTask(t)
 
@@ -450,6 +474,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
 
 	err := t.PreExecute(ctx)
+	metrics.ProxyTaskLatency.WithLabelValues(t.Name(), metrics.PreExecuteLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	defer func() {
 		t.Notify(err)
@@ -463,6 +488,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
 	err = t.Execute(ctx)
+	metrics.ProxyTaskLatency.WithLabelValues(t.Name(), metrics.ExecuteLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 	if err != nil {
 		trace.LogError(span, err)
 		log.Error("Failed to execute task: "+err.Error(),
@@ -472,6 +498,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 
 	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
 	err = t.PostExecute(ctx)
+	metrics.ProxyTaskLatency.WithLabelValues(t.Name(), metrics.PostExecuteLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	if err != nil {
 		trace.LogError(span, err)
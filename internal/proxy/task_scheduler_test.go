@@ -34,7 +34,7 @@ func TestBaseTaskQueue(t *testing.T) {
 
 	tsoAllocatorIns := newMockTsoAllocator()
 	idAllocatorIns := newMockIDAllocatorInterface()
-	queue := newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	queue := newBaseTaskQueue(dqQueueType, tsoAllocatorIns, idAllocatorIns)
 	assert.NotNil(t, queue)
 
 	assert.True(t, queue.utEmpty())
@@ -26,6 +26,7 @@ import (
 	"math/rand"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -43,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 )
@@ -179,23 +181,6 @@ func constructCollectionSchemaWithAllType(
 		AutoID:      false,
 	}
 
-	if enableMultipleVectorFields {
-		return &schemapb.CollectionSchema{
-			Name:        collectionName,
-			Description: "",
-			AutoID:      false,
-			Fields: []*schemapb.FieldSchema{
-				b,
-				i32,
-				i64,
-				f,
-				d,
-				fVec,
-				bVec,
-			},
-		}
-	}
-
 	return &schemapb.CollectionSchema{
 		Name:        collectionName,
 		Description: "",
@@ -207,7 +192,7 @@ func constructCollectionSchemaWithAllType(
 			f,
 			d,
 			fVec,
-			// bVec,
+			bVec,
 		},
 	}
 }
@@ -672,6 +657,238 @@ func TestInsertTask_checkRowNums(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInsertTask_StringPrimaryKey(t *testing.T) {
+	it := insertTask{
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				Base: &commonpb.MsgBase{},
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Name:   "TestInsertTask_StringPrimaryKey",
+			AutoID: false,
+			Fields: []*schemapb.FieldSchema{
+				{
+					Name:         "pk",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_String,
+					TypeParams:   []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "4"}},
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_String,
+					FieldName: "pk",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{
+								StringData: &schemapb.StringArray{Data: []string{"a", "bcde"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{},
+		},
+	}
+
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.checkMaxLengthOfStringFields())
+	assert.NoError(t, it.checkFieldAutoIDAndHashPK())
+	assert.Equal(t, []string{"a", "bcde"}, it.result.IDs.GetStrId().GetData())
+	assert.Equal(t, 2, len(it.HashValues))
+
+	it.req.FieldsData[0].GetScalars().GetStringData().Data[1] = "bcdef"
+	assert.Error(t, it.checkMaxLengthOfStringFields())
+}
+
+func TestInsertTask_JSONField(t *testing.T) {
+	it := insertTask{
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				Base: &commonpb.MsgBase{},
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Name:   "TestInsertTask_JSONField",
+			AutoID: false,
+			Fields: []*schemapb.FieldSchema{
+				{
+					Name:         "pk",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_Int64,
+				},
+				{
+					Name:     "meta",
+					DataType: schemapb.DataType_JSON,
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "pk",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{Data: []int64{1, 2}},
+							},
+						},
+					},
+				},
+				{
+					Type:      schemapb.DataType_JSON,
+					FieldName: "meta",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_BytesData{
+								BytesData: &schemapb.BytesArray{Data: [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":5}`)}},
+							},
+						},
+					},
+				},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{},
+		},
+	}
+
+	assert.NoError(t, it.checkRowNums())
+	assert.NoError(t, it.checkJSONFields())
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	assert.Equal(t, 2, len(it.RowData))
+	// int64 pk followed by the uint32 length and bytes of the json document
+	assert.Equal(t, 8+4+len(`{"color":"red"}`), len(it.RowData[0].GetValue()))
+
+	it.req.FieldsData[1].GetScalars().GetBytesData().Data[1] = []byte(`[1, 2]`)
+	assert.Error(t, it.checkJSONFields())
+	it.req.FieldsData[1].GetScalars().GetBytesData().Data[1] = []byte(`{"price":`)
+	assert.Error(t, it.checkJSONFields())
+
+	it.req.FieldsData[1].Type = schemapb.DataType_String
+	assert.Error(t, it.checkRowNums())
+}
+
+func TestInsertTask_PartitionKey(t *testing.T) {
+	tenants := []string{"a", "b", "c", "d"}
+	it := insertTask{
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				Base:           &commonpb.MsgBase{},
+				CollectionName: "TestInsertTask_PartitionKey",
+			},
+		},
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_PartitionKey",
+			Fields: []*schemapb.FieldSchema{
+				{
+					Name:         "pk",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_Int64,
+				},
+				{
+					Name:           "tenant",
+					DataType:       schemapb.DataType_String,
+					IsPartitionKey: true,
+				},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: uint32(len(tenants)),
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "pk",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}},
+							},
+						},
+					},
+				},
+				{
+					Type:      schemapb.DataType_String,
+					FieldName: "tenant",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{
+								StringData: &schemapb.StringArray{Data: tenants},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, it.hashPartitionKeys())
+	assert.Equal(t, len(tenants), len(it.partitionKeyHashes))
+	assert.NoError(t, it.transferColumnBasedRequestToRowBasedData())
+	it.RowIDs = []int64{1, 2, 3, 4}
+	it.Timestamps = []uint64{10, 10, 10, 10}
+	it.HashValues = []uint32{0, 1, 2, 3}
+
+	partitionNames := []string{"_default_0", "_default_1"}
+	partitionsMap := map[string]UniqueID{"_default_0": 10, "_default_1": 11}
+	msgs, err := it.splitByPartitionKey(partitionNames, partitionsMap)
+	assert.NoError(t, err)
+	numRows := 0
+	for _, msg := range msgs {
+		assert.Equal(t, partitionsMap[msg.PartitionName], msg.PartitionID)
+		assert.Equal(t, len(msg.RowIDs), len(msg.RowData))
+		for _, rowID := range msg.RowIDs {
+			h, err := typeutil.Hash32PartitionKey(tenants[rowID-1])
+			assert.NoError(t, err)
+			assert.Equal(t, partitionNames[h%2], msg.PartitionName)
+		}
+		numRows += len(msg.RowIDs)
+	}
+	assert.Equal(t, len(tenants), numRows)
+
+	_, err = it.splitByPartitionKey(nil, nil)
+	assert.Error(t, err)
+
+	// partition name can't be specified
+	it.req.PartitionName = "p1"
+	assert.Error(t, it.hashPartitionKeys())
+	it.req.PartitionName = ""
+
+	// partition key must be provided
+	it.req.FieldsData = it.req.FieldsData[:1]
+	assert.Error(t, it.hashPartitionKeys())
+}
+
+func TestGetPrimaryKeysFromExpr(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:   "TestGetPrimaryKeysFromExpr",
+		AutoID: false,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64_pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "str", DataType: schemapb.DataType_String},
+		},
+	}
+	ids, rowNum, err := getPrimaryKeysFromExpr(schema, "int64_pk in [1, 2, 3]")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), rowNum)
+	assert.Equal(t, []int64{1, 2, 3}, ids.GetIntId().GetData())
+
+	schema.Fields[0].IsPrimaryKey = false
+	schema.Fields[1].IsPrimaryKey = true
+	ids, rowNum, err = getPrimaryKeysFromExpr(schema, `str in ["a", "b"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), rowNum)
+	assert.Equal(t, []string{"a", "b"}, ids.GetStrId().GetData())
+}
+
 func TestTranslateOutputFields(t *testing.T) {
 	const (
 		idFieldName           = "id"
@@ -1153,14 +1370,59 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.NoError(t, err)
 		task.CreateCollectionRequest.Schema = twoVecFieldsSchema
 		err = task.PreExecute(ctx)
-		if enableMultipleVectorFields {
-			assert.NoError(t, err)
-		} else {
-			assert.Error(t, err)
-		}
+		assert.NoError(t, err)
+
+		task.CreateCollectionRequest.Schema = marshaledSchema
+		task.CreateCollectionRequest.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "-1"}}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		task.CreateCollectionRequest.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}}
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
 	})
 }
 
+func TestGetExpireTimestamp(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	InitMetaCache(rc)
+
+	prefix := "TestGetExpireTimestamp"
+	createCollection := func(properties []*commonpb.KeyValuePair) string {
+		collectionName := prefix + funcutil.GenRandomStr()
+		schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			Properties:     properties,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		return collectionName
+	}
+
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+
+	expireTs, err := getExpireTimestamp(ctx, "", createCollection(nil), ts)
+	assert.NoError(t, err)
+	assert.Equal(t, Timestamp(0), expireTs)
+
+	collectionName := createCollection([]*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}})
+	expireTs, err = getExpireTimestamp(ctx, "", collectionName, ts)
+	assert.NoError(t, err)
+	assert.Equal(t, tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0), expireTs)
+
+	_, err = getExpireTimestamp(ctx, "", prefix+"_not_exist", ts)
+	assert.Error(t, err)
+}
+
 func TestDropCollectionTask(t *testing.T) {
 	Params.Init()
 	rc := NewRootCoordMock()
@@ -1229,7 +1491,7 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NotNil(t, err)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1307,7 +1569,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1420,7 +1682,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1479,7 +1741,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1712,6 +1974,8 @@ func TestSearchTask_all(t *testing.T) {
 
 	Params.Init()
 	Params.ProxyCfg.SearchResultChannelNames = []string{funcutil.GenRandomStr()}
+	// the request is sent by the query msgstream
+	Params.ProxyCfg.SearchByGrpc = false
 
 	rc := NewRootCoordMock()
 	rc.Start()
@@ -1774,7 +2038,7 @@ func TestSearchTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2120,7 +2384,7 @@ func TestSearchTaskWithInvalidRoundDecimal(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2402,6 +2666,8 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 
 	Params.Init()
 	Params.ProxyCfg.SearchResultChannelNames = []string{funcutil.GenRandomStr()}
+	// the request is sent by the query msgstream
+	Params.ProxyCfg.SearchByGrpc = false
 
 	rc := NewRootCoordMock()
 	rc.Start()
@@ -2460,7 +2726,7 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2824,7 +3090,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	assert.NoError(t, createColT.Execute(ctx))
 	assert.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, _ := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, _ := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// validateCollectionName
 	task.query.CollectionName = "$"
@@ -3044,6 +3310,8 @@ func TestSearchTask_Execute(t *testing.T) {
 
 	Params.Init()
 	Params.ProxyCfg.SearchResultChannelNames = []string{funcutil.GenRandomStr()}
+	// the request is sent by the query msgstream
+	Params.ProxyCfg.SearchByGrpc = false
 
 	rc := NewRootCoordMock()
 	rc.Start()
@@ -3132,6 +3400,154 @@ func TestSearchTask_Execute(t *testing.T) {
 	// TODO(dragondriver): cover getDQLStream
 }
 
+func TestSearchTask_searchShards(t *testing.T) {
+	var err error
+
+	Params.Init()
+	Params.ProxyCfg.SearchByGrpc = true
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	ctx := context.Background()
+
+	err = InitMetaCache(rc)
+	assert.NoError(t, err)
+
+	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
+	query := newMockGetChannelsService()
+	factory := newSimpleMockMsgStreamFactory()
+	chMgr := newChannelsMgrImpl(dmlChannelsFunc, nil, query.GetChannels, nil, factory)
+	defer chMgr.removeAllDMLStream()
+	defer chMgr.removeAllDQLStream()
+
+	prefix := "TestSearchTask_searchShards"
+	collectionName := prefix + funcutil.GenRandomStr()
+	shardsNum := int32(2)
+	dbName := ""
+	int64Field := "int64"
+	floatVecField := "fvec"
+	dim := 128
+
+	schema := constructCollectionSchema(int64Field, floatVecField, dim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      shardsNum,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, createColT.OnEnqueue())
+	assert.NoError(t, createColT.PreExecute(ctx))
+	assert.NoError(t, createColT.Execute(ctx))
+	assert.NoError(t, createColT.PostExecute(ctx))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+
+	qc := NewQueryCoordMock(SetQueryCoordGetShardLeadersFunc(func(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+		return &querypb.GetShardLeadersResponse{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			NodeIds:   []int64{1, 2},
+			NodeAddrs: []string{"localhost:1", "localhost:2"},
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+
+	newTask := func(nodes map[string]*queryNodeMock) *searchTask {
+		return &searchTask{
+			Condition: NewTaskCondition(ctx),
+			ctx:       ctx,
+			SearchRequest: &internalpb.SearchRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:   commonpb.MsgType_Search,
+					Timestamp: uint64(time.Now().UnixNano()),
+				},
+				CollectionID: collectionID,
+			},
+			query: &milvuspb.SearchRequest{
+				CollectionName: collectionName,
+			},
+			resultBuf: make(chan []*internalpb.SearchResults),
+			chMgr:     chMgr,
+			qc:        qc,
+			shardMgr:  newMockShardClientMgr(nodes),
+		}
+	}
+	// every node searches a part of the vchannels
+	genNode := func(task *searchTask, offset int, errCode commonpb.ErrorCode) *queryNodeMock {
+		return &queryNodeMock{
+			searchFunc: func(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+				assert.Equal(t, collectionID, req.GetReq().GetCollectionID())
+				vchans, err := task.getVChannels()
+				assert.NoError(t, err)
+				return &internalpb.SearchResults{
+					Status:             &commonpb.Status{ErrorCode: errCode},
+					ChannelIDsSearched: vchans[offset : offset+1],
+				}, nil
+			},
+		}
+	}
+
+	t.Run("all shards searched", func(t *testing.T) {
+		nodes := make(map[string]*queryNodeMock)
+		task := newTask(nodes)
+		nodes["localhost:1"] = genNode(task, 0, commonpb.ErrorCode_Success)
+		nodes["localhost:2"] = genNode(task, 1, commonpb.ErrorCode_Success)
+		assert.NoError(t, task.Execute(ctx))
+		results := <-task.resultBuf
+		assert.Equal(t, 2, len(results))
+	})
+
+	t.Run("shard not searched", func(t *testing.T) {
+		nodes := make(map[string]*queryNodeMock)
+		task := newTask(nodes)
+		nodes["localhost:1"] = genNode(task, 0, commonpb.ErrorCode_Success)
+		nodes["localhost:2"] = genNode(task, 0, commonpb.ErrorCode_Success)
+		assert.Error(t, task.Execute(ctx))
+	})
+
+	t.Run("query node failed", func(t *testing.T) {
+		nodes := make(map[string]*queryNodeMock)
+		task := newTask(nodes)
+		nodes["localhost:1"] = genNode(task, 0, commonpb.ErrorCode_Success)
+		nodes["localhost:2"] = genNode(task, 1, commonpb.ErrorCode_UnexpectedError)
+		assert.Error(t, task.searchShards(ctx))
+
+		nodes["localhost:2"] = &queryNodeMock{searchErr: errors.New("mock")}
+		task = newTask(nodes)
+		assert.Error(t, task.searchShards(ctx))
+		// the client of the failed query node is evicted
+		_, ok := task.shardMgr.clients["localhost:2"]
+		assert.False(t, ok)
+		assert.True(t, nodes["localhost:2"].stopped)
+	})
+
+	t.Run("query node unreachable", func(t *testing.T) {
+		nodes := make(map[string]*queryNodeMock)
+		task := newTask(nodes)
+		nodes["localhost:1"] = genNode(task, 0, commonpb.ErrorCode_Success)
+		assert.Error(t, task.searchShards(ctx))
+	})
+
+	t.Run("fall back to msgstream", func(t *testing.T) {
+		nodes := make(map[string]*queryNodeMock)
+		task := newTask(nodes)
+		nodes["localhost:1"] = &queryNodeMock{searchErr: errors.New("mock")}
+		// the request is sent by msgstream after searching by grpc fails
+		assert.NoError(t, task.Execute(ctx))
+	})
+}
+
 func genSearchResultData(nq int64, topk int64, ids []int64, scores []float32) *schemapb.SearchResultData {
 	return &schemapb.SearchResultData{
 		NumQueries: nq,
@@ -3163,7 +3579,7 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, 0, metricType, false)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Results.Ids.GetIntId().Data)
 		assert.Equal(t, []float32{1.0, 2.0, 3.0, 4.0}, res.Results.Scores)
@@ -3178,10 +3594,204 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, 0, metricType, false)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Results.Ids.GetIntId().Data)
 	})
+	t.Run("range search", func(t *testing.T) {
+		data1 := genSearchResultData(2, topk, []int64{1, 2, 3}, []float32{-1.0, -2.0, -3.0})
+		data1.Topks = []int64{2, 1}
+		data2 := genSearchResultData(2, topk, []int64{2, 7, 6, 8}, []float32{-1.0, -2.0, -2.5, -4.0})
+		data2.Topks = []int64{0, 4}
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+		res, err := reduceSearchResultData(dataArray, 2, topk, 0, metricType, true)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 4}, res.Results.Topks)
+		assert.Equal(t, []int64{1, 2, 2, 7, 6, 3}, res.Results.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{1.0, 2.0, 1.0, 2.0, 2.5, 3.0}, res.Results.Scores)
+
+		data2.Topks = []int64{1, 4}
+		_, err = reduceSearchResultData(dataArray, 2, topk, 0, metricType, true)
+		assert.Error(t, err)
+	})
+	t.Run("string primary keys", func(t *testing.T) {
+		genStrData := func(ids []string, scores []float32) *schemapb.SearchResultData {
+			data := genSearchResultData(nq, topk, nil, scores)
+			data.Ids = &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{Data: ids},
+				},
+			}
+			return data
+		}
+		// the empty string fills a hit without entity
+		data1 := genStrData([]string{"a", "b", "c", ""}, []float32{-1.0, -2.0, -3.0, -4.0})
+		data2 := genStrData([]string{"e", "a", "c", "d"}, []float32{-1.5, -1.0, -3.0, -3.5})
+		res, err := reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, nq, topk, 0, metricType, false)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "e", "b", "c"}, res.Results.Ids.GetStrId().GetData())
+		assert.Equal(t, []float32{1.0, 1.5, 2.0, 3.0}, res.Results.Scores)
+	})
+}
+
+func TestSearchTask_ReduceWithOffset(t *testing.T) {
+	ids1 := []int64{1, 2, 3, 4}
+	scores1 := []float32{-1.0, -2.0, -3.0, -4.0}
+	ids2 := []int64{5, 1, 3, 6}
+	scores2 := []float32{-1.5, -1.0, -3.0, -3.5}
+	dataArray := []*schemapb.SearchResultData{
+		genSearchResultData(1, 4, ids1, scores1),
+		genSearchResultData(1, 4, ids2, scores2),
+	}
+	// topk 2 with offset 2
+	res, err := reduceSearchResultData(dataArray, 1, 4, 2, "L2", false)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 3}, res.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{2.0, 3.0}, res.Results.Scores)
+	assert.Equal(t, []int64{2}, res.Results.Topks)
+
+	res, err = reduceSearchResultData(dataArray, 1, 4, 10, "L2", false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(res.Results.Ids.GetIntId().Data))
+	assert.Equal(t, []int64{0}, res.Results.Topks)
+}
+
+func TestQueryTask_checkPagination(t *testing.T) {
+	qt := &queryTask{
+		query:   &milvuspb.QueryRequest{},
+		pkField: &schemapb.FieldSchema{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+	}
+	assert.NoError(t, qt.checkPagination())
+
+	qt.query.Limit = 10
+	assert.NoError(t, qt.checkPagination())
+
+	qt.query.Offset = 10
+	assert.NoError(t, qt.checkPagination())
+
+	qt.query.Cursor = "cursor"
+	assert.Error(t, qt.checkPagination())
+
+	qt.query.Cursor = ""
+	qt.query.Limit = maxPaginationWindow
+	assert.Error(t, qt.checkPagination())
+
+	qt.query.Limit = 0
+	assert.Error(t, qt.checkPagination())
+
+	qt.query.Offset = -1
+	assert.Error(t, qt.checkPagination())
+
+	qt.query.Offset = 0
+	qt.query.Limit = 10
+	qt.pkField = nil
+	assert.Error(t, qt.checkPagination())
+}
+
+func TestQueryTask_mergeRetrieveResults(t *testing.T) {
+	genResult := func(ids []int64) *internalpb.RetrieveResults {
+		values := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, id*10)
+		}
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: ids},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "int64",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{Data: values},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	results := []*internalpb.RetrieveResults{genResult([]int64{5, 1, 3}), genResult([]int64{2, 1, 4}), nil}
+
+	ret, err := mergeRetrieveResults(results, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(ret.FieldsData[0].GetScalars().GetLongData().Data))
+
+	ret, err = mergeRetrieveResults(results, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 20}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret, err = mergeRetrieveResults(results, 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{30, 40}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret, err = mergeRetrieveResults(results, 4, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{50}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret, err = mergeRetrieveResults(results, 5, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(ret.FieldsData))
+
+	mismatched := genResult([]int64{7})
+	mismatched.FieldsData = append(mismatched.FieldsData, mismatched.FieldsData[0])
+	_, err = mergeRetrieveResults(append(results, mismatched), 0, 2)
+	assert.Error(t, err)
+
+	genStrResult := func(ids []string, values []int64) *internalpb.RetrieveResults {
+		result := genResult(values)
+		result.Ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{Data: ids},
+			},
+		}
+		return result
+	}
+	strResults := []*internalpb.RetrieveResults{genStrResult([]string{"e", "a", "c"}, []int64{5, 1, 3}), genStrResult([]string{"b", "a", "d"}, []int64{2, 1, 4})}
+
+	ret, err = mergeRetrieveResults(strResults, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{50, 10, 30, 20, 40}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+
+	ret, err = mergeRetrieveResults(strResults, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{20, 30}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+}
+
+func TestSearchTask_parseRangeSearchParams(t *testing.T) {
+	params, err := parseRangeSearchParams(`{"nprobe": 10}`, "L2")
+	assert.NoError(t, err)
+	assert.Nil(t, params)
+
+	params, err = parseRangeSearchParams(`{"nprobe": 10, "radius": 10, "range_filter": 1.5}`, "L2")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(10), params.Radius)
+	assert.Equal(t, float32(1.5), params.RangeFilter)
+	assert.True(t, params.HasRangeFilter)
+
+	params, err = parseRangeSearchParams(`{"nprobe": 10, "radius": "0.5"}`, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.5), params.Radius)
+	assert.False(t, params.HasRangeFilter)
+
+	_, err = parseRangeSearchParams(`{"nprobe": 10, "radius": 10, "range_filter": 20}`, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchParams(`{"nprobe": 10, "range_filter": 1}`, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchParams(`{"nprobe": 10, "radius": "a"}`, "L2")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchParams(`{"nprobe": 10, "radius": 1, "range_filter": "a"}`, "IP")
+	assert.Error(t, err)
+
+	_, err = parseRangeSearchParams(`nprobe`, "L2")
+	assert.Error(t, err)
 }
 
 func TestQueryTask_all(t *testing.T) {
@@ -3189,6 +3799,8 @@ func TestQueryTask_all(t *testing.T) {
 
 	Params.Init()
 	Params.ProxyCfg.RetrieveResultChannelNames = []string{funcutil.GenRandomStr()}
+	// the request is sent by the query msgstream
+	Params.ProxyCfg.SearchByGrpc = false
 
 	rc := NewRootCoordMock()
 	rc.Start()
@@ -3248,7 +3860,7 @@ func TestQueryTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -3568,7 +4180,7 @@ func TestTask_all(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -3833,6 +4445,83 @@ func TestTask_all(t *testing.T) {
 		assert.NoError(t, task.Execute(ctx))
 		assert.NoError(t, task.PostExecute(ctx))
 	})
+
+	t.Run("upsert", func(t *testing.T) {
+		hash := generateHashKeys(nb)
+		req := &milvuspb.UpsertRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Upsert,
+				SourceID: Params.ProxyCfg.ProxyID,
+			},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Bool, boolField, nb),
+				newScalarFieldData(schemapb.DataType_Int32, int32Field, nb),
+				newScalarFieldData(schemapb.DataType_Int64, int64Field, nb),
+				newScalarFieldData(schemapb.DataType_Float, floatField, nb),
+				newScalarFieldData(schemapb.DataType_Double, doubleField, nb),
+				newFloatVectorFieldData(floatVecField, nb, dim),
+				newBinaryVectorFieldData(binaryVecField, nb, dim),
+			},
+			HashKeys: hash,
+			NumRows:  uint32(nb),
+		}
+		task := newUpsertTask(ctx, req, idAllocator, segAllocator, chMgr, ticker)
+
+		assert.NoError(t, task.OnEnqueue())
+		assert.NotNil(t, task.TraceCtx())
+		assert.Equal(t, upsertTaskName, task.Name())
+		assert.Equal(t, commonpb.MsgType_Upsert, task.Type())
+
+		id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+		task.SetID(id)
+		assert.Equal(t, id, task.ID())
+		assert.Equal(t, id, task.deleteMsg.Base.MsgID)
+
+		ts := Timestamp(time.Now().UnixNano())
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+		assert.Equal(t, ts, task.EndTs())
+		assert.Equal(t, ts, task.deleteMsg.Base.Timestamp)
+
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, int64(nb), task.result.UpsertCnt)
+		assert.Equal(t, req.FieldsData[2].GetScalars().GetLongData().GetData(), task.deleteMsg.PrimaryKeys)
+		assert.Equal(t, task.insertTask.HashValues, task.deleteMsg.HashValues)
+		for i := 0; i < nb; i++ {
+			assert.Equal(t, ts, task.deleteMsg.Timestamps[i])
+			assert.Equal(t, ts, task.insertTask.Timestamps[i])
+		}
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+	})
+
+	t.Run("upsert with duplicate primary keys", func(t *testing.T) {
+		pkData := newScalarFieldData(schemapb.DataType_Int64, int64Field, nb)
+		pkData.GetScalars().GetLongData().Data[1] = pkData.GetScalars().GetLongData().Data[0]
+		req := &milvuspb.UpsertRequest{
+			Base:           &commonpb.MsgBase{},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Bool, boolField, nb),
+				newScalarFieldData(schemapb.DataType_Int32, int32Field, nb),
+				pkData,
+				newScalarFieldData(schemapb.DataType_Float, floatField, nb),
+				newScalarFieldData(schemapb.DataType_Double, doubleField, nb),
+				newFloatVectorFieldData(floatVecField, nb, dim),
+				newBinaryVectorFieldData(binaryVecField, nb, dim),
+			},
+			HashKeys: generateHashKeys(nb),
+			NumRows:  uint32(nb),
+		}
+		task := newUpsertTask(ctx, req, idAllocator, segAllocator, chMgr, ticker)
+		assert.NoError(t, task.OnEnqueue())
+		task.SetTs(Timestamp(time.Now().UnixNano()))
+		assert.Error(t, task.PreExecute(ctx))
+	})
 }
 
 func TestCreateAlias_all(t *testing.T) {
@@ -3957,3 +4646,250 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+func TestAddFieldTask_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	ctx := context.Background()
+	assert.NoError(t, InitMetaCache(rc))
+	prefix := "TestAddFieldTask_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	task := &AddFieldTask{
+		Condition: NewTaskCondition(ctx),
+		AddFieldRequest: &milvuspb.AddFieldRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			Field: &schemapb.FieldSchema{
+				Name:     "age",
+				DataType: schemapb.DataType_Int32,
+			},
+		},
+		ctx:        ctx,
+		rootCoord:  rc,
+		queryCoord: qc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, AddFieldTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	// no default value
+	assert.Error(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AddField, task.Type())
+
+	task.Field.DataType = schemapb.DataType_FloatVector
+	task.Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}}
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.Field.DataType = schemapb.DataType_Int32
+	task.Field.IsPrimaryKey = true
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.Field.IsPrimaryKey = false
+	assert.NoError(t, task.PreExecute(ctx))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", collectionName)
+	assert.NoError(t, err)
+	status, err = qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		CollectionID: collectionID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	// the collection should be released first
+	assert.Error(t, task.PreExecute(ctx))
+	status, err = qc.ReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{
+		CollectionID: collectionID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.NoError(t, task.PreExecute(ctx))
+
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+	assert.NoError(t, task.PostExecute(ctx))
+
+	globalMetaCache.RemoveCollection(ctx, "", collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, "", collectionName)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), collSchema.Version)
+	assert.Equal(t, "age", collSchema.Fields[len(collSchema.Fields)-1].Name)
+
+	// inserted rows get the default value of the added field
+	it := &insertTask{
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_FloatVector,
+					FieldName: "fvec",
+				},
+			},
+		},
+		schema: collSchema,
+	}
+	assert.NoError(t, it.fillDefaultFieldsData())
+	assert.Equal(t, 2, len(it.req.FieldsData))
+	assert.Equal(t, "age", it.req.FieldsData[1].FieldName)
+	assert.Equal(t, []int32{18, 18}, it.req.FieldsData[1].GetScalars().GetIntData().GetData())
+}
+
+func TestDatabaseTasks_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	assert.NoError(t, InitMetaCache(rc))
+	dbName := "TestDatabaseTasks_all" + funcutil.GenRandomStr()
+
+	createTask := &CreateDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		CreateDatabaseRequest: &milvuspb.CreateDatabaseRequest{
+			Base:   nil,
+			DbName: dbName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, createTask.OnEnqueue())
+	assert.NotNil(t, createTask.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	createTask.SetID(id)
+	assert.Equal(t, id, createTask.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	createTask.SetTs(ts)
+	assert.Equal(t, ts, createTask.BeginTs())
+	assert.Equal(t, ts, createTask.EndTs())
+	assert.NoError(t, createTask.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_CreateDatabase, createTask.Type())
+	assert.NoError(t, createTask.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, createTask.result.ErrorCode)
+	assert.NoError(t, createTask.PostExecute(ctx))
+
+	createTask.DbName = "$invalid"
+	assert.Error(t, createTask.PreExecute(ctx))
+
+	listTask := &ListDatabasesTask{
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: &milvuspb.ListDatabasesRequest{},
+		ctx:                  ctx,
+		rootCoord:            rc,
+	}
+	assert.NoError(t, listTask.OnEnqueue())
+	assert.NoError(t, listTask.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_ListDatabases, listTask.Type())
+	assert.NoError(t, listTask.Execute(ctx))
+	assert.NoError(t, listTask.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, listTask.result.Status.ErrorCode)
+	assert.Contains(t, listTask.result.DbNames, dbName)
+	assert.Contains(t, listTask.result.DbNames, common.DefaultDBName)
+
+	dropTask := &DropDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		DropDatabaseRequest: &milvuspb.DropDatabaseRequest{
+			Base:   nil,
+			DbName: common.DefaultDBName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, dropTask.OnEnqueue())
+	assert.Error(t, dropTask.PreExecute(ctx))
+
+	dropTask.DbName = dbName
+	assert.NoError(t, dropTask.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_DropDatabase, dropTask.Type())
+	assert.NoError(t, dropTask.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, dropTask.result.ErrorCode)
+	assert.NoError(t, dropTask.PostExecute(ctx))
+}
+
+func TestSelectReplica(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewMetaCache(nil)
+	assert.NoError(t, err)
+	globalMetaCache = cache
+
+	qc := NewQueryCoordMock()
+	qc.Start()
+	defer qc.Stop()
+	// collection without replica
+	replicaID, err := selectReplica(ctx, qc, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(0), replicaID)
+
+	replicas := []*querypb.ReplicaInfo{
+		{ReplicaID: 10, CollectionID: 1, NodeIds: []int64{1}},
+		{ReplicaID: 20, CollectionID: 1, NodeIds: []int64{2}},
+	}
+	var calls int32
+	qc = NewQueryCoordMock(SetQueryCoordGetReplicasFunc(func(ctx context.Context, request *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+		atomic.AddInt32(&calls, 1)
+		return &querypb.GetReplicasResponse{
+			Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Replicas: replicas,
+		}, nil
+	}))
+	qc.Start()
+	defer qc.Stop()
+	// the replicas are cached until they are cleared
+	replicaID, err = selectReplica(ctx, qc, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(0), replicaID)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	globalMetaCache.ClearReplicas(1)
+
+	// the replicas serve the requests in turn
+	selected := make(map[UniqueID]struct{})
+	for i := 0; i < len(replicas); i++ {
+		replicaID, err = selectReplica(ctx, qc, 1)
+		assert.NoError(t, err)
+		selected[replicaID] = struct{}{}
+	}
+	assert.Equal(t, 2, len(selected))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	globalMetaCache.ClearReplicas(1)
+	failedQc := NewQueryCoordMock(SetQueryCoordGetReplicasFunc(func(ctx context.Context, request *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+		return &querypb.GetReplicasResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "no available replica"},
+		}, nil
+	}))
+	failedQc.Start()
+	defer failedQc.Stop()
+	_, err = selectReplica(ctx, failedQc, 1)
+	assert.Error(t, err)
+
+	// failures are not cached
+	replicaID, err = selectReplica(ctx, qc, 1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, UniqueID(0), replicaID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -24,10 +24,23 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-// enableMultipleVectorFields indicates whether to enable multiple vector fields.
-const enableMultipleVectorFields = false
+// maxLengthKey is the type param key of the max byte length of a string field.
+const maxLengthKey = "max_length"
+
+// maxStringLength is the upper limit of the max_length type param.
+const maxStringLength = 65535
+
+// maxUsernameLength is the upper limit of the length of a username or a role name.
+const maxUsernameLength = 32
+
+// minPasswordLength and maxPasswordLength limit the length of a password.
+const (
+	minPasswordLength = 6
+	maxPasswordLength = 256
+)
 
 // isAlpha check if c is alpha.
 func isAlpha(c uint8) bool {
@@ -84,6 +97,38 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+// validateDatabaseName follows the naming rules of collections, an empty name is
+// rejected here since it refers to the default database.
+func validateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("database name should not be empty")
+	}
+
+	invalidMsg := fmt.Sprintf("Invalid database name: %s. ", dbName)
+	if int64(len(dbName)) > Params.ProxyCfg.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && c != '$' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters, dollars and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func validatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -160,6 +205,28 @@ func validateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+// validateMaxLength checks the max_length type param of a string field.
+func validateMaxLength(field *schemapb.FieldSchema) error {
+	maxLength, err := getMaxLength(field)
+	if err != nil {
+		return err
+	}
+	if maxLength <= 0 || maxLength > maxStringLength {
+		return fmt.Errorf("invalid max_length: %d of field %s. should be in range 1 ~ %d", maxLength, field.Name, maxStringLength)
+	}
+	return nil
+}
+
+// getMaxLength returns the max_length type param of a string field.
+func getMaxLength(field *schemapb.FieldSchema) (int64, error) {
+	for _, param := range field.TypeParams {
+		if param.Key == maxLengthKey {
+			return strconv.ParseInt(param.Value, 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("max_length is not defined in type params of string field %s", field.Name)
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
 		return nil
@@ -196,6 +263,9 @@ func ValidateFieldAutoID(coll *schemapb.CollectionSchema) error {
 			if !field.IsPrimaryKey {
 				return fmt.Errorf("only primary field can speficy AutoID with true, field name = %s", field.Name)
 			}
+			if field.DataType != schemapb.DataType_Int64 {
+				return fmt.Errorf("only int64 primary field can speficy AutoID with true, field name = %s", field.Name)
+			}
 		}
 	}
 	return nil
@@ -208,8 +278,8 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 			if idx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return errors.New("the data type of primary key should be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return errors.New("the data type of primary key should be int64 or string")
 			}
 			idx = i
 		}
@@ -220,6 +290,51 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validatePartitionKey checks there is at most one partition key, which is an int64 or string field other than the primary key
+func validatePartitionKey(coll *schemapb.CollectionSchema, numPartitions int64) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !field.IsPartitionKey {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one partition key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+		if field.IsPrimaryKey {
+			return fmt.Errorf("the primary key %s can't be the partition key", field.Name)
+		}
+		if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+			return errors.New("the data type of partition key should be int64 or string")
+		}
+		idx = i
+	}
+	if numPartitions < 0 {
+		return fmt.Errorf("num_partitions %d should not be negative", numPartitions)
+	}
+	if idx == -1 && numPartitions > 0 {
+		return errors.New("num_partitions can only be set for a collection with partition key")
+	}
+	return nil
+}
+
+// validateClusteringKey checks there is at most one clustering key, which is a numeric or string field
+func validateClusteringKey(coll *schemapb.CollectionSchema) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !field.IsClusteringKey {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one clustering key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+		if !typeutil.IsClusteringKeyType(field.DataType) {
+			return fmt.Errorf("the data type %s of clustering key %s should be numeric or string", field.DataType.String(), field.Name)
+		}
+		idx = i
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -239,7 +354,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_JSON:
 		return false, nil
 
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -283,8 +399,8 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			} else if primaryIdx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[primaryIdx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return fmt.Errorf("type of primary key shoule be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_String {
+				return fmt.Errorf("type of primary key shoule be int64 or string")
 			}
 			primaryIdx = idx
 		}
@@ -350,26 +466,65 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
-// validateMultipleVectorFields check if schema has multiple vector fields.
+// validateMultipleVectorFields checks that the number of vector fields in schema doesn't exceed
+// the limit of proxy.maxVectorFieldNum, the vector fields are searched by hybrid search together.
 func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
-	vecExist := false
-	var vecName string
-
+	var vecNames []string
 	for i := range schema.Fields {
-		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := (dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector)
-		if isVec && vecExist && !enableMultipleVectorFields {
-			return fmt.Errorf(
-				"multiple vector fields is not supported, fields name: %s, %s",
-				vecName,
-				name,
-			)
-		} else if isVec {
-			vecExist = true
-			vecName = name
+		if dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector {
+			vecNames = append(vecNames, schema.Fields[i].Name)
+		}
+	}
+	if int64(len(vecNames)) > Params.ProxyCfg.MaxVectorFieldNum {
+		return fmt.Errorf("maximum vector field's number should be limited to %d, vector fields: %s",
+			Params.ProxyCfg.MaxVectorFieldNum, strings.Join(vecNames, ", "))
+	}
+
+	return nil
+}
+
+// validateUsernameOrRoleName checks that the name starts with a letter, only contains
+// letters, numbers and underscores, and is no longer than maxUsernameLength.
+func validateUsernameOrRoleName(entity, entityType string) error {
+	entity = strings.TrimSpace(entity)
+
+	if entity == "" {
+		return fmt.Errorf("%s should not be empty", entityType)
+	}
+
+	invalidMsg := fmt.Sprintf("Invalid %s: %s. ", entityType, entity)
+	if len(entity) > maxUsernameLength {
+		return fmt.Errorf("%sThe length of %s must be not greater than %d characters.", invalidMsg, entityType, maxUsernameLength)
+	}
+
+	if !isAlpha(entity[0]) {
+		return fmt.Errorf("%sThe first character of %s must be a letter.", invalidMsg, entityType)
+	}
+
+	for i := 1; i < len(entity); i++ {
+		c := entity[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			return fmt.Errorf("%s%s should only contain numbers, letters and underscores.", invalidMsg, entityType)
 		}
 	}
+	return nil
+}
 
+// ValidateUsername returns nil if username is a valid username.
+func ValidateUsername(username string) error {
+	return validateUsernameOrRoleName(username, "username")
+}
+
+// ValidateRoleName returns nil if roleName is a valid role name.
+func ValidateRoleName(roleName string) error {
+	return validateUsernameOrRoleName(roleName, "role name")
+}
+
+// ValidatePassword returns nil if the length of password is between minPasswordLength and maxPasswordLength.
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("the length of password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
 	return nil
 }
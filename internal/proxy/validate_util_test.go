@@ -17,6 +17,8 @@
 package proxy
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -50,6 +52,30 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, validateDatabaseName("abc"))
+	assert.Nil(t, validateDatabaseName("_123abc"))
+	assert.Nil(t, validateDatabaseName("abc123_$"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateDatabaseName(name))
+	}
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, validatePartitionTag("abc", true))
 	assert.Nil(t, validatePartitionTag("123abc", true))
@@ -196,6 +222,101 @@ func TestValidatePrimaryKey(t *testing.T) {
 	assert.NotNil(t, validateSchema(&coll))
 }
 
+func TestValidatePrimaryKey_String(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name:   "coll1",
+		AutoID: false,
+		Fields: []*schemapb.FieldSchema{
+			{
+				Name:         "pk",
+				FieldID:      100,
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_String,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "64"}},
+			},
+		},
+	}
+	assert.Nil(t, validatePrimaryKey(coll))
+	assert.Nil(t, ValidateFieldAutoID(coll))
+
+	coll.Fields[0].AutoID = true
+	assert.NotNil(t, ValidateFieldAutoID(coll))
+}
+
+func TestValidatePartitionKey(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name: "coll1",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "tenant", FieldID: 101, DataType: schemapb.DataType_String},
+			{Name: "score", FieldID: 102, DataType: schemapb.DataType_Float},
+		},
+	}
+	assert.Nil(t, validatePartitionKey(coll, 0))
+	assert.NotNil(t, validatePartitionKey(coll, 16))
+
+	coll.Fields[1].IsPartitionKey = true
+	assert.Nil(t, validatePartitionKey(coll, 0))
+	assert.Nil(t, validatePartitionKey(coll, 16))
+	assert.NotNil(t, validatePartitionKey(coll, -1))
+
+	coll.Fields[0].IsPartitionKey = true
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+
+	coll.Fields[0].IsPartitionKey = false
+	coll.Fields[2].IsPartitionKey = true
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+
+	coll.Fields[1].IsPartitionKey = false
+	assert.NotNil(t, validatePartitionKey(coll, 0))
+}
+
+func TestValidateClusteringKey(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name: "coll1",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "age", FieldID: 101, DataType: schemapb.DataType_Int32},
+			{Name: "vec", FieldID: 102, DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	assert.Nil(t, validateClusteringKey(coll))
+
+	coll.Fields[1].IsClusteringKey = true
+	assert.Nil(t, validateClusteringKey(coll))
+
+	coll.Fields[0].IsClusteringKey = true
+	assert.NotNil(t, validateClusteringKey(coll))
+
+	coll.Fields[0].IsClusteringKey = false
+	coll.Fields[1].IsClusteringKey = false
+	coll.Fields[2].IsClusteringKey = true
+	assert.NotNil(t, validateClusteringKey(coll))
+}
+
+func TestValidateMaxLength(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:     "str",
+		DataType: schemapb.DataType_String,
+	}
+	assert.NotNil(t, validateMaxLength(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "abc"}}
+	assert.NotNil(t, validateMaxLength(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "0"}}
+	assert.NotNil(t, validateMaxLength(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "65536"}}
+	assert.NotNil(t, validateMaxLength(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: maxLengthKey, Value: "65535"}}
+	assert.Nil(t, validateMaxLength(field))
+	maxLength, err := getMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(65535), maxLength)
+}
+
 func TestValidateSchema(t *testing.T) {
 	coll := &schemapb.CollectionSchema{
 		Name:        "coll1",
@@ -374,6 +495,8 @@ func TestValidateSchema(t *testing.T) {
 }
 
 func TestValidateMultipleVectorFields(t *testing.T) {
+	Params.Init()
+
 	// case1, no vector field
 	schema1 := &schemapb.CollectionSchema{}
 	assert.NoError(t, validateMultipleVectorFields(schema1))
@@ -402,9 +525,37 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 			},
 		},
 	}
-	if enableMultipleVectorFields {
-		assert.NoError(t, validateMultipleVectorFields(schema3))
-	} else {
-		assert.Error(t, validateMultipleVectorFields(schema3))
+	assert.NoError(t, validateMultipleVectorFields(schema3))
+
+	// case4, vector fields more than the limit
+	schema4 := &schemapb.CollectionSchema{}
+	for i := int64(0); i <= Params.ProxyCfg.MaxVectorFieldNum; i++ {
+		schema4.Fields = append(schema4.Fields, &schemapb.FieldSchema{
+			Name:     fmt.Sprintf("case4_%d", i),
+			DataType: schemapb.DataType_FloatVector,
+		})
 	}
+	assert.Error(t, validateMultipleVectorFields(schema4))
+}
+
+func TestValidateUsername(t *testing.T) {
+	assert.Nil(t, ValidateUsername("user1"))
+	assert.Nil(t, ValidateUsername("user_1"))
+	assert.NotNil(t, ValidateUsername(""))
+	assert.NotNil(t, ValidateUsername("1user"))
+	assert.NotNil(t, ValidateUsername("_user"))
+	assert.NotNil(t, ValidateUsername("user-1"))
+	assert.NotNil(t, ValidateUsername(strings.Repeat("a", maxUsernameLength+1)))
+}
+
+func TestValidateRoleName(t *testing.T) {
+	assert.Nil(t, ValidateRoleName("role1"))
+	assert.NotNil(t, ValidateRoleName(""))
+	assert.NotNil(t, ValidateRoleName("role$"))
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Nil(t, ValidatePassword("password"))
+	assert.NotNil(t, ValidatePassword("pwd"))
+	assert.NotNil(t, ValidatePassword(strings.Repeat("a", maxPasswordLength+1)))
 }
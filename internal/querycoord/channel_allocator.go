@@ -39,6 +39,15 @@ func shuffleChannelsToQueryNode(ctx context.Context, reqs []*querypb.WatchDmChan
 	if len(reqs) == 0 {
 		return nil
 	}
+	replicaIDs := make([]UniqueID, 0, len(reqs))
+	for _, req := range reqs {
+		replicaIDs = append(replicaIDs, req.ReplicaID)
+	}
+	replicaNodeIDs, err := getReplicaNodeIDs(metaCache, replicaIDs)
+	if err != nil {
+		log.Error("shuffleChannelsToQueryNode failed", zap.Error(err))
+		return err
+	}
 	for {
 		onlineNodeIDs := cluster.onlineNodeIDs()
 		if len(onlineNodeIDs) == 0 {
@@ -65,15 +74,28 @@ func shuffleChannelsToQueryNode(ctx context.Context, reqs []*querypb.WatchDmChan
 
 		if len(availableNodeIDs) > 0 {
 			log.Debug("shuffleChannelsToQueryNode: shuffle channel to available QueryNode", zap.Int64s("available nodeIDs", availableNodeIDs))
+			allocated := true
 			for _, req := range reqs {
 				sort.Slice(availableNodeIDs, func(i, j int) bool {
 					return nodeID2NumChannels[availableNodeIDs[i]] < nodeID2NumChannels[availableNodeIDs[j]]
 				})
-				selectedNodeID := availableNodeIDs[0]
-				req.NodeID = selectedNodeID
-				nodeID2NumChannels[selectedNodeID]++
+				// the dmChannel can only be assigned to the nodes of its replica
+				allocated = false
+				for _, nodeID := range availableNodeIDs {
+					if nodeInReplica(nodeID, replicaNodeIDs[req.ReplicaID]) {
+						req.NodeID = nodeID
+						nodeID2NumChannels[nodeID]++
+						allocated = true
+						break
+					}
+				}
+				if !allocated {
+					break
+				}
+			}
+			if allocated {
+				return nil
 			}
-			return nil
 		}
 
 		if !wait {
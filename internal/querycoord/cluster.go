@@ -28,13 +28,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -82,7 +81,7 @@ type Cluster interface {
 	estimateSegmentsSize(segments *querypb.LoadSegmentsRequest) (int64, error)
 }
 
-type newQueryNodeFn func(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error)
+type newQueryNodeFn func(ctx context.Context, address string, id UniqueID, kv kv.MetaKv) (Node, error)
 
 type nodeState int
 
@@ -93,10 +92,10 @@ const (
 )
 
 type queryNodeCluster struct {
-	ctx    context.Context
-	cancel context.CancelFunc
-	client *etcdkv.EtcdKV
-	dataKV kv.DataKV
+	ctx          context.Context
+	cancel       context.CancelFunc
+	client       kv.MetaKv
+	chunkManager storage.ChunkManager
 
 	session        *sessionutil.Session
 	sessionVersion int64
@@ -107,10 +106,10 @@ type queryNodeCluster struct {
 	newNodeFn        newQueryNodeFn
 	segmentAllocator SegmentAllocatePolicy
 	channelAllocator ChannelAllocatePolicy
-	segSizeEstimator func(request *querypb.LoadSegmentsRequest, dataKV kv.DataKV) (int64, error)
+	segSizeEstimator func(request *querypb.LoadSegmentsRequest, chunkManager storage.ChunkManager) (int64, error)
 }
 
-func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdKV, newNodeFn newQueryNodeFn, session *sessionutil.Session) (Cluster, error) {
+func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv kv.MetaKv, newNodeFn newQueryNodeFn, session *sessionutil.Session) (Cluster, error) {
 	childCtx, cancel := context.WithCancel(ctx)
 	nodes := make(map[int64]Node)
 	c := &queryNodeCluster{
@@ -130,16 +129,7 @@ func newQueryNodeCluster(ctx context.Context, clusterMeta Meta, kv *etcdkv.EtcdK
 		return nil, err
 	}
 
-	option := &minioKV.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	c.dataKV, err = minioKV.NewMinIOKV(ctx, option)
+	c.chunkManager, err = storage.NewChunkManager(ctx, &Params)
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +271,7 @@ func (c *queryNodeCluster) watchDmChannels(ctx context.Context, nodeID int64, in
 				CollectionID: info.CollectionID,
 				DmChannel:    info.ChannelName,
 				NodeIDLoaded: nodeID,
+				ReplicaID:    in.ReplicaID,
 			}
 		}
 
@@ -696,16 +687,16 @@ func (c *queryNodeCluster) allocateChannelsToQueryNode(ctx context.Context, reqs
 }
 
 func (c *queryNodeCluster) estimateSegmentsSize(segments *querypb.LoadSegmentsRequest) (int64, error) {
-	return c.segSizeEstimator(segments, c.dataKV)
+	return c.segSizeEstimator(segments, c.chunkManager)
 }
 
 func defaultSegEstimatePolicy() segEstimatePolicy {
 	return estimateSegmentsSize
 }
 
-type segEstimatePolicy func(request *querypb.LoadSegmentsRequest, dataKv kv.DataKV) (int64, error)
+type segEstimatePolicy func(request *querypb.LoadSegmentsRequest, chunkManager storage.ChunkManager) (int64, error)
 
-func estimateSegmentsSize(segments *querypb.LoadSegmentsRequest, kvClient kv.DataKV) (int64, error) {
+func estimateSegmentsSize(segments *querypb.LoadSegmentsRequest, chunkManager storage.ChunkManager) (int64, error) {
 	requestSize := int64(0)
 	for _, loadInfo := range segments.Infos {
 		segmentSize := int64(0)
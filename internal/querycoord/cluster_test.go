@@ -29,7 +29,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/indexnode"
-	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
@@ -92,7 +91,7 @@ var uidField = constFieldParam{
 
 type indexParam = map[string]string
 
-func segSizeEstimateForTest(segments *querypb.LoadSegmentsRequest, dataKV kv.DataKV) (int64, error) {
+func segSizeEstimateForTest(segments *querypb.LoadSegmentsRequest, chunkManager storage.ChunkManager) (int64, error) {
 	sizePerRecord, err := typeutil.EstimateSizePerRecord(segments.Schema)
 	if err != nil {
 		return 0, err
@@ -237,8 +236,8 @@ func genStorageBlob(collectionID UniqueID,
 	return binLogs, err
 }
 
-func saveSimpleBinLog(ctx context.Context, schema *schemapb.CollectionSchema, dataKV kv.DataKV) ([]*datapb.FieldBinlog, error) {
-	return saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultNumRowPerSegment, schema, dataKV)
+func saveSimpleBinLog(ctx context.Context, schema *schemapb.CollectionSchema, chunkManager storage.ChunkManager) ([]*datapb.FieldBinlog, error) {
+	return saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultNumRowPerSegment, schema, chunkManager)
 }
 
 func saveBinLog(ctx context.Context,
@@ -247,14 +246,14 @@ func saveBinLog(ctx context.Context,
 	segmentID UniqueID,
 	msgLength int,
 	schema *schemapb.CollectionSchema,
-	dataKV kv.DataKV) ([]*datapb.FieldBinlog, error) {
+	chunkManager storage.ChunkManager) ([]*datapb.FieldBinlog, error) {
 	binLogs, err := genStorageBlob(collectionID, partitionID, segmentID, msgLength, schema)
 	if err != nil {
 		return nil, err
 	}
 
 	log.Debug(".. [query coord unittest] Saving bin logs to MinIO ..", zap.Int("number", len(binLogs)))
-	kvs := make(map[string]string, len(binLogs))
+	kvs := make(map[string][]byte, len(binLogs))
 
 	// write insert binlog
 	fieldBinlog := make([]*datapb.FieldBinlog, 0)
@@ -266,7 +265,7 @@ func saveBinLog(ctx context.Context,
 		}
 
 		key := genKey(collectionID, partitionID, segmentID, fieldID)
-		kvs[key] = string(blob.Value[:])
+		kvs[key] = blob.Value[:]
 		fieldBinlog = append(fieldBinlog, &datapb.FieldBinlog{
 			FieldID: fieldID,
 			Binlogs: []*datapb.Binlog{{LogPath: key}},
@@ -274,7 +273,7 @@ func saveBinLog(ctx context.Context,
 	}
 	log.Debug("[QueryCoord unittest] save binlog file to MinIO/S3")
 
-	err = dataKV.MultiSave(kvs)
+	err = chunkManager.MultiWrite(kvs)
 	return fieldBinlog, err
 }
 
@@ -179,6 +179,19 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 	if collectionInfo, err := qc.meta.getCollectionInfoByID(collectionID); err == nil {
 		// if collection has been loaded by load collection request, return success
 		if collectionInfo.LoadType == querypb.LoadType_loadCollection {
+			// a loaded collection can't change its replica number, it should be released first
+			if loadedNumber := len(qc.meta.getReplicasByCollectionID(collectionID)); (loadedNumber > 1 || req.ReplicaNumber > 1) && loadedNumber != int(req.ReplicaNumber) {
+				status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+				err = fmt.Errorf("collection %d has been loaded with %d replicas, please release it firstly", collectionID, loadedNumber)
+				status.Reason = err.Error()
+				log.Warn("loadCollectionRequest failed",
+					zap.String("role", typeutil.QueryCoordRole),
+					zap.Int64("collectionID", collectionID),
+					zap.Int32("replicaNumber", req.ReplicaNumber),
+					zap.Int64("msgID", req.Base.MsgID),
+					zap.Error(err))
+				return status, nil
+			}
 			log.Debug("collection has already been loaded, return load success directly",
 				zap.String("role", typeutil.QueryCoordRole),
 				zap.Int64("collectionID", collectionID),
@@ -885,6 +898,163 @@ func (qc *QueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalanceR
 	return status, nil
 }
 
+// GetReplicas returns the available replicas of the collection, a replica is available if all its query nodes are online
+func (qc *QueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	log.Debug("getReplicasRequest received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Int64("msgID", req.Base.MsgID))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Error("getReplicas failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, nil
+	}
+
+	loadedReplicas := qc.meta.getReplicasByCollectionID(req.CollectionID)
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, replica := range loadedReplicas {
+		if isReplicaAvailable(qc.cluster, replica) {
+			replicas = append(replicas, replica)
+		}
+	}
+	// an empty list means the collection isn't replicated, so it's an error if none of the replicas is available
+	if len(loadedReplicas) > 0 && len(replicas) == 0 {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("no available replica of collection %d", req.CollectionID)
+		status.Reason = err.Error()
+		log.Warn("getReplicas failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, nil
+	}
+
+	log.Debug("getReplicasRequest completed",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Any("replicas", replicas),
+		zap.Int64("msgID", req.Base.MsgID))
+
+	return &querypb.GetReplicasResponse{
+		Status:   status,
+		Replicas: replicas,
+	}, nil
+}
+
+// GetShardLeaders returns the QueryNodes watching the dmChannels of the collection in the replica,
+// and all the QueryNodes serving the collection in the replica, the leaders included
+func (qc *QueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	log.Debug("getShardLeadersRequest received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Int64("replicaID", req.ReplicaID),
+		zap.Int64("msgID", req.Base.MsgID))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Error("getShardLeaders failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, nil
+	}
+
+	shards, nodeIDs, nodeAddrs, err := qc.getShardLeaders(req.CollectionID, req.ReplicaID)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		log.Warn("getShardLeaders failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.Base.MsgID), zap.Error(err))
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, nil
+	}
+
+	log.Debug("getShardLeadersRequest completed",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Any("shards", shards),
+		zap.Int64s("nodeIDs", nodeIDs),
+		zap.Int64("msgID", req.Base.MsgID))
+
+	return &querypb.GetShardLeadersResponse{
+		Status:    status,
+		Shards:    shards,
+		NodeIds:   nodeIDs,
+		NodeAddrs: nodeAddrs,
+	}, nil
+}
+
+func (qc *QueryCoord) getShardLeaders(collectionID UniqueID, replicaID UniqueID) ([]*querypb.ShardLeader, []int64, []string, error) {
+	if !qc.meta.hasCollection(collectionID) {
+		return nil, nil, nil, fmt.Errorf("collection %d has not been loaded", collectionID)
+	}
+
+	addrs := make(map[int64]string)
+	getAddr := func(nodeID int64) (string, error) {
+		if addr, ok := addrs[nodeID]; ok {
+			return addr, nil
+		}
+		node, err := qc.cluster.getNodeInfoByID(nodeID)
+		if err != nil {
+			return "", err
+		}
+		if !node.isOnline() {
+			return "", fmt.Errorf("QueryNode %d is offline", nodeID)
+		}
+		addrs[nodeID] = node.getAddress()
+		return addrs[nodeID], nil
+	}
+
+	shards := make([]*querypb.ShardLeader, 0)
+	for _, info := range qc.meta.getDmChannelInfosByCollectionID(collectionID) {
+		if info.ReplicaID != replicaID {
+			continue
+		}
+		addr, err := getAddr(info.NodeIDLoaded)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("leader of channel %s is unavailable: %w", info.DmChannel, err)
+		}
+		shards = append(shards, &querypb.ShardLeader{
+			ChannelName: info.DmChannel,
+			NodeID:      info.NodeIDLoaded,
+			Address:     addr,
+		})
+	}
+	if len(shards) == 0 {
+		return nil, nil, nil, fmt.Errorf("no dmChannel of collection %d is watched in replica %d", collectionID, replicaID)
+	}
+
+	for _, info := range qc.meta.showSegmentInfos(collectionID, nil) {
+		for _, nodeID := range getSegmentNodeIDs(info) {
+			if getSegmentReplicaIDOnNode(info, nodeID) != replicaID {
+				continue
+			}
+			if _, err := getAddr(nodeID); err != nil {
+				return nil, nil, nil, fmt.Errorf("QueryNode holding segment %d is unavailable: %w", info.SegmentID, err)
+			}
+		}
+	}
+
+	nodeIDs := make([]int64, 0, len(addrs))
+	nodeAddrs := make([]string, 0, len(addrs))
+	for nodeID, addr := range addrs {
+		nodeIDs = append(nodeIDs, nodeID)
+		nodeAddrs = append(nodeAddrs, addr)
+	}
+	return shards, nodeIDs, nodeAddrs, nil
+}
+
 // GetMetrics returns all the queryCoord's metrics
 func (qc *QueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("getMetricsRequest received",
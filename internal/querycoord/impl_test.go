@@ -290,6 +290,34 @@ func TestGrpcTask(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test GetShardLeaders", func(t *testing.T) {
+		res, err := queryCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetShardLeaders,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+		assert.NotEmpty(t, res.Shards)
+		for _, shard := range res.Shards {
+			assert.Equal(t, node.queryNodeID, shard.NodeID)
+		}
+		assert.ElementsMatch(t, []int64{node.queryNodeID}, res.NodeIds)
+		assert.Equal(t, len(res.NodeIds), len(res.NodeAddrs))
+	})
+
+	t.Run("Test GetShardLeadersOfNotLoadedCol", func(t *testing.T) {
+		res, err := queryCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetShardLeaders,
+			},
+			CollectionID: -1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+	})
+
 	t.Run("Test ReleaseParOfNotLoadedCol", func(t *testing.T) {
 		status, err := queryCoord.ReleasePartitions(ctx, &querypb.ReleasePartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -692,6 +720,17 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test GetShardLeaders", func(t *testing.T) {
+		res, err := unHealthyCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetShardLeaders,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test LoadBalance", func(t *testing.T) {
 		res, err := unHealthyCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
 			Base: &commonpb.MsgBase{
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -43,6 +44,7 @@ const (
 	collectionMetaPrefix   = "queryCoord-collectionMeta"
 	dmChannelMetaPrefix    = "queryCoord-dmChannelWatchInfo"
 	deltaChannelMetaPrefix = "queryCoord-deltaChannel"
+	replicaMetaPrefix      = "queryCoord-replicaMeta"
 )
 
 type col2SegmentInfos = map[UniqueID][]*querypb.SegmentInfo
@@ -72,11 +74,17 @@ type Meta interface {
 	getPartitionStatesByID(collectionID UniqueID, partitionID UniqueID) (*querypb.PartitionStates, error)
 
 	getDmChannelInfosByNodeID(nodeID int64) []*querypb.DmChannelWatchInfo
+	getDmChannelInfosByCollectionID(collectionID UniqueID) []*querypb.DmChannelWatchInfo
 	setDmChannelInfos(channelInfos []*querypb.DmChannelWatchInfo) error
 
 	getDeltaChannelsByCollectionID(collectionID UniqueID) ([]*datapb.VchannelInfo, error)
 	setDeltaChannel(collectionID UniqueID, info []*datapb.VchannelInfo) error
 
+	addReplicas(collectionID UniqueID, nodeGroups [][]int64) ([]*querypb.ReplicaInfo, error)
+	setReplicaInfo(info *querypb.ReplicaInfo) error
+	getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error)
+	getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo
+
 	getQueryChannelInfoByID(collectionID UniqueID) *querypb.QueryChannelInfo
 	getQueryStreamByID(collectionID UniqueID, queryChannel string) (msgstream.MsgStream, error)
 
@@ -109,6 +117,8 @@ type MetaReplica struct {
 	dmChannelMu       sync.RWMutex
 	queryStreams      map[UniqueID]msgstream.MsgStream
 	streamMu          sync.RWMutex
+	replicaInfos      map[UniqueID]*querypb.ReplicaInfo
+	replicaMu         sync.RWMutex
 
 	//partitionStates map[UniqueID]*querypb.PartitionStates
 }
@@ -121,6 +131,7 @@ func newMeta(ctx context.Context, kv kv.MetaKv, factory msgstream.Factory, idAll
 	deltaChannelInfos := make(map[UniqueID][]*datapb.VchannelInfo)
 	dmChannelInfos := make(map[string]*querypb.DmChannelWatchInfo)
 	queryMsgStream := make(map[UniqueID]msgstream.MsgStream)
+	replicaInfos := make(map[UniqueID]*querypb.ReplicaInfo)
 
 	m := &MetaReplica{
 		ctx:         childCtx,
@@ -135,6 +146,7 @@ func newMeta(ctx context.Context, kv kv.MetaKv, factory msgstream.Factory, idAll
 		deltaChannelInfos: deltaChannelInfos,
 		dmChannelInfos:    dmChannelInfos,
 		queryStreams:      queryMsgStream,
+		replicaInfos:      replicaInfos,
 	}
 
 	err := m.reloadFromKV()
@@ -204,13 +216,25 @@ func (m *MetaReplica) reloadFromKV() error {
 		return err
 	}
 	for index := range dmChannelKeys {
-		dmChannel := filepath.Base(dmChannelKeys[index])
 		dmChannelWatchInfo := &querypb.DmChannelWatchInfo{}
 		err = proto.Unmarshal([]byte(dmChannelValues[index]), dmChannelWatchInfo)
 		if err != nil {
 			return err
 		}
-		m.dmChannelInfos[dmChannel] = dmChannelWatchInfo
+		m.dmChannelInfos[getDmChannelWatchInfoKey(dmChannelWatchInfo)] = dmChannelWatchInfo
+	}
+
+	_, replicaValues, err := m.client.LoadWithPrefix(replicaMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range replicaValues {
+		replicaInfo := &querypb.ReplicaInfo{}
+		err = proto.Unmarshal([]byte(value), replicaInfo)
+		if err != nil {
+			return err
+		}
+		m.replicaInfos[replicaInfo.ReplicaID] = replicaInfo
 	}
 
 	//TODO::update partition states
@@ -387,6 +411,14 @@ func (m *MetaReplica) releaseCollection(collectionID UniqueID) error {
 	}
 	m.dmChannelMu.Unlock()
 
+	m.replicaMu.Lock()
+	for replicaID, info := range m.replicaInfos {
+		if info.CollectionID == collectionID {
+			delete(m.replicaInfos, replicaID)
+		}
+	}
+	m.replicaMu.Unlock()
+
 	return nil
 }
 
@@ -452,6 +484,9 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 			},
 			Infos: []*querypb.SegmentChangeInfo{},
 		}
+		// a replicated segment is saved once per replica, merge them into a single segmentInfo
+		mergedInfos := make(map[UniqueID]*querypb.SegmentInfo)
+		compactedSegmentIDs := make(map[UniqueID]struct{})
 		for _, info := range onlineInfos {
 			segmentID := info.SegmentID
 			onlineNodeID := info.NodeID
@@ -459,8 +494,25 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 				OnlineNodeID:   onlineNodeID,
 				OnlineSegments: []*querypb.SegmentInfo{info},
 			}
-			offlineInfo, err := m.getSegmentInfoByID(segmentID)
-			if err == nil {
+			offlineInfo, ok := mergedInfos[segmentID]
+			if !ok {
+				var err error
+				offlineInfo, err = m.getSegmentInfoByID(segmentID)
+				ok = err == nil
+			}
+			if ok && len(info.ReplicaIds) > 0 {
+				// the segment is replaced only in the replica it is loaded into, placements in other replicas are kept
+				replicaID := info.ReplicaIds[0]
+				for offset, id := range offlineInfo.ReplicaIds {
+					if id != replicaID {
+						info.ReplicaIds = append(info.ReplicaIds, id)
+						info.NodeIds = append(info.NodeIds, offlineInfo.NodeIds[offset])
+					} else if offlineInfo.SegmentState == commonpb.SegmentState_Sealed {
+						changeInfo.OfflineNodeID = offlineInfo.NodeIds[offset]
+						changeInfo.OfflineSegments = []*querypb.SegmentInfo{offlineInfo}
+					}
+				}
+			} else if ok {
 				offlineNodeID := offlineInfo.NodeID
 				// if the offline segment state is growing, it will not impact the global sealed segments
 				if offlineInfo.SegmentState == commonpb.SegmentState_Sealed {
@@ -469,22 +521,35 @@ func (m *MetaReplica) saveGlobalSealedSegInfos(saves col2SegmentInfos) (col2Seal
 				}
 			}
 			segmentsChangeInfo.Infos = append(segmentsChangeInfo.Infos, changeInfo)
+			mergedInfos[segmentID] = info
 
 			// generate offline segment change info if the loaded segment is compacted from other sealed segments
 			for _, compactionSegmentID := range info.CompactionFrom {
+				if _, ok := compactedSegmentIDs[compactionSegmentID]; ok {
+					continue
+				}
 				compactionSegmentInfo, err := m.getSegmentInfoByID(compactionSegmentID)
 				if err == nil && compactionSegmentInfo.SegmentState == commonpb.SegmentState_Sealed {
-					segmentsChangeInfo.Infos = append(segmentsChangeInfo.Infos, &querypb.SegmentChangeInfo{
-						OfflineNodeID:   compactionSegmentInfo.NodeID,
-						OfflineSegments: []*querypb.SegmentInfo{compactionSegmentInfo},
-					})
+					for _, nodeID := range getSegmentNodeIDs(compactionSegmentInfo) {
+						segmentsChangeInfo.Infos = append(segmentsChangeInfo.Infos, &querypb.SegmentChangeInfo{
+							OfflineNodeID:   nodeID,
+							OfflineSegments: []*querypb.SegmentInfo{compactionSegmentInfo},
+						})
+					}
 					segmentsCompactionFrom = append(segmentsCompactionFrom, compactionSegmentInfo)
+					compactedSegmentIDs[compactionSegmentID] = struct{}{}
 				} else {
 					return nil, fmt.Errorf("saveGlobalSealedSegInfos: the compacted segment %d has not been loaded into memory", compactionSegmentID)
 				}
 			}
 		}
 		col2SegmentChangeInfos[collectionID] = segmentsChangeInfo
+
+		infos := make([]*querypb.SegmentInfo, 0, len(mergedInfos))
+		for _, info := range mergedInfos {
+			infos = append(infos, info)
+		}
+		saves[collectionID] = infos
 	}
 
 	queryChannelInfosMap := make(map[UniqueID]*querypb.QueryChannelInfo)
@@ -600,13 +665,14 @@ func (m *MetaReplica) removeGlobalSealedSegInfos(collectionID UniqueID, partitio
 		Infos: []*querypb.SegmentChangeInfo{},
 	}
 	for _, info := range removes {
-		offlineNodeID := info.NodeID
-		changeInfo := &querypb.SegmentChangeInfo{
-			OfflineNodeID:   offlineNodeID,
-			OfflineSegments: []*querypb.SegmentInfo{info},
-		}
+		for _, offlineNodeID := range getSegmentNodeIDs(info) {
+			changeInfo := &querypb.SegmentChangeInfo{
+				OfflineNodeID:   offlineNodeID,
+				OfflineSegments: []*querypb.SegmentInfo{info},
+			}
 
-		segmentChangeInfos.Infos = append(segmentChangeInfos.Infos, changeInfo)
+			segmentChangeInfos.Infos = append(segmentChangeInfos.Infos, changeInfo)
+		}
 	}
 
 	// produce sealedSegmentChangeInfos to query channel
@@ -764,7 +830,7 @@ func (m *MetaReplica) getSegmentInfosByNode(nodeID int64) []*querypb.SegmentInfo
 
 	segmentInfos := make([]*querypb.SegmentInfo, 0)
 	for _, info := range m.segmentInfos {
-		if info.NodeID == nodeID {
+		if nodeIncluded(nodeID, getSegmentNodeIDs(info)) {
 			segmentInfos = append(segmentInfos, proto.Clone(info).(*querypb.SegmentInfo))
 		}
 	}
@@ -772,6 +838,24 @@ func (m *MetaReplica) getSegmentInfosByNode(nodeID int64) []*querypb.SegmentInfo
 	return segmentInfos
 }
 
+// getSegmentNodeIDs returns the nodes holding the segment, a replicated segment is held by one node per replica
+func getSegmentNodeIDs(info *querypb.SegmentInfo) []int64 {
+	if len(info.NodeIds) > 0 {
+		return info.NodeIds
+	}
+	return []int64{info.NodeID}
+}
+
+// getSegmentReplicaIDOnNode returns the replica which the segment on the node belongs to, 0 if the segment is not replicated
+func getSegmentReplicaIDOnNode(info *querypb.SegmentInfo, nodeID int64) UniqueID {
+	for offset, id := range info.NodeIds {
+		if id == nodeID {
+			return info.ReplicaIds[offset]
+		}
+	}
+	return 0
+}
+
 func (m *MetaReplica) getCollectionInfoByID(collectionID UniqueID) (*querypb.CollectionInfo, error) {
 	m.collectionMu.RLock()
 	defer m.collectionMu.RUnlock()
@@ -813,6 +897,20 @@ func (m *MetaReplica) getDmChannelInfosByNodeID(nodeID int64) []*querypb.DmChann
 	return watchedDmChannelWatchInfo
 }
 
+func (m *MetaReplica) getDmChannelInfosByCollectionID(collectionID UniqueID) []*querypb.DmChannelWatchInfo {
+	m.dmChannelMu.RLock()
+	defer m.dmChannelMu.RUnlock()
+
+	var dmChannelWatchInfos []*querypb.DmChannelWatchInfo
+	for _, channelInfo := range m.dmChannelInfos {
+		if channelInfo.CollectionID == collectionID {
+			dmChannelWatchInfos = append(dmChannelWatchInfos, proto.Clone(channelInfo).(*querypb.DmChannelWatchInfo))
+		}
+	}
+
+	return dmChannelWatchInfos
+}
+
 func (m *MetaReplica) setDmChannelInfos(dmChannelWatchInfos []*querypb.DmChannelWatchInfo) error {
 	m.dmChannelMu.Lock()
 	defer m.dmChannelMu.Unlock()
@@ -823,7 +921,7 @@ func (m *MetaReplica) setDmChannelInfos(dmChannelWatchInfos []*querypb.DmChannel
 		return err
 	}
 	for _, channelInfo := range dmChannelWatchInfos {
-		m.dmChannelInfos[channelInfo.DmChannel] = channelInfo
+		m.dmChannelInfos[getDmChannelWatchInfoKey(channelInfo)] = channelInfo
 	}
 
 	return nil
@@ -885,6 +983,100 @@ func (m *MetaReplica) setDeltaChannel(collectionID UniqueID, infos []*datapb.Vch
 	return nil
 }
 
+// addReplicas creates a replica for each group of nodes, the node groups should be disjoint
+func (m *MetaReplica) addReplicas(collectionID UniqueID, nodeGroups [][]int64) ([]*querypb.ReplicaInfo, error) {
+	replicas := make([]*querypb.ReplicaInfo, 0, len(nodeGroups))
+	kvs := make(map[string]string)
+	for _, nodeIDs := range nodeGroups {
+		replicaID, err := m.idAllocator()
+		if err != nil {
+			return nil, err
+		}
+		info := &querypb.ReplicaInfo{
+			ReplicaID:    replicaID,
+			CollectionID: collectionID,
+			NodeIds:      nodeIDs,
+		}
+		infoBytes, err := proto.Marshal(info)
+		if err != nil {
+			return nil, err
+		}
+		kvs[getReplicaInfoKey(info)] = string(infoBytes)
+		replicas = append(replicas, info)
+	}
+
+	m.replicaMu.Lock()
+	defer m.replicaMu.Unlock()
+	err := m.client.MultiSave(kvs)
+	if err != nil {
+		log.Error("save replicaInfos error", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+	for _, info := range replicas {
+		m.replicaInfos[info.ReplicaID] = info
+	}
+	log.Debug("add replicas to meta", zap.Int64("collectionID", collectionID), zap.Any("replicas", replicas))
+
+	return cloneReplicaInfos(replicas), nil
+}
+
+// setReplicaInfo updates the member nodes of a replica
+func (m *MetaReplica) setReplicaInfo(info *querypb.ReplicaInfo) error {
+	m.replicaMu.Lock()
+	defer m.replicaMu.Unlock()
+
+	infoBytes, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	err = m.client.Save(getReplicaInfoKey(info), string(infoBytes))
+	if err != nil {
+		log.Error("save replicaInfo error", zap.Int64("replicaID", info.ReplicaID), zap.Error(err))
+		return err
+	}
+	m.replicaInfos[info.ReplicaID] = proto.Clone(info).(*querypb.ReplicaInfo)
+
+	return nil
+}
+
+func (m *MetaReplica) getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error) {
+	m.replicaMu.RLock()
+	defer m.replicaMu.RUnlock()
+
+	if info, ok := m.replicaInfos[replicaID]; ok {
+		return proto.Clone(info).(*querypb.ReplicaInfo), nil
+	}
+
+	return nil, fmt.Errorf("getReplicaByID: can't find replica %d in replicaInfos", replicaID)
+}
+
+// getReplicasByCollectionID returns the replicas of the collection ordered by replicaID,
+// it's empty if the collection is loaded without replicas
+func (m *MetaReplica) getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo {
+	m.replicaMu.RLock()
+	defer m.replicaMu.RUnlock()
+
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, info := range m.replicaInfos {
+		if info.CollectionID == collectionID {
+			replicas = append(replicas, info)
+		}
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].ReplicaID < replicas[j].ReplicaID
+	})
+
+	return cloneReplicaInfos(replicas)
+}
+
+func cloneReplicaInfos(infos []*querypb.ReplicaInfo) []*querypb.ReplicaInfo {
+	clones := make([]*querypb.ReplicaInfo, 0, len(infos))
+	for _, info := range infos {
+		clones = append(clones, proto.Clone(info).(*querypb.ReplicaInfo))
+	}
+	return clones
+}
+
 // Get Query channel info for collection, so far all the collection share the same query channel 0
 func (m *MetaReplica) getQueryChannelInfoByID(collectionID UniqueID) *querypb.QueryChannelInfo {
 	m.channelMu.Lock()
@@ -1043,12 +1235,24 @@ func saveDmChannelWatchInfos(infos []*querypb.DmChannelWatchInfo, kv kv.MetaKv)
 			return err
 		}
 
-		key := fmt.Sprintf("%s/%d/%s", dmChannelMetaPrefix, info.CollectionID, info.DmChannel)
-		kvs[key] = string(infoBytes)
+		kvs[getDmChannelWatchInfoKey(info)] = string(infoBytes)
 	}
 	return kv.MultiSave(kvs)
 }
 
+// getDmChannelWatchInfoKey returns the meta key of the dmChannel watch info,
+// a dmChannel of a replicated collection is watched once per replica
+func getDmChannelWatchInfoKey(info *querypb.DmChannelWatchInfo) string {
+	if info.ReplicaID == 0 {
+		return fmt.Sprintf("%s/%d/%s", dmChannelMetaPrefix, info.CollectionID, info.DmChannel)
+	}
+	return fmt.Sprintf("%s/%d/%s/%d", dmChannelMetaPrefix, info.CollectionID, info.DmChannel, info.ReplicaID)
+}
+
+func getReplicaInfoKey(info *querypb.ReplicaInfo) string {
+	return fmt.Sprintf("%s/%d/%d", replicaMetaPrefix, info.CollectionID, info.ReplicaID)
+}
+
 func removeCollectionMeta(collectionID UniqueID, kv kv.MetaKv) error {
 	var prefixes []string
 	collectionInfosPrefix := fmt.Sprintf("%s/%d", collectionMetaPrefix, collectionID)
@@ -1057,6 +1261,8 @@ func removeCollectionMeta(collectionID UniqueID, kv kv.MetaKv) error {
 	prefixes = append(prefixes, dmChannelInfosPrefix)
 	deltaChannelInfosPrefix := fmt.Sprintf("%s/%d", deltaChannelMetaPrefix, collectionID)
 	prefixes = append(prefixes, deltaChannelInfosPrefix)
+	replicaInfosPrefix := fmt.Sprintf("%s/%d", replicaMetaPrefix, collectionID)
+	prefixes = append(prefixes, replicaInfosPrefix)
 
 	return kv.MultiRemoveWithPrefix(prefixes)
 }
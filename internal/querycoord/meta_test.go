@@ -235,6 +235,13 @@ func TestMetaFunc(t *testing.T) {
 		assert.Equal(t, 2, len(channelInfos))
 	})
 
+	t.Run("Test GetDmChannelsByCollectionID", func(t *testing.T) {
+		channelInfos := meta.getDmChannelInfosByCollectionID(defaultCollectionID)
+		assert.Equal(t, 2, len(channelInfos))
+		channelInfos = meta.getDmChannelInfosByCollectionID(defaultCollectionID + 1)
+		assert.Equal(t, 0, len(channelInfos))
+	})
+
 	t.Run("Test ShowSegmentInfo", func(t *testing.T) {
 		infos := meta.showSegmentInfos(defaultCollectionID, []UniqueID{defaultPartitionID})
 		assert.Equal(t, 1, len(infos))
@@ -354,3 +361,44 @@ func TestReloadMetaFromKV(t *testing.T) {
 	_, ok = meta.segmentInfos[defaultSegmentID]
 	assert.Equal(t, true, ok)
 }
+
+func TestReplicaMeta(t *testing.T) {
+	refreshParams()
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+	kv := etcdkv.NewEtcdKV(etcdCli, Params.BaseParams.MetaRootPath)
+	id := UniqueID(0)
+	idAllocator := func() (UniqueID, error) {
+		id++
+		return id, nil
+	}
+	meta, err := newMeta(context.Background(), kv, nil, idAllocator)
+	assert.Nil(t, err)
+
+	replicas, err := meta.addReplicas(defaultCollectionID, [][]int64{{1, 3}, {2}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(replicas))
+	assert.Equal(t, []int64{1, 3}, replicas[0].NodeIds)
+	assert.Equal(t, []int64{2}, replicas[1].NodeIds)
+
+	replica, err := meta.getReplicaByID(replicas[1].ReplicaID)
+	assert.Nil(t, err)
+	replica.NodeIds = append(replica.NodeIds, 4)
+	err = meta.setReplicaInfo(replica)
+	assert.Nil(t, err)
+
+	// replicas are reloaded from etcd
+	reloadedMeta, err := newMeta(context.Background(), kv, nil, idAllocator)
+	assert.Nil(t, err)
+	reloadedReplicas := reloadedMeta.getReplicasByCollectionID(defaultCollectionID)
+	assert.Equal(t, 2, len(reloadedReplicas))
+	assert.Equal(t, replicas[0].ReplicaID, reloadedReplicas[0].ReplicaID)
+	assert.Equal(t, []int64{2, 4}, reloadedReplicas[1].NodeIds)
+
+	err = reloadedMeta.releaseCollection(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reloadedMeta.getReplicasByCollectionID(defaultCollectionID)))
+	_, err = reloadedMeta.getReplicaByID(replicas[0].ReplicaID)
+	assert.NotNil(t, err)
+}
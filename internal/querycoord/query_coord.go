@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"path"
 	"sort"
 	"strconv"
 	"sync"
@@ -31,19 +32,18 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/allocator"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
-	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -71,7 +71,7 @@ type QueryCoord struct {
 	loopCtx    context.Context
 	loopCancel context.CancelFunc
 	loopWg     sync.WaitGroup
-	kvClient   *etcdkv.EtcdKV
+	kvClient   kv.MetaKv
 
 	initOnce sync.Once
 
@@ -116,7 +116,7 @@ func (qc *QueryCoord) Register() error {
 }
 
 func (qc *QueryCoord) initSession() error {
-	qc.session = sessionutil.NewSession(qc.loopCtx, Params.BaseParams.MetaRootPath, qc.etcdCli)
+	qc.session = metastore.NewSession(qc.loopCtx, qc.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if qc.session == nil {
 		return fmt.Errorf("session is nil, the etcd client connection may have failed")
 	}
@@ -138,12 +138,20 @@ func (qc *QueryCoord) Init() error {
 			return
 		}
 		log.Debug("queryCoord try to connect etcd")
-		etcdKV := etcdkv.NewEtcdKV(qc.etcdCli, Params.BaseParams.MetaRootPath)
-		qc.kvClient = etcdKV
+		qc.kvClient, initError = metastore.NewMetaKV(qc.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
+		if initError != nil {
+			log.Error("query coordinator failed to create meta kv", zap.Error(initError))
+			return
+		}
 		log.Debug("query coordinator try to connect etcd success")
 
 		// init id allocator
-		idAllocatorKV := tsoutil.NewTSOKVBase(qc.etcdCli, Params.BaseParams.KvRootPath, "queryCoordTaskID")
+		idAllocatorKV, err := metastore.NewMetaKV(qc.etcdCli, path.Join(Params.BaseParams.KvRootPath, "queryCoordTaskID"), &Params.BaseParams)
+		if err != nil {
+			log.Error("query coordinator failed to create id allocator kv", zap.Error(err))
+			initError = err
+			return
+		}
 		idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", idAllocatorKV)
 		initError = idAllocator.Initialize()
 		if initError != nil {
@@ -423,7 +431,7 @@ func (qc *QueryCoord) watchHandoffSegmentLoop() {
 					continue
 				}
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					validHandoffReq, _ := qc.indexChecker.verifyHandoffReqValid(segmentInfo)
 					if Params.QueryCoordCfg.AutoHandoff && validHandoffReq {
 						qc.indexChecker.enqueueHandoffReq(segmentInfo)
@@ -480,6 +488,10 @@ func (qc *QueryCoord) loadBalanceSegmentLoop() {
 				leastSegmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 				segmentInfos := qc.meta.getSegmentInfosByNode(nodeID)
 				for _, segmentInfo := range segmentInfos {
+					// a replicated segment can only be moved within its replica, it's not balanced automatically
+					if len(segmentInfo.ReplicaIds) > 0 {
+						continue
+					}
 					leastInfo, err := qc.cluster.getSegmentInfoByID(ctx, segmentInfo.SegmentID)
 					if err != nil {
 						log.Warn("loadBalanceSegmentLoop: get segment info from QueryNode failed", zap.Int64("nodeID", nodeID), zap.Error(err))
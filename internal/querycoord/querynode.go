@@ -25,7 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	nodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -57,6 +57,7 @@ type Node interface {
 
 	setState(state nodeState)
 	getState() nodeState
+	getAddress() string
 	isOnline() bool
 	isOffline() bool
 
@@ -74,7 +75,7 @@ type queryNode struct {
 	id       int64
 	address  string
 	client   types.QueryNode
-	kvClient *etcdkv.EtcdKV
+	kvClient kv.MetaKv
 
 	sync.RWMutex
 	watchedQueryChannels map[UniqueID]*querypb.QueryChannelInfo
@@ -88,7 +89,7 @@ type queryNode struct {
 	cpuUsage     float64
 }
 
-func newQueryNode(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error) {
+func newQueryNode(ctx context.Context, address string, id UniqueID, kv kv.MetaKv) (Node, error) {
 	watchedChannels := make(map[UniqueID]*querypb.QueryChannelInfo)
 	watchedDeltaChannels := make(map[UniqueID][]*datapb.VchannelInfo)
 	childCtx, cancel := context.WithCancel(ctx)
@@ -207,6 +208,10 @@ func (qn *queryNode) getState() nodeState {
 	return qn.state
 }
 
+func (qn *queryNode) getAddress() string {
+	return qn.address
+}
+
 func (qn *queryNode) isOnline() bool {
 	qn.stateLock.RLock()
 	defer qn.stateLock.RUnlock()
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// assignReplicas returns the replicas of the collection, they are created by splitting the online query nodes
+// into replicaNumber disjoint groups if the collection has no replica yet.
+// No replica is created if replicaNumber <= 1, the collection is served by all query nodes as before.
+func assignReplicas(meta Meta, cluster Cluster, collectionID UniqueID, replicaNumber int32) ([]*querypb.ReplicaInfo, error) {
+	if replicaNumber <= 1 {
+		return nil, nil
+	}
+	if replicas := meta.getReplicasByCollectionID(collectionID); len(replicas) > 0 {
+		if len(replicas) != int(replicaNumber) {
+			return nil, fmt.Errorf("collection %d has been loaded with %d replicas", collectionID, len(replicas))
+		}
+		return replicas, nil
+	}
+
+	onlineNodeIDs := cluster.onlineNodeIDs()
+	if len(onlineNodeIDs) < int(replicaNumber) {
+		return nil, fmt.Errorf("no enough QueryNode to load %d replicas, online QueryNodes: %v", replicaNumber, onlineNodeIDs)
+	}
+	sort.Slice(onlineNodeIDs, func(i, j int) bool {
+		return onlineNodeIDs[i] < onlineNodeIDs[j]
+	})
+	nodeGroups := make([][]int64, replicaNumber)
+	for offset, nodeID := range onlineNodeIDs {
+		nodeGroups[offset%len(nodeGroups)] = append(nodeGroups[offset%len(nodeGroups)], nodeID)
+	}
+
+	return meta.addReplicas(collectionID, nodeGroups)
+}
+
+// replicateLoadRequests copies the load segment and watch dmChannel requests for every replica,
+// the requests are returned as is if there is no replica
+func replicateLoadRequests(replicas []*querypb.ReplicaInfo,
+	loadSegmentRequests []*querypb.LoadSegmentsRequest,
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) ([]*querypb.LoadSegmentsRequest, []*querypb.WatchDmChannelsRequest) {
+	if len(replicas) == 0 {
+		return loadSegmentRequests, watchDmChannelRequests
+	}
+
+	loadReqs := make([]*querypb.LoadSegmentsRequest, 0, len(loadSegmentRequests)*len(replicas))
+	watchReqs := make([]*querypb.WatchDmChannelsRequest, 0, len(watchDmChannelRequests)*len(replicas))
+	for _, replica := range replicas {
+		for _, req := range loadSegmentRequests {
+			loadReq := proto.Clone(req).(*querypb.LoadSegmentsRequest)
+			loadReq.ReplicaID = replica.ReplicaID
+			loadReqs = append(loadReqs, loadReq)
+		}
+		for _, req := range watchDmChannelRequests {
+			watchReq := proto.Clone(req).(*querypb.WatchDmChannelsRequest)
+			watchReq.ReplicaID = replica.ReplicaID
+			watchReqs = append(watchReqs, watchReq)
+		}
+	}
+
+	return loadReqs, watchReqs
+}
+
+// getReplicaNodeIDs returns the member nodes of the replicas, requests without replica (replicaID = 0)
+// are mapped to nil which means they can be assigned to any node
+func getReplicaNodeIDs(meta Meta, replicaIDs []UniqueID) (map[UniqueID][]int64, error) {
+	replicaNodeIDs := make(map[UniqueID][]int64)
+	for _, replicaID := range replicaIDs {
+		if _, ok := replicaNodeIDs[replicaID]; ok || replicaID == 0 {
+			continue
+		}
+		replica, err := meta.getReplicaByID(replicaID)
+		if err != nil {
+			return nil, err
+		}
+		// a replica whose nodes are all removed still restricts its requests, so the slice mustn't be nil
+		replicaNodeIDs[replicaID] = append([]int64{}, replica.NodeIds...)
+	}
+	return replicaNodeIDs, nil
+}
+
+// nodeInReplica checks whether the node is a member of the replica, any node is allowed if replicaNodeIDs is nil
+func nodeInReplica(nodeID int64, replicaNodeIDs []int64) bool {
+	return replicaNodeIDs == nil || nodeIncluded(nodeID, replicaNodeIDs)
+}
+
+// isReplicaAvailable checks whether all the nodes of the replica are online, only an available replica
+// holds all the segments and dmChannels of the collection
+func isReplicaAvailable(cluster Cluster, replica *querypb.ReplicaInfo) bool {
+	if len(replica.NodeIds) == 0 {
+		return false
+	}
+	for _, nodeID := range replica.NodeIds {
+		online, err := cluster.isOnline(nodeID)
+		if err != nil || !online {
+			return false
+		}
+	}
+	return true
+}
+
+// prepareReplicaRecovery makes sure the replica has an online node to take over the segments and dmChannels
+// of the offline nodes, a free node which doesn't belong to any replica of the collection joins the replica
+// if there is no other node in it. It returns an error if the replica can't be recovered.
+func prepareReplicaRecovery(meta Meta, cluster Cluster, replicaID UniqueID, offlineNodeIDs []int64) error {
+	replica, err := meta.getReplicaByID(replicaID)
+	if err != nil {
+		return err
+	}
+	for _, nodeID := range replica.NodeIds {
+		if nodeIncluded(nodeID, offlineNodeIDs) {
+			continue
+		}
+		if online, err := cluster.isOnline(nodeID); err == nil && online {
+			return nil
+		}
+	}
+
+	usedNodeIDs := make([]int64, 0)
+	for _, info := range meta.getReplicasByCollectionID(replica.CollectionID) {
+		usedNodeIDs = append(usedNodeIDs, info.NodeIds...)
+	}
+	onlineNodeIDs := cluster.onlineNodeIDs()
+	sort.Slice(onlineNodeIDs, func(i, j int) bool {
+		return onlineNodeIDs[i] < onlineNodeIDs[j]
+	})
+	for _, nodeID := range onlineNodeIDs {
+		if !nodeIncluded(nodeID, usedNodeIDs) && !nodeIncluded(nodeID, offlineNodeIDs) {
+			replica.NodeIds = append(replica.NodeIds, nodeID)
+			return meta.setReplicaInfo(replica)
+		}
+	}
+
+	return fmt.Errorf("no free QueryNode to recover replica %d of collection %d", replicaID, replica.CollectionID)
+}
+
+// removeNodesFromReplicas removes the offline nodes from the replicas after their segments and dmChannels
+// have been taken over by the other nodes
+func removeNodesFromReplicas(meta Meta, replicaIDs []UniqueID, offlineNodeIDs []int64) error {
+	for _, replicaID := range replicaIDs {
+		replica, err := meta.getReplicaByID(replicaID)
+		if err != nil {
+			return err
+		}
+		nodeIDs := make([]int64, 0, len(replica.NodeIds))
+		for _, nodeID := range replica.NodeIds {
+			if !nodeIncluded(nodeID, offlineNodeIDs) {
+				nodeIDs = append(nodeIDs, nodeID)
+			}
+		}
+		if len(nodeIDs) == len(replica.NodeIds) {
+			continue
+		}
+		replica.NodeIds = nodeIDs
+		err = meta.setReplicaInfo(replica)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
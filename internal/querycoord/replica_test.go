@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestReplicateLoadRequests(t *testing.T) {
+	loadReqs := []*querypb.LoadSegmentsRequest{{CollectionID: defaultCollectionID}}
+	watchReqs := []*querypb.WatchDmChannelsRequest{{CollectionID: defaultCollectionID}}
+
+	// requests are kept as is without replica
+	replicatedLoadReqs, replicatedWatchReqs := replicateLoadRequests(nil, loadReqs, watchReqs)
+	assert.Equal(t, loadReqs, replicatedLoadReqs)
+	assert.Equal(t, watchReqs, replicatedWatchReqs)
+
+	replicas := []*querypb.ReplicaInfo{{ReplicaID: 1}, {ReplicaID: 2}}
+	replicatedLoadReqs, replicatedWatchReqs = replicateLoadRequests(replicas, loadReqs, watchReqs)
+	assert.Equal(t, 2, len(replicatedLoadReqs))
+	assert.Equal(t, 2, len(replicatedWatchReqs))
+	for offset, replica := range replicas {
+		assert.Equal(t, replica.ReplicaID, replicatedLoadReqs[offset].ReplicaID)
+		assert.Equal(t, replica.ReplicaID, replicatedWatchReqs[offset].ReplicaID)
+	}
+	assert.Equal(t, int64(0), loadReqs[0].ReplicaID)
+}
+
+func TestNodeInReplica(t *testing.T) {
+	assert.True(t, nodeInReplica(1, nil))
+	assert.True(t, nodeInReplica(1, []int64{1, 2}))
+	assert.False(t, nodeInReplica(3, []int64{1, 2}))
+	// a replica without any node accepts no node
+	assert.False(t, nodeInReplica(1, []int64{}))
+}
+
+func TestSegmentReplicaPlacement(t *testing.T) {
+	info := &querypb.SegmentInfo{
+		SegmentID: defaultSegmentID,
+		NodeID:    1,
+	}
+	assert.Equal(t, []int64{1}, getSegmentNodeIDs(info))
+	assert.Equal(t, UniqueID(0), getSegmentReplicaIDOnNode(info, 1))
+
+	info.ReplicaIds = []int64{10, 20}
+	info.NodeIds = []int64{1, 2}
+	assert.Equal(t, []int64{1, 2}, getSegmentNodeIDs(info))
+	assert.Equal(t, UniqueID(20), getSegmentReplicaIDOnNode(info, 2))
+	assert.Equal(t, UniqueID(0), getSegmentReplicaIDOnNode(info, 3))
+}
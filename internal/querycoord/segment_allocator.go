@@ -45,6 +45,11 @@ func shuffleSegmentsToQueryNode(ctx context.Context, reqs []*querypb.LoadSegment
 	if len(reqs) == 0 {
 		return nil
 	}
+	replicaNodeIDs, err := getReplicaNodeIDsOfSegmentReqs(reqs, metaCache)
+	if err != nil {
+		log.Error("shuffleSegmentsToQueryNode failed", zap.Error(err))
+		return err
+	}
 
 	for {
 		onlineNodeIDs := cluster.onlineNodeIDs()
@@ -77,15 +82,28 @@ func shuffleSegmentsToQueryNode(ctx context.Context, reqs []*querypb.LoadSegment
 
 		if len(availableNodeIDs) > 0 {
 			log.Debug("shuffleSegmentsToQueryNode: shuffle segment to available QueryNode", zap.Int64s("available nodeIDs", availableNodeIDs))
+			allocated := true
 			for _, req := range reqs {
 				sort.Slice(availableNodeIDs, func(i, j int) bool {
 					return nodeID2NumSegment[availableNodeIDs[i]] < nodeID2NumSegment[availableNodeIDs[j]]
 				})
-				selectedNodeID := availableNodeIDs[0]
-				req.DstNodeID = selectedNodeID
-				nodeID2NumSegment[selectedNodeID]++
+				// the segment can only be assigned to the nodes of its replica
+				allocated = false
+				for _, nodeID := range availableNodeIDs {
+					if nodeInReplica(nodeID, replicaNodeIDs[req.ReplicaID]) {
+						req.DstNodeID = nodeID
+						nodeID2NumSegment[nodeID]++
+						allocated = true
+						break
+					}
+				}
+				if !allocated {
+					break
+				}
+			}
+			if allocated {
+				return nil
 			}
-			return nil
 		}
 
 		if !wait {
@@ -127,6 +145,11 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 		log.Warn("shuffleSegmentsToQueryNodeV2: estimate segment size error", zap.Error(err))
 		return err
 	}
+	replicaNodeIDs, err := getReplicaNodeIDsOfSegmentReqs(reqs, metaCache)
+	if err != nil {
+		log.Warn("shuffleSegmentsToQueryNodeV2: get nodes of replica error", zap.Error(err))
+		return err
+	}
 
 	log.Debug("shuffleSegmentsToQueryNodeV2: estimate the size of loadReqs end")
 	for {
@@ -178,8 +201,11 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 					return memUsageRate[availableNodeIDs[i]] < memUsageRate[availableNodeIDs[j]]
 				})
 				findNodeToAllocate := false
-				// assign load segment request to query node which has least memUsageRate
+				// assign load segment request to query node of its replica which has least memUsageRate
 				for _, nodeID := range availableNodeIDs {
+					if !nodeInReplica(nodeID, replicaNodeIDs[reqs[offset].ReplicaID]) {
+						continue
+					}
 					memUsageAfterLoad := memUsage[nodeID] + uint64(sizeOfReq)
 					memUsageRateAfterLoad := float64(memUsageAfterLoad) / float64(totalMem[nodeID])
 					if memUsageRateAfterLoad > Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage {
@@ -223,6 +249,14 @@ func shuffleSegmentsToQueryNodeV2(ctx context.Context, reqs []*querypb.LoadSegme
 	}
 }
 
+func getReplicaNodeIDsOfSegmentReqs(reqs []*querypb.LoadSegmentsRequest, metaCache Meta) (map[UniqueID][]int64, error) {
+	replicaIDs := make([]UniqueID, 0, len(reqs))
+	for _, req := range reqs {
+		replicaIDs = append(replicaIDs, req.ReplicaID)
+	}
+	return getReplicaNodeIDs(metaCache, replicaIDs)
+}
+
 func nodeIncluded(nodeID int64, includeNodeIDs []int64) bool {
 	for _, id := range includeNodeIDs {
 		if id == nodeID {
@@ -23,8 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -52,16 +52,7 @@ func TestShuffleSegmentsToQueryNode(t *testing.T) {
 		segSizeEstimator: segSizeEstimateForTest,
 	}
 
-	option := &minioKV.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	cluster.dataKV, err = minioKV.NewMinIOKV(baseCtx, option)
+	cluster.chunkManager, err = storage.NewChunkManager(baseCtx, &Params)
 	assert.Nil(t, err)
 
 	schema := genCollectionSchema(defaultCollectionID, false)
@@ -365,13 +365,14 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 		for _, segmentBingLog := range binlogs {
 			segmentID := segmentBingLog.SegmentID
 			segmentLoadInfo := &querypb.SegmentLoadInfo{
-				SegmentID:    segmentID,
-				PartitionID:  partitionID,
-				CollectionID: collectionID,
-				BinlogPaths:  segmentBingLog.FieldBinlogs,
-				NumOfRows:    segmentBingLog.NumOfRows,
-				Statslogs:    segmentBingLog.Statslogs,
-				Deltalogs:    segmentBingLog.Deltalogs,
+				SegmentID:          segmentID,
+				PartitionID:        partitionID,
+				CollectionID:       collectionID,
+				BinlogPaths:        segmentBingLog.FieldBinlogs,
+				NumOfRows:          segmentBingLog.NumOfRows,
+				Statslogs:          segmentBingLog.Statslogs,
+				Deltalogs:          segmentBingLog.Deltalogs,
+				ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 			}
 
 			indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -432,6 +433,14 @@ func (lct *loadCollectionTask) execute(ctx context.Context) error {
 		watchDmChannelReqs = append(watchDmChannelReqs, watchRequest)
 	}
 
+	replicas, err := assignReplicas(lct.meta, lct.cluster, collectionID, lct.ReplicaNumber)
+	if err != nil {
+		log.Error("loadCollectionTask: assign replicas failed", zap.Int64("collectionID", collectionID), zap.Int32("replicaNumber", lct.ReplicaNumber), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
+		lct.setResultInfo(err)
+		return err
+	}
+	loadSegmentReqs, watchDmChannelReqs = replicateLoadRequests(replicas, loadSegmentReqs, watchDmChannelReqs)
+
 	internalTasks, err := assignInternalTask(ctx, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs, false, nil, nil)
 	if err != nil {
 		log.Error("loadCollectionTask: assign child task failed", zap.Int64("collectionID", collectionID), zap.Int64("msgID", lct.Base.MsgID), zap.Error(err))
@@ -734,13 +743,14 @@ func (lpt *loadPartitionTask) execute(ctx context.Context) error {
 		for _, segmentBingLog := range binlogs {
 			segmentID := segmentBingLog.SegmentID
 			segmentLoadInfo := &querypb.SegmentLoadInfo{
-				SegmentID:    segmentID,
-				PartitionID:  partitionID,
-				CollectionID: collectionID,
-				BinlogPaths:  segmentBingLog.FieldBinlogs,
-				NumOfRows:    segmentBingLog.NumOfRows,
-				Statslogs:    segmentBingLog.Statslogs,
-				Deltalogs:    segmentBingLog.Deltalogs,
+				SegmentID:          segmentID,
+				PartitionID:        partitionID,
+				CollectionID:       collectionID,
+				BinlogPaths:        segmentBingLog.FieldBinlogs,
+				NumOfRows:          segmentBingLog.NumOfRows,
+				Statslogs:          segmentBingLog.Statslogs,
+				Deltalogs:          segmentBingLog.Deltalogs,
+				ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 			}
 
 			indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -1079,6 +1089,7 @@ func (lst *loadSegmentTask) reschedule(ctx context.Context) ([]task, error) {
 			Schema:       lst.Schema,
 			SourceNodeID: lst.SourceNodeID,
 			CollectionID: lst.CollectionID,
+			ReplicaID:    lst.ReplicaID,
 		}
 		loadSegmentReqs = append(loadSegmentReqs, req)
 	}
@@ -1253,6 +1264,7 @@ func (wdt *watchDmChannelTask) reschedule(ctx context.Context) ([]task, error) {
 			Infos:        []*datapb.VchannelInfo{info},
 			Schema:       wdt.Schema,
 			ExcludeInfos: wdt.ExcludeInfos,
+			ReplicaID:    wdt.ReplicaID,
 		}
 		watchDmChannelReqs = append(watchDmChannelReqs, req)
 	}
@@ -1512,15 +1524,16 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 				if segmentBinlogs.SegmentID == segmentID {
 					findBinlog = true
 					segmentLoadInfo := &querypb.SegmentLoadInfo{
-						SegmentID:      segmentID,
-						PartitionID:    partitionID,
-						CollectionID:   collectionID,
-						BinlogPaths:    segmentBinlogs.FieldBinlogs,
-						NumOfRows:      segmentBinlogs.NumOfRows,
-						Statslogs:      segmentBinlogs.Statslogs,
-						Deltalogs:      segmentBinlogs.Deltalogs,
-						CompactionFrom: segmentInfo.CompactionFrom,
-						IndexInfos:     segmentInfo.IndexInfos,
+						SegmentID:          segmentID,
+						PartitionID:        partitionID,
+						CollectionID:       collectionID,
+						BinlogPaths:        segmentBinlogs.FieldBinlogs,
+						NumOfRows:          segmentBinlogs.NumOfRows,
+						Statslogs:          segmentBinlogs.Statslogs,
+						Deltalogs:          segmentBinlogs.Deltalogs,
+						CompactionFrom:     segmentInfo.CompactionFrom,
+						IndexInfos:         segmentInfo.IndexInfos,
+						ClusteringKeyRange: segmentBinlogs.ClusteringKeyRange,
 					}
 
 					msgBase := proto.Clone(ht.Base).(*commonpb.MsgBase)
@@ -1533,6 +1546,29 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 					}
 				}
 			}
+			if findBinlog {
+				// the segments split from the same segments by a clustering compaction are loaded together, since the
+				// compacted segments are released as soon as one of them is loaded, the indexes of the others are not loaded
+				for _, segmentBinlogs := range binlogs {
+					if segmentBinlogs.SegmentID == segmentID || !hasCommonSegment(segmentBinlogs.CompactionFrom, segmentInfo.CompactionFrom) {
+						continue
+					}
+					if _, err := ht.meta.getSegmentInfoByID(segmentBinlogs.SegmentID); err == nil {
+						continue
+					}
+					loadSegmentReq.Infos = append(loadSegmentReq.Infos, &querypb.SegmentLoadInfo{
+						SegmentID:          segmentBinlogs.SegmentID,
+						PartitionID:        partitionID,
+						CollectionID:       collectionID,
+						BinlogPaths:        segmentBinlogs.FieldBinlogs,
+						NumOfRows:          segmentBinlogs.NumOfRows,
+						Statslogs:          segmentBinlogs.Statslogs,
+						Deltalogs:          segmentBinlogs.Deltalogs,
+						CompactionFrom:     segmentBinlogs.CompactionFrom,
+						ClusteringKeyRange: segmentBinlogs.ClusteringKeyRange,
+					})
+				}
+			}
 			for _, info := range dmChannelInfos {
 				deltaChannel, err := generateWatchDeltaChannelInfo(info)
 				if err != nil {
@@ -1554,7 +1590,9 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 				ht.setResultInfo(err)
 				return err
 			}
-			internalTasks, err := assignInternalTask(ctx, ht, ht.meta, ht.cluster, []*querypb.LoadSegmentsRequest{loadSegmentReq}, nil, true, nil, nil)
+			// the handoff segment is loaded into every replica of the collection
+			loadSegmentReqs, _ := replicateLoadRequests(ht.meta.getReplicasByCollectionID(collectionID), []*querypb.LoadSegmentsRequest{loadSegmentReq}, nil)
+			internalTasks, err := assignInternalTask(ctx, ht, ht.meta, ht.cluster, loadSegmentReqs, nil, true, nil, nil)
 			if err != nil {
 				log.Error("handoffTask: assign child task failed", zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID), zap.Error(err))
 				ht.setResultInfo(err)
@@ -1579,6 +1617,18 @@ func (ht *handoffTask) execute(ctx context.Context) error {
 	return nil
 }
 
+// hasCommonSegment checks whether two lists of segment IDs share any segment
+func hasCommonSegment(a, b []UniqueID) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (ht *handoffTask) postExecute(context.Context) error {
 	if ht.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
 		ht.clearChildTasks()
@@ -1642,21 +1692,38 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 	defer lbt.reduceRetryCount()
 
 	if lbt.triggerCondition == querypb.TriggerCondition_NodeDown {
-		segmentID2Info := make(map[UniqueID]*querypb.SegmentInfo)
-		dmChannel2WatchInfo := make(map[string]*querypb.DmChannelWatchInfo)
+		// the segments and dmChannels are recovered in the replicas they belong to, replicaID is 0 if not replicated
+		segmentID2ReplicaIDs := make(map[UniqueID][]UniqueID)
+		dmChannel2ReplicaIDs := make(map[string][]UniqueID)
 		loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
 		watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0)
 		recoveredCollectionIDs := make(map[UniqueID]struct{})
+		recoveredReplicaIDs := make(map[UniqueID]struct{})
 		for _, nodeID := range lbt.SourceNodeIDs {
 			segmentInfos := lbt.meta.getSegmentInfosByNode(nodeID)
 			for _, segmentInfo := range segmentInfos {
-				segmentID2Info[segmentInfo.SegmentID] = segmentInfo
+				replicaID := getSegmentReplicaIDOnNode(segmentInfo, nodeID)
+				segmentID2ReplicaIDs[segmentInfo.SegmentID] = append(segmentID2ReplicaIDs[segmentInfo.SegmentID], replicaID)
 				recoveredCollectionIDs[segmentInfo.CollectionID] = struct{}{}
+				recoveredReplicaIDs[replicaID] = struct{}{}
 			}
 			dmChannelWatchInfos := lbt.meta.getDmChannelInfosByNodeID(nodeID)
 			for _, watchInfo := range dmChannelWatchInfos {
-				dmChannel2WatchInfo[watchInfo.DmChannel] = watchInfo
+				dmChannel2ReplicaIDs[watchInfo.DmChannel] = append(dmChannel2ReplicaIDs[watchInfo.DmChannel], watchInfo.ReplicaID)
 				recoveredCollectionIDs[watchInfo.CollectionID] = struct{}{}
+				recoveredReplicaIDs[watchInfo.ReplicaID] = struct{}{}
+			}
+		}
+
+		unrecoverableReplicaIDs := make(map[UniqueID]struct{})
+		for replicaID := range recoveredReplicaIDs {
+			if replicaID == 0 {
+				continue
+			}
+			err := prepareReplicaRecovery(lbt.meta, lbt.cluster, replicaID, lbt.SourceNodeIDs)
+			if err != nil {
+				log.Warn("loadBalanceTask: replica can't be recovered, it's unavailable until reloaded", zap.Int64("replicaID", replicaID), zap.Error(err))
+				unrecoverableReplicaIDs[replicaID] = struct{}{}
 			}
 		}
 
@@ -1692,15 +1759,16 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 
 				for _, segmentBingLog := range binlogs {
 					segmentID := segmentBingLog.SegmentID
-					if _, ok := segmentID2Info[segmentID]; ok {
+					if replicaIDs, ok := segmentID2ReplicaIDs[segmentID]; ok {
 						segmentLoadInfo := &querypb.SegmentLoadInfo{
-							SegmentID:    segmentID,
-							PartitionID:  partitionID,
-							CollectionID: collectionID,
-							BinlogPaths:  segmentBingLog.FieldBinlogs,
-							NumOfRows:    segmentBingLog.NumOfRows,
-							Statslogs:    segmentBingLog.Statslogs,
-							Deltalogs:    segmentBingLog.Deltalogs,
+							SegmentID:          segmentID,
+							PartitionID:        partitionID,
+							CollectionID:       collectionID,
+							BinlogPaths:        segmentBingLog.FieldBinlogs,
+							NumOfRows:          segmentBingLog.NumOfRows,
+							Statslogs:          segmentBingLog.Statslogs,
+							Deltalogs:          segmentBingLog.Deltalogs,
+							ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 						}
 						indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
 							CollectionID: collectionID,
@@ -1711,16 +1779,22 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 							segmentLoadInfo.IndexInfos = indexInfo
 						}
 
-						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
-						msgBase.MsgType = commonpb.MsgType_LoadSegments
-						loadSegmentReq := &querypb.LoadSegmentsRequest{
-							Base:         msgBase,
-							Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
-							Schema:       schema,
-							CollectionID: collectionID,
+						for _, replicaID := range replicaIDs {
+							if _, ok := unrecoverableReplicaIDs[replicaID]; ok {
+								continue
+							}
+							msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+							msgBase.MsgType = commonpb.MsgType_LoadSegments
+							loadSegmentReq := &querypb.LoadSegmentsRequest{
+								Base:         msgBase,
+								Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
+								Schema:       schema,
+								CollectionID: collectionID,
+								ReplicaID:    replicaID,
+							}
+
+							loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 						}
-
-						loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 					}
 				}
 
@@ -1745,7 +1819,10 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 
 			mergedDmChannel := mergeDmChannelInfo(dmChannelInfos)
 			for channelName, vChannelInfo := range mergedDmChannel {
-				if _, ok := dmChannel2WatchInfo[channelName]; ok {
+				for _, replicaID := range dmChannel2ReplicaIDs[channelName] {
+					if _, ok := unrecoverableReplicaIDs[replicaID]; ok {
+						continue
+					}
 					msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
 					msgBase.MsgType = commonpb.MsgType_WatchDmChannels
 					watchRequest := &querypb.WatchDmChannelsRequest{
@@ -1753,6 +1830,7 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 						CollectionID: collectionID,
 						Infos:        []*datapb.VchannelInfo{vChannelInfo},
 						Schema:       schema,
+						ReplicaID:    replicaID,
 					}
 
 					if collectionInfo.LoadType == querypb.LoadType_LoadPartition {
@@ -1785,6 +1863,8 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 
 		balancedSegmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 		balancedSegmentIDs := make([]UniqueID, 0)
+		// a replicated segment is balanced within the replica it belongs to on the source node
+		balancedSegmentReplicaIDs := make(map[UniqueID]UniqueID)
 
 		for _, nodeID := range lbt.SourceNodeIDs {
 			nodeExist := lbt.cluster.hasNode(nodeID)
@@ -1798,6 +1878,7 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 			for _, info := range segmentInfos {
 				balancedSegmentInfos[info.SegmentID] = info
 				balancedSegmentIDs = append(balancedSegmentIDs, info.SegmentID)
+				balancedSegmentReplicaIDs[info.SegmentID] = getSegmentReplicaIDOnNode(info, nodeID)
 			}
 		}
 
@@ -1861,13 +1942,14 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 					}
 					segmentBingLog := segmentID2Binlog[segmentID]
 					segmentLoadInfo := &querypb.SegmentLoadInfo{
-						SegmentID:    segmentID,
-						PartitionID:  partitionID,
-						CollectionID: collectionID,
-						BinlogPaths:  segmentBingLog.FieldBinlogs,
-						NumOfRows:    segmentBingLog.NumOfRows,
-						Statslogs:    segmentBingLog.Statslogs,
-						Deltalogs:    segmentBingLog.Deltalogs,
+						SegmentID:          segmentID,
+						PartitionID:        partitionID,
+						CollectionID:       collectionID,
+						BinlogPaths:        segmentBingLog.FieldBinlogs,
+						NumOfRows:          segmentBingLog.NumOfRows,
+						Statslogs:          segmentBingLog.Statslogs,
+						Deltalogs:          segmentBingLog.Deltalogs,
+						ClusteringKeyRange: segmentBingLog.ClusteringKeyRange,
 					}
 
 					indexInfo, err := getIndexInfo(ctx, &querypb.SegmentInfo{
@@ -1886,6 +1968,7 @@ func (lbt *loadBalanceTask) execute(ctx context.Context) error {
 						Infos:        []*querypb.SegmentLoadInfo{segmentLoadInfo},
 						Schema:       collectionInfo.Schema,
 						CollectionID: collectionID,
+						ReplicaID:    balancedSegmentReplicaIDs[segmentID],
 					}
 					loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 				}
@@ -1933,6 +2016,19 @@ func (lbt *loadBalanceTask) postExecute(context.Context) error {
 		lbt.clearChildTasks()
 	}
 	if lbt.triggerCondition == querypb.TriggerCondition_NodeDown {
+		if lbt.getResultInfo().ErrorCode == commonpb.ErrorCode_Success {
+			// the offline nodes are removed from the replicas once their segments and dmChannels have been recovered
+			var replicaIDs []UniqueID
+			for _, collectionInfo := range lbt.meta.showCollections() {
+				for _, replica := range lbt.meta.getReplicasByCollectionID(collectionInfo.CollectionID) {
+					replicaIDs = append(replicaIDs, replica.ReplicaID)
+				}
+			}
+			err := removeNodesFromReplicas(lbt.meta, replicaIDs, lbt.SourceNodeIDs)
+			if err != nil {
+				log.Error("loadBalanceTask: remove offline nodes from replicas failed", zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs), zap.Error(err))
+			}
+		}
 		for _, id := range lbt.SourceNodeIDs {
 			err := lbt.cluster.removeNodeInfo(id)
 			if err != nil {
@@ -29,7 +29,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -131,7 +131,7 @@ type TaskScheduler struct {
 	meta                     Meta
 	cluster                  Cluster
 	taskIDAllocator          func() (UniqueID, error)
-	client                   *etcdkv.EtcdKV
+	client                   kv.MetaKv
 	stopActivateTaskLoopChan chan int
 
 	rootCoord  types.RootCoord
@@ -147,7 +147,7 @@ type TaskScheduler struct {
 func NewTaskScheduler(ctx context.Context,
 	meta Meta,
 	cluster Cluster,
-	kv *etcdkv.EtcdKV,
+	kv kv.MetaKv,
 	rootCoord types.RootCoord,
 	dataCoord types.DataCoord,
 	indexCoord types.IndexCoord,
@@ -921,6 +921,10 @@ func updateSegmentInfoFromTask(ctx context.Context, triggerTask task, meta Meta)
 						SegmentState:   commonpb.SegmentState_Sealed,
 						CompactionFrom: loadInfo.CompactionFrom,
 					}
+					if req.ReplicaID != 0 {
+						segmentInfo.ReplicaIds = []int64{req.ReplicaID}
+						segmentInfo.NodeIds = []int64{dstNodeID}
+					}
 					if _, ok := segmentInfosToSave[collectionID]; !ok {
 						segmentInfosToSave[collectionID] = make([]*querypb.SegmentInfo, 0)
 					}
@@ -55,7 +55,8 @@ type Collection struct {
 	vDeltaChannels []Channel
 	pDeltaChannels []Channel
 
-	loadType loadType
+	loadType  loadType
+	replicaID UniqueID
 
 	releaseMu          sync.RWMutex // guards release
 	releasedPartitions map[UniqueID]struct{}
@@ -319,6 +320,16 @@ func (c *Collection) getLoadType() loadType {
 	return c.loadType
 }
 
+// setReplicaID set the replica which the collection on this query node belongs to, 0 if the collection isn't replicated
+func (c *Collection) setReplicaID(replicaID UniqueID) {
+	c.replicaID = replicaID
+}
+
+// getReplicaID get the replica which the collection on this query node belongs to
+func (c *Collection) getReplicaID() UniqueID {
+	return c.replicaID
+}
+
 // newCollection returns a new Collection
 func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection {
 	/*
@@ -149,3 +149,14 @@ func TestCollection_loadType(t *testing.T) {
 	lt = collection.getLoadType()
 	assert.Equal(t, loadTypePartition, lt)
 }
+
+func TestCollection_replicaID(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	assert.Equal(t, UniqueID(0), collection.getReplicaID())
+
+	collection.setReplicaID(UniqueID(1))
+	assert.Equal(t, UniqueID(1), collection.getReplicaID())
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -127,7 +128,7 @@ func TestFlowGraphDeleteNode_operate(t *testing.T) {
 		for i := 0; i < defaultMsgLength; i++ {
 			pks[i] = int64(i)
 		}
-		s.updateBloomFilter(pks)
+		s.updateBloomFilter(storage.NewInt64PrimaryKeys(pks))
 		assert.Nil(t, err)
 		buf := make([]byte, 8)
 		for i := 0; i < defaultMsgLength; i++ {
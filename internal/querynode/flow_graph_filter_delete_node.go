@@ -101,7 +101,7 @@ func (fddNode *filterDeleteNode) filterInvalidDeleteMessage(msg *msgstream.Delet
 		return nil
 	}
 
-	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+	if len(msg.PrimaryKeys)+len(msg.StringPrimaryKeys) != len(msg.Timestamps) {
 		log.Warn("Error, misaligned messages detected")
 		return nil
 	}
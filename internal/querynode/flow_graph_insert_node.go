@@ -21,7 +21,6 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"io"
 	"strconv"
 	"sync"
 
@@ -33,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
@@ -49,12 +49,12 @@ type insertData struct {
 	insertTimestamps map[UniqueID][]Timestamp
 	insertRecords    map[UniqueID][]*commonpb.Blob
 	insertOffset     map[UniqueID]int64
-	insertPKs        map[UniqueID][]int64
+	insertPKs        map[UniqueID][]storage.PrimaryKey
 }
 
 // deleteData stores the valid delete data
 type deleteData struct {
-	deleteIDs        map[UniqueID][]int64
+	deleteIDs        map[UniqueID][]storage.PrimaryKey
 	deleteTimestamps map[UniqueID][]Timestamp
 	deleteOffset     map[UniqueID]int64
 }
@@ -84,7 +84,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertTimestamps: make(map[UniqueID][]Timestamp),
 		insertRecords:    make(map[UniqueID][]*commonpb.Blob),
 		insertOffset:     make(map[UniqueID]int64),
-		insertPKs:        make(map[UniqueID][]int64),
+		insertPKs:        make(map[UniqueID][]storage.PrimaryKey),
 	}
 
 	if iMsg == nil {
@@ -164,7 +164,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	wg.Wait()
 
 	delData := &deleteData{
-		deleteIDs:        make(map[UniqueID][]int64),
+		deleteIDs:        make(map[UniqueID][]storage.PrimaryKey),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
 		deleteOffset:     make(map[UniqueID]int64),
 	}
@@ -175,6 +175,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				zap.Any("collectionID", delMsg.CollectionID),
 				zap.Any("collectionName", delMsg.CollectionName),
 				zap.Any("pks", delMsg.PrimaryKeys),
+				zap.Strings("string pks", delMsg.StringPrimaryKeys),
 				zap.Any("timestamp", delMsg.Timestamps))
 			processDeleteMessages(iNode.streamingReplica, delMsg, delData)
 		}
@@ -236,7 +237,7 @@ func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, d
 			log.Warn(err.Error())
 			continue
 		}
-		pks, err := filterSegmentsByPKs(msg.PrimaryKeys, segment)
+		pks, err := filterSegmentsByPKs(storage.ParseDeleteRequestPrimaryKeys(&msg.DeleteRequest), segment)
 		if err != nil {
 			log.Warn(err.Error())
 			continue
@@ -250,18 +251,16 @@ func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, d
 }
 
 // filterSegmentsByPKs would filter segments by primary keys
-func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
+func filterSegmentsByPKs(pks []storage.PrimaryKey, segment *Segment) ([]storage.PrimaryKey, error) {
 	if pks == nil {
 		return nil, fmt.Errorf("pks is nil when getSegmentsByPKs")
 	}
 	if segment == nil {
 		return nil, fmt.Errorf("segments is nil when getSegmentsByPKs")
 	}
-	buf := make([]byte, 8)
-	res := make([]int64, 0)
+	res := make([]storage.PrimaryKey, 0)
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		exist := segment.pkFilter.Test(buf)
+		exist := storage.TestPKInBloomFilter(segment.pkFilter, pk)
 		if exist {
 			res = append(res, pk)
 		}
@@ -321,7 +320,7 @@ func (iNode *insertNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 	timestamps := deleteData.deleteTimestamps[segmentID]
 	offset := deleteData.deleteOffset[segmentID]
 
-	err = targetSegment.segmentDelete(offset, &ids, &timestamps)
+	err = targetSegment.segmentDelete(offset, ids, timestamps)
 	if err != nil {
 		log.Warn("QueryNode: targetSegmentDelete failed", zap.Error(err))
 		return
@@ -332,7 +331,7 @@ func (iNode *insertNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 
 // TODO: remove this function to proper file
 // getPrimaryKeys would get primary keys by insert messages
-func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface) ([]int64, error) {
+func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface) ([]storage.PrimaryKey, error) {
 	if len(msg.RowIDs) != len(msg.Timestamps) || len(msg.RowIDs) != len(msg.RowData) {
 		log.Warn("misaligned messages detected")
 		return nil, errors.New("misaligned messages detected")
@@ -344,26 +343,33 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 		log.Warn(err.Error())
 		return nil, err
 	}
-	offset := 0
+
+	// sizes of the fields before primary key in a row, -1 represents a string field
+	// whose size is decided by the length prefix in each row
+	var pkField *schemapb.FieldSchema
+	sizes := make([]int, 0, len(collection.schema.Fields))
 	for _, field := range collection.schema.Fields {
 		if field.IsPrimaryKey {
+			pkField = field
 			break
 		}
 		switch field.DataType {
 		case schemapb.DataType_Bool:
-			offset++
+			sizes = append(sizes, 1)
 		case schemapb.DataType_Int8:
-			offset++
+			sizes = append(sizes, 1)
 		case schemapb.DataType_Int16:
-			offset += 2
+			sizes = append(sizes, 2)
 		case schemapb.DataType_Int32:
-			offset += 4
+			sizes = append(sizes, 4)
 		case schemapb.DataType_Int64:
-			offset += 8
+			sizes = append(sizes, 8)
 		case schemapb.DataType_Float:
-			offset += 4
+			sizes = append(sizes, 4)
 		case schemapb.DataType_Double:
-			offset += 8
+			sizes = append(sizes, 8)
+		case schemapb.DataType_String, schemapb.DataType_JSON:
+			sizes = append(sizes, -1)
 		case schemapb.DataType_FloatVector:
 			for _, t := range field.TypeParams {
 				if t.Key == "dim" {
@@ -372,7 +378,7 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 						log.Error("strconv wrong on get dim", zap.Error(err))
 						break
 					}
-					offset += dim * 4
+					sizes = append(sizes, dim*4)
 					break
 				}
 			}
@@ -384,30 +390,77 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 						log.Error("strconv wrong on get dim", zap.Error(err))
 						return nil, err
 					}
-					offset += dim / 8
+					sizes = append(sizes, dim/8)
 					break
 				}
 			}
 		}
 	}
-
-	blobReaders := make([]io.Reader, len(msg.RowData))
-	for i, blob := range msg.RowData {
-		blobReaders[i] = bytes.NewReader(blob.GetValue()[offset : offset+8])
+	if pkField == nil {
+		return nil, fmt.Errorf("primary field is not found, collectionID = %d", collectionID)
 	}
-	pks := make([]int64, len(blobReaders))
 
-	for i, reader := range blobReaders {
-		err := binary.Read(reader, common.Endian, &pks[i])
+	pks := make([]storage.PrimaryKey, 0, len(msg.RowData))
+	for _, blob := range msg.RowData {
+		pk, err := readPrimaryKey(blob.GetValue(), sizes, pkField.DataType)
 		if err != nil {
 			log.Warn("binary read blob value failed", zap.Error(err))
 			return nil, err
 		}
+		pks = append(pks, pk)
 	}
 
 	return pks, nil
 }
 
+// readPrimaryKey skips the fields before primary key by their sizes and reads the primary key of a row.
+// A string or json is encoded as its uint32 byte length followed by the bytes.
+func readPrimaryKey(row []byte, sizes []int, pkType schemapb.DataType) (storage.PrimaryKey, error) {
+	readStringLength := func(offset int) (int, error) {
+		if offset+4 > len(row) {
+			return 0, errors.New("row data is too short to read string length")
+		}
+		return int(common.Endian.Uint32(row[offset : offset+4])), nil
+	}
+
+	offset := 0
+	for _, size := range sizes {
+		if size < 0 {
+			length, err := readStringLength(offset)
+			if err != nil {
+				return nil, err
+			}
+			size = 4 + length
+		}
+		offset += size
+	}
+
+	switch pkType {
+	case schemapb.DataType_Int64:
+		var pk int64
+		if offset+8 > len(row) {
+			return nil, errors.New("row data is too short to read int64 primary key")
+		}
+		err := binary.Read(bytes.NewReader(row[offset:offset+8]), common.Endian, &pk)
+		if err != nil {
+			return nil, err
+		}
+		return storage.NewInt64PrimaryKey(pk), nil
+	case schemapb.DataType_String:
+		length, err := readStringLength(offset)
+		if err != nil {
+			return nil, err
+		}
+		offset += 4
+		if offset+length > len(row) {
+			return nil, errors.New("row data is too short to read string primary key")
+		}
+		return storage.NewStringPrimaryKey(string(row[offset : offset+length])), nil
+	default:
+		return nil, fmt.Errorf("unsupported primary key data type %s", pkType.String())
+	}
+}
+
 // newInsertNode returns a new insertNode
 func newInsertNode(streamingReplica ReplicaInterface) *insertNode {
 	maxQueueLength := Params.QueryNodeCfg.FlowGraphMaxQueueLength
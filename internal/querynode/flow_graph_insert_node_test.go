@@ -17,6 +17,8 @@
 package querynode
 
 import (
+	"bytes"
+	"encoding/binary"
 	"sync"
 	"testing"
 
@@ -26,6 +28,8 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -58,8 +62,8 @@ func genFlowGraphDeleteData() (*deleteData, error) {
 		return nil, err
 	}
 	dData := &deleteData{
-		deleteIDs: map[UniqueID][]UniqueID{
-			defaultSegmentID: deleteMsg.PrimaryKeys,
+		deleteIDs: map[UniqueID][]storage.PrimaryKey{
+			defaultSegmentID: storage.NewInt64PrimaryKeys(deleteMsg.PrimaryKeys),
 		},
 		deleteTimestamps: map[UniqueID][]Timestamp{
 			defaultSegmentID: deleteMsg.Timestamps,
@@ -377,15 +381,68 @@ func TestGetSegmentsByPKs(t *testing.T) {
 		segmentID: 1,
 		pkFilter:  filter,
 	}
-	pks, err := filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, segment)
+	pks, err := filterSegmentsByPKs(storage.NewInt64PrimaryKeys([]int64{0, 1, 2, 3, 4}), segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 3)
 
-	pks, err = filterSegmentsByPKs([]int64{}, segment)
+	pks, err = filterSegmentsByPKs([]storage.PrimaryKey{}, segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 0)
 	_, err = filterSegmentsByPKs(nil, segment)
 	assert.NotNil(t, err)
-	_, err = filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, nil)
+	_, err = filterSegmentsByPKs(storage.NewInt64PrimaryKeys([]int64{0, 1, 2, 3, 4}), nil)
 	assert.NotNil(t, err)
+
+	strFilter := bloom.NewWithEstimates(1000000, 0.01)
+	storage.AddPKToBloomFilter(strFilter, storage.NewStringPrimaryKey("a1b2c3"))
+	strSegment := &Segment{
+		segmentID: 2,
+		pkFilter:  strFilter,
+	}
+	pks, err = filterSegmentsByPKs(storage.NewStringPrimaryKeys([]string{"a1b2c3", "https://milvus.io"}), strSegment)
+	assert.Nil(t, err)
+	assert.Equal(t, []storage.PrimaryKey{storage.NewStringPrimaryKey("a1b2c3")}, pks)
+}
+
+func TestReadPrimaryKey(t *testing.T) {
+	var row bytes.Buffer
+	// int32 field, string field, then the string primary key
+	assert.NoError(t, binary.Write(&row, common.Endian, int32(7)))
+	assert.NoError(t, binary.Write(&row, common.Endian, uint32(3)))
+	row.WriteString("abc")
+	assert.NoError(t, binary.Write(&row, common.Endian, uint32(9)))
+	row.WriteString("uuid-1234")
+
+	pk, err := readPrimaryKey(row.Bytes(), []int{4, -1}, schemapb.DataType_String)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.NewStringPrimaryKey("uuid-1234"), pk)
+
+	_, err = readPrimaryKey(row.Bytes()[:10], []int{4, -1}, schemapb.DataType_String)
+	assert.Error(t, err)
+
+	row.Reset()
+	assert.NoError(t, binary.Write(&row, common.Endian, int64(42)))
+	pk, err = readPrimaryKey(row.Bytes(), []int{}, schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.NewInt64PrimaryKey(42), pk)
+
+	_, err = readPrimaryKey(row.Bytes(), []int{}, schemapb.DataType_Float)
+	assert.Error(t, err)
+}
+
+func TestEncodePrimaryKeys(t *testing.T) {
+	blob, err := encodePrimaryKeys(storage.NewInt64PrimaryKeys([]int64{1, 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0}, blob)
+
+	blob, err = encodePrimaryKeys(storage.NewStringPrimaryKeys([]string{"a", "bc"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0, 'a', 2, 0, 0, 0, 'b', 'c'}, blob)
+
+	mixed := append(storage.NewInt64PrimaryKeys([]int64{1}), storage.NewStringPrimaryKeys([]string{"a"})...)
+	_, err = encodePrimaryKeys(mixed)
+	assert.Error(t, err)
+
+	_, err = encodePrimaryKeys(nil)
+	assert.Error(t, err)
 }
@@ -56,6 +56,7 @@ func newQueryNodeFlowGraph(ctx context.Context,
 		channel:      channel,
 		flowGraph:    flowgraph.NewTimeTickedFlowGraph(ctx1),
 	}
+	q.flowGraph.SetMetricLabels(collectionID, channel)
 
 	var dmStreamNode node = q.newDmInputNode(ctx1, factory)
 	var filterDmNode node = newFilteredDmNode(streamingReplica, collectionID)
@@ -123,6 +124,7 @@ func newQueryNodeDeltaFlowGraph(ctx context.Context,
 		channel:      channel,
 		flowGraph:    flowgraph.NewTimeTickedFlowGraph(ctx1),
 	}
+	q.flowGraph.SetMetricLabels(collectionID, channel)
 
 	var dmStreamNode node = q.newDmInputNode(ctx1, factory)
 	var filterDeleteNode node = newFilteredDeleteNode(historicalReplica, collectionID)
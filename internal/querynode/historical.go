@@ -57,7 +57,7 @@ func (h *historical) close() {
 
 // // retrieve will retrieve from the segments in historical
 func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
-	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
+	plan *RetrievePlan, pruner *segmentPruner) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
 
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
@@ -89,6 +89,11 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 			}
+			// a pruned segment is retrieved with no result
+			if !pruner.mayMatch(seg) {
+				retrieveSegmentIDs = append(retrieveSegmentIDs, segID)
+				continue
+			}
 			result, err := seg.retrieve(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
@@ -107,7 +112,7 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 
 // search will search all the target segments in historical
 func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
-	searchTs Timestamp) ([]*SearchResult, []UniqueID, []UniqueID, error) {
+	searchTs Timestamp, pruner *segmentPruner) ([]*SearchResult, []UniqueID, []UniqueID, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	searchSegmentIDs := make([]UniqueID, 0)
@@ -177,6 +182,13 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 				if !seg.getOnService() {
 					return
 				}
+				// a pruned segment is searched with no result
+				if !pruner.mayMatch(seg) {
+					segmentLock.Lock()
+					searchSegmentIDs = append(searchSegmentIDs, seg.segmentID)
+					segmentLock.Unlock()
+					return
+				}
 				searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
 				if err != nil {
 					err2 = err
@@ -21,6 +21,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 func TestHistorical_Search(t *testing.T) {
@@ -35,8 +39,41 @@ func TestHistorical_Search(t *testing.T) {
 		plan, searchReqs, err := genSimpleSearchPlanAndRequests()
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test search pruned segment", func(t *testing.T) {
+		tSafe := newTSafeReplica()
+		his, err := genSimpleHistorical(ctx, tSafe)
+		assert.NoError(t, err)
+
+		plan, searchReqs, err := genSimpleSearchPlanAndRequests()
+		assert.NoError(t, err)
+
+		seg, err := his.replica.getSegmentByID(defaultSegmentID)
 		assert.NoError(t, err)
+		seg.setFieldStats(map[FieldID]*storage.FieldStats{
+			simpleConstField.id: {
+				FieldID:  simpleConstField.id,
+				Type:     schemapb.DataType_Int32,
+				Min:      "1",
+				Max:      "10",
+				RowCount: defaultMsgLength,
+			},
+		})
+		pruner := &segmentPruner{predicates: &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: simpleConstField.id, DataType: schemapb.DataType_Int32},
+				Op:         planpb.OpType_GreaterThan,
+				Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 10}},
+			}},
+		}}
+
+		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), pruner)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(res))
+		assert.Equal(t, []UniqueID{defaultSegmentID}, ids)
 	})
 
 	t.Run("test no collection - search partitions", func(t *testing.T) {
@@ -50,7 +87,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -65,7 +102,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removeCollection(defaultCollectionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -84,7 +121,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		_, _, _, err = his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Error(t, err)
 	})
 
@@ -99,7 +136,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0))
+		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{}, plan, Timestamp(0), nil)
 		assert.Equal(t, 0, len(res))
 		assert.Equal(t, 0, len(ids))
 		assert.NoError(t, err)
@@ -120,7 +157,7 @@ func TestHistorical_Search(t *testing.T) {
 		err = his.replica.removePartition(defaultPartitionID)
 		assert.NoError(t, err)
 
-		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0))
+		res, ids, _, err := his.search(searchReqs, defaultCollectionID, []UniqueID{defaultPartitionID}, plan, Timestamp(0), nil)
 		assert.Equal(t, 0, len(res))
 		assert.Equal(t, 0, len(ids))
 		assert.Error(t, err)
@@ -18,12 +18,14 @@ package querynode
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -530,6 +532,82 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 	}, nil
 }
 
+// Search searches the sealed segments and the growing data of the collection served by this query node
+func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (*internalpb.SearchResults, error) {
+	failResult := func(err error) *internalpb.SearchResults {
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	if !node.isHealthy() {
+		return failResult(fmt.Errorf("query node %d is not ready", Params.QueryNodeCfg.QueryNodeID)), nil
+	}
+	if req.GetReq().GetBase() == nil {
+		return failResult(errors.New("invalid search request")), nil
+	}
+
+	msg := &msgstream.SearchMsg{
+		BaseMsg:       msgstream.BaseMsg{Ctx: ctx},
+		SearchRequest: *req.GetReq(),
+	}
+	qc, err := node.queryService.getQueryCollection(msg.CollectionID)
+	if err != nil {
+		log.Warn("Search failed", zap.Int64("collectionID", msg.CollectionID), zap.Error(err))
+		return failResult(err), nil
+	}
+	if err = qc.waitQueryable(ctx, msg, msg.ReplicaID); err != nil {
+		log.Warn("Search failed", zap.Int64("collectionID", msg.CollectionID), zap.Int64("msgID", msg.ID()), zap.Error(err))
+		return failResult(err), nil
+	}
+	result, err := qc.doSearch(msg)
+	if err != nil {
+		log.Warn("Search failed", zap.Int64("collectionID", msg.CollectionID), zap.Int64("msgID", msg.ID()), zap.Error(err))
+		return failResult(err), nil
+	}
+	return result, nil
+}
+
+// Query retrieves the entities from the sealed segments and the growing data of the collection served by this query node
+func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	failResult := func(err error) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	if !node.isHealthy() {
+		return failResult(fmt.Errorf("query node %d is not ready", Params.QueryNodeCfg.QueryNodeID)), nil
+	}
+	if req.GetReq().GetBase() == nil {
+		return failResult(errors.New("invalid query request")), nil
+	}
+
+	msg := &msgstream.RetrieveMsg{
+		BaseMsg:         msgstream.BaseMsg{Ctx: ctx},
+		RetrieveRequest: *req.GetReq(),
+	}
+	qc, err := node.queryService.getQueryCollection(msg.CollectionID)
+	if err != nil {
+		log.Warn("Query failed", zap.Int64("collectionID", msg.CollectionID), zap.Error(err))
+		return failResult(err), nil
+	}
+	if err = qc.waitQueryable(ctx, msg, msg.ReplicaID); err != nil {
+		log.Warn("Query failed", zap.Int64("collectionID", msg.CollectionID), zap.Int64("msgID", msg.ID()), zap.Error(err))
+		return failResult(err), nil
+	}
+	result, err := qc.doRetrieve(msg)
+	if err != nil {
+		log.Warn("Query failed", zap.Int64("collectionID", msg.CollectionID), zap.Int64("msgID", msg.ID()), zap.Error(err))
+		return failResult(err), nil
+	}
+	return result, nil
+}
+
 // isHealthy checks if QueryNode is healthy
 func (node *QueryNode) isHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
@@ -24,6 +24,7 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -597,3 +598,99 @@ func TestImpl_ReleaseSegments(t *testing.T) {
 	})
 	wg.Wait()
 }
+
+func TestImpl_Search(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	req, err := genSimpleSearchRequest()
+	assert.NoError(t, err)
+
+	t.Run("test collection not loaded", func(t *testing.T) {
+		rsp, err := node.Search(ctx, &queryPb.SearchRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
+	err = node.queryService.addQueryCollection(defaultCollectionID)
+	assert.NoError(t, err)
+	err = node.historical.replica.removeSegment(defaultSegmentID)
+	assert.NoError(t, err)
+	err = node.streaming.replica.removeSegment(defaultSegmentID)
+	assert.NoError(t, err)
+
+	t.Run("test valid", func(t *testing.T) {
+		rsp, err := node.Search(ctx, &queryPb.SearchRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+	})
+
+	t.Run("test other replica", func(t *testing.T) {
+		otherReq := proto.Clone(req).(*internalpb.SearchRequest)
+		otherReq.ReplicaID = 1000
+		rsp, err := node.Search(ctx, &queryPb.SearchRequest{Req: otherReq})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
+	t.Run("test invalid request", func(t *testing.T) {
+		rsp, err := node.Search(ctx, &queryPb.SearchRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
+	t.Run("test unhealthy", func(t *testing.T) {
+		node.UpdateStateCode(internalpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(internalpb.StateCode_Healthy)
+		rsp, err := node.Search(ctx, &queryPb.SearchRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+}
+
+func TestImpl_Query(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	req, err := genSimpleRetrieveRequest()
+	assert.NoError(t, err)
+
+	t.Run("test collection not loaded", func(t *testing.T) {
+		rsp, err := node.Query(ctx, &queryPb.QueryRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
+	err = node.queryService.addQueryCollection(defaultCollectionID)
+	assert.NoError(t, err)
+	qc, err := node.queryService.getQueryCollection(defaultCollectionID)
+	assert.NoError(t, err)
+	qc.vectorChunkManager, err = genVectorChunkManager(ctx)
+	assert.NoError(t, err)
+
+	t.Run("test valid", func(t *testing.T) {
+		rsp, err := node.Query(ctx, &queryPb.QueryRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+	})
+
+	t.Run("test invalid request", func(t *testing.T) {
+		rsp, err := node.Query(ctx, &queryPb.QueryRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+
+	t.Run("test unhealthy", func(t *testing.T) {
+		node.UpdateStateCode(internalpb.StateCode_Abnormal)
+		defer node.UpdateStateCode(internalpb.StateCode_Healthy)
+		rsp, err := node.Query(ctx, &queryPb.QueryRequest{Req: req})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, rsp.Status.ErrorCode)
+	})
+}
@@ -25,8 +25,6 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/kv"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -50,7 +48,7 @@ type indexLoader struct {
 	rootCoord  types.RootCoord
 	indexCoord types.IndexCoord
 
-	kv kv.DataKV // minio kv
+	chunkManager storage.ChunkManager
 }
 
 // loadIndex would load index to segment
@@ -119,7 +117,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 	indexCodec := storage.NewIndexFileBinlogCodec()
 	for _, p := range indexPath {
 		log.Debug("", zap.String("load path", fmt.Sprintln(p)))
-		indexPiece, err := loader.kv.Load(p)
+		indexPiece, err := loader.chunkManager.Read(p)
 		if err != nil {
 			return nil, nil, "", err
 		}
@@ -128,7 +126,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 			_, indexParams, indexName, _, err = indexCodec.Deserialize([]*storage.Blob{
 				{
 					Key:   storage.IndexParamsKey,
-					Value: []byte(indexPiece),
+					Value: indexPiece,
 				},
 			})
 			if err != nil {
@@ -138,7 +136,7 @@ func (loader *indexLoader) getIndexBinlog(indexPath []string) ([][]byte, indexPa
 			data, _, _, _, err := indexCodec.Deserialize([]*storage.Blob{
 				{
 					Key:   path.Base(p), // though key is not important here
-					Value: []byte(indexPiece),
+					Value: indexPiece,
 				},
 			})
 			if err != nil {
@@ -159,9 +157,9 @@ func (loader *indexLoader) estimateIndexBinlogSize(segment *Segment, fieldID Fie
 	indexSize := int64(0)
 	indexPaths := segment.getIndexPaths(fieldID)
 	for _, p := range indexPaths {
-		logSize, err := storage.EstimateMemorySize(loader.kv, p)
+		logSize, err := storage.EstimateMemorySize(loader.chunkManager, p)
 		if err != nil {
-			logSize, err = storage.GetBinlogSize(loader.kv, p)
+			logSize, err = storage.GetBinlogSize(loader.chunkManager, p)
 			if err != nil {
 				return 0, err
 			}
@@ -245,21 +243,7 @@ func (loader *indexLoader) setIndexInfo(segment *Segment, info *indexInfo) {
 }
 
 // newIndexLoader returns a new indexLoader
-func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface) *indexLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	client, err := minioKV.NewMinIOKV(ctx, option)
-	if err != nil {
-		panic(err)
-	}
-
+func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, replica ReplicaInterface, cm storage.ChunkManager) *indexLoader {
 	return &indexLoader{
 		ctx:     ctx,
 		replica: replica,
@@ -270,7 +254,7 @@ func newIndexLoader(ctx context.Context, rootCoord types.RootCoord, indexCoord t
 		rootCoord:  rootCoord,
 		indexCoord: indexCoord,
 
-		kv: client,
+		chunkManager: cm,
 	}
 }
 
@@ -18,10 +18,12 @@ package querynode
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -57,6 +59,9 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 
 			SimdType: Params.KnowhereCfg.SimdType,
 		},
+		QuotaMetrics: metricsinfo.QueryNodeQuotaMetrics{
+			MaxTSafeDelay: getMaxTSafeDelay(node.tSafeReplica, time.Now()),
+		},
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
@@ -81,3 +86,20 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeCfg.QueryNodeID),
 	}, nil
 }
+
+// getMaxTSafeDelay returns how far the most lagging tsafe is behind now
+func getMaxTSafeDelay(replica TSafeReplicaInterface, now time.Time) time.Duration {
+	var maxDelay time.Duration
+	for _, channel := range replica.getTSafeChannels() {
+		ts, err := replica.getTSafe(channel)
+		// channels without any time tick consumed yet are skipped
+		if err != nil || ts == 0 {
+			continue
+		}
+		physicalTime, _ := tsoutil.ParseTS(ts)
+		if delay := now.Sub(physicalTime); delay > maxDelay {
+			maxDelay = delay
+		}
+	}
+	return maxDelay
+}
@@ -19,6 +19,7 @@ package querynode
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func TestGetSystemInfoMetrics(t *testing.T) {
@@ -47,3 +49,19 @@ func TestGetSystemInfoMetrics(t *testing.T) {
 	assert.NoError(t, err)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 }
+
+func TestGetMaxTSafeDelay(t *testing.T) {
+	replica := newTSafeReplica()
+	now := time.Unix(time.Now().Unix(), 0)
+	assert.Equal(t, time.Duration(0), getMaxTSafeDelay(replica, now))
+
+	replica.addTSafe("dml-0")
+	replica.addTSafe("dml-1")
+	assert.Equal(t, time.Duration(0), getMaxTSafeDelay(replica, now))
+	err := replica.setTSafe("dml-0", tsoutil.ComposeTSByTime(now.Add(-time.Second), 0))
+	assert.NoError(t, err)
+	err = replica.setTSafe("dml-1", tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []Channel{"dml-0", "dml-1"}, replica.getTSafeChannels())
+	assert.Equal(t, time.Minute, getMaxTSafeDelay(replica, now))
+}
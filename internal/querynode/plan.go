@@ -29,11 +29,16 @@ import (
 	"errors"
 	"fmt"
 	"unsafe"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
 
 // SearchPlan is a wrapper of the underlying C-structure C.CSearchPlan
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	// rangeSearchParams is nil unless the plan is a range search
+	rangeSearchParams *planpb.RangeSearchParams
 }
 
 // createSearchPlan returns a new SearchPlan and error
@@ -61,6 +66,11 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
 
+	var planNode planpb.PlanNode
+	if err := proto.Unmarshal(expr, &planNode); err != nil {
+		return nil, err
+	}
+
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlanByExpr(col.collectionPtr, (*C.char)(unsafe.Pointer(&expr[0])), (C.int64_t)(len(expr)), &cPlan)
 
@@ -69,10 +79,31 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 		return nil, err1
 	}
 
-	var newPlan = &SearchPlan{cSearchPlan: cPlan}
+	var newPlan = &SearchPlan{
+		cSearchPlan:       cPlan,
+		rangeSearchParams: planNode.GetVectorAnns().GetQueryInfo().GetRangeSearchParams(),
+	}
 	return newPlan, nil
 }
 
+// createSearchPlanByExprWithTopK returns a new SearchPlan of the serialized plan with its topk replaced
+func createSearchPlanByExprWithTopK(col *Collection, expr []byte, topK int64) (*SearchPlan, error) {
+	var planNode planpb.PlanNode
+	if err := proto.Unmarshal(expr, &planNode); err != nil {
+		return nil, err
+	}
+	queryInfo := planNode.GetVectorAnns().GetQueryInfo()
+	if queryInfo == nil {
+		return nil, errors.New("no query info in the search plan")
+	}
+	queryInfo.Topk = topK
+	newExpr, err := proto.Marshal(&planNode)
+	if err != nil {
+		return nil, err
+	}
+	return createSearchPlanByExpr(col, newExpr)
+}
+
 func (plan *SearchPlan) getTopK() int64 {
 	topK := C.GetTopK(plan.cSearchPlan)
 	return int64(topK)
@@ -85,6 +116,10 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+func (plan *SearchPlan) getRangeSearchParams() *planpb.RangeSearchParams {
+	return plan.rangeSearchParams
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -67,6 +67,9 @@ func TestPlan_createSearchPlanByExpr(t *testing.T) {
 
 	_, err = createSearchPlanByExpr(col, expr)
 	assert.Error(t, err)
+
+	_, err = createSearchPlanByExpr(col, []byte{1, 2, 3})
+	assert.Error(t, err)
 }
 
 func TestPlan_NilCollection(t *testing.T) {
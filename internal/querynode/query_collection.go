@@ -21,15 +21,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -45,6 +49,9 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// maxTopK is the max topk of a search, a range search enlarges its topk up to it
+const maxTopK = 16384
+
 type queryMsg interface {
 	msgstream.TsMsg
 	GuaranteeTs() Timestamp
@@ -81,10 +88,11 @@ type queryCollection struct {
 	queryMsgStream       msgstream.MsgStream
 	queryResultMsgStream msgstream.MsgStream
 
-	localChunkManager  storage.ChunkManager
-	remoteChunkManager storage.ChunkManager
-	vectorChunkManager storage.ChunkManager
-	localCacheEnabled  bool
+	localChunkManager    storage.ChunkManager
+	remoteChunkManager   storage.ChunkManager
+	vectorChunkManagerMu sync.Mutex // guards vectorChunkManager
+	vectorChunkManager   storage.ChunkManager
+	localCacheEnabled    bool
 
 	globalSegmentManager *globalSealedSegmentManager
 }
@@ -424,11 +432,13 @@ func (q *queryCollection) adjustByChangeInfo(msg *msgstream.SealedSegmentsChange
 func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	msgType := msg.Type()
 	var collectionID UniqueID
+	var replicaID UniqueID
 	var msgTypeStr string
 
 	switch msgType {
 	case commonpb.MsgType_Retrieve:
 		collectionID = msg.(*msgstream.RetrieveMsg).CollectionID
+		replicaID = msg.(*msgstream.RetrieveMsg).ReplicaID
 		msgTypeStr = "retrieve"
 		//log.Debug("consume retrieve message",
 		//	zap.Any("collectionID", collectionID),
@@ -436,6 +446,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//)
 	case commonpb.MsgType_Search:
 		collectionID = msg.(*msgstream.SearchMsg).CollectionID
+		replicaID = msg.(*msgstream.SearchMsg).ReplicaID
 		msgTypeStr = "search"
 		//log.Debug("consume search message",
 		//	zap.Any("collectionID", collectionID),
@@ -484,6 +495,10 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		)
 		return err
 	}
+	// the request is served by the query nodes of a single replica, others ignore it
+	if replicaID != 0 && replicaID != collection.getReplicaID() {
+		return nil
+	}
 	guaranteeTs := msg.GuaranteeTs()
 	if guaranteeTs >= collection.getReleaseTime() {
 		err = fmt.Errorf("retrieve failed, collection has been released, msgID = %d, collectionID = %d", msg.ID(), collectionID)
@@ -566,6 +581,44 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	return nil
 }
 
+// waitQueryable checks the request sent by grpc and blocks until the data before its guarantee timestamp
+// is serviceable, unlike the requests from the query channel, the request is served by this node
+// only if the node belongs to the replica which the request addresses to.
+func (q *queryCollection) waitQueryable(ctx context.Context, msg queryMsg, replicaID UniqueID) error {
+	collection, err := q.historical.replica.getCollectionByID(q.collectionID)
+	if err != nil {
+		return err
+	}
+	if replicaID != 0 && replicaID != collection.getReplicaID() {
+		return fmt.Errorf("query node serves replica %d of collection %d rather than replica %d",
+			collection.getReplicaID(), q.collectionID, replicaID)
+	}
+	guaranteeTs := msg.GuaranteeTs()
+	if guaranteeTs >= collection.getReleaseTime() {
+		return fmt.Errorf("collection has been released, msgID = %d, collectionID = %d", msg.ID(), q.collectionID)
+	}
+	if len(collection.getVChannels()) == 0 && len(collection.getVDeltaChannels()) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for guaranteeTs > q.getServiceableTime() {
+		if q.checkTimeout(msg) {
+			return fmt.Errorf("wait for serviceable time timeout, collectionID = %d, msgID = %d, timeoutTS = %d",
+				q.collectionID, msg.ID(), msg.TimeoutTs())
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-q.releaseCtx.Done():
+			return fmt.Errorf("collection %d has been released", q.collectionID)
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
 func (q *queryCollection) doUnsolvedQueryMsg() {
 	log.Debug("starting doUnsolvedMsg...", zap.Any("collectionID", q.collectionID))
 	for {
@@ -695,35 +748,73 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 		hits = append(hits, &hit)
 	}
 
-	blobOffset := 0
-	// skip id
-	numQueries := len(rawHits)
-	pbHits := &milvuspb.Hits{}
-	err := proto.Unmarshal(rawHits[0], pbHits)
-	if err != nil {
-		return nil, err
-	}
-	topK := len(pbHits.IDs)
-
-	blobOffset += 8
-	var ids []int64
+	// the rows of all the hits, a row holds the primary key followed by the output fields,
+	// strings are variable-length so every row is read from its own offset
+	var rows [][]byte
 	var scores []float32
 	for _, hit := range hits {
-		ids = append(ids, hit.IDs...)
+		rows = append(rows, hit.RowData...)
 		scores = append(scores, hit.Scores...)
 	}
+	blobOffsets := make([]int, len(rows))
+	skip := func(blobLen int) {
+		for i := range blobOffsets {
+			blobOffsets[i] += blobLen
+		}
+	}
+	readStrings := func() ([]string, error) {
+		strs := make([]string, 0, len(rows))
+		for i, row := range rows {
+			offset := blobOffsets[i]
+			if offset+4 > len(row) {
+				return nil, errors.New("row data is too short to read string length")
+			}
+			length := int(common.Endian.Uint32(row[offset : offset+4]))
+			offset += 4
+			if offset+length > len(row) {
+				return nil, errors.New("row data is too short to read string")
+			}
+			strs = append(strs, string(row[offset:offset+length]))
+			blobOffsets[i] = offset + length
+		}
+		return strs, nil
+	}
 
+	numQueries := len(rawHits)
+	topK := len(hits[0].Scores)
 	finalResult := &schemapb.SearchResultData{
-		Ids: &schemapb.IDs{
+		Scores:     scores,
+		TopK:       int64(topK),
+		NumQueries: int64(numQueries),
+	}
+
+	// the hits of string primary keys have no int64 ids, the keys are read from the rows
+	pkField, err := schema.GetPrimaryKeyField()
+	if err == nil && pkField.DataType == schemapb.DataType_String {
+		ids, err := readStrings()
+		if err != nil {
+			return nil, err
+		}
+		finalResult.Ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: ids,
+				},
+			},
+		}
+	} else {
+		var ids []int64
+		for _, hit := range hits {
+			ids = append(ids, hit.IDs...)
+		}
+		finalResult.Ids = &schemapb.IDs{
 			IdField: &schemapb.IDs_IntId{
 				IntId: &schemapb.LongArray{
 					Data: ids,
 				},
 			},
-		},
-		Scores:     scores,
-		TopK:       int64(topK),
-		NumQueries: int64(numQueries),
+		}
+		skip(8)
 	}
 
 	for _, fieldID := range fieldIDs {
@@ -735,12 +826,10 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 		case schemapb.DataType_Bool:
 			blobLen := 1
 			var colData []bool
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := dataBlob[0]
-					colData = append(colData, data != 0)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := dataBlob[0]
+				colData = append(colData, data != 0)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -754,16 +843,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Int8:
 			blobLen := 1
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(dataBlob[0])
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := int32(dataBlob[0])
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -777,16 +864,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Int16:
 			blobLen := 2
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(int16(common.Endian.Uint16(dataBlob)))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := int32(int16(common.Endian.Uint16(dataBlob)))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -800,16 +885,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Int32:
 			blobLen := 4
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(common.Endian.Uint32(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := int32(common.Endian.Uint32(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -823,16 +906,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Int64:
 			blobLen := 8
 			var colData []int64
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int64(common.Endian.Uint64(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := int64(common.Endian.Uint64(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -846,16 +927,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Float:
 			blobLen := 4
 			var colData []float32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := math.Float32frombits(common.Endian.Uint32(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := math.Float32frombits(common.Endian.Uint32(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -869,16 +948,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_Double:
 			blobLen := 8
 			var colData []float64
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := math.Float64frombits(common.Endian.Uint64(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				data := math.Float64frombits(common.Endian.Uint64(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -892,7 +969,45 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
+		case schemapb.DataType_String:
+			colData, err := readStrings()
+			if err != nil {
+				return nil, err
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
+		case schemapb.DataType_JSON:
+			docs, err := readStrings()
+			if err != nil {
+				return nil, err
+			}
+			colData := make([][]byte, 0, len(docs))
+			for _, doc := range docs {
+				colData = append(colData, []byte(doc))
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 		case schemapb.DataType_FloatVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -900,15 +1015,13 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			blobLen := dim * 4
 			var colData []float32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					//ref https://github.com/golang/go/wiki/cgo#turning-c-arrays-into-go-slices
-					/* #nosec G103 */
-					ptr := unsafe.Pointer(&dataBlob[0])
-					farray := (*[1 << 28]float32)(ptr)
-					colData = append(colData, farray[:dim:dim]...)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				//ref https://github.com/golang/go/wiki/cgo#turning-c-arrays-into-go-slices
+				/* #nosec G103 */
+				ptr := unsafe.Pointer(&dataBlob[0])
+				farray := (*[1 << 28]float32)(ptr)
+				colData = append(colData, farray[:dim:dim]...)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Vectors{
@@ -923,7 +1036,7 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		case schemapb.DataType_BinaryVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -931,11 +1044,9 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			blobLen := dim / 8
 			var colData []byte
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					colData = append(colData, dataBlob...)
-				}
+			for i, row := range rows {
+				dataBlob := row[blobOffsets[i] : blobOffsets[i]+blobLen]
+				colData = append(colData, dataBlob...)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Vectors{
@@ -948,7 +1059,7 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+			skip(blobLen)
 		default:
 			return nil, fmt.Errorf("unsupported data type %s", schemapb.DataType_name[int32(fieldMeta.DataType)])
 		}
@@ -957,30 +1068,52 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	return finalResult, nil
 }
 
+func (q *queryCollection) search(msg queryMsg) error {
+	searchMsg := msg.(*msgstream.SearchMsg)
+	result, err := q.doSearch(searchMsg)
+	if err != nil {
+		return err
+	}
+
+	resultChannelInt := 0
+	searchResultMsg := &msgstream.SearchResultMsg{
+		BaseMsg:       msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		SearchResults: *result,
+	}
+	err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+	if err != nil {
+		return err
+	}
+	log.Debug("QueryNode publish SearchResultMsg",
+		zap.Int64("collectionID", searchMsg.CollectionID),
+		zap.Int64("msgID", searchMsg.ID()),
+	)
+	return nil
+}
+
+// doSearch searches the sealed segments and the growing segments of the collection on this query node,
+// the result records the searched segments and channels so that the proxy could check whether it's complete.
 // TODO:: cache map[dsl]plan
 // TODO: reBatched search requests
-func (q *queryCollection) search(msg queryMsg) error {
+func (q *queryCollection) doSearch(searchMsg *msgstream.SearchMsg) (*internalpb.SearchResults, error) {
 	q.streaming.replica.queryRLock()
 	q.historical.replica.queryRLock()
 	defer q.historical.replica.queryRUnlock()
 	defer q.streaming.replica.queryRUnlock()
 
-	searchMsg := msg.(*msgstream.SearchMsg)
-	collectionID := searchMsg.CollectionID
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
 	defer sp.Finish()
 	searchMsg.SetTraceCtx(ctx)
 	searchTimestamp := searchMsg.BeginTs()
-	travelTimestamp := searchMsg.TravelTimestamp
 
 	collection, err := q.streaming.replica.getCollectionByID(searchMsg.CollectionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	schema, err := typeutil.CreateSchemaHelper(collection.schema)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var plan *SearchPlan
@@ -988,27 +1121,29 @@ func (q *queryCollection) search(msg queryMsg) error {
 		expr := searchMsg.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		dsl := searchMsg.Dsl
 		plan, err = createSearchPlan(collection, dsl)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	defer plan.delete()
 	topK := plan.getTopK()
 	if topK == 0 {
-		return fmt.Errorf("limit must be greater than 0, msgID = %d", searchMsg.ID())
+		return nil, fmt.Errorf("limit must be greater than 0, msgID = %d", searchMsg.ID())
 	}
-	if topK >= 16385 {
-		return fmt.Errorf("limit %d is too large, msgID = %d", topK, searchMsg.ID())
+	if topK > maxTopK {
+		return nil, fmt.Errorf("limit %d is too large, msgID = %d", topK, searchMsg.ID())
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer searchReq.delete()
 	queryNum := searchReq.getNumOfQuery()
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
@@ -1033,194 +1168,248 @@ func (q *queryCollection) search(msg queryMsg) error {
 		globalSealedSegments = q.globalSegmentManager.getGlobalSegmentIDs()
 	}
 
+	transformed, sealedSegmentSearched, err := q.searchAndReduce(searchMsg, collection, schema, plan, searchRequests, sp, tr)
+	if err != nil {
+		return nil, err
+	}
+	if rangeSearchParams := plan.getRangeSearchParams(); rangeSearchParams != nil && transformed != nil {
+		transformed, sealedSegmentSearched, err = q.rangeSearch(searchMsg, collection, schema, plan, transformed, sealedSegmentSearched, sp, tr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &internalpb.SearchResults{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_SearchResult,
+			MsgID:     searchMsg.Base.MsgID,
+			Timestamp: searchTimestamp,
+			SourceID:  searchMsg.Base.SourceID,
+		},
+		Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ResultChannelID:          searchMsg.ResultChannelID,
+		MetricType:               plan.getMetricType(),
+		NumQueries:               queryNum,
+		TopK:                     topK,
+		SlicedOffset:             1,
+		SlicedNumCount:           1,
+		SealedSegmentIDsSearched: sealedSegmentSearched,
+		ChannelIDsSearched:       collection.getVChannels(),
+		GlobalSealedSegmentIDs:   globalSealedSegments,
+	}
+	if transformed == nil {
+		log.Debug("QueryNode empty search result",
+			zap.Any("collectionID", collection.id),
+			zap.Any("msgID", searchMsg.ID()),
+			zap.Any("vChannels", collection.getVChannels()),
+			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+		)
+		tr.Elapse(fmt.Sprintf("all done, msgID = %d", searchMsg.ID()))
+		return result, nil
+	}
+
+	result.SlicedBlob, err = proto.Marshal(transformed)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("QueryNode search result",
+		zap.Any("collectionID", collection.id),
+		zap.Any("msgID", searchMsg.ID()),
+		zap.Any("vChannels", collection.getVChannels()),
+		zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+	)
+
+	sp.LogFields(oplog.String("statistical time", "stats done"))
+	tr.Elapse(fmt.Sprintf("all done, msgID = %d", searchMsg.ID()))
+	return result, nil
+}
+
+// rangeSearch keeps the hits of a range search within range. If some query has fewer than topk hits within range
+// while its candidates are not exhausted, the segments are searched again with a doubled topk, up to maxTopK.
+func (q *queryCollection) rangeSearch(searchMsg *msgstream.SearchMsg, collection *Collection, schema *typeutil.SchemaHelper,
+	plan *SearchPlan, transformed *schemapb.SearchResultData, sealedSegmentSearched []UniqueID,
+	sp opentracing.Span, tr *timerecord.TimeRecorder) (*schemapb.SearchResultData, []UniqueID, error) {
+	topK := plan.getTopK()
+	metricType := plan.getMetricType()
+	rangeSearchParams := plan.getRangeSearchParams()
+	for searchTopK := topK; ; {
+		filtered, exhausted := filterRangeSearchResults(transformed, topK, metricType, rangeSearchParams)
+		if exhausted || searchTopK >= maxTopK {
+			return filtered, sealedSegmentSearched, nil
+		}
+
+		searchTopK *= 2
+		if searchTopK > maxTopK {
+			searchTopK = maxTopK
+		}
+		log.Debug("range search again with a larger topk", zap.Int64("msgID", searchMsg.ID()), zap.Int64("topK", searchTopK))
+		rangePlan, err := createSearchPlanByExprWithTopK(collection, searchMsg.SerializedExprPlan, searchTopK)
+		if err != nil {
+			return nil, nil, err
+		}
+		transformed, sealedSegmentSearched, err = func() (*schemapb.SearchResultData, []UniqueID, error) {
+			defer rangePlan.delete()
+			searchReq, err := parseSearchRequest(rangePlan, searchMsg.PlaceholderGroup)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer searchReq.delete()
+			return q.searchAndReduce(searchMsg, collection, schema, rangePlan, []*searchRequest{searchReq}, sp, tr)
+		}()
+		if err != nil {
+			return nil, nil, err
+		}
+		// the segments could be released in the meantime
+		if transformed == nil {
+			return nil, sealedSegmentSearched, nil
+		}
+	}
+}
+
+// searchAndReduce searches the sealed segments and the growing segments with plan and reduces the hits of all segments,
+// the returned result data is nil if there is no segment to search.
+func (q *queryCollection) searchAndReduce(searchMsg *msgstream.SearchMsg, collection *Collection, schema *typeutil.SchemaHelper,
+	plan *SearchPlan, searchRequests []*searchRequest,
+	sp opentracing.Span, tr *timerecord.TimeRecorder) (*schemapb.SearchResultData, []UniqueID, error) {
+	collectionID := collection.id
+	travelTimestamp := searchMsg.TravelTimestamp
 	searchResults := make([]*SearchResult, 0)
 
 	// historical search
 	log.Debug("historical search start", zap.Int64("msgID", searchMsg.ID()))
-	hisSearchResults, sealedSegmentSearched, sealedPartitionSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
+	var pruner *segmentPruner
+	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
+		pruner = newSegmentPruner(searchMsg.SerializedExprPlan)
+	}
+	hisSearchResults, sealedSegmentSearched, sealedPartitionSearched, err := q.historical.search(searchRequests, collection.id, searchMsg.PartitionIDs, plan, travelTimestamp, pruner)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	searchResults = append(searchResults, hisSearchResults...)
 	log.Debug("historical search", zap.Int64("msgID", searchMsg.ID()), zap.Int64("collectionID", collectionID), zap.Int64s("searched partitionIDs", sealedPartitionSearched), zap.Int64s("searched segmentIDs", sealedSegmentSearched))
-	tr.Record(fmt.Sprintf("historical search done, msgID = %d", searchMsg.ID()))
+	metrics.QueryNodeSQSegmentLatency.WithLabelValues(metrics.SearchLabel,
+		metrics.SealedSegmentLabel).Observe(float64(tr.Record(fmt.Sprintf("historical search done, msgID = %d", searchMsg.ID())).Milliseconds()))
 
 	log.Debug("streaming search start", zap.Int64("msgID", searchMsg.ID()))
 	for _, channel := range collection.getVChannels() {
 		var strSearchResults []*SearchResult
 		strSearchResults, growingSegmentSearched, growingPartitionSearched, err := q.streaming.search(searchRequests, collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err != nil {
-			return err
+			deleteSearchResults(searchResults)
+			return nil, nil, err
 		}
 		searchResults = append(searchResults, strSearchResults...)
 		log.Debug("streaming search", zap.Int64("msgID", searchMsg.ID()), zap.Int64("collectionID", collectionID), zap.String("searched dmChannel", channel), zap.Int64s("searched partitionIDs", growingPartitionSearched), zap.Int64s("searched segmentIDs", growingSegmentSearched))
 	}
-	tr.Record(fmt.Sprintf("streaming search done, msgID = %d", searchMsg.ID()))
-
+	metrics.QueryNodeSQSegmentLatency.WithLabelValues(metrics.SearchLabel,
+		metrics.GrowingSegmentLabel).Observe(float64(tr.Record(fmt.Sprintf("streaming search done, msgID = %d", searchMsg.ID())).Milliseconds()))
 	sp.LogFields(oplog.String("statistical time", "segment search end"))
+
 	if len(searchResults) <= 0 {
-		for range searchRequests {
-			resultChannelInt := 0
-			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-				SearchResults: internalpb.SearchResults{
-					Base: &commonpb.MsgBase{
-						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
-					},
-					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
-					MetricType:               plan.getMetricType(),
-					NumQueries:               queryNum,
-					TopK:                     topK,
-					SlicedBlob:               nil,
-					SlicedOffset:             1,
-					SlicedNumCount:           1,
-					SealedSegmentIDsSearched: sealedSegmentSearched,
-					ChannelIDsSearched:       collection.getVChannels(),
-					GlobalSealedSegmentIDs:   globalSealedSegments,
-				},
-			}
-			log.Debug("QueryNode Empty SearchResultMsg",
-				zap.Any("collectionID", collection.id),
-				zap.Any("msgID", searchMsg.ID()),
-				zap.Any("vChannels", collection.getVChannels()),
-				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-			if err != nil {
-				return err
-			}
-			tr.Record(fmt.Sprintf("publish empty search result done, msgID = %d", searchMsg.ID()))
-			tr.Elapse(fmt.Sprintf("all done, msgID = %d", searchMsg.ID()))
-			return nil
-		}
+		return nil, sealedSegmentSearched, nil
 	}
+	defer deleteSearchResults(searchResults)
 
 	numSegment := int64(len(searchResults))
-	var marshaledHits *MarshaledHits
 	log.Debug("QueryNode reduce data", zap.Int64("msgID", searchMsg.ID()), zap.Int64("numSegment", numSegment))
 	err = reduceSearchResultsAndFillData(plan, searchResults, numSegment)
 	log.Debug("QueryNode reduce data finished", zap.Int64("msgID", searchMsg.ID()))
 	sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
 	if err != nil {
 		log.Error("QueryNode reduce data failed", zap.Int64("msgID", searchMsg.ID()), zap.Error(err))
-		return err
+		return nil, nil, err
 	}
-	marshaledHits, err = reorganizeSearchResults(searchResults, numSegment)
+	marshaledHits, err := reorganizeSearchResults(searchResults, numSegment)
 	sp.LogFields(oplog.String("statistical time", "reorganizeSearchResults end"))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	defer deleteMarshaledHits(marshaledHits)
 
 	hitsBlob, err := marshaledHits.getHitsBlob()
 	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	tr.Record(fmt.Sprintf("reduce result done, msgID = %d", searchMsg.ID()))
 
+	// there is exactly one search request in the group
+	hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(0)
+	if err != nil {
+		return nil, nil, err
+	}
 	var offset int64
-	for index := range searchRequests {
-		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
-		if err != nil {
-			return err
-		}
-		hits := make([][]byte, len(hitBlobSizePeerQuery))
-		for i, len := range hitBlobSizePeerQuery {
-			hits[i] = hitsBlob[offset : offset+len]
-			//test code to checkout marshaled hits
-			//marshaledHit := hitsBlob[offset:offset+len]
-			//unMarshaledHit := milvuspb.Hits{}
-			//err = proto.Unmarshal(marshaledHit, &unMarshaledHit)
-			//if err != nil {
-			//	return err
-			//}
-			//log.Debug("hits msg  = ", unMarshaledHit)
-			offset += len
-		}
-
-		// TODO: remove inefficient code in cgo and use SearchResultData directly
-		// TODO: Currently add a translate layer from hits to SearchResultData
-		// TODO: hits marshal and unmarshal is likely bottleneck
+	hits := make([][]byte, len(hitBlobSizePeerQuery))
+	for i, len := range hitBlobSizePeerQuery {
+		hits[i] = hitsBlob[offset : offset+len]
+		offset += len
+	}
 
-		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
-		if err != nil {
-			return err
-		}
-		byteBlobs, err := proto.Marshal(transformed)
-		if err != nil {
-			return err
-		}
+	// TODO: remove inefficient code in cgo and use SearchResultData directly
+	// TODO: Currently add a translate layer from hits to SearchResultData
+	// TODO: hits marshal and unmarshal is likely bottleneck
 
-		resultChannelInt := 0
-		searchResultMsg := &msgstream.SearchResultMsg{
-			BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-			SearchResults: internalpb.SearchResults{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_SearchResult,
-					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
-					SourceID:  searchMsg.Base.SourceID,
-				},
-				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-				ResultChannelID:          searchMsg.ResultChannelID,
-				MetricType:               plan.getMetricType(),
-				NumQueries:               queryNum,
-				TopK:                     topK,
-				SlicedBlob:               byteBlobs,
-				SlicedOffset:             1,
-				SlicedNumCount:           1,
-				SealedSegmentIDsSearched: sealedSegmentSearched,
-				ChannelIDsSearched:       collection.getVChannels(),
-				GlobalSealedSegmentIDs:   globalSealedSegments,
-			},
-		}
-		log.Debug("QueryNode SearchResultMsg",
-			zap.Any("collectionID", collection.id),
-			zap.Any("msgID", searchMsg.ID()),
-			zap.Any("vChannels", collection.getVChannels()),
-			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-		)
+	transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transformed, sealedSegmentSearched, nil
+}
 
-		// For debugging, please don't delete.
-		//fmt.Println("==================== search result ======================")
-		//for i := 0; i < len(hits); i++ {
-		//	testHits := milvuspb.Hits{}
-		//	err := proto.Unmarshal(hits[i], &testHits)
-		//	if err != nil {
-		//		panic(err)
-		//	}
-		//	fmt.Println(testHits.IDs)
-		//	fmt.Println(testHits.Scores)
-		//}
-		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-		if err != nil {
-			return err
-		}
-		tr.Record(fmt.Sprintf("publish search result, msgID = %d", searchMsg.ID()))
+func (q *queryCollection) retrieve(msg queryMsg) error {
+	retrieveMsg := msg.(*msgstream.RetrieveMsg)
+	result, err := q.doRetrieve(retrieveMsg)
+	if err != nil {
+		return err
 	}
 
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
-	deleteSearchResults(searchResults)
-	deleteMarshaledHits(marshaledHits)
-	sp.LogFields(oplog.String("statistical time", "stats done"))
-	plan.delete()
-	searchReq.delete()
-	tr.Elapse(fmt.Sprintf("all done, msgID = %d", searchMsg.ID()))
+	resultChannelInt := 0
+	retrieveResultMsg := &msgstream.RetrieveResultMsg{
+		BaseMsg:         msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		RetrieveResults: *result,
+	}
+	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
+	if err != nil {
+		return err
+	}
+	log.Debug("QueryNode publish RetrieveResultMsg",
+		zap.Int64("msgID", retrieveMsg.ID()),
+		zap.Any("vChannels", result.ChannelIDsRetrieved),
+		zap.Any("collectionID", retrieveMsg.CollectionID),
+		zap.Any("sealedSegmentRetrieved", result.SealedSegmentIDsRetrieved),
+	)
 	return nil
 }
 
-func (q *queryCollection) retrieve(msg queryMsg) error {
+// getVectorChunkManager creates the vector chunk manager on first use, it's shared by all the retrieve requests
+func (q *queryCollection) getVectorChunkManager(collection *Collection) (storage.ChunkManager, error) {
+	q.vectorChunkManagerMu.Lock()
+	defer q.vectorChunkManagerMu.Unlock()
+
+	if q.vectorChunkManager == nil {
+		if q.localChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
+		}
+		if q.remoteChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil")
+		}
+		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
+			&etcdpb.CollectionMeta{
+				ID:     collection.id,
+				Schema: collection.schema,
+			}, q.localCacheEnabled)
+	}
+	return q.vectorChunkManager, nil
+}
+
+// doRetrieve retrieves the entities from the sealed segments and the growing segments of the collection on this query node
+func (q *queryCollection) doRetrieve(retrieveMsg *msgstream.RetrieveMsg) (*internalpb.RetrieveResults, error) {
 	// TODO(yukun)
 	// step 1: get retrieve object and defer destruction
 	// step 2: for each segment, call retrieve to get ids proto buffer
 	// step 3: merge all proto in go
 	// step 4: publish results
 	// retrieveProtoBlob, err := proto.Marshal(&retrieveMsg.RetrieveRequest)
-	retrieveMsg := msg.(*msgstream.RetrieveMsg)
 	sp, ctx := trace.StartSpanFromContext(retrieveMsg.TraceCtx())
 	defer sp.Finish()
 	retrieveMsg.SetTraceCtx(ctx)
@@ -1229,13 +1418,13 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	collectionID := retrieveMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	expr := retrieveMsg.SerializedExprPlan
 	plan, err := createRetrievePlanByExpr(collection, expr, timestamp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer plan.delete()
 
@@ -1250,83 +1439,65 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	var mergeList []*segcorepb.RetrieveResults
 
-	if q.vectorChunkManager == nil {
-		if q.localChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for local chunk manager is nil, msgID = %d", retrieveMsg.ID())
-		}
-		if q.remoteChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil, msgID = %d", retrieveMsg.ID())
-		}
-		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
-			&etcdpb.CollectionMeta{
-				ID:     collection.id,
-				Schema: collection.schema,
-			}, q.localCacheEnabled)
+	vectorChunkManager, err := q.getVectorChunkManager(collection)
+	if err != nil {
+		return nil, fmt.Errorf("%s, msgID = %d", err.Error(), retrieveMsg.ID())
 	}
 
 	// historical retrieve
 	log.Debug("historical retrieve start", zap.Int64("msgID", retrieveMsg.ID()))
-	hisRetrieveResults, sealedSegmentRetrieved, sealedPartitionRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
+	hisRetrieveResults, sealedSegmentRetrieved, sealedPartitionRetrieved, err := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, vectorChunkManager, plan, newSegmentPruner(expr))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mergeList = append(mergeList, hisRetrieveResults...)
 	log.Debug("historical retrieve", zap.Int64("msgID", retrieveMsg.ID()), zap.Int64("collectionID", collectionID), zap.Int64s("retrieve partitionIDs", sealedPartitionRetrieved), zap.Int64s("retrieve segmentIDs", sealedSegmentRetrieved))
-	tr.Record(fmt.Sprintf("historical retrieve done, msgID = %d", retrieveMsg.ID()))
+	metrics.QueryNodeSQSegmentLatency.WithLabelValues(metrics.QueryLabel,
+		metrics.SealedSegmentLabel).Observe(float64(tr.Record(fmt.Sprintf("historical retrieve done, msgID = %d", retrieveMsg.ID())).Milliseconds()))
 
 	// streaming retrieve
 	log.Debug("streaming retrieve start", zap.Int64("msgID", retrieveMsg.ID()))
 	strRetrieveResults, streamingSegmentRetrived, streamingPartitionRetrived, err := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mergeList = append(mergeList, strRetrieveResults...)
 	log.Debug("streaming retrieve", zap.Int64("msgID", retrieveMsg.ID()), zap.Int64("collectionID", collectionID), zap.Int64s("retrieve partitionIDs", streamingPartitionRetrived), zap.Int64s("retrieve segmentIDs", streamingSegmentRetrived))
-	tr.Record(fmt.Sprintf("streaming retrieve done, msgID = %d", retrieveMsg.ID()))
+	metrics.QueryNodeSQSegmentLatency.WithLabelValues(metrics.QueryLabel,
+		metrics.GrowingSegmentLabel).Observe(float64(tr.Record(fmt.Sprintf("streaming retrieve done, msgID = %d", retrieveMsg.ID())).Milliseconds()))
 
-	result, err := mergeRetrieveResults(mergeList)
+	result, err := mergeRetrieveResults(mergeList, retrieveMsg.Limit)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	tr.Record(fmt.Sprintf("merge result done, msgID = %d", retrieveMsg.ID()))
+	tr.Elapse(fmt.Sprintf("merge result done, msgID = %d", retrieveMsg.ID()))
 
-	resultChannelInt := 0
-	retrieveResultMsg := &msgstream.RetrieveResultMsg{
-		BaseMsg: msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-		RetrieveResults: internalpb.RetrieveResults{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_RetrieveResult,
-				MsgID:    retrieveMsg.Base.MsgID,
-				SourceID: retrieveMsg.Base.SourceID,
-			},
-			Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-			Ids:                       result.Ids,
-			FieldsData:                result.FieldsData,
-			ResultChannelID:           retrieveMsg.ResultChannelID,
-			SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
-			ChannelIDsRetrieved:       collection.getVChannels(),
-			GlobalSealedSegmentIDs:    globalSealedSegments,
+	return &internalpb.RetrieveResults{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RetrieveResult,
+			MsgID:    retrieveMsg.Base.MsgID,
+			SourceID: retrieveMsg.Base.SourceID,
 		},
-	}
+		Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:                       result.Ids,
+		FieldsData:                result.FieldsData,
+		ResultChannelID:           retrieveMsg.ResultChannelID,
+		SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
+		ChannelIDsRetrieved:       collection.getVChannels(),
+		GlobalSealedSegmentIDs:    globalSealedSegments,
+	}, nil
+}
 
-	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
-	if err != nil {
-		return err
+// mergeRetrieveResults merges the retrieve results of segments and removes duplicates.
+// If limit is positive, only the limit entities with the smallest primary keys are kept in ascending order.
+func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+	if limit > 0 {
+		return mergeRetrieveResultsWithLimit(retrieveResults, limit)
 	}
-	log.Debug("QueryNode publish RetrieveResultMsg",
-		zap.Int64("msgID", retrieveMsg.ID()),
-		zap.Any("vChannels", collection.getVChannels()),
-		zap.Any("collectionID", collection.ID()),
-		zap.Any("sealedSegmentRetrieved", sealedSegmentRetrieved),
-	)
-	tr.Elapse(fmt.Sprintf("all done, msgID = %d", retrieveMsg.ID()))
-	return nil
-}
 
-func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
 	var ret *segcorepb.RetrieveResults
 	var skipDupCnt int64
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
@@ -1337,13 +1508,7 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 
 		if ret == nil {
 			ret = &segcorepb.RetrieveResults{
-				Ids: &schemapb.IDs{
-					IdField: &schemapb.IDs_IntId{
-						IntId: &schemapb.LongArray{
-							Data: []int64{},
-						},
-					},
-				},
+				Ids:        &schemapb.IDs{},
 				FieldsData: make([]*schemapb.FieldData, len(rr.FieldsData)),
 			}
 		}
@@ -1352,12 +1517,13 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 			return nil, fmt.Errorf("mismatch FieldData in RetrieveResults")
 		}
 
-		dstIds := ret.Ids.GetIntId()
-		for i, id := range rr.Ids.GetIntId().GetData() {
-			if _, ok := idSet[id]; !ok {
-				dstIds.Data = append(dstIds.Data, id)
+		numPks := typeutil.GetSizeOfIDs(rr.Ids)
+		for i := 0; i < numPks; i++ {
+			pk := typeutil.GetPK(rr.Ids, int64(i))
+			if _, ok := idSet[pk]; !ok {
+				typeutil.AppendPKs(ret.Ids, pk)
 				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
-				idSet[id] = struct{}{}
+				idSet[pk] = struct{}{}
 			} else {
 				// primary keys duplicate
 				skipDupCnt++
@@ -1377,6 +1543,67 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 	return ret, nil
 }
 
+func mergeRetrieveResultsWithLimit(retrieveResults []*segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+	type entity struct {
+		pk        interface{}
+		resultIdx int
+		offset    int64
+	}
+	var entities []entity
+	var skipDupCnt int64
+	var idSet = make(map[interface{}]struct{})
+	numFields := -1
+	for i, rr := range retrieveResults {
+		// skip empty result, it will break merge result
+		if rr == nil || len(rr.Offset) == 0 {
+			continue
+		}
+		if numFields == -1 {
+			numFields = len(rr.FieldsData)
+		} else if numFields != len(rr.FieldsData) {
+			return nil, fmt.Errorf("mismatch FieldData in RetrieveResults")
+		}
+		numPks := typeutil.GetSizeOfIDs(rr.Ids)
+		for j := 0; j < numPks; j++ {
+			pk := typeutil.GetPK(rr.Ids, int64(j))
+			if _, ok := idSet[pk]; ok {
+				// primary keys duplicate
+				skipDupCnt++
+				continue
+			}
+			idSet[pk] = struct{}{}
+			entities = append(entities, entity{pk: pk, resultIdx: i, offset: int64(j)})
+		}
+	}
+	log.Debug("skip duplicated query result", zap.Int64("count", skipDupCnt))
+
+	// not found, return default values indicating not result found
+	if numFields == -1 {
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{},
+			FieldsData: []*schemapb.FieldData{},
+		}, nil
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return typeutil.ComparePK(entities[i].pk, entities[j].pk)
+	})
+	if int64(len(entities)) > limit {
+		entities = entities[:limit]
+	}
+
+	ids := &schemapb.IDs{}
+	fieldsData := make([]*schemapb.FieldData, numFields)
+	for _, e := range entities {
+		typeutil.AppendPKs(ids, e.pk)
+		typeutil.AppendFieldData(fieldsData, retrieveResults[e.resultIdx].FieldsData, e.offset)
+	}
+	return &segcorepb.RetrieveResults{
+		Ids:        ids,
+		FieldsData: fieldsData,
+	}, nil
+}
+
 func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID UniqueID) error {
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	defer span.Finish()
@@ -467,6 +467,108 @@ func TestQueryCollection_TranslateHits(t *testing.T) {
 		_, err = translateHits(genSchema(dataType), fieldIDs, genRawHits(dataType))
 		assert.Error(t, err)
 	})
+
+	t.Run("test string primary key and string field", func(t *testing.T) {
+		pkFieldID := FieldID(100)
+		schema := &schemapb.CollectionSchema{
+			Name:   defaultCollectionName,
+			AutoID: false,
+			Fields: []*schemapb.FieldSchema{
+				genPKField(constFieldParam{
+					id:       pkFieldID,
+					dataType: schemapb.DataType_String,
+				}),
+				genConstantField(constFieldParam{
+					id:       fieldID,
+					dataType: schemapb.DataType_String,
+				}),
+			},
+		}
+		schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+		assert.NoError(t, err)
+
+		// a row holds the string primary key followed by the string field
+		genRow := func(strs ...string) []byte {
+			var buf bytes.Buffer
+			for _, str := range strs {
+				err := binary.Write(&buf, common.Endian, uint32(len(str)))
+				assert.NoError(t, err)
+				buf.WriteString(str)
+			}
+			return buf.Bytes()
+		}
+		hits := []*milvuspb.Hits{
+			{
+				Scores:  []float32{0.1, 0.2},
+				RowData: [][]byte{genRow("a", "x"), genRow("bbb", "")},
+			},
+			{
+				Scores:  []float32{0.3, 0.4},
+				RowData: [][]byte{genRow("cc", "yy"), genRow("", "zzz")},
+			},
+		}
+		rawHits := make([][]byte, 0)
+		for _, h := range hits {
+			rawHit, err := proto.Marshal(h)
+			assert.NoError(t, err)
+			rawHits = append(rawHits, rawHit)
+		}
+
+		res, err := translateHits(schemaHelper, fieldIDs, rawHits)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res.NumQueries)
+		assert.Equal(t, int64(2), res.TopK)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, res.Scores)
+		assert.Equal(t, []string{"a", "bbb", "cc", ""}, res.Ids.GetStrId().GetData())
+		assert.Equal(t, 1, len(res.FieldsData))
+		assert.Equal(t, []string{"x", "", "yy", "zzz"}, res.FieldsData[0].GetScalars().GetStringData().GetData())
+
+		// a row missing the bytes of the string field
+		rawHit, err := proto.Marshal(&milvuspb.Hits{
+			Scores:  []float32{0.1},
+			RowData: [][]byte{genRow("a", "x")[:7]},
+		})
+		assert.NoError(t, err)
+		_, err = translateHits(schemaHelper, fieldIDs, [][]byte{rawHit})
+		assert.Error(t, err)
+	})
+
+	t.Run("test json field", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Name:   defaultCollectionName,
+			AutoID: true,
+			Fields: []*schemapb.FieldSchema{
+				genConstantField(constFieldParam{
+					id:       fieldID,
+					dataType: schemapb.DataType_JSON,
+				}),
+			},
+		}
+		schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+		assert.NoError(t, err)
+
+		// a row holds the int64 primary key followed by the json document, which is encoded the same way as a string
+		genRow := func(pk int64, doc string) []byte {
+			var buf bytes.Buffer
+			err := binary.Write(&buf, common.Endian, pk)
+			assert.NoError(t, err)
+			err = binary.Write(&buf, common.Endian, uint32(len(doc)))
+			assert.NoError(t, err)
+			buf.WriteString(doc)
+			return buf.Bytes()
+		}
+		rawHit, err := proto.Marshal(&milvuspb.Hits{
+			IDs:     []int64{1, 2},
+			Scores:  []float32{0.1, 0.2},
+			RowData: [][]byte{genRow(1, `{"a":1}`), genRow(2, `{"b":"x"}`)},
+		})
+		assert.NoError(t, err)
+
+		res, err := translateHits(schemaHelper, fieldIDs, [][]byte{rawHit})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.FieldsData))
+		assert.Equal(t, [][]byte{[]byte(`{"a":1}`), []byte(`{"b":"x"}`)}, res.FieldsData[0].GetScalars().GetBytesData().GetData())
+	})
 }
 
 func TestQueryCollection_serviceableTime(t *testing.T) {
@@ -558,13 +660,88 @@ func TestQueryCollection_mergeRetrieveResults(t *testing.T) {
 		FieldsData: fieldDataArray2,
 	}
 
-	result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2})
+	result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2}, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(result.FieldsData[0].GetScalars().GetLongData().Data))
 	assert.Equal(t, 2*Dim, len(result.FieldsData[1].GetVectors().GetFloatVector().Data))
 
-	_, err = mergeRetrieveResults(nil)
+	_, err = mergeRetrieveResults(nil, 0)
 	assert.NoError(t, err)
+
+	t.Run("with limit", func(t *testing.T) {
+		result3 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{5, 1},
+					},
+				},
+			},
+			Offset: []int64{0, 1},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{55, 11}, 1),
+			},
+		}
+		result4 := &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{3, 1, 2},
+					},
+				},
+			},
+			Offset: []int64{0, 1, 2},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{33, 11, 22}, 1),
+			},
+		}
+
+		result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result3, result4}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, result.Ids.GetIntId().GetData())
+		assert.Equal(t, []int64{11, 22, 33}, result.FieldsData[0].GetScalars().GetLongData().Data)
+
+		result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result3, result4}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 5}, result.Ids.GetIntId().GetData())
+
+		result, err = mergeRetrieveResults(nil, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(result.FieldsData))
+
+		_, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result3}, 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("string ids", func(t *testing.T) {
+		genResult := func(ids []string, values []int64) *segcorepb.RetrieveResults {
+			return &segcorepb.RetrieveResults{
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_StrId{
+						StrId: &schemapb.StringArray{
+							Data: ids,
+						},
+					},
+				},
+				Offset: make([]int64, len(ids)),
+				FieldsData: []*schemapb.FieldData{
+					genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, values, 1),
+				},
+			}
+		}
+		result5 := genResult([]string{"e", "a"}, []int64{55, 11})
+		result6 := genResult([]string{"c", "a", "b"}, []int64{33, 11, 22})
+
+		result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result5, result6}, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"e", "a", "c", "b"}, result.Ids.GetStrId().GetData())
+		assert.Equal(t, []int64{55, 11, 33, 22}, result.FieldsData[0].GetScalars().GetLongData().Data)
+
+		result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result5, result6}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, result.Ids.GetStrId().GetData())
+		assert.Equal(t, []int64{11, 22, 33}, result.FieldsData[0].GetScalars().GetLongData().Data)
+	})
 }
 
 func TestQueryCollection_doUnsolvedQueryMsg(t *testing.T) {
@@ -43,18 +43,17 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
-	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -112,8 +111,7 @@ type QueryNode struct {
 
 	session *sessionutil.Session
 
-	minioKV kv.BaseKV // minio minioKV
-	etcdKV  *etcdkv.EtcdKV
+	etcdKV kv.MetaKv
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -133,7 +131,7 @@ func NewQueryNode(ctx context.Context, factory msgstream.Factory) *QueryNode {
 }
 
 func (node *QueryNode) initSession() error {
-	node.session = sessionutil.NewSession(node.queryNodeLoopCtx, Params.BaseParams.MetaRootPath, node.etcdCli)
+	node.session = metastore.NewSession(node.queryNodeLoopCtx, node.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if node.session == nil {
 		return fmt.Errorf("session is nil, the etcd client connection may have failed")
 	}
@@ -194,7 +192,12 @@ func (node *QueryNode) Init() error {
 		}
 		Params.QueryNodeCfg.Refresh()
 
-		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.BaseParams.MetaRootPath)
+		node.etcdKV, err = metastore.NewMetaKV(node.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
+		if err != nil {
+			log.Error("QueryNode failed to create meta kv", zap.Error(err))
+			initError = err
+			return
+		}
 		log.Debug("queryNode try to connect etcd success", zap.Any("MetaRootPath", Params.BaseParams.MetaRootPath))
 		node.tSafeReplica = newTSafeReplica()
 
@@ -347,7 +350,7 @@ func (node *QueryNode) watchChangeInfo() {
 		case resp := <-watchChan:
 			for _, event := range resp.Events {
 				switch event.Type {
-				case mvccpb.PUT:
+				case kv.EventTypePut:
 					infoID, err := strconv.ParseInt(filepath.Base(string(event.Kv.Key)), 10, 64)
 					if err != nil {
 						log.Warn("Parse SealedSegmentsChangeInfo id failed", zap.Any("error", err.Error()))
@@ -26,7 +26,6 @@ import (
 
 	"go.uber.org/zap"
 
-	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -66,20 +65,10 @@ func newQueryService(ctx context.Context,
 
 	localChunkManager := storage.NewLocalChunkManager(path)
 
-	option := &miniokv.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	client, err := miniokv.NewMinIOKV(ctx, option)
+	remoteChunkManager, err := storage.NewChunkManager(ctx, &Params)
 	if err != nil {
 		panic(err)
 	}
-	remoteChunkManager := storage.NewMinioChunkManager(client)
 
 	return &queryService{
 		ctx:    queryServiceCtx,
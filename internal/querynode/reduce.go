@@ -28,6 +28,11 @@ import "C"
 import (
 	"errors"
 	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // SearchResult contains a pointer to the search result in C++ memory
@@ -64,6 +69,100 @@ func reduceSearchResultsAndFillData(plan *SearchPlan, searchResults []*SearchRes
 	return nil
 }
 
+// filterRangeSearchResults keeps at most topK hits within range for every query of the hits reduced from all segments.
+// The kept hits are packed query by query, and the number of hits of each query is put into Topks.
+// It also returns whether the candidates are exhausted, i.e. every query either has topK hits within range,
+// or has fewer hits than data.TopK, or reaches a hit beyond radius. Otherwise the caller should search with a larger topk.
+func filterRangeSearchResults(data *schemapb.SearchResultData, topK int64, metricType string, params *planpb.RangeSearchParams) (*schemapb.SearchResultData, bool) {
+	ret := &schemapb.SearchResultData{
+		NumQueries: data.NumQueries,
+		TopK:       topK,
+		FieldsData: make([]*schemapb.FieldData, len(data.FieldsData)),
+		Scores:     make([]float32, 0),
+		Ids:        &schemapb.IDs{},
+		Topks:      make([]int64, 0, data.NumQueries),
+	}
+	switch data.GetIds().GetIdField().(type) {
+	case *schemapb.IDs_StrId:
+		ret.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	default:
+		ret.Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	}
+	exhausted := true
+	idsLen := int64(typeutil.GetSizeOfIDs(data.Ids))
+	for qi := int64(0); qi < data.NumQueries; qi++ {
+		var realTopK int64
+		queryExhausted := false
+		for k := int64(0); k < data.TopK; k++ {
+			idx := qi*data.TopK + k
+			if idx >= idsLen {
+				queryExhausted = true
+				break
+			}
+			pk := typeutil.GetPK(data.Ids, idx)
+			if pk == int64(-1) || pk == "" {
+				queryExhausted = true
+				break
+			}
+			if beyondRadius(data.Scores[idx], metricType, params) {
+				// the hits are sorted, the rest of them are beyond radius too
+				queryExhausted = true
+				break
+			}
+			if !inRange(data.Scores[idx], metricType, params) {
+				continue
+			}
+			typeutil.AppendFieldData(ret.FieldsData, data.FieldsData, idx)
+			typeutil.AppendPKs(ret.Ids, pk)
+			ret.Scores = append(ret.Scores, data.Scores[idx])
+			realTopK++
+			if realTopK >= topK {
+				queryExhausted = true
+				break
+			}
+		}
+		exhausted = exhausted && queryExhausted
+		ret.Topks = append(ret.Topks, realTopK)
+	}
+	// keep the output fields even if no hit is within range
+	for i, fieldData := range ret.FieldsData {
+		if fieldData == nil {
+			ret.FieldsData[i] = &schemapb.FieldData{
+				Type:      data.FieldsData[i].Type,
+				FieldName: data.FieldsData[i].FieldName,
+				FieldId:   data.FieldsData[i].FieldId,
+			}
+		}
+	}
+	return ret, exhausted
+}
+
+// beyondRadius returns whether the score of a hit is not closer than radius.
+func beyondRadius(score float32, metricType string, params *planpb.RangeSearchParams) bool {
+	if distance.PositivelyRelated(metricType) {
+		return score <= params.Radius
+	}
+	return -score >= params.Radius
+}
+
+// inRange returns whether the score of a hit is within the range of params,
+// the scores of metrics other than IP are negative distances.
+func inRange(score float32, metricType string, params *planpb.RangeSearchParams) bool {
+	if distance.PositivelyRelated(metricType) {
+		return score > params.Radius && (!params.HasRangeFilter || score <= params.RangeFilter)
+	}
+	dis := -score
+	return dis < params.Radius && (!params.HasRangeFilter || dis >= params.RangeFilter)
+}
+
 func reorganizeSearchResults(searchResults []*SearchResult, numSegments int64) (*MarshaledHits, error) {
 	cSearchResults := make([]C.CSearchResult, 0)
 	for _, res := range searchResults {
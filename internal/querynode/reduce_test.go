@@ -26,6 +26,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestReduce_AllFunc(t *testing.T) {
@@ -117,3 +119,97 @@ func TestReduce_nilPlan(t *testing.T) {
 	err := reduceSearchResultsAndFillData(plan, nil, 1)
 	assert.Error(t, err)
 }
+
+func TestReduce_filterRangeSearchResults(t *testing.T) {
+	genData := func(scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{1, 2, -1, 4, 5, 6},
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "int64",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{
+									Data: []int64{10, 20, 0, 40, 50, 60},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("L2", func(t *testing.T) {
+		// scores of L2 are negative distances
+		data := genData([]float32{-1, -2, 0, -0.5, -3, -5})
+		params := &planpb.RangeSearchParams{Radius: 4, RangeFilter: 1, HasRangeFilter: true}
+		ret, exhausted := filterRangeSearchResults(data, 3, "L2", params)
+		assert.True(t, exhausted)
+		assert.Equal(t, int64(2), ret.NumQueries)
+		assert.Equal(t, int64(3), ret.TopK)
+		assert.Equal(t, []int64{2, 1}, ret.Topks)
+		assert.Equal(t, []int64{1, 2, 5}, ret.Ids.GetIntId().GetData())
+		assert.Equal(t, []float32{-1, -2, -3}, ret.Scores)
+		assert.Equal(t, []int64{10, 20, 50}, ret.FieldsData[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		data := genData([]float32{0.9, 0.5, 0, 0.99, 0.7, 0.1})
+		params := &planpb.RangeSearchParams{Radius: 0.6}
+		ret, exhausted := filterRangeSearchResults(data, 3, "IP", params)
+		assert.True(t, exhausted)
+		assert.Equal(t, []int64{1, 2}, ret.Topks)
+		assert.Equal(t, []int64{1, 4, 5}, ret.Ids.GetIntId().GetData())
+	})
+
+	t.Run("no hit in range", func(t *testing.T) {
+		data := genData([]float32{0.9, 0.5, 0, 0.99, 0.7, 0.1})
+		params := &planpb.RangeSearchParams{Radius: 0.999}
+		ret, exhausted := filterRangeSearchResults(data, 3, "IP", params)
+		assert.True(t, exhausted)
+		assert.Equal(t, []int64{0, 0}, ret.Topks)
+		assert.Equal(t, 0, len(ret.Ids.GetIntId().GetData()))
+		assert.Equal(t, 1, len(ret.FieldsData))
+		assert.Equal(t, "int64", ret.FieldsData[0].FieldName)
+		_, err := proto.Marshal(ret)
+		assert.NoError(t, err)
+	})
+	t.Run("string ids", func(t *testing.T) {
+		data := &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Scores:     []float32{-0.5, -1.5, -2},
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: []string{"a", "b", "c"},
+					},
+				},
+			},
+		}
+		params := &planpb.RangeSearchParams{Radius: 4, RangeFilter: 1, HasRangeFilter: true}
+		// all the hits are within radius, the hits after them could be within range too
+		ret, exhausted := filterRangeSearchResults(data, 3, "L2", params)
+		assert.False(t, exhausted)
+		assert.Equal(t, []int64{2}, ret.Topks)
+		assert.Equal(t, []string{"b", "c"}, ret.Ids.GetStrId().GetData())
+
+		ret, exhausted = filterRangeSearchResults(data, 2, "L2", params)
+		assert.True(t, exhausted)
+		assert.Equal(t, int64(2), ret.TopK)
+		assert.Equal(t, []string{"b", "c"}, ret.Ids.GetStrId().GetData())
+	})
+}
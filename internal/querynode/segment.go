@@ -107,6 +107,12 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	clusteringKeyRange *datapb.FieldRange // range of the clustering key, nil if the segment isn't clustered
+
+	statsMu     sync.RWMutex // guards fieldStats and fieldRanges
+	fieldStats  map[FieldID]*storage.FieldStats
+	fieldRanges map[FieldID]*datapb.FieldRange // ranges of the numeric fields by fieldStats
 }
 
 // ID returns the identity number.
@@ -158,6 +164,49 @@ func (s *Segment) getType() segmentType {
 	return s.segmentType
 }
 
+// getFieldRange returns the range of the values of a field in the segment, nil if it's unknown
+func (s *Segment) getFieldRange(fieldID FieldID) *datapb.FieldRange {
+	if s.clusteringKeyRange != nil && s.clusteringKeyRange.GetFieldID() == fieldID {
+		return s.clusteringKeyRange
+	}
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+	return s.fieldRanges[fieldID]
+}
+
+// setFieldStats sets the stats of the numeric fields, each of which covers all the rows of the segment
+func (s *Segment) setFieldStats(fieldStats map[FieldID]*storage.FieldStats) {
+	fieldRanges := make(map[FieldID]*datapb.FieldRange, len(fieldStats))
+	for fieldID, stats := range fieldStats {
+		if min, max, ok := stats.IntRange(); ok && typeutil.IsIntegerType(stats.Type) {
+			fieldRanges[fieldID] = &datapb.FieldRange{
+				FieldID: fieldID,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: min}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: max}},
+			}
+		}
+		if min, max, ok := stats.FloatRange(); ok && typeutil.IsFloatingType(stats.Type) {
+			fieldRanges[fieldID] = &datapb.FieldRange{
+				FieldID: fieldID,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: min}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: max}},
+			}
+		}
+	}
+
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	s.fieldStats = fieldStats
+	s.fieldRanges = fieldRanges
+}
+
+// getFieldStats returns the stats of a numeric field, nil if there is none
+func (s *Segment) getFieldStats(fieldID FieldID) *storage.FieldStats {
+	s.statsMu.RLock()
+	defer s.statsMu.RUnlock()
+	return s.fieldStats[fieldID]
+}
+
 func (s *Segment) getOnService() bool {
 	return s.onService
 }
@@ -551,11 +600,56 @@ func (s *Segment) checkIndexReady(fieldID int64) bool {
 	return s.indexInfos[fieldID].getReadyLoad()
 }
 
-func (s *Segment) updateBloomFilter(pks []int64) {
-	buf := make([]byte, 8)
+func (s *Segment) updateBloomFilter(pks []storage.PrimaryKey) {
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		s.pkFilter.Add(buf)
+		storage.AddPKToBloomFilter(s.pkFilter, pk)
+	}
+}
+
+// encodeStrings encodes each string as its uint32 length followed by its bytes, which is how
+// segcore reads strings from inserted rows, loaded fields and primary keys
+func encodeStrings(strs []string) ([]byte, error) {
+	var buffer bytes.Buffer
+	for _, str := range strs {
+		if err := binary.Write(&buffer, common.Endian, uint32(len(str))); err != nil {
+			return nil, err
+		}
+		buffer.WriteString(str)
+	}
+	return buffer.Bytes(), nil
+}
+
+// encodePrimaryKeys encodes pks into the blob segcore reads primary keys from, int64 primary keys
+// are laid out as an int64 array and string primary keys are encoded by encodeStrings
+func encodePrimaryKeys(pks []storage.PrimaryKey) ([]byte, error) {
+	if len(pks) == 0 {
+		return nil, errors.New("empty primary keys")
+	}
+	switch pks[0].Type() {
+	case schemapb.DataType_Int64:
+		var buffer bytes.Buffer
+		for _, pk := range pks {
+			v, ok := pk.(*storage.Int64PrimaryKey)
+			if !ok {
+				return nil, fmt.Errorf("mixed primary key types %s and %s", schemapb.DataType_Int64.String(), pk.Type().String())
+			}
+			if err := binary.Write(&buffer, common.Endian, v.Value); err != nil {
+				return nil, err
+			}
+		}
+		return buffer.Bytes(), nil
+	case schemapb.DataType_String:
+		strs := make([]string, 0, len(pks))
+		for _, pk := range pks {
+			v, ok := pk.(*storage.StringPrimaryKey)
+			if !ok {
+				return nil, fmt.Errorf("mixed primary key types %s and %s", pks[0].Type().String(), pk.Type().String())
+			}
+			strs = append(strs, v.Value)
+		}
+		return encodeStrings(strs)
+	default:
+		return nil, fmt.Errorf("unsupported primary key type %s", pks[0].Type().String())
 	}
 }
 
@@ -623,11 +717,20 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("entityIDs row num not equal to length of records")
 	}
 
-	var rawData = make([]byte, numOfRow*sizeofPerRow)
+	// rows with string fields are variable-length, segcore walks them by the schema,
+	// so they are concatenated without padding and sizeofPerRow is -1 when the lengths differ
+	var rawDataLen = 0
+	for i := 0; i < len(*records); i++ {
+		if len((*records)[i].Value) != sizeofPerRow {
+			sizeofPerRow = -1
+		}
+		rawDataLen += len((*records)[i].Value)
+	}
+	var rawData = make([]byte, rawDataLen)
 	var copyOffset = 0
 	for i := 0; i < len(*records); i++ {
 		copy(rawData[copyOffset:], (*records)[i].Value)
-		copyOffset += sizeofPerRow
+		copyOffset += len((*records)[i].Value)
 	}
 
 	var cOffset = C.long(offset)
@@ -653,13 +756,13 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 	return nil
 }
 
-func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps *[]Timestamp) error {
+func (s *Segment) segmentDelete(offset int64, primaryKeys []storage.PrimaryKey, timestamps []Timestamp) error {
 	/*
 		CStatus
 		Delete(CSegmentInterface c_segment,
 		           long int reserved_offset,
 		           long size,
-		           const long* primary_keys,
+		           const void* primary_keys,
 		           const unsigned long* timestamps);
 	*/
 	s.segPtrMu.RLock()
@@ -668,16 +771,21 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("null seg core pointer")
 	}
 
-	if len(*entityIDs) != len(*timestamps) {
-		return errors.New("length of entityIDs not equal to length of timestamps")
+	if len(primaryKeys) != len(timestamps) {
+		return errors.New("length of primaryKeys not equal to length of timestamps")
+	}
+
+	pkBlob, err := encodePrimaryKeys(primaryKeys)
+	if err != nil {
+		return err
 	}
 
 	var cOffset = C.long(offset)
-	var cSize = C.long(len(*entityIDs))
-	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
-	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
+	var cSize = C.long(len(primaryKeys))
+	var cPrimaryKeysPtr = unsafe.Pointer(&pkBlob[0])
+	var cTimestampsPtr = (*C.ulong)(&timestamps[0])
 
-	status := C.Delete(s.segmentPtr, cOffset, cSize, cEntityIdsPtr, cTimestampsPtr)
+	status := C.Delete(s.segmentPtr, cOffset, cSize, cPrimaryKeysPtr, cTimestampsPtr)
 	if err := HandleCStatus(&status, "Delete failed"); err != nil {
 		return err
 	}
@@ -746,8 +854,28 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 		}
 		dataPointer = unsafe.Pointer(&d[0])
 	case []string:
-		// TODO: support string type
-		return errors.New("we cannot support string type now")
+		if len(d) <= 0 {
+			return emptyErr
+		}
+		blob, err := encodeStrings(d)
+		if err != nil {
+			return err
+		}
+		dataPointer = unsafe.Pointer(&blob[0])
+	case [][]byte:
+		if len(d) <= 0 {
+			return emptyErr
+		}
+		// json documents are encoded the same way as strings
+		docs := make([]string, 0, len(d))
+		for _, doc := range d {
+			docs = append(docs, string(doc))
+		}
+		blob, err := encodeStrings(docs)
+		if err != nil {
+			return err
+		}
+		dataPointer = unsafe.Pointer(&blob[0])
 	default:
 		return errors.New("illegal field data type")
 	}
@@ -778,7 +906,7 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	return nil
 }
 
-func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestamps []Timestamp, rowCount int64) error {
+func (s *Segment) segmentLoadDeletedRecord(primaryKeys []storage.PrimaryKey, timestamps []Timestamp, rowCount int64) error {
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
 	if s.segmentPtr == nil {
@@ -788,9 +916,13 @@ func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestam
 		errMsg := fmt.Sprintln("segmentLoadFieldData failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
+	pkBlob, err := encodePrimaryKeys(primaryKeys)
+	if err != nil {
+		return err
+	}
 	loadInfo := C.CLoadDeletedRecordInfo{
 		timestamps:   unsafe.Pointer(&timestamps[0]),
-		primary_keys: unsafe.Pointer(&primaryKeys[0]),
+		primary_keys: unsafe.Pointer(&pkBlob[0]),
 		row_count:    C.int64_t(rowCount),
 	}
 	/*
@@ -29,12 +29,11 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
-	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
@@ -42,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const timeoutForEachRead = 10 * time.Second
@@ -53,8 +53,8 @@ type segmentLoader struct {
 
 	dataCoord types.DataCoord
 
-	minioKV kv.DataKV // minio minioKV
-	etcdKV  *etcdkv.EtcdKV
+	chunkManager storage.ChunkManager
+	etcdKV       kv.MetaKv
 
 	indexLoader *indexLoader
 
@@ -96,6 +96,7 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 			return err
 		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentType, true)
+		segment.clusteringKeyRange = info.GetClusteringKeyRange()
 		newSegments[segmentID] = segment
 		fieldBinlog, indexedFieldID, err := loader.getFieldAndIndexInfo(segment, info)
 		if err != nil {
@@ -195,6 +196,12 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 		}
 	}
 
+	log.Debug("loading field stats...")
+	err = loader.loadSegmentFieldStats(segment, segmentLoadInfo)
+	if err != nil {
+		return err
+	}
+
 	log.Debug("loading delta...")
 	err = loader.loadDeltaLogs(segment, segmentLoadInfo.Deltalogs)
 	if err != nil {
@@ -235,7 +242,15 @@ func (loader *segmentLoader) filterFieldBinlogs(fieldBinlogs []*datapb.FieldBinl
 }
 
 func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog, segmentType segmentType) error {
-	iCodec := storage.InsertCodec{}
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	// the codec fills default values of the fields added after the segment was written
+	iCodec := storage.NewInsertCodec(&etcdpb.CollectionMeta{
+		ID:     segment.collectionID,
+		Schema: collection.Schema(),
+	})
 	blobs := make([]*storage.Blob, 0)
 	for _, fb := range fieldBinlogs {
 		log.Debug("load segment fields data",
@@ -244,14 +259,14 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 			zap.String("paths", fmt.Sprintln(fb.Binlogs)),
 		)
 		for _, path := range fb.Binlogs {
-			binLog, err := loader.minioKV.Load(path.GetLogPath())
+			binLog, err := loader.chunkManager.Read(path.GetLogPath())
 			if err != nil {
 				// TODO: return or continue?
 				return err
 			}
 			blob := &storage.Blob{
 				Key:   path.GetLogPath(),
-				Value: []byte(binLog),
+				Value: binLog,
 			}
 			blobs = append(blobs, blob)
 		}
@@ -367,6 +382,9 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 		case *storage.StringFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
+		case *storage.JSONFieldData:
+			numRows = fieldData.NumRows
+			data = fieldData.Data
 		case *storage.FloatVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
@@ -398,13 +416,13 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 		return nil
 	}
 
-	values, err := loader.minioKV.MultiLoad(binlogPaths)
+	values, err := loader.chunkManager.MultiRead(binlogPaths)
 	if err != nil {
 		return err
 	}
 	blobs := make([]*storage.Blob, 0)
 	for i := 0; i < len(values); i++ {
-		blobs = append(blobs, &storage.Blob{Value: []byte(values[i])})
+		blobs = append(blobs, &storage.Blob{Value: values[i]})
 	}
 
 	stats, err := storage.DeserializeStats(blobs)
@@ -424,18 +442,80 @@ func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPath
 	return nil
 }
 
+// loadSegmentFieldStats loads the stats of the numeric fields other than the primary key, the stats of a field
+// are dropped unless they cover all the rows of the segment, e.g. some binlogs were written without stats
+func (loader *segmentLoader) loadSegmentFieldStats(segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo) error {
+	collection, err := loader.historicalReplica.getCollectionByID(segment.collectionID)
+	if err != nil {
+		return err
+	}
+	statsFieldIDs := make([]int64, 0)
+	for _, field := range collection.Schema().GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID && !field.GetIsPrimaryKey() &&
+			(typeutil.IsIntegerType(field.GetDataType()) || typeutil.IsFloatingType(field.GetDataType())) {
+			statsFieldIDs = append(statsFieldIDs, field.GetFieldID())
+		}
+	}
+
+	statsPaths := make([]string, 0)
+	for _, fieldBinlog := range segmentLoadInfo.GetStatslogs() {
+		if funcutil.SliceContain(statsFieldIDs, fieldBinlog.GetFieldID()) {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				statsPaths = append(statsPaths, binlog.GetLogPath())
+			}
+		}
+	}
+	if len(statsPaths) == 0 {
+		return nil
+	}
+
+	values, err := loader.chunkManager.MultiRead(statsPaths)
+	if err != nil {
+		return err
+	}
+	blobs := make([]*storage.Blob, 0, len(values))
+	for i := 0; i < len(values); i++ {
+		blobs = append(blobs, &storage.Blob{Value: values[i]})
+	}
+	stats, err := storage.DeserializeFieldStats(blobs)
+	if err != nil {
+		return err
+	}
+
+	fieldStats := make(map[FieldID]*storage.FieldStats)
+	invalid := make(map[FieldID]bool)
+	for _, stat := range stats {
+		merged, ok := fieldStats[stat.FieldID]
+		if !ok {
+			fieldStats[stat.FieldID] = stat
+			continue
+		}
+		if err := merged.Merge(stat); err != nil {
+			log.Warn("failed to merge field stats", zap.Int64("segmentID", segment.segmentID), zap.Error(err))
+			invalid[stat.FieldID] = true
+		}
+	}
+	for fieldID, stat := range fieldStats {
+		if invalid[fieldID] || stat.RowCount != segmentLoadInfo.GetNumOfRows() {
+			delete(fieldStats, fieldID)
+		}
+	}
+	segment.setFieldStats(fieldStats)
+	return nil
+}
+
 func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
 	dCodec := storage.DeleteCodec{}
 	var blobs []*storage.Blob
 	for _, deltaLog := range deltaLogs {
 		for _, log := range deltaLog.GetBinlogs() {
-			value, err := loader.minioKV.Load(log.GetLogPath())
+			value, err := loader.chunkManager.Read(log.GetLogPath())
 			if err != nil {
 				return err
 			}
 			blob := &storage.Blob{
 				Key:   log.GetLogPath(),
-				Value: []byte(value),
+				Value: value,
 			}
 			blobs = append(blobs, blob)
 		}
@@ -472,7 +552,7 @@ func (loader *segmentLoader) FromDmlCPLoadDelete(ctx context.Context, collection
 	}
 
 	delData := &deleteData{
-		deleteIDs:        make(map[UniqueID][]int64),
+		deleteIDs:        make(map[UniqueID][]storage.PrimaryKey),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
 		deleteOffset:     make(map[UniqueID]int64),
 	}
@@ -498,6 +578,7 @@ func (loader *segmentLoader) FromDmlCPLoadDelete(ctx context.Context, collection
 			}
 			log.Debug("delete pk",
 				zap.Any("pk", dmsg.PrimaryKeys),
+				zap.Strings("string pk", dmsg.StringPrimaryKeys),
 				zap.String("vChannelName", position.GetChannelName()),
 				zap.Any("msg id", position.GetMsgID()),
 			)
@@ -543,7 +624,7 @@ func deletePk(replica ReplicaInterface, deleteData *deleteData, segmentID Unique
 	timestamps := deleteData.deleteTimestamps[segmentID]
 	offset := deleteData.deleteOffset[segmentID]
 
-	err = targetSegment.segmentDelete(offset, &ids, &timestamps)
+	err = targetSegment.segmentDelete(offset, ids, timestamps)
 	if err != nil {
 		log.Warn("QueryNode: targetSegmentDelete failed", zap.Error(err))
 		return
@@ -605,9 +686,9 @@ func (loader *segmentLoader) estimateSegmentSize(segment *Segment,
 			zap.Any("paths", fb.Binlogs),
 		)
 		for _, binlogPath := range fb.Binlogs {
-			logSize, err := storage.EstimateMemorySize(loader.minioKV, binlogPath.GetLogPath())
+			logSize, err := storage.EstimateMemorySize(loader.chunkManager, binlogPath.GetLogPath())
 			if err != nil {
-				logSize, err = storage.GetBinlogSize(loader.minioKV, binlogPath.GetLogPath())
+				logSize, err = storage.GetBinlogSize(loader.chunkManager, binlogPath.GetLogPath())
 				if err != nil {
 					return 0, err
 				}
@@ -669,29 +750,20 @@ func newSegmentLoader(ctx context.Context,
 	indexCoord types.IndexCoord,
 	historicalReplica ReplicaInterface,
 	streamingReplica ReplicaInterface,
-	etcdKV *etcdkv.EtcdKV,
+	etcdKV kv.MetaKv,
 	factory msgstream.Factory) *segmentLoader {
-	option := &minioKV.Option{
-		Address:           Params.MinioCfg.Address,
-		AccessKeyID:       Params.MinioCfg.AccessKeyID,
-		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
-		UseSSL:            Params.MinioCfg.UseSSL,
-		BucketName:        Params.MinioCfg.BucketName,
-		CreateBucket:      true,
-	}
-
-	client, err := minioKV.NewMinIOKV(ctx, option)
+	cm, err := storage.NewChunkManager(ctx, &Params)
 	if err != nil {
 		panic(err)
 	}
 
-	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, historicalReplica)
+	iLoader := newIndexLoader(ctx, rootCoord, indexCoord, historicalReplica, cm)
 	return &segmentLoader{
 		historicalReplica: historicalReplica,
 		streamingReplica:  streamingReplica,
 
-		minioKV: client,
-		etcdKV:  etcdKV,
+		chunkManager: cm,
+		etcdKV:       etcdKV,
 
 		indexLoader: iLoader,
 
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 func TestSegmentLoader_loadSegment(t *testing.T) {
@@ -357,6 +358,59 @@ func TestSegmentLoader_estimateSegmentSize(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSegmentLoader_loadSegmentFieldStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+	loader := node.loader
+	assert.NotNil(t, loader)
+
+	seg, err := node.historical.replica.getSegmentByID(defaultSegmentID)
+	assert.NoError(t, err)
+
+	statsPaths := []string{"field-stats-test/1", "field-stats-test/2"}
+	for i, data := range [][]int32{{1, 5}, {3, 9}} {
+		sw := &storage.StatsWriter{}
+		err = sw.StatsField(simpleConstField.id, &storage.Int32FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		})
+		assert.NoError(t, err)
+		err = loader.chunkManager.Write(statsPaths[i], sw.GetBuffer())
+		assert.NoError(t, err)
+	}
+	loadInfo := &querypb.SegmentLoadInfo{
+		SegmentID: defaultSegmentID,
+		NumOfRows: 4,
+		Statslogs: []*datapb.FieldBinlog{
+			{
+				FieldID: simpleConstField.id,
+				Binlogs: []*datapb.Binlog{{LogPath: statsPaths[0]}, {LogPath: statsPaths[1]}},
+			},
+		},
+	}
+
+	err = loader.loadSegmentFieldStats(seg, loadInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), seg.getFieldStats(simpleConstField.id).RowCount)
+	fieldRange := seg.getFieldRange(simpleConstField.id)
+	assert.Equal(t, int64(1), fieldRange.GetMin().GetLongData())
+	assert.Equal(t, int64(9), fieldRange.GetMax().GetLongData())
+
+	// the stats don't cover all the rows
+	loadInfo.NumOfRows = 5
+	err = loader.loadSegmentFieldStats(seg, loadInfo)
+	assert.NoError(t, err)
+	assert.Nil(t, seg.getFieldStats(simpleConstField.id))
+	assert.Nil(t, seg.getFieldRange(simpleConstField.id))
+
+	loadInfo.Statslogs[0].Binlogs = []*datapb.Binlog{{LogPath: "field-stats-test/not-exist"}}
+	err = loader.loadSegmentFieldStats(seg, loadInfo)
+	assert.Error(t, err)
+}
+
 func TestSegmentLoader_testLoadGrowing(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
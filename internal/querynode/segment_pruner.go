@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// segmentPruner skips the sealed segments in which no entity can match the predicates of a plan,
+// by the ranges of the field values in the segments. It's conservative, the segments are kept
+// whenever the ranges are unknown or the predicates can't be evaluated on ranges.
+type segmentPruner struct {
+	predicates *planpb.Expr
+}
+
+// newSegmentPruner creates a segmentPruner from a serialized plan, nil is returned if the plan
+// can't be parsed or has no predicate, which keeps all the segments
+func newSegmentPruner(serializedExprPlan []byte) *segmentPruner {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedExprPlan, plan); err != nil {
+		return nil
+	}
+	var predicates *planpb.Expr
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		predicates = node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		predicates = node.Predicates
+	}
+	if predicates == nil {
+		return nil
+	}
+	return &segmentPruner{predicates: predicates}
+}
+
+// mayMatch returns false if no entity of seg can match the predicates
+func (p *segmentPruner) mayMatch(seg *Segment) bool {
+	if p == nil {
+		return true
+	}
+	return exprMayMatch(p.predicates, seg.getFieldRange)
+}
+
+// exprMayMatch returns false if no entity whose field values are in the ranges returned by getRange can match expr
+func exprMayMatch(expr *planpb.Expr, getRange func(fieldID FieldID) *datapb.FieldRange) bool {
+	columnRange := func(column *planpb.ColumnInfo) *datapb.FieldRange {
+		if len(column.GetNestedPath()) > 0 {
+			return nil
+		}
+		return getRange(column.GetFieldId())
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return exprMayMatch(e.BinaryExpr.GetLeft(), getRange) && exprMayMatch(e.BinaryExpr.GetRight(), getRange)
+		case planpb.BinaryExpr_LogicalOr:
+			return exprMayMatch(e.BinaryExpr.GetLeft(), getRange) || exprMayMatch(e.BinaryExpr.GetRight(), getRange)
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		if r := columnRange(e.UnaryRangeExpr.GetColumnInfo()); r != nil {
+			return unaryRangeMayMatch(e.UnaryRangeExpr.GetOp(), e.UnaryRangeExpr.GetValue(), r)
+		}
+	case *planpb.Expr_BinaryRangeExpr:
+		if r := columnRange(e.BinaryRangeExpr.GetColumnInfo()); r != nil {
+			lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+			if e.BinaryRangeExpr.GetLowerInclusive() {
+				lowerOp = planpb.OpType_GreaterEqual
+			}
+			if e.BinaryRangeExpr.GetUpperInclusive() {
+				upperOp = planpb.OpType_LessEqual
+			}
+			return unaryRangeMayMatch(lowerOp, e.BinaryRangeExpr.GetLowerValue(), r) &&
+				unaryRangeMayMatch(upperOp, e.BinaryRangeExpr.GetUpperValue(), r)
+		}
+	case *planpb.Expr_TermExpr:
+		if r := columnRange(e.TermExpr.GetColumnInfo()); r != nil {
+			for _, v := range e.TermExpr.GetValues() {
+				if unaryRangeMayMatch(planpb.OpType_Equal, v, r) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	// the negations, the comparisons between fields and the arithmetic comparisons are not evaluated
+	return true
+}
+
+// unaryRangeMayMatch returns false if no value in r can satisfy "value op v"
+func unaryRangeMayMatch(op planpb.OpType, v *planpb.GenericValue, r *datapb.FieldRange) bool {
+	toMin, okMin := compareGenericValue(v, r.GetMin())
+	toMax, okMax := compareGenericValue(v, r.GetMax())
+	if !okMin || !okMax {
+		return true
+	}
+	switch op {
+	case planpb.OpType_GreaterThan:
+		return toMax < 0
+	case planpb.OpType_GreaterEqual:
+		return toMax <= 0
+	case planpb.OpType_LessThan:
+		return toMin > 0
+	case planpb.OpType_LessEqual:
+		return toMin >= 0
+	case planpb.OpType_Equal:
+		return toMin >= 0 && toMax <= 0
+	case planpb.OpType_NotEqual:
+		return toMin != 0 || toMax != 0
+	case planpb.OpType_PrefixMatch:
+		// the values of a prefix are contiguous and not less than the prefix
+		prefix := v.GetStringVal()
+		return toMax <= 0 && (toMin >= 0 || strings.HasPrefix(r.GetMin().GetStringData(), prefix))
+	default:
+		return true
+	}
+}
+
+// compareGenericValue compares the value of a plan with the value of a range, false is returned if
+// they can't be compared
+func compareGenericValue(v *planpb.GenericValue, f *schemapb.ValueField) (int, bool) {
+	compareFloat := func(a, b float64) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	switch fv := f.GetData().(type) {
+	case *schemapb.ValueField_LongData:
+		switch gv := v.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			switch {
+			case gv.Int64Val < fv.LongData:
+				return -1, true
+			case gv.Int64Val > fv.LongData:
+				return 1, true
+			default:
+				return 0, true
+			}
+		case *planpb.GenericValue_FloatVal:
+			return compareFloat(gv.FloatVal, float64(fv.LongData)), true
+		}
+	case *schemapb.ValueField_DoubleData:
+		switch gv := v.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return compareFloat(float64(gv.Int64Val), fv.DoubleData), true
+		case *planpb.GenericValue_FloatVal:
+			// the value may be compared in single precision with a float field, they aren't compared
+			// unless both precisions agree
+			cmp := compareFloat(gv.FloatVal, fv.DoubleData)
+			return cmp, cmp == compareFloat(float64(float32(gv.FloatVal)), fv.DoubleData)
+		}
+	case *schemapb.ValueField_StringData:
+		if gv, ok := v.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return strings.Compare(gv.StringVal, fv.StringData), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestNewSegmentPruner(t *testing.T) {
+	assert.Nil(t, newSegmentPruner([]byte("invalid plan")))
+
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{}},
+	}
+	bs, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+	assert.Nil(t, newSegmentPruner(bs))
+
+	predicates := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+			Op:         planpb.OpType_GreaterThan,
+			Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 10}},
+		}},
+	}
+	plan = &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{Predicates: predicates},
+	}
+	bs, err = proto.Marshal(plan)
+	assert.NoError(t, err)
+	pruner := newSegmentPruner(bs)
+	assert.NotNil(t, pruner)
+	assert.True(t, proto.Equal(predicates, pruner.predicates))
+
+	var nilPruner *segmentPruner
+	assert.True(t, nilPruner.mayMatch(nil))
+}
+
+func TestExprMayMatch(t *testing.T) {
+	longValue := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	floatValue := func(v float64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+	}
+	stringValue := func(v string) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+	}
+	unaryRange := func(fieldID int64, op planpb.OpType, v *planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+			Op:         op,
+			Value:      v,
+		}}}
+	}
+	binaryExpr := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    op,
+			Left:  left,
+			Right: right,
+		}}}
+	}
+
+	// int field 100 in [10, 20], float field 101 in [1.5, 2.5], string field 102 in ["ab", "cd"]
+	ranges := map[FieldID]*datapb.FieldRange{
+		100: {
+			FieldID: 100,
+			Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+			Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 20}},
+		},
+		101: {
+			FieldID: 101,
+			Min:     &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}},
+			Max:     &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 2.5}},
+		},
+		102: {
+			FieldID: 102,
+			Min:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "ab"}},
+			Max:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "cd"}},
+		},
+	}
+	getRange := func(fieldID FieldID) *datapb.FieldRange {
+		return ranges[fieldID]
+	}
+
+	tests := []struct {
+		description string
+		expr        *planpb.Expr
+		mayMatch    bool
+	}{
+		{"greater than max", unaryRange(100, planpb.OpType_GreaterThan, longValue(20)), false},
+		{"greater equal max", unaryRange(100, planpb.OpType_GreaterEqual, longValue(20)), true},
+		{"less than min", unaryRange(100, planpb.OpType_LessThan, longValue(10)), false},
+		{"less equal min", unaryRange(100, planpb.OpType_LessEqual, longValue(10)), true},
+		{"equal in range", unaryRange(100, planpb.OpType_Equal, longValue(15)), true},
+		{"equal out of range", unaryRange(100, planpb.OpType_Equal, longValue(21)), false},
+		{"not equal", unaryRange(100, planpb.OpType_NotEqual, longValue(15)), true},
+		{"float with int value", unaryRange(101, planpb.OpType_GreaterThan, longValue(3)), false},
+		{"float in range", unaryRange(101, planpb.OpType_LessThan, floatValue(1.6)), true},
+		{"float out of range", unaryRange(101, planpb.OpType_LessThan, floatValue(1.5)), false},
+		{"int with float value", unaryRange(100, planpb.OpType_GreaterThan, floatValue(19.5)), true},
+		{"string out of range", unaryRange(102, planpb.OpType_GreaterThan, stringValue("cd")), false},
+		{"prefix in range", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("a")), true},
+		{"prefix of min", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("abc")), true},
+		{"prefix out of range", unaryRange(102, planpb.OpType_PrefixMatch, stringValue("ce")), false},
+		{"postfix", unaryRange(102, planpb.OpType_PostfixMatch, stringValue("zz")), true},
+		{"mismatched type", unaryRange(102, planpb.OpType_Equal, longValue(1)), true},
+		{"unknown range", unaryRange(103, planpb.OpType_Equal, longValue(1)), true},
+		{"and", binaryExpr(planpb.BinaryExpr_LogicalAnd,
+			unaryRange(100, planpb.OpType_Equal, longValue(15)),
+			unaryRange(101, planpb.OpType_Equal, floatValue(3))), false},
+		{"or", binaryExpr(planpb.BinaryExpr_LogicalOr,
+			unaryRange(100, planpb.OpType_Equal, longValue(15)),
+			unaryRange(101, planpb.OpType_Equal, floatValue(3))), true},
+		{"not", &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Op:    planpb.UnaryExpr_Not,
+			Child: unaryRange(100, planpb.OpType_Equal, longValue(15)),
+		}}}, true},
+		{"binary range out of range", &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: 100},
+			LowerInclusive: false,
+			UpperInclusive: true,
+			LowerValue:     longValue(20),
+			UpperValue:     longValue(30),
+		}}}, false},
+		{"binary range in range", &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: 100},
+			LowerInclusive: true,
+			UpperInclusive: true,
+			LowerValue:     longValue(20),
+			UpperValue:     longValue(30),
+		}}}, true},
+		{"term out of range", &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+			Values:     []*planpb.GenericValue{longValue(1), longValue(30)},
+		}}}, false},
+		{"term in range", &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+			Values:     []*planpb.GenericValue{longValue(1), longValue(12)},
+		}}}, true},
+		{"nested path", &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100, NestedPath: []string{"a"}},
+			Op:         planpb.OpType_Equal,
+			Value:      longValue(30),
+		}}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.mayMatch, exprMayMatch(test.expr, getRange))
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 //-------------------------------------------------------------------------------------- constructor and destructor
@@ -280,7 +281,7 @@ func TestSegment_getDeletedCount(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	err = segment.segmentDelete(offsetDelete, storage.NewInt64PrimaryKeys(ids), timestamps)
 	assert.NoError(t, err)
 
 	var deletedCount = segment.getDeletedCount()
@@ -450,7 +451,7 @@ func TestSegment_segmentDelete(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	err = segment.segmentDelete(offsetDelete, storage.NewInt64PrimaryKeys(ids), timestamps)
 	assert.NoError(t, err)
 
 	deleteCollection(collection)
@@ -650,31 +651,46 @@ func TestSegment_segmentPreDelete(t *testing.T) {
 }
 
 func TestSegment_segmentLoadDeletedRecord(t *testing.T) {
-	fieldParam := constFieldParam{
-		id:       100,
-		dataType: schemapb.DataType_Int64,
-	}
-	field := genPKField(fieldParam)
-	schema := &schemapb.CollectionSchema{
-		Name:   defaultCollectionName,
-		AutoID: false,
-		Fields: []*schemapb.FieldSchema{
-			field,
-		},
+	genSealedSegment := func(pkType schemapb.DataType) *Segment {
+		fieldParam := constFieldParam{
+			id:       100,
+			dataType: pkType,
+		}
+		field := genPKField(fieldParam)
+		schema := &schemapb.CollectionSchema{
+			Name:   defaultCollectionName,
+			AutoID: false,
+			Fields: []*schemapb.FieldSchema{
+				field,
+			},
+		}
+
+		return newSegment(newCollection(defaultCollectionID, schema),
+			defaultSegmentID,
+			defaultPartitionID,
+			defaultCollectionID,
+			defaultDMLChannel,
+			segmentTypeSealed,
+			true)
 	}
 
-	seg := newSegment(newCollection(defaultCollectionID, schema),
-		defaultSegmentID,
-		defaultPartitionID,
-		defaultCollectionID,
-		defaultDMLChannel,
-		segmentTypeSealed,
-		true)
-	pks := []IntPrimaryKey{1, 2, 3}
-	timestamps := []Timestamp{10, 10, 10}
-	var rowCount int64 = 3
-	error := seg.segmentLoadDeletedRecord(pks, timestamps, rowCount)
-	assert.NoError(t, error)
+	t.Run("test int64 primary keys", func(t *testing.T) {
+		seg := genSealedSegment(schemapb.DataType_Int64)
+		pks := storage.NewInt64PrimaryKeys([]int64{1, 2, 3})
+		timestamps := []Timestamp{10, 10, 10}
+		var rowCount int64 = 3
+		error := seg.segmentLoadDeletedRecord(pks, timestamps, rowCount)
+		assert.NoError(t, error)
+	})
+
+	t.Run("test string primary keys", func(t *testing.T) {
+		seg := genSealedSegment(schemapb.DataType_String)
+		pks := storage.NewStringPrimaryKeys([]string{"a", "bb", "ccc"})
+		timestamps := []Timestamp{10, 10, 10}
+		var rowCount int64 = 3
+		error := seg.segmentLoadDeletedRecord(pks, timestamps, rowCount)
+		assert.NoError(t, error)
+	})
 }
 
 func TestSegment_segmentLoadFieldData(t *testing.T) {
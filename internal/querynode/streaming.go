@@ -24,7 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -40,7 +40,7 @@ type streaming struct {
 	msFactory msgstream.Factory
 }
 
-func newStreaming(ctx context.Context, replica ReplicaInterface, factory msgstream.Factory, etcdKV *etcdkv.EtcdKV, tSafeReplica TSafeReplicaInterface) *streaming {
+func newStreaming(ctx context.Context, replica ReplicaInterface, factory msgstream.Factory, etcdKV kv.MetaKv, tSafeReplica TSafeReplicaInterface) *streaming {
 
 	return &streaming{
 		replica:      replica,
@@ -283,6 +283,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	sCol.addVChannels(vChannels)
 	sCol.addPChannels(pChannels)
 	sCol.setLoadType(lType)
+	sCol.setReplicaID(w.req.ReplicaID)
 	hCol, err := w.node.historical.replica.getCollectionByID(collectionID)
 	if err != nil {
 		return err
@@ -290,6 +291,7 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	hCol.addVChannels(vChannels)
 	hCol.addPChannels(pChannels)
 	hCol.setLoadType(lType)
+	hCol.setReplicaID(w.req.ReplicaID)
 	if lType == loadTypePartition {
 		for _, partitionID := range partitionIDs {
 			sCol.deleteReleasedPartition(partitionID)
@@ -720,11 +722,13 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 			return err
 		}
 		sCol.deleteReleasedPartition(partitionID)
+		sCol.setReplicaID(l.req.ReplicaID)
 		hCol, err := l.node.historical.replica.getCollectionByID(collectionID)
 		if err != nil {
 			return err
 		}
 		hCol.deleteReleasedPartition(partitionID)
+		hCol.setReplicaID(l.req.ReplicaID)
 	}
 
 	log.Debug("LoadSegments done", zap.String("SegmentLoadInfos", fmt.Sprintln(l.req.Infos)))
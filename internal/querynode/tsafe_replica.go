@@ -19,15 +19,19 @@ package querynode
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 // TSafeReplicaInterface is the interface wrapper of tSafeReplica
 type TSafeReplicaInterface interface {
 	getTSafe(vChannel Channel) (Timestamp, error)
+	getTSafeChannels() []Channel
 	setTSafe(vChannel Channel, timestamp Timestamp) error
 	addTSafe(vChannel Channel)
 	removeTSafe(vChannel Channel)
@@ -54,6 +58,16 @@ func (t *tSafeReplica) getTSafe(vChannel Channel) (Timestamp, error) {
 	return ts.get(), nil
 }
 
+func (t *tSafeReplica) getTSafeChannels() []Channel {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	channels := make([]Channel, 0, len(t.tSafes))
+	for channel := range t.tSafes {
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
 func (t *tSafeReplica) setTSafe(vChannel Channel, timestamp Timestamp) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -62,6 +76,8 @@ func (t *tSafeReplica) setTSafe(vChannel Channel, timestamp Timestamp) error {
 		return errors.New("set tSafe failed, err = " + err.Error())
 	}
 	ts.set(timestamp)
+	physicalTime, _ := tsoutil.ParseTS(timestamp)
+	metrics.QueryNodeTSafeLag.WithLabelValues(vChannel).Set(float64(time.Since(physicalTime).Milliseconds()))
 	return nil
 }
 
@@ -97,6 +113,7 @@ func (t *tSafeReplica) removeTSafe(vChannel Channel) {
 		zap.Any("vChannel", vChannel),
 	)
 	delete(t.tSafes, vChannel)
+	metrics.QueryNodeTSafeLag.DeleteLabelValues(vChannel)
 }
 
 func (t *tSafeReplica) registerTSafeWatcher(vChannel Channel, watcher *tSafeWatcher) error {
@@ -20,17 +20,20 @@ import (
 	"bytes"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -44,6 +47,9 @@ const (
 	// ProxyMetaPrefix prefix for proxy meta
 	ProxyMetaPrefix = ComponentPrefix + "/proxy"
 
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+
 	// CollectionMetaPrefix prefix for collection meta
 	CollectionMetaPrefix = ComponentPrefix + "/collection"
 
@@ -56,6 +62,21 @@ const (
 	// CollectionAliasMetaPrefix prefix for collection alias meta
 	CollectionAliasMetaPrefix = ComponentPrefix + "/collection-alias"
 
+	// CredentialPrefix prefix for credential and rbac meta
+	CredentialPrefix = ComponentPrefix + "/credential"
+
+	// CredentialUserPrefix prefix for user credentials
+	CredentialUserPrefix = CredentialPrefix + "/users"
+
+	// CredentialRolePrefix prefix for roles
+	CredentialRolePrefix = CredentialPrefix + "/roles"
+
+	// CredentialUserRolePrefix prefix for user to role mappings
+	CredentialUserRolePrefix = CredentialPrefix + "/user-role-mapping"
+
+	// CredentialGrantPrefix prefix for privileges granted to roles
+	CredentialGrantPrefix = CredentialPrefix + "/grantee-privileges"
+
 	// TimestampPrefix prefix for timestamp
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
@@ -85,15 +106,22 @@ type MetaTable struct {
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
-	collAlias2ID    map[string]typeutil.UniqueID                                    // collection alias to collection id
+	dbName2ID       map[string]typeutil.UniqueID                                    // database name to database id
+	dbID2Meta       map[typeutil.UniqueID]pb.DatabaseInfo                           // database id to database meta
+	collName2ID     map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection name -> collection id
+	collAlias2ID    map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	userCreds       map[string]pb.CredentialInfo                                    // username -> credential
+	roles           map[string]struct{}                                             // role names, built-in roles excluded
+	userRoles       map[string]map[string]struct{}                                  // username -> role names
+	grants          map[string]pb.GrantInfo                                         // grant key -> grant
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 // NewMetaTable creates meta table for rootcoord, which stores all in-memory information
@@ -105,6 +133,7 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -117,11 +146,17 @@ func (mt *MetaTable) reloadFromKV() error {
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
-	mt.collAlias2ID = make(map[string]typeutil.UniqueID)
+	mt.dbName2ID = make(map[string]typeutil.UniqueID)
+	mt.dbID2Meta = make(map[typeutil.UniqueID]pb.DatabaseInfo)
+	mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.userCreds = make(map[string]pb.CredentialInfo)
+	mt.roles = make(map[string]struct{})
+	mt.userRoles = make(map[string]map[string]struct{})
+	mt.grants = make(map[string]pb.GrantInfo)
 
 	_, values, err := mt.snapshot.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -155,6 +190,23 @@ func (mt *MetaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	// the default database always exists and is never persisted
+	mt.addDatabaseMeta(pb.DatabaseInfo{ID: common.DefaultDBID, Name: common.DefaultDBName})
+
+	_, values, err = mt.snapshot.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.Unmarshal([]byte(value), &dbInfo)
+		if err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.DatabaseInfo err:%w", err)
+		}
+		mt.addDatabaseMeta(dbInfo)
+	}
+
 	_, values, err = mt.snapshot.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -166,8 +218,12 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("rootcoord Unmarshal pb.CollectionInfo err:%w", err)
 		}
+		collNames, ok := mt.collName2ID[collInfo.DbId]
+		if !ok {
+			return fmt.Errorf("database id = %d of collection %s not found", collInfo.DbId, collInfo.Schema.Name)
+		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		collNames[collInfo.Schema.Name] = collInfo.ID
 	}
 
 	_, values, err = mt.txn.LoadWithPrefix(SegmentIndexMetaPrefix)
@@ -233,13 +289,201 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("rootcoord Unmarshal pb.AliasInfo err:%w", err)
 		}
-		mt.collAlias2ID[aliasInfo.Schema.Name] = aliasInfo.ID
+		aliases, ok := mt.collAlias2ID[aliasInfo.DbId]
+		if !ok {
+			return fmt.Errorf("database id = %d of alias %s not found", aliasInfo.DbId, aliasInfo.Schema.Name)
+		}
+		aliases[aliasInfo.Schema.Name] = aliasInfo.ID
+	}
+
+	if err = mt.reloadCredentialFromKV(); err != nil {
+		return err
 	}
 
 	log.Debug("reload meta table from KV successfully")
 	return nil
 }
 
+func (mt *MetaTable) reloadCredentialFromKV() error {
+	_, values, err := mt.txn.LoadWithPrefix(CredentialUserPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		cred := pb.CredentialInfo{}
+		if err = proto.Unmarshal([]byte(value), &cred); err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.CredentialInfo err:%w", err)
+		}
+		mt.userCreds[cred.Username] = cred
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(CredentialRolePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		role := pb.RoleInfo{}
+		if err = proto.Unmarshal([]byte(value), &role); err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.RoleInfo err:%w", err)
+		}
+		mt.roles[role.Name] = struct{}{}
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(CredentialUserRolePrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		userRole := pb.UserRoleInfo{}
+		if err = proto.Unmarshal([]byte(value), &userRole); err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.UserRoleInfo err:%w", err)
+		}
+		mt.addUserRole(userRole.Username, userRole.RoleName)
+	}
+
+	_, values, err = mt.txn.LoadWithPrefix(CredentialGrantPrefix)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		grant := pb.GrantInfo{}
+		if err = proto.Unmarshal([]byte(value), &grant); err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.GrantInfo err:%w", err)
+		}
+		mt.grants[grantKey(grant.RoleName, grant.ObjectType, grant.ObjectName, grant.Privilege)] = grant
+	}
+	return nil
+}
+
+func (mt *MetaTable) addDatabaseMeta(db pb.DatabaseInfo) {
+	mt.dbName2ID[db.Name] = db.ID
+	mt.dbID2Meta[db.ID] = db
+	mt.collName2ID[db.ID] = make(map[string]typeutil.UniqueID)
+	mt.collAlias2ID[db.ID] = make(map[string]typeutil.UniqueID)
+}
+
+// getDatabaseID returns the id of database dbName, empty name refers to the default database
+func (mt *MetaTable) getDatabaseID(dbName string) (typeutil.UniqueID, error) {
+	if dbName == "" {
+		return common.DefaultDBID, nil
+	}
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return 0, fmt.Errorf("database %s not found", dbName)
+	}
+	return dbID, nil
+}
+
+// getCollectionIDByName returns the id of collection collName in database dbName, collName could be an alias
+func (mt *MetaTable) getCollectionIDByName(dbName string, collName string) (typeutil.UniqueID, bool) {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, false
+	}
+	if collID, ok := mt.collName2ID[dbID][collName]; ok {
+		return collID, true
+	}
+	collID, ok := mt.collAlias2ID[dbID][collName]
+	return collID, ok
+}
+
+func aliasMetaKey(dbID typeutil.UniqueID, alias string) string {
+	// aliases of the default database keep the key used before databases were introduced
+	if dbID == common.DefaultDBID {
+		return fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
+	}
+	return fmt.Sprintf("%s/%d/%s", CollectionAliasMetaPrefix, dbID, alias)
+}
+
+// CreateDatabase add database
+func (mt *MetaTable) CreateDatabase(db *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2ID[db.Name]; ok {
+		return fmt.Errorf("database %s exist", db.Name)
+	}
+	if _, ok := mt.dbID2Meta[db.ID]; ok {
+		return fmt.Errorf("database id = %d exist", db.ID)
+	}
+
+	db.CreateTime = ts
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, db.ID)
+	v, err := proto.Marshal(db)
+	if err != nil {
+		log.Error("MetaTable CreateDatabase Marshal fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable CreateDatabase Marshal fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.addDatabaseMeta(*db)
+	return nil
+}
+
+// DropDatabase delete database, all collections of the database must have been dropped
+func (mt *MetaTable) DropDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if dbName == "" || dbName == common.DefaultDBName {
+		return fmt.Errorf("default database cannot be dropped")
+	}
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return fmt.Errorf("database %s not found", dbName)
+	}
+	if len(mt.collName2ID[dbID]) != 0 {
+		return fmt.Errorf("database %s is not empty, it still has %d collections", dbName, len(mt.collName2ID[dbID]))
+	}
+
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbID)
+	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{k}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+
+	delete(mt.dbName2ID, dbName)
+	delete(mt.dbID2Meta, dbID)
+	delete(mt.collName2ID, dbID)
+	delete(mt.collAlias2ID, dbID)
+	return nil
+}
+
+// GetDatabaseByName return database meta by database name, empty name refers to the default database
+func (mt *MetaTable) GetDatabaseByName(dbName string) (*pb.DatabaseInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
+	db := mt.dbID2Meta[dbID]
+	return proto.Clone(&db).(*pb.DatabaseInfo), nil
+}
+
+// ListDatabases list all databases, ordered by database id
+func (mt *MetaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*pb.DatabaseInfo, 0, len(mt.dbID2Meta))
+	for _, db := range mt.dbID2Meta {
+		db := db
+		dbs = append(dbs, proto.Clone(&db).(*pb.DatabaseInfo))
+	}
+	sort.Slice(dbs, func(i, j int) bool {
+		return dbs[i].ID < dbs[j].ID
+	})
+	return dbs
+}
+
 // AddTenant add tenant
 func (mt *MetaTable) AddTenant(te *pb.TenantMeta, ts typeutil.Timestamp) error {
 	mt.tenantLock.Lock()
@@ -289,10 +533,14 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 
 	if len(coll.PartitionIDs) != len(coll.PartitionNames) ||
 		len(coll.PartitionIDs) != len(coll.PartitionCreatedTimestamps) ||
-		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
+		(len(coll.PartitionIDs) > 1 && typeutil.GetPartitionKeyField(coll.Schema) == nil) {
 		return fmt.Errorf("partition parameters' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	collNames, ok := mt.collName2ID[coll.DbId]
+	if !ok {
+		return fmt.Errorf("database id = %d not found", coll.DbId)
+	}
+	if _, ok := collNames[coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
 	if len(coll.FieldIndexes) != len(idx) {
@@ -300,11 +548,11 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 	}
 
 	coll.CreateTime = ts
-	if len(coll.PartitionCreatedTimestamps) == 1 {
-		coll.PartitionCreatedTimestamps[0] = ts
+	for i := range coll.PartitionCreatedTimestamps {
+		coll.PartitionCreatedTimestamps[i] = ts
 	}
 	mt.collID2Meta[coll.ID] = *coll
-	mt.collName2ID[coll.Schema.Name] = coll.ID
+	collNames[coll.Schema.Name] = coll.ID
 	for _, i := range idx {
 		mt.indexID2Meta[i.IndexID] = *i
 	}
@@ -353,7 +601,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[collMeta.DbId], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -379,7 +627,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 	var aliases []string
 	// delete collection aliases
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[collMeta.DbId] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -394,10 +642,8 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
-		delMetakeysSnap = append(delMetakeysSnap,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		delete(mt.collAlias2ID[collMeta.DbId], alias)
+		delMetakeysSnap = append(delMetakeysSnap, aliasMetaKey(collMeta.DbId, alias))
 	}
 
 	// save ddOpStr into etcd
@@ -459,15 +705,19 @@ func (mt *MetaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-// GetCollectionByName return collection meta by collection name
-func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName return collection meta by database name and collection name
+func (mt *MetaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.collName2ID[dbID][collectionName]
 		if !ok {
-			if vid, ok = mt.collAlias2ID[collectionName]; !ok {
+			if vid, ok = mt.collAlias2ID[dbID][collectionName]; !ok {
 				return nil, fmt.Errorf("can't find collection: " + collectionName)
 			}
 		}
@@ -489,21 +739,25 @@ func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.DbId == dbID && collMeta.Schema.Name == collectionName {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-// ListCollections list all collection names
-func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections list all collection names of database dbName
+func (mt *MetaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		for collName, collID := range mt.collName2ID[dbID] {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -521,6 +775,9 @@ func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 		}
+		if collMeta.DbId != dbID {
+			continue
+		}
 		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
@@ -531,9 +788,11 @@ func (mt *MetaTable) ListAliases(collID typeutil.UniqueID) []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var aliases []string
-	for alias, cid := range mt.collAlias2ID {
-		if cid == collID {
-			aliases = append(aliases, alias)
+	for _, dbAliases := range mt.collAlias2ID {
+		for alias, cid := range dbAliases {
+			if cid == collID {
+				aliases = append(aliases, alias)
+			}
 		}
 	}
 	return aliases
@@ -630,6 +889,50 @@ func (mt *MetaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	return nil
 }
 
+// AddField appends field to the schema of collection collID and increases the schema version,
+// the field is assigned an id greater than the ids of all existing fields
+func (mt *MetaTable) AddField(collID typeutil.UniqueID, field *schemapb.FieldSchema, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+
+	fieldID := typeutil.UniqueID(common.StartOfUserFieldID)
+	for _, f := range coll.Schema.Fields {
+		if f.Name == field.Name {
+			return fmt.Errorf("field name = %s already exists", field.Name)
+		}
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+
+	schema := proto.Clone(coll.Schema).(*schemapb.CollectionSchema)
+	newField := proto.Clone(field).(*schemapb.FieldSchema)
+	newField.FieldID = fieldID
+	schema.Fields = append(schema.Fields, newField)
+	schema.Version++
+	coll.Schema = schema
+
+	k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v1, err := proto.Marshal(&coll)
+	if err != nil {
+		log.Error("MetaTable AddField saveColl Marshal fail",
+			zap.String("key", k1), zap.Error(err))
+		return fmt.Errorf("metaTable AddField Marshal fail, k1:%s, err:%w", k1, err)
+	}
+
+	err = mt.snapshot.Save(k1, string(v1), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.collID2Meta[collID] = coll
+	return nil
+}
+
 // GetPartitionNameByID return partition name by partition id
 func (mt *MetaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
 	if ts == 0 {
@@ -850,22 +1153,19 @@ func (mt *MetaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) error {
 }
 
 // DropIndex drop index
-func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
+func (mt *MetaTable) DropIndex(dbName, collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return 0, false, fmt.Errorf("collection name = %s not exist", collName)
-		}
+		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -976,20 +1276,17 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 }
 
 // GetFieldSchema return field schema
-func (mt *MetaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *MetaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *MetaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1034,25 +1331,22 @@ func (mt *MetaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // GetNotIndexedSegments return segment ids which have no index
-func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
@@ -1169,16 +1463,13 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 }
 
 // GetIndexByName return index info by index name
-func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *MetaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.getCollectionIDByName(dbName, collName)
 	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
-		}
+		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1237,25 +1528,29 @@ func (mt *MetaTable) dupMeta() (
 }
 
 // AddAlias add collection alias
-func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) AddAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("duplicate collection alias, alias = %s", collectionAlias)
 	}
 
-	if _, ok := mt.collName2ID[collectionAlias]; ok {
+	if _, ok := mt.collName2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("collection alias collides with existing collection name. collection = %s, alias = %s", collectionAlias, collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbID][collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasMetaKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AddAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1271,19 +1566,23 @@ func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts
 }
 
 // DropAlias drop collection alias
-func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) DropAlias(dbName string, collectionAlias string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
-	delete(mt.collAlias2ID, collectionAlias)
+	delete(mt.collAlias2ID[dbID], collectionAlias)
 
 	delMetakeys := []string{
-		fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias),
+		aliasMetaKey(dbID, collectionAlias),
 	}
 	meta := make(map[string]string)
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
+	err = mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
 	if err != nil {
 		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
@@ -1292,21 +1591,25 @@ func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) er
 }
 
 // AlterAlias alter collection alias
-func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) AlterAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbID][collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasMetaKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AlterAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1321,10 +1624,396 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	return nil
 }
 
-// IsAlias returns true if specific `collectionAlias` is an alias of collection.
-func (mt *MetaTable) IsAlias(collectionAlias string) bool {
+// IsAlias returns true if specific `collectionAlias` is an alias of collection in database dbName.
+func (mt *MetaTable) IsAlias(dbName string, collectionAlias string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
-	_, ok := mt.collAlias2ID[collectionAlias]
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return false
+	}
+	_, ok := mt.collAlias2ID[dbID][collectionAlias]
+	return ok
+}
+
+func credentialUserKey(username string) string {
+	return fmt.Sprintf("%s/%s", CredentialUserPrefix, username)
+}
+
+func credentialRoleKey(role string) string {
+	return fmt.Sprintf("%s/%s", CredentialRolePrefix, role)
+}
+
+func credentialUserRoleKey(username string, role string) string {
+	return fmt.Sprintf("%s/%s/%s", CredentialUserRolePrefix, username, role)
+}
+
+func grantKey(role, objectType, objectName, privilege string) string {
+	return path.Join(role, objectType, objectName, privilege)
+}
+
+func credentialGrantKey(grant *pb.GrantInfo) string {
+	return fmt.Sprintf("%s/%s", CredentialGrantPrefix, grantKey(grant.RoleName, grant.ObjectType, grant.ObjectName, grant.Privilege))
+}
+
+func isBuiltinRole(role string) bool {
+	return role == util.RoleAdmin || role == util.RolePublic
+}
+
+// roleExists returns true if role is a built-in role or has been created, the caller must hold mt.credLock
+func (mt *MetaTable) roleExists(role string) bool {
+	if isBuiltinRole(role) {
+		return true
+	}
+	_, ok := mt.roles[role]
 	return ok
 }
+
+func (mt *MetaTable) addUserRole(username string, role string) {
+	if _, ok := mt.userRoles[username]; !ok {
+		mt.userRoles[username] = make(map[string]struct{})
+	}
+	mt.userRoles[username][role] = struct{}{}
+}
+
+func (mt *MetaTable) saveCredential(cred *pb.CredentialInfo) error {
+	k := credentialUserKey(cred.Username)
+	v, err := proto.Marshal(cred)
+	if err != nil {
+		log.Error("MetaTable saveCredential Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable saveCredential Marshal fail key:%s, err:%w", k, err)
+	}
+	if err = mt.txn.Save(k, string(v)); err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("save credential of user %s failed, err:%w", cred.Username, err)
+	}
+	return nil
+}
+
+// AddCredential saves the credential of a new user
+func (mt *MetaTable) AddCredential(cred *pb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userCreds[cred.Username]; ok {
+		return fmt.Errorf("user %s already exists", cred.Username)
+	}
+	if err := mt.saveCredential(cred); err != nil {
+		return err
+	}
+	mt.userCreds[cred.Username] = *cred
+	return nil
+}
+
+// UpdateCredential replaces the credential of an existing user
+func (mt *MetaTable) UpdateCredential(cred *pb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userCreds[cred.Username]; !ok {
+		return fmt.Errorf("user %s not found", cred.Username)
+	}
+	if err := mt.saveCredential(cred); err != nil {
+		return err
+	}
+	mt.userCreds[cred.Username] = *cred
+	return nil
+}
+
+// GetCredential returns the credential of the user
+func (mt *MetaTable) GetCredential(username string) (*pb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	cred, ok := mt.userCreds[username]
+	if !ok {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	return proto.Clone(&cred).(*pb.CredentialInfo), nil
+}
+
+// DeleteCredential removes the user together with its role bindings, the root user can't be deleted
+func (mt *MetaTable) DeleteCredential(username string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if username == util.UserRoot {
+		return fmt.Errorf("user %s cannot be deleted", util.UserRoot)
+	}
+	if _, ok := mt.userCreds[username]; !ok {
+		return fmt.Errorf("user %s not found", username)
+	}
+
+	keys := []string{credentialUserKey(username)}
+	for role := range mt.userRoles[username] {
+		keys = append(keys, credentialUserRoleKey(username, role))
+	}
+	if err := mt.txn.MultiRemove(keys); err != nil {
+		log.Error("TxnKV MultiRemove fail", zap.Strings("keys", keys), zap.Error(err))
+		return fmt.Errorf("delete credential of user %s failed, err:%w", username, err)
+	}
+	delete(mt.userCreds, username)
+	delete(mt.userRoles, username)
+	return nil
+}
+
+// ListCredentialUsernames returns the names of all users in alphabetical order
+func (mt *MetaTable) ListCredentialUsernames() []string {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	usernames := make([]string, 0, len(mt.userCreds))
+	for username := range mt.userCreds {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+// CreateRole creates a new role
+func (mt *MetaTable) CreateRole(role string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if mt.roleExists(role) {
+		return fmt.Errorf("role %s already exists", role)
+	}
+	k := credentialRoleKey(role)
+	v, err := proto.Marshal(&pb.RoleInfo{Name: role})
+	if err != nil {
+		log.Error("MetaTable CreateRole Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable CreateRole Marshal fail key:%s, err:%w", k, err)
+	}
+	if err = mt.txn.Save(k, string(v)); err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("create role %s failed, err:%w", role, err)
+	}
+	mt.roles[role] = struct{}{}
+	return nil
+}
+
+// DropRole removes the role together with its user bindings and grants, built-in roles can't be dropped
+func (mt *MetaTable) DropRole(role string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if isBuiltinRole(role) {
+		return fmt.Errorf("built-in role %s cannot be dropped", role)
+	}
+	if _, ok := mt.roles[role]; !ok {
+		return fmt.Errorf("role %s not found", role)
+	}
+
+	keys := []string{credentialRoleKey(role)}
+	for username, roles := range mt.userRoles {
+		if _, ok := roles[role]; ok {
+			keys = append(keys, credentialUserRoleKey(username, role))
+		}
+	}
+	var grantKeys []string
+	for k, grant := range mt.grants {
+		if grant.RoleName == role {
+			grant := grant
+			keys = append(keys, credentialGrantKey(&grant))
+			grantKeys = append(grantKeys, k)
+		}
+	}
+	if err := mt.txn.MultiRemove(keys); err != nil {
+		log.Error("TxnKV MultiRemove fail", zap.Strings("keys", keys), zap.Error(err))
+		return fmt.Errorf("drop role %s failed, err:%w", role, err)
+	}
+
+	delete(mt.roles, role)
+	for _, roles := range mt.userRoles {
+		delete(roles, role)
+	}
+	for _, k := range grantKeys {
+		delete(mt.grants, k)
+	}
+	return nil
+}
+
+// AddUserToRole binds the user to the role, every user belongs to the public role implicitly
+func (mt *MetaTable) AddUserToRole(username string, role string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userCreds[username]; !ok {
+		return fmt.Errorf("user %s not found", username)
+	}
+	if role == util.RolePublic {
+		return fmt.Errorf("every user belongs to role %s", util.RolePublic)
+	}
+	if !mt.roleExists(role) {
+		return fmt.Errorf("role %s not found", role)
+	}
+	if _, ok := mt.userRoles[username][role]; ok {
+		return fmt.Errorf("user %s already belongs to role %s", username, role)
+	}
+
+	k := credentialUserRoleKey(username, role)
+	v, err := proto.Marshal(&pb.UserRoleInfo{Username: username, RoleName: role})
+	if err != nil {
+		log.Error("MetaTable AddUserToRole Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable AddUserToRole Marshal fail key:%s, err:%w", k, err)
+	}
+	if err = mt.txn.Save(k, string(v)); err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("add user %s to role %s failed, err:%w", username, role, err)
+	}
+	mt.addUserRole(username, role)
+	return nil
+}
+
+// RemoveUserFromRole unbinds the user from the role
+func (mt *MetaTable) RemoveUserFromRole(username string, role string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.userRoles[username][role]; !ok {
+		return fmt.Errorf("user %s doesn't belong to role %s", username, role)
+	}
+	k := credentialUserRoleKey(username, role)
+	if err := mt.txn.Remove(k); err != nil {
+		log.Error("TxnKV Remove fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("remove user %s from role %s failed, err:%w", username, role, err)
+	}
+	delete(mt.userRoles[username], role)
+	return nil
+}
+
+// ListRoles returns the names of all roles in alphabetical order, built-in roles included
+func (mt *MetaTable) ListRoles() []string {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	roles := []string{util.RoleAdmin, util.RolePublic}
+	for role := range mt.roles {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// GetRoleUsers returns the users bound to the role in alphabetical order
+func (mt *MetaTable) GetRoleUsers(role string) ([]string, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if !mt.roleExists(role) {
+		return nil, fmt.Errorf("role %s not found", role)
+	}
+	var usernames []string
+	for username := range mt.userCreds {
+		if _, ok := mt.userRoles[username][role]; ok || role == util.RolePublic {
+			usernames = append(usernames, username)
+		}
+	}
+	sort.Strings(usernames)
+	return usernames, nil
+}
+
+// GetUserRoles returns the roles bound to the user in alphabetical order, the public role excluded
+func (mt *MetaTable) GetUserRoles(username string) ([]string, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if _, ok := mt.userCreds[username]; !ok {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	var roles []string
+	for role := range mt.userRoles[username] {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles, nil
+}
+
+// GrantPrivilege grants the privilege on the object to the role
+func (mt *MetaTable) GrantPrivilege(grant *pb.GrantInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if !mt.roleExists(grant.RoleName) {
+		return fmt.Errorf("role %s not found", grant.RoleName)
+	}
+	gk := grantKey(grant.RoleName, grant.ObjectType, grant.ObjectName, grant.Privilege)
+	if _, ok := mt.grants[gk]; ok {
+		return fmt.Errorf("privilege %s on %s %s has been granted to role %s", grant.Privilege, grant.ObjectType, grant.ObjectName, grant.RoleName)
+	}
+
+	k := credentialGrantKey(grant)
+	v, err := proto.Marshal(grant)
+	if err != nil {
+		log.Error("MetaTable GrantPrivilege Marshal fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable GrantPrivilege Marshal fail key:%s, err:%w", k, err)
+	}
+	if err = mt.txn.Save(k, string(v)); err != nil {
+		log.Error("TxnKV Save fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("grant privilege to role %s failed, err:%w", grant.RoleName, err)
+	}
+	mt.grants[gk] = *grant
+	return nil
+}
+
+// RevokePrivilege revokes the privilege on the object from the role
+func (mt *MetaTable) RevokePrivilege(grant *pb.GrantInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	gk := grantKey(grant.RoleName, grant.ObjectType, grant.ObjectName, grant.Privilege)
+	if _, ok := mt.grants[gk]; !ok {
+		return fmt.Errorf("privilege %s on %s %s hasn't been granted to role %s", grant.Privilege, grant.ObjectType, grant.ObjectName, grant.RoleName)
+	}
+	k := credentialGrantKey(grant)
+	if err := mt.txn.Remove(k); err != nil {
+		log.Error("TxnKV Remove fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("revoke privilege from role %s failed, err:%w", grant.RoleName, err)
+	}
+	delete(mt.grants, gk)
+	return nil
+}
+
+// SelectGrant returns the grants of the role, empty objectType or objectName matches all
+func (mt *MetaTable) SelectGrant(role string, objectType string, objectName string) ([]*pb.GrantInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if !mt.roleExists(role) {
+		return nil, fmt.Errorf("role %s not found", role)
+	}
+	grants := make([]*pb.GrantInfo, 0)
+	for _, grant := range mt.grants {
+		if grant.RoleName != role ||
+			(objectType != "" && grant.ObjectType != objectType) ||
+			(objectName != "" && grant.ObjectName != objectName) {
+			continue
+		}
+		grant := grant
+		grants = append(grants, proto.Clone(&grant).(*pb.GrantInfo))
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grantKey(grants[i].RoleName, grants[i].ObjectType, grants[i].ObjectName, grants[i].Privilege) <
+			grantKey(grants[j].RoleName, grants[j].ObjectType, grants[j].ObjectName, grants[j].Privilege)
+	})
+	return grants, nil
+}
+
+// ListPolicy returns all grants and the role bindings of all users
+func (mt *MetaTable) ListPolicy() ([]*pb.GrantInfo, map[string][]string) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	grants := make([]*pb.GrantInfo, 0, len(mt.grants))
+	for _, grant := range mt.grants {
+		grant := grant
+		grants = append(grants, proto.Clone(&grant).(*pb.GrantInfo))
+	}
+	userRoles := make(map[string][]string, len(mt.userRoles))
+	for username, roles := range mt.userRoles {
+		for role := range roles {
+			userRoles[username] = append(userRoles[username], role)
+		}
+	}
+	return grants, userRoles
+}
@@ -26,12 +26,14 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -308,7 +310,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName(collName, 0)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -317,7 +319,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema(collName, "field110")
+		field, err := mt.GetFieldSchema("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -331,22 +333,22 @@ func TestMetaTable(t *testing.T) {
 	t.Run("add alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		exists := mt.IsAlias(aliasName1)
+		exists := mt.IsAlias("", aliasName1)
 		assert.False(t, exists)
-		err = mt.AddAlias(aliasName1, collName, ts)
+		err = mt.AddAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
 		aliases := mt.ListAliases(collID)
 		assert.Equal(t, aliases, []string{aliasName1})
-		exists = mt.IsAlias(aliasName1)
+		exists = mt.IsAlias("", aliasName1)
 		assert.True(t, exists)
 	})
 	wg.Add(1)
 	t.Run("alter alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.AlterAlias(aliasName1, collName, ts)
+		err = mt.AlterAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
-		err = mt.AlterAlias(aliasName1, collNameInvalid, ts)
+		err = mt.AlterAlias("", aliasName1, collNameInvalid, ts)
 		assert.NotNil(t, err)
 	})
 
@@ -354,7 +356,7 @@ func TestMetaTable(t *testing.T) {
 	t.Run("delete alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.DropAlias(aliasName1, ts)
+		err = mt.DropAlias("", aliasName1, ts)
 		assert.Nil(t, err)
 	})
 
@@ -432,9 +434,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err := mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -450,7 +452,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err = mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -462,7 +464,7 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("get index by name", func(t *testing.T) {
 		defer wg.Done()
-		_, idx, err := mt.GetIndexByName(collName, "field110")
+		_, idx, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -478,7 +480,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName(collName, "idx201")
+		_, idx, err = mt.GetIndexByName("", collName, "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -504,20 +506,20 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop index", func(t *testing.T) {
 		defer wg.Done()
-		idx, ok, err := mt.DropIndex(collName, "field110", "field110")
+		idx, ok, err := mt.DropIndex("", collName, "field110", "field110")
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex(collName, "field110", "field110-error")
+		_, ok, err = mt.DropIndex("", collName, "field110", "field110-error")
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName(collName, "field110")
+		_, idxs, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName(collName, "field110-1")
+		_, idxs, err = mt.GetIndexByName("", collName, "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -547,12 +549,12 @@ func TestMetaTable(t *testing.T) {
 		err = mt.DeleteCollection(collIDInvalid, ts, "")
 		assert.NotNil(t, err)
 		ts2 := ftso()
-		err = mt.AddAlias(aliasName2, collName, ts2)
+		err = mt.AddAlias("", aliasName2, collName, ts2)
 		assert.Nil(t, err)
 		err = mt.DeleteCollection(collID, ts, "")
 		assert.Nil(t, err)
 		ts3 := ftso()
-		err = mt.DropAlias(aliasName2, ts3)
+		err = mt.DropAlias("", aliasName2, ts3)
 		assert.NotNil(t, err)
 
 		// check DD operation flag
@@ -619,7 +621,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection %s with id %d", collInfo.Schema.Name, collInfo.ID))
 
@@ -831,16 +833,16 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		mt.collName2ID[common.DefaultDBID]["abc"] = 2
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc")
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -857,7 +859,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -873,7 +875,7 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string) error {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
 	})
 
 	wg.Add(1)
@@ -950,12 +952,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = map[int64]map[string]int64{common.DefaultDBID: {}}
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -1004,8 +1006,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		mt.collName2ID[common.DefaultDBID]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1025,13 +1027,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -1039,8 +1041,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -1073,8 +1075,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1088,8 +1090,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[common.DefaultDBID]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1109,7 +1111,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1214,29 +1216,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1247,22 +1249,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -1316,3 +1318,458 @@ func TestFixIssue10540(t *testing.T) {
 	_, err = NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 }
+
+func TestMetaTable_AddField(t *testing.T) {
+	const (
+		collID   = typeutil.UniqueID(1)
+		collName = "coll"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, skv)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	collInfo := &pb.CollectionInfo{
+		ID: collID,
+		Schema: &schemapb.CollectionSchema{
+			Name: collName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: common.StartOfUserFieldID, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+		},
+		PartitionIDs:               []typeutil.UniqueID{10},
+		PartitionNames:             []string{Params.CommonCfg.DefaultPartitionName},
+		PartitionCreatedTimestamps: []uint64{0},
+	}
+	createTs := ftso()
+	err = mt.AddCollection(collInfo, createTs, []*pb.IndexInfo{}, "")
+	assert.Nil(t, err)
+
+	field := &schemapb.FieldSchema{
+		Name:         "age",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+	}
+	addTs := ftso()
+	err = mt.AddField(collID, field, addTs)
+	assert.Nil(t, err)
+
+	collMeta, err := mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), collMeta.Schema.Version)
+	assert.Equal(t, 4, len(collMeta.Schema.Fields))
+	assert.Equal(t, int64(common.StartOfUserFieldID+1), collMeta.Schema.Fields[3].FieldID)
+	assert.Equal(t, int32(18), collMeta.Schema.Fields[3].GetDefaultValue().GetIntData())
+	// field of the request is not modified
+	assert.Equal(t, int64(0), field.FieldID)
+
+	// schema before the field is added
+	collMeta, err = mt.GetCollectionByName("", collName, createTs)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), collMeta.Schema.Version)
+	assert.Equal(t, 3, len(collMeta.Schema.Fields))
+
+	err = mt.AddField(collID, field, ftso())
+	assert.NotNil(t, err)
+
+	err = mt.AddField(collID+1, field, ftso())
+	assert.NotNil(t, err)
+
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByID(collID, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), collMeta.Schema.Version)
+	assert.Equal(t, "age", collMeta.Schema.Fields[3].Name)
+}
+
+func TestMetaTable_PartitionKey(t *testing.T) {
+	const (
+		collID   = typeutil.UniqueID(1)
+		collName = "coll"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, skv)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	collInfo := &pb.CollectionInfo{
+		ID: collID,
+		Schema: &schemapb.CollectionSchema{
+			Name: collName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.StartOfUserFieldID, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: common.StartOfUserFieldID + 1, Name: "tenant", DataType: schemapb.DataType_String},
+			},
+		},
+		PartitionIDs: []typeutil.UniqueID{10, 11},
+		PartitionNames: []string{
+			typeutil.PartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, 0),
+			typeutil.PartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, 1),
+		},
+		PartitionCreatedTimestamps: []uint64{0, 0},
+	}
+	// multiple partitions are only created for a collection with partition key
+	err = mt.AddCollection(collInfo, 100, []*pb.IndexInfo{}, "")
+	assert.NotNil(t, err)
+
+	collInfo.Schema.Fields[1].IsPartitionKey = true
+	err = mt.AddCollection(collInfo, 101, []*pb.IndexInfo{}, "")
+	assert.Nil(t, err)
+
+	collMeta, err := mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, collInfo.PartitionNames, collMeta.PartitionNames)
+	assert.Equal(t, []uint64{101, 101}, collMeta.PartitionCreatedTimestamps)
+}
+
+func TestMetaTable_Database(t *testing.T) {
+	const (
+		dbName    = "db1"
+		collName  = "coll"
+		aliasName = "alias"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp = 100
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, skv)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	t.Run("default database", func(t *testing.T) {
+		dbs := mt.ListDatabases()
+		assert.Equal(t, 1, len(dbs))
+		assert.Equal(t, common.DefaultDBName, dbs[0].Name)
+		assert.Equal(t, common.DefaultDBID, dbs[0].ID)
+
+		db, err := mt.GetDatabaseByName("")
+		assert.Nil(t, err)
+		assert.Equal(t, common.DefaultDBID, db.ID)
+
+		err = mt.DropDatabase(common.DefaultDBName, ftso())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("create database", func(t *testing.T) {
+		err = mt.CreateDatabase(&pb.DatabaseInfo{ID: 1, Name: dbName}, ftso())
+		assert.Nil(t, err)
+
+		err = mt.CreateDatabase(&pb.DatabaseInfo{ID: 2, Name: dbName}, ftso())
+		assert.NotNil(t, err)
+		err = mt.CreateDatabase(&pb.DatabaseInfo{ID: 1, Name: "db2"}, ftso())
+		assert.NotNil(t, err)
+
+		db, err := mt.GetDatabaseByName(dbName)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), db.ID)
+
+		_, err = mt.GetDatabaseByName("db2")
+		assert.NotNil(t, err)
+
+		dbs := mt.ListDatabases()
+		assert.Equal(t, 2, len(dbs))
+		assert.Equal(t, dbName, dbs[1].Name)
+	})
+
+	t.Run("collection names are scoped by database", func(t *testing.T) {
+		collInfo := &pb.CollectionInfo{
+			ID:     10,
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}
+		err = mt.AddCollection(collInfo, ftso(), nil, "")
+		assert.Nil(t, err)
+
+		collInfo = &pb.CollectionInfo{
+			ID:     11,
+			DbId:   1,
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}
+		err = mt.AddCollection(collInfo, ftso(), nil, "")
+		assert.Nil(t, err)
+
+		collInfo = &pb.CollectionInfo{
+			ID:     12,
+			DbId:   100,
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}
+		err = mt.AddCollection(collInfo, ftso(), nil, "")
+		assert.NotNil(t, err)
+
+		coll, err := mt.GetCollectionByName("", collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), coll.ID)
+		coll, err = mt.GetCollectionByName(common.DefaultDBName, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), coll.ID)
+		coll, err = mt.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11), coll.ID)
+		coll, err = mt.GetCollectionByName(dbName, collName, vtso)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11), coll.ID)
+
+		colls, err := mt.ListCollections(dbName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(colls))
+		_, err = mt.ListCollections("db2", 0)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("aliases are scoped by database", func(t *testing.T) {
+		err = mt.AddAlias(dbName, aliasName, collName, ftso())
+		assert.Nil(t, err)
+		assert.True(t, mt.IsAlias(dbName, aliasName))
+		assert.False(t, mt.IsAlias("", aliasName))
+
+		coll, err := mt.GetCollectionByName(dbName, aliasName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11), coll.ID)
+		_, err = mt.GetCollectionByName("", aliasName, 0)
+		assert.NotNil(t, err)
+
+		err = mt.AddAlias("", aliasName, collName, ftso())
+		assert.Nil(t, err)
+		assert.True(t, mt.IsAlias("", aliasName))
+	})
+
+	t.Run("reload from kv", func(t *testing.T) {
+		mt2, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(mt2.ListDatabases()))
+
+		coll, err := mt2.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11), coll.ID)
+		coll, err = mt2.GetCollectionByName(dbName, aliasName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(11), coll.ID)
+		coll, err = mt2.GetCollectionByName("", aliasName, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), coll.ID)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		err = mt.DropDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.DropAlias(dbName, aliasName, ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteCollection(11, ftso(), "")
+		assert.Nil(t, err)
+
+		err = mt.DropDatabase(dbName, ftso())
+		assert.Nil(t, err)
+		err = mt.DropDatabase(dbName, ftso())
+		assert.NotNil(t, err)
+
+		_, err = mt.GetDatabaseByName(dbName)
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, len(mt.ListDatabases()))
+
+		mt2, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(mt2.ListDatabases()))
+	})
+}
+
+func TestMetaTable_Credential(t *testing.T) {
+	const (
+		username = "user1"
+		roleName = "role1"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	assert.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	assert.NotNil(t, skv)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	grant := &pb.GrantInfo{
+		RoleName:   roleName,
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "coll",
+		Privilege:  util.PrivilegeInsert,
+		Grantor:    util.UserRoot,
+	}
+
+	t.Run("credential", func(t *testing.T) {
+		err := mt.AddCredential(&pb.CredentialInfo{Username: util.UserRoot, EncryptedPassword: "root"})
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: username, EncryptedPassword: "pwd"})
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: username, EncryptedPassword: "pwd"})
+		assert.NotNil(t, err)
+
+		err = mt.UpdateCredential(&pb.CredentialInfo{Username: username, EncryptedPassword: "pwd2"})
+		assert.Nil(t, err)
+		err = mt.UpdateCredential(&pb.CredentialInfo{Username: "not_exist", EncryptedPassword: "pwd"})
+		assert.NotNil(t, err)
+
+		cred, err := mt.GetCredential(username)
+		assert.Nil(t, err)
+		assert.Equal(t, "pwd2", cred.EncryptedPassword)
+		_, err = mt.GetCredential("not_exist")
+		assert.NotNil(t, err)
+
+		assert.Equal(t, []string{username, util.UserRoot}, mt.ListCredentialUsernames())
+	})
+
+	t.Run("role", func(t *testing.T) {
+		err := mt.CreateRole(roleName)
+		assert.Nil(t, err)
+		err = mt.CreateRole(roleName)
+		assert.NotNil(t, err)
+		err = mt.CreateRole(util.RoleAdmin)
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{util.RoleAdmin, util.RolePublic, roleName}, mt.ListRoles())
+
+		err = mt.AddUserToRole(username, roleName)
+		assert.Nil(t, err)
+		err = mt.AddUserToRole(username, roleName)
+		assert.NotNil(t, err)
+		err = mt.AddUserToRole(username, util.RolePublic)
+		assert.NotNil(t, err)
+		err = mt.AddUserToRole("not_exist", roleName)
+		assert.NotNil(t, err)
+		err = mt.AddUserToRole(username, "not_exist")
+		assert.NotNil(t, err)
+
+		users, err := mt.GetRoleUsers(roleName)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{username}, users)
+		users, err = mt.GetRoleUsers(util.RolePublic)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{username, util.UserRoot}, users)
+		_, err = mt.GetRoleUsers("not_exist")
+		assert.NotNil(t, err)
+
+		roles, err := mt.GetUserRoles(username)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{roleName}, roles)
+	})
+
+	t.Run("grant", func(t *testing.T) {
+		err := mt.GrantPrivilege(grant)
+		assert.Nil(t, err)
+		err = mt.GrantPrivilege(grant)
+		assert.NotNil(t, err)
+		err = mt.GrantPrivilege(&pb.GrantInfo{RoleName: "not_exist"})
+		assert.NotNil(t, err)
+
+		grants, err := mt.SelectGrant(roleName, "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+		assert.True(t, proto.Equal(grant, grants[0]))
+		grants, err = mt.SelectGrant(roleName, util.ObjectTypeGlobal, "")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(grants))
+		_, err = mt.SelectGrant("not_exist", "", "")
+		assert.NotNil(t, err)
+
+		grantInfos, userRoles := mt.ListPolicy()
+		assert.Equal(t, 1, len(grantInfos))
+		assert.Equal(t, []string{roleName}, userRoles[username])
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		mt2, err := NewMetaTable(txnKV, skv)
+		assert.Nil(t, err)
+		assert.Equal(t, mt.ListCredentialUsernames(), mt2.ListCredentialUsernames())
+		assert.Equal(t, mt.ListRoles(), mt2.ListRoles())
+		roles, err := mt2.GetUserRoles(username)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{roleName}, roles)
+		grants, err := mt2.SelectGrant(roleName, "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grants))
+	})
+
+	t.Run("revoke and drop", func(t *testing.T) {
+		err := mt.RevokePrivilege(grant)
+		assert.Nil(t, err)
+		err = mt.RevokePrivilege(grant)
+		assert.NotNil(t, err)
+
+		err = mt.RemoveUserFromRole(username, roleName)
+		assert.Nil(t, err)
+		err = mt.RemoveUserFromRole(username, roleName)
+		assert.NotNil(t, err)
+
+		err = mt.AddUserToRole(username, roleName)
+		assert.Nil(t, err)
+		err = mt.GrantPrivilege(grant)
+		assert.Nil(t, err)
+
+		err = mt.DropRole(util.RolePublic)
+		assert.NotNil(t, err)
+		err = mt.DropRole(roleName)
+		assert.Nil(t, err)
+		err = mt.DropRole(roleName)
+		assert.NotNil(t, err)
+		roles, err := mt.GetUserRoles(username)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(roles))
+		grantInfos, _ := mt.ListPolicy()
+		assert.Equal(t, 0, len(grantInfos))
+
+		err = mt.DeleteCredential(util.UserRoot)
+		assert.NotNil(t, err)
+		err = mt.DeleteCredential(username)
+		assert.Nil(t, err)
+		err = mt.DeleteCredential(username)
+		assert.NotNil(t, err)
+		assert.Equal(t, []string{util.UserRoot}, mt.ListCredentialUsernames())
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"path"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -31,7 +32,6 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
-	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	ms "github.com/milvus-io/milvus/internal/msgstream"
@@ -47,6 +47,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/metastore"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -889,8 +892,8 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 }
 
 // RemoveIndex will call drop index service
-func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName string) error {
-	_, indexInfos, err := c.MetaTable.GetIndexByName(collName, indexName)
+func (c *Core) RemoveIndex(ctx context.Context, dbName string, collName string, indexName string) error {
+	_, indexInfos, err := c.MetaTable.GetIndexByName(dbName, collName, indexName)
 	if err != nil {
 		log.Error("GetIndexByName failed,", zap.String("collection name", collName),
 			zap.String("index name", indexName), zap.Error(err))
@@ -906,7 +909,7 @@ func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName strin
 }
 
 // ExpireMetaCache will call invalidate collection meta cache
-func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeutil.Timestamp) {
+func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []string, ts typeutil.Timestamp) {
 	for _, collName := range collNames {
 		req := proxypb.InvalidateCollMetaCacheRequest{
 			Base: &commonpb.MsgBase{
@@ -915,6 +918,7 @@ func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeu
 				Timestamp: ts,
 				SourceID:  c.session.ServerID,
 			},
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
@@ -943,7 +947,7 @@ func (c *Core) SetEtcdClient(etcdClient *clientv3.Client) {
 }
 
 func (c *Core) initSession() error {
-	c.session = sessionutil.NewSession(c.ctx, Params.BaseParams.MetaRootPath, c.etcdCli)
+	c.session = metastore.NewSession(c.ctx, c.etcdCli, Params.BaseParams.MetaRootPath, &Params.BaseParams)
 	if c.session == nil {
 		return fmt.Errorf("session is nil, the etcd client connection may have failed")
 	}
@@ -957,7 +961,7 @@ func (c *Core) Init() error {
 	var initError error
 	if c.kvBaseCreate == nil {
 		c.kvBaseCreate = func(root string) (kv.TxnKV, error) {
-			return etcdkv.NewEtcdKV(c.etcdCli, root), nil
+			return metastore.NewMetaKV(c.etcdCli, root, &Params.BaseParams)
 		}
 	}
 	c.initOnce.Do(func() {
@@ -996,8 +1000,13 @@ func (c *Core) Init() error {
 		}
 
 		log.Debug("RootCoord, Setting TSO and ID Allocator")
-		kv := tsoutil.NewTSOKVBase(c.etcdCli, Params.BaseParams.KvRootPath, "gid")
-		idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", kv)
+		idKV, err := metastore.NewMetaKV(c.etcdCli, path.Join(Params.BaseParams.KvRootPath, "gid"), &Params.BaseParams)
+		if err != nil {
+			initError = err
+			log.Error("RootCoord failed to new the kv of ID Allocator", zap.Error(err))
+			return
+		}
+		idAllocator := allocator.NewGlobalIDAllocator("idTimestamp", idKV)
 		if initError = idAllocator.Initialize(); initError != nil {
 			return
 		}
@@ -1008,8 +1017,13 @@ func (c *Core) Init() error {
 			return idAllocator.UpdateID()
 		}
 
-		kv = tsoutil.NewTSOKVBase(c.etcdCli, Params.BaseParams.KvRootPath, "tso")
-		tsoAllocator := tso.NewGlobalTSOAllocator("timestamp", kv)
+		tsoKV, err := metastore.NewMetaKV(c.etcdCli, path.Join(Params.BaseParams.KvRootPath, "tso"), &Params.BaseParams)
+		if err != nil {
+			initError = err
+			log.Error("RootCoord failed to new the kv of TSO Allocator", zap.Error(err))
+			return
+		}
+		tsoAllocator := tso.NewGlobalTSOAllocator("timestamp", tsoKV)
 		if initError = tsoAllocator.Initialize(); initError != nil {
 			return
 		}
@@ -1049,6 +1063,8 @@ func (c *Core) Init() error {
 		if initError != nil {
 			return
 		}
+
+		initError = c.initCredentials()
 	})
 	if initError != nil {
 		log.Debug("RootCoord init error", zap.Error(initError))
@@ -1078,7 +1094,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 
 	invalidateCache := false
 	var ts typeutil.Timestamp
-	var collName string
+	var dbName, collName string
 
 	switch ddOp.Type {
 	// TODO remove create collection resend
@@ -1088,7 +1104,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		if _, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err != nil {
+		if _, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err != nil {
 			if _, err = c.SendDdCreateCollectionReq(ctx, &ddReq, ddReq.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1102,8 +1118,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err == nil {
+		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err == nil {
 			if err = c.SendDdDropCollectionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1118,8 +1135,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1138,8 +1156,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1157,7 +1176,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	}
 
 	if invalidateCache {
-		c.ExpireMetaCache(ctx, []string{collName}, ts)
+		c.ExpireMetaCache(ctx, dbName, []string{collName}, ts)
 	}
 
 	// Update DDOperation in etcd
@@ -1433,6 +1452,36 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+// AddField append a scalar field to collection schema
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("AddField", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("AddField failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AddField failed: "+err.Error()), nil
+	}
+	log.Debug("AddField success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.String("field name", in.GetField().GetName()),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
 // CreatePartition create partition
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreatePartitionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
@@ -2048,3 +2097,476 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 
 	return succStatus(), nil
 }
+
+// CreateDatabase create database
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("CreateDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("CreateDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// DropDatabase drop database and all collections in it
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DropDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("DropDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("DropDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// ListDatabases list all databases
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	log.Debug("ListDatabases", zap.String("role", typeutil.RootCoordRole), zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("ListDatabases failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+	log.Debug("ListDatabases success", zap.String("role", typeutil.RootCoordRole),
+		zap.Strings("db names", t.Rsp.DbNames), zap.Int64("msgID", in.Base.MsgID))
+
+	t.Rsp.Status = succStatus()
+	return t.Rsp, nil
+}
+
+// initCredentials creates the root user with the default password if it doesn't exist
+func (c *Core) initCredentials() error {
+	if _, err := c.MetaTable.GetCredential(util.UserRoot); err == nil {
+		return nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(util.DefaultRootPassword)
+	if err != nil {
+		return err
+	}
+	return c.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+		Username:          util.UserRoot,
+		EncryptedPassword: encryptedPassword,
+	})
+}
+
+func (c *Core) invalidateCredentialCache(ctx context.Context, username string) {
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_UpdateCredential,
+			SourceID: c.session.ServerID,
+		},
+		Username: username,
+	})
+}
+
+func (c *Core) refreshPolicyInfoCache(ctx context.Context) {
+	c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RefreshPolicyInfoCache,
+			SourceID: c.session.ServerID,
+		},
+	})
+}
+
+// CreateCredential create a new user, the password in the request is encoded by base64
+func (c *Core) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	password, err := crypto.Base64Decode(in.Password)
+	if err != nil {
+		log.Error("CreateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "CreateCredential failed: decode password failed: "+err.Error()), nil
+	}
+	encryptedPassword, err := crypto.PasswordEncrypt(password)
+	if err != nil {
+		log.Error("CreateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateCredential failed: encrypt password failed: "+err.Error()), nil
+	}
+	err = c.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+		Username:          in.Username,
+		EncryptedPassword: encryptedPassword,
+	})
+	if err != nil {
+		log.Error("CreateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateCredential failed: "+err.Error()), nil
+	}
+	log.Debug("CreateCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	return succStatus(), nil
+}
+
+// UpdateCredential update the password of a user, the old password must match
+func (c *Core) UpdateCredential(ctx context.Context, in *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("UpdateCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	err := func() error {
+		oldPassword, err := crypto.Base64Decode(in.OldPassword)
+		if err != nil {
+			return fmt.Errorf("decode old password failed: %w", err)
+		}
+		newPassword, err := crypto.Base64Decode(in.NewPassword)
+		if err != nil {
+			return fmt.Errorf("decode new password failed: %w", err)
+		}
+		cred, err := c.MetaTable.GetCredential(in.Username)
+		if err != nil {
+			return err
+		}
+		if !crypto.PasswordVerify(oldPassword, cred.EncryptedPassword) {
+			return fmt.Errorf("old password of user %s is not correct", in.Username)
+		}
+		encryptedPassword, err := crypto.PasswordEncrypt(newPassword)
+		if err != nil {
+			return fmt.Errorf("encrypt password failed: %w", err)
+		}
+		return c.MetaTable.UpdateCredential(&etcdpb.CredentialInfo{
+			Username:          in.Username,
+			EncryptedPassword: encryptedPassword,
+		})
+	}()
+	if err != nil {
+		log.Error("UpdateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "UpdateCredential failed: "+err.Error()), nil
+	}
+	c.invalidateCredentialCache(ctx, in.Username)
+	log.Debug("UpdateCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	return succStatus(), nil
+}
+
+// DeleteCredential delete a user and its role bindings
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DeleteCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	if err := c.MetaTable.DeleteCredential(in.Username); err != nil {
+		log.Error("DeleteCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DeleteCredential failed: "+err.Error()), nil
+	}
+	c.invalidateCredentialCache(ctx, in.Username)
+	c.refreshPolicyInfoCache(ctx)
+	log.Debug("DeleteCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	return succStatus(), nil
+}
+
+// ListCredUsers list the names of all users
+func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListCredUsersResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	return &milvuspb.ListCredUsersResponse{
+		Status:    succStatus(),
+		Usernames: c.MetaTable.ListCredentialUsernames(),
+	}, nil
+}
+
+// GetCredential get the encrypted password of a user
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	cred, err := c.MetaTable.GetCredential(in.Username)
+	if err != nil {
+		log.Warn("GetCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "GetCredential failed: "+err.Error()),
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status:            succStatus(),
+		Username:          cred.Username,
+		EncryptedPassword: cred.EncryptedPassword,
+	}, nil
+}
+
+// CreateRole create a new role
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateRole", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	if in.RoleName == "" {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "CreateRole failed: role name should not be empty"), nil
+	}
+	if err := c.MetaTable.CreateRole(in.RoleName); err != nil {
+		log.Error("CreateRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateRole failed: "+err.Error()), nil
+	}
+	log.Debug("CreateRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	return succStatus(), nil
+}
+
+// DropRole drop a role together with its user bindings and grants
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DropRole", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	if err := c.MetaTable.DropRole(in.RoleName); err != nil {
+		log.Error("DropRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropRole failed: "+err.Error()), nil
+	}
+	c.refreshPolicyInfoCache(ctx)
+	log.Debug("DropRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	return succStatus(), nil
+}
+
+// OperateUserRole add a user to a role or remove a user from a role
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("OperateUserRole", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username),
+		zap.String("role name", in.RoleName), zap.String("type", in.Type.String()))
+	var err error
+	switch in.Type {
+	case milvuspb.OperateUserRoleType_AddUserToRole:
+		err = c.MetaTable.AddUserToRole(in.Username, in.RoleName)
+	case milvuspb.OperateUserRoleType_RemoveUserFromRole:
+		err = c.MetaTable.RemoveUserFromRole(in.Username, in.RoleName)
+	default:
+		err = fmt.Errorf("invalid operate user role type %d", in.Type)
+	}
+	if err != nil {
+		log.Error("OperateUserRole failed", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username),
+			zap.String("role name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "OperateUserRole failed: "+err.Error()), nil
+	}
+	c.refreshPolicyInfoCache(ctx)
+	log.Debug("OperateUserRole success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username),
+		zap.String("role name", in.RoleName))
+	return succStatus(), nil
+}
+
+// SelectRole get a role or all roles, optionally with the users of the roles
+func (c *Core) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.SelectRoleResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	roles := []string{in.RoleName}
+	if in.RoleName == "" {
+		roles = c.MetaTable.ListRoles()
+	}
+	results := make([]*milvuspb.RoleResult, 0, len(roles))
+	for _, role := range roles {
+		usernames, err := c.MetaTable.GetRoleUsers(role)
+		if err != nil {
+			log.Warn("SelectRole failed", zap.String("role", typeutil.RootCoordRole),
+				zap.String("role name", role), zap.Error(err))
+			return &milvuspb.SelectRoleResponse{
+				Status: failStatus(commonpb.ErrorCode_UnexpectedError, "SelectRole failed: "+err.Error()),
+			}, nil
+		}
+		result := &milvuspb.RoleResult{RoleName: role}
+		if in.IncludeUserInfo {
+			result.Usernames = usernames
+		}
+		results = append(results, result)
+	}
+	return &milvuspb.SelectRoleResponse{
+		Status:  succStatus(),
+		Results: results,
+	}, nil
+}
+
+// SelectUser get a user or all users, optionally with the roles of the users
+func (c *Core) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.SelectUserResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	usernames := []string{in.Username}
+	if in.Username == "" {
+		usernames = c.MetaTable.ListCredentialUsernames()
+	}
+	results := make([]*milvuspb.UserResult, 0, len(usernames))
+	for _, username := range usernames {
+		roles, err := c.MetaTable.GetUserRoles(username)
+		if err != nil {
+			log.Warn("SelectUser failed", zap.String("role", typeutil.RootCoordRole),
+				zap.String("username", username), zap.Error(err))
+			return &milvuspb.SelectUserResponse{
+				Status: failStatus(commonpb.ErrorCode_UnexpectedError, "SelectUser failed: "+err.Error()),
+			}, nil
+		}
+		result := &milvuspb.UserResult{Username: username}
+		if in.IncludeRoleInfo {
+			result.RoleNames = roles
+		}
+		results = append(results, result)
+	}
+	return &milvuspb.SelectUserResponse{
+		Status:  succStatus(),
+		Results: results,
+	}, nil
+}
+
+// OperatePrivilege grant a privilege to a role or revoke a privilege from a role
+func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("OperatePrivilege", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity),
+		zap.String("type", in.Type.String()))
+	if err := ValidateGrantEntity(in.Entity); err != nil {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "OperatePrivilege failed: "+err.Error()), nil
+	}
+	grant := &etcdpb.GrantInfo{
+		RoleName:   in.Entity.RoleName,
+		ObjectType: in.Entity.ObjectType,
+		ObjectName: in.Entity.ObjectName,
+		Privilege:  in.Entity.Privilege,
+		Grantor:    in.Entity.Grantor,
+	}
+	var err error
+	switch in.Type {
+	case milvuspb.OperatePrivilegeType_Grant:
+		err = c.MetaTable.GrantPrivilege(grant)
+	case milvuspb.OperatePrivilegeType_Revoke:
+		err = c.MetaTable.RevokePrivilege(grant)
+	default:
+		err = fmt.Errorf("invalid operate privilege type %d", in.Type)
+	}
+	if err != nil {
+		log.Error("OperatePrivilege failed", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity),
+			zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "OperatePrivilege failed: "+err.Error()), nil
+	}
+	c.refreshPolicyInfoCache(ctx)
+	log.Debug("OperatePrivilege success", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity))
+	return succStatus(), nil
+}
+
+// SelectGrant list the grants of a role, the object type and the object name are optional filters
+func (c *Core) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.SelectGrantResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	if in.Entity == nil || in.Entity.RoleName == "" {
+		return &milvuspb.SelectGrantResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "SelectGrant failed: role name should not be empty"),
+		}, nil
+	}
+
+	grants, err := c.MetaTable.SelectGrant(in.Entity.RoleName, in.Entity.ObjectType, in.Entity.ObjectName)
+	if err != nil {
+		log.Warn("SelectGrant failed", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity),
+			zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "SelectGrant failed: "+err.Error()),
+		}, nil
+	}
+	entities := make([]*milvuspb.GrantEntity, 0, len(grants))
+	for _, grant := range grants {
+		entities = append(entities, GrantInfoToEntity(grant))
+	}
+	return &milvuspb.SelectGrantResponse{
+		Status:   succStatus(),
+		Entities: entities,
+	}, nil
+}
+
+// ListPolicy list all grants and the role bindings of all users
+func (c *Core) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListPolicyResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	grants, userRoles := c.MetaTable.ListPolicy()
+	rsp := &rootcoordpb.ListPolicyResponse{
+		Status:    succStatus(),
+		Grants:    make([]*milvuspb.GrantEntity, 0, len(grants)),
+		UserRoles: make([]*milvuspb.UserResult, 0, len(userRoles)),
+	}
+	for _, grant := range grants {
+		rsp.Grants = append(rsp.Grants, GrantInfoToEntity(grant))
+	}
+	for username, roles := range userRoles {
+		rsp.UserRoles = append(rsp.UserRoles, &milvuspb.UserResult{
+			Username:  username,
+			RoleNames: roles,
+		})
+	}
+	return rsp, nil
+}
@@ -35,6 +35,8 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/stretchr/testify/require"
@@ -91,6 +93,18 @@ func (p *proxyMock) ReleaseDQLMessageStream(ctx context.Context, request *proxyp
 	}, nil
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (p *proxyMock) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 type dataMock struct {
 	types.DataCoord
 	randVal int
@@ -341,8 +355,14 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 		chanNames[i] = ToPhysicalChannel(vchanNames[i])
 	}
 
+	db, err := core.MetaTable.GetDatabaseByName(dbName)
+	if err != nil {
+		return fmt.Errorf("get database error = %w", err)
+	}
+
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
+		DbId:                       db.ID,
 		Schema:                     &schema,
 		PartitionIDs:               []typeutil.UniqueID{partID},
 		PartitionNames:             []string{Params.CommonCfg.DefaultPartitionName},
@@ -371,7 +391,7 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 		DbName:               t.DbName,
 		CollectionName:       t.CollectionName,
 		PartitionName:        Params.CommonCfg.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		DbID:                 db.ID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -653,6 +673,15 @@ func TestRootCoord(t *testing.T) {
 	err = core.Register()
 	assert.Nil(t, err)
 
+	dbStatus, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_CreateDatabase,
+		},
+		DbName: dbName,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, dbStatus.ErrorCode)
+
 	time.Sleep(100 * time.Millisecond)
 	shardsNum := int32(8)
 
@@ -714,6 +743,7 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			Schema:         sbf,
 			ShardsNum:      shardsNum,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}},
 		}
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
@@ -721,8 +751,9 @@ func TestRootCoord(t *testing.T) {
 
 		assert.Equal(t, shardsNum, int32(core.chanTimeTick.getDmlChannelNum()))
 
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
+		assert.Equal(t, req.Properties, createMeta.Properties)
 		dmlStream.AsConsumer([]string{createMeta.PhysicalChannelNames[0]}, Params.RootCoordCfg.MsgChannelSubName)
 		dmlStream.Start()
 
@@ -867,7 +898,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -887,6 +918,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(rsp.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(rsp.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, rsp.ShardsNum)
+		assert.Equal(t, collMeta.Properties, rsp.Properties)
 	})
 
 	wg.Add(1)
@@ -927,7 +959,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -988,7 +1020,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partition", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -1011,7 +1043,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("show segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -1055,7 +1087,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -1066,7 +1098,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -1082,7 +1114,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -1148,7 +1180,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("flush segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -1206,7 +1238,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -1216,7 +1248,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -1246,7 +1278,7 @@ func TestRootCoord(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.CommonCfg.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName(dbName, collName, Params.CommonCfg.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -1259,7 +1291,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName(dbName, collName, Params.CommonCfg.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -1278,13 +1310,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -1325,7 +1357,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("remove DQL msgstream", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 
 		req := &proxypb.ReleaseDQLMessageStreamRequest{
@@ -1353,7 +1385,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -1896,6 +1928,190 @@ func TestRootCoord(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
+	wg.Add(1)
+	t.Run("database", func(t *testing.T) {
+		defer wg.Done()
+		const otherDBName = "testDb2"
+		rsp, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: otherDBName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		rsp, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: otherDBName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		listRsp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listRsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{common.DefaultDBName, dbName, otherDBName}, listRsp.DbNames)
+		assert.Equal(t, len(listRsp.DbNames), len(listRsp.CreatedTimestamps))
+
+		// the same collection name can be used in another database
+		rsp, err = core.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateCollection,
+			},
+			DbName:         otherDBName,
+			CollectionName: collName,
+			Schema:         sbf,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		coll1, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
+		assert.Nil(t, err)
+		coll2, err := core.MetaTable.GetCollectionByName(otherDBName, collName, 0)
+		assert.Nil(t, err)
+		assert.NotEqual(t, coll1.ID, coll2.ID)
+
+		rsp, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropDatabase,
+			},
+			DbName: common.DefaultDBName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// drop database drops all collections in it
+		rsp, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropDatabase,
+			},
+			DbName: otherDBName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		assert.False(t, core.MetaTable.HasCollection(coll2.ID, 0))
+		assert.True(t, core.MetaTable.HasCollection(coll1.ID, 0))
+		_, err = core.MetaTable.GetDatabaseByName(otherDBName)
+		assert.NotNil(t, err)
+	})
+
+	wg.Add(1)
+	t.Run("credential and rbac", func(t *testing.T) {
+		defer wg.Done()
+		const (
+			username = "user1"
+			roleName = "role1"
+		)
+		// the root user is created when core starts
+		credRsp, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: util.UserRoot})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, credRsp.Status.ErrorCode)
+		assert.True(t, crypto.PasswordVerify(util.DefaultRootPassword, credRsp.EncryptedPassword))
+
+		status, err := core.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Username: username,
+			Password: crypto.Base64Encode("password"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+			Username:    username,
+			OldPassword: crypto.Base64Encode("wrong_password"),
+			NewPassword: crypto.Base64Encode("password2"),
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+			Username:    username,
+			OldPassword: crypto.Base64Encode("password"),
+			NewPassword: crypto.Base64Encode("password2"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		credRsp, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: username})
+		assert.Nil(t, err)
+		assert.True(t, crypto.PasswordVerify("password2", credRsp.EncryptedPassword))
+
+		usersRsp, err := core.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{util.UserRoot, username}, usersRsp.Usernames)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{RoleName: roleName})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: username,
+			RoleName: roleName,
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		roleRsp, err := core.SelectRole(ctx, &milvuspb.SelectRoleRequest{RoleName: roleName, IncludeUserInfo: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roleRsp.Results))
+		assert.Equal(t, []string{username}, roleRsp.Results[0].Usernames)
+
+		userRsp, err := core.SelectUser(ctx, &milvuspb.SelectUserRequest{Username: username, IncludeRoleInfo: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(userRsp.Results))
+		assert.Equal(t, []string{roleName}, userRsp.Results[0].RoleNames)
+
+		entity := &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			ObjectType: util.ObjectTypeCollection,
+			ObjectName: collName,
+			Privilege:  util.PrivilegeSearch,
+			Grantor:    util.UserRoot,
+		}
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{RoleName: roleName, ObjectType: util.ObjectTypeCollection, ObjectName: collName, Privilege: "unknown"},
+			Type:   milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.ErrorCode)
+
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Entity: entity,
+			Type:   milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grantRsp, err := core.SelectGrant(ctx, &milvuspb.SelectGrantRequest{Entity: &milvuspb.GrantEntity{RoleName: roleName}})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(grantRsp.Entities))
+		assert.True(t, proto.Equal(entity, grantRsp.Entities[0]))
+
+		policyRsp, err := core.ListPolicy(ctx, &rootcoordpb.ListPolicyRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(policyRsp.Grants))
+		assert.Equal(t, 1, len(policyRsp.UserRoles))
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: roleName})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		policyRsp, err = core.ListPolicy(ctx, &rootcoordpb.ListPolicyRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(policyRsp.Grants))
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: util.UserRoot})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: username})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("create alias", func(t *testing.T) {
 		defer wg.Done()
@@ -1906,6 +2122,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 3012,
 				SourceID:  3012,
 			},
+			DbName:         dbName,
 			CollectionName: collName,
 			Alias:          aliasName,
 		}
@@ -1964,6 +2181,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 3015,
 				SourceID:  3015,
 			},
+			DbName:         dbName,
 			CollectionName: collName2,
 			Alias:          aliasName,
 		}
@@ -1972,6 +2190,45 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("add field", func(t *testing.T) {
+		defer wg.Done()
+		req := &milvuspb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				MsgID:     3018,
+				Timestamp: 3018,
+				SourceID:  3018,
+			},
+			DbName:         dbName,
+			CollectionName: aliasName,
+			Field: &schemapb.FieldSchema{
+				Name:     "field_added",
+				DataType: schemapb.DataType_Int64,
+			},
+		}
+		rsp, err := core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		req.Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+		rsp, err = core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// field names are unique
+		rsp, err = core.AddField(ctx, req)
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName2, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), collMeta.Schema.Version)
+		assert.Equal(t, 1, len(collMeta.Schema.Fields))
+		assert.Equal(t, int64(common.StartOfUserFieldID), collMeta.Schema.Fields[0].FieldID)
+		assert.Equal(t, "field_added", collMeta.Schema.Fields[0].Name)
+	})
+
 	wg.Add(1)
 	t.Run("drop collection with alias", func(t *testing.T) {
 		defer wg.Done()
@@ -1982,6 +2239,7 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 3016,
 				SourceID:  3016,
 			},
+			DbName:         dbName,
 			CollectionName: aliasName,
 		}
 		rsp, err := core.DropCollection(ctx, req)
@@ -1999,7 +2257,8 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 3017,
 				SourceID:  3017,
 			},
-			Alias: aliasName,
+			DbName: dbName,
+			Alias:  aliasName,
 		}
 		rsp, err := core.DropAlias(ctx, req)
 		assert.Nil(t, err)
@@ -2353,6 +2612,15 @@ func TestRootCoord2(t *testing.T) {
 	err = core.Register()
 	assert.Nil(t, err)
 
+	dbStatus, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_CreateDatabase,
+		},
+		DbName: dbName,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, dbStatus.ErrorCode)
+
 	m := map[string]interface{}{
 		"receiveBufSize": 1024,
 		"pulsarAddress":  Params.PulsarCfg.Address,
@@ -2401,7 +2669,7 @@ func TestRootCoord2(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		collInfo, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collInfo, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		dmlStream, _ := msFactory.NewMsgStream(ctx)
 		dmlStream.AsConsumer([]string{collInfo.PhysicalChannelNames[0]}, Params.RootCoordCfg.MsgChannelSubName)
@@ -2418,7 +2686,7 @@ func TestRootCoord2(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2634,6 +2902,15 @@ func TestCheckFlushedSegments(t *testing.T) {
 	err = core.Register()
 	assert.Nil(t, err)
 
+	dbStatus, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_CreateDatabase,
+		},
+		DbName: dbName,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, dbStatus.ErrorCode)
+
 	m := map[string]interface{}{
 		"receiveBufSize": 1024,
 		"pulsarAddress":  Params.PulsarCfg.Address,
@@ -2801,6 +3078,15 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	err = core.Register()
 	assert.Nil(t, err)
 
+	dbStatus, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_CreateDatabase,
+		},
+		DbName: dbName,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, dbStatus.ErrorCode)
+
 	m := map[string]interface{}{
 		"receiveBufSize": 1024,
 		"pulsarAddress":  Params.PulsarCfg.Address,
@@ -2821,7 +3107,7 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	createCollectionInMeta(dbName, collName, core, shardsNum, modifyFunc)
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName(dbName, collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -101,8 +101,24 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Req.ShardsNum <= 0 {
 		t.Req.ShardsNum = common.DefaultShardsNum
 	}
+	partitionNames := []string{Params.CommonCfg.DefaultPartitionName}
+	if typeutil.GetPartitionKeyField(&schema) != nil {
+		if t.Req.NumPartitions <= 0 {
+			t.Req.NumPartitions = common.DefaultPartitionsWithPartitionKey
+		}
+		if t.Req.NumPartitions > Params.RootCoordCfg.MaxPartitionNum {
+			return fmt.Errorf("maximum partition's number should be limit to %d", Params.RootCoordCfg.MaxPartitionNum)
+		}
+		partitionNames = make([]string, t.Req.NumPartitions)
+		for i := range partitionNames {
+			partitionNames[i] = typeutil.PartitionKeyPartitionName(Params.CommonCfg.DefaultPartitionName, int64(i))
+		}
+	} else if t.Req.NumPartitions > 0 {
+		return fmt.Errorf("num_partitions can only be set for a collection with partition key")
+	}
 	log.Debug("CreateCollectionReqTask Execute", zap.Any("CollectionName", t.Req.CollectionName),
 		zap.Int32("ShardsNum", t.Req.ShardsNum),
+		zap.Int("NumPartitions", len(partitionNames)),
 		zap.String("ConsistencyLevel", t.Req.ConsistencyLevel.String()))
 
 	for idx, field := range schema.Fields {
@@ -124,14 +140,23 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	}
 	schema.Fields = append(schema.Fields, rowIDField, timeStampField)
 
+	db, err := t.core.MetaTable.GetDatabaseByName(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+
 	collID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
 	}
-	partID, _, err := t.core.IDAllocator(1)
+	partID, _, err := t.core.IDAllocator(uint32(len(partitionNames)))
 	if err != nil {
 		return fmt.Errorf("alloc partition id error = %w", err)
 	}
+	partIDs := make([]typeutil.UniqueID, len(partitionNames))
+	for i := range partIDs {
+		partIDs[i] = partID + int64(i)
+	}
 
 	log.Debug("collection name -> id",
 		zap.String("collection name", t.Req.CollectionName),
@@ -155,14 +180,16 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	collInfo := etcdpb.CollectionInfo{
 		ID:                         collID,
 		Schema:                     &schema,
-		PartitionIDs:               []typeutil.UniqueID{partID},
-		PartitionNames:             []string{Params.CommonCfg.DefaultPartitionName},
+		PartitionIDs:               partIDs,
+		PartitionNames:             partitionNames,
 		FieldIndexes:               make([]*etcdpb.FieldIndexInfo, 0, 16),
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  t.Req.ShardsNum,
-		PartitionCreatedTimestamps: []uint64{0},
+		PartitionCreatedTimestamps: make([]uint64, len(partIDs)),
 		ConsistencyLevel:           t.Req.ConsistencyLevel,
+		DbId:                       db.ID,
+		Properties:                 t.Req.Properties,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -178,8 +205,8 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		Base:                 t.Req.Base,
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
-		PartitionName:        Params.CommonCfg.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		PartitionName:        partitionNames[0],
+		DbID:                 db.ID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -270,11 +297,11 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropCollection {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
 		return fmt.Errorf("cannot drop the collection via alias = %s", t.Req.CollectionName)
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -283,7 +310,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		Base:           t.Req.Base,
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
-		DbID:           0, //not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 	}
 
@@ -302,7 +329,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 
 	// drop all indices
-	if err = t.core.RemoveIndex(ctx, t.Req.CollectionName, ""); err != nil {
+	if err = t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, ""); err != nil {
 		return err
 	}
 
@@ -359,8 +386,8 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
-	t.core.ExpireMetaCache(ctx, aliases, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, aliases, ts)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -383,7 +410,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -413,7 +440,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -433,6 +460,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	}
 	t.Rsp.ShardsNum = collInfo.ShardsNum
 	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
+	t.Rsp.Properties = collInfo.Properties
 
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
@@ -459,7 +487,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -473,6 +501,53 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+// AddFieldReqTask add field request task
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+// Type return msg type
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	field := t.Req.GetField()
+	if field == nil {
+		return fmt.Errorf("field schema is empty")
+	}
+	if field.IsPrimaryKey || field.AutoID || field.IsPartitionKey || field.IsClusteringKey {
+		return fmt.Errorf("primary key, auto id, partition key or clustering key field %s can't be added to an existing collection", field.Name)
+	}
+	if err := typeutil.CheckDefaultValue(field); err != nil {
+		return err
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.AddField(collMeta.ID, field, ts); err != nil {
+		return fmt.Errorf("meta table add field failed, error = %w", err)
+	}
+
+	collNames := []string{collMeta.Schema.Name}
+	if t.Req.CollectionName != collMeta.Schema.Name {
+		collNames = append(collNames, t.Req.CollectionName)
+	}
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, collNames, ts)
+	return nil
+}
+
 // CreatePartitionReqTask create partition request task
 type CreatePartitionReqTask struct {
 	baseReqTask
@@ -489,10 +564,13 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyField(collMeta.Schema) != nil {
+		return fmt.Errorf("can't create partition in collection %s with partition key", t.Req.CollectionName)
+	}
 	partID, _, err := t.core.IDAllocator(1)
 	if err != nil {
 		return err
@@ -503,7 +581,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, // todo, not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 		PartitionID:    partID,
 	}
@@ -551,7 +629,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -573,10 +651,13 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
+	if typeutil.GetPartitionKeyField(collInfo.Schema) != nil {
+		return fmt.Errorf("can't drop partition in collection %s with partition key", t.Req.CollectionName)
+	}
 	partID, err := t.core.MetaTable.GetPartitionByName(collInfo.ID, t.Req.PartitionName, 0)
 	if err != nil {
 		return err
@@ -587,7 +668,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, //todo,not used
+		DbID:           collInfo.DbId,
 		CollectionID:   collInfo.ID,
 		PartitionID:    partID,
 	}
@@ -635,7 +716,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
 
 	//notify query service to release partition
 	// TODO::xige-16, reOpen when queryCoord support release partitions after load collection
@@ -665,7 +746,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -695,7 +776,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -832,7 +913,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -846,7 +927,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -896,7 +977,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -933,10 +1014,10 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if err := t.core.RemoveIndex(ctx, t.Req.CollectionName, t.Req.IndexName); err != nil {
+	if err := t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, t.Req.IndexName); err != nil {
 		return err
 	}
-	_, _, err := t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
+	_, _, err := t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
 	return err
 }
 
@@ -961,7 +1042,7 @@ func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AddAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table add alias failed, error = %w", err)
 	}
@@ -990,12 +1071,12 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.DropAlias(t.Req.Alias, ts)
+	err = t.core.MetaTable.DropAlias(t.Req.DbName, t.Req.Alias, ts)
 	if err != nil {
 		return fmt.Errorf("meta table drop alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.Alias}, ts)
 
 	return nil
 }
@@ -1021,12 +1102,125 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AlterAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table alter alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.Alias}, ts)
+
+	return nil
+}
+
+// CreateDatabaseReqTask create database request task
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+// Type return msg type
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" {
+		return fmt.Errorf("database name should not be empty")
+	}
+
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.CreateDatabase(&etcdpb.DatabaseInfo{ID: dbID, Name: t.Req.DbName}, ts)
+	if err != nil {
+		return fmt.Errorf("meta table create database failed, error = %w", err)
+	}
+	return nil
+}
+
+// DropDatabaseReqTask drop database request task
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+// Type return msg type
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution, all collections of the database are dropped before the database itself,
+// so a failed drop can simply be retried
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" || t.Req.DbName == common.DefaultDBName {
+		return fmt.Errorf("default database cannot be dropped")
+	}
+
+	colls, err := t.core.MetaTable.ListCollections(t.Req.DbName, 0)
+	if err != nil {
+		return err
+	}
+	for collName := range colls {
+		dropCollTask := &DropCollectionReqTask{
+			baseReqTask: t.baseReqTask,
+			Req: &milvuspb.DropCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_DropCollection,
+					MsgID:    t.Req.Base.MsgID,
+					SourceID: t.Req.Base.SourceID,
+				},
+				DbName:         t.Req.DbName,
+				CollectionName: collName,
+			},
+		}
+		if err = dropCollTask.Execute(ctx); err != nil {
+			return fmt.Errorf("drop collection %s of database %s failed, error = %w", collName, t.Req.DbName, err)
+		}
+	}
 
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.DropDatabase(t.Req.DbName, ts)
+	if err != nil {
+		return fmt.Errorf("meta table drop database failed, error = %w", err)
+	}
+	return nil
+}
+
+// ListDatabasesReqTask list databases request task
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+// Type return msg type
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, db := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, db.CreateTime)
+	}
 	return nil
 }
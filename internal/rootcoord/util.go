@@ -24,7 +24,9 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -157,3 +159,37 @@ func ConvertChannelName(chanName string, tokenFrom string, tokenTo string) (stri
 	}
 	return "", fmt.Errorf("cannot find token '%s' in '%s'", tokenFrom, chanName)
 }
+
+// ValidateGrantEntity check whether the object type and the privilege of the grant are valid
+func ValidateGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return fmt.Errorf("grant entity should not be empty")
+	}
+	if entity.RoleName == "" {
+		return fmt.Errorf("role name should not be empty")
+	}
+	if entity.ObjectName == "" {
+		return fmt.Errorf("object name should not be empty")
+	}
+	privileges, ok := util.ObjectPrivileges[entity.ObjectType]
+	if !ok {
+		return fmt.Errorf("invalid object type %s", entity.ObjectType)
+	}
+	for _, privilege := range privileges {
+		if privilege == entity.Privilege {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid privilege %s of object type %s", entity.Privilege, entity.ObjectType)
+}
+
+// GrantInfoToEntity converts the grant meta into the grant entity of the milvus api
+func GrantInfoToEntity(grant *etcdpb.GrantInfo) *milvuspb.GrantEntity {
+	return &milvuspb.GrantEntity{
+		RoleName:   grant.RoleName,
+		ObjectType: grant.ObjectType,
+		ObjectName: grant.ObjectName,
+		Privilege:  grant.Privilege,
+		Grantor:    grant.Grantor,
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -156,3 +158,33 @@ func Test_ConvertChannelName(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, deltaChanName, str)
 }
+
+func Test_ValidateGrantEntity(t *testing.T) {
+	assert.NotNil(t, ValidateGrantEntity(nil))
+	assert.NotNil(t, ValidateGrantEntity(&milvuspb.GrantEntity{}))
+	assert.NotNil(t, ValidateGrantEntity(&milvuspb.GrantEntity{RoleName: "role"}))
+	assert.NotNil(t, ValidateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role",
+		ObjectType: "unknown",
+		ObjectName: "coll",
+		Privilege:  util.PrivilegeInsert,
+	}))
+	assert.NotNil(t, ValidateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role",
+		ObjectType: util.ObjectTypeGlobal,
+		ObjectName: util.AnyWord,
+		Privilege:  util.PrivilegeInsert,
+	}))
+	assert.Nil(t, ValidateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "coll",
+		Privilege:  util.PrivilegeInsert,
+	}))
+	assert.Nil(t, ValidateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role",
+		ObjectType: util.ObjectTypeGlobal,
+		ObjectName: util.AnyWord,
+		Privilege:  util.PrivilegeAll,
+	}))
+}
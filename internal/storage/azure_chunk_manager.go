@@ -0,0 +1,540 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// azureAPIVersion is the version of the Blob service REST API
+	azureAPIVersion = "2020-04-08"
+	// azureMaxBlockSize is the maximum size of a block of the block blobs
+	azureMaxBlockSize = 4000 * 1024 * 1024
+)
+
+// AzureOption is the option to connect to Azure Blob Storage.
+type AzureOption struct {
+	// Address is the endpoint of the blob service, https://<AccountName>.blob.core.windows.net if it's empty
+	Address         string
+	AccountName     string
+	AccountKey      string
+	ContainerName   string
+	CreateContainer bool
+
+	// PartSize is the size of each block of the blobs, the blobs not larger than it are uploaded at once
+	PartSize int64
+	// RetryAttempts and RetrySleep are the attempts and the initial backoff of each request
+	RetryAttempts uint
+	RetrySleep    time.Duration
+}
+
+// AzureChunkManager is responsible for read and write data stored in Azure Blob Storage.
+// The large files are uploaded block by block, each block and the small files are verified by their md5 checksums.
+type AzureChunkManager struct {
+	ctx          context.Context
+	client       *http.Client
+	containerURL *url.URL
+	accountName  string
+	accountKey   []byte
+	partSize     int64
+	retryOpts    []retry.Option
+}
+
+// azureError is the error responded by the blob service.
+type azureError struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *azureError) Error() string {
+	return fmt.Sprintf("azure blob request failed, status: %d, code: %s, message: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// NewAzureChunkManager creates a new AzureChunkManager, the container is created if it doesn't exist and CreateContainer is set.
+func NewAzureChunkManager(ctx context.Context, option *AzureOption) (*AzureChunkManager, error) {
+	address := option.Address
+	if address == "" {
+		address = fmt.Sprintf("https://%s.blob.core.windows.net", option.AccountName)
+	}
+	endpoint, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(option.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure account key: %w", err)
+	}
+
+	partSize := option.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize < 0 || partSize > azureMaxBlockSize {
+		return nil, fmt.Errorf("part size %d is out of the range (0, %d]", partSize, azureMaxBlockSize)
+	}
+
+	containerURL := *endpoint
+	containerURL.Path = strings.TrimSuffix(endpoint.Path, "/") + "/" + option.ContainerName
+	containerURL.RawPath = ""
+
+	cm := &AzureChunkManager{
+		ctx:          ctx,
+		client:       &http.Client{},
+		containerURL: &containerURL,
+		accountName:  option.AccountName,
+		accountKey:   key,
+		partSize:     partSize,
+		retryOpts:    retryOptions(option.RetryAttempts, option.RetrySleep),
+	}
+
+	err = cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodHead, cm.containerURL, url.Values{"restype": {"container"}}, nil, nil)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+		if !isAzureNotFound(err) {
+			return err
+		}
+		if !option.CreateContainer {
+			return retry.Unrecoverable(fmt.Errorf("container %s not Existed", option.ContainerName))
+		}
+		resp, err = cm.do(http.MethodPut, cm.containerURL, url.Values{"restype": {"container"}}, nil, nil)
+		if err != nil {
+			var azureErr *azureError
+			// created by others at the same time
+			if errors.As(err, &azureErr) && azureErr.Code == "ContainerAlreadyExists" {
+				return nil
+			}
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// isAzureRetryable tells whether the failed request might succeed if sent again,
+// the requests failed without responses, like the network errors, are retryable.
+func isAzureRetryable(err error) bool {
+	if retry.IsUncoverable(err) {
+		return false
+	}
+	var azureErr *azureError
+	if errors.As(err, &azureErr) {
+		return isRetryableStatus(azureErr.StatusCode)
+	}
+	return true
+}
+
+func isAzureNotFound(err error) bool {
+	var azureErr *azureError
+	return errors.As(err, &azureErr) && azureErr.StatusCode == http.StatusNotFound
+}
+
+func (cm *AzureChunkManager) withRetry(fn func() error) error {
+	return doWithRetry(cm.ctx, fn, isAzureRetryable, cm.retryOpts...)
+}
+
+func (cm *AzureChunkManager) blobURL(key string) *url.URL {
+	u := *cm.containerURL
+	u.Path = u.Path + "/" + key
+	u.RawPath = ""
+	return &u
+}
+
+// do sends the request signed by the account key, the responses of the failed requests are returned as azureError.
+func (cm *AzureChunkManager) do(method string, u *url.URL, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	reqURL := *u
+	reqURL.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(cm.ctx, method, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("Authorization", "SharedKey "+cm.accountName+":"+azureSharedKeySignature(cm.accountName, cm.accountKey, req))
+
+	resp, err := cm.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	azureErr := &azureError{}
+	if data, err := ioutil.ReadAll(resp.Body); err == nil && len(data) > 0 {
+		_ = xml.Unmarshal(data, azureErr)
+	}
+	azureErr.StatusCode = resp.StatusCode
+	if azureErr.Code == "" {
+		azureErr.Code = resp.Header.Get("x-ms-error-code")
+	}
+	return nil, azureErr
+}
+
+// azureSharedKeySignature returns the Shared Key signature of the request, see
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func azureSharedKeySignature(accountName string, accountKey []byte, req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+	for name := range req.Header {
+		if name = strings.ToLower(name); strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name)
+		}
+	}
+	sort.Strings(msHeaders)
+	var canonicalized strings.Builder
+	for _, name := range msHeaders {
+		canonicalized.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+
+	canonicalized.WriteString("/" + accountName + req.URL.EscapedPath())
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for name := range query {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		values := query[name]
+		sort.Strings(values)
+		canonicalized.WriteString("\n" + strings.ToLower(name) + ":" + strings.Join(values, ","))
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is signed instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalized.String(),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// GetPath returns the path of the blob if exists.
+func (cm *AzureChunkManager) GetPath(key string) (string, error) {
+	if !cm.Exist(key) {
+		return "", errors.New("azure file manage cannot be found with key:" + key)
+	}
+	return key, nil
+}
+
+// Size returns the size of the blob.
+func (cm *AzureChunkManager) Size(key string) (int64, error) {
+	var size int64
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodHead, cm.blobURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		size = resp.ContentLength
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Write uploads the content as a block blob, block by block if it's larger than the part size.
+func (cm *AzureChunkManager) Write(key string, content []byte) error {
+	if int64(len(content)) > cm.partSize {
+		return cm.uploadBlocks(key, content)
+	}
+	return cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("x-ms-blob-type", "BlockBlob")
+		header.Set("Content-MD5", md5Base64(content))
+		resp, err := cm.do(http.MethodPut, cm.blobURL(key), nil, header, content)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+type azureBlockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+func (cm *AzureChunkManager) uploadBlocks(key string, content []byte) error {
+	size := int64(len(content))
+	blockList := azureBlockList{}
+	for start := int64(0); start < size; start += cm.partSize {
+		end := start + cm.partSize
+		if end > size {
+			end = size
+		}
+		data := content[start:end]
+		// the ids of the blocks of a blob have to be in the same length
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%010d", len(blockList.Latest))))
+		err := cm.withRetry(func() error {
+			header := http.Header{}
+			header.Set("Content-MD5", md5Base64(data))
+			resp, err := cm.do(http.MethodPut, cm.blobURL(key), url.Values{"comp": {"block"}, "blockid": {blockID}}, header, data)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			return nil
+		})
+		if err != nil {
+			// the uncommitted blocks are garbage collected by the blob service
+			return err
+		}
+		blockList.Latest = append(blockList.Latest, blockID)
+	}
+
+	body, err := xml.Marshal(blockList)
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+	return cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("Content-Type", "application/xml")
+		header.Set("x-ms-blob-content-md5", md5Base64(content))
+		resp, err := cm.do(http.MethodPut, cm.blobURL(key), url.Values{"comp": {"blocklist"}}, header, body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+// MultiWrite writes the contents as the blobs, it stops at the first failure.
+func (cm *AzureChunkManager) MultiWrite(contents map[string][]byte) error {
+	for key, content := range contents {
+		if err := cm.Write(key, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exist checks whether the blob exists.
+func (cm *AzureChunkManager) Exist(key string) bool {
+	_, err := cm.Size(key)
+	return err == nil
+}
+
+// Read downloads the whole blob, which is verified by its md5 checksum.
+func (cm *AzureChunkManager) Read(key string) ([]byte, error) {
+	var data []byte
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodGet, cm.blobURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		data, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if checksum := resp.Header.Get("Content-MD5"); checksum != "" && checksum != md5Base64(data) {
+			return fmt.Errorf("checksum mismatched of %s, content-md5: %s, checksum: %s", key, checksum, md5Base64(data))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// MultiRead downloads the blobs, it fails if any of them cannot be read.
+func (cm *AzureChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		data, err := cm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, data)
+	}
+	return results, nil
+}
+
+type azureBlobList struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			LastModified string `xml:"Last-Modified"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+// ListWithPrefix returns the keys and the last modified time of all the blobs start with @prefix.
+func (cm *AzureChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	marker := ""
+	for {
+		list := azureBlobList{}
+		err := cm.withRetry(func() error {
+			query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {prefix}}
+			if marker != "" {
+				query.Set("marker", marker)
+			}
+			resp, err := cm.do(http.MethodGet, cm.containerURL, query, nil, nil)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return err
+			}
+			return xml.Unmarshal(data, &list)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, blob := range list.Blobs {
+			modTime, err := time.Parse(http.TimeFormat, blob.Properties.LastModified)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, blob.Name)
+			modTimes = append(modTimes, modTime)
+		}
+		if list.NextMarker == "" {
+			return keys, modTimes, nil
+		}
+		marker = list.NextMarker
+	}
+}
+
+// ReadAt downloads the range of the blob starting at @off into p,
+// io.EOF is returned if the blob ends before p is filled.
+func (cm *AzureChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("AzureChunkManager: invalid offset")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var n int
+	err := cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("x-ms-range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+		resp, err := cm.do(http.MethodGet, cm.blobURL(key), nil, header, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		n, err = io.ReadFull(resp.Body, p)
+		if (err == io.EOF || err == io.ErrUnexpectedEOF) && int64(n) == resp.ContentLength {
+			// the blob ends before p is filled, otherwise the response is truncated and read again
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		var azureErr *azureError
+		if errors.As(err, &azureErr) && azureErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Remove deletes the blob, it's not an error if the blob doesn't exist.
+func (cm *AzureChunkManager) Remove(key string) error {
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodDelete, cm.blobURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+	if isAzureNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MultiRemove deletes the blobs, it stops at the first failure.
+func (cm *AzureChunkManager) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		if err := cm.Remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWithPrefix deletes all the blobs start with @prefix.
+func (cm *AzureChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, _, err := cm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return cm.MultiRemove(keys)
+}
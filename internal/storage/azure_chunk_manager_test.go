@@ -0,0 +1,551 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAzureAccountName = "devaccount"
+	testAzureContainer   = "test-container"
+)
+
+var testAzureAccountKey = base64.StdEncoding.EncodeToString([]byte("devaccount-key"))
+
+type fakeAzureBlob struct {
+	data       []byte
+	contentMD5 string
+	modTime    time.Time
+}
+
+// fakeAzureServer is an in-memory server of the part of the Blob service REST API used by the AzureChunkManager,
+// the requests are addressed like the storage emulator does, http://<host>/<account>/<container>/<blob>
+type fakeAzureServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	containers map[string]map[string]*fakeAzureBlob
+	blocks     map[string]map[string][]byte
+	requests   []fakeRequest
+
+	// failures is the number of the following requests responded with 503 Server Busy
+	failures int
+	// corruptions is the number of the following blobs responded with a corrupted byte
+	corruptions int
+	// listPageSize limits the blobs listed in each response if it's not zero
+	listPageSize int
+}
+
+func newFakeAzureServer() *fakeAzureServer {
+	s := &fakeAzureServer{
+		containers: make(map[string]map[string]*fakeAzureBlob),
+		blocks:     make(map[string]map[string][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeAzureServer) address() string {
+	return s.URL + "/" + testAzureAccountName
+}
+
+// operations returns the operations of the received requests
+func (s *fakeAzureServer) operations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	operations := make([]string, 0, len(s.requests))
+	for _, req := range s.requests {
+		operations = append(operations, req.operation)
+	}
+	return operations
+}
+
+func (s *fakeAzureServer) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *fakeAzureServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"+testAzureAccountName+"/"), "/", 2)
+	containerName, blobName := path[0], ""
+	if len(path) > 1 {
+		blobName = path[1]
+	}
+	operation := azureOperation(r.Method, blobName, query)
+	s.requests = append(s.requests, fakeRequest{operation: operation, header: r.Header.Clone()})
+
+	key, _ := base64.StdEncoding.DecodeString(testAzureAccountKey)
+	if r.Header.Get("Authorization") != "SharedKey "+testAzureAccountName+":"+azureSharedKeySignature(testAzureAccountName, key, r) {
+		writeAzureError(w, r, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+	if s.failures > 0 {
+		s.failures--
+		writeAzureError(w, r, http.StatusServiceUnavailable, "ServerBusy")
+		return
+	}
+
+	container, ok := s.containers[containerName]
+	if operation == "CreateContainer" {
+		if ok {
+			writeAzureError(w, r, http.StatusConflict, "ContainerAlreadyExists")
+			return
+		}
+		s.containers[containerName] = make(map[string]*fakeAzureBlob)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if !ok {
+		writeAzureError(w, r, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	blobPath := containerName + "/" + blobName
+	switch operation {
+	case "GetContainerProperties":
+	case "ListBlobs":
+		s.listBlobs(w, container, query)
+	case "PutBlob":
+		data, ok := readAzureBody(w, r)
+		if !ok {
+			return
+		}
+		container[blobName] = &fakeAzureBlob{data: data, contentMD5: r.Header.Get("Content-MD5"), modTime: time.Now()}
+		w.WriteHeader(http.StatusCreated)
+	case "PutBlock":
+		data, ok := readAzureBody(w, r)
+		if !ok {
+			return
+		}
+		if s.blocks[blobPath] == nil {
+			s.blocks[blobPath] = make(map[string][]byte)
+		}
+		s.blocks[blobPath][query.Get("blockid")] = data
+		w.WriteHeader(http.StatusCreated)
+	case "PutBlockList":
+		blockList := azureBlockList{}
+		if err := xml.NewDecoder(r.Body).Decode(&blockList); err != nil {
+			writeAzureError(w, r, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		var data []byte
+		for _, blockID := range blockList.Latest {
+			block, ok := s.blocks[blobPath][blockID]
+			if !ok {
+				writeAzureError(w, r, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			data = append(data, block...)
+		}
+		delete(s.blocks, blobPath)
+		container[blobName] = &fakeAzureBlob{data: data, contentMD5: r.Header.Get("x-ms-blob-content-md5"), modTime: time.Now()}
+		w.WriteHeader(http.StatusCreated)
+	case "GetBlob", "GetBlobProperties":
+		s.getBlob(w, r, container, blobName)
+	case "DeleteBlob":
+		if _, ok := container[blobName]; !ok {
+			writeAzureError(w, r, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(container, blobName)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeAzureError(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func azureOperation(method string, blobName string, query map[string][]string) string {
+	comp := ""
+	if values := query["comp"]; len(values) > 0 {
+		comp = values[0]
+	}
+	if blobName == "" {
+		switch {
+		case method == http.MethodPut:
+			return "CreateContainer"
+		case method == http.MethodHead:
+			return "GetContainerProperties"
+		case comp == "list":
+			return "ListBlobs"
+		default:
+			return "GetContainerProperties"
+		}
+	}
+	switch method {
+	case http.MethodPut:
+		switch comp {
+		case "block":
+			return "PutBlock"
+		case "blocklist":
+			return "PutBlockList"
+		default:
+			return "PutBlob"
+		}
+	case http.MethodDelete:
+		return "DeleteBlob"
+	case http.MethodHead:
+		return "GetBlobProperties"
+	default:
+		return "GetBlob"
+	}
+}
+
+func writeAzureError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+// readAzureBody reads the body, which is verified by the Content-MD5 header
+func readAzureBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeAzureError(w, r, http.StatusBadRequest, "InvalidInput")
+		return nil, false
+	}
+	if checksum := r.Header.Get("Content-MD5"); checksum != "" && checksum != md5Base64(data) {
+		writeAzureError(w, r, http.StatusBadRequest, "Md5Mismatch")
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *fakeAzureServer) listBlobs(w http.ResponseWriter, container map[string]*fakeAzureBlob, query map[string][]string) {
+	type blob struct {
+		Name         string
+		LastModified string `xml:"Properties>Last-Modified"`
+	}
+	result := struct {
+		XMLName    xml.Name `xml:"EnumerationResults"`
+		Prefix     string
+		Blobs      []blob `xml:"Blobs>Blob"`
+		NextMarker string
+	}{}
+	prefix, marker := "", ""
+	if values := query["prefix"]; len(values) > 0 {
+		prefix = values[0]
+	}
+	if values := query["marker"]; len(values) > 0 {
+		marker = values[0]
+	}
+
+	names := make([]string, 0, len(container))
+	for name := range container {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if s.listPageSize > 0 && len(names) > s.listPageSize {
+		result.NextMarker = names[s.listPageSize]
+		names = names[:s.listPageSize]
+	}
+	result.Prefix = prefix
+	for _, name := range names {
+		result.Blobs = append(result.Blobs, blob{
+			Name:         name,
+			LastModified: container[name].modTime.UTC().Format(http.TimeFormat),
+		})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (s *fakeAzureServer) getBlob(w http.ResponseWriter, r *http.Request, container map[string]*fakeAzureBlob, blobName string) {
+	blob, ok := container[blobName]
+	if !ok {
+		writeAzureError(w, r, http.StatusNotFound, "BlobNotFound")
+		return
+	}
+	data, status := blob.data, http.StatusOK
+	if rng := r.Header.Get("x-ms-range"); rng != "" {
+		var start, end int
+		if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil {
+			writeAzureError(w, r, http.StatusBadRequest, "InvalidHeaderValue")
+			return
+		}
+		if start >= len(data) {
+			writeAzureError(w, r, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+		if end >= len(data) {
+			end = len(data) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		data, status = data[start:end+1], http.StatusPartialContent
+	} else if blob.contentMD5 != "" {
+		w.Header().Set("Content-MD5", blob.contentMD5)
+	}
+	if r.Method == http.MethodGet && s.corruptions > 0 && len(data) > 0 {
+		s.corruptions--
+		data = append([]byte{data[0] + 1}, data[1:]...)
+	}
+	w.Header().Set("Last-Modified", blob.modTime.UTC().Format(http.TimeFormat))
+	w.Header().Set("x-ms-blob-type", "BlockBlob")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+func newTestAzureChunkManager(t *testing.T, server *fakeAzureServer, option AzureOption) *AzureChunkManager {
+	option.Address = server.address()
+	option.AccountName = testAzureAccountName
+	option.AccountKey = testAzureAccountKey
+	option.ContainerName = testAzureContainer
+	option.CreateContainer = true
+	option.RetrySleep = time.Millisecond
+	cm, err := NewAzureChunkManager(context.TODO(), &option)
+	require.NoError(t, err)
+	return cm
+}
+
+func TestNewAzureChunkManager(t *testing.T) {
+	server := newFakeAzureServer()
+	defer server.Close()
+
+	option := &AzureOption{
+		Address:       server.address(),
+		AccountName:   testAzureAccountName,
+		AccountKey:    testAzureAccountKey,
+		ContainerName: testAzureContainer,
+	}
+	_, err := NewAzureChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	assert.Empty(t, server.containers)
+
+	option.CreateContainer = true
+	_, err = NewAzureChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+	assert.Contains(t, server.containers, testAzureContainer)
+
+	option.CreateContainer = false
+	_, err = NewAzureChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+
+	option.PartSize = -1
+	_, err = NewAzureChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	option.PartSize = 0
+
+	option.AccountKey = "not base64"
+	_, err = NewAzureChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+
+	// the wrong key is rejected without retries
+	option.AccountKey = base64.StdEncoding.EncodeToString([]byte("wrong-key"))
+	option.RetryAttempts = 3
+	server.reset()
+	_, err = NewAzureChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"GetContainerProperties"}, server.operations())
+}
+
+func TestAzureChunkManager(t *testing.T) {
+	server := newFakeAzureServer()
+	defer server.Close()
+	cm := newTestAzureChunkManager(t, server, AzureOption{})
+
+	t.Run("read and write", func(t *testing.T) {
+		err := cm.Write("a/b/1", []byte("value1"))
+		require.NoError(t, err)
+		assert.True(t, cm.Exist("a/b/1"))
+		size, err := cm.Size("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), size)
+		value, err := cm.Read("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value1"), value)
+		p, err := cm.GetPath("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "a/b/1", p)
+
+		err = cm.MultiWrite(map[string][]byte{"a/b/2": []byte("value2"), "a/c/3": []byte("value3")})
+		assert.NoError(t, err)
+		values, err := cm.MultiRead([]string{"a/b/2", "a/c/3"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("value2"), []byte("value3")}, values)
+
+		assert.False(t, cm.Exist("a/b/4"))
+		_, err = cm.Size("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.Read("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.GetPath("a/b/4")
+		assert.Error(t, err)
+		values, err = cm.MultiRead([]string{"a/b/1", "a/b/4"})
+		assert.Error(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("read at", func(t *testing.T) {
+		p := make([]byte, 3)
+		n, err := cm.ReadAt("a/b/1", p, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []byte("alu"), p)
+
+		n, err = cm.ReadAt("a/b/1", p, 4)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []byte("e1"), p[:n])
+
+		n, err = cm.ReadAt("a/b/1", p, 6)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 0, n)
+
+		_, err = cm.ReadAt("a/b/1", p, -1)
+		assert.Error(t, err)
+		_, err = cm.ReadAt("a/b/4", p, 0)
+		assert.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("list and remove", func(t *testing.T) {
+		keys, modTimes, err := cm.ListWithPrefix("a/b/")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2"}, keys)
+		assert.Len(t, modTimes, 2)
+		assert.WithinDuration(t, time.Now(), modTimes[0], time.Minute)
+
+		server.listPageSize = 1
+		keys, _, err = cm.ListWithPrefix("a/")
+		server.listPageSize = 0
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2", "a/c/3"}, keys)
+
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+		assert.False(t, cm.Exist("a/b/1"))
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+
+		err = cm.MultiRemove([]string{"a/b/2", "a/b/4"})
+		assert.NoError(t, err)
+		err = cm.RemoveWithPrefix("a/")
+		assert.NoError(t, err)
+		keys, _, err = cm.ListWithPrefix("a/")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestAzureChunkManager_BlockUpload(t *testing.T) {
+	server := newFakeAzureServer()
+	defer server.Close()
+	cm := newTestAzureChunkManager(t, server, AzureOption{PartSize: 1024})
+
+	data := make([]byte, 2500)
+	rand.Read(data)
+	server.reset()
+	err := cm.Write("index/1", data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PutBlock", "PutBlock", "PutBlock", "PutBlockList"}, server.operations())
+	assert.Empty(t, server.blocks)
+
+	value, err := cm.Read("index/1")
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(data, value))
+
+	// across the blocks
+	p := make([]byte, 100)
+	n, err := cm.ReadAt("index/1", p, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, n)
+	assert.Equal(t, data[1000:1100], p)
+
+	// the blob is not committed if a block fails
+	server.failures = 5
+	err = cm.Write("index/2", data)
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("index/2"))
+}
+
+func TestAzureChunkManager_Retry(t *testing.T) {
+	server := newFakeAzureServer()
+	defer server.Close()
+	cm := newTestAzureChunkManager(t, server, AzureOption{RetryAttempts: 3})
+
+	server.failures = 2
+	err := cm.Write("a/1", []byte("value"))
+	assert.NoError(t, err)
+
+	server.failures = 3
+	err = cm.Write("a/2", []byte("value"))
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("a/2"))
+
+	server.failures = 2
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// the missing blob is not retried
+	server.reset()
+	_, err = cm.Read("a/2")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"GetBlob"}, server.operations())
+}
+
+func TestAzureChunkManager_Checksum(t *testing.T) {
+	server := newFakeAzureServer()
+	defer server.Close()
+	cm := newTestAzureChunkManager(t, server, AzureOption{RetryAttempts: 2})
+
+	err := cm.Write("a/1", []byte("value"))
+	require.NoError(t, err)
+
+	server.corruptions = 1
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	server.corruptions = 2
+	_, err = cm.Read("a/1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatched")
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"sync/atomic"
 
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 )
 
@@ -43,7 +44,7 @@ type Iterator interface {
 // Value is the return value of Next
 type Value struct {
 	ID        int64
-	PK        int64
+	PK        PrimaryKey
 	Timestamp int64
 	IsDeleted bool
 	Value     interface{}
@@ -59,8 +60,14 @@ type InsertBinlogIterator struct {
 
 // NewInsertBinlogIterator creates a new iterator
 func NewInsertBinlogIterator(blobs []*Blob, PKfieldID UniqueID) (*InsertBinlogIterator, error) {
+	return NewInsertBinlogIteratorWithSchema(blobs, PKfieldID, nil)
+}
+
+// NewInsertBinlogIteratorWithSchema creates a new iterator, the fields with a default value
+// in schema but absent in blobs are filled with the default value
+func NewInsertBinlogIteratorWithSchema(blobs []*Blob, PKfieldID UniqueID, schema *etcdpb.CollectionMeta) (*InsertBinlogIterator, error) {
 	// TODO: load part of file to read records other than loading all content
-	reader := NewInsertCodec(nil)
+	reader := NewInsertCodec(schema)
 
 	_, _, serData, err := reader.Deserialize(blobs)
 
@@ -91,10 +98,15 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 		m[fieldID] = fieldData.GetRow(itr.pos)
 	}
 
+	pk, err := NewPrimaryKey(itr.data.Data[itr.PKfieldID].GetRow(itr.pos))
+	if err != nil {
+		return nil, err
+	}
+
 	v := &Value{
 		ID:        itr.data.Data[rootcoord.RowIDField].GetRow(itr.pos).(int64),
 		Timestamp: itr.data.Data[rootcoord.TimeStampField].GetRow(itr.pos).(int64),
-		PK:        itr.data.Data[itr.PKfieldID].GetRow(itr.pos).(int64),
+		PK:        pk,
 		IsDeleted: false,
 		Value:     m,
 	}
@@ -118,7 +118,7 @@ func TestInsertlogIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -136,6 +136,26 @@ func TestInsertlogIterator(t *testing.T) {
 		_, err = itr.Next()
 		assert.Equal(t, ErrNoMoreRecord, err)
 	})
+
+	t.Run("fill default value", func(t *testing.T) {
+		blobs := generateTestData(t, 2)
+		schema := &etcdpb.CollectionMeta{ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+			{FieldID: 104, Name: "added", DataType: schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}}},
+		}}}
+		itr, err := NewInsertBinlogIteratorWithSchema(blobs, rootcoord.RowIDField, schema)
+		assert.Nil(t, err)
+
+		for i := 1; i <= 2; i++ {
+			assert.True(t, itr.HasNext())
+			v, err := itr.Next()
+			assert.Nil(t, err)
+			row := v.(*Value).Value.(map[FieldID]interface{})
+			assert.Equal(t, int64(7), row[104])
+			assert.Equal(t, int32(i), row[101])
+		}
+		assert.False(t, itr.HasNext())
+	})
 }
 
 func TestMergeIterator(t *testing.T) {
@@ -175,7 +195,7 @@ func TestMergeIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -210,7 +230,7 @@ func TestMergeIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -0,0 +1,135 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	// MinioStorage persists the files in MinIO/S3
+	MinioStorage = "minio"
+	// LocalStorage persists the files in the local file system, which has to be shared in cluster mode
+	LocalStorage = "local"
+	// S3Storage persists the files in AWS S3
+	S3Storage = "s3"
+	// GcsStorage persists the files in Google Cloud Storage
+	GcsStorage = "gcs"
+	// AzureStorage persists the files in Azure Blob Storage
+	AzureStorage = "azure"
+)
+
+// NewChunkManager returns the ChunkManager persisting binlogs and index files in the storage configured by params.
+func NewChunkManager(ctx context.Context, params *paramtable.GlobalParamTable) (ChunkManager, error) {
+	switch params.StorageCfg.Type {
+	case MinioStorage:
+		option := &miniokv.Option{
+			Address:           params.MinioCfg.Address,
+			AccessKeyID:       params.MinioCfg.AccessKeyID,
+			SecretAccessKeyID: params.MinioCfg.SecretAccessKey,
+			UseSSL:            params.MinioCfg.UseSSL,
+			BucketName:        params.MinioCfg.BucketName,
+			CreateBucket:      true,
+		}
+		kv, err := miniokv.NewMinIOKV(ctx, option)
+		if err != nil {
+			return nil, err
+		}
+		return NewMinioChunkManager(kv), nil
+	case LocalStorage:
+		log.Info("use local storage", zap.String("path", params.StorageCfg.Path))
+		return NewLocalChunkManager(params.StorageCfg.Path), nil
+	case S3Storage:
+		cm, err := NewS3ChunkManager(ctx, &S3Option{
+			Address:         params.StorageCfg.S3Address,
+			Region:          params.StorageCfg.S3Region,
+			BucketName:      params.StorageCfg.S3BucketName,
+			AccessKeyID:     params.StorageCfg.S3AccessKeyID,
+			SecretAccessKey: params.StorageCfg.S3SecretAccessKey,
+			UseSSL:          params.StorageCfg.S3UseSSL,
+			UseIAM:          params.StorageCfg.S3UseIAM,
+			IAMEndpoint:     params.StorageCfg.S3IAMEndpoint,
+			SSEKMSKeyID:     params.StorageCfg.S3SSEKMSKeyID,
+			CreateBucket:    params.StorageCfg.S3CreateBucket,
+			PartSize:        params.StorageCfg.PartSize,
+			RetryAttempts:   params.StorageCfg.RetryAttempts,
+			RetrySleep:      params.StorageCfg.RetrySleep,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cm, nil
+	case GcsStorage:
+		if params.StorageCfg.GCSAccessKeyID != "" {
+			// the HMAC keys only work with the S3 compatible API
+			cm, err := NewS3ChunkManager(ctx, &S3Option{
+				Address:         params.StorageCfg.GCSAddress,
+				BucketName:      params.StorageCfg.GCSBucketName,
+				AccessKeyID:     params.StorageCfg.GCSAccessKeyID,
+				SecretAccessKey: params.StorageCfg.GCSSecretAccessKey,
+				UseSSL:          params.StorageCfg.GCSUseSSL,
+				CreateBucket:    params.StorageCfg.GCSCreateBucket,
+				PartSize:        params.StorageCfg.PartSize,
+				RetryAttempts:   params.StorageCfg.RetryAttempts,
+				RetrySleep:      params.StorageCfg.RetrySleep,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return cm, nil
+		}
+		cm, err := NewGCSChunkManager(ctx, &GCSOption{
+			Address:         params.StorageCfg.GCSAddress,
+			UseSSL:          params.StorageCfg.GCSUseSSL,
+			BucketName:      params.StorageCfg.GCSBucketName,
+			CredentialsFile: params.StorageCfg.GCSCredentialsFile,
+			ProjectID:       params.StorageCfg.GCSProjectID,
+			CreateBucket:    params.StorageCfg.GCSCreateBucket,
+			PartSize:        params.StorageCfg.PartSize,
+			RetryAttempts:   params.StorageCfg.RetryAttempts,
+			RetrySleep:      params.StorageCfg.RetrySleep,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cm, nil
+	case AzureStorage:
+		cm, err := NewAzureChunkManager(ctx, &AzureOption{
+			Address:         params.StorageCfg.AzureAddress,
+			AccountName:     params.StorageCfg.AzureAccountName,
+			AccountKey:      params.StorageCfg.AzureAccountKey,
+			ContainerName:   params.StorageCfg.AzureContainerName,
+			CreateContainer: params.StorageCfg.AzureCreateContainer,
+			PartSize:        params.StorageCfg.PartSize,
+			RetryAttempts:   params.StorageCfg.RetryAttempts,
+			RetrySleep:      params.StorageCfg.RetrySleep,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cm, nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %s", params.StorageCfg.Type)
+	}
+}
@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNewChunkManager(t *testing.T) {
+	var params paramtable.GlobalParamTable
+	params.Init()
+
+	t.Run("minio", func(t *testing.T) {
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &MinioChunkManager{}, cm)
+	})
+
+	t.Run("local", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "chunk_manager_factory")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		params.StorageCfg.Type = LocalStorage
+		params.StorageCfg.Path = dir
+		defer func() { params.StorageCfg.Type = MinioStorage }()
+
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &LocalChunkManager{}, cm)
+
+		err = cm.Write("files/1", []byte{1})
+		assert.NoError(t, err)
+		_, err = os.Stat(dir + "/files/1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("s3", func(t *testing.T) {
+		server := newFakeS3Server()
+		defer server.Close()
+
+		params.StorageCfg.Type = S3Storage
+		params.StorageCfg.S3Address = server.address()
+		params.StorageCfg.S3Region = "us-east-1"
+		params.StorageCfg.S3BucketName = "s3-bucket"
+		params.StorageCfg.S3UseSSL = false
+		params.StorageCfg.S3SSEKMSKeyID = ""
+		defer func() { params.StorageCfg.Type = MinioStorage }()
+
+		// the bucket isn't created by default
+		_, err := NewChunkManager(context.TODO(), &params)
+		assert.Error(t, err)
+		assert.Empty(t, server.buckets)
+
+		params.StorageCfg.S3CreateBucket = true
+		defer func() { params.StorageCfg.S3CreateBucket = false }()
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &S3ChunkManager{}, cm)
+		assert.Contains(t, server.buckets, "s3-bucket")
+	})
+
+	t.Run("gcs", func(t *testing.T) {
+		server := newFakeGCSServer()
+		defer server.Close()
+		os.Setenv("GCE_METADATA_HOST", server.tokens.host())
+		defer os.Unsetenv("GCE_METADATA_HOST")
+
+		params.StorageCfg.Type = GcsStorage
+		params.StorageCfg.GCSAddress = server.address()
+		params.StorageCfg.GCSBucketName = "gcs-bucket"
+		params.StorageCfg.GCSUseSSL = false
+		defer func() { params.StorageCfg.Type = MinioStorage }()
+
+		// the bucket isn't created by default
+		_, err := NewChunkManager(context.TODO(), &params)
+		assert.Error(t, err)
+		assert.Empty(t, server.buckets)
+
+		params.StorageCfg.GCSCreateBucket = true
+		defer func() { params.StorageCfg.GCSCreateBucket = false }()
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &GCSChunkManager{}, cm)
+		assert.Equal(t, testGCSProjectID, server.projects["gcs-bucket"])
+	})
+
+	t.Run("gcs with hmac keys", func(t *testing.T) {
+		server := newFakeS3Server()
+		defer server.Close()
+
+		params.StorageCfg.Type = GcsStorage
+		params.StorageCfg.GCSAddress = server.address()
+		params.StorageCfg.GCSBucketName = "gcs-bucket"
+		params.StorageCfg.GCSAccessKeyID = "hmac-access-key"
+		params.StorageCfg.GCSSecretAccessKey = "hmac-secret"
+		params.StorageCfg.GCSUseSSL = false
+		params.StorageCfg.GCSCreateBucket = true
+		defer func() {
+			params.StorageCfg.Type = MinioStorage
+			params.StorageCfg.GCSAccessKeyID = ""
+			params.StorageCfg.GCSCreateBucket = false
+		}()
+
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &S3ChunkManager{}, cm)
+		assert.Contains(t, server.buckets, "gcs-bucket")
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		server := newFakeAzureServer()
+		defer server.Close()
+
+		params.StorageCfg.Type = AzureStorage
+		params.StorageCfg.AzureAddress = server.address()
+		params.StorageCfg.AzureAccountName = testAzureAccountName
+		params.StorageCfg.AzureAccountKey = testAzureAccountKey
+		params.StorageCfg.AzureContainerName = testAzureContainer
+		defer func() { params.StorageCfg.Type = MinioStorage }()
+
+		// the container isn't created by default
+		_, err := NewChunkManager(context.TODO(), &params)
+		assert.Error(t, err)
+		assert.Empty(t, server.containers)
+
+		params.StorageCfg.AzureCreateContainer = true
+		defer func() { params.StorageCfg.AzureCreateContainer = false }()
+		cm, err := NewChunkManager(context.TODO(), &params)
+		assert.NoError(t, err)
+		assert.IsType(t, &AzureChunkManager{}, cm)
+		assert.Contains(t, server.containers, testAzureContainer)
+
+		params.StorageCfg.AzureAccountKey = "not base64"
+		cm, err = NewChunkManager(context.TODO(), &params)
+		assert.Error(t, err)
+		assert.Nil(t, cm)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		params.StorageCfg.Type = "unknown"
+		defer func() { params.StorageCfg.Type = MinioStorage }()
+
+		_, err := NewChunkManager(context.TODO(), &params)
+		assert.Error(t, err)
+	})
+}
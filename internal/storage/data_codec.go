@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
@@ -144,6 +145,10 @@ type StringFieldData struct {
 	NumRows []int64
 	Data    []string
 }
+type JSONFieldData struct {
+	NumRows []int64
+	Data    [][]byte
+}
 type BinaryVectorFieldData struct {
 	NumRows []int64
 	Data    []byte
@@ -164,6 +169,7 @@ func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
 func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
 func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int         { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
 
@@ -176,6 +182,7 @@ func (data *Int64FieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *FloatFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *DoubleFieldData) GetRow(i int) interface{} { return data.Data[i] }
 func (data *StringFieldData) GetRow(i int) interface{} { return data.Data[i] }
+func (data *JSONFieldData) GetRow(i int) interface{}   { return data.Data[i] }
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -225,6 +232,14 @@ func (data *StringFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data)
 }
 
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows)
+	for _, doc := range data.Data {
+		size += len(doc)
+	}
+	return size
+}
+
 func (data *BinaryVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
@@ -281,6 +296,11 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		return nil, nil, fmt.Errorf("there's no data in InsertData")
 	}
 
+	// buffers created before a field was added don't contain it
+	if err := FillDefaultFieldData(insertCodec.Schema.GetSchema(), data); err != nil {
+		return nil, nil, err
+	}
+
 	ts := timeFieldData.(*Int64FieldData).Data
 	startTs := ts[0]
 	endTs := ts[len(ts)-1]
@@ -370,6 +390,16 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case schemapb.DataType_JSON:
+			for _, doc := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(doc)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			if err != nil {
@@ -417,9 +447,36 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// stats fields
 		switch field.DataType {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+			schemapb.DataType_Float, schemapb.DataType_Double:
+			statsWriter := &StatsWriter{}
+			err = statsWriter.StatsField(field.FieldID, singleData)
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:   blobKey,
+				Value: statsWriter.GetBuffer(),
+			})
 		case schemapb.DataType_Int64:
 			statsWriter := &StatsWriter{}
-			err = statsWriter.StatsInt64(field.FieldID, field.IsPrimaryKey, singleData.(*Int64FieldData).Data)
+			// the stats of the primary key are read as PrimaryKeyStats, the system fields keep the same format
+			if field.IsPrimaryKey || field.FieldID < common.StartOfUserFieldID {
+				err = statsWriter.StatsInt64(field.FieldID, field.IsPrimaryKey, singleData.(*Int64FieldData).Data)
+			} else {
+				err = statsWriter.StatsField(field.FieldID, singleData)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBuffer := statsWriter.GetBuffer()
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:   blobKey,
+				Value: statsBuffer,
+			})
+		case schemapb.DataType_String:
+			statsWriter := &StatsWriter{}
+			err = statsWriter.StatsString(field.FieldID, field.IsPrimaryKey, singleData.(*StringFieldData).Data)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -644,6 +701,29 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 					stringFieldData.Data = append(stringFieldData.Data, singleString)
 				}
 				resultData.Data[fieldID] = stringFieldData
+			case schemapb.DataType_JSON:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &JSONFieldData{}
+				}
+				jsonFieldData := resultData.Data[fieldID].(*JSONFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					doc, err := eventReader.GetOneJSONFromPayload(i)
+					if err != nil {
+						eventReader.Close()
+						binlogReader.Close()
+						return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					jsonFieldData.Data = append(jsonFieldData.Data, doc)
+				}
+				resultData.Data[fieldID] = jsonFieldData
 			case schemapb.DataType_BinaryVector:
 				if resultData.Data[fieldID] == nil {
 					resultData.Data[fieldID] = &BinaryVectorFieldData{}
@@ -704,9 +784,91 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 		binlogReader.Close()
 	}
 
+	// segments written before a field was added have no binlog of it
+	if err := FillDefaultFieldData(insertCodec.Schema.GetSchema(), resultData); err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+	}
+
 	return cID, pID, sID, resultData, nil
 }
 
+// FillDefaultFieldData adds the fields which have a default value in schema but are absent in data,
+// every row of such a field is set to the default value.
+func FillDefaultFieldData(schema *schemapb.CollectionSchema, data *InsertData) error {
+	timeFieldData, ok := data.Data[rootcoord.TimeStampField]
+	if !ok {
+		return nil
+	}
+	rowNum := timeFieldData.RowNum()
+
+	for _, field := range schema.GetFields() {
+		if field.GetDefaultValue() == nil {
+			continue
+		}
+		if _, ok := data.Data[field.FieldID]; ok {
+			continue
+		}
+		numRows := make([]int64, len(timeFieldData.(*Int64FieldData).NumRows))
+		copy(numRows, timeFieldData.(*Int64FieldData).NumRows)
+		switch value := field.GetDefaultValue().GetData().(type) {
+		case *schemapb.ValueField_BoolData:
+			fieldData := &BoolFieldData{NumRows: numRows, Data: make([]bool, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = value.BoolData
+			}
+			data.Data[field.FieldID] = fieldData
+		case *schemapb.ValueField_IntData:
+			switch field.DataType {
+			case schemapb.DataType_Int8:
+				fieldData := &Int8FieldData{NumRows: numRows, Data: make([]int8, rowNum)}
+				for i := range fieldData.Data {
+					fieldData.Data[i] = int8(value.IntData)
+				}
+				data.Data[field.FieldID] = fieldData
+			case schemapb.DataType_Int16:
+				fieldData := &Int16FieldData{NumRows: numRows, Data: make([]int16, rowNum)}
+				for i := range fieldData.Data {
+					fieldData.Data[i] = int16(value.IntData)
+				}
+				data.Data[field.FieldID] = fieldData
+			default:
+				fieldData := &Int32FieldData{NumRows: numRows, Data: make([]int32, rowNum)}
+				for i := range fieldData.Data {
+					fieldData.Data[i] = value.IntData
+				}
+				data.Data[field.FieldID] = fieldData
+			}
+		case *schemapb.ValueField_LongData:
+			fieldData := &Int64FieldData{NumRows: numRows, Data: make([]int64, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = value.LongData
+			}
+			data.Data[field.FieldID] = fieldData
+		case *schemapb.ValueField_FloatData:
+			fieldData := &FloatFieldData{NumRows: numRows, Data: make([]float32, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = value.FloatData
+			}
+			data.Data[field.FieldID] = fieldData
+		case *schemapb.ValueField_DoubleData:
+			fieldData := &DoubleFieldData{NumRows: numRows, Data: make([]float64, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = value.DoubleData
+			}
+			data.Data[field.FieldID] = fieldData
+		case *schemapb.ValueField_StringData:
+			fieldData := &StringFieldData{NumRows: numRows, Data: make([]string, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = value.StringData
+			}
+			data.Data[field.FieldID] = fieldData
+		default:
+			return fmt.Errorf("unexpected default value %T of field %d", value, field.FieldID)
+		}
+	}
+	return nil
+}
+
 // Deserialize transfer blob back to insert data.
 // From schema, it get all fields.
 // For each field, it will create a binlog reader, and read all event to the buffer.
@@ -719,13 +881,13 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 // DeleteData saves each entity delete message represented as <primarykey,timestamp> map.
 // timestamp represents the time when this instance was deleted
 type DeleteData struct {
-	Pks      []int64     // primary keys
-	Tss      []Timestamp // timestamps
+	Pks      []PrimaryKey // primary keys
+	Tss      []Timestamp  // timestamps
 	RowCount int64
 }
 
 // Append append 1 pk&ts pair to DeleteData
-func (data *DeleteData) Append(pk UniqueID, ts Timestamp) {
+func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 	data.Pks = append(data.Pks, pk)
 	data.Tss = append(data.Tss, ts)
 	data.RowCount++
@@ -741,7 +903,8 @@ func NewDeleteCodec() *DeleteCodec {
 }
 
 // Serialize transfer delete data to blob. .
-// For each delete message, it will save "pk,ts" string to binlog.
+// For each delete message, it will save "pk,ts" string to binlog,
+// string primary keys are saved quoted since they may contain ",".
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
@@ -770,11 +933,21 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 		if ts > endTs {
 			endTs = ts
 		}
-		err := eventWriter.AddOneStringToPayload(fmt.Sprintf("%d,%d", pk, ts))
+		var deleteLog string
+		switch key := pk.(type) {
+		case *Int64PrimaryKey:
+			deleteLog = fmt.Sprintf("%d,%d", key.Value, ts)
+			sizeTotal += binary.Size(key.Value)
+		case *StringPrimaryKey:
+			deleteLog = fmt.Sprintf("%s,%d", strconv.Quote(key.Value), ts)
+			sizeTotal += len(key.Value)
+		default:
+			return nil, fmt.Errorf("unsupported primary key type %T", pk)
+		}
+		err := eventWriter.AddOneStringToPayload(deleteLog)
 		if err != nil {
 			return nil, err
 		}
-		sizeTotal += binary.Size(pk)
 		sizeTotal += binary.Size(ts)
 	}
 	eventWriter.SetEventTimestamp(startTs, endTs)
@@ -837,21 +1010,22 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			splits := strings.Split(singleString, ",")
-			if len(splits) != 2 {
+			// the quoted string primary key may contain ',', so split at the last one
+			sep := strings.LastIndex(singleString, ",")
+			if sep < 0 {
 				eventReader.Close()
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the format of delta log is incorrect")
 			}
 
-			pk, err := strconv.ParseInt(splits[0], 10, 64)
+			pk, err := parseDeleteLogPrimaryKey(singleString[:sep])
 			if err != nil {
 				eventReader.Close()
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			ts, err := strconv.ParseUint(splits[1], 10, 64)
+			ts, err := strconv.ParseUint(singleString[sep+1:], 10, 64)
 			if err != nil {
 				eventReader.Close()
 				binlogReader.Close()
@@ -870,6 +1044,23 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 	return pid, sid, result, nil
 }
 
+// parseDeleteLogPrimaryKey parses the primary key part of a delta log entry,
+// a quoted value is a string primary key, otherwise it's an int64 one.
+func parseDeleteLogPrimaryKey(s string) (PrimaryKey, error) {
+	if strings.HasPrefix(s, "\"") {
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, err
+		}
+		return NewStringPrimaryKey(v), nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return NewInt64PrimaryKey(v), nil
+}
+
 // DataDefinitionCodec serializes and deserializes the data definition
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
@@ -46,6 +46,7 @@ const (
 	StringField       = 107
 	BinaryVectorField = 108
 	FloatVectorField  = 109
+	JSONField         = 110
 )
 
 func TestInsertCodec(t *testing.T) {
@@ -143,6 +144,13 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      JSONField,
+					Name:         "field_json",
+					IsPrimaryKey: false,
+					Description:  "json",
+					DataType:     schemapb.DataType_JSON,
+				},
 			},
 		},
 	}
@@ -199,6 +207,10 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"key":3}`), []byte(`{"key":4}`)},
+			},
 		},
 	}
 
@@ -254,6 +266,10 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"key":1}`), []byte(`{"key":2}`)},
+			},
 		},
 	}
 
@@ -271,6 +287,7 @@ func TestInsertCodec(t *testing.T) {
 			StringField:       &StringFieldData{[]int64{}, []string{}},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
+			JSONField:         &JSONFieldData{[]int64{}, [][]byte{}},
 		},
 	}
 	b, s, err := insertCodec.Serialize(PartitionID, SegmentID, insertDataEmpty)
@@ -308,6 +325,7 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[JSONField].(*JSONFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -320,6 +338,7 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{[]byte(`{"key":1}`), []byte(`{"key":2}`), []byte(`{"key":3}`), []byte(`{"key":4}`)}, resultData.Data[JSONField].(*JSONFieldData).Data)
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
 
@@ -339,12 +358,12 @@ func TestInsertCodec(t *testing.T) {
 func TestDeleteCodec(t *testing.T) {
 	deleteCodec := NewDeleteCodec()
 	deleteData := &DeleteData{
-		Pks:      []int64{1},
+		Pks:      []PrimaryKey{NewInt64PrimaryKey(1)},
 		Tss:      []uint64{43757345},
 		RowCount: int64(1),
 	}
 
-	deleteData.Append(2, 23578294723)
+	deleteData.Append(NewInt64PrimaryKey(2), 23578294723)
 	blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
 	assert.Nil(t, err)
 
@@ -353,6 +372,18 @@ func TestDeleteCodec(t *testing.T) {
 	assert.Equal(t, pid, int64(1))
 	assert.Equal(t, sid, int64(1))
 	assert.Equal(t, data, deleteData)
+
+	t.Run("string primary keys", func(t *testing.T) {
+		deleteData := &DeleteData{}
+		deleteData.Append(NewStringPrimaryKey("https://milvus.io/a,b"), 43757345)
+		deleteData.Append(NewStringPrimaryKey("\"quoted\""), 23578294723)
+		blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+		assert.Nil(t, err)
+
+		_, _, data, err := deleteCodec.Deserialize([]*Blob{blob})
+		assert.Nil(t, err)
+		assert.Equal(t, deleteData, data)
+	})
 }
 
 func TestDDCodec(t *testing.T) {
@@ -577,3 +608,79 @@ func TestSchemaError(t *testing.T) {
 	assert.Nil(t, blobs)
 	assert.NotNil(t, err)
 }
+
+func TestInsertCodecFillDefaultValue(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  RowIDField,
+					Name:     "row_id",
+					DataType: schemapb.DataType_Int64,
+				},
+				{
+					FieldID:  TimestampField,
+					Name:     "Timestamp",
+					DataType: schemapb.DataType_Int64,
+				},
+				{
+					FieldID:      Int64Field,
+					Name:         "field_int64",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_Int64,
+				},
+			},
+		},
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{3, 4},
+			},
+			TimestampField: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{3, 4},
+			},
+			Int64Field: &Int64FieldData{
+				NumRows: []int64{2},
+				Data:    []int64{3, 4},
+			},
+		},
+	}
+	blobs, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	// add fields after the segment is written
+	schema.Schema.Fields = append(schema.Schema.Fields,
+		&schemapb.FieldSchema{
+			FieldID:      Int8Field,
+			Name:         "field_int8",
+			DataType:     schemapb.DataType_Int8,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 8}},
+		},
+		&schemapb.FieldSchema{
+			FieldID:      StringField,
+			Name:         "field_string",
+			DataType:     schemapb.DataType_String,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "default"}},
+		},
+	)
+	schema.Schema.Version = 1
+
+	_, _, resultData, err := NewInsertCodec(schema).Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, []int8{8, 8}, resultData.Data[Int8Field].(*Int8FieldData).Data)
+	assert.Equal(t, []int64{2}, resultData.Data[Int8Field].(*Int8FieldData).NumRows)
+	assert.Equal(t, []string{"default", "default"}, resultData.Data[StringField].(*StringFieldData).Data)
+
+	// buffered data without the new fields is filled when it's serialized
+	blobs, _, err = NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(blobs))
+	_, _, resultData, err = (&InsertCodec{}).Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, []int8{8, 8}, resultData.Data[Int8Field].(*Int8FieldData).Data)
+}
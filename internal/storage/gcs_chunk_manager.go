@@ -0,0 +1,568 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+// GCSOption is the option to connect to Google Cloud Storage with the OAuth 2.0 credentials of a service account.
+type GCSOption struct {
+	// Address is the endpoint of the XML API, storage.googleapis.com if it's empty
+	Address    string
+	UseSSL     bool
+	BucketName string
+	// CredentialsFile is the json key file of the service account, GOOGLE_APPLICATION_CREDENTIALS is used if it's empty.
+	// Without a key file, the credentials of the GCE instance or the GKE workload identity are taken from the metadata server.
+	CredentialsFile string
+	// MetadataHost is the metadata server, GCE_METADATA_HOST or metadata.google.internal if it's empty
+	MetadataHost string
+	// ProjectID is the project the bucket is created in, the project of the credentials if it's empty
+	ProjectID    string
+	CreateBucket bool
+
+	// PartSize is the size of each part of the multipart uploads, the objects not larger than it are uploaded at once
+	PartSize int64
+	// RetryAttempts and RetrySleep are the attempts and the initial backoff of each request
+	RetryAttempts uint
+	RetrySleep    time.Duration
+}
+
+// GCSChunkManager is responsible for read and write data stored in Google Cloud Storage, through the XML API.
+// The large files are uploaded part by part, each part and the small files are verified by their md5 checksums.
+type GCSChunkManager struct {
+	ctx         context.Context
+	client      *http.Client
+	bucketURL   *url.URL
+	credentials *gcsCredentials
+	partSize    int64
+	retryOpts   []retry.Option
+}
+
+// gcsError is the error responded by GCS.
+type gcsError struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *gcsError) Error() string {
+	return fmt.Sprintf("gcs request failed, status: %d, code: %s, message: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// NewGCSChunkManager creates a new GCSChunkManager, the bucket is created if it doesn't exist and CreateBucket is set.
+func NewGCSChunkManager(ctx context.Context, option *GCSOption) (*GCSChunkManager, error) {
+	address := option.Address
+	if address == "" {
+		address = "storage.googleapis.com"
+	}
+	scheme := "http"
+	if option.UseSSL {
+		scheme = "https"
+	}
+
+	partSize := option.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize < s3MinPartSize {
+		return nil, fmt.Errorf("part size %d is less than the minimum %d", partSize, s3MinPartSize)
+	}
+
+	client := &http.Client{}
+	credentials, err := newGCSCredentials(client, option)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &GCSChunkManager{
+		ctx:         ctx,
+		client:      client,
+		bucketURL:   &url.URL{Scheme: scheme, Host: address, Path: "/" + option.BucketName},
+		credentials: credentials,
+		partSize:    partSize,
+		retryOpts:   retryOptions(option.RetryAttempts, option.RetrySleep),
+	}
+
+	err = cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodHead, cm.bucketURL, nil, nil, nil)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+		if !isGCSNotFound(err) {
+			return err
+		}
+		if !option.CreateBucket {
+			return retry.Unrecoverable(fmt.Errorf("bucket %s not Existed", option.BucketName))
+		}
+		projectID := option.ProjectID
+		if projectID == "" {
+			if projectID, err = credentials.projectID(ctx); err != nil {
+				return err
+			}
+		}
+		header := http.Header{}
+		header.Set("x-goog-project-id", projectID)
+		resp, err = cm.do(http.MethodPut, cm.bucketURL, nil, header, nil)
+		if err != nil {
+			var gcsErr *gcsError
+			// created by others at the same time
+			if errors.As(err, &gcsErr) && gcsErr.Code == "BucketAlreadyOwnedByYou" {
+				return nil
+			}
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// newGCSCredentials returns the credentials of the service account key file if there is one,
+// otherwise the ones served by the metadata server.
+func newGCSCredentials(client *http.Client, option *GCSOption) (*gcsCredentials, error) {
+	credentialsFile := option.CredentialsFile
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	if credentialsFile != "" {
+		keyJSON, err := ioutil.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gcs credentials file: %w", err)
+		}
+		return newGCSServiceAccountCredentials(client, keyJSON)
+	}
+	metadataHost := option.MetadataHost
+	if metadataHost == "" {
+		metadataHost = os.Getenv("GCE_METADATA_HOST")
+	}
+	return newGCSMetadataCredentials(client, metadataHost), nil
+}
+
+// isGCSRetryable tells whether the failed request might succeed if sent again,
+// the requests failed without responses, like the network errors, are retryable.
+func isGCSRetryable(err error) bool {
+	if retry.IsUncoverable(err) {
+		return false
+	}
+	var gcsErr *gcsError
+	if errors.As(err, &gcsErr) {
+		return isRetryableStatus(gcsErr.StatusCode)
+	}
+	return true
+}
+
+func isGCSNotFound(err error) bool {
+	var gcsErr *gcsError
+	return errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusNotFound
+}
+
+func (cm *GCSChunkManager) withRetry(fn func() error) error {
+	return doWithRetry(cm.ctx, fn, isGCSRetryable, cm.retryOpts...)
+}
+
+func (cm *GCSChunkManager) objectURL(key string) *url.URL {
+	u := *cm.bucketURL
+	u.Path = u.Path + "/" + key
+	return &u
+}
+
+// do sends the request authorized by the access token, the responses of the failed requests are returned as gcsError.
+func (cm *GCSChunkManager) do(method string, u *url.URL, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	token, err := cm.credentials.accessToken(cm.ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqURL := *u
+	reqURL.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(cm.ctx, method, reqURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := cm.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		// the token might be revoked, a new one is fetched by the next request
+		cm.credentials.invalidate()
+	}
+	gcsErr := &gcsError{}
+	if data, err := ioutil.ReadAll(resp.Body); err == nil && len(data) > 0 {
+		_ = xml.Unmarshal(data, gcsErr)
+	}
+	gcsErr.StatusCode = resp.StatusCode
+	return nil, gcsErr
+}
+
+// gcsMD5 returns the base64 encoded md5 checksum in the x-goog-hash headers, the composite objects have none.
+func gcsMD5(header http.Header) string {
+	for _, values := range header.Values("x-goog-hash") {
+		for _, value := range strings.Split(values, ",") {
+			if value = strings.TrimSpace(value); strings.HasPrefix(value, "md5=") {
+				return strings.TrimPrefix(value, "md5=")
+			}
+		}
+	}
+	return ""
+}
+
+// GetPath returns the path of the object if exists.
+func (cm *GCSChunkManager) GetPath(key string) (string, error) {
+	if !cm.Exist(key) {
+		return "", errors.New("gcs file manage cannot be found with key:" + key)
+	}
+	return key, nil
+}
+
+// Size returns the size of the object.
+func (cm *GCSChunkManager) Size(key string) (int64, error) {
+	var size int64
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodHead, cm.objectURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		size = resp.ContentLength
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Write uploads the content as the object, part by part if it's larger than the part size.
+func (cm *GCSChunkManager) Write(key string, content []byte) error {
+	if int64(len(content)) > cm.partSize {
+		return cm.multipartUpload(key, content)
+	}
+	return cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("Content-MD5", md5Base64(content))
+		resp, err := cm.do(http.MethodPut, cm.objectURL(key), nil, header, content)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+type gcsInitiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type gcsCompletePart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type gcsCompleteMultipartUpload struct {
+	XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+	Parts   []gcsCompletePart `xml:"Part"`
+}
+
+func (cm *GCSChunkManager) multipartUpload(key string, content []byte) error {
+	var uploadID string
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodPost, cm.objectURL(key), url.Values{"uploads": {""}}, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		result := gcsInitiateMultipartUploadResult{}
+		if err := xml.Unmarshal(data, &result); err != nil {
+			return err
+		}
+		uploadID = result.UploadID
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	size := int64(len(content))
+	complete := gcsCompleteMultipartUpload{}
+	for start := int64(0); start < size; start += cm.partSize {
+		end := start + cm.partSize
+		if end > size {
+			end = size
+		}
+		data := content[start:end]
+		partNumber := len(complete.Parts) + 1
+		var etag string
+		err = cm.withRetry(func() error {
+			header := http.Header{}
+			header.Set("Content-MD5", md5Base64(data))
+			query := url.Values{"partNumber": {strconv.Itoa(partNumber)}, "uploadId": {uploadID}}
+			resp, err := cm.do(http.MethodPut, cm.objectURL(key), query, header, data)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			etag = resp.Header.Get("ETag")
+			return nil
+		})
+		if err != nil {
+			cm.abortMultipartUpload(key, uploadID)
+			return err
+		}
+		complete.Parts = append(complete.Parts, gcsCompletePart{PartNumber: partNumber, ETag: etag})
+	}
+
+	body, err := xml.Marshal(complete)
+	if err != nil {
+		cm.abortMultipartUpload(key, uploadID)
+		return err
+	}
+	err = cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("Content-Type", "application/xml")
+		resp, err := cm.do(http.MethodPost, cm.objectURL(key), url.Values{"uploadId": {uploadID}}, header, body)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+	if err != nil {
+		cm.abortMultipartUpload(key, uploadID)
+		return err
+	}
+	return nil
+}
+
+// abortMultipartUpload removes the uploaded parts, the error is ignored since they're cleaned up by the lifecycle rules anyway
+func (cm *GCSChunkManager) abortMultipartUpload(key, uploadID string) {
+	_ = cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodDelete, cm.objectURL(key), url.Values{"uploadId": {uploadID}}, nil, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+}
+
+// MultiWrite writes the contents as the objects, it stops at the first failure.
+func (cm *GCSChunkManager) MultiWrite(contents map[string][]byte) error {
+	for key, content := range contents {
+		if err := cm.Write(key, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exist checks whether the object exists.
+func (cm *GCSChunkManager) Exist(key string) bool {
+	_, err := cm.Size(key)
+	return err == nil
+}
+
+// Read downloads the whole object, which is verified by its md5 checksum unless it's a composite object.
+func (cm *GCSChunkManager) Read(key string) ([]byte, error) {
+	var data []byte
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodGet, cm.objectURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		data, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if checksum := gcsMD5(resp.Header); checksum != "" && checksum != md5Base64(data) {
+			return fmt.Errorf("checksum mismatched of %s, md5: %s, checksum: %s", key, checksum, md5Base64(data))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// MultiRead downloads the objects, it fails if any of them cannot be read.
+func (cm *GCSChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		data, err := cm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, data)
+	}
+	return results, nil
+}
+
+type gcsListBucketResult struct {
+	Contents []struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// ListWithPrefix returns the keys and the last modified time of all the objects start with @prefix.
+func (cm *GCSChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	token := ""
+	for {
+		list := gcsListBucketResult{}
+		err := cm.withRetry(func() error {
+			query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+			if token != "" {
+				query.Set("continuation-token", token)
+			}
+			resp, err := cm.do(http.MethodGet, cm.bucketURL, query, nil, nil)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return err
+			}
+			return xml.Unmarshal(data, &list)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, object := range list.Contents {
+			modTime, err := time.Parse(time.RFC3339, object.LastModified)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, object.Key)
+			modTimes = append(modTimes, modTime)
+		}
+		if !list.IsTruncated || list.NextContinuationToken == "" {
+			return keys, modTimes, nil
+		}
+		token = list.NextContinuationToken
+	}
+}
+
+// ReadAt downloads the range of the object starting at @off into p,
+// io.EOF is returned if the object ends before p is filled.
+func (cm *GCSChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("GCSChunkManager: invalid offset")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var n int
+	err := cm.withRetry(func() error {
+		header := http.Header{}
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+		resp, err := cm.do(http.MethodGet, cm.objectURL(key), nil, header, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		n, err = io.ReadFull(resp.Body, p)
+		if (err == io.EOF || err == io.ErrUnexpectedEOF) && int64(n) == resp.ContentLength {
+			// the object ends before p is filled, otherwise the response is truncated and read again
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		var gcsErr *gcsError
+		if errors.As(err, &gcsErr) && gcsErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Remove deletes the object, it's not an error if the object doesn't exist.
+func (cm *GCSChunkManager) Remove(key string) error {
+	err := cm.withRetry(func() error {
+		resp, err := cm.do(http.MethodDelete, cm.objectURL(key), nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	})
+	if isGCSNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// MultiRemove deletes the objects, it stops at the first failure.
+func (cm *GCSChunkManager) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		if err := cm.Remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWithPrefix deletes all the objects start with @prefix.
+func (cm *GCSChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, _, err := cm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return cm.MultiRemove(keys)
+}
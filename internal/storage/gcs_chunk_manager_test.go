@@ -0,0 +1,608 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGCSBucket = "test-bucket"
+
+type fakeGCSObject struct {
+	data    []byte
+	md5     string
+	modTime time.Time
+}
+
+// fakeGCSServer is an in-memory server of the part of the XML API used by the GCSChunkManager,
+// the requests are authorized by the last token issued by its token server.
+type fakeGCSServer struct {
+	*httptest.Server
+	tokens *fakeGCSTokenServer
+
+	mu       sync.Mutex
+	buckets  map[string]map[string]*fakeGCSObject
+	projects map[string]string
+	uploads  map[string]map[int][]byte
+	uploadID int
+	requests []fakeRequest
+
+	// failures is the number of the following requests responded with 503 Service Unavailable
+	failures int
+	// partFailures is the number of the following UploadPart requests responded with 503 Service Unavailable
+	partFailures int
+	// corruptions is the number of the following objects responded with a corrupted byte
+	corruptions int
+	// listPageSize limits the keys listed in each response if it's not zero
+	listPageSize int
+}
+
+func newFakeGCSServer() *fakeGCSServer {
+	s := &fakeGCSServer{
+		tokens:   newFakeGCSTokenServer(nil),
+		buckets:  make(map[string]map[string]*fakeGCSObject),
+		projects: make(map[string]string),
+		uploads:  make(map[string]map[int][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeGCSServer) Close() {
+	s.Server.Close()
+	s.tokens.Close()
+}
+
+func (s *fakeGCSServer) address() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// operations returns the operations of the received requests
+func (s *fakeGCSServer) operations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	operations := make([]string, 0, len(s.requests))
+	for _, req := range s.requests {
+		operations = append(operations, req.operation)
+	}
+	return operations
+}
+
+func (s *fakeGCSServer) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *fakeGCSServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucketName, key := r.URL.Path[1:], ""
+	if i := strings.Index(bucketName, "/"); i >= 0 {
+		bucketName, key = bucketName[:i], bucketName[i+1:]
+	}
+	query := r.URL.Query()
+	operation := gcsOperation(r.Method, key, query)
+	s.requests = append(s.requests, fakeRequest{operation: operation, header: r.Header.Clone()})
+
+	if r.Header.Get("Authorization") != "Bearer "+s.tokens.lastToken() {
+		writeGCSError(w, r, http.StatusUnauthorized, "AuthenticationRequired")
+		return
+	}
+	if s.failures > 0 {
+		s.failures--
+		writeGCSError(w, r, http.StatusServiceUnavailable, "ServiceUnavailable")
+		return
+	}
+
+	if operation == "CreateBucket" {
+		if _, ok := s.buckets[bucketName]; ok {
+			writeGCSError(w, r, http.StatusConflict, "BucketAlreadyOwnedByYou")
+			return
+		}
+		projectID := r.Header.Get("x-goog-project-id")
+		if projectID == "" {
+			writeGCSError(w, r, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+		s.buckets[bucketName] = make(map[string]*fakeGCSObject)
+		s.projects[bucketName] = projectID
+		return
+	}
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		writeGCSError(w, r, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch operation {
+	case "GetBucket":
+	case "ListObjects":
+		s.listObjects(w, bucket, query)
+	case "PutObject":
+		data, ok := readGCSBody(w, r)
+		if !ok {
+			return
+		}
+		bucket[key] = &fakeGCSObject{data: data, md5: md5Base64(data), modTime: time.Now()}
+	case "InitiateMultipartUpload":
+		s.uploadID++
+		uploadID := strconv.Itoa(s.uploadID)
+		s.uploads[uploadID] = make(map[int][]byte)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`,
+			bucketName, key, uploadID)
+	case "UploadPart":
+		if s.partFailures > 0 {
+			s.partFailures--
+			writeGCSError(w, r, http.StatusServiceUnavailable, "ServiceUnavailable")
+			return
+		}
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeGCSError(w, r, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		data, ok := readGCSBody(w, r)
+		if !ok {
+			return
+		}
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[partNumber] = data
+		w.Header().Set("ETag", `"`+md5Hex(data)+`"`)
+	case "CompleteMultipartUpload":
+		s.completeMultipartUpload(w, r, bucket, key)
+	case "AbortMultipartUpload":
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case "GetObject", "HeadObject":
+		s.getObject(w, r, bucket, key)
+	case "DeleteObject":
+		if _, ok := bucket[key]; !ok {
+			writeGCSError(w, r, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		delete(bucket, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeGCSError(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func gcsOperation(method string, key string, query map[string][]string) string {
+	_, uploads := query["uploads"]
+	_, uploadID := query["uploadId"]
+	if key == "" {
+		switch method {
+		case http.MethodPut:
+			return "CreateBucket"
+		case http.MethodHead:
+			return "GetBucket"
+		default:
+			return "ListObjects"
+		}
+	}
+	switch method {
+	case http.MethodPut:
+		if uploadID {
+			return "UploadPart"
+		}
+		return "PutObject"
+	case http.MethodPost:
+		if uploads {
+			return "InitiateMultipartUpload"
+		}
+		return "CompleteMultipartUpload"
+	case http.MethodDelete:
+		if uploadID {
+			return "AbortMultipartUpload"
+		}
+		return "DeleteObject"
+	case http.MethodHead:
+		return "HeadObject"
+	default:
+		return "GetObject"
+	}
+}
+
+func writeGCSError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+// readGCSBody reads the body, which is verified by the Content-MD5 header
+func readGCSBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeGCSError(w, r, http.StatusBadRequest, "InvalidArgument")
+		return nil, false
+	}
+	if checksum := r.Header.Get("Content-MD5"); checksum != "" && checksum != md5Base64(data) {
+		writeGCSError(w, r, http.StatusBadRequest, "BadDigest")
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *fakeGCSServer) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucket map[string]*fakeGCSObject, key string) {
+	uploadID := r.URL.Query().Get("uploadId")
+	parts, ok := s.uploads[uploadID]
+	if !ok {
+		writeGCSError(w, r, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	complete := gcsCompleteMultipartUpload{}
+	if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+		writeGCSError(w, r, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	var data []byte
+	for i, part := range complete.Parts {
+		partData, ok := parts[part.PartNumber]
+		if !ok || part.PartNumber != i+1 || part.ETag != `"`+md5Hex(partData)+`"` {
+			writeGCSError(w, r, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		data = append(data, partData...)
+	}
+	delete(s.uploads, uploadID)
+	// the composite objects have no md5 checksum
+	bucket[key] = &fakeGCSObject{data: data, modTime: time.Now()}
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><CompleteMultipartUploadResult><Key>%s</Key></CompleteMultipartUploadResult>`, key)
+}
+
+func (s *fakeGCSServer) listObjects(w http.ResponseWriter, bucket map[string]*fakeGCSObject, query map[string][]string) {
+	type content struct {
+		Key          string
+		LastModified string
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Prefix                string
+		Contents              []content
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{}
+	prefix, token := "", ""
+	if values := query["prefix"]; len(values) > 0 {
+		prefix = values[0]
+	}
+	if values := query["continuation-token"]; len(values) > 0 {
+		token = values[0]
+	}
+
+	keys := make([]string, 0, len(bucket))
+	for key := range bucket {
+		if strings.HasPrefix(key, prefix) && key >= token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if s.listPageSize > 0 && len(keys) > s.listPageSize {
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[s.listPageSize]
+		keys = keys[:s.listPageSize]
+	}
+	result.Prefix = prefix
+	for _, key := range keys {
+		result.Contents = append(result.Contents, content{
+			Key:          key,
+			LastModified: bucket[key].modTime.UTC().Format("2006-01-02T15:04:05.000Z"),
+		})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (s *fakeGCSServer) getObject(w http.ResponseWriter, r *http.Request, bucket map[string]*fakeGCSObject, key string) {
+	object, ok := bucket[key]
+	if !ok {
+		writeGCSError(w, r, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+	data, status := object.data, http.StatusOK
+	if rng := r.Header.Get("Range"); rng != "" {
+		var start, end int
+		if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil {
+			writeGCSError(w, r, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+		if start >= len(data) {
+			writeGCSError(w, r, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+		if end >= len(data) {
+			end = len(data) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		data, status = data[start:end+1], http.StatusPartialContent
+	}
+	w.Header().Add("x-goog-hash", "crc32c=AAAAAA==")
+	if object.md5 != "" {
+		w.Header().Add("x-goog-hash", "md5="+object.md5)
+	}
+	if r.Method == http.MethodGet && s.corruptions > 0 && len(data) > 0 {
+		s.corruptions--
+		data = append([]byte{data[0] + 1}, data[1:]...)
+	}
+	w.Header().Set("Last-Modified", object.modTime.UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+func newTestGCSChunkManager(t *testing.T, server *fakeGCSServer, option GCSOption) *GCSChunkManager {
+	option.Address = server.address()
+	option.MetadataHost = server.tokens.host()
+	option.BucketName = testGCSBucket
+	option.CreateBucket = true
+	option.RetrySleep = time.Millisecond
+	cm, err := NewGCSChunkManager(context.TODO(), &option)
+	require.NoError(t, err)
+	return cm
+}
+
+func TestNewGCSChunkManager(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+
+	option := &GCSOption{
+		Address:      server.address(),
+		MetadataHost: server.tokens.host(),
+		BucketName:   testGCSBucket,
+	}
+	_, err := NewGCSChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	assert.Empty(t, server.buckets)
+
+	// the bucket is created in the project of the credentials
+	option.CreateBucket = true
+	_, err = NewGCSChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+	assert.Equal(t, testGCSProjectID, server.projects[testGCSBucket])
+
+	option.CreateBucket = false
+	_, err = NewGCSChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+
+	option.BucketName = "other-bucket"
+	option.ProjectID = "other-project"
+	option.CreateBucket = true
+	_, err = NewGCSChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+	assert.Equal(t, "other-project", server.projects["other-bucket"])
+
+	option.PartSize = 1024
+	_, err = NewGCSChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	option.PartSize = 0
+
+	option.CredentialsFile = "/not/existed/key.json"
+	_, err = NewGCSChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+}
+
+func TestGCSChunkManager(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+	cm := newTestGCSChunkManager(t, server, GCSOption{})
+
+	t.Run("read and write", func(t *testing.T) {
+		err := cm.Write("a/b/1", []byte("value1"))
+		require.NoError(t, err)
+		assert.True(t, cm.Exist("a/b/1"))
+		size, err := cm.Size("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), size)
+		value, err := cm.Read("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value1"), value)
+		p, err := cm.GetPath("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "a/b/1", p)
+
+		err = cm.MultiWrite(map[string][]byte{"a/b/2": []byte("value2"), "a/c/3": []byte("value3")})
+		assert.NoError(t, err)
+		values, err := cm.MultiRead([]string{"a/b/2", "a/c/3"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("value2"), []byte("value3")}, values)
+
+		assert.False(t, cm.Exist("a/b/4"))
+		_, err = cm.Size("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.Read("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.GetPath("a/b/4")
+		assert.Error(t, err)
+		values, err = cm.MultiRead([]string{"a/b/1", "a/b/4"})
+		assert.Error(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("read at", func(t *testing.T) {
+		p := make([]byte, 3)
+		n, err := cm.ReadAt("a/b/1", p, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []byte("alu"), p)
+
+		n, err = cm.ReadAt("a/b/1", p, 4)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []byte("e1"), p[:n])
+
+		n, err = cm.ReadAt("a/b/1", p, 6)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 0, n)
+
+		_, err = cm.ReadAt("a/b/1", p, -1)
+		assert.Error(t, err)
+		_, err = cm.ReadAt("a/b/4", p, 0)
+		assert.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("list and remove", func(t *testing.T) {
+		keys, modTimes, err := cm.ListWithPrefix("a/b/")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2"}, keys)
+		assert.Len(t, modTimes, 2)
+		assert.WithinDuration(t, time.Now(), modTimes[0], time.Minute)
+
+		server.listPageSize = 1
+		keys, _, err = cm.ListWithPrefix("a/")
+		server.listPageSize = 0
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2", "a/c/3"}, keys)
+
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+		assert.False(t, cm.Exist("a/b/1"))
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+
+		err = cm.MultiRemove([]string{"a/b/2", "a/b/4"})
+		assert.NoError(t, err)
+		err = cm.RemoveWithPrefix("a/")
+		assert.NoError(t, err)
+		keys, _, err = cm.ListWithPrefix("a/")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestGCSChunkManager_MultipartUpload(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+	cm := newTestGCSChunkManager(t, server, GCSOption{PartSize: s3MinPartSize})
+
+	data := make([]byte, 2*s3MinPartSize+100)
+	rand.Read(data)
+	server.reset()
+	err := cm.Write("index/1", data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"InitiateMultipartUpload", "UploadPart", "UploadPart", "UploadPart", "CompleteMultipartUpload"},
+		server.operations())
+	assert.Empty(t, server.uploads)
+
+	value, err := cm.Read("index/1")
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(data, value))
+
+	// across the parts
+	p := make([]byte, 100)
+	n, err := cm.ReadAt("index/1", p, s3MinPartSize-50)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, n)
+	assert.Equal(t, data[s3MinPartSize-50:s3MinPartSize+50], p)
+
+	// the upload is aborted if a part fails
+	server.partFailures = 1
+	err = cm.Write("index/2", data)
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("index/2"))
+	assert.Empty(t, server.uploads)
+}
+
+func TestGCSChunkManager_Retry(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+	cm := newTestGCSChunkManager(t, server, GCSOption{RetryAttempts: 3})
+
+	server.failures = 2
+	err := cm.Write("a/1", []byte("value"))
+	assert.NoError(t, err)
+
+	server.failures = 3
+	err = cm.Write("a/2", []byte("value"))
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("a/2"))
+
+	server.failures = 2
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// the missing object is not retried
+	server.reset()
+	_, err = cm.Read("a/2")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"GetObject"}, server.operations())
+}
+
+func TestGCSChunkManager_TokenRefresh(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+	cm := newTestGCSChunkManager(t, server, GCSOption{})
+
+	err := cm.Write("a/1", []byte("value"))
+	require.NoError(t, err)
+
+	// a token issued to another process, the rejected token is dropped and a new one is fetched
+	token := server.tokens.lastToken()
+	_, err = cm.credentials.fetch(context.TODO())
+	require.NoError(t, err)
+	_, err = cm.Read("a/1")
+	assert.Error(t, err)
+	assert.Equal(t, "Bearer "+token, server.requests[len(server.requests)-1].header.Get("Authorization"))
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestGCSChunkManager_Checksum(t *testing.T) {
+	server := newFakeGCSServer()
+	defer server.Close()
+	cm := newTestGCSChunkManager(t, server, GCSOption{RetryAttempts: 2})
+
+	err := cm.Write("a/1", []byte("value"))
+	require.NoError(t, err)
+
+	server.corruptions = 1
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	server.corruptions = 2
+	_, err = cm.Read("a/1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatched")
+}
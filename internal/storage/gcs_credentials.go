@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// gcsScope is the OAuth 2.0 scope to read and write the objects and create the buckets
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+	// gcsDefaultTokenURI is the token endpoint of the service accounts whose key doesn't specify one
+	gcsDefaultTokenURI = "https://oauth2.googleapis.com/token"
+	// gcsDefaultMetadataHost is the metadata server of the GCE instances and the GKE pods with workload identity
+	gcsDefaultMetadataHost = "metadata.google.internal"
+	// gcsTokenExpiryDelta is how long before its expiry an access token is refreshed
+	gcsTokenExpiryDelta = time.Minute
+	// gcsJWTLifetime is the lifetime of the JWT exchanged for the access token of a service account
+	gcsJWTLifetime = time.Hour
+)
+
+// gcsTokenResponse is the access token responded by the token endpoint and the metadata server
+type gcsTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// gcsCredentials caches the OAuth 2.0 access token fetched by fetch, until it's about to expire or is invalidated.
+type gcsCredentials struct {
+	fetch func(ctx context.Context) (*gcsTokenResponse, error)
+	// projectID returns the project of the credentials, which the buckets are created in
+	projectID func(ctx context.Context) (string, error)
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// accessToken returns the cached access token, a new one is fetched if it's about to expire
+func (c *gcsCredentials) accessToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Add(gcsTokenExpiryDelta).Before(c.expiry) {
+		return c.token, nil
+	}
+	resp, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	if resp.AccessToken == "" {
+		return "", errors.New("gcs token response without access token")
+	}
+	c.token = resp.AccessToken
+	c.expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	return c.token, nil
+}
+
+// invalidate drops the cached access token, e.g. after it's rejected
+func (c *gcsCredentials) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = ""
+}
+
+// gcsServiceAccountKey is the json key file of a service account
+type gcsServiceAccountKey struct {
+	Type         string `json:"type"`
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// newGCSServiceAccountCredentials returns the credentials of the service account of the json key, the access tokens
+// are exchanged for the JWTs signed by its private key, see
+// https://developers.google.com/identity/protocols/oauth2/service-account#authorizingrequests
+func newGCSServiceAccountCredentials(client *http.Client, keyJSON []byte) (*gcsCredentials, error) {
+	key := gcsServiceAccountKey{}
+	if err := json.Unmarshal(keyJSON, &key); err != nil {
+		return nil, fmt.Errorf("invalid gcs service account key: %w", err)
+	}
+	if key.Type != "service_account" {
+		return nil, fmt.Errorf("gcs credentials of type %q are not a service account key", key.Type)
+	}
+	if key.ClientEmail == "" {
+		return nil, errors.New("gcs service account key without client_email")
+	}
+	privateKey, err := parseRSAPrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key of gcs service account %s: %w", key.ClientEmail, err)
+	}
+	tokenURI := key.TokenURI
+	if tokenURI == "" {
+		tokenURI = gcsDefaultTokenURI
+	}
+
+	return &gcsCredentials{
+		fetch: func(ctx context.Context) (*gcsTokenResponse, error) {
+			assertion, err := signGCSJWT(privateKey, key.PrivateKeyID, key.ClientEmail, tokenURI, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			form := url.Values{
+				"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+				"assertion":  {assertion},
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURI, strings.NewReader(form.Encode()))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return doGCSTokenRequest(client, req)
+		},
+		projectID: func(ctx context.Context) (string, error) {
+			if key.ProjectID == "" {
+				return "", fmt.Errorf("gcs service account key of %s without project_id", key.ClientEmail)
+			}
+			return key.ProjectID, nil
+		},
+	}, nil
+}
+
+func parseRSAPrivateKey(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not a RSA private key")
+	}
+	return key, nil
+}
+
+// signGCSJWT returns the JWT of the service account requesting an access token of gcsScope, signed with RS256
+func signGCSJWT(key *rsa.PrivateKey, keyID, email, audience string, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   email,
+		"scope": gcsScope,
+		"aud":   audience,
+		"iat":   now.Unix(),
+		"exp":   now.Add(gcsJWTLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// newGCSMetadataCredentials returns the credentials of the service account attached to the GCE instance or, with
+// workload identity, to the Kubernetes service account of the GKE pod, which are served by the metadata server.
+func newGCSMetadataCredentials(client *http.Client, metadataHost string) *gcsCredentials {
+	if metadataHost == "" {
+		metadataHost = gcsDefaultMetadataHost
+	}
+	newRequest := func(ctx context.Context, path string, query url.Values) (*http.Request, error) {
+		u := url.URL{Scheme: "http", Host: metadataHost, Path: "/computeMetadata/v1/" + path, RawQuery: query.Encode()}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+		return req, nil
+	}
+
+	return &gcsCredentials{
+		fetch: func(ctx context.Context) (*gcsTokenResponse, error) {
+			req, err := newRequest(ctx, "instance/service-accounts/default/token", url.Values{"scopes": {gcsScope}})
+			if err != nil {
+				return nil, err
+			}
+			return doGCSTokenRequest(client, req)
+		},
+		projectID: func(ctx context.Context) (string, error) {
+			req, err := newRequest(ctx, "project/project-id", nil)
+			if err != nil {
+				return "", err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return "", err
+			}
+			if resp.StatusCode != http.StatusOK {
+				return "", &gcsError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+			}
+			return strings.TrimSpace(string(data)), nil
+		},
+	}
+}
+
+// doGCSTokenRequest sends the request of an access token, the failed responses are returned as gcsError
+func doGCSTokenRequest(client *http.Client, req *http.Request) (*gcsTokenResponse, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &gcsError{StatusCode: resp.StatusCode, Code: "InvalidCredentials", Message: strings.TrimSpace(string(data))}
+	}
+	token := &gcsTokenResponse{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("invalid gcs token response: %w", err)
+	}
+	return token, nil
+}
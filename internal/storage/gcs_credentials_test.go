@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGCSProjectID   = "test-project"
+	testGCSClientEmail = "milvus@test-project.iam.gserviceaccount.com"
+)
+
+// fakeGCSTokenServer is the token endpoint of the service accounts and the metadata server,
+// the tokens it issues are "token-1", "token-2" and so on.
+type fakeGCSTokenServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	publicKey *rsa.PublicKey
+	issued    int
+	expiresIn int64
+	// failures is the number of the following token requests responded with 503 Service Unavailable
+	failures int
+}
+
+func newFakeGCSTokenServer(publicKey *rsa.PublicKey) *fakeGCSTokenServer {
+	s := &fakeGCSTokenServer{publicKey: publicKey, expiresIn: 3600}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeGCSTokenServer) host() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// lastToken returns the last issued token
+func (s *fakeGCSTokenServer) lastToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fmt.Sprintf("token-%d", s.issued)
+}
+
+func (s *fakeGCSTokenServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	switch r.URL.Path {
+	case "/token":
+		if err := s.verifyAssertion(r); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"invalid_grant","error_description":%q}`, err.Error())
+			return
+		}
+	case "/computeMetadata/v1/instance/service-accounts/default/token":
+		if r.Header.Get("Metadata-Flavor") != "Google" || r.URL.Query().Get("scopes") != gcsScope {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	case "/computeMetadata/v1/project/project-id":
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, testGCSProjectID)
+		return
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	s.issued++
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(gcsTokenResponse{
+		AccessToken: fmt.Sprintf("token-%d", s.issued),
+		ExpiresIn:   s.expiresIn,
+		TokenType:   "Bearer",
+	})
+}
+
+// verifyAssertion verifies the JWT exchanged for the token is signed by the service account
+func (s *fakeGCSTokenServer) verifyAssertion(r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		return fmt.Errorf("unexpected grant type %s", grantType)
+	}
+	parts := strings.Split(r.PostForm.Get("assertion"), ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed jwt")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(s.publicKey, crypto.SHA256, digest[:], signature); err != nil {
+		return err
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return err
+	}
+	claims := struct {
+		Iss   string `json:"iss"`
+		Scope string `json:"scope"`
+		Aud   string `json:"aud"`
+		Iat   int64  `json:"iat"`
+		Exp   int64  `json:"exp"`
+	}{}
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return err
+	}
+	if claims.Iss != testGCSClientEmail || claims.Scope != gcsScope || claims.Aud != s.URL+"/token" || claims.Exp <= claims.Iat {
+		return fmt.Errorf("unexpected claims %s", data)
+	}
+	return nil
+}
+
+// newTestGCSServiceAccountKey returns the json key of a service account, whose tokens are issued by server
+func newTestGCSServiceAccountKey(t *testing.T, key *rsa.PrivateKey, tokenURI string) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyJSON, err := json.Marshal(gcsServiceAccountKey{
+		Type:         "service_account",
+		ProjectID:    testGCSProjectID,
+		PrivateKeyID: "key-1",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail:  testGCSClientEmail,
+		TokenURI:     tokenURI,
+	})
+	require.NoError(t, err)
+	return keyJSON
+}
+
+func TestGCSServiceAccountCredentials(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newFakeGCSTokenServer(&key.PublicKey)
+	defer server.Close()
+
+	creds, err := newGCSServiceAccountCredentials(http.DefaultClient, newTestGCSServiceAccountKey(t, key, server.URL+"/token"))
+	require.NoError(t, err)
+	token, err := creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	projectID, err := creds.projectID(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, testGCSProjectID, projectID)
+
+	// the token is cached until it's about to expire or is invalidated
+	token, err = creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	creds.invalidate()
+	token, err = creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+	creds.invalidate()
+	server.expiresIn = 30
+	token, err = creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-3", token)
+	token, err = creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-4", token)
+
+	// the JWT signed by another key is rejected
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	creds, err = newGCSServiceAccountCredentials(http.DefaultClient, newTestGCSServiceAccountKey(t, otherKey, server.URL+"/token"))
+	require.NoError(t, err)
+	_, err = creds.accessToken(context.TODO())
+	assert.Error(t, err)
+	assert.False(t, isGCSRetryable(err))
+
+	_, err = newGCSServiceAccountCredentials(http.DefaultClient, []byte("not json"))
+	assert.Error(t, err)
+	_, err = newGCSServiceAccountCredentials(http.DefaultClient, []byte(`{"type":"authorized_user"}`))
+	assert.Error(t, err)
+	_, err = newGCSServiceAccountCredentials(http.DefaultClient,
+		[]byte(`{"type":"service_account","client_email":"a@b.c","private_key":"not pem"}`))
+	assert.Error(t, err)
+}
+
+func TestGCSMetadataCredentials(t *testing.T) {
+	server := newFakeGCSTokenServer(nil)
+	defer server.Close()
+
+	creds := newGCSMetadataCredentials(http.DefaultClient, server.host())
+	token, err := creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	projectID, err := creds.projectID(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, testGCSProjectID, projectID)
+
+	// the failures of the metadata server are retryable
+	creds.invalidate()
+	server.failures = 1
+	_, err = creds.accessToken(context.TODO())
+	assert.Error(t, err)
+	assert.True(t, isGCSRetryable(err))
+	token, err = creds.accessToken(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+}
@@ -21,13 +21,26 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"golang.org/x/exp/mmap"
 
 	"github.com/milvus-io/milvus/internal/log"
 )
 
+const (
+	// tmpFileSuffix is the suffix of the hidden files being written, they are renamed to the key
+	// once the content is synced so that readers never see a partially written file.
+	tmpFileSuffix = ".tmp"
+	// maxWriteAttempts bounds the retries of a write whose directory is removed concurrently.
+	maxWriteAttempts = 3
+)
+
 // LocalChunkManager is responsible for read and write local file.
+// The keys are paths relative to localPath. Writes are atomic and the directories emptied by
+// removals are cleaned up, so it could be used as the remote storage when running without MinIO.
 type LocalChunkManager struct {
 	localPath string
 }
@@ -39,36 +52,84 @@ func NewLocalChunkManager(localPath string) *LocalChunkManager {
 	}
 }
 
+func (lcm *LocalChunkManager) filePath(key string) string {
+	return path.Join(lcm.localPath, key)
+}
+
 // GetPath returns the path of local data if exists.
 func (lcm *LocalChunkManager) GetPath(key string) (string, error) {
 	if !lcm.Exist(key) {
 		return "", errors.New("local file cannot be found with key:" + key)
 	}
-	path := path.Join(lcm.localPath, key)
-	return path, nil
+	return lcm.filePath(key), nil
+}
+
+// Size returns the size of the local file.
+func (lcm *LocalChunkManager) Size(key string) (int64, error) {
+	info, err := os.Stat(lcm.filePath(key))
+	if err != nil {
+		return 0, err
+	}
+	if info.IsDir() {
+		return 0, errors.New("local file cannot be found with key:" + key)
+	}
+	return info.Size(), nil
 }
 
 // Write writes the data to local storage.
 func (lcm *LocalChunkManager) Write(key string, content []byte) error {
-	filePath := path.Join(lcm.localPath, key)
+	filePath := lcm.filePath(key)
 	dir := path.Dir(filePath)
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		err := os.MkdirAll(dir, os.ModePerm)
-		if err != nil {
+	var err error
+	for i := 0; i < maxWriteAttempts; i++ {
+		if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+		err = writeFileAtomic(filePath, content)
+		// the directory was emptied and removed by a concurrent removal, create it again
+		if !os.IsNotExist(err) {
 			return err
 		}
 	}
-	err := ioutil.WriteFile(filePath, content, 0600)
+	return err
+}
+
+// writeFileAtomic writes @content to a temporary file in the same directory and renames it to @filePath.
+func writeFileAtomic(filePath string, content []byte) error {
+	file, err := ioutil.TempFile(path.Dir(filePath), "."+path.Base(filePath)+".*"+tmpFileSuffix)
 	if err != nil {
 		return err
 	}
+	tmpPath := file.Name()
+	_, err = file.Write(content)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
+
+// MultiWrite writes the data to local storage, it stops at the first failure.
+func (lcm *LocalChunkManager) MultiWrite(contents map[string][]byte) error {
+	for key, content := range contents {
+		if err := lcm.Write(key, content); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // Exist checks whether chunk is saved to local storage.
 func (lcm *LocalChunkManager) Exist(key string) bool {
-	path := path.Join(lcm.localPath, key)
-	_, err := os.Stat(path)
+	_, err := os.Stat(lcm.filePath(key))
 	if err != nil {
 		return os.IsExist(err)
 	}
@@ -77,8 +138,7 @@ func (lcm *LocalChunkManager) Exist(key string) bool {
 
 // Read reads the local storage data if exists.
 func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
-	filePath := path.Join(lcm.localPath, key)
-	file, err := os.Open(path.Clean(filePath))
+	file, err := os.Open(path.Clean(lcm.filePath(key)))
 	if err != nil {
 		return nil, err
 	}
@@ -90,15 +150,71 @@ func (lcm *LocalChunkManager) Read(key string) ([]byte, error) {
 	return content, nil
 }
 
+// MultiRead reads the local storage data of all the keys, it fails if any of them cannot be read.
+func (lcm *LocalChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := lcm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, content)
+	}
+	return results, nil
+}
+
+// ListWithPrefix walks the local storage and returns the keys start with @prefix like an object storage does,
+// the prefix doesn't have to be a directory. Files being written are skipped.
+func (lcm *LocalChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+
+	// keep the leading slash of the prefix so that the keys returned could be compared with the ones written
+	leading := ""
+	if strings.HasPrefix(prefix, "/") {
+		leading = "/"
+	}
+	trimmed := strings.TrimPrefix(prefix, "/")
+
+	err := filepath.Walk(lcm.filePath(path.Dir(prefix)), func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the directory doesn't exist or is removed during the walk
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || isTmpFile(info.Name()) {
+			return nil
+		}
+		rel, err := filepath.Rel(lcm.localPath, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, trimmed) {
+			keys = append(keys, leading+key)
+			modTimes = append(modTimes, info.ModTime())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, modTimes, nil
+}
+
+func isTmpFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.HasSuffix(name, tmpFileSuffix)
+}
+
 // ReadAt reads specific position data of local storage if exists.
 func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, err error) {
-	path := path.Join(lcm.localPath, key)
-	at, err := mmap.Open(path)
+	at, err := mmap.Open(lcm.filePath(key))
 	defer func() {
 		if at != nil {
-			err = at.Close()
-			if err != nil {
-				log.Error(err.Error())
+			if closeErr := at.Close(); closeErr != nil {
+				log.Error(closeErr.Error())
 			}
 		}
 	}()
@@ -108,3 +224,44 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 
 	return at.ReadAt(p, off)
 }
+
+// Remove deletes the local file and the parent directories left empty.
+func (lcm *LocalChunkManager) Remove(key string) error {
+	filePath := lcm.filePath(key)
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	lcm.removeEmptyDirs(path.Dir(filePath))
+	return nil
+}
+
+// MultiRemove deletes the local files, it stops at the first failure.
+func (lcm *LocalChunkManager) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		if err := lcm.Remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWithPrefix deletes all the local files start with @prefix.
+func (lcm *LocalChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, _, err := lcm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return lcm.MultiRemove(keys)
+}
+
+// removeEmptyDirs removes @dir and its parents up to the root path until a non-empty one.
+// A directory fails to be removed if it's not empty or a file is being written to it, it's kept then.
+func (lcm *LocalChunkManager) removeEmptyDirs(dir string) {
+	root := path.Clean(lcm.localPath)
+	for dir != root && strings.HasPrefix(dir, root+"/") {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = path.Dir(dir)
+	}
+}
@@ -17,9 +17,15 @@
 package storage
 
 import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLocalChunkManager_GetPath(t *testing.T) {
@@ -55,3 +61,144 @@ func TestLocalChunkManager_Read(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res), len(bin))
 }
+
+func TestLocalChunkManager_MultiReadWrite(t *testing.T) {
+	lcm := NewLocalChunkManager(path.Join(localPath, "multi"))
+	defer lcm.RemoveWithPrefix("")
+
+	err := lcm.MultiWrite(map[string][]byte{
+		"a/1": {1},
+		"a/2": {2, 2},
+	})
+	assert.NoError(t, err)
+
+	res, err := lcm.MultiRead([]string{"a/2", "a/1"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{{2, 2}, {1}}, res)
+
+	size, err := lcm.Size("a/2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), size)
+
+	_, err = lcm.Size("a")
+	assert.Error(t, err)
+	_, err = lcm.Size("a/3")
+	assert.Error(t, err)
+
+	res, err = lcm.MultiRead([]string{"a/1", "a/3"})
+	assert.Error(t, err)
+	assert.Nil(t, res)
+
+	content := make([]byte, 2)
+	n, err := lcm.ReadAt("a/2", content, 1)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestLocalChunkManager_AtomicWrite(t *testing.T) {
+	root, err := ioutil.TempDir("", "local_chunk_manager")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	lcm := NewLocalChunkManager(root)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			content := make([]byte, 1<<16)
+			for j := range content {
+				content[j] = byte(i)
+			}
+			assert.NoError(t, lcm.Write("dir/key", content))
+		}(i)
+	}
+	wg.Wait()
+
+	// the content is written by exactly one of the writers and no temporary file is left
+	res, err := lcm.Read("dir/key")
+	assert.NoError(t, err)
+	assert.Equal(t, 1<<16, len(res))
+	for _, b := range res {
+		assert.Equal(t, res[0], b)
+	}
+	files, err := ioutil.ReadDir(path.Join(root, "dir"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+}
+
+func TestLocalChunkManager_ListWithPrefix(t *testing.T) {
+	root, err := ioutil.TempDir("", "local_chunk_manager")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	lcm := NewLocalChunkManager(root)
+
+	err = lcm.MultiWrite(map[string][]byte{
+		"files/a/1":   {1},
+		"files/a/b/2": {2},
+		"files/ab/3":  {3},
+		"other/4":     {4},
+	})
+	require.NoError(t, err)
+	// a file being written is not listed
+	err = ioutil.WriteFile(path.Join(root, "files/a/.5.123"+tmpFileSuffix), []byte{5}, 0600)
+	require.NoError(t, err)
+
+	keys, modTimes, err := lcm.ListWithPrefix("files/a/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"files/a/1", "files/a/b/2"}, keys)
+	assert.Equal(t, len(keys), len(modTimes))
+
+	keys, _, err = lcm.ListWithPrefix("files/a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"files/a/1", "files/a/b/2", "files/ab/3"}, keys)
+
+	keys, _, err = lcm.ListWithPrefix("/files/ab")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/files/ab/3"}, keys)
+
+	keys, _, err = lcm.ListWithPrefix("")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(keys))
+
+	keys, _, err = lcm.ListWithPrefix("files/c/")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestLocalChunkManager_Remove(t *testing.T) {
+	root, err := ioutil.TempDir("", "local_chunk_manager")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	lcm := NewLocalChunkManager(root)
+
+	err = lcm.MultiWrite(map[string][]byte{
+		"files/a/b/1": {1},
+		"files/a/2":   {2},
+		"files/c/3":   {3},
+		"files/c/4":   {4},
+	})
+	require.NoError(t, err)
+
+	// removing a key not exist is not an error
+	assert.NoError(t, lcm.Remove("files/a/b/5"))
+
+	// the directories emptied are removed
+	assert.NoError(t, lcm.Remove("files/a/b/1"))
+	assert.False(t, lcm.Exist("files/a/b/1"))
+	assert.False(t, lcm.Exist("files/a/b"))
+	assert.True(t, lcm.Exist("files/a/2"))
+
+	assert.NoError(t, lcm.MultiRemove([]string{"files/c/3", "files/c/4"}))
+	assert.False(t, lcm.Exist("files/c"))
+
+	assert.NoError(t, lcm.RemoveWithPrefix("files/"))
+	assert.False(t, lcm.Exist("files"))
+	// the root path is kept
+	_, err = os.Stat(root)
+	assert.NoError(t, err)
+
+	// writing to the directories removed creates them again
+	assert.NoError(t, lcm.Write("files/a/b/1", []byte{1}))
+	assert.True(t, lcm.Exist("files/a/b/1"))
+}
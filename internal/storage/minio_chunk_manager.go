@@ -19,6 +19,7 @@ package storage
 import (
 	"errors"
 	"io"
+	"time"
 
 	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 )
@@ -43,11 +44,25 @@ func (mcm *MinioChunkManager) GetPath(key string) (string, error) {
 	return key, nil
 }
 
+// Size returns the size of minio data if exists.
+func (mcm *MinioChunkManager) Size(key string) (int64, error) {
+	return mcm.minio.GetSize(key)
+}
+
 // Write writes the data to minio storage.
 func (mcm *MinioChunkManager) Write(key string, content []byte) error {
 	return mcm.minio.Save(key, string(content))
 }
 
+// MultiWrite writes the data to minio storage.
+func (mcm *MinioChunkManager) MultiWrite(contents map[string][]byte) error {
+	kvs := make(map[string]string, len(contents))
+	for key, content := range contents {
+		kvs[key] = string(content)
+	}
+	return mcm.minio.MultiSave(kvs)
+}
+
 // Exist checks whether chunk is saved to minio storage.
 func (mcm *MinioChunkManager) Exist(key string) bool {
 	return mcm.minio.Exist(key)
@@ -59,6 +74,24 @@ func (mcm *MinioChunkManager) Read(key string) ([]byte, error) {
 	return []byte(results), err
 }
 
+// MultiRead reads the minio storage data of all the keys, it fails if any of them cannot be read.
+func (mcm *MinioChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	values, err := mcm.minio.MultiLoad(keys)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]byte, 0, len(values))
+	for _, value := range values {
+		results = append(results, []byte(value))
+	}
+	return results, nil
+}
+
+// ListWithPrefix returns the keys of all the minio objects start with @prefix.
+func (mcm *MinioChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	return mcm.minio.ListWithPrefix(prefix)
+}
+
 // ReadAt reads specific position data of minio storage if exists.
 func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	results, err := mcm.minio.Load(key)
@@ -76,3 +109,18 @@ func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, erro
 
 	return n, nil
 }
+
+// Remove deletes the minio object.
+func (mcm *MinioChunkManager) Remove(key string) error {
+	return mcm.minio.Remove(key)
+}
+
+// MultiRemove deletes the minio objects.
+func (mcm *MinioChunkManager) MultiRemove(keys []string) error {
+	return mcm.minio.MultiRemove(keys)
+}
+
+// RemoveWithPrefix deletes all the minio objects start with @prefix.
+func (mcm *MinioChunkManager) RemoveWithPrefix(prefix string) error {
+	return mcm.minio.RemoveWithPrefix(prefix)
+}
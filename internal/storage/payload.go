@@ -47,6 +47,7 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -68,6 +69,7 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetOneStringFromPayload(idx int) (string, error)
+	GetOneJSONFromPayload(idx int) ([]byte, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
 	GetPayloadLengthFromReader() (int, error)
@@ -87,9 +89,18 @@ type PayloadReader struct {
 	colType          schemapb.DataType
 }
 
+// storedColumnType returns the column type used by the parquet wrapper for @colType,
+// JSON documents are kept in a string column.
+func storedColumnType(colType schemapb.DataType) schemapb.DataType {
+	if colType == schemapb.DataType_JSON {
+		return schemapb.DataType_String
+	}
+	return colType
+}
+
 // NewPayloadWriter is constructor of PayloadWriter
 func NewPayloadWriter(colType schemapb.DataType) (*PayloadWriter, error) {
-	w := C.NewPayloadWriter(C.int(colType))
+	w := C.NewPayloadWriter(C.int(storedColumnType(colType)))
 	if w == nil {
 		return nil, errors.New("create Payload writer failed")
 	}
@@ -149,6 +160,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_JSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -292,6 +309,14 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return HandleCStatus(&status, "AddOneStringToPayload failed")
 }
 
+// AddOneJSONToPayload adds one JSON document into payload
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	if w.colType != schemapb.DataType_JSON {
+		return errors.New("incorrect data type")
+	}
+	return w.AddOneStringToPayload(string(msg))
+}
+
 // AddBinaryVectorToPayload dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -367,7 +392,7 @@ func NewPayloadReader(colType schemapb.DataType, buf []byte) (*PayloadReader, er
 	if len(buf) == 0 {
 		return nil, errors.New("create Payload reader failed, buffer is empty")
 	}
-	r := C.NewPayloadReader(C.int(colType), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.long(len(buf)))
+	r := C.NewPayloadReader(C.int(storedColumnType(colType)), (*C.uint8_t)(unsafe.Pointer(&buf[0])), C.long(len(buf)))
 	if r == nil {
 		return nil, errors.New("failed to read parquet from buffer")
 	}
@@ -388,6 +413,9 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 		case schemapb.DataType_String:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_JSON:
+			val, err := r.GetOneJSONFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -585,6 +613,22 @@ func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+// GetOneJSONFromPayload returns the JSON document at @idx from payload
+func (r *PayloadReader) GetOneJSONFromPayload(idx int) ([]byte, error) {
+	if r.colType != schemapb.DataType_JSON {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cStr *C.char
+	var cSize C.int
+
+	status := C.GetOneStringFromPayload(r.payloadReaderPtr, C.int(idx), &cStr, &cSize)
+	if err := HandleCStatus(&status, "GetOneJSONFromPayload failed"); err != nil {
+		return nil, err
+	}
+	return C.GoBytes(unsafe.Pointer(cStr), cSize), nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
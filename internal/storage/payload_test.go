@@ -348,6 +348,45 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		w.ReleasePayloadWriter()
 	})
 
+	t.Run("TestAddOneJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_JSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"color":"red"}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`{"price":10}`))
+		assert.Nil(t, err)
+		err = w.AddOneStringToPayload(`{"size":1}`)
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(`{"size":2}`)
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_JSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, length)
+		doc0, err := r.GetOneJSONFromPayload(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"color":"red"}`), doc0)
+		idoc1, _, err := r.GetDataFromPayload(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"price":10}`), idoc1.([]byte))
+		_, err = r.GetOneStringFromPayload(2)
+		assert.NotNil(t, err)
+
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
 		require.Nil(t, err)
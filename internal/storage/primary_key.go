@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// PrimaryKey is the value of a primary key column, it can be an int64 or a string.
+// Keys of different data types are never equal and compare as false.
+type PrimaryKey interface {
+	GT(key PrimaryKey) bool
+	GE(key PrimaryKey) bool
+	LT(key PrimaryKey) bool
+	LE(key PrimaryKey) bool
+	EQ(key PrimaryKey) bool
+	GetValue() interface{}
+	Type() schemapb.DataType
+	String() string
+}
+
+// Int64PrimaryKey is the primary key of int64 type
+type Int64PrimaryKey struct {
+	Value int64
+}
+
+// NewInt64PrimaryKey returns a new Int64PrimaryKey
+func NewInt64PrimaryKey(v int64) *Int64PrimaryKey {
+	return &Int64PrimaryKey{Value: v}
+}
+
+// GT returns true if ip is greater than key
+func (ip *Int64PrimaryKey) GT(key PrimaryKey) bool {
+	pk, ok := key.(*Int64PrimaryKey)
+	return ok && ip.Value > pk.Value
+}
+
+// GE returns true if ip is greater than or equal to key
+func (ip *Int64PrimaryKey) GE(key PrimaryKey) bool {
+	pk, ok := key.(*Int64PrimaryKey)
+	return ok && ip.Value >= pk.Value
+}
+
+// LT returns true if ip is less than key
+func (ip *Int64PrimaryKey) LT(key PrimaryKey) bool {
+	pk, ok := key.(*Int64PrimaryKey)
+	return ok && ip.Value < pk.Value
+}
+
+// LE returns true if ip is less than or equal to key
+func (ip *Int64PrimaryKey) LE(key PrimaryKey) bool {
+	pk, ok := key.(*Int64PrimaryKey)
+	return ok && ip.Value <= pk.Value
+}
+
+// EQ returns true if ip is equal to key
+func (ip *Int64PrimaryKey) EQ(key PrimaryKey) bool {
+	pk, ok := key.(*Int64PrimaryKey)
+	return ok && ip.Value == pk.Value
+}
+
+// GetValue returns the int64 value of ip
+func (ip *Int64PrimaryKey) GetValue() interface{} {
+	return ip.Value
+}
+
+// Type returns schemapb.DataType_Int64
+func (ip *Int64PrimaryKey) Type() schemapb.DataType {
+	return schemapb.DataType_Int64
+}
+
+// String returns the decimal representation of ip
+func (ip *Int64PrimaryKey) String() string {
+	return strconv.FormatInt(ip.Value, 10)
+}
+
+// StringPrimaryKey is the primary key of string type
+type StringPrimaryKey struct {
+	Value string
+}
+
+// NewStringPrimaryKey returns a new StringPrimaryKey
+func NewStringPrimaryKey(v string) *StringPrimaryKey {
+	return &StringPrimaryKey{Value: v}
+}
+
+// GT returns true if sp is greater than key
+func (sp *StringPrimaryKey) GT(key PrimaryKey) bool {
+	pk, ok := key.(*StringPrimaryKey)
+	return ok && sp.Value > pk.Value
+}
+
+// GE returns true if sp is greater than or equal to key
+func (sp *StringPrimaryKey) GE(key PrimaryKey) bool {
+	pk, ok := key.(*StringPrimaryKey)
+	return ok && sp.Value >= pk.Value
+}
+
+// LT returns true if sp is less than key
+func (sp *StringPrimaryKey) LT(key PrimaryKey) bool {
+	pk, ok := key.(*StringPrimaryKey)
+	return ok && sp.Value < pk.Value
+}
+
+// LE returns true if sp is less than or equal to key
+func (sp *StringPrimaryKey) LE(key PrimaryKey) bool {
+	pk, ok := key.(*StringPrimaryKey)
+	return ok && sp.Value <= pk.Value
+}
+
+// EQ returns true if sp is equal to key
+func (sp *StringPrimaryKey) EQ(key PrimaryKey) bool {
+	pk, ok := key.(*StringPrimaryKey)
+	return ok && sp.Value == pk.Value
+}
+
+// GetValue returns the string value of sp
+func (sp *StringPrimaryKey) GetValue() interface{} {
+	return sp.Value
+}
+
+// Type returns schemapb.DataType_String
+func (sp *StringPrimaryKey) Type() schemapb.DataType {
+	return schemapb.DataType_String
+}
+
+// String returns the value of sp
+func (sp *StringPrimaryKey) String() string {
+	return sp.Value
+}
+
+// NewPrimaryKey wraps the raw value of a primary key column into a PrimaryKey
+func NewPrimaryKey(v interface{}) (PrimaryKey, error) {
+	switch pk := v.(type) {
+	case int64:
+		return NewInt64PrimaryKey(pk), nil
+	case string:
+		return NewStringPrimaryKey(pk), nil
+	default:
+		return nil, fmt.Errorf("unsupported primary key type %T", v)
+	}
+}
+
+// NewInt64PrimaryKeys wraps int64 values into primary keys
+func NewInt64PrimaryKeys(values []int64) []PrimaryKey {
+	pks := make([]PrimaryKey, 0, len(values))
+	for _, v := range values {
+		pks = append(pks, NewInt64PrimaryKey(v))
+	}
+	return pks
+}
+
+// NewStringPrimaryKeys wraps string values into primary keys
+func NewStringPrimaryKeys(values []string) []PrimaryKey {
+	pks := make([]PrimaryKey, 0, len(values))
+	for _, v := range values {
+		pks = append(pks, NewStringPrimaryKey(v))
+	}
+	return pks
+}
+
+// ParseDeleteRequestPrimaryKeys returns the primary keys carried by a delete request,
+// string primary keys take precedence over int64 ones.
+func ParseDeleteRequestPrimaryKeys(req *internalpb.DeleteRequest) []PrimaryKey {
+	if len(req.GetStringPrimaryKeys()) > 0 {
+		return NewStringPrimaryKeys(req.GetStringPrimaryKeys())
+	}
+	return NewInt64PrimaryKeys(req.GetPrimaryKeys())
+}
+
+// pkBytes returns the bytes representation of pk which is put into bloom filters,
+// int64 keys keep the 8 bytes encoding used by the existing stats logs.
+func pkBytes(pk PrimaryKey) []byte {
+	switch key := pk.(type) {
+	case *Int64PrimaryKey:
+		b := make([]byte, 8)
+		common.Endian.PutUint64(b, uint64(key.Value))
+		return b
+	case *StringPrimaryKey:
+		return []byte(key.Value)
+	default:
+		return nil
+	}
+}
+
+// AddPKToBloomFilter adds pk into the bloom filter bf
+func AddPKToBloomFilter(bf *bloom.BloomFilter, pk PrimaryKey) {
+	bf.Add(pkBytes(pk))
+}
+
+// TestPKInBloomFilter returns false if pk is definitely not in the bloom filter bf
+func TestPKInBloomFilter(bf *bloom.BloomFilter, pk PrimaryKey) bool {
+	return bf.Test(pkBytes(pk))
+}
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt64PrimaryKey(t *testing.T) {
+	pk := NewInt64PrimaryKey(10)
+	assert.Equal(t, schemapb.DataType_Int64, pk.Type())
+	assert.Equal(t, int64(10), pk.GetValue())
+	assert.Equal(t, "10", pk.String())
+
+	assert.True(t, pk.GT(NewInt64PrimaryKey(9)))
+	assert.True(t, pk.GE(NewInt64PrimaryKey(10)))
+	assert.True(t, pk.LT(NewInt64PrimaryKey(11)))
+	assert.True(t, pk.LE(NewInt64PrimaryKey(10)))
+	assert.True(t, pk.EQ(NewInt64PrimaryKey(10)))
+
+	// keys of different types never match
+	assert.False(t, pk.EQ(NewStringPrimaryKey("10")))
+	assert.False(t, pk.GT(NewStringPrimaryKey("1")))
+	assert.False(t, pk.LT(NewStringPrimaryKey("2")))
+}
+
+func TestStringPrimaryKey(t *testing.T) {
+	pk := NewStringPrimaryKey("b")
+	assert.Equal(t, schemapb.DataType_String, pk.Type())
+	assert.Equal(t, "b", pk.GetValue())
+	assert.Equal(t, "b", pk.String())
+
+	assert.True(t, pk.GT(NewStringPrimaryKey("a")))
+	assert.True(t, pk.GE(NewStringPrimaryKey("b")))
+	assert.True(t, pk.LT(NewStringPrimaryKey("c")))
+	assert.True(t, pk.LE(NewStringPrimaryKey("b")))
+	assert.True(t, pk.EQ(NewStringPrimaryKey("b")))
+
+	assert.False(t, pk.EQ(NewInt64PrimaryKey(1)))
+	assert.False(t, pk.GE(NewInt64PrimaryKey(1)))
+	assert.False(t, pk.LE(NewInt64PrimaryKey(1)))
+}
+
+func TestNewPrimaryKey(t *testing.T) {
+	pk, err := NewPrimaryKey(int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, NewInt64PrimaryKey(1), pk)
+
+	pk, err = NewPrimaryKey("uuid")
+	assert.NoError(t, err)
+	assert.Equal(t, NewStringPrimaryKey("uuid"), pk)
+
+	_, err = NewPrimaryKey(float32(1))
+	assert.Error(t, err)
+}
+
+func TestParseDeleteRequestPrimaryKeys(t *testing.T) {
+	pks := ParseDeleteRequestPrimaryKeys(&internalpb.DeleteRequest{PrimaryKeys: []int64{1, 2}})
+	assert.Equal(t, []PrimaryKey{NewInt64PrimaryKey(1), NewInt64PrimaryKey(2)}, pks)
+
+	pks = ParseDeleteRequestPrimaryKeys(&internalpb.DeleteRequest{StringPrimaryKeys: []string{"a", "b"}})
+	assert.Equal(t, []PrimaryKey{NewStringPrimaryKey("a"), NewStringPrimaryKey("b")}, pks)
+}
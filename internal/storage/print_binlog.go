@@ -287,6 +287,18 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Printf("\t\t%d : %s\n", i, val)
 		}
+	case schemapb.DataType_JSON:
+		rows, err := reader.GetPayloadLengthFromReader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rows; i++ {
+			val, err := reader.GetOneJSONFromPayload(i)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\t\t%d : %s\n", i, val)
+		}
 	case schemapb.DataType_BinaryVector:
 		val, dim, err := reader.GetBinaryVectorFromPayload()
 		if err != nil {
@@ -0,0 +1,408 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// s3MinPartSize is the minimum size of the parts of a multipart upload except the last one
+	s3MinPartSize = 5 * 1024 * 1024
+	// defaultPartSize is the part size of the multipart uploads if it's not configured
+	defaultPartSize = 64 * 1024 * 1024
+)
+
+// the ETag of an object uploaded at once without SSE-KMS is the md5 checksum of its content
+var md5ETagPattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// S3Option is the option to connect to AWS S3 or a storage compatible with its API, like GCS and MinIO.
+type S3Option struct {
+	Address         string
+	Region          string
+	BucketName      string
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+	// UseIAM takes the credentials from the environment, the EC2 instance or the ECS task role instead of the keys
+	UseIAM      bool
+	IAMEndpoint string
+	// SSEKMSKeyID encrypts the objects with SSE-KMS by the key if it's not empty
+	SSEKMSKeyID  string
+	CreateBucket bool
+
+	// PartSize is the size of each part of the multipart uploads, the objects not larger than it are uploaded at once
+	PartSize int64
+	// RetryAttempts and RetrySleep are the attempts and the initial backoff of each request
+	RetryAttempts uint
+	RetrySleep    time.Duration
+}
+
+// S3ChunkManager is responsible for read and write data stored in AWS S3 or a storage compatible with its API.
+// The large files are uploaded part by part, each part and the small files are verified by their md5 checksums.
+type S3ChunkManager struct {
+	ctx        context.Context
+	core       *minio.Core
+	bucketName string
+	sse        encrypt.ServerSide
+	partSize   int64
+	retryOpts  []retry.Option
+}
+
+// NewS3ChunkManager creates a new S3ChunkManager, the bucket is created if it doesn't exist and CreateBucket is set.
+func NewS3ChunkManager(ctx context.Context, option *S3Option) (*S3ChunkManager, error) {
+	var creds *credentials.Credentials
+	if option.UseIAM {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{
+				Client:   &http.Client{Transport: http.DefaultTransport},
+				Endpoint: option.IAMEndpoint,
+			},
+		})
+	} else {
+		creds = credentials.NewStaticV4(option.AccessKeyID, option.SecretAccessKey, "")
+	}
+	core, err := minio.NewCore(option.Address, &minio.Options{
+		Creds:  creds,
+		Secure: option.UseSSL,
+		Region: option.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sse encrypt.ServerSide
+	if option.SSEKMSKeyID != "" {
+		sse, err = encrypt.NewSSEKMS(option.SSEKMSKeyID, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	partSize := option.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+	if partSize < s3MinPartSize {
+		return nil, fmt.Errorf("part size %d is less than the minimum %d", partSize, s3MinPartSize)
+	}
+
+	cm := &S3ChunkManager{
+		ctx:        ctx,
+		core:       core,
+		bucketName: option.BucketName,
+		sse:        sse,
+		partSize:   partSize,
+		retryOpts:  retryOptions(option.RetryAttempts, option.RetrySleep),
+	}
+
+	err = cm.withRetry(func() error {
+		exist, err := core.BucketExists(ctx, option.BucketName)
+		if err != nil || exist {
+			return err
+		}
+		if !option.CreateBucket {
+			return retry.Unrecoverable(fmt.Errorf("bucket %s not Existed", option.BucketName))
+		}
+		return core.MakeBucket(ctx, option.BucketName, minio.MakeBucketOptions{Region: option.Region})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// retryOptions returns the options of util/retry, at least one attempt is made
+func retryOptions(attempts uint, sleep time.Duration) []retry.Option {
+	if attempts == 0 {
+		attempts = 1
+	}
+	opts := []retry.Option{retry.Attempts(attempts)}
+	if sleep > 0 {
+		opts = append(opts, retry.Sleep(sleep))
+	}
+	return opts
+}
+
+// isS3Retryable tells whether the failed request might succeed if sent again,
+// the requests failed without responses, like the network errors, are retryable.
+func isS3Retryable(err error) bool {
+	if retry.IsUncoverable(err) {
+		return false
+	}
+	resp := minio.ToErrorResponse(err)
+	return resp.StatusCode == 0 || isRetryableStatus(resp.StatusCode)
+}
+
+func (cm *S3ChunkManager) withRetry(fn func() error) error {
+	return doWithRetry(cm.ctx, fn, isS3Retryable, cm.retryOpts...)
+}
+
+// GetPath returns the path of the object if exists.
+func (cm *S3ChunkManager) GetPath(key string) (string, error) {
+	if !cm.Exist(key) {
+		return "", errors.New("s3 file manage cannot be found with key:" + key)
+	}
+	return key, nil
+}
+
+// Size returns the size of the object.
+func (cm *S3ChunkManager) Size(key string) (int64, error) {
+	var size int64
+	err := cm.withRetry(func() error {
+		info, err := cm.core.StatObject(cm.ctx, cm.bucketName, key, minio.StatObjectOptions{})
+		size = info.Size
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Write uploads the content as the object, with a multipart upload if it's larger than the part size.
+func (cm *S3ChunkManager) Write(key string, content []byte) error {
+	if int64(len(content)) > cm.partSize {
+		return cm.multipartUpload(key, content)
+	}
+	return cm.withRetry(func() error {
+		_, err := cm.core.PutObject(cm.ctx, cm.bucketName, key, bytes.NewReader(content), int64(len(content)),
+			md5Base64(content), "", minio.PutObjectOptions{ServerSideEncryption: cm.sse})
+		return err
+	})
+}
+
+func (cm *S3ChunkManager) multipartUpload(key string, content []byte) error {
+	var uploadID string
+	err := cm.withRetry(func() error {
+		var err error
+		uploadID, err = cm.core.NewMultipartUpload(cm.ctx, cm.bucketName, key, minio.PutObjectOptions{ServerSideEncryption: cm.sse})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	size := int64(len(content))
+	parts := make([]minio.CompletePart, 0, (size+cm.partSize-1)/cm.partSize)
+	for start := int64(0); start < size; start += cm.partSize {
+		end := start + cm.partSize
+		if end > size {
+			end = size
+		}
+		data := content[start:end]
+		partID := len(parts) + 1
+		var part minio.ObjectPart
+		err = cm.withRetry(func() error {
+			var err error
+			part, err = cm.core.PutObjectPart(cm.ctx, cm.bucketName, key, uploadID, partID, bytes.NewReader(data), int64(len(data)),
+				md5Base64(data), "", cm.sse)
+			return err
+		})
+		if err != nil {
+			cm.abortMultipartUpload(key, uploadID)
+			return err
+		}
+		parts = append(parts, minio.CompletePart{PartNumber: partID, ETag: part.ETag})
+	}
+
+	err = cm.withRetry(func() error {
+		_, err := cm.core.CompleteMultipartUpload(cm.ctx, cm.bucketName, key, uploadID, parts)
+		return err
+	})
+	if err != nil {
+		cm.abortMultipartUpload(key, uploadID)
+		return err
+	}
+	return nil
+}
+
+// abortMultipartUpload removes the uploaded parts, the error is ignored since they're cleaned up by the lifecycle rules anyway
+func (cm *S3ChunkManager) abortMultipartUpload(key, uploadID string) {
+	_ = cm.withRetry(func() error {
+		return cm.core.AbortMultipartUpload(cm.ctx, cm.bucketName, key, uploadID)
+	})
+}
+
+// MultiWrite writes the contents as the objects, it stops at the first failure.
+func (cm *S3ChunkManager) MultiWrite(contents map[string][]byte) error {
+	for key, content := range contents {
+		if err := cm.Write(key, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exist checks whether the object exists.
+func (cm *S3ChunkManager) Exist(key string) bool {
+	_, err := cm.Size(key)
+	return err == nil
+}
+
+// Read downloads the whole object, which is verified by the ETag if it's the md5 checksum.
+func (cm *S3ChunkManager) Read(key string) ([]byte, error) {
+	var data []byte
+	err := cm.withRetry(func() error {
+		reader, info, _, err := cm.core.GetObject(cm.ctx, cm.bucketName, key, minio.GetObjectOptions{})
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		data, err = ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		return cm.verifyETag(key, info.ETag, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (cm *S3ChunkManager) verifyETag(key string, etag string, data []byte) error {
+	if cm.sse != nil || !md5ETagPattern.MatchString(etag) {
+		return nil
+	}
+	sum := md5.Sum(data)
+	if checksum := hex.EncodeToString(sum[:]); checksum != etag {
+		return fmt.Errorf("checksum mismatched of %s, etag: %s, checksum: %s", key, etag, checksum)
+	}
+	return nil
+}
+
+// MultiRead downloads the objects, it fails if any of them cannot be read.
+func (cm *S3ChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		data, err := cm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, data)
+	}
+	return results, nil
+}
+
+// ListWithPrefix returns the keys and the last modified time of all the objects start with @prefix.
+func (cm *S3ChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	var keys []string
+	var modTimes []time.Time
+	err := cm.withRetry(func() error {
+		keys, modTimes = nil, nil
+		for object := range cm.core.Client.ListObjects(cm.ctx, cm.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if object.Err != nil {
+				return object.Err
+			}
+			keys = append(keys, object.Key)
+			modTimes = append(modTimes, object.LastModified)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, modTimes, nil
+}
+
+// ReadAt downloads the range of the object starting at @off into p,
+// io.EOF is returned if the object ends before p is filled.
+func (cm *S3ChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("S3ChunkManager: invalid offset")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var n int
+	err := cm.withRetry(func() error {
+		opts := minio.GetObjectOptions{}
+		if err := opts.SetRange(off, off+int64(len(p))-1); err != nil {
+			return retry.Unrecoverable(err)
+		}
+		reader, info, _, err := cm.core.GetObject(cm.ctx, cm.bucketName, key, opts)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		n, err = io.ReadFull(reader, p)
+		if (err == io.EOF || err == io.ErrUnexpectedEOF) && int64(n) == info.Size {
+			// the object ends before p is filled, otherwise the response is truncated and read again
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "InvalidRange" {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Remove deletes the object, it's not an error if the object doesn't exist.
+func (cm *S3ChunkManager) Remove(key string) error {
+	err := cm.withRetry(func() error {
+		return cm.core.RemoveObject(cm.ctx, cm.bucketName, key, minio.RemoveObjectOptions{})
+	})
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return nil
+	}
+	return err
+}
+
+// MultiRemove deletes the objects, it stops at the first failure.
+func (cm *S3ChunkManager) MultiRemove(keys []string) error {
+	for _, key := range keys {
+		if err := cm.Remove(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveWithPrefix deletes all the objects start with @prefix.
+func (cm *S3ChunkManager) RemoveWithPrefix(prefix string) error {
+	keys, _, err := cm.ListWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	return cm.MultiRemove(keys)
+}
@@ -0,0 +1,636 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRequest is a request received by the fake servers
+type fakeRequest struct {
+	operation string
+	header    http.Header
+}
+
+type fakeS3Object struct {
+	data    []byte
+	etag    string
+	modTime time.Time
+}
+
+// fakeS3Server is an in-memory server of the part of the S3 API used by the S3ChunkManager
+type fakeS3Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	buckets  map[string]map[string]*fakeS3Object
+	uploads  map[string]map[int][]byte
+	uploadID int
+	requests []fakeRequest
+
+	// failures is the number of the following requests responded with 503 Service Unavailable
+	failures int
+	// corruptions is the number of the following objects responded with a corrupted byte
+	corruptions int
+	// listPageSize limits the keys listed in each response if it's not zero
+	listPageSize int
+}
+
+func newFakeS3Server() *fakeS3Server {
+	s := &fakeS3Server{
+		buckets: make(map[string]map[string]*fakeS3Object),
+		uploads: make(map[string]map[int][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *fakeS3Server) address() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// operations returns the operations of the received requests
+func (s *fakeS3Server) operations() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	operations := make([]string, 0, len(s.requests))
+	for _, req := range s.requests {
+		operations = append(operations, req.operation)
+	}
+	return operations
+}
+
+func (s *fakeS3Server) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *fakeS3Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucketName, key := r.URL.Path[1:], ""
+	if i := strings.Index(bucketName, "/"); i >= 0 {
+		bucketName, key = bucketName[:i], bucketName[i+1:]
+	}
+	query := r.URL.Query()
+	operation := s3Operation(r.Method, key, query)
+	s.requests = append(s.requests, fakeRequest{operation: operation, header: r.Header.Clone()})
+
+	if s.failures > 0 {
+		s.failures--
+		writeS3Error(w, http.StatusServiceUnavailable, "SlowDown")
+		return
+	}
+
+	if operation == "CreateBucket" {
+		if _, ok := s.buckets[bucketName]; !ok {
+			s.buckets[bucketName] = make(map[string]*fakeS3Object)
+		}
+		return
+	}
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch operation {
+	case "HeadBucket":
+	case "GetBucketLocation":
+		fmt.Fprint(w, `<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></LocationConstraint>`)
+	case "ListObjectsV2":
+		s.listObjects(w, bucket, query)
+	case "PutObject":
+		data, ok := readS3Body(w, r)
+		if !ok {
+			return
+		}
+		bucket[key] = &fakeS3Object{data: data, etag: md5Hex(data), modTime: time.Now()}
+		w.Header().Set("ETag", `"`+bucket[key].etag+`"`)
+	case "CreateMultipartUpload":
+		s.uploadID++
+		uploadID := strconv.Itoa(s.uploadID)
+		s.uploads[uploadID] = make(map[int][]byte)
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`,
+			bucketName, key, uploadID)
+	case "UploadPart":
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		data, ok := readS3Body(w, r)
+		if !ok {
+			return
+		}
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[partNumber] = data
+		w.Header().Set("ETag", `"`+md5Hex(data)+`"`)
+	case "CompleteMultipartUpload":
+		s.completeMultipartUpload(w, r, bucketName, bucket, key)
+	case "AbortMultipartUpload":
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case "GetObject", "HeadObject":
+		s.getObject(w, r, bucket, key)
+	case "DeleteObject":
+		delete(bucket, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func s3Operation(method string, key string, query map[string][]string) string {
+	has := func(name string) bool {
+		_, ok := query[name]
+		return ok
+	}
+	if key == "" {
+		switch {
+		case method == http.MethodPut:
+			return "CreateBucket"
+		case method == http.MethodHead:
+			return "HeadBucket"
+		case has("location"):
+			return "GetBucketLocation"
+		default:
+			return "ListObjectsV2"
+		}
+	}
+	switch method {
+	case http.MethodPut:
+		if has("uploadId") {
+			return "UploadPart"
+		}
+		return "PutObject"
+	case http.MethodPost:
+		if has("uploads") {
+			return "CreateMultipartUpload"
+		}
+		return "CompleteMultipartUpload"
+	case http.MethodDelete:
+		if has("uploadId") {
+			return "AbortMultipartUpload"
+		}
+		return "DeleteObject"
+	case http.MethodHead:
+		return "HeadObject"
+	default:
+		return "GetObject"
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// readS3Body reads the body, which is verified by the Content-MD5 header
+func readS3Body(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	var data []byte
+	var err error
+	if r.Header.Get("X-Amz-Content-Sha256") == "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		data, err = decodeAwsChunked(r.Body)
+	} else {
+		data, err = ioutil.ReadAll(r.Body)
+	}
+	if err != nil {
+		writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+		return nil, false
+	}
+	if checksum := r.Header.Get("Content-MD5"); checksum != "" && checksum != md5Base64(data) {
+		writeS3Error(w, http.StatusBadRequest, "BadDigest")
+		return nil, false
+	}
+	return data, true
+}
+
+// decodeAwsChunked decodes the body uploaded with the streaming signature, which is made of
+// the chunks formatted as "<hex size>;chunk-signature=<signature>\r\n<data>\r\n"
+func decodeAwsChunked(body io.Reader) ([]byte, error) {
+	reader := bufio.NewReader(body)
+	var data []byte
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(line), ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func (s *fakeS3Server) listObjects(w http.ResponseWriter, bucket map[string]*fakeS3Object, query map[string][]string) {
+	type object struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Prefix                string
+		KeyCount              int
+		IsTruncated           bool
+		NextContinuationToken string
+		Contents              []object
+	}{}
+	prefix, start := "", ""
+	if values := query["prefix"]; len(values) > 0 {
+		prefix = values[0]
+	}
+	if values := query["continuation-token"]; len(values) > 0 {
+		start = values[0]
+	}
+
+	keys := make([]string, 0, len(bucket))
+	for key := range bucket {
+		if strings.HasPrefix(key, prefix) && key > start {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if s.listPageSize > 0 && len(keys) > s.listPageSize {
+		keys = keys[:s.listPageSize]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	result.Prefix = prefix
+	result.KeyCount = len(keys)
+	for _, key := range keys {
+		obj := bucket[key]
+		result.Contents = append(result.Contents, object{
+			Key:          key,
+			LastModified: obj.modTime.UTC().Format(time.RFC3339),
+			ETag:         `"` + obj.etag + `"`,
+			Size:         len(obj.data),
+		})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (s *fakeS3Server) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucketName string, bucket map[string]*fakeS3Object, key string) {
+	uploadID := r.URL.Query().Get("uploadId")
+	parts, ok := s.uploads[uploadID]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	complete := struct {
+		Parts []struct {
+			PartNumber int
+			ETag       string
+		} `xml:"Part"`
+	}{}
+	if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+		writeS3Error(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+	var data, checksums []byte
+	for _, part := range complete.Parts {
+		partData, ok := parts[part.PartNumber]
+		if !ok || md5Hex(partData) != strings.Trim(part.ETag, `"`) {
+			writeS3Error(w, http.StatusBadRequest, "InvalidPart")
+			return
+		}
+		data = append(data, partData...)
+		sum := md5.Sum(partData)
+		checksums = append(checksums, sum[:]...)
+	}
+	delete(s.uploads, uploadID)
+	etag := fmt.Sprintf("%s-%d", md5Hex(checksums), len(complete.Parts))
+	bucket[key] = &fakeS3Object{data: data, etag: etag, modTime: time.Now()}
+	fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>"%s"</ETag></CompleteMultipartUploadResult>`,
+		bucketName, key, etag)
+}
+
+func (s *fakeS3Server) getObject(w http.ResponseWriter, r *http.Request, bucket map[string]*fakeS3Object, key string) {
+	obj, ok := bucket[key]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+	data, status := obj.data, http.StatusOK
+	if rng := r.Header.Get("Range"); rng != "" {
+		var start, end int
+		if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+		if start >= len(data) {
+			writeS3Error(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+		if end >= len(data) {
+			end = len(data) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		data, status = data[start:end+1], http.StatusPartialContent
+	}
+	if r.Method == http.MethodGet && s.corruptions > 0 && len(data) > 0 {
+		s.corruptions--
+		data = append([]byte{data[0] + 1}, data[1:]...)
+	}
+	w.Header().Set("ETag", `"`+obj.etag+`"`)
+	w.Header().Set("Last-Modified", obj.modTime.UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(data)
+	}
+}
+
+func newTestS3ChunkManager(t *testing.T, server *fakeS3Server, option S3Option) *S3ChunkManager {
+	option.Address = server.address()
+	option.Region = "us-east-1"
+	option.BucketName = "test-bucket"
+	option.AccessKeyID = "access"
+	option.SecretAccessKey = "secret"
+	option.CreateBucket = true
+	option.RetrySleep = time.Millisecond
+	cm, err := NewS3ChunkManager(context.TODO(), &option)
+	require.NoError(t, err)
+	return cm
+}
+
+// disableMinioRetry stops the minio client retrying the failed requests by itself, so that the retries of the chunk manager are tested
+func disableMinioRetry(t *testing.T) {
+	maxRetry := minio.MaxRetry
+	minio.MaxRetry = 1
+	t.Cleanup(func() { minio.MaxRetry = maxRetry })
+}
+
+func TestNewS3ChunkManager(t *testing.T) {
+	server := newFakeS3Server()
+	defer server.Close()
+
+	option := &S3Option{
+		Address:    server.address(),
+		Region:     "us-east-1",
+		BucketName: "test-bucket",
+	}
+	_, err := NewS3ChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+	assert.Empty(t, server.buckets)
+
+	option.CreateBucket = true
+	_, err = NewS3ChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+	assert.Contains(t, server.buckets, "test-bucket")
+
+	option.CreateBucket = false
+	_, err = NewS3ChunkManager(context.TODO(), option)
+	assert.NoError(t, err)
+
+	option.PartSize = 1024
+	_, err = NewS3ChunkManager(context.TODO(), option)
+	assert.Error(t, err)
+}
+
+func TestS3ChunkManager(t *testing.T) {
+	server := newFakeS3Server()
+	defer server.Close()
+	cm := newTestS3ChunkManager(t, server, S3Option{})
+
+	t.Run("read and write", func(t *testing.T) {
+		err := cm.Write("a/b/1", []byte("value1"))
+		require.NoError(t, err)
+		assert.True(t, cm.Exist("a/b/1"))
+		size, err := cm.Size("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), size)
+		value, err := cm.Read("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value1"), value)
+		p, err := cm.GetPath("a/b/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "a/b/1", p)
+
+		err = cm.MultiWrite(map[string][]byte{"a/b/2": []byte("value2"), "a/c/3": []byte("value3")})
+		assert.NoError(t, err)
+		values, err := cm.MultiRead([]string{"a/b/2", "a/c/3"})
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte("value2"), []byte("value3")}, values)
+
+		assert.False(t, cm.Exist("a/b/4"))
+		_, err = cm.Size("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.Read("a/b/4")
+		assert.Error(t, err)
+		_, err = cm.GetPath("a/b/4")
+		assert.Error(t, err)
+		values, err = cm.MultiRead([]string{"a/b/1", "a/b/4"})
+		assert.Error(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("read at", func(t *testing.T) {
+		p := make([]byte, 3)
+		n, err := cm.ReadAt("a/b/1", p, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []byte("alu"), p)
+
+		n, err = cm.ReadAt("a/b/1", p, 4)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []byte("e1"), p[:n])
+
+		n, err = cm.ReadAt("a/b/1", p, 6)
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, 0, n)
+
+		_, err = cm.ReadAt("a/b/1", p, -1)
+		assert.Error(t, err)
+		_, err = cm.ReadAt("a/b/4", p, 0)
+		assert.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("list and remove", func(t *testing.T) {
+		keys, modTimes, err := cm.ListWithPrefix("a/b/")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2"}, keys)
+		assert.Len(t, modTimes, 2)
+		assert.WithinDuration(t, time.Now(), modTimes[0], time.Minute)
+
+		server.listPageSize = 1
+		keys, _, err = cm.ListWithPrefix("a/")
+		server.listPageSize = 0
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/b/1", "a/b/2", "a/c/3"}, keys)
+
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+		assert.False(t, cm.Exist("a/b/1"))
+		err = cm.Remove("a/b/1")
+		assert.NoError(t, err)
+
+		err = cm.MultiRemove([]string{"a/b/2", "a/b/4"})
+		assert.NoError(t, err)
+		err = cm.RemoveWithPrefix("a/")
+		assert.NoError(t, err)
+		keys, _, err = cm.ListWithPrefix("a/")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestS3ChunkManager_MultipartUpload(t *testing.T) {
+	server := newFakeS3Server()
+	defer server.Close()
+	cm := newTestS3ChunkManager(t, server, S3Option{PartSize: s3MinPartSize})
+
+	data := make([]byte, 2*s3MinPartSize+1024)
+	rand.Read(data)
+	server.reset()
+	err := cm.Write("index/1", data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CreateMultipartUpload", "UploadPart", "UploadPart", "UploadPart", "CompleteMultipartUpload"}, server.operations())
+	assert.Empty(t, server.uploads)
+
+	value, err := cm.Read("index/1")
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(data, value))
+
+	// across the parts
+	p := make([]byte, 100)
+	n, err := cm.ReadAt("index/1", p, s3MinPartSize-50)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, n)
+	assert.Equal(t, data[s3MinPartSize-50:s3MinPartSize+50], p)
+
+	// the upload is aborted if a part fails
+	disableMinioRetry(t)
+	server.reset()
+	server.failures = 3
+	err = cm.Write("index/2", data)
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("index/2"))
+	assert.Empty(t, server.uploads)
+}
+
+func TestS3ChunkManager_Retry(t *testing.T) {
+	disableMinioRetry(t)
+	server := newFakeS3Server()
+	defer server.Close()
+	cm := newTestS3ChunkManager(t, server, S3Option{RetryAttempts: 3})
+
+	server.failures = 2
+	err := cm.Write("a/1", []byte("value"))
+	assert.NoError(t, err)
+
+	server.failures = 3
+	err = cm.Write("a/2", []byte("value"))
+	assert.Error(t, err)
+	assert.False(t, cm.Exist("a/2"))
+
+	server.failures = 2
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// the missing key is not retried
+	server.reset()
+	_, err = cm.Read("a/2")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"GetObject"}, server.operations())
+}
+
+func TestS3ChunkManager_Checksum(t *testing.T) {
+	server := newFakeS3Server()
+	defer server.Close()
+	cm := newTestS3ChunkManager(t, server, S3Option{RetryAttempts: 2})
+
+	err := cm.Write("a/1", []byte("value"))
+	require.NoError(t, err)
+
+	server.corruptions = 1
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	server.corruptions = 2
+	_, err = cm.Read("a/1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatched")
+}
+
+func TestS3ChunkManager_SSEKMS(t *testing.T) {
+	server := newFakeS3Server()
+	defer server.Close()
+	cm := newTestS3ChunkManager(t, server, S3Option{SSEKMSKeyID: "kms-key", PartSize: s3MinPartSize})
+
+	server.reset()
+	err := cm.Write("a/1", []byte("value"))
+	require.NoError(t, err)
+	err = cm.Write("a/2", make([]byte, s3MinPartSize+1))
+	require.NoError(t, err)
+
+	for _, req := range server.requests {
+		if req.operation == "PutObject" || req.operation == "CreateMultipartUpload" {
+			assert.Equal(t, "aws:kms", req.header.Get("X-Amz-Server-Side-Encryption"))
+			assert.Equal(t, "kms-key", req.header.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"))
+		}
+	}
+
+	value, err := cm.Read("a/1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
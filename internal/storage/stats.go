@@ -18,17 +18,30 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/spaolacci/murmur3"
 )
 
 const (
 	// TODO silverxia maybe need set from config
 	bloomFilterSize       uint    = 100000
 	maxBloomFalsePositive float64 = 0.005
+
+	// 2^10 registers of the ndv sketch, the standard error is about 3%
+	ndvSketchPrecision = 10
 )
 
+// errNotPrimaryKeyStats is returned when the stats of a field other than the primary key are read as PrimaryKeyStats
+var errNotPrimaryKeyStats = errors.New("not primary key stats")
+
 // Int64Stats contains statistics data for int64 column
 type Int64Stats struct {
 	FieldID int64              `json:"fieldID"`
@@ -37,6 +50,242 @@ type Int64Stats struct {
 	BF      *bloom.BloomFilter `json:"bf"`
 }
 
+// StringStats contains statistics data for string column
+type StringStats struct {
+	FieldID int64              `json:"fieldID"`
+	Max     string             `json:"max"`
+	Min     string             `json:"min"`
+	BF      *bloom.BloomFilter `json:"bf"`
+}
+
+// PrimaryKeyStats contains statistics data for primary key column, it reads
+// both the Int64Stats and the StringStats written by StatsWriter
+type PrimaryKeyStats struct {
+	FieldID int64
+	Max     PrimaryKey
+	Min     PrimaryKey
+	BF      *bloom.BloomFilter
+}
+
+// UnmarshalJSON decodes stats written by StatsWriter into PrimaryKeyStats,
+// the primary key type is decided by the json type of max and min
+func (stats *PrimaryKeyStats) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		FieldID int64              `json:"fieldID"`
+		Max     json.RawMessage    `json:"max"`
+		Min     json.RawMessage    `json:"min"`
+		BF      *bloom.BloomFilter `json:"bf"`
+		Type    schemapb.DataType  `json:"type"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Type != schemapb.DataType_None {
+		return errNotPrimaryKeyStats
+	}
+	stats.FieldID = raw.FieldID
+	stats.BF = raw.BF
+
+	var err error
+	if stats.Max, err = unmarshalPrimaryKey(raw.Max); err != nil {
+		return err
+	}
+	if stats.Min, err = unmarshalPrimaryKey(raw.Min); err != nil {
+		return err
+	}
+	return nil
+}
+
+func unmarshalPrimaryKey(data json.RawMessage) (PrimaryKey, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return NewStringPrimaryKey(v), nil
+	}
+	var v int64
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+	}
+	return NewInt64PrimaryKey(v), nil
+}
+
+// FieldStats contains statistics data for a numeric column which isn't the primary key
+type FieldStats struct {
+	FieldID int64             `json:"fieldID"`
+	Type    schemapb.DataType `json:"type"`
+	// Max and Min are empty if the range is unknown, which is the case if there is no value, or any
+	// value of a floating column is NaN or infinity
+	Max       json.Number `json:"max,omitempty"`
+	Min       json.Number `json:"min,omitempty"`
+	RowCount  int64       `json:"rowCount"`
+	NullCount int64       `json:"nullCount"`
+	NDV       *NDVSketch  `json:"ndv"`
+}
+
+// IntRange returns the range of an integer column, false if it's unknown
+func (stats *FieldStats) IntRange() (int64, int64, bool) {
+	if stats.Min == "" || stats.Max == "" {
+		return 0, 0, false
+	}
+	min, err := stats.Min.Int64()
+	if err != nil {
+		return 0, 0, false
+	}
+	max, err := stats.Max.Int64()
+	if err != nil {
+		return 0, 0, false
+	}
+	return min, max, true
+}
+
+// FloatRange returns the range of a floating column, false if it's unknown
+func (stats *FieldStats) FloatRange() (float64, float64, bool) {
+	if stats.Min == "" || stats.Max == "" {
+		return 0, 0, false
+	}
+	min, err := stats.Min.Float64()
+	if err != nil {
+		return 0, 0, false
+	}
+	max, err := stats.Max.Float64()
+	if err != nil {
+		return 0, 0, false
+	}
+	return min, max, true
+}
+
+// Merge merges the stats of another binlog of the same field into stats
+func (stats *FieldStats) Merge(other *FieldStats) error {
+	if stats.FieldID != other.FieldID || stats.Type != other.Type {
+		return fmt.Errorf("can't merge stats of field %d type %s into stats of field %d type %s",
+			other.FieldID, other.Type, stats.FieldID, stats.Type)
+	}
+
+	switch {
+	case stats.RowCount == 0:
+		stats.Min, stats.Max = other.Min, other.Max
+	case other.RowCount == 0:
+		// the range of other is unknown since it has no value
+	case isIntegerStats(stats.Type):
+		min, max, ok := stats.IntRange()
+		otherMin, otherMax, otherOk := other.IntRange()
+		if ok && otherOk {
+			stats.setIntRange(minInt64(min, otherMin), maxInt64(max, otherMax))
+		} else {
+			stats.Min, stats.Max = "", ""
+		}
+	default:
+		min, max, ok := stats.FloatRange()
+		otherMin, otherMax, otherOk := other.FloatRange()
+		if ok && otherOk {
+			stats.setFloatRange(math.Min(min, otherMin), math.Max(max, otherMax))
+		} else {
+			stats.Min, stats.Max = "", ""
+		}
+	}
+	stats.RowCount += other.RowCount
+	stats.NullCount += other.NullCount
+
+	if stats.NDV == nil || other.NDV == nil {
+		stats.NDV = nil
+		return nil
+	}
+	return stats.NDV.Merge(other.NDV)
+}
+
+func (stats *FieldStats) setIntRange(min, max int64) {
+	stats.Min = json.Number(strconv.FormatInt(min, 10))
+	stats.Max = json.Number(strconv.FormatInt(max, 10))
+}
+
+func (stats *FieldStats) setFloatRange(min, max float64) {
+	stats.Min = json.Number(strconv.FormatFloat(min, 'g', -1, 64))
+	stats.Max = json.Number(strconv.FormatFloat(max, 'g', -1, 64))
+}
+
+func isIntegerStats(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// NDVSketch is a HyperLogLog sketch which estimates the number of distinct values of a column
+type NDVSketch struct {
+	Registers []byte `json:"registers"`
+}
+
+// NewNDVSketch returns an empty NDVSketch
+func NewNDVSketch() *NDVSketch {
+	return &NDVSketch{Registers: make([]byte, 1<<ndvSketchPrecision)}
+}
+
+// Add adds a value in its binary form to the sketch
+func (s *NDVSketch) Add(value []byte) {
+	h := murmur3.Sum64(value)
+	idx := h >> (64 - ndvSketchPrecision)
+	// the position of the first 1 bit in the remaining bits, the guard bit limits it
+	rank := byte(bits.LeadingZeros64(h<<ndvSketchPrecision|1<<(ndvSketchPrecision-1)) + 1)
+	if rank > s.Registers[idx] {
+		s.Registers[idx] = rank
+	}
+}
+
+// Merge merges another sketch into s, the sketch of the union of the values
+func (s *NDVSketch) Merge(other *NDVSketch) error {
+	if len(s.Registers) != len(other.Registers) {
+		return fmt.Errorf("can't merge ndv sketches of %d and %d registers", len(other.Registers), len(s.Registers))
+	}
+	for i, r := range other.Registers {
+		if r > s.Registers[i] {
+			s.Registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Estimate returns the estimated number of distinct values
+func (s *NDVSketch) Estimate() uint64 {
+	m := float64(len(s.Registers))
+	if m == 0 {
+		return 0
+	}
+	sum, zeros := 0.0, 0
+	for _, r := range s.Registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	// linear counting is more accurate for small cardinalities
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
 // StatsWriter writes stats to buffer
 type StatsWriter struct {
 	buffer []byte
@@ -76,6 +325,117 @@ func (sw *StatsWriter) StatsInt64(fieldID int64, isPrimaryKey bool, msgs []int64
 	return nil
 }
 
+// StatsString writes StringStats from @msgs with @fieldID to @buffer
+func (sw *StatsWriter) StatsString(fieldID int64, isPrimaryKey bool, msgs []string) error {
+	if len(msgs) < 1 {
+		// return error: msgs must has one element at least
+		return nil
+	}
+
+	stats := &StringStats{
+		FieldID: fieldID,
+		Max:     msgs[0],
+		Min:     msgs[0],
+	}
+	// string data is not sorted by value, so max and min must be found one by one
+	for _, msg := range msgs {
+		if msg > stats.Max {
+			stats.Max = msg
+		}
+		if msg < stats.Min {
+			stats.Min = msg
+		}
+	}
+	if isPrimaryKey {
+		stats.BF = bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+		for _, msg := range msgs {
+			AddPKToBloomFilter(stats.BF, NewStringPrimaryKey(msg))
+		}
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
+// StatsField writes FieldStats of the numeric @data with @fieldID to @buffer, nothing is written if the
+// type of @data isn't numeric
+func (sw *StatsWriter) StatsField(fieldID int64, data FieldData) error {
+	stats := &FieldStats{
+		FieldID:  fieldID,
+		RowCount: int64(data.RowNum()),
+		// no field is nullable yet
+		NullCount: 0,
+		NDV:       NewNDVSketch(),
+	}
+
+	b := make([]byte, 8)
+	ints := func(n int, get func(i int) int64) {
+		if n == 0 {
+			return
+		}
+		min, max := get(0), get(0)
+		for i := 0; i < n; i++ {
+			v := get(i)
+			min, max = minInt64(min, v), maxInt64(max, v)
+			common.Endian.PutUint64(b, uint64(v))
+			stats.NDV.Add(b)
+		}
+		stats.setIntRange(min, max)
+	}
+	floats := func(n int, get func(i int) float64) {
+		if n == 0 {
+			return
+		}
+		min, max, finite := get(0), get(0), true
+		for i := 0; i < n; i++ {
+			v := get(i)
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				finite = false
+			}
+			min, max = math.Min(min, v), math.Max(max, v)
+			common.Endian.PutUint64(b, math.Float64bits(v))
+			stats.NDV.Add(b)
+		}
+		if finite {
+			stats.setFloatRange(min, max)
+		}
+	}
+
+	switch d := data.(type) {
+	case *Int8FieldData:
+		stats.Type = schemapb.DataType_Int8
+		ints(len(d.Data), func(i int) int64 { return int64(d.Data[i]) })
+	case *Int16FieldData:
+		stats.Type = schemapb.DataType_Int16
+		ints(len(d.Data), func(i int) int64 { return int64(d.Data[i]) })
+	case *Int32FieldData:
+		stats.Type = schemapb.DataType_Int32
+		ints(len(d.Data), func(i int) int64 { return int64(d.Data[i]) })
+	case *Int64FieldData:
+		stats.Type = schemapb.DataType_Int64
+		ints(len(d.Data), func(i int) int64 { return d.Data[i] })
+	case *FloatFieldData:
+		stats.Type = schemapb.DataType_Float
+		floats(len(d.Data), func(i int) float64 { return float64(d.Data[i]) })
+	case *DoubleFieldData:
+		stats.Type = schemapb.DataType_Double
+		floats(len(d.Data), func(i int) float64 { return d.Data[i] })
+	default:
+		return nil
+	}
+
+	buffer, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = buffer
+	return nil
+}
+
 // StatsReader reads stats
 type StatsReader struct {
 	buffer []byte
@@ -96,16 +456,60 @@ func (sr *StatsReader) GetInt64Stats() (*Int64Stats, error) {
 	return stats, nil
 }
 
-// DeserializeStats deserialize @blobs as []*Int64Stats
-func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
-	results := make([]*Int64Stats, 0, len(blobs))
+// GetStringStats returns buffer as StringStats
+func (sr *StatsReader) GetStringStats() (*StringStats, error) {
+	stats := &StringStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetPrimaryKeyStats returns buffer as PrimaryKeyStats
+func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
+	stats := &PrimaryKeyStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetFieldStats returns buffer as FieldStats, the type is None if the buffer isn't FieldStats
+func (sr *StatsReader) GetFieldStats() (*FieldStats, error) {
+	// the min and max of the other stats may not be numbers
+	var header struct {
+		Type schemapb.DataType `json:"type"`
+	}
+	if err := json.Unmarshal(sr.buffer, &header); err != nil {
+		return nil, err
+	}
+	if header.Type == schemapb.DataType_None {
+		return &FieldStats{}, nil
+	}
+
+	stats := &FieldStats{}
+	err := json.Unmarshal(sr.buffer, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// DeserializeStats deserialize @blobs as []*PrimaryKeyStats, the stats of the other fields are skipped
+func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
+	results := make([]*PrimaryKeyStats, 0, len(blobs))
 	for _, blob := range blobs {
 		if blob.Value == nil {
 			continue
 		}
 		sr := &StatsReader{}
 		sr.SetBuffer(blob.Value)
-		stats, err := sr.GetInt64Stats()
+		stats, err := sr.GetPrimaryKeyStats()
+		if errors.Is(err, errNotPrimaryKeyStats) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -113,3 +517,24 @@ func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
 	}
 	return results, nil
 }
+
+// DeserializeFieldStats deserialize @blobs as []*FieldStats, the stats written by StatsInt64 and StatsString are skipped
+func DeserializeFieldStats(blobs []*Blob) ([]*FieldStats, error) {
+	results := make([]*FieldStats, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		sr := &StatsReader{}
+		sr.SetBuffer(blob.Value)
+		stats, err := sr.GetFieldStats()
+		if err != nil {
+			return nil, err
+		}
+		if stats.Type == schemapb.DataType_None {
+			continue
+		}
+		results = append(results, stats)
+	}
+	return results, nil
+}
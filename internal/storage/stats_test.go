@@ -17,9 +17,11 @@
 package storage
 
 import (
+	"math"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +49,180 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(rootcoord.RowIDField, true, msgs)
 	assert.Nil(t, err)
 }
+
+func TestStatsWriter_StatsString(t *testing.T) {
+	data := []string{"b", "https://milvus.io", "a", "c"}
+	sw := &StatsWriter{}
+	err := sw.StatsString(common.StartOfUserFieldID, true, data)
+	assert.NoError(t, err)
+	b := sw.GetBuffer()
+
+	sr := &StatsReader{}
+	sr.SetBuffer(b)
+	stats, err := sr.GetStringStats()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://milvus.io", stats.Max)
+	assert.Equal(t, "a", stats.Min)
+	for _, pk := range data {
+		assert.True(t, TestPKInBloomFilter(stats.BF, NewStringPrimaryKey(pk)))
+	}
+
+	msgs := []string{}
+	err = sw.StatsString(common.StartOfUserFieldID, true, msgs)
+	assert.Nil(t, err)
+}
+
+func TestStatsReader_GetPrimaryKeyStats(t *testing.T) {
+	sw := &StatsWriter{}
+	err := sw.StatsInt64(common.StartOfUserFieldID, true, []int64{1, 2, 3})
+	assert.NoError(t, err)
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	assert.Equal(t, NewInt64PrimaryKey(3), stats.Max)
+	assert.Equal(t, NewInt64PrimaryKey(1), stats.Min)
+	assert.True(t, TestPKInBloomFilter(stats.BF, NewInt64PrimaryKey(2)))
+
+	err = sw.StatsString(common.StartOfUserFieldID, true, []string{"x", "y"})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	assert.Equal(t, NewStringPrimaryKey("y"), stats.Max)
+	assert.Equal(t, NewStringPrimaryKey("x"), stats.Min)
+	assert.True(t, TestPKInBloomFilter(stats.BF, NewStringPrimaryKey("x")))
+}
+
+func TestStatsWriter_StatsField(t *testing.T) {
+	sw := &StatsWriter{}
+	err := sw.StatsField(common.StartOfUserFieldID, &Int32FieldData{
+		NumRows: []int64{5},
+		Data:    []int32{3, -1, 7, 3, 2},
+	})
+	assert.NoError(t, err)
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(common.StartOfUserFieldID), stats.FieldID)
+	assert.Equal(t, schemapb.DataType_Int32, stats.Type)
+	assert.Equal(t, int64(5), stats.RowCount)
+	assert.Equal(t, int64(0), stats.NullCount)
+	assert.Equal(t, uint64(4), stats.NDV.Estimate())
+	min, max, ok := stats.IntRange()
+	assert.True(t, ok)
+	assert.Equal(t, int64(-1), min)
+	assert.Equal(t, int64(7), max)
+
+	err = sw.StatsField(common.StartOfUserFieldID+1, &DoubleFieldData{
+		NumRows: []int64{3},
+		Data:    []float64{1.5, -2.25, 0},
+	})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Double, stats.Type)
+	fmin, fmax, ok := stats.FloatRange()
+	assert.True(t, ok)
+	assert.Equal(t, -2.25, fmin)
+	assert.Equal(t, 1.5, fmax)
+
+	// the range is unknown with a NaN
+	err = sw.StatsField(common.StartOfUserFieldID+1, &FloatFieldData{
+		NumRows: []int64{2},
+		Data:    []float32{1, float32(math.NaN())},
+	})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Float, stats.Type)
+	_, _, ok = stats.FloatRange()
+	assert.False(t, ok)
+
+	// the stats of the primary key aren't FieldStats
+	err = sw.StatsInt64(common.StartOfUserFieldID, true, []int64{1, 2, 3})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_None, stats.Type)
+}
+
+func TestFieldStats_Merge(t *testing.T) {
+	newStats := func(data []int64) *FieldStats {
+		sw := &StatsWriter{}
+		err := sw.StatsField(common.StartOfUserFieldID, &Int64FieldData{
+			NumRows: []int64{int64(len(data))},
+			Data:    data,
+		})
+		assert.NoError(t, err)
+		sr := &StatsReader{}
+		sr.SetBuffer(sw.GetBuffer())
+		stats, err := sr.GetFieldStats()
+		assert.NoError(t, err)
+		return stats
+	}
+
+	stats := newStats([]int64{1, 2, 3})
+	err := stats.Merge(newStats([]int64{3, 4, 10}))
+	assert.NoError(t, err)
+	err = stats.Merge(newStats([]int64{}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), stats.RowCount)
+	assert.Equal(t, uint64(5), stats.NDV.Estimate())
+	min, max, ok := stats.IntRange()
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), min)
+	assert.Equal(t, int64(10), max)
+
+	other := newStats([]int64{1})
+	other.FieldID++
+	assert.Error(t, stats.Merge(other))
+}
+
+func TestNDVSketch(t *testing.T) {
+	sketch := NewNDVSketch()
+	assert.Equal(t, uint64(0), sketch.Estimate())
+
+	b := make([]byte, 8)
+	for i := 0; i < 100000; i++ {
+		common.Endian.PutUint64(b, uint64(i%20000))
+		sketch.Add(b)
+	}
+	estimate := float64(sketch.Estimate())
+	assert.InDelta(t, 20000, estimate, 20000*0.1)
+
+	assert.Error(t, sketch.Merge(&NDVSketch{Registers: []byte{1}}))
+}
+
+func TestDeserializeFieldStats(t *testing.T) {
+	blobs := make([]*Blob, 0)
+	sw := &StatsWriter{}
+	err := sw.StatsInt64(common.StartOfUserFieldID, true, []int64{1, 2, 3})
+	assert.NoError(t, err)
+	blobs = append(blobs, &Blob{Value: sw.GetBuffer()})
+	err = sw.StatsString(common.StartOfUserFieldID+1, false, []string{"a", "b"})
+	assert.NoError(t, err)
+	blobs = append(blobs, &Blob{Value: sw.GetBuffer()})
+	err = sw.StatsField(common.StartOfUserFieldID+2, &DoubleFieldData{
+		NumRows: []int64{1},
+		Data:    []float64{0.5},
+	})
+	assert.NoError(t, err)
+	blobs = append(blobs, &Blob{Value: sw.GetBuffer()})
+
+	fieldStats, err := DeserializeFieldStats(blobs)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(fieldStats))
+	assert.Equal(t, int64(common.StartOfUserFieldID+2), fieldStats[0].FieldID)
+
+	pkStats, err := DeserializeStats([]*Blob{blobs[0], blobs[2]})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pkStats))
+	assert.Equal(t, int64(common.StartOfUserFieldID), pkStats[0].FieldID)
+}
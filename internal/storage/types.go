@@ -16,19 +16,35 @@
 
 package storage
 
+import "time"
+
 // ChunkManager is to manager chunks.
 // Include Read, Write, Remove chunks.
 type ChunkManager interface {
 	// GetPath returns path of @key
 	GetPath(key string) (string, error)
+	// Size returns the size of content stored in @key
+	Size(key string) (int64, error)
 	// Write writes @content to @key
 	Write(key string, content []byte) error
+	// MultiWrite writes each content of @contents to its key
+	MultiWrite(contents map[string][]byte) error
 	// Exist returns true if @key exists
 	Exist(key string) bool
 	// Read reads @key and returns content
 	Read(key string) ([]byte, error)
+	// MultiRead reads @keys and returns contents in the same order
+	MultiRead(keys []string) ([][]byte, error)
+	// ListWithPrefix returns all the keys start with @prefix and their last modified time
+	ListWithPrefix(prefix string) ([]string, []time.Time, error)
 	// ReadAt reads @key by offset @off, content stored in @p, return @n as the number of bytes read
 	// if all bytes are read, @err is io.EOF
 	// return other error if read failed
 	ReadAt(key string, p []byte, off int64) (n int, err error)
+	// Remove deletes @key, it's not an error if @key doesn't exist
+	Remove(key string) error
+	// MultiRemove deletes @keys
+	MultiRemove(keys []string) error
+	// RemoveWithPrefix deletes all the keys start with @prefix
+	RemoveWithPrefix(prefix string) error
 }
@@ -18,27 +18,31 @@ package storage
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"sort"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/retry"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
-
-	"github.com/milvus-io/milvus/internal/kv"
 )
 
 // GetBinlogSize get size of a binlog file.
 //		normal binlog file, error = nil;
-//		key not exist, size = 0, error = nil;
+//		key not exist, size = 0, error != nil;
 //		key not in binlog format, size = (a not accurate number), error != nil;
 //		failed to read event reader, size = (a not accurate number), error != nil;
-func GetBinlogSize(kv kv.DataKV, key string) (int64, error) {
+func GetBinlogSize(cm ChunkManager, key string) (int64, error) {
 
-	return kv.GetSize(key)
+	return cm.Size(key)
 }
 
 // EstimateMemorySize get approximate memory size of a binlog file.
@@ -49,7 +53,7 @@ func GetBinlogSize(kv kv.DataKV, key string) (int64, error) {
 //		5, original_size not in extra, size = 0, error != nil;
 //		6, original_size not in int format, size = 0, error != nil;
 //		7, normal binlog with original_size, return original_size, error = nil;
-func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
+func EstimateMemorySize(cm ChunkManager, key string) (int64, error) {
 	total := int64(0)
 
 	header := &eventHeader{}
@@ -59,7 +63,7 @@ func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
 	endPos := startPos + headerSize
 
 	// get header
-	headerContent, err := kv.LoadPartial(key, int64(startPos), int64(endPos))
+	headerContent, err := readPartial(cm, key, int64(startPos), int64(endPos))
 	if err != nil {
 		return total, err
 	}
@@ -77,7 +81,7 @@ func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
 
 	var desc *descriptorEvent
 	endPos = startPos + int(header.EventLength)
-	descContent, err := kv.LoadPartial(key, int64(startPos), int64(endPos))
+	descContent, err := readPartial(cm, key, int64(startPos), int64(endPos))
 	if err != nil {
 		return total, err
 	}
@@ -104,6 +108,16 @@ func EstimateMemorySize(kv kv.DataKV, key string) (int64, error) {
 	return total, nil
 }
 
+// readPartial reads the data ranged in [start, end) of @key, the content may be shorter than the range.
+func readPartial(cm ChunkManager, key string, start, end int64) ([]byte, error) {
+	p := make([]byte, end-start)
+	n, err := cm.ReadAt(key, p, start)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return p[:n], nil
+}
+
 //////////////////////////////////////////////////////////////////////////////////////////////////
 
 func checkTsField(data *InsertData) bool {
@@ -234,3 +248,32 @@ func TransferColumnBasedInsertDataToRowBased(data *InsertData) (
 
 	return utss, rowIds.Data, rows, nil
 }
+
+// doWithRetry calls fn until it succeeds, the attempts run out or it fails with an error not retryable.
+// The error not retryable is returned as it is, so that the callers are able to check it.
+func doWithRetry(ctx context.Context, fn func() error, retryable func(error) bool, opts ...retry.Option) error {
+	var unrecoverable error
+	err := retry.Do(ctx, func() error {
+		err := fn()
+		if err != nil && !retryable(err) {
+			unrecoverable = err
+			return retry.Unrecoverable(err)
+		}
+		return err
+	}, opts...)
+	if unrecoverable != nil {
+		return unrecoverable
+	}
+	return err
+}
+
+// isRetryableStatus tells whether the request responded with the http status code might succeed if sent again.
+func isRetryableStatus(code int) bool {
+	return code >= http.StatusInternalServerError || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+}
+
+// md5Base64 returns the base64 encoded md5 checksum of data, which is the format of the Content-MD5 header.
+func md5Base64(data []byte) string {
+	sum := md5.Sum(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
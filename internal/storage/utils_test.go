@@ -23,21 +23,32 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"path"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
-	"github.com/milvus-io/milvus/internal/kv"
-
 	"github.com/stretchr/testify/assert"
-
-	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 )
 
+// mockReadAt serves ReadAt of the mocks by their partial loading.
+func mockReadAt(loadPartial func(key string, start, end int64) ([]byte, error), key string, p []byte, off int64) (int, error) {
+	content, err := loadPartial(key, off, off+int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, content)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
 type mockLessHeaderDataKV struct {
-	kv.BaseKV
+	ChunkManager
 }
 
 func (kv *mockLessHeaderDataKV) LoadPartial(key string, start, end int64) ([]byte, error) {
@@ -51,16 +62,20 @@ func (kv *mockLessHeaderDataKV) LoadPartial(key string, start, end int64) ([]byt
 	return ret, nil
 }
 
-func (kv *mockLessHeaderDataKV) GetSize(key string) (int64, error) {
+func (kv *mockLessHeaderDataKV) Size(key string) (int64, error) {
 	return 0, errors.New("less header")
 }
 
+func (kv *mockLessHeaderDataKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	return mockReadAt(kv.LoadPartial, key, p, off)
+}
+
 func newMockLessHeaderDataKV() *mockLessHeaderDataKV {
 	return &mockLessHeaderDataKV{}
 }
 
 type mockWrongHeaderDataKV struct {
-	kv.BaseKV
+	ChunkManager
 }
 
 func (kv *mockWrongHeaderDataKV) LoadPartial(key string, start, end int64) ([]byte, error) {
@@ -75,26 +90,30 @@ func (kv *mockWrongHeaderDataKV) LoadPartial(key string, start, end int64) ([]by
 	return buffer.Bytes(), nil
 }
 
-func (kv *mockWrongHeaderDataKV) GetSize(key string) (int64, error) {
+func (kv *mockWrongHeaderDataKV) Size(key string) (int64, error) {
 	return 0, errors.New("wrong header")
 }
 
-func newMockWrongHeaderDataKV() kv.DataKV {
+func (kv *mockWrongHeaderDataKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	return mockReadAt(kv.LoadPartial, key, p, off)
+}
+
+func newMockWrongHeaderDataKV() ChunkManager {
 	return &mockWrongHeaderDataKV{}
 }
 
 func TestGetBinlogSize(t *testing.T) {
-	memoryKV := memkv.NewMemoryKV()
-	defer memoryKV.Close()
+	lcm := NewLocalChunkManager(path.Join(localPath, "TestGetBinlogSize"))
+	defer lcm.RemoveWithPrefix("")
 
 	key := "TestGetBinlogSize"
 
 	var size int64
 	var err error
 
-	// key not in memoryKV
-	size, err = GetBinlogSize(memoryKV, key)
-	assert.NoError(t, err)
+	// key not exist
+	size, err = GetBinlogSize(lcm, key)
+	assert.Error(t, err)
 	assert.Zero(t, size)
 
 	// normal binlog key, for example, index binlog
@@ -129,10 +148,10 @@ func TestGetBinlogSize(t *testing.T) {
 	assert.Nil(t, err)
 
 	for _, blob := range serializedBlobs {
-		err = memoryKV.Save(blob.Key, string(blob.Value))
+		err = lcm.Write(blob.Key, blob.Value)
 		assert.Nil(t, err)
 
-		size, err = GetBinlogSize(memoryKV, blob.Key)
+		size, err = GetBinlogSize(lcm, blob.Key)
 		assert.Nil(t, err)
 		assert.Equal(t, size, int64(len(blob.Value)))
 	}
@@ -159,16 +178,16 @@ func TestGetBinlogSize_not_in_binlog_format(t *testing.T) {
 }
 
 func TestEstimateMemorySize(t *testing.T) {
-	memoryKV := memkv.NewMemoryKV()
-	defer memoryKV.Close()
+	lcm := NewLocalChunkManager(path.Join(localPath, "TestEstimateMemorySize"))
+	defer lcm.RemoveWithPrefix("")
 
 	key := "TestEstimateMemorySize"
 
 	var size int64
 	var err error
 
-	// key not in memoryKV
-	_, err = EstimateMemorySize(memoryKV, key)
+	// key not exist
+	_, err = EstimateMemorySize(lcm, key)
 	assert.Error(t, err)
 
 	// normal binlog key, for example, index binlog
@@ -203,7 +222,7 @@ func TestEstimateMemorySize(t *testing.T) {
 	assert.Nil(t, err)
 
 	for _, blob := range serializedBlobs {
-		err = memoryKV.Save(blob.Key, string(blob.Value))
+		err = lcm.Write(blob.Key, blob.Value)
 		assert.Nil(t, err)
 
 		buf := bytes.NewBuffer(blob.Value)
@@ -211,7 +230,7 @@ func TestEstimateMemorySize(t *testing.T) {
 		_, _ = readMagicNumber(buf)
 		desc, _ := ReadDescriptorEvent(buf)
 
-		size, err = EstimateMemorySize(memoryKV, blob.Key)
+		size, err = EstimateMemorySize(lcm, blob.Key)
 		assert.Nil(t, err)
 		assert.Equal(t, fmt.Sprintf("%v", desc.Extras[originalSizeKey]), fmt.Sprintf("%v", size))
 	}
@@ -238,7 +257,7 @@ func TestEstimateMemorySize_not_in_binlog_format(t *testing.T) {
 }
 
 type mockFailedToGetDescDataKV struct {
-	kv.BaseKV
+	ChunkManager
 }
 
 func (kv *mockFailedToGetDescDataKV) LoadPartial(key string, start, end int64) ([]byte, error) {
@@ -255,10 +274,14 @@ func (kv *mockFailedToGetDescDataKV) LoadPartial(key string, start, end int64) (
 	return buf.Bytes(), nil
 }
 
-func (kv *mockFailedToGetDescDataKV) GetSize(key string) (int64, error) {
+func (kv *mockFailedToGetDescDataKV) Size(key string) (int64, error) {
 	return 0, nil
 }
 
+func (kv *mockFailedToGetDescDataKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	return mockReadAt(kv.LoadPartial, key, p, off)
+}
+
 func newMockFailedToGetDescDataKV() *mockFailedToGetDescDataKV {
 	return &mockFailedToGetDescDataKV{}
 }
@@ -274,7 +297,7 @@ func TestEstimateMemorySize_failed_to_load_desc(t *testing.T) {
 }
 
 type mockLessDescDataKV struct {
-	kv.BaseKV
+	ChunkManager
 }
 
 func (kv *mockLessDescDataKV) LoadPartial(key string, start, end int64) ([]byte, error) {
@@ -298,10 +321,14 @@ func (kv *mockLessDescDataKV) LoadPartial(key string, start, end int64) ([]byte,
 	*/
 }
 
-func (kv *mockLessDescDataKV) GetSize(key string) (int64, error) {
+func (kv *mockLessDescDataKV) Size(key string) (int64, error) {
 	return 0, nil
 }
 
+func (kv *mockLessDescDataKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	return mockReadAt(kv.LoadPartial, key, p, off)
+}
+
 func newMockLessDescDataKV() *mockLessDescDataKV {
 	return &mockLessDescDataKV{}
 }
@@ -316,7 +343,7 @@ func TestEstimateMemorySize_less_desc_data(t *testing.T) {
 }
 
 type mockOriginalSizeDataKV struct {
-	kv.BaseKV
+	ChunkManager
 	impl func(key string, start, end int64) ([]byte, error)
 }
 
@@ -327,10 +354,14 @@ func (kv *mockOriginalSizeDataKV) LoadPartial(key string, start, end int64) ([]b
 	return nil, nil
 }
 
-func (kv *mockOriginalSizeDataKV) GetSize(key string) (int64, error) {
+func (kv *mockOriginalSizeDataKV) Size(key string) (int64, error) {
 	return 0, nil
 }
 
+func (kv *mockOriginalSizeDataKV) ReadAt(key string, p []byte, off int64) (int, error) {
+	return mockReadAt(kv.LoadPartial, key, p, off)
+}
+
 func newMockOriginalSizeDataKV() *mockOriginalSizeDataKV {
 	return &mockOriginalSizeDataKV{}
 }
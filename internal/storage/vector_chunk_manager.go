@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -97,6 +98,14 @@ func (vcm *VectorChunkManager) GetPath(key string) (string, error) {
 	return vcm.remoteChunkManager.GetPath(key)
 }
 
+// Size returns the size of the cached vector data if cached, otherwise the size of the remote binlog.
+func (vcm *VectorChunkManager) Size(key string) (int64, error) {
+	if vcm.localChunkManager.Exist(key) && vcm.localCacheEnable {
+		return vcm.localChunkManager.Size(key)
+	}
+	return vcm.remoteChunkManager.Size(key)
+}
+
 // Write writes the vector data to local cache if cache enabled.
 func (vcm *VectorChunkManager) Write(key string, content []byte) error {
 	if !vcm.localCacheEnable {
@@ -105,6 +114,14 @@ func (vcm *VectorChunkManager) Write(key string, content []byte) error {
 	return vcm.localChunkManager.Write(key, content)
 }
 
+// MultiWrite writes the vector data to local cache if cache enabled.
+func (vcm *VectorChunkManager) MultiWrite(contents map[string][]byte) error {
+	if !vcm.localCacheEnable {
+		return errors.New("Cannot write local file for local cache is not allowed")
+	}
+	return vcm.localChunkManager.MultiWrite(contents)
+}
+
 // Exist checks whether vector data is saved to local cache.
 func (vcm *VectorChunkManager) Exist(key string) bool {
 	return vcm.localChunkManager.Exist(key)
@@ -129,6 +146,24 @@ func (vcm *VectorChunkManager) Read(key string) ([]byte, error) {
 	return vcm.downloadVectorFile(key)
 }
 
+// MultiRead reads the pure vector data of all the keys.
+func (vcm *VectorChunkManager) MultiRead(keys []string) ([][]byte, error) {
+	results := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		content, err := vcm.Read(key)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, content)
+	}
+	return results, nil
+}
+
+// ListWithPrefix lists the remote vector binlogs, the cache only holds a part of them.
+func (vcm *VectorChunkManager) ListWithPrefix(prefix string) ([]string, []time.Time, error) {
+	return vcm.remoteChunkManager.ListWithPrefix(prefix)
+}
+
 // ReadAt reads specific position data of vector. If cached, it reads from local.
 func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, error) {
 	if vcm.localCacheEnable {
@@ -164,3 +199,18 @@ func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, err
 
 	return n, nil
 }
+
+// Remove removes the cached vector data, the remote binlog is kept.
+func (vcm *VectorChunkManager) Remove(key string) error {
+	return vcm.localChunkManager.Remove(key)
+}
+
+// MultiRemove removes the cached vector data of all the keys.
+func (vcm *VectorChunkManager) MultiRemove(keys []string) error {
+	return vcm.localChunkManager.MultiRemove(keys)
+}
+
+// RemoveWithPrefix removes the cached vector data start with @prefix.
+func (vcm *VectorChunkManager) RemoveWithPrefix(prefix string) error {
+	return vcm.localChunkManager.RemoveWithPrefix(prefix)
+}
@@ -68,6 +68,10 @@ type DataNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// Compaction will add a compaction task according to the request plan
 	Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error)
+
+	// Import starts to import the files of the task into sealed segments in background,
+	// the progress and the result of the task are reported to DataCoord through ReportImport
+	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -256,6 +260,30 @@ type DataCoord interface {
 	// response status contains the status/error code and failing reason if any
 	// error is returned only when some communication issue occurs
 	DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)
+
+	// Import splits the files to import into tasks and distributes them to DataNodes
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the target collection, partition, channels and the files to import
+	//
+	// response contains the ids of the created import tasks
+	// error is returned only when some communication issue occurs
+	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*milvuspb.ImportResponse, error)
+	// GetImportState gets the state and the progress of an import task
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	// ReportImport reports the progress and the result of an import task executed by DataNode
+	ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error)
+
+	// PinCollection keeps the segments of a collection from being compacted and garbage collected
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the collection id and the ttl of the pin, the pin is released after the ttl
+	//
+	// response contains the id of the pin, which is released by UnpinCollection
+	// error is returned only when some communication issue occurs
+	PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error)
+	// UnpinCollection releases a pin of PinCollection, it fails if the pin has expired
+	UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -500,6 +528,92 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// AddField notifies RootCoord to append a scalar field to the schema of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and the field schema with its default value
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database, all collections in it are dropped as well
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies RootCoord to list all databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` contains the names of all databases, including the default one
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
+	// CreateCredential creates a new user with the given username and password
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and base64 encoded password
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+
+	// UpdateCredential updates the password of a user, the old password must be correct
+	UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+
+	// DeleteCredential deletes a user together with its role bindings
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListCredUsers lists the names of all users
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// CreateRole creates a new role
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole drops a role together with its user bindings and grants, built-in roles can't be dropped
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole adds a user to a role or removes a user from a role
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// SelectRole gets one or all roles, `IncludeUserInfo` controls whether the users of the roles are returned
+	SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error)
+
+	// SelectUser gets one or all users, `IncludeRoleInfo` controls whether the roles of the users are returned
+	SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
+
+	// OperatePrivilege grants a privilege on an object to a role or revokes it
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+
+	// SelectGrant lists the grants of a role, the object type and object name of the entity are optional filters
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+
+	// GetCredential gets the encrypted password of a user, only used by Proxy to authenticate requests
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// ListPolicy lists all grants and the roles of all users, only used by Proxy to refresh its policy cache
+	ListPolicy(ctx context.Context, req *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -647,6 +761,18 @@ type Proxy interface {
 	//
 	// error is returned only when some communication issue occurs.
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+
+	// InvalidateCredentialCache notifies Proxy to remove the cached credential of a user.
+	//
+	// InvalidateCredentialCache should be called when the password of the user was changed or the user was deleted.
+	// error is always nil
+	InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+
+	// RefreshPolicyInfoCache notifies Proxy to reload the grants and role bindings from RootCoord.
+	//
+	// RefreshPolicyInfoCache should be called when any role, role binding or grant was changed.
+	// error is always nil
+	RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
 }
 
 // ProxyComponent defines the interface of proxy component.
@@ -673,6 +799,11 @@ type ProxyComponent interface {
 	//  `queryCoord` is a client of query coordinator.
 	SetQueryCoordClient(queryCoord QueryCoord)
 
+	// SetQueryNodeCreator set the function creating QueryNode clients for Proxy
+	//  `creator` creates the client of the query node listening on the address, the clients are used to
+	//  send search and query requests to query nodes directly.
+	SetQueryNodeCreator(creator func(ctx context.Context, addr string) (QueryNode, error))
+
 	// UpdateStateCode updates state code for Proxy
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode internalpb.StateCode)
@@ -907,6 +1038,19 @@ type ProxyComponent interface {
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace rows by primary key
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The rows sharing primary keys with the request are deleted and the new rows are inserted under the same timestamp.
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `SuccIndex` in `MutationResult` return the succeed number of upserted rows.
+	// the `ErrIndex` in `MutationResult` return the failed number of upsert rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -917,6 +1061,18 @@ type ProxyComponent interface {
 	// error is always nil
 	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
 
+	// HybridSearch notifies Proxy to search several vector fields of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, one search request per vector field, rank params
+	//
+	// Each search request is an ANN search on a vector field with its own search params and filter expression,
+	// the results of them are fused by the reranker specified in rank params, weighted score or reciprocal rank fusion.
+	// The `Status` in response struct `SearchResults` indicates if this operation is processed successfully or fail cause;
+	// the `Results` in `SearchResults` return the fused results.
+	// error is always nil
+	HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error)
+
 	// Flush notifies Proxy to flush buffer into storage
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1022,11 +1178,105 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// AddField notifies Proxy to append a scalar field to the schema of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name and the field schema
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies Proxy to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database and all collections in it
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies Proxy to list all databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// `DbNames` contains the names of all databases, including the default one
+	// error is always nil
+	ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
+	// CreateCredential creates a new user with the given username and password
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and base64 encoded password
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+
+	// UpdateCredential updates the password of a user, the old password must be correct
+	UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+
+	// DeleteCredential deletes a user together with its role bindings
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListCredUsers lists the names of all users
+	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+
+	// CreateRole creates a new role
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole drops a role together with its user bindings and grants, built-in roles can't be dropped
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole adds a user to a role or removes a user from a role
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// SelectRole gets one or all roles, `IncludeUserInfo` controls whether the users of the roles are returned
+	SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error)
+
+	// SelectUser gets one or all users, `IncludeRoleInfo` controls whether the roles of the users are returned
+	SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
+
+	// OperatePrivilege grants a privilege on an object to a role or revokes it
+	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+
+	// SelectGrant lists the grants of a role, the object type and object name of the entity are optional filters
+	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
 	// GetFlushState gets the flush state of multiple segments
 	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+
+	// Import imports files in the object storage into a collection, row-based json files or column-based numpy files
+	// are parsed by DataNodes and written into sealed segments directly, without going through the message stream.
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, partition name, file paths
+	//
+	// The `ErrorCode` of `Status` is `Success` if the import tasks are created, `Tasks` contains the ids of the tasks;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+
+	// GetImportState gets the state of an import task, including the number of imported rows and the generated segments
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -1059,6 +1309,10 @@ type QueryNode interface {
 	ReleasePartitions(ctx context.Context, req *querypb.ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
+	// Search searches the sealed segments and dmChannels on the query node, it's the grpc counterpart of the query msgstream
+	Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error)
+	// Query retrieves the sealed segments and dmChannels on the query node, it's the grpc counterpart of the query msgstream
+	Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error)
 
 	// GetMetrics gets the metrics about QueryNode.
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
@@ -1109,6 +1363,8 @@ type QueryCoord interface {
 	GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
+	GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error)
+	GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
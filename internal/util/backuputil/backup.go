@@ -0,0 +1,393 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/backuppb"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	// ManifestFile is the name of the manifest under the directory of a backup
+	ManifestFile = "manifest.json"
+	// BinlogDir is the directory under the directory of a backup where the binlogs are copied to
+	BinlogDir = "binlogs"
+
+	// defaultPinTTL is how long the segments of the backed up collection are pinned if the backup doesn't unpin them
+	defaultPinTTL = time.Hour
+)
+
+// flushStateCheckInterval is the interval of checking whether the segments sealed by the flush of a backup are flushed
+var flushStateCheckInterval = time.Second
+
+// RootCoord is the part of RootCoord used by backup and restore
+type RootCoord interface {
+	CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
+	ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
+	DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	CreateIndex(ctx context.Context, req *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+}
+
+// DataCoord is the part of DataCoord used by backup and restore
+type DataCoord interface {
+	Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error)
+	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error)
+	UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error)
+	AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+	SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)
+	GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+}
+
+// BackupParam describes the backup to create
+type BackupParam struct {
+	// Name of the backup, the backup is saved under RootPath/Name
+	Name     string
+	RootPath string
+	// DbName is the database of the collection, empty means the default database
+	DbName         string
+	CollectionName string
+	// Timestamp limits the backup to the data written up to it, zero means all the data flushed by the backup.
+	// A segment or a delta log holding data both before and after Timestamp fails the backup, since
+	// binlogs are copied as a whole, e.g. when the segments have been compacted since Timestamp
+	Timestamp uint64
+	// PinTTL bounds how long the collection is kept from compaction and garbage collection,
+	// the backup fails if it takes longer. Zero means defaultPinTTL
+	PinTTL time.Duration
+}
+
+// Backup flushes a collection, copies the binlogs of its flushed segments under the backup directory
+// and writes a manifest with the schema, the partitions, the segments and the indexes of the collection.
+// The collection is pinned in DataCoord during the backup, so that the segments listed by the backup
+// are neither compacted nor garbage collected before their binlogs are copied.
+func Backup(ctx context.Context, rootCoord RootCoord, dataCoord DataCoord, cm storage.ChunkManager,
+	param *BackupParam) (*backuppb.BackupManifest, error) {
+	if param.Name == "" {
+		return nil, errors.New("backup name should not be empty")
+	}
+	if cm.Exist(path.Join(BackupDir(param.RootPath, param.Name), ManifestFile)) {
+		return nil, fmt.Errorf("backup %s already exists under %s", param.Name, param.RootPath)
+	}
+	// the data written after the flush of the backup is not backed up
+	if physical, _ := tsoutil.ParseTS(param.Timestamp); physical.After(time.Now()) {
+		return nil, fmt.Errorf("backup timestamp %d is in the future", param.Timestamp)
+	}
+
+	collResp, err := rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		DbName:         param.DbName,
+		CollectionName: param.CollectionName,
+	})
+	if err := statusError("DescribeCollection", collResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	collectionID := collResp.GetCollectionID()
+
+	unpin, err := pinCollection(ctx, dataCoord, collectionID, param.PinTTL)
+	if err != nil {
+		return nil, err
+	}
+	pinned := true
+	defer func() {
+		if pinned {
+			if err := unpin(); err != nil {
+				log.Warn("failed to unpin the collection of a failed backup", zap.String("name", param.Name), zap.Error(err))
+			}
+		}
+	}()
+	if err := flushCollection(ctx, dataCoord, collectionID); err != nil {
+		return nil, err
+	}
+
+	manifest := &backuppb.BackupManifest{
+		Name:                param.Name,
+		DbName:              param.DbName,
+		CollectionName:      param.CollectionName,
+		CollectionID:        collectionID,
+		Schema:              collResp.GetSchema(),
+		ShardsNum:           collResp.GetShardsNum(),
+		ConsistencyLevel:    collResp.GetConsistencyLevel(),
+		VirtualChannelNames: collResp.GetVirtualChannelNames(),
+	}
+
+	partResp, err := rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+		DbName:         param.DbName,
+		CollectionName: param.CollectionName,
+	})
+	if err := statusError("ShowPartitions", partResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	for i, partitionID := range partResp.GetPartitionIDs() {
+		segments, err := getFlushedSegments(ctx, dataCoord, collectionID, partitionID)
+		if err != nil {
+			return nil, err
+		}
+		if param.Timestamp != 0 {
+			if segments, err = filterSegments(segments, param.Timestamp); err != nil {
+				return nil, err
+			}
+		}
+		for _, segment := range segments {
+			if err := backupSegment(cm, param, segment); err != nil {
+				return nil, err
+			}
+			if ts := segment.GetDmlPosition().GetTimestamp(); ts > manifest.BackupTimestamp {
+				manifest.BackupTimestamp = ts
+			}
+		}
+		manifest.Partitions = append(manifest.Partitions, &backuppb.PartitionBackup{
+			PartitionName: partResp.GetPartitionNames()[i],
+			PartitionID:   partitionID,
+			Segments:      segments,
+		})
+	}
+
+	indexResp, err := rootCoord.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeIndex},
+		DbName:         param.DbName,
+		CollectionName: param.CollectionName,
+	})
+	if err != nil || indexResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_IndexNotExist {
+		if err := statusError("DescribeIndex", indexResp.GetStatus(), err); err != nil {
+			return nil, err
+		}
+	}
+	for _, desc := range indexResp.GetIndexDescriptions() {
+		manifest.Indexes = append(manifest.Indexes, &backuppb.IndexBackup{
+			IndexName: desc.GetIndexName(),
+			FieldName: desc.GetFieldName(),
+			Params:    desc.GetParams(),
+		})
+	}
+
+	if param.Timestamp != 0 {
+		manifest.BackupTimestamp = param.Timestamp
+	}
+
+	// the pin has expired if unpin fails, the segments may have been compacted while they were copied
+	pinned = false
+	if err := unpin(); err != nil {
+		return nil, err
+	}
+	// the manifest is written last, a backup without manifest is incomplete and can't be restored
+	if err := writeManifest(cm, param.RootPath, manifest); err != nil {
+		return nil, err
+	}
+	log.Info("backup created", zap.String("name", param.Name), zap.String("db", param.DbName),
+		zap.String("collection", param.CollectionName),
+		zap.Uint64("timestamp", manifest.GetBackupTimestamp()), zap.Int("partitionNum", len(manifest.GetPartitions())))
+	return manifest, nil
+}
+
+// pinCollection keeps the segments of the collection from compaction and garbage collection,
+// it returns the function releasing the pin
+func pinCollection(ctx context.Context, dataCoord DataCoord, collectionID int64, ttl time.Duration) (func() error, error) {
+	if ttl <= 0 {
+		ttl = defaultPinTTL
+	}
+	resp, err := dataCoord.PinCollection(ctx, &datapb.PinCollectionRequest{
+		CollectionID: collectionID,
+		TtlSeconds:   int64((ttl + time.Second - 1) / time.Second),
+	})
+	if err := statusError("PinCollection", resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	pinID := resp.GetPinID()
+	return func() error {
+		// the pin is released even if the backup is cancelled
+		status, err := dataCoord.UnpinCollection(context.Background(), &datapb.UnpinCollectionRequest{PinID: pinID})
+		return statusError("UnpinCollection", status, err)
+	}, nil
+}
+
+// flushCollection seals the growing segments of the collection and waits until they are flushed
+func flushCollection(ctx context.Context, dataCoord DataCoord, collectionID int64) error {
+	flushResp, err := dataCoord.Flush(ctx, &datapb.FlushRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_Flush},
+		CollectionID: collectionID,
+	})
+	if err := statusError("Flush", flushResp.GetStatus(), err); err != nil {
+		return err
+	}
+	if len(flushResp.GetSegmentIDs()) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(flushStateCheckInterval)
+	defer ticker.Stop()
+	for {
+		stateResp, err := dataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{
+			SegmentIDs: flushResp.GetSegmentIDs(),
+		})
+		if err := statusError("GetFlushState", stateResp.GetStatus(), err); err != nil {
+			return err
+		}
+		if stateResp.GetFlushed() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for segments %v to be flushed: %w", flushResp.GetSegmentIDs(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// getFlushedSegments returns the flushed segments of a partition
+func getFlushedSegments(ctx context.Context, dataCoord DataCoord, collectionID, partitionID int64) ([]*datapb.SegmentInfo, error) {
+	flushedResp, err := dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+	})
+	if err := statusError("GetFlushedSegments", flushedResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	if len(flushedResp.GetSegments()) == 0 {
+		return nil, nil
+	}
+	infoResp, err := dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		SegmentIDs: flushedResp.GetSegments(),
+	})
+	if err := statusError("GetSegmentInfo", infoResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+
+	return infoResp.GetInfos(), nil
+}
+
+// filterSegments returns the segments with the delta logs written up to ts
+func filterSegments(segments []*datapb.SegmentInfo, ts uint64) ([]*datapb.SegmentInfo, error) {
+	filtered := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if segment.GetDmlPosition().GetTimestamp() > ts {
+			if segment.GetStartPosition().GetTimestamp() > ts {
+				continue
+			}
+			return nil, fmt.Errorf("segment %d holds data both before and after the backup timestamp %d",
+				segment.GetID(), ts)
+		}
+		segment = proto.Clone(segment).(*datapb.SegmentInfo)
+		for _, fieldLog := range segment.GetDeltalogs() {
+			binlogs := make([]*datapb.Binlog, 0, len(fieldLog.GetBinlogs()))
+			for _, binlog := range fieldLog.GetBinlogs() {
+				if binlog.GetTimestampFrom() > ts {
+					continue
+				}
+				if binlog.GetTimestampTo() > ts {
+					return nil, fmt.Errorf("delta log %s of segment %d holds deletes both before and after the backup timestamp %d",
+						binlog.GetLogPath(), segment.GetID(), ts)
+				}
+				binlogs = append(binlogs, binlog)
+			}
+			fieldLog.Binlogs = binlogs
+		}
+		filtered = append(filtered, segment)
+	}
+	return filtered, nil
+}
+
+func backupSegment(cm storage.ChunkManager, param *BackupParam, segment *datapb.SegmentInfo) error {
+	for _, fieldLogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+		for _, fieldLog := range fieldLogs {
+			for _, binlog := range fieldLog.GetBinlogs() {
+				dst := backupLogPath(param.RootPath, param.Name, binlog.GetLogPath())
+				if err := copyObject(cm, binlog.GetLogPath(), dst); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// BackupDir returns the directory of the backup named name under rootPath
+func BackupDir(rootPath, name string) string {
+	return path.Join(rootPath, name)
+}
+
+// backupLogPath returns the key a binlog of the source cluster is copied to
+func backupLogPath(rootPath, name, logPath string) string {
+	return path.Join(BackupDir(rootPath, name), BinlogDir, logPath)
+}
+
+// ReadManifest reads the manifest of the backup named name under rootPath
+func ReadManifest(cm storage.ChunkManager, rootPath, name string) (*backuppb.BackupManifest, error) {
+	key := path.Join(BackupDir(rootPath, name), ManifestFile)
+	if !cm.Exist(key) {
+		return nil, fmt.Errorf("backup %s not found under %s", name, rootPath)
+	}
+	content, err := cm.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &backuppb.BackupManifest{}
+	if err := jsonpb.UnmarshalString(string(content), manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest of backup %s: %w", name, err)
+	}
+	return manifest, nil
+}
+
+func writeManifest(cm storage.ChunkManager, rootPath string, manifest *backuppb.BackupManifest) error {
+	marshaler := jsonpb.Marshaler{Indent: "  "}
+	content, err := marshaler.MarshalToString(manifest)
+	if err != nil {
+		return err
+	}
+	return cm.Write(path.Join(BackupDir(rootPath, manifest.GetName()), ManifestFile), []byte(content))
+}
+
+func copyObject(cm storage.ChunkManager, src, dst string) error {
+	content, err := cm.Read(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	if err := cm.Write(dst, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	return nil
+}
+
+// statusError returns an error if the call of op failed or returned a failed status
+func statusError(op string, status *commonpb.Status, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", op, err)
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("%s failed: %s", op, status.GetReason())
+	}
+	return nil
+}
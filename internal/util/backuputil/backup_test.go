@@ -0,0 +1,585 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+var successStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+
+type mockCollection struct {
+	id         int64
+	schema     *schemapb.CollectionSchema
+	channels   []string
+	partitions map[string]int64
+	indexes    []*milvuspb.IndexDescription
+}
+
+type mockRootCoord struct {
+	nextID         int64
+	collections    map[string]*mockCollection
+	createIndexErr error
+}
+
+func newMockRootCoord() *mockRootCoord {
+	return &mockRootCoord{nextID: 1000, collections: make(map[string]*mockCollection)}
+}
+
+// collKey is the key of a collection in mockRootCoord, collections of the default database are keyed by their names
+func collKey(dbName, collName string) string {
+	if dbName == "" {
+		return collName
+	}
+	return dbName + "." + collName
+}
+
+func (m *mockRootCoord) allocID() int64 {
+	m.nextID++
+	return m.nextID
+}
+
+func (m *mockRootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	if _, ok := m.collections[collKey(req.GetDbName(), req.GetCollectionName())]; ok {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "collection exists"}, nil
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), schema); err != nil {
+		return nil, err
+	}
+	// user fields are numbered from 100 in order, then the system fields are appended
+	for i, field := range schema.GetFields() {
+		field.FieldID = int64(100 + i)
+	}
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+		&schemapb.FieldSchema{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64})
+	coll := &mockCollection{id: m.allocID(), schema: schema, partitions: map[string]int64{"_default": m.allocID()}}
+	for i := int32(0); i < req.GetShardsNum(); i++ {
+		coll.channels = append(coll.channels, fmt.Sprintf("%s_%dv%d", req.GetCollectionName(), coll.id, i))
+	}
+	m.collections[collKey(req.GetDbName(), req.GetCollectionName())] = coll
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	coll, ok := m.collections[collKey(req.GetDbName(), req.GetCollectionName())]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "collection not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:              successStatus,
+		Schema:              coll.schema,
+		CollectionID:        coll.id,
+		VirtualChannelNames: coll.channels,
+		ShardsNum:           int32(len(coll.channels)),
+		ConsistencyLevel:    commonpb.ConsistencyLevel_Strong,
+	}, nil
+}
+
+func (m *mockRootCoord) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	delete(m.collections, collKey(req.GetDbName(), req.GetCollectionName()))
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	m.collections[collKey(req.GetDbName(), req.GetCollectionName())].partitions[req.GetPartitionName()] = m.allocID()
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	resp := &milvuspb.ShowPartitionsResponse{Status: successStatus}
+	for name, id := range m.collections[collKey(req.GetDbName(), req.GetCollectionName())].partitions {
+		resp.PartitionNames = append(resp.PartitionNames, name)
+		resp.PartitionIDs = append(resp.PartitionIDs, id)
+	}
+	return resp, nil
+}
+
+func (m *mockRootCoord) CreateIndex(ctx context.Context, req *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	if m.createIndexErr != nil {
+		return nil, m.createIndexErr
+	}
+	coll := m.collections[collKey(req.GetDbName(), req.GetCollectionName())]
+	coll.indexes = append(coll.indexes, &milvuspb.IndexDescription{
+		IndexName: "_default_idx",
+		FieldName: req.GetFieldName(),
+		Params:    req.GetExtraParams(),
+	})
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) DescribeIndex(ctx context.Context, req *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	coll := m.collections[collKey(req.GetDbName(), req.GetCollectionName())]
+	if len(coll.indexes) == 0 {
+		return &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist, Reason: "index not exist"},
+		}, nil
+	}
+	return &milvuspb.DescribeIndexResponse{Status: successStatus, IndexDescriptions: coll.indexes}, nil
+}
+
+type mockDataCoord struct {
+	nextID      int64
+	segments    map[int64]*datapb.SegmentInfo
+	pins        map[int64]int64 // pinID -> collectionID
+	pinCount    int
+	expirePins  bool
+	flushChecks int
+}
+
+func newMockDataCoord() *mockDataCoord {
+	return &mockDataCoord{nextID: 5000, segments: make(map[int64]*datapb.SegmentInfo), pins: make(map[int64]int64)}
+}
+
+func (m *mockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	resp := &datapb.FlushResponse{Status: successStatus, CollectionID: req.GetCollectionID()}
+	for id, segment := range m.segments {
+		if segment.GetCollectionID() == req.GetCollectionID() && segment.GetState() == commonpb.SegmentState_Growing {
+			segment.State = commonpb.SegmentState_Sealed
+			resp.SegmentIDs = append(resp.SegmentIDs, id)
+		}
+	}
+	return resp, nil
+}
+
+// GetFlushState flushes the sealed segments at the second check
+func (m *mockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	m.flushChecks++
+	if m.flushChecks < 2 {
+		return &milvuspb.GetFlushStateResponse{Status: successStatus, Flushed: false}, nil
+	}
+	for _, id := range req.GetSegmentIDs() {
+		m.segments[id].State = commonpb.SegmentState_Flushed
+	}
+	return &milvuspb.GetFlushStateResponse{Status: successStatus, Flushed: true}, nil
+}
+
+func (m *mockDataCoord) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest) (*datapb.PinCollectionResponse, error) {
+	m.nextID++
+	m.pins[m.nextID] = req.GetCollectionID()
+	m.pinCount++
+	return &datapb.PinCollectionResponse{Status: successStatus, PinID: m.nextID}, nil
+}
+
+func (m *mockDataCoord) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest) (*commonpb.Status, error) {
+	_, ok := m.pins[req.GetPinID()]
+	delete(m.pins, req.GetPinID())
+	if !ok || m.expirePins {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "pin expired"}, nil
+	}
+	return successStatus, nil
+}
+
+func (m *mockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
+	resp := &datapb.AssignSegmentIDResponse{Status: successStatus}
+	for _, r := range req.GetSegmentIDRequests() {
+		m.nextID++
+		m.segments[m.nextID] = &datapb.SegmentInfo{
+			ID:            m.nextID,
+			CollectionID:  r.GetCollectionID(),
+			PartitionID:   r.GetPartitionID(),
+			InsertChannel: r.GetChannelName(),
+			State:         commonpb.SegmentState_Growing,
+			IsImporting:   r.GetIsImport(),
+		}
+		resp.SegIDAssignments = append(resp.SegIDAssignments, &datapb.SegmentIDAssignment{
+			SegID:        m.nextID,
+			ChannelName:  r.GetChannelName(),
+			CollectionID: r.GetCollectionID(),
+			PartitionID:  r.GetPartitionID(),
+			Status:       successStatus,
+		})
+	}
+	return resp, nil
+}
+
+func (m *mockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	resp := &datapb.GetSegmentInfoResponse{Status: successStatus}
+	for _, id := range req.GetSegmentIDs() {
+		resp.Infos = append(resp.Infos, proto.Clone(m.segments[id]).(*datapb.SegmentInfo))
+	}
+	return resp, nil
+}
+
+func (m *mockDataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+	segment := m.segments[req.GetSegmentID()]
+	segment.Binlogs = req.GetField2BinlogPaths()
+	segment.Statslogs = req.GetField2StatslogPaths()
+	segment.Deltalogs = req.GetDeltalogs()
+	segment.NumOfRows = req.GetCheckPoints()[0].GetNumOfRows()
+	if req.GetFlushed() {
+		segment.State = commonpb.SegmentState_Flushed
+		segment.IsImporting = false
+	}
+	return successStatus, nil
+}
+
+func (m *mockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	resp := &datapb.GetFlushedSegmentsResponse{Status: successStatus}
+	for id, segment := range m.segments {
+		if segment.GetCollectionID() == req.GetCollectionID() && segment.GetPartitionID() == req.GetPartitionID() &&
+			segment.GetState() == commonpb.SegmentState_Flushed {
+			resp.Segments = append(resp.Segments, id)
+		}
+	}
+	return resp, nil
+}
+
+// addSegment writes the binlogs of a flushed segment of coll, with one insert log and one stats log
+// per field and a delta log for each of the deltaTs
+func addSegment(t *testing.T, dc *mockDataCoord, cm storage.ChunkManager, coll *mockCollection, partition string,
+	dmlTs uint64, deltaTs ...uint64) *datapb.SegmentInfo {
+	dc.nextID++
+	segment := &datapb.SegmentInfo{
+		ID:            dc.nextID,
+		CollectionID:  coll.id,
+		PartitionID:   coll.partitions[partition],
+		InsertChannel: coll.channels[len(dc.segments)%len(coll.channels)],
+		NumOfRows:     10,
+		State:         commonpb.SegmentState_Flushed,
+		DmlPosition:   &internalpb.MsgPosition{Timestamp: dmlTs},
+	}
+	for _, field := range coll.schema.GetFields() {
+		for _, root := range []string{"files/insert_log", "files/stats_log"} {
+			logPath := fmt.Sprintf("%s/%d/%d/%d/%d/%d", root, coll.id, segment.PartitionID, segment.ID, field.FieldID, 1)
+			require.NoError(t, cm.Write(logPath, []byte(logPath)))
+			fieldLog := &datapb.FieldBinlog{
+				FieldID: field.FieldID,
+				Binlogs: []*datapb.Binlog{{EntriesNum: 10, LogPath: logPath}},
+			}
+			if root == "files/insert_log" {
+				segment.Binlogs = append(segment.Binlogs, fieldLog)
+			} else {
+				segment.Statslogs = append(segment.Statslogs, fieldLog)
+			}
+		}
+	}
+	deltaLog := &datapb.FieldBinlog{}
+	for i, ts := range deltaTs {
+		logPath := fmt.Sprintf("files/delta_log/%d/%d/%d/%d", coll.id, segment.PartitionID, segment.ID, i)
+		require.NoError(t, cm.Write(logPath, []byte(logPath)))
+		deltaLog.Binlogs = append(deltaLog.Binlogs, &datapb.Binlog{TimestampFrom: ts, TimestampTo: ts, LogPath: logPath})
+	}
+	segment.Deltalogs = []*datapb.FieldBinlog{deltaLog}
+	dc.segments[segment.ID] = segment
+	return segment
+}
+
+func sampleSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}}},
+		},
+	}
+}
+
+// createSampleCollection creates the collection "coll" in the database dbName with the partitions "_default"
+// and "p1" and an index on the vector field
+func createSampleCollection(t *testing.T, rc *mockRootCoord, dbName string) *mockCollection {
+	ctx := context.Background()
+	blob, err := proto.Marshal(sampleSchema())
+	require.NoError(t, err)
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{DbName: dbName, CollectionName: "coll",
+		Schema: blob, ShardsNum: 2})
+	require.NoError(t, statusError("CreateCollection", status, err))
+	_, err = rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{DbName: dbName, CollectionName: "coll", PartitionName: "p1"})
+	require.NoError(t, err)
+	_, err = rc.CreateIndex(ctx, &milvuspb.CreateIndexRequest{DbName: dbName, CollectionName: "coll", FieldName: "vec",
+		ExtraParams: []*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}}})
+	require.NoError(t, err)
+	return rc.collections[collKey(dbName, "coll")]
+}
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+	defer func(interval time.Duration) { flushStateCheckInterval = interval }(flushStateCheckInterval)
+	flushStateCheckInterval = time.Millisecond
+
+	rc, dc := newMockRootCoord(), newMockDataCoord()
+	coll := createSampleCollection(t, rc, "")
+
+	seg1 := addSegment(t, dc, cm, coll, "_default", 100, 150, 300)
+	seg2 := addSegment(t, dc, cm, coll, "p1", 200)
+	seg3 := addSegment(t, dc, cm, coll, "p1", 400)
+	// the growing segment is flushed by the backup
+	seg4 := addSegment(t, dc, cm, coll, "p1", 300)
+	seg4.State = commonpb.SegmentState_Growing
+
+	manifest, err := Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", CollectionName: "coll"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, dc.flushChecks)
+	assert.Equal(t, 1, dc.pinCount)
+	assert.Equal(t, 0, len(dc.pins))
+	assert.Equal(t, coll.id, manifest.GetCollectionID())
+	assert.Equal(t, int32(2), manifest.GetShardsNum())
+	assert.Equal(t, 1, len(manifest.GetIndexes()))
+	backedUp := make(map[int64]*datapb.SegmentInfo)
+	for _, partition := range manifest.GetPartitions() {
+		for _, segment := range partition.GetSegments() {
+			assert.Equal(t, coll.partitions[partition.GetPartitionName()], segment.GetPartitionID())
+			backedUp[segment.GetID()] = segment
+		}
+	}
+	assert.Equal(t, 4, len(backedUp))
+	for _, segment := range []*datapb.SegmentInfo{seg1, seg2, seg3, seg4} {
+		require.Contains(t, backedUp, segment.GetID())
+	}
+	assert.Equal(t, 2, len(backedUp[seg1.GetID()].GetDeltalogs()[0].GetBinlogs()))
+	assert.Equal(t, uint64(400), manifest.GetBackupTimestamp())
+	assert.True(t, cm.Exist(backupLogPath("backup", "bak", seg1.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())))
+
+	read, err := ReadManifest(cm, "backup", "bak")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(manifest, read))
+
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", CollectionName: "coll"})
+	assert.Error(t, err)
+
+	// restoring under the same name fails as the collection exists
+	assert.Error(t, Restore(ctx, rc, dc, cm, &RestoreParam{Name: "bak", RootPath: "backup"}))
+
+	err = Restore(ctx, rc, dc, cm, &RestoreParam{Name: "bak", RootPath: "backup", CollectionName: "restored"})
+	require.NoError(t, err)
+	restored := rc.collections["restored"]
+	require.NotNil(t, restored)
+	assert.Equal(t, 2, len(restored.channels))
+	assert.Equal(t, 4, len(restored.schema.GetFields()))
+	assert.Equal(t, 2, len(restored.partitions))
+	assert.Equal(t, 1, len(restored.indexes))
+	assert.Equal(t, "IVF_FLAT", restored.indexes[0].GetParams()[0].GetValue())
+
+	restoredSegments := 0
+	for _, segment := range dc.segments {
+		if segment.GetCollectionID() != restored.id {
+			continue
+		}
+		restoredSegments++
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.Equal(t, int64(10), segment.GetNumOfRows())
+		assert.Contains(t, restored.channels, segment.GetInsertChannel())
+		for _, fieldLogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs()} {
+			assert.Equal(t, 4, len(fieldLogs))
+			for _, fieldLog := range fieldLogs {
+				logPath := fieldLog.GetBinlogs()[0].GetLogPath()
+				assert.Contains(t, logPath, fmt.Sprintf("/%d/%d/%d/%d/", restored.id, segment.GetPartitionID(), segment.GetID(), fieldLog.GetFieldID()))
+				assert.True(t, cm.Exist(logPath))
+			}
+		}
+	}
+	assert.Equal(t, 4, restoredSegments)
+}
+
+func TestBackupRestore_Database(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+
+	rc, dc := newMockRootCoord(), newMockDataCoord()
+	// a collection with the same name in the default database is not backed up
+	createSampleCollection(t, rc, "")
+	coll := createSampleCollection(t, rc, "db1")
+	addSegment(t, dc, cm, coll, "_default", 100)
+
+	manifest, err := Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", DbName: "db1", CollectionName: "coll"})
+	require.NoError(t, err)
+	assert.Equal(t, "db1", manifest.GetDbName())
+	assert.Equal(t, coll.id, manifest.GetCollectionID())
+	assert.Equal(t, 1, len(manifest.GetIndexes()))
+
+	err = Restore(ctx, rc, dc, cm, &RestoreParam{Name: "bak", RootPath: "backup", DbName: "db2"})
+	require.NoError(t, err)
+	restored := rc.collections[collKey("db2", "coll")]
+	require.NotNil(t, restored)
+	assert.Equal(t, 2, len(restored.partitions))
+	assert.Equal(t, 1, len(restored.indexes))
+
+	// the collection is dropped from its database if the restore fails
+	rc.createIndexErr = errors.New("mock error")
+	err = Restore(ctx, rc, dc, cm, &RestoreParam{Name: "bak", RootPath: "backup", DbName: "db3"})
+	assert.Error(t, err)
+	assert.NotContains(t, rc.collections, collKey("db3", "coll"))
+	assert.Contains(t, rc.collections, collKey("db2", "coll"))
+}
+
+func TestBackup_Timestamp(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+
+	rc, dc := newMockRootCoord(), newMockDataCoord()
+	coll := createSampleCollection(t, rc, "")
+	seg1 := addSegment(t, dc, cm, coll, "_default", 100, 150, 300)
+	seg2 := addSegment(t, dc, cm, coll, "p1", 200)
+	seg3 := addSegment(t, dc, cm, coll, "p1", 400)
+	seg3.StartPosition = &internalpb.MsgPosition{Timestamp: 350}
+
+	manifest, err := Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", CollectionName: "coll", Timestamp: 250})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(250), manifest.GetBackupTimestamp())
+	backedUp := make(map[int64]*datapb.SegmentInfo)
+	for _, partition := range manifest.GetPartitions() {
+		for _, segment := range partition.GetSegments() {
+			backedUp[segment.GetID()] = segment
+		}
+	}
+	assert.Equal(t, 2, len(backedUp))
+	require.Contains(t, backedUp, seg1.GetID())
+	require.Contains(t, backedUp, seg2.GetID())
+	// the deletes after the timestamp are not backed up
+	deltaLogs := backedUp[seg1.GetID()].GetDeltalogs()[0].GetBinlogs()
+	assert.Equal(t, 1, len(deltaLogs))
+	assert.Equal(t, uint64(150), deltaLogs[0].GetTimestampTo())
+	assert.False(t, cm.Exist(backupLogPath("backup", "bak", seg1.GetDeltalogs()[0].GetBinlogs()[1].GetLogPath())))
+	assert.Equal(t, 2, len(seg1.GetDeltalogs()[0].GetBinlogs()))
+
+	// the binlogs of a segment or a delta log across the timestamp can't be split
+	seg3.StartPosition.Timestamp = 220
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak1", RootPath: "backup", CollectionName: "coll", Timestamp: 250})
+	assert.Error(t, err)
+	seg3.StartPosition.Timestamp = 350
+	seg2.Deltalogs[0].Binlogs = append(seg2.Deltalogs[0].Binlogs, &datapb.Binlog{TimestampFrom: 240, TimestampTo: 260})
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak2", RootPath: "backup", CollectionName: "coll", Timestamp: 250})
+	assert.Error(t, err)
+
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak3", RootPath: "backup", CollectionName: "coll",
+		Timestamp: tsoutil.ComposeTSByTime(time.Now().Add(time.Hour), 0)})
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(dc.pins))
+}
+
+func TestBackup_PinExpired(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+
+	rc, dc := newMockRootCoord(), newMockDataCoord()
+	coll := createSampleCollection(t, rc, "")
+	addSegment(t, dc, cm, coll, "_default", 100)
+	dc.expirePins = true
+
+	// the segments may have been compacted while they were copied, the backup can't be trusted
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", CollectionName: "coll"})
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(dc.pins))
+	_, err = ReadManifest(cm, "backup", "bak")
+	assert.Error(t, err)
+}
+
+func TestRestore_Cleanup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+
+	rc, dc := newMockRootCoord(), newMockDataCoord()
+	coll := createSampleCollection(t, rc, "")
+	addSegment(t, dc, cm, coll, "_default", 100, 150)
+	addSegment(t, dc, cm, coll, "p1", 200)
+	_, err = Backup(ctx, rc, dc, cm, &BackupParam{Name: "bak", RootPath: "backup", CollectionName: "coll"})
+	require.NoError(t, err)
+
+	rc.createIndexErr = errors.New("mock error")
+	err = Restore(ctx, rc, dc, cm, &RestoreParam{Name: "bak", RootPath: "backup", CollectionName: "restored"})
+	assert.Error(t, err)
+	// the restored collection is dropped and the binlogs copied to it are removed
+	assert.NotContains(t, rc.collections, "restored")
+	restoredSegments := 0
+	for _, segment := range dc.segments {
+		if segment.GetCollectionID() == coll.id {
+			continue
+		}
+		restoredSegments++
+		for _, fieldLogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+			for _, fieldLog := range fieldLogs {
+				for _, binlog := range fieldLog.GetBinlogs() {
+					assert.False(t, cm.Exist(binlog.GetLogPath()))
+				}
+			}
+		}
+	}
+	assert.Equal(t, 2, restoredSegments)
+	assert.Contains(t, rc.collections, "coll")
+}
+
+func TestBackup_NotFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	ctx := context.Background()
+
+	_, err = Backup(ctx, newMockRootCoord(), newMockDataCoord(), cm, &BackupParam{Name: "bak", CollectionName: "coll"})
+	assert.Error(t, err)
+	_, err = Backup(ctx, newMockRootCoord(), newMockDataCoord(), cm, &BackupParam{CollectionName: "coll"})
+	assert.Error(t, err)
+	_, err = ReadManifest(cm, "", "bak")
+	assert.Error(t, err)
+	assert.Error(t, Restore(ctx, newMockRootCoord(), newMockDataCoord(), cm, &RestoreParam{Name: "bak"}))
+}
+
+func TestRebaseLogPath(t *testing.T) {
+	src := logPathIDs{collectionID: 1, partitionID: 2, segmentID: 3, fieldID: 100}
+	dst := logPathIDs{collectionID: 11, partitionID: 12, segmentID: 13, fieldID: 101}
+
+	logPath, err := rebaseLogPath("files/insert_log/1/2/3/100/9", src, dst, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/insert_log/11/12/13/101/9", logPath)
+
+	logPath, err = rebaseLogPath("files/delta_log/1/2/3/9", src, dst, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "files/delta_log/11/12/13/9", logPath)
+
+	_, err = rebaseLogPath("files/insert_log/1/2/4/100/9", src, dst, true)
+	assert.Error(t, err)
+	_, err = rebaseLogPath("2/3/9", src, dst, true)
+	assert.Error(t, err)
+}
@@ -0,0 +1,382 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backuputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/backuppb"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// RestoreParam describes the backup to restore
+type RestoreParam struct {
+	Name     string
+	RootPath string
+	// DbName is the database the collection is restored to, empty means the default database
+	DbName string
+	// CollectionName is the name of the restored collection, empty means the name of the backed up one
+	CollectionName string
+}
+
+// restoredCollection is the collection created by restore
+type restoredCollection struct {
+	collectionID int64
+	channels     []string
+	fieldIDs     map[int64]int64 // field ids of the backed up collection to the ones of the restored one
+	partitionIDs map[string]int64
+	logPaths     []string // binlogs copied to the restored collection
+}
+
+// Restore creates a collection with the schema, the partitions and the indexes in the manifest of a backup,
+// copies the binlogs of the backup to the paths of new segments and registers the segments to DataCoord.
+// Segments are registered as flushed, the restored collection can be loaded once Restore returns.
+// If Restore fails, the restored collection is dropped and the copied binlogs are removed.
+func Restore(ctx context.Context, rootCoord RootCoord, dataCoord DataCoord, cm storage.ChunkManager,
+	param *RestoreParam) (err error) {
+	manifest, err := ReadManifest(cm, param.RootPath, param.Name)
+	if err != nil {
+		return err
+	}
+	collName := param.CollectionName
+	if collName == "" {
+		collName = manifest.GetCollectionName()
+	}
+
+	coll, err := createCollection(ctx, rootCoord, manifest, param.DbName, collName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			cleanupRestore(rootCoord, cm, param.DbName, collName, coll.logPaths)
+		}
+	}()
+	// shards of the restored collection are in the same order as the ones of the backed up collection
+	channels := make(map[string]string, len(manifest.GetVirtualChannelNames()))
+	for i, channel := range manifest.GetVirtualChannelNames() {
+		if i < len(coll.channels) {
+			channels[channel] = coll.channels[i]
+		}
+	}
+
+	for _, partition := range manifest.GetPartitions() {
+		partitionID, ok := coll.partitionIDs[partition.GetPartitionName()]
+		if !ok {
+			return fmt.Errorf("partition %s not found in restored collection %s", partition.GetPartitionName(), collName)
+		}
+		for _, segment := range partition.GetSegments() {
+			channel, ok := channels[segment.GetInsertChannel()]
+			if !ok {
+				return fmt.Errorf("channel %s of segment %d not found in backup", segment.GetInsertChannel(), segment.GetID())
+			}
+			if err := restoreSegment(ctx, dataCoord, cm, param, coll, partitionID, channel, segment); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, index := range manifest.GetIndexes() {
+		status, err := rootCoord.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateIndex},
+			DbName:         param.DbName,
+			CollectionName: collName,
+			FieldName:      index.GetFieldName(),
+			ExtraParams:    index.GetParams(),
+		})
+		if err := statusError("CreateIndex", status, err); err != nil {
+			return err
+		}
+	}
+	log.Info("backup restored", zap.String("name", param.Name), zap.String("db", param.DbName), zap.String("collection", collName),
+		zap.Int64("collectionID", coll.collectionID))
+	return nil
+}
+
+// createCollection creates the collection and the partitions in the manifest,
+// the collection is dropped if the partitions fail to be created
+func createCollection(ctx context.Context, rootCoord RootCoord, manifest *backuppb.BackupManifest,
+	dbName, collName string) (coll *restoredCollection, err error) {
+	if manifest.GetSchema() == nil {
+		return nil, errors.New("backup manifest has no schema")
+	}
+	schema := proto.Clone(manifest.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Name = collName
+	// system fields are added by RootCoord
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+	schema.Fields = fields
+	blob, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	partitionKey := typeutil.GetPartitionKeyField(schema) != nil
+	req := &milvuspb.CreateCollectionRequest{
+		Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		DbName:           dbName,
+		CollectionName:   collName,
+		Schema:           blob,
+		ShardsNum:        manifest.GetShardsNum(),
+		ConsistencyLevel: manifest.GetConsistencyLevel(),
+	}
+	if partitionKey {
+		req.NumPartitions = int64(len(manifest.GetPartitions()))
+	}
+	status, err := rootCoord.CreateCollection(ctx, req)
+	if err := statusError("CreateCollection", status, err); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			cleanupRestore(rootCoord, nil, dbName, collName, nil)
+		}
+	}()
+
+	collResp, err := rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		DbName:         dbName,
+		CollectionName: collName,
+	})
+	if err := statusError("DescribeCollection", collResp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	coll = &restoredCollection{
+		collectionID: collResp.GetCollectionID(),
+		channels:     collResp.GetVirtualChannelNames(),
+		fieldIDs:     make(map[int64]int64),
+	}
+	// field ids are assigned by RootCoord, fields are matched by names
+	for _, field := range manifest.GetSchema().GetFields() {
+		for _, restored := range collResp.GetSchema().GetFields() {
+			if restored.GetName() == field.GetName() {
+				coll.fieldIDs[field.GetFieldID()] = restored.GetFieldID()
+			}
+		}
+	}
+
+	coll.partitionIDs, err = showPartitions(ctx, rootCoord, dbName, collName)
+	if err != nil {
+		return nil, err
+	}
+	// partitions of collections with a partition key are created along with the collection
+	if partitionKey {
+		return coll, nil
+	}
+	created := false
+	for _, partition := range manifest.GetPartitions() {
+		if _, ok := coll.partitionIDs[partition.GetPartitionName()]; ok {
+			continue
+		}
+		status, err := rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+			DbName:         dbName,
+			CollectionName: collName,
+			PartitionName:  partition.GetPartitionName(),
+		})
+		if err := statusError("CreatePartition", status, err); err != nil {
+			return nil, err
+		}
+		created = true
+	}
+	if created {
+		coll.partitionIDs, err = showPartitions(ctx, rootCoord, dbName, collName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coll, nil
+}
+
+func showPartitions(ctx context.Context, rootCoord RootCoord, dbName, collName string) (map[string]int64, error) {
+	resp, err := rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+		DbName:         dbName,
+		CollectionName: collName,
+	})
+	if err := statusError("ShowPartitions", resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	partitionIDs := make(map[string]int64, len(resp.GetPartitionNames()))
+	for i, name := range resp.GetPartitionNames() {
+		partitionIDs[name] = resp.GetPartitionIDs()[i]
+	}
+	return partitionIDs, nil
+}
+
+// restoreSegment allocates a new segment, copies the binlogs of the backed up segment to the paths
+// of the new one and saves the paths to DataCoord
+func restoreSegment(ctx context.Context, dataCoord DataCoord, cm storage.ChunkManager, param *RestoreParam,
+	coll *restoredCollection, partitionID int64, channel string, segment *datapb.SegmentInfo) error {
+	resp, err := dataCoord.AssignSegmentID(ctx, &datapb.AssignSegmentIDRequest{
+		SegmentIDRequests: []*datapb.SegmentIDRequest{
+			{
+				CollectionID: coll.collectionID,
+				PartitionID:  partitionID,
+				ChannelName:  channel,
+				Count:        uint32(segment.GetNumOfRows()),
+				IsImport:     true,
+			},
+		},
+	})
+	if err := statusError("AssignSegmentID", resp.GetStatus(), err); err != nil {
+		return err
+	}
+	if len(resp.GetSegIDAssignments()) == 0 {
+		return errors.New("no segment assigned for restore")
+	}
+	assignment := resp.GetSegIDAssignments()[0]
+	if err := statusError("AssignSegmentID", assignment.GetStatus(), nil); err != nil {
+		return err
+	}
+
+	src := logPathIDs{collectionID: segment.GetCollectionID(), partitionID: segment.GetPartitionID(), segmentID: segment.GetID()}
+	dst := logPathIDs{collectionID: coll.collectionID, partitionID: partitionID, segmentID: assignment.GetSegID()}
+	restoreLogs := func(fieldLogs []*datapb.FieldBinlog, withField bool) ([]*datapb.FieldBinlog, error) {
+		restored := make([]*datapb.FieldBinlog, 0, len(fieldLogs))
+		for _, fieldLog := range fieldLogs {
+			fieldID := fieldLog.GetFieldID()
+			if withField {
+				var ok bool
+				if fieldID, ok = coll.fieldIDs[fieldLog.GetFieldID()]; !ok {
+					return nil, fmt.Errorf("field %d of segment %d not found in restored collection",
+						fieldLog.GetFieldID(), segment.GetID())
+				}
+			}
+			src.fieldID, dst.fieldID = fieldLog.GetFieldID(), fieldID
+			binlogs := make([]*datapb.Binlog, 0, len(fieldLog.GetBinlogs()))
+			for _, binlog := range fieldLog.GetBinlogs() {
+				logPath, err := rebaseLogPath(binlog.GetLogPath(), src, dst, withField)
+				if err != nil {
+					return nil, err
+				}
+				// the path is recorded before copying, so that a partially written object is removed as well
+				coll.logPaths = append(coll.logPaths, logPath)
+				if err := copyObject(cm, backupLogPath(param.RootPath, param.Name, binlog.GetLogPath()), logPath); err != nil {
+					return nil, err
+				}
+				restoredLog := proto.Clone(binlog).(*datapb.Binlog)
+				restoredLog.LogPath = logPath
+				binlogs = append(binlogs, restoredLog)
+			}
+			restored = append(restored, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: binlogs})
+		}
+		return restored, nil
+	}
+	binlogs, err := restoreLogs(segment.GetBinlogs(), true)
+	if err != nil {
+		return err
+	}
+	statslogs, err := restoreLogs(segment.GetStatslogs(), true)
+	if err != nil {
+		return err
+	}
+	deltalogs, err := restoreLogs(segment.GetDeltalogs(), false)
+	if err != nil {
+		return err
+	}
+
+	status, err := dataCoord.SaveBinlogPaths(ctx, &datapb.SaveBinlogPathsRequest{
+		SegmentID:           dst.segmentID,
+		CollectionID:        coll.collectionID,
+		Field2BinlogPaths:   binlogs,
+		Field2StatslogPaths: statslogs,
+		Deltalogs:           deltalogs,
+		CheckPoints: []*datapb.CheckPoint{
+			{
+				SegmentID: dst.segmentID,
+				NumOfRows: segment.GetNumOfRows(),
+			},
+		},
+		Flushed:   true,
+		Importing: true,
+	})
+	if err := statusError("SaveBinlogPaths", status, err); err != nil {
+		return err
+	}
+	log.Debug("segment restored", zap.Int64("backupSegmentID", segment.GetID()),
+		zap.Int64("segmentID", dst.segmentID), zap.String("channel", channel))
+	return nil
+}
+
+// cleanupRestore drops the collection of a failed restore and removes the binlogs copied to it,
+// failures are only logged since the restore has failed anyway
+func cleanupRestore(rootCoord RootCoord, cm storage.ChunkManager, dbName, collName string, logPaths []string) {
+	// the cleanup is done even if the restore is cancelled
+	status, err := rootCoord.DropCollection(context.Background(), &milvuspb.DropCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+		DbName:         dbName,
+		CollectionName: collName,
+	})
+	if err := statusError("DropCollection", status, err); err != nil {
+		log.Warn("failed to drop the collection of a failed restore", zap.String("collection", collName), zap.Error(err))
+	}
+	if len(logPaths) == 0 {
+		return
+	}
+	if err := cm.MultiRemove(logPaths); err != nil {
+		log.Warn("failed to remove the binlogs of a failed restore", zap.String("collection", collName), zap.Error(err))
+	}
+}
+
+// logPathIDs are the ids in a binlog path, insert and stats logs are under
+// {root}/{collectionID}/{partitionID}/{segmentID}/{fieldID}/{logID},
+// delta logs are under {root}/{collectionID}/{partitionID}/{segmentID}/{logID}
+type logPathIDs struct {
+	collectionID, partitionID, segmentID, fieldID int64
+}
+
+// rebaseLogPath replaces the ids of src in logPath with the ones of dst, withField tells whether
+// logPath has a field id
+func rebaseLogPath(logPath string, src, dst logPathIDs, withField bool) (string, error) {
+	srcIDs := []int64{src.collectionID, src.partitionID, src.segmentID}
+	dstIDs := []int64{dst.collectionID, dst.partitionID, dst.segmentID}
+	if withField {
+		srcIDs = append(srcIDs, src.fieldID)
+		dstIDs = append(dstIDs, dst.fieldID)
+	}
+	parts := strings.Split(logPath, "/")
+	// the ids are followed by the log id
+	begin := len(parts) - len(srcIDs) - 1
+	if begin < 0 {
+		return "", fmt.Errorf("invalid binlog path %s", logPath)
+	}
+	for i, id := range srcIDs {
+		if parts[begin+i] != strconv.FormatInt(id, 10) {
+			return "", fmt.Errorf("binlog path %s doesn't belong to segment %d", logPath, src.segmentID)
+		}
+		parts[begin+i] = strconv.FormatInt(dstIDs[i], 10)
+	}
+	return strings.Join(parts, "/"), nil
+}
@@ -21,3 +21,126 @@ const (
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 )
+
+// Credential and RBAC consts
+const (
+	// HeaderAuthorize is the metadata key which carries the base64 encoded "username:password" credential
+	HeaderAuthorize = "authorization"
+	// CredentialSeperator separates the username and the password in the credential
+	CredentialSeperator = ":"
+
+	// UserRoot is the built-in super user, it's created when RootCoord starts for the first time
+	UserRoot = "root"
+	// DefaultRootPassword is the initial password of the root user
+	DefaultRootPassword = "Milvus"
+
+	// RoleAdmin is the built-in role which owns all privileges
+	RoleAdmin = "admin"
+	// RolePublic is the built-in role which every user belongs to
+	RolePublic = "public"
+
+	// AnyWord matches any object name of an object type in a grant
+	AnyWord = "*"
+
+	ObjectTypeGlobal     = "Global"
+	ObjectTypeCollection = "Collection"
+	ObjectTypeUser       = "User"
+)
+
+// Privileges which can be granted to a role
+const (
+	PrivilegeAll = "All"
+
+	// privileges of the Global object
+	PrivilegeCreateCollection = "CreateCollection"
+	PrivilegeShowCollections  = "ShowCollections"
+	PrivilegeCreateDatabase   = "CreateDatabase"
+	PrivilegeDropDatabase     = "DropDatabase"
+	PrivilegeListDatabases    = "ListDatabases"
+	PrivilegeCreateOwnership  = "CreateOwnership"
+	PrivilegeDropOwnership    = "DropOwnership"
+	PrivilegeSelectOwnership  = "SelectOwnership"
+	PrivilegeManageOwnership  = "ManageOwnership"
+	PrivilegeCreateAlias      = "CreateAlias"
+	PrivilegeDropAlias        = "DropAlias"
+	PrivilegeAlterAlias       = "AlterAlias"
+	PrivilegeLoadBalance      = "LoadBalance"
+	PrivilegeGetMetrics       = "GetMetrics"
+
+	// privileges of the Collection object
+	PrivilegeDropCollection     = "DropCollection"
+	PrivilegeDescribeCollection = "DescribeCollection"
+	PrivilegeAddField           = "AddField"
+	PrivilegeLoad               = "Load"
+	PrivilegeRelease            = "Release"
+	PrivilegeCompaction         = "Compaction"
+	PrivilegeInsert             = "Insert"
+	PrivilegeDelete             = "Delete"
+	PrivilegeUpsert             = "Upsert"
+	PrivilegeGetStatistics      = "GetStatistics"
+	PrivilegeCreateIndex        = "CreateIndex"
+	PrivilegeIndexDetail        = "IndexDetail"
+	PrivilegeDropIndex          = "DropIndex"
+	PrivilegeSearch             = "Search"
+	PrivilegeFlush              = "Flush"
+	PrivilegeQuery              = "Query"
+	PrivilegeCreatePartition    = "CreatePartition"
+	PrivilegeDropPartition      = "DropPartition"
+	PrivilegeShowPartitions     = "ShowPartitions"
+	PrivilegeHasPartition       = "HasPartition"
+	PrivilegeImport             = "Import"
+
+	// privileges of the User object
+	PrivilegeUpdateUser = "UpdateUser"
+	PrivilegeSelectUser = "SelectUser"
+)
+
+// ObjectPrivileges lists the privileges which can be granted on each object type
+var ObjectPrivileges = map[string][]string{
+	ObjectTypeGlobal: {
+		PrivilegeAll,
+		PrivilegeCreateCollection,
+		PrivilegeShowCollections,
+		PrivilegeCreateDatabase,
+		PrivilegeDropDatabase,
+		PrivilegeListDatabases,
+		PrivilegeCreateOwnership,
+		PrivilegeDropOwnership,
+		PrivilegeSelectOwnership,
+		PrivilegeManageOwnership,
+		PrivilegeCreateAlias,
+		PrivilegeDropAlias,
+		PrivilegeAlterAlias,
+		PrivilegeLoadBalance,
+		PrivilegeGetMetrics,
+	},
+	ObjectTypeCollection: {
+		PrivilegeAll,
+		PrivilegeDropCollection,
+		PrivilegeDescribeCollection,
+		PrivilegeAddField,
+		PrivilegeLoad,
+		PrivilegeRelease,
+		PrivilegeCompaction,
+		PrivilegeInsert,
+		PrivilegeDelete,
+		PrivilegeUpsert,
+		PrivilegeGetStatistics,
+		PrivilegeCreateIndex,
+		PrivilegeIndexDetail,
+		PrivilegeDropIndex,
+		PrivilegeSearch,
+		PrivilegeFlush,
+		PrivilegeQuery,
+		PrivilegeCreatePartition,
+		PrivilegeDropPartition,
+		PrivilegeShowPartitions,
+		PrivilegeHasPartition,
+		PrivilegeImport,
+	},
+	ObjectTypeUser: {
+		PrivilegeAll,
+		PrivilegeUpdateUser,
+		PrivilegeSelectUser,
+	},
+}
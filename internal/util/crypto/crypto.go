@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt hashes the password by bcrypt, the hash is salted so hashing
+// the same password twice gives different results
+func PasswordEncrypt(pwd string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// PasswordVerify returns true if the password matches the bcrypt hash
+func PasswordVerify(rawPwd, encryptedPwd string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(encryptedPwd), []byte(rawPwd)) == nil
+}
+
+// SHA256 returns the hex encoded sha256 digest of the salted string, it's much cheaper than
+// bcrypt and is used to cache the passwords which were verified
+func SHA256(src string, salt string) string {
+	h := sha256.New()
+	h.Write([]byte(src + salt))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Base64Encode encodes the string by the standard base64 encoding
+func Base64Encode(pwd string) string {
+	return base64.StdEncoding.EncodeToString([]byte(pwd))
+}
+
+// Base64Decode decodes the standard base64 encoded string
+func Base64Decode(pwd string) (string, error) {
+	bytes, err := base64.StdEncoding.DecodeString(pwd)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordVerify(t *testing.T) {
+	encrypted, err := PasswordEncrypt("password")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "password", encrypted)
+
+	assert.True(t, PasswordVerify("password", encrypted))
+	assert.False(t, PasswordVerify("Password", encrypted))
+	assert.False(t, PasswordVerify("password", "not encrypted"))
+
+	// bcrypt is salted
+	encrypted2, err := PasswordEncrypt("password")
+	assert.Nil(t, err)
+	assert.NotEqual(t, encrypted, encrypted2)
+	assert.True(t, PasswordVerify("password", encrypted2))
+}
+
+func TestSHA256(t *testing.T) {
+	assert.Equal(t, SHA256("password", "root"), SHA256("password", "root"))
+	assert.NotEqual(t, SHA256("password", "root"), SHA256("password", "user"))
+	assert.Equal(t, 64, len(SHA256("password", "")))
+}
+
+func TestBase64(t *testing.T) {
+	encoded := Base64Encode("root:Milvus")
+	assert.Equal(t, "cm9vdDpNaWx2dXM=", encoded)
+
+	decoded, err := Base64Decode(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "root:Milvus", decoded)
+
+	_, err = Base64Decode("!not base64!")
+	assert.NotNil(t, err)
+}
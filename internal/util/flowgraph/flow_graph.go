@@ -19,6 +19,7 @@ package flowgraph
 import (
 	"context"
 	"errors"
+	"strconv"
 	"sync"
 )
 
@@ -27,6 +28,17 @@ type TimeTickedFlowGraph struct {
 	nodeCtx   map[NodeName]*nodeCtx
 	stopOnce  sync.Once
 	startOnce sync.Once
+
+	// labels of the metrics reported by the nodes, to tell the graphs of different collections and vchannels apart
+	collectionID int64
+	vchannel     string
+}
+
+// SetMetricLabels sets the collection and vchannel the flowgraph consumes, which label the metrics of its nodes,
+// it should be called before Start
+func (fg *TimeTickedFlowGraph) SetMetricLabels(collectionID int64, vchannel string) {
+	fg.collectionID = collectionID
+	fg.vchannel = vchannel
 }
 
 // AddNode add Node into flowgraph
@@ -81,6 +93,8 @@ func (fg *TimeTickedFlowGraph) Start() {
 	fg.startOnce.Do(func() {
 		wg := sync.WaitGroup{}
 		for _, v := range fg.nodeCtx {
+			v.collectionID = strconv.FormatInt(fg.collectionID, 10)
+			v.vchannel = fg.vchannel
 			wg.Add(1)
 			v.Start(&wg)
 		}
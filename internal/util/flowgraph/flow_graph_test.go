@@ -21,10 +21,15 @@ import (
 	"log"
 	"math"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/metrics"
 )
 
 // Flow graph basic example: count `d = pow(a) + sqrt(a)`
@@ -309,3 +314,64 @@ func TestTimeTickedFlowGraph_Close(t *testing.T) {
 	defer cancel()
 	fg.Close()
 }
+
+// queueLengthSeries returns the node names of the queue length series reported under the labels
+func queueLengthSeries(collectionID, vchannel string) []string {
+	ch := make(chan prometheus.Metric, 100)
+	go func() {
+		metrics.FlowGraphNodeQueueLength.Collect(ch)
+		close(ch)
+	}()
+
+	var names []string
+	for m := range ch {
+		pb := &dto.Metric{}
+		if err := m.Write(pb); err != nil {
+			continue
+		}
+		labels := make(map[string]string)
+		for _, pair := range pb.GetLabel() {
+			labels[pair.GetName()] = pair.GetValue()
+		}
+		if labels["collection_id"] == collectionID && labels["vchannel"] == vchannel {
+			names = append(names, labels["node_name"])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestTimeTickedFlowGraph_MetricLabels(t *testing.T) {
+	fg1, inputChan1, outputChan1, cancel1 := createExampleFlowGraph()
+	defer cancel1()
+	fg1.SetMetricLabels(1, "vchannel-1")
+	fg1.Start()
+
+	fg2, inputChan2, outputChan2, cancel2 := createExampleFlowGraph()
+	defer cancel2()
+	fg2.SetMetricLabels(2, "vchannel-2")
+	fg2.Start()
+	defer fg2.Close()
+
+	inputChan1 <- 4
+	<-outputChan1
+	inputChan2 <- 4
+	<-outputChan2
+
+	// the graphs report the same nodes under their own labels
+	nodes := []string{"NodeA", "NodeB", "NodeC", "NodeD"}
+	assert.Equal(t, nodes, queueLengthSeries("1", "vchannel-1"))
+	assert.Equal(t, nodes, queueLengthSeries("2", "vchannel-2"))
+
+	// closing one graph only removes its own series
+	fg1.Close()
+	// wake nodeA up which is blocked in Operate
+	inputChan1 <- 1
+	assert.Eventually(t, func() bool {
+		return len(queueLengthSeries("1", "vchannel-1")) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	inputChan2 <- 9
+	assert.Equal(t, float64(84), <-outputChan2)
+	assert.Equal(t, nodes, queueLengthSeries("2", "vchannel-2"))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"go.uber.org/zap"
 )
 
@@ -58,6 +59,10 @@ type nodeCtx struct {
 	downstream             []*nodeCtx
 	downstreamInputChanIdx map[string]int
 
+	// metric labels, set by the flowgraph when it starts
+	collectionID string
+	vchannel     string
+
 	closeCh chan struct{}
 }
 
@@ -83,6 +88,8 @@ func (nodeCtx *nodeCtx) work() {
 		checker.Check(name)
 		defer checker.Remove(name)
 	}
+	// the worker is the only one that reports the queue length, remove it when the worker quits
+	defer metrics.FlowGraphNodeQueueLength.DeleteLabelValues(nodeCtx.metricLabels()...)
 
 	for {
 		select {
@@ -94,7 +101,14 @@ func (nodeCtx *nodeCtx) work() {
 			var inputs, res []Msg
 			if !nodeCtx.node.IsInputNode() {
 				nodeCtx.collectInputMessages()
+				select {
+				case <-nodeCtx.closeCh:
+					// closed while waiting for the inputs
+					return
+				default:
+				}
 				inputs = nodeCtx.inputMessages
+				metrics.FlowGraphNodeQueueLength.WithLabelValues(nodeCtx.metricLabels()...).Set(float64(nodeCtx.queueLength()))
 			}
 			n := nodeCtx.node
 			res = n.Operate(inputs)
@@ -130,6 +144,20 @@ func (nodeCtx *nodeCtx) Close() {
 	close(nodeCtx.closeCh)
 }
 
+// metricLabels returns the label values of the metrics reported by the node
+func (nodeCtx *nodeCtx) metricLabels() []string {
+	return []string{nodeCtx.collectionID, nodeCtx.vchannel, nodeCtx.node.Name()}
+}
+
+// queueLength returns the num of messages waiting in the input channels
+func (nodeCtx *nodeCtx) queueLength() int {
+	length := 0
+	for _, channel := range nodeCtx.inputChannels {
+		length += len(channel)
+	}
+	return length
+}
+
 // deliverMsg tries to put the Msg to specified downstream channel
 func (nodeCtx *nodeCtx) deliverMsg(wg *sync.WaitGroup, msg Msg, inputChanIdx int) {
 	defer wg.Done()
@@ -23,9 +23,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-basic/ipv4"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -168,6 +170,23 @@ func GetAttrByKeyFromRepeatedKV(key string, kvs []*commonpb.KeyValuePair) (strin
 	return "", errors.New("key " + key + " not found")
 }
 
+// GetCollectionTTL returns the time-to-live of entities set in the collection properties,
+// 0 means entities never expire
+func GetCollectionTTL(props []*commonpb.KeyValuePair) (time.Duration, error) {
+	value, err := GetAttrByKeyFromRepeatedKV(common.CollectionTTLConfigKey, props)
+	if err != nil {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s, should be an integer", common.CollectionTTLConfigKey, value)
+	}
+	if seconds < 0 {
+		return 0, fmt.Errorf("invalid %s %d, should not be negative", common.CollectionTTLConfigKey, seconds)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 // CheckCtxValid check if the context is valid
 func CheckCtxValid(ctx context.Context) bool {
 	return ctx.Err() != context.DeadlineExceeded && ctx.Err() != context.Canceled
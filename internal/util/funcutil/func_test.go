@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -260,6 +261,22 @@ func TestGetAttrByKeyFromRepeatedKV(t *testing.T) {
 	}
 }
 
+func TestGetCollectionTTL(t *testing.T) {
+	ttl, err := GetCollectionTTL(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	ttl, err = GetCollectionTTL([]*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "604800"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 7*24*time.Hour, ttl)
+
+	_, err = GetCollectionTTL([]*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "7d"}})
+	assert.Error(t, err)
+
+	_, err = GetCollectionTTL([]*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "-1"}})
+	assert.Error(t, err)
+}
+
 func TestCheckCtxValid(t *testing.T) {
 	bgCtx := context.Background()
 	timeout := 20 * time.Millisecond
@@ -0,0 +1,467 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// JSONFileExt is the extension of row-based files
+	JSONFileExt = ".json"
+	// NumpyFileExt is the extension of column-based files, each file holds one field and is named after it
+	NumpyFileExt = ".npy"
+)
+
+// FileError is returned when an import file can't be read, or its content doesn't match the collection schema
+type FileError struct {
+	File string
+	Err  error
+}
+
+// Error implements error interface
+func (e *FileError) Error() string {
+	return fmt.Sprintf("failed to import file %s: %s", e.File, e.Err.Error())
+}
+
+// Unwrap returns the underlying error
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// IDAllocFunc allocates count consecutive ids and returns the first one
+type IDAllocFunc func(count uint32) (int64, error)
+
+// ImportFlushFunc is called every time a block of rows of a shard is ready, fields contains
+// the columns of all the fields including RowID and Timestamp, shardID is in [0, shardNum)
+type ImportFlushFunc func(fields map[storage.FieldID]storage.FieldData, shardID int) error
+
+// ImportWrapper parses import files, fills the system fields and the auto-generated primary keys,
+// splits the rows into shards by the hash of primary keys, and passes them to the flush function
+// in blocks no larger than the segment size.
+type ImportWrapper struct {
+	ctx              context.Context
+	collectionSchema *schemapb.CollectionSchema
+	shardNum         int32
+	segmentSize      int64 // max size in bytes of a block passed to callFlushFunc
+	timestamp        typeutil.Timestamp
+	idAlloc          IDAllocFunc
+	chunkManager     storage.ChunkManager
+	callFlushFunc    ImportFlushFunc
+
+	importedRows int64
+}
+
+// NewImportWrapper creates an ImportWrapper
+func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
+	timestamp typeutil.Timestamp, idAlloc IDAllocFunc, chunkManager storage.ChunkManager, flushFunc ImportFlushFunc) *ImportWrapper {
+	return &ImportWrapper{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		shardNum:         shardNum,
+		segmentSize:      segmentSize,
+		timestamp:        timestamp,
+		idAlloc:          idAlloc,
+		chunkManager:     chunkManager,
+		callFlushFunc:    flushFunc,
+	}
+}
+
+// ImportedRows returns the number of rows passed to the flush function
+func (p *ImportWrapper) ImportedRows() int64 {
+	return p.importedRows
+}
+
+// Import parses the files and flushes their rows. Each row-based file is a json file holding rows of all the fields,
+// column-based files are numpy files named after the fields, one file per field, and all of them hold the same
+// number of rows. Errors about a specific file are returned as *FileError.
+func (p *ImportWrapper) Import(files []string, rowBased bool) error {
+	if len(files) == 0 {
+		return errors.New("no file to import")
+	}
+	if p.shardNum <= 0 {
+		return fmt.Errorf("invalid shard number %d", p.shardNum)
+	}
+
+	if rowBased {
+		for _, file := range files {
+			if err := p.ctx.Err(); err != nil {
+				return err
+			}
+			if path.Ext(file) != JSONFileExt {
+				return &FileError{File: file, Err: fmt.Errorf("row-based import only supports %s files", JSONFileExt)}
+			}
+			content, err := p.chunkManager.Read(file)
+			if err != nil {
+				return &FileError{File: file, Err: err}
+			}
+			columns, err := ParseJSONRows(content, p.collectionSchema)
+			if err != nil {
+				return &FileError{File: file, Err: err}
+			}
+			log.Debug("import wrapper parsed row-based file", zap.String("file", file), zap.Int("rows", rowCount(columns)))
+			if err := p.flush(columns); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	columns := make(map[storage.FieldID]storage.FieldData)
+	columnFiles := make(map[storage.FieldID]string)
+	for _, file := range files {
+		if err := p.ctx.Err(); err != nil {
+			return err
+		}
+		if path.Ext(file) != NumpyFileExt {
+			return &FileError{File: file, Err: fmt.Errorf("column-based import only supports %s files", NumpyFileExt)}
+		}
+		name := strings.TrimSuffix(path.Base(file), NumpyFileExt)
+		field := getFieldByName(p.collectionSchema, name)
+		if field == nil || field.GetFieldID() < common.StartOfUserFieldID {
+			return &FileError{File: file, Err: fmt.Errorf("field %s is not defined in collection schema", name)}
+		}
+		if isAutoIDPrimaryKey(field) {
+			return &FileError{File: file, Err: fmt.Errorf("field %s is auto-generated and must not be provided", name)}
+		}
+		if prev, ok := columnFiles[field.GetFieldID()]; ok {
+			return &FileError{File: file, Err: fmt.Errorf("field %s is already provided by %s", name, prev)}
+		}
+		content, err := p.chunkManager.Read(file)
+		if err != nil {
+			return &FileError{File: file, Err: err}
+		}
+		column, err := ParseNumpyColumn(content, field)
+		if err != nil {
+			return &FileError{File: file, Err: err}
+		}
+		columns[field.GetFieldID()] = column
+		columnFiles[field.GetFieldID()] = file
+	}
+
+	rows := -1
+	for _, field := range importFields(p.collectionSchema) {
+		column, ok := columns[field.GetFieldID()]
+		if !ok {
+			return fmt.Errorf("no file is provided for field %s", field.GetName())
+		}
+		if rows < 0 {
+			rows = column.RowNum()
+		} else if column.RowNum() != rows {
+			return &FileError{File: columnFiles[field.GetFieldID()],
+				Err: fmt.Errorf("row count %d mismatches the row count %d of the other files", column.RowNum(), rows)}
+		}
+	}
+	return p.flush(columns)
+}
+
+// flush fills the system fields and the auto-generated primary keys of the parsed columns,
+// then passes the rows to callFlushFunc shard by shard.
+func (p *ImportWrapper) flush(columns map[storage.FieldID]storage.FieldData) error {
+	rows := rowCount(columns)
+	if rows == 0 {
+		return nil
+	}
+
+	pkField := getPrimaryKeyField(p.collectionSchema)
+	if pkField == nil {
+		return errors.New("primary key field is not found in collection schema")
+	}
+
+	begin, err := p.idAlloc(uint32(rows))
+	if err != nil {
+		return err
+	}
+	rowIDs := make([]int64, rows)
+	timestamps := make([]int64, rows)
+	for i := range rowIDs {
+		rowIDs[i] = begin + int64(i)
+		timestamps[i] = int64(p.timestamp)
+	}
+	columns[common.RowIDField] = &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: rowIDs}
+	columns[common.TimeStampField] = &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: timestamps}
+	if isAutoIDPrimaryKey(pkField) {
+		// auto-generated primary keys are the row ids, the same as the ones generated by Proxy
+		pks := make([]int64, rows)
+		copy(pks, rowIDs)
+		columns[pkField.GetFieldID()] = &storage.Int64FieldData{NumRows: []int64{int64(rows)}, Data: pks}
+	}
+	for _, column := range columns {
+		setNumRows(column)
+	}
+
+	// split the rows into shards in the same way as Proxy hashes primary keys to channels
+	pkColumn := columns[pkField.GetFieldID()]
+	shards := make([][]int, p.shardNum)
+	for i := 0; i < rows; i++ {
+		var hash uint32
+		switch pk := pkColumn.GetRow(i).(type) {
+		case int64:
+			hash, _ = typeutil.Hash32Int64(pk)
+		case string:
+			h, _ := typeutil.Hash32String(pk)
+			hash = uint32(h)
+		default:
+			return fmt.Errorf("unsupported primary key type %T", pk)
+		}
+		shard := hash % uint32(p.shardNum)
+		shards[shard] = append(shards[shard], i)
+	}
+
+	// estimate the number of rows of a block by the average row size
+	memSize := 0
+	for _, column := range columns {
+		memSize += column.GetMemorySize()
+	}
+	blockRows := rows
+	if p.segmentSize > 0 && int64(memSize) > p.segmentSize {
+		blockRows = int(p.segmentSize * int64(rows) / int64(memSize))
+		if blockRows <= 0 {
+			blockRows = 1
+		}
+	}
+
+	for shardID, shard := range shards {
+		for start := 0; start < len(shard); start += blockRows {
+			if err := p.ctx.Err(); err != nil {
+				return err
+			}
+			end := start + blockRows
+			if end > len(shard) {
+				end = len(shard)
+			}
+			block := make(map[storage.FieldID]storage.FieldData, len(columns))
+			for fieldID, column := range columns {
+				picked, err := pickRows(column, shard[start:end])
+				if err != nil {
+					return err
+				}
+				block[fieldID] = picked
+			}
+			if err := p.callFlushFunc(block, shardID); err != nil {
+				return err
+			}
+			p.importedRows += int64(end - start)
+		}
+	}
+	return nil
+}
+
+// importFields returns the fields whose values are provided by import files,
+// which are all the user fields except the auto-generated primary key
+func importFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || isAutoIDPrimaryKey(field) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func getFieldByName(schema *schemapb.CollectionSchema, name string) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetName() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func getPrimaryKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+func isAutoIDPrimaryKey(field *schemapb.FieldSchema) bool {
+	return field.GetIsPrimaryKey() && field.GetAutoID()
+}
+
+func getFieldDim(field *schemapb.FieldSchema) (int, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == "dim" {
+			dim, err := strconv.Atoi(kv.GetValue())
+			if err != nil || dim <= 0 {
+				return 0, fmt.Errorf("invalid dim %s of field %s", kv.GetValue(), field.GetName())
+			}
+			return dim, nil
+		}
+	}
+	return 0, fmt.Errorf("dim of field %s is not found", field.GetName())
+}
+
+// newFieldData creates an empty column for the field
+func newFieldData(field *schemapb.FieldSchema) (storage.FieldData, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return &storage.BoolFieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Int8:
+		return &storage.Int8FieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Int16:
+		return &storage.Int16FieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Int32:
+		return &storage.Int32FieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Int64:
+		return &storage.Int64FieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Float:
+		return &storage.FloatFieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_Double:
+		return &storage.DoubleFieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_String:
+		return &storage.StringFieldData{NumRows: []int64{0}}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &storage.FloatVectorFieldData{NumRows: []int64{0}, Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDim(field)
+		if err != nil {
+			return nil, err
+		}
+		if dim%8 != 0 {
+			return nil, fmt.Errorf("dim %d of binary vector field %s is not a multiple of 8", dim, field.GetName())
+		}
+		return &storage.BinaryVectorFieldData{NumRows: []int64{0}, Dim: dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.GetDataType().String(), field.GetName())
+	}
+}
+
+// rowCount returns the number of rows of the columns
+func rowCount(columns map[storage.FieldID]storage.FieldData) int {
+	for _, column := range columns {
+		return column.RowNum()
+	}
+	return 0
+}
+
+// setNumRows sets NumRows of the column to its actual row count
+func setNumRows(column storage.FieldData) {
+	n := []int64{int64(column.RowNum())}
+	switch data := column.(type) {
+	case *storage.BoolFieldData:
+		data.NumRows = n
+	case *storage.Int8FieldData:
+		data.NumRows = n
+	case *storage.Int16FieldData:
+		data.NumRows = n
+	case *storage.Int32FieldData:
+		data.NumRows = n
+	case *storage.Int64FieldData:
+		data.NumRows = n
+	case *storage.FloatFieldData:
+		data.NumRows = n
+	case *storage.DoubleFieldData:
+		data.NumRows = n
+	case *storage.StringFieldData:
+		data.NumRows = n
+	case *storage.FloatVectorFieldData:
+		data.NumRows = n
+	case *storage.BinaryVectorFieldData:
+		data.NumRows = n
+	}
+}
+
+// pickRows copies the rows at the offsets of the column into a new column
+func pickRows(column storage.FieldData, offsets []int) (storage.FieldData, error) {
+	n := []int64{int64(len(offsets))}
+	switch data := column.(type) {
+	case *storage.BoolFieldData:
+		picked := &storage.BoolFieldData{NumRows: n, Data: make([]bool, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.Int8FieldData:
+		picked := &storage.Int8FieldData{NumRows: n, Data: make([]int8, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.Int16FieldData:
+		picked := &storage.Int16FieldData{NumRows: n, Data: make([]int16, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.Int32FieldData:
+		picked := &storage.Int32FieldData{NumRows: n, Data: make([]int32, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.Int64FieldData:
+		picked := &storage.Int64FieldData{NumRows: n, Data: make([]int64, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.FloatFieldData:
+		picked := &storage.FloatFieldData{NumRows: n, Data: make([]float32, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.DoubleFieldData:
+		picked := &storage.DoubleFieldData{NumRows: n, Data: make([]float64, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.StringFieldData:
+		picked := &storage.StringFieldData{NumRows: n, Data: make([]string, 0, len(offsets))}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i])
+		}
+		return picked, nil
+	case *storage.FloatVectorFieldData:
+		picked := &storage.FloatVectorFieldData{NumRows: n, Dim: data.Dim, Data: make([]float32, 0, len(offsets)*data.Dim)}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i*data.Dim:(i+1)*data.Dim]...)
+		}
+		return picked, nil
+	case *storage.BinaryVectorFieldData:
+		bytesPerRow := data.Dim / 8
+		picked := &storage.BinaryVectorFieldData{NumRows: n, Dim: data.Dim, Data: make([]byte, 0, len(offsets)*bytesPerRow)}
+		for _, i := range offsets {
+			picked.Data = append(picked.Data, data.Data[i*bytesPerRow:(i+1)*bytesPerRow]...)
+		}
+		return picked, nil
+	default:
+		return nil, fmt.Errorf("unsupported field data type %T", column)
+	}
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type flushedBlock struct {
+	fields  map[storage.FieldID]storage.FieldData
+	shardID int
+}
+
+func newTestWrapper(t *testing.T, autoID bool, segmentSize int64, cm storage.ChunkManager) (*ImportWrapper, *[]flushedBlock) {
+	blocks := make([]flushedBlock, 0)
+	nextID := int64(1000)
+	idAlloc := func(count uint32) (int64, error) {
+		begin := nextID
+		nextID += int64(count)
+		return begin, nil
+	}
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		blocks = append(blocks, flushedBlock{fields: fields, shardID: shardID})
+		return nil
+	}
+	return NewImportWrapper(context.Background(), sampleSchema(autoID), 2, segmentSize, 100, idAlloc, cm, flushFunc), &blocks
+}
+
+func TestImportWrapper_RowBased(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import_wrapper")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+
+	content := []byte(`{"rows": [
+		{"flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]},
+		{"flag": false, "age": 2, "score": 2, "name": "b", "vec": [0.3, 0.4], "bin": [3, 4]},
+		{"flag": true, "age": 3, "score": 3, "name": "c", "vec": [0.5, 0.6], "bin": [5, 6]}
+	]}`)
+	require.Nil(t, cm.Write("rows.json", content))
+
+	wrapper, blocks := newTestWrapper(t, true, 0, cm)
+	err = wrapper.Import([]string{"rows.json"}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), wrapper.ImportedRows())
+
+	total := 0
+	for _, block := range *blocks {
+		rows := block.fields[common.RowIDField].RowNum()
+		total += rows
+		assert.Equal(t, rows, block.fields[common.TimeStampField].RowNum())
+		assert.Equal(t, rows, block.fields[105].RowNum())
+		// auto-generated primary keys are the row ids
+		assert.Equal(t, block.fields[common.RowIDField], block.fields[100])
+		for _, ts := range block.fields[common.TimeStampField].(*storage.Int64FieldData).Data {
+			assert.Equal(t, int64(100), ts)
+		}
+	}
+	assert.Equal(t, 3, total)
+
+	t.Run("file errors", func(t *testing.T) {
+		wrapper, _ := newTestWrapper(t, true, 0, cm)
+		err := wrapper.Import([]string{"rows.npy"}, true)
+		fileErr := &FileError{}
+		assert.True(t, errors.As(err, &fileErr))
+		assert.Equal(t, "rows.npy", fileErr.File)
+
+		err = wrapper.Import([]string{"not_exist.json"}, true)
+		assert.True(t, errors.As(err, &fileErr))
+
+		// the primary key must be provided if it isn't auto-generated
+		wrapper, _ = newTestWrapper(t, false, 0, cm)
+		err = wrapper.Import([]string{"rows.json"}, true)
+		assert.True(t, errors.As(err, &fileErr))
+		assert.Equal(t, "rows.json", fileErr.File)
+
+		err = wrapper.Import(nil, true)
+		assert.Error(t, err)
+	})
+}
+
+func TestImportWrapper_ColumnBased(t *testing.T) {
+	dir, err := ioutil.TempDir("", "import_wrapper")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+
+	rows := 100
+	pks := make([]int64, rows)
+	flags := make([]bool, rows)
+	ages := make([]int8, rows)
+	scores := make([]float64, rows)
+	names := make([]uint32, rows)
+	vecs := make([]float32, rows*2)
+	bins := make([]uint8, rows*2)
+	for i := 0; i < rows; i++ {
+		pks[i] = int64(i)
+		names[i] = 'a' + uint32(i%26)
+	}
+	files := map[string][]byte{
+		"data/pk.npy":    genNumpyContent(t, "<i8", "100,", pks),
+		"data/flag.npy":  genNumpyContent(t, "|b1", "100,", flags),
+		"data/age.npy":   genNumpyContent(t, "|i1", "100,", ages),
+		"data/score.npy": genNumpyContent(t, "<f8", "100,", scores),
+		"data/name.npy":  genNumpyContent(t, "<U1", "100,", names),
+		"data/vec.npy":   genNumpyContent(t, "<f4", "100, 2", vecs),
+		"data/bin.npy":   genNumpyContent(t, "|u1", "100, 2", bins),
+	}
+	paths := make([]string, 0, len(files))
+	for p, content := range files {
+		require.Nil(t, cm.Write(p, content))
+		paths = append(paths, p)
+	}
+
+	// a small segment size splits the rows into several blocks
+	wrapper, blocks := newTestWrapper(t, false, 1024, cm)
+	err = wrapper.Import(paths, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(rows), wrapper.ImportedRows())
+	assert.True(t, len(*blocks) > 2)
+
+	seen := make(map[int64]int)
+	for _, block := range *blocks {
+		assert.Equal(t, 9, len(block.fields))
+		for _, pk := range block.fields[100].(*storage.Int64FieldData).Data {
+			// rows of the same primary key always go to the same shard
+			if shard, ok := seen[pk]; ok {
+				assert.Equal(t, shard, block.shardID)
+			}
+			seen[pk] = block.shardID
+		}
+	}
+	assert.Equal(t, rows, len(seen))
+
+	t.Run("missing field", func(t *testing.T) {
+		wrapper, _ := newTestWrapper(t, false, 0, cm)
+		err := wrapper.Import(paths[1:], false)
+		assert.Error(t, err)
+	})
+
+	t.Run("mismatched row count", func(t *testing.T) {
+		require.Nil(t, cm.Write("short/age.npy", genNumpyContent(t, "|i1", "1,", []int8{1})))
+		mismatched := []string{"short/age.npy"}
+		for _, p := range paths {
+			if p != "data/age.npy" {
+				mismatched = append(mismatched, p)
+			}
+		}
+		wrapper, _ := newTestWrapper(t, false, 0, cm)
+		err := wrapper.Import(mismatched, false)
+		fileErr := &FileError{}
+		assert.True(t, errors.As(err, &fileErr))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		require.Nil(t, cm.Write("data/unknown.npy", genNumpyContent(t, "<i8", "1,", []int64{1})))
+		wrapper, _ := newTestWrapper(t, false, 0, cm)
+		err := wrapper.Import(append(paths, "data/unknown.npy"), false)
+		fileErr := &FileError{}
+		assert.True(t, errors.As(err, &fileErr))
+		assert.Equal(t, "data/unknown.npy", fileErr.File)
+	})
+}
@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// jsonRows is the layout of a row-based json file
+type jsonRows struct {
+	Rows []map[string]interface{} `json:"rows"`
+}
+
+// ParseJSONRows parses the content of a row-based json file into columns of the schema fields, the content
+// looks like {"rows": [{"field_1": 1, "field_2": [0.1, 0.2]}, {"field_1": 2, "field_2": [0.3, 0.4]}]}.
+// The auto-generated primary key must not be provided, all the other user fields are required in every row.
+func ParseJSONRows(content []byte, schema *schemapb.CollectionSchema) (map[storage.FieldID]storage.FieldData, error) {
+	fields := importFields(schema)
+	columns := make(map[storage.FieldID]storage.FieldData, len(fields))
+	for _, field := range fields {
+		column, err := newFieldData(field)
+		if err != nil {
+			return nil, err
+		}
+		columns[field.GetFieldID()] = column
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	// keep the precision of int64 values
+	decoder.UseNumber()
+	rows := &jsonRows{}
+	if err := decoder.Decode(rows); err != nil {
+		return nil, fmt.Errorf("invalid json content: %w", err)
+	}
+	if rows.Rows == nil {
+		return nil, errors.New(`"rows" is not found in json content`)
+	}
+
+	for i, row := range rows.Rows {
+		for name := range row {
+			field := getFieldByName(schema, name)
+			if field == nil || field.GetFieldID() < common.StartOfUserFieldID {
+				return nil, fmt.Errorf("row %d: field %s is not defined in collection schema", i, name)
+			}
+			if isAutoIDPrimaryKey(field) {
+				return nil, fmt.Errorf("row %d: field %s is auto-generated and must not be provided", i, name)
+			}
+		}
+		for _, field := range fields {
+			value, ok := row[field.GetName()]
+			if !ok {
+				return nil, fmt.Errorf("row %d: field %s is missing", i, field.GetName())
+			}
+			if err := appendJSONValue(columns[field.GetFieldID()], value); err != nil {
+				return nil, fmt.Errorf("row %d: field %s: %w", i, field.GetName(), err)
+			}
+		}
+	}
+	return columns, nil
+}
+
+// appendJSONValue appends a value decoded from json to the column
+func appendJSONValue(column storage.FieldData, value interface{}) error {
+	switch data := column.(type) {
+	case *storage.BoolFieldData:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%v is not a bool value", value)
+		}
+		data.Data = append(data.Data, v)
+	case *storage.Int8FieldData:
+		v, err := parseJSONInt(value, 8)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, int8(v))
+	case *storage.Int16FieldData:
+		v, err := parseJSONInt(value, 16)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, int16(v))
+	case *storage.Int32FieldData:
+		v, err := parseJSONInt(value, 32)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, int32(v))
+	case *storage.Int64FieldData:
+		v, err := parseJSONInt(value, 64)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, v)
+	case *storage.FloatFieldData:
+		v, err := parseJSONFloat(value, 32)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, float32(v))
+	case *storage.DoubleFieldData:
+		v, err := parseJSONFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		data.Data = append(data.Data, v)
+	case *storage.StringFieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string value", value)
+		}
+		data.Data = append(data.Data, v)
+	case *storage.FloatVectorFieldData:
+		elements, ok := value.([]interface{})
+		if !ok || len(elements) != data.Dim {
+			return fmt.Errorf("%v is not a float vector of dim %d", value, data.Dim)
+		}
+		for _, element := range elements {
+			v, err := parseJSONFloat(element, 32)
+			if err != nil {
+				return err
+			}
+			data.Data = append(data.Data, float32(v))
+		}
+	case *storage.BinaryVectorFieldData:
+		elements, ok := value.([]interface{})
+		if !ok || len(elements) != data.Dim/8 {
+			return fmt.Errorf("%v is not a binary vector of dim %d, which consists of %d uint8 values", value, data.Dim, data.Dim/8)
+		}
+		for _, element := range elements {
+			number, ok := element.(json.Number)
+			if !ok {
+				return fmt.Errorf("%v is not a uint8 value", element)
+			}
+			v, err := strconv.ParseUint(number.String(), 10, 8)
+			if err != nil {
+				return fmt.Errorf("%v is not a uint8 value", element)
+			}
+			data.Data = append(data.Data, byte(v))
+		}
+	default:
+		return fmt.Errorf("unsupported field data type %T", column)
+	}
+	return nil
+}
+
+func parseJSONInt(value interface{}, bitSize int) (int64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%v is not an integer value", value)
+	}
+	v, err := strconv.ParseInt(number.String(), 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not an int%d value", value, bitSize)
+	}
+	return v, nil
+}
+
+func parseJSONFloat(value interface{}, bitSize int) (float64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%v is not a float value", value)
+	}
+	v, err := strconv.ParseFloat(number.String(), bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%v is not a float%d value", value, bitSize)
+	}
+	return v, nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func sampleSchema(autoID bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "import_test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: autoID, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 104, Name: "name", DataType: schemapb.DataType_String},
+			{FieldID: 105, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 106, Name: "bin", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+}
+
+func TestParseJSONRows(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		content := []byte(`{"rows": [
+			{"pk": 9007199254740993, "flag": true, "age": 18, "score": 1.5, "name": "a", "vec": [0.1, 0.2], "bin": [1, 255]},
+			{"pk": 2, "flag": false, "age": -3, "score": 2, "name": "b", "vec": [0.3, 0.4], "bin": [0, 7]}
+		]}`)
+		columns, err := ParseJSONRows(content, sampleSchema(false))
+		assert.Nil(t, err)
+		assert.Equal(t, 7, len(columns))
+		assert.Equal(t, []int64{9007199254740993, 2}, columns[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []bool{true, false}, columns[101].(*storage.BoolFieldData).Data)
+		assert.Equal(t, []int8{18, -3}, columns[102].(*storage.Int8FieldData).Data)
+		assert.Equal(t, []float64{1.5, 2}, columns[103].(*storage.DoubleFieldData).Data)
+		assert.Equal(t, []string{"a", "b"}, columns[104].(*storage.StringFieldData).Data)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, columns[105].(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, []byte{1, 255, 0, 7}, columns[106].(*storage.BinaryVectorFieldData).Data)
+	})
+
+	t.Run("auto id", func(t *testing.T) {
+		content := []byte(`{"rows": [{"flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]}]}`)
+		columns, err := ParseJSONRows(content, sampleSchema(true))
+		assert.Nil(t, err)
+		_, ok := columns[100]
+		assert.False(t, ok)
+
+		content = []byte(`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]}]}`)
+		_, err = ParseJSONRows(content, sampleSchema(true))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		cases := []string{
+			`not a json`,
+			`{"data": []}`,
+			// missing field
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2]}]}`,
+			// unknown field
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2], "x": 1}]}`,
+			// system field
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2], "RowID": 1}]}`,
+			// int8 overflow
+			`{"rows": [{"pk": 1, "flag": true, "age": 128, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]}]}`,
+			// wrong dim
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1], "bin": [1, 2]}]}`,
+			// wrong types
+			`{"rows": [{"pk": "1", "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]}]}`,
+			`{"rows": [{"pk": 1, "flag": 1, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 2]}]}`,
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": 1, "vec": [0.1, 0.2], "bin": [1, 2]}]}`,
+			`{"rows": [{"pk": 1, "flag": true, "age": 1, "score": 1, "name": "a", "vec": [0.1, 0.2], "bin": [1, 256]}]}`,
+		}
+		for _, c := range cases {
+			_, err := ParseJSONRows([]byte(c), sampleSchema(false))
+			assert.Error(t, err, c)
+		}
+	})
+}
@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// numpyMagic is the magic string at the beginning of a .npy file,
+// see https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+var numpyMagic = []byte("\x93NUMPY")
+
+var (
+	numpyDescrRegexp   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	numpyFortranRegexp = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	numpyShapeRegexp   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+// numpyHeader is the header of a .npy file
+type numpyHeader struct {
+	descr        string
+	fortranOrder bool
+	shape        []int
+}
+
+// parseNumpyHeader parses the header of a .npy file and returns the header and the offset of the array data
+func parseNumpyHeader(content []byte) (*numpyHeader, int, error) {
+	if !bytes.HasPrefix(content, numpyMagic) || len(content) < 10 {
+		return nil, 0, errors.New("not a numpy file")
+	}
+
+	var headerLen, offset int
+	switch major := content[6]; major {
+	case 1:
+		headerLen = int(common.Endian.Uint16(content[8:10]))
+		offset = 10
+	case 2, 3:
+		if len(content) < 12 {
+			return nil, 0, errors.New("numpy header is truncated")
+		}
+		headerLen = int(common.Endian.Uint32(content[8:12]))
+		offset = 12
+	default:
+		return nil, 0, fmt.Errorf("unsupported numpy format version %d", major)
+	}
+	if len(content) < offset+headerLen {
+		return nil, 0, errors.New("numpy header is truncated")
+	}
+	dict := string(content[offset : offset+headerLen])
+
+	header := &numpyHeader{}
+	match := numpyDescrRegexp.FindStringSubmatch(dict)
+	if match == nil {
+		return nil, 0, fmt.Errorf("descr is not found in numpy header %s", dict)
+	}
+	header.descr = match[1]
+
+	match = numpyFortranRegexp.FindStringSubmatch(dict)
+	if match == nil {
+		return nil, 0, fmt.Errorf("fortran_order is not found in numpy header %s", dict)
+	}
+	header.fortranOrder = match[1] == "True"
+
+	match = numpyShapeRegexp.FindStringSubmatch(dict)
+	if match == nil {
+		return nil, 0, fmt.Errorf("shape is not found in numpy header %s", dict)
+	}
+	for _, s := range strings.Split(match[1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, 0, fmt.Errorf("invalid shape in numpy header %s", dict)
+		}
+		header.shape = append(header.shape, n)
+	}
+	return header, offset + headerLen, nil
+}
+
+// expectedNumpyDescr returns the numpy dtype which is accepted for the data type of a field,
+// multi-byte types must be little endian.
+func expectedNumpyDescr(dataType schemapb.DataType) (string, bool) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return "|b1", true
+	case schemapb.DataType_Int8:
+		return "|i1", true
+	case schemapb.DataType_Int16:
+		return "<i2", true
+	case schemapb.DataType_Int32:
+		return "<i4", true
+	case schemapb.DataType_Int64:
+		return "<i8", true
+	case schemapb.DataType_Float, schemapb.DataType_FloatVector:
+		return "<f4", true
+	case schemapb.DataType_Double:
+		return "<f8", true
+	case schemapb.DataType_BinaryVector:
+		return "|u1", true
+	default:
+		return "", false
+	}
+}
+
+// ParseNumpyColumn parses the content of a .npy file into the column of the field. Scalar fields require a 1-D array,
+// float vectors require a 2-D float32 array with the dim of the field as the second dimension, and binary vectors
+// require a 2-D uint8 array with dim/8 as the second dimension. String fields require a 1-D unicode array.
+func ParseNumpyColumn(content []byte, field *schemapb.FieldSchema) (storage.FieldData, error) {
+	header, offset, err := parseNumpyHeader(content)
+	if err != nil {
+		return nil, err
+	}
+	column, err := newFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	body := content[offset:]
+
+	// validate the shape
+	rows := 0
+	if len(header.shape) > 0 {
+		rows = header.shape[0]
+	}
+	switch data := column.(type) {
+	case *storage.FloatVectorFieldData:
+		if len(header.shape) != 2 || header.shape[1] != data.Dim {
+			return nil, fmt.Errorf("shape %v mismatches the dim %d of field %s", header.shape, data.Dim, field.GetName())
+		}
+	case *storage.BinaryVectorFieldData:
+		if len(header.shape) != 2 || header.shape[1] != data.Dim/8 {
+			return nil, fmt.Errorf("shape %v mismatches the dim %d of field %s", header.shape, data.Dim, field.GetName())
+		}
+	default:
+		if len(header.shape) != 1 {
+			return nil, fmt.Errorf("shape %v of field %s is not a 1-D array", header.shape, field.GetName())
+		}
+	}
+	if header.fortranOrder && len(header.shape) > 1 {
+		return nil, errors.New("fortran order array is not supported")
+	}
+
+	// string values are fixed width UTF-32 code points
+	if data, ok := column.(*storage.StringFieldData); ok {
+		if !strings.HasPrefix(header.descr, "<U") {
+			return nil, fmt.Errorf("dtype %s mismatches the data type %s of field %s", header.descr, field.GetDataType().String(), field.GetName())
+		}
+		width, err := strconv.Atoi(strings.TrimPrefix(header.descr, "<U"))
+		if err != nil || width <= 0 {
+			return nil, fmt.Errorf("invalid dtype %s", header.descr)
+		}
+		if len(body) < rows*width*4 {
+			return nil, fmt.Errorf("data size %d mismatches shape %v", len(body), header.shape)
+		}
+		data.Data = make([]string, 0, rows)
+		for i := 0; i < rows; i++ {
+			var sb strings.Builder
+			for j := 0; j < width; j++ {
+				r := rune(common.Endian.Uint32(body[(i*width+j)*4:]))
+				if r == 0 {
+					break
+				}
+				sb.WriteRune(r)
+			}
+			data.Data = append(data.Data, sb.String())
+		}
+		return column, nil
+	}
+
+	descr, ok := expectedNumpyDescr(field.GetDataType())
+	if !ok {
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.GetDataType().String(), field.GetName())
+	}
+	// numpy writes '|' for single byte types and '<' for little endian ones, '=' is the native order
+	if header.descr != descr && strings.Replace(header.descr, "=", "<", 1) != descr {
+		return nil, fmt.Errorf("dtype %s mismatches the data type %s of field %s", header.descr, field.GetDataType().String(), field.GetName())
+	}
+
+	count := 1
+	for _, n := range header.shape {
+		count *= n
+	}
+	reader := bytes.NewReader(body)
+	switch data := column.(type) {
+	case *storage.BoolFieldData:
+		data.Data = make([]bool, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.Int8FieldData:
+		data.Data = make([]int8, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.Int16FieldData:
+		data.Data = make([]int16, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.Int32FieldData:
+		data.Data = make([]int32, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.Int64FieldData:
+		data.Data = make([]int64, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.FloatFieldData:
+		data.Data = make([]float32, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.DoubleFieldData:
+		data.Data = make([]float64, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.FloatVectorFieldData:
+		data.Data = make([]float32, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	case *storage.BinaryVectorFieldData:
+		data.Data = make([]byte, count)
+		err = binary.Read(reader, common.Endian, data.Data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("data size %d mismatches shape %v: %w", len(body), header.shape, err)
+	}
+	return column, nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// genNumpyContent generates the content of a version 1.0 .npy file
+func genNumpyContent(t *testing.T, descr string, shape string, data interface{}) []byte {
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shape)
+	// the header is padded with spaces and terminated by a newline, aligned to 64 bytes
+	padding := 64 - (10+len(header)+1)%64
+	header += strings.Repeat(" ", padding) + "\n"
+
+	buf := &bytes.Buffer{}
+	buf.Write(numpyMagic)
+	buf.Write([]byte{1, 0})
+	assert.Nil(t, binary.Write(buf, common.Endian, uint16(len(header))))
+	buf.WriteString(header)
+	assert.Nil(t, binary.Write(buf, common.Endian, data))
+	return buf.Bytes()
+}
+
+func TestParseNumpyColumn(t *testing.T) {
+	schema := sampleSchema(false)
+
+	t.Run("scalar", func(t *testing.T) {
+		column, err := ParseNumpyColumn(genNumpyContent(t, "<i8", "3,", []int64{1, 2, 3}), schema.Fields[2])
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, column.(*storage.Int64FieldData).Data)
+
+		column, err = ParseNumpyColumn(genNumpyContent(t, "|b1", "2,", []bool{true, false}), schema.Fields[3])
+		assert.Nil(t, err)
+		assert.Equal(t, []bool{true, false}, column.(*storage.BoolFieldData).Data)
+
+		column, err = ParseNumpyColumn(genNumpyContent(t, "<f8", "2,", []float64{0.5, 1.5}), schema.Fields[5])
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{0.5, 1.5}, column.(*storage.DoubleFieldData).Data)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// "ab" and "中" in a <U2 array
+		column, err := ParseNumpyColumn(genNumpyContent(t, "<U2", "2,", []uint32{'a', 'b', '中', 0}), schema.Fields[6])
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ab", "中"}, column.(*storage.StringFieldData).Data)
+	})
+
+	t.Run("vector", func(t *testing.T) {
+		column, err := ParseNumpyColumn(genNumpyContent(t, "<f4", "2, 2", []float32{0.1, 0.2, 0.3, 0.4}), schema.Fields[7])
+		assert.Nil(t, err)
+		assert.Equal(t, 2, column.RowNum())
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, column.(*storage.FloatVectorFieldData).Data)
+
+		column, err = ParseNumpyColumn(genNumpyContent(t, "|u1", "1, 2", []uint8{1, 2}), schema.Fields[8])
+		assert.Nil(t, err)
+		assert.Equal(t, 1, column.RowNum())
+		assert.Equal(t, []byte{1, 2}, column.(*storage.BinaryVectorFieldData).Data)
+	})
+
+	t.Run("mismatched", func(t *testing.T) {
+		// dtype
+		_, err := ParseNumpyColumn(genNumpyContent(t, "<i4", "3,", []int32{1, 2, 3}), schema.Fields[2])
+		assert.Error(t, err)
+		// big endian
+		_, err = ParseNumpyColumn(genNumpyContent(t, ">i8", "3,", []int64{1, 2, 3}), schema.Fields[2])
+		assert.Error(t, err)
+		// dim
+		_, err = ParseNumpyColumn(genNumpyContent(t, "<f4", "1, 4", []float32{0.1, 0.2, 0.3, 0.4}), schema.Fields[7])
+		assert.Error(t, err)
+		// shape of scalar
+		_, err = ParseNumpyColumn(genNumpyContent(t, "<i8", "1, 2", []int64{1, 2}), schema.Fields[2])
+		assert.Error(t, err)
+		// truncated data
+		_, err = ParseNumpyColumn(genNumpyContent(t, "<i8", "3,", []int64{1, 2}), schema.Fields[2])
+		assert.Error(t, err)
+		// not a numpy file
+		_, err = ParseNumpyColumn([]byte("{}"), schema.Fields[2])
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparamcheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RadiusKey is the key of the radius param of range search
+	RadiusKey = "radius"
+
+	// RangeFilterKey is the key of the range_filter param of range search
+	RangeFilterKey = "range_filter"
+)
+
+// CheckRangeSearchParams checks the radius and range_filter of a range search against the metric type.
+// For IP, hits satisfy radius < distance <= range_filter, so range_filter must be larger than radius.
+// For the other metrics, hits satisfy range_filter <= distance < radius, so range_filter must be smaller than radius.
+// rangeFilter is ignored if hasRangeFilter is false.
+func CheckRangeSearchParams(metricType string, radius, rangeFilter float64, hasRangeFilter bool) error {
+	switch strings.ToUpper(metricType) {
+	case IP:
+		if hasRangeFilter && rangeFilter <= radius {
+			return fmt.Errorf("%s(%v) must be greater than %s(%v) for metric type %s",
+				RangeFilterKey, rangeFilter, RadiusKey, radius, metricType)
+		}
+	case L2, HAMMING, JACCARD, TANIMOTO:
+		if radius <= 0 {
+			return fmt.Errorf("%s(%v) must be greater than 0 for metric type %s", RadiusKey, radius, metricType)
+		}
+		if hasRangeFilter && (rangeFilter < 0 || rangeFilter >= radius) {
+			return fmt.Errorf("%s(%v) must be in range [0, %s(%v)) for metric type %s",
+				RangeFilterKey, rangeFilter, RadiusKey, radius, metricType)
+		}
+	default:
+		return fmt.Errorf("range search is not supported for metric type %s", metricType)
+	}
+	return nil
+}
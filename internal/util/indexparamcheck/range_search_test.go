@@ -0,0 +1,42 @@
+package indexparamcheck
+
+import (
+	"testing"
+)
+
+func Test_CheckRangeSearchParams(t *testing.T) {
+	cases := []struct {
+		metricType     string
+		radius         float64
+		rangeFilter    float64
+		hasRangeFilter bool
+		wantErr        bool
+	}{
+		{L2, 10, 0, false, false},
+		{L2, 10, 1, true, false},
+		{L2, 10, 0, true, false},
+		{L2, 10, 10, true, true},
+		{L2, 10, 20, true, true},
+		{L2, 10, -1, true, true},
+		{L2, 0, 0, false, true},
+		{"l2", 10, 1, true, false},
+		{IP, 0.5, 0, false, false},
+		{IP, -0.5, 0.9, true, false},
+		{IP, 0.5, 0.5, true, true},
+		{IP, 0.5, 0.1, true, true},
+		{HAMMING, 10, 2, true, false},
+		{JACCARD, 0.8, 0.2, true, false},
+		{TANIMOTO, 0.8, 0.9, true, true},
+		{SUBSTRUCTURE, 10, 0, false, true},
+		{SUPERSTRUCTURE, 10, 0, false, true},
+		{"unknown", 10, 0, false, true},
+	}
+
+	for _, test := range cases {
+		err := CheckRangeSearchParams(test.metricType, test.radius, test.rangeFilter, test.hasRangeFilter)
+		if (err != nil) != test.wantErr {
+			t.Errorf("CheckRangeSearchParams(%v, %v, %v, %v) = %v, want error: %v",
+				test.metricType, test.radius, test.rangeFilter, test.hasRangeFilter, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	embeddedkv "github.com/milvus-io/milvus/internal/kv/embedded"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	rocksdbkv "github.com/milvus-io/milvus/internal/kv/rocksdb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+const (
+	// EtcdType keeps the meta in etcd
+	EtcdType = "etcd"
+	// RocksdbType keeps the meta in an embedded store on rocksdb, which is shared by the components in the process
+	RocksdbType = "rocksdb"
+)
+
+var (
+	storeMu sync.Mutex
+	stores  = make(map[string]*embeddedkv.Store)
+)
+
+// NewMetaKV returns the kv.MetaKv of the meta under rootPath in the meta store configured by param, etcdCli is
+// used if the meta is kept in etcd.
+func NewMetaKV(etcdCli *clientv3.Client, rootPath string, param *paramtable.BaseParamTable) (kv.MetaKv, error) {
+	switch param.MetaStoreType {
+	case EtcdType:
+		return etcdkv.NewEtcdKV(etcdCli, rootPath), nil
+	case RocksdbType:
+		store, err := openRocksdbStore(param.MetaStorePath)
+		if err != nil {
+			return nil, err
+		}
+		return embeddedkv.NewMetaKV(store, rootPath), nil
+	default:
+		return nil, fmt.Errorf("unknown meta store type %s", param.MetaStoreType)
+	}
+}
+
+// NewSession returns the session under metaRoot in the meta store configured by param, so that the components
+// discover each other in the store keeping their meta. It returns nil if the meta store can't be reached.
+func NewSession(ctx context.Context, etcdCli *clientv3.Client, metaRoot string, param *paramtable.BaseParamTable) *sessionutil.Session {
+	if param.MetaStoreType == EtcdType {
+		return sessionutil.NewSession(ctx, metaRoot, etcdCli)
+	}
+	metaKV, err := NewMetaKV(etcdCli, metaRoot, param)
+	if err != nil {
+		log.Warn("failed to open the meta store of session", zap.String("type", param.MetaStoreType), zap.Error(err))
+		return nil
+	}
+	return sessionutil.NewSessionWithMetaKV(ctx, metaKV)
+}
+
+// openRocksdbStore opens the embedded store at path once, all the components in the process share it.
+func openRocksdbStore(path string) (*embeddedkv.Store, error) {
+	storeMu.Lock()
+	defer storeMu.Unlock()
+	if store, ok := stores[path]; ok {
+		return store, nil
+	}
+	backend, err := rocksdbkv.NewRocksdbKV(path)
+	if err != nil {
+		return nil, err
+	}
+	store, err := embeddedkv.NewStore(backend)
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	log.Info("meta store opened", zap.String("type", RocksdbType), zap.String("path", path))
+	stores[path] = store
+	return store, nil
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	embeddedkv "github.com/milvus-io/milvus/internal/kv/embedded"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+func TestNewMetaKV(t *testing.T) {
+	param := &paramtable.BaseParamTable{
+		MetaStoreType: EtcdType,
+	}
+	metaKV, err := NewMetaKV(nil, "by-dev/meta", param)
+	assert.NoError(t, err)
+	assert.IsType(t, &etcdkv.EtcdKV{}, metaKV)
+
+	dir, err := ioutil.TempDir("", "meta_store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	param.MetaStoreType = RocksdbType
+	param.MetaStorePath = dir
+	metaKV, err = NewMetaKV(nil, "by-dev/meta", param)
+	assert.NoError(t, err)
+	assert.IsType(t, &embeddedkv.MetaKV{}, metaKV)
+	err = metaKV.Save("a", "1")
+	assert.NoError(t, err)
+
+	// the store is shared by the meta kvs in the process
+	kvRoot, err := NewMetaKV(nil, "by-dev", param)
+	assert.NoError(t, err)
+	value, err := kvRoot.Load("meta/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", value)
+	stores[dir].Close()
+	delete(stores, dir)
+
+	param.MetaStoreType = "unknown"
+	_, err = NewMetaKV(nil, "by-dev/meta", param)
+	assert.Error(t, err)
+}
+
+func TestNewSession(t *testing.T) {
+	dir, err := ioutil.TempDir("", "meta_store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	param := &paramtable.BaseParamTable{
+		MetaStoreType: RocksdbType,
+		MetaStorePath: dir,
+	}
+	defer func() {
+		stores[dir].Close()
+		delete(stores, dir)
+	}()
+
+	ctx := context.Background()
+	s := NewSession(ctx, nil, "by-dev/meta", param)
+	require.NotNil(t, s)
+	s.Init("test", "testAddr", false, false)
+	s.Register()
+	defer s.Revoke(time.Second)
+
+	// the sessions of the components in the process are kept in the same store
+	sessions, _, err := NewSession(ctx, nil, "by-dev/meta", param).GetSessions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(sessions))
+
+	param.MetaStoreType = "unknown"
+	assert.Nil(t, NewSession(ctx, nil, "by-dev/meta", param))
+}
@@ -13,6 +13,7 @@ package metricsinfo
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // ComponentInfos defines the interface of all component infos
@@ -103,10 +104,17 @@ type QueryNodeConfiguration struct {
 	SimdType string `json:"simd_type"`
 }
 
+// QueryNodeQuotaMetrics records the metrics of QueryNode used by the quota center of Proxy.
+type QueryNodeQuotaMetrics struct {
+	// MaxTSafeDelay is how far the most lagging tsafe of the DML channels is behind the current time
+	MaxTSafeDelay time.Duration `json:"max_tsafe_delay"`
+}
+
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
+	QuotaMetrics         QueryNodeQuotaMetrics  `json:"quota_metrics"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -173,10 +181,17 @@ type DataCoordConfiguration struct {
 	SegmentMaxSize float64 `json:"segment_max_size"`
 }
 
+// DataCoordQuotaMetrics records the metrics of DataCoord used by the quota center of Proxy.
+type DataCoordQuotaMetrics struct {
+	// FlushLag is the age of the oldest data not flushed yet
+	FlushLag time.Duration `json:"flush_lag"`
+}
+
 // DataCoordInfos implements ComponentInfos
 type DataCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations DataCoordConfiguration `json:"system_configurations"`
+	QuotaMetrics         DataCoordQuotaMetrics  `json:"quota_metrics"`
 }
 
 // RootCoordConfiguration records the configuration of RootCoord.
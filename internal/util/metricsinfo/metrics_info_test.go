@@ -96,6 +96,9 @@ func TestQueryNodeInfos_Codec(t *testing.T) {
 
 			SimdType: "avx2",
 		},
+		QuotaMetrics: QueryNodeQuotaMetrics{
+			MaxTSafeDelay: time.Second,
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
@@ -304,6 +307,9 @@ func TestDataCoordInfos_Codec(t *testing.T) {
 		SystemConfigurations: DataCoordConfiguration{
 			SegmentMaxSize: 1024 * 1024,
 		},
+		QuotaMetrics: DataCoordQuotaMetrics{
+			FlushLag: time.Minute,
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
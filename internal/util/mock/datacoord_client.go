@@ -118,3 +118,23 @@ func (m *DataCoordClient) GetFlushState(ctx context.Context, req *milvuspb.GetFl
 func (m *DataCoordClient) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest, opts ...grpc.CallOption) (*datapb.DropVirtualChannelResponse, error) {
 	return &datapb.DropVirtualChannelResponse{}, m.Err
 }
+
+func (m *DataCoordClient) Import(ctx context.Context, req *datapb.ImportTaskRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	return &milvuspb.ImportResponse{}, m.Err
+}
+
+func (m *DataCoordClient) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{}, m.Err
+}
+
+func (m *DataCoordClient) ReportImport(ctx context.Context, req *datapb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) PinCollection(ctx context.Context, req *datapb.PinCollectionRequest, opts ...grpc.CallOption) (*datapb.PinCollectionResponse, error) {
+	return &datapb.PinCollectionResponse{}, m.Err
+}
+
+func (m *DataCoordClient) UnpinCollection(ctx context.Context, req *datapb.UnpinCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
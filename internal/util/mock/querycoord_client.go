@@ -83,6 +83,14 @@ func (m *QueryCoordClient) LoadBalance(ctx context.Context, in *querypb.LoadBala
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *QueryCoordClient) GetReplicas(ctx context.Context, in *querypb.GetReplicasRequest, opts ...grpc.CallOption) (*querypb.GetReplicasResponse, error) {
+	return &querypb.GetReplicasResponse{}, m.Err
+}
+
+func (m *QueryCoordClient) GetShardLeaders(ctx context.Context, in *querypb.GetShardLeadersRequest, opts ...grpc.CallOption) (*querypb.GetShardLeadersResponse, error) {
+	return &querypb.GetShardLeadersResponse{}, m.Err
+}
+
 func (m *QueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// kafkaTimeoutMs is the timeout of requests waiting for the kafka broker, in milliseconds
+const kafkaTimeoutMs = 3000
+
+// readerSeq makes the consumer group of every reader unique
+var readerSeq int64
+
+// kafkaClient creates producers, consumers and readers connected to a kafka cluster
+type kafkaClient struct {
+	broker string
+}
+
+// Check if kafkaClient implements Client interface
+var _ Client = &kafkaClient{}
+
+// NewKafkaClient returns a new kafkaClient object connected to the comma separated broker list
+func NewKafkaClient(broker string) (*kafkaClient, error) {
+	if broker == "" {
+		return nil, errors.New("kafka broker list is empty")
+	}
+	return &kafkaClient{broker: broker}, nil
+}
+
+// CreateProducer creates a producer for kafka client
+func (kc *kafkaClient) CreateProducer(options ProducerOptions) (Producer, error) {
+	if options.Topic == "" {
+		return nil, errors.New("topic is empty")
+	}
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": kc.broker,
+		"acks":              "all",
+	})
+	if err != nil {
+		return nil, err
+	}
+	// drain the events which are not delivered to the delivery channel of a message
+	go func() {
+		for range p.Events() {
+		}
+	}()
+	return &kafkaProducer{p: p, topic: options.Topic}, nil
+}
+
+// CreateReader creates a kafka reader from reader options
+func (kc *kafkaClient) CreateReader(options ReaderOptions) (Reader, error) {
+	if options.Topic == "" {
+		return nil, errors.New("topic is empty")
+	}
+	groupID := fmt.Sprintf("%s-%d-%d", options.SubscriptionRolePrefix, time.Now().UnixNano(), atomic.AddInt64(&readerSeq, 1))
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  kc.broker,
+		"group.id":           groupID,
+		"enable.auto.commit": false,
+		"auto.offset.reset":  "earliest",
+	})
+	if err != nil {
+		return nil, err
+	}
+	reader, err := newKafkaReader(c, options)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+// Subscribe subscribes a consumer in kafka client
+func (kc *kafkaClient) Subscribe(options ConsumerOptions) (Consumer, error) {
+	if options.Topic == "" {
+		return nil, errors.New("topic is empty")
+	}
+	offsetReset := "latest"
+	if options.SubscriptionInitialPosition == SubscriptionPositionEarliest {
+		offsetReset = "earliest"
+	}
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  kc.broker,
+		"group.id":           options.SubscriptionName,
+		"enable.auto.commit": false,
+		"auto.offset.reset":  offsetReset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := newKafkaConsumer(c, options)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return consumer, nil
+}
+
+// EarliestMessageID returns the message ID pointing to the beginning of a topic
+func (kc *kafkaClient) EarliestMessageID() MessageID {
+	return &kafkaID{messageID: int64(kafka.OffsetBeginning)}
+}
+
+// StringToMsgID converts string id to MessageID
+func (kc *kafkaClient) StringToMsgID(id string) (MessageID, error) {
+	offset, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+// BytesToMsgID converts a byte array to messageID
+func (kc *kafkaClient) BytesToMsgID(id []byte) (MessageID, error) {
+	offset, err := DeserializeKafkaID(id)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: offset}, nil
+}
+
+// Close does nothing since the producers and consumers own their connections
+func (kc *kafkaClient) Close() {
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockKafkaClient returns a client connected to an in-process mock kafka cluster
+func newMockKafkaClient(t *testing.T) (*kafkaClient, func()) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.Nil(t, err)
+	client, err := NewKafkaClient(cluster.BootstrapServers())
+	require.Nil(t, err)
+	return client, func() {
+		client.Close()
+		cluster.Close()
+	}
+}
+
+// produceKafkaMessages sends n messages to the topic and returns their ids
+func produceKafkaMessages(t *testing.T, client *kafkaClient, topic string, n int) []MessageID {
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	require.Nil(t, err)
+	defer producer.Close()
+
+	ids := make([]MessageID, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := producer.Send(context.Background(), &ProducerMessage{
+			Payload:    []byte(fmt.Sprintf("msg-%d", i)),
+			Properties: map[string]string{"index": fmt.Sprint(i)},
+		})
+		require.Nil(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestNewKafkaClient(t *testing.T) {
+	client, err := NewKafkaClient("")
+	assert.Error(t, err)
+	assert.Nil(t, client)
+
+	client, err = NewKafkaClient("localhost:9092")
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestKafkaClient_CreateProducer(t *testing.T) {
+	client, closeFn := newMockKafkaClient(t)
+	defer closeFn()
+
+	_, err := client.CreateProducer(ProducerOptions{Topic: ""})
+	assert.Error(t, err)
+
+	topic := "TestKafkaClient_CreateProducer"
+	producer, err := client.CreateProducer(ProducerOptions{Topic: topic})
+	assert.Nil(t, err)
+	defer producer.Close()
+	assert.Equal(t, topic, producer.(*kafkaProducer).Topic())
+
+	for i := 0; i < 3; i++ {
+		id, err := producer.Send(context.Background(), &ProducerMessage{Payload: []byte{byte(i)}})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(i), id.(*kafkaID).messageID)
+	}
+}
+
+func TestKafkaClient_MsgID(t *testing.T) {
+	client, err := NewKafkaClient("localhost:9092")
+	assert.Nil(t, err)
+
+	id, err := client.StringToMsgID("10")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), id.(*kafkaID).messageID)
+	_, err = client.StringToMsgID("x")
+	assert.Error(t, err)
+
+	id, err = client.BytesToMsgID(id.Serialize())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), id.(*kafkaID).messageID)
+	_, err = client.BytesToMsgID([]byte{1})
+	assert.Error(t, err)
+
+	assert.Equal(t, int64(kafka.OffsetBeginning), client.EarliestMessageID().(*kafkaID).messageID)
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// kafkaPollInterval is how long a poll waits for a message before checking whether the consumer is closed
+const kafkaPollInterval = 100 * time.Millisecond
+
+// kafkaConsumer consumes partition 0 of a kafka topic, the subscription name is used as the consumer group,
+// the offset of a message is committed when it is acknowledged
+type kafkaConsumer struct {
+	c                *kafka.Consumer
+	topic            string
+	subscriptionName string
+	msgChannel       chan Message
+	closeCh          chan struct{}
+	once             sync.Once
+	closeOnce        sync.Once
+	wg               sync.WaitGroup
+}
+
+// Check if kafkaConsumer implements Consumer interface
+var _ Consumer = &kafkaConsumer{}
+
+func newKafkaConsumer(c *kafka.Consumer, options ConsumerOptions) (*kafkaConsumer, error) {
+	// the committed offset of the group is used if there is one, otherwise auto.offset.reset decides
+	err := c.Assign([]kafka.TopicPartition{{Topic: &options.Topic, Partition: 0, Offset: kafka.OffsetStored}})
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumer{
+		c:                c,
+		topic:            options.Topic,
+		subscriptionName: options.SubscriptionName,
+		msgChannel:       make(chan Message, options.BufSize),
+		closeCh:          make(chan struct{}),
+	}, nil
+}
+
+// Subscription returns the subscription name of this consumer
+func (kc *kafkaConsumer) Subscription() string {
+	return kc.subscriptionName
+}
+
+// Chan returns a channel to read messages from kafka
+func (kc *kafkaConsumer) Chan() <-chan Message {
+	kc.once.Do(func() {
+		kc.wg.Add(1)
+		go func() {
+			defer kc.wg.Done()
+			defer close(kc.msgChannel)
+			for {
+				select {
+				case <-kc.closeCh:
+					return
+				default:
+				}
+				msg, err := kc.c.ReadMessage(kafkaPollInterval)
+				if err != nil {
+					if kafkaErr, ok := err.(kafka.Error); !ok || kafkaErr.Code() != kafka.ErrTimedOut {
+						log.Warn("kafka consumer failed to read message", zap.String("topic", kc.topic), zap.Error(err))
+					}
+					continue
+				}
+				select {
+				case kc.msgChannel <- &kafkaMessage{msg: msg}:
+				case <-kc.closeCh:
+					return
+				}
+			}
+		}()
+	})
+	return kc.msgChannel
+}
+
+// Seek moves the consumer to the position of the message id, the message itself is consumed again if inclusive
+func (kc *kafkaConsumer) Seek(id MessageID, inclusive bool) error {
+	offset, err := kc.seekOffset(id.(*kafkaID).offset(), inclusive)
+	if err != nil {
+		return err
+	}
+	return kc.c.Assign([]kafka.TopicPartition{{Topic: &kc.topic, Partition: 0, Offset: offset}})
+}
+
+// seekOffset returns the offset to consume from when seeking to offset. A logical offset is a position
+// rather than a message, adding one to it makes another logical offset, e.g. OffsetBeginning+1 is OffsetEnd
+func (kc *kafkaConsumer) seekOffset(offset kafka.Offset, inclusive bool) (kafka.Offset, error) {
+	if inclusive {
+		return offset, nil
+	}
+	if offset >= 0 {
+		return offset + 1, nil
+	}
+	if offset != kafka.OffsetBeginning {
+		return offset, nil
+	}
+	// skip the earliest message, there is nothing to skip in an empty topic
+	low, high, err := kc.c.QueryWatermarkOffsets(kc.topic, 0, kafkaTimeoutMs)
+	if err != nil {
+		return 0, err
+	}
+	if low == high {
+		return kafka.OffsetBeginning, nil
+	}
+	return kafka.Offset(low + 1), nil
+}
+
+// GetLatestMsgID returns the id of the last message in the topic,
+// the offset is -1 if there is no message
+func (kc *kafkaConsumer) GetLatestMsgID() (MessageID, error) {
+	_, high, err := kc.c.QueryWatermarkOffsets(kc.topic, 0, kafkaTimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaID{messageID: high - 1}, nil
+}
+
+// Ack commits the offset after the message, so that the group consumes from the next message once it resubscribes
+func (kc *kafkaConsumer) Ack(message Message) {
+	offset := message.ID().(*kafkaID).offset() + 1
+	_, err := kc.c.CommitOffsets([]kafka.TopicPartition{{Topic: &kc.topic, Partition: 0, Offset: offset}})
+	if err != nil {
+		log.Warn("failed to commit kafka offset", zap.String("topic", kc.topic),
+			zap.String("subscription", kc.subscriptionName), zap.Int64("offset", int64(offset)), zap.Error(err))
+	}
+}
+
+// Close is used to free the resources of this consumer, it can be called more than once
+func (kc *kafkaConsumer) Close() {
+	kc.closeOnce.Do(func() {
+		close(kc.closeCh)
+		kc.wg.Wait()
+		if err := kc.c.Close(); err != nil {
+			log.Warn("failed to close kafka consumer", zap.String("topic", kc.topic), zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveKafkaMessage(t *testing.T, consumer Consumer) Message {
+	select {
+	case msg := <-consumer.Chan():
+		return msg
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for kafka message")
+		return nil
+	}
+}
+
+func TestKafkaConsumer(t *testing.T) {
+	client, closeFn := newMockKafkaClient(t)
+	defer closeFn()
+
+	topic := "TestKafkaConsumer"
+	ids := produceKafkaMessages(t, client, topic, 10)
+
+	_, err := client.Subscribe(ConsumerOptions{SubscriptionName: "sub"})
+	assert.Error(t, err)
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	require.Nil(t, err)
+	defer consumer.Close()
+	assert.Equal(t, "sub", consumer.Subscription())
+
+	for i := 0; i < 3; i++ {
+		msg := receiveKafkaMessage(t, consumer)
+		consumer.Ack(msg)
+		assert.Equal(t, topic, msg.Topic())
+		assert.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Payload())
+		assert.Equal(t, fmt.Sprint(i), msg.Properties()["index"])
+		assert.Equal(t, ids[i].Serialize(), msg.ID().Serialize())
+	}
+
+	latest, err := consumer.(*kafkaConsumer).GetLatestMsgID()
+	assert.Nil(t, err)
+	assert.Equal(t, ids[9].Serialize(), latest.Serialize())
+
+	// the group resumes after the acknowledged messages, closing twice is fine
+	consumer.Close()
+	consumer, err = client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: SubscriptionPositionEarliest,
+		BufSize:                     1024,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []byte("msg-3"), receiveKafkaMessage(t, consumer).Payload())
+	consumer.Close()
+
+	// consumers seek before they start consuming, as msgstream does
+	t.Run("seek", func(t *testing.T) {
+		for _, inclusive := range []bool{true, false} {
+			consumer, err := client.Subscribe(ConsumerOptions{
+				Topic:                       topic,
+				SubscriptionName:            fmt.Sprintf("seek-%t", inclusive),
+				SubscriptionInitialPosition: SubscriptionPositionEarliest,
+				BufSize:                     1024,
+			})
+			require.Nil(t, err)
+			err = consumer.Seek(ids[5], inclusive)
+			assert.Nil(t, err)
+			expected := "msg-6"
+			if inclusive {
+				expected = "msg-5"
+			}
+			assert.Equal(t, []byte(expected), receiveKafkaMessage(t, consumer).Payload())
+			consumer.Close()
+		}
+	})
+
+	t.Run("seek earliest", func(t *testing.T) {
+		for _, inclusive := range []bool{true, false} {
+			consumer, err := client.Subscribe(ConsumerOptions{
+				Topic:                       topic,
+				SubscriptionName:            fmt.Sprintf("seek-earliest-%t", inclusive),
+				SubscriptionInitialPosition: SubscriptionPositionLatest,
+				BufSize:                     1024,
+			})
+			require.Nil(t, err)
+			err = consumer.Seek(client.EarliestMessageID(), inclusive)
+			assert.Nil(t, err)
+			expected := "msg-1"
+			if inclusive {
+				expected = "msg-0"
+			}
+			assert.Equal(t, []byte(expected), receiveKafkaMessage(t, consumer).Payload())
+			consumer.Close()
+		}
+	})
+}
+
+func TestKafkaConsumer_Latest(t *testing.T) {
+	client, closeFn := newMockKafkaClient(t)
+	defer closeFn()
+
+	topic := "TestKafkaConsumer_Latest"
+	produceKafkaMessages(t, client, topic, 3)
+
+	consumer, err := client.Subscribe(ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            "sub",
+		SubscriptionInitialPosition: SubscriptionPositionLatest,
+		BufSize:                     1024,
+	})
+	require.Nil(t, err)
+	defer consumer.Close()
+
+	latest, err := consumer.(*kafkaConsumer).GetLatestMsgID()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), latest.(*kafkaID).messageID)
+
+	// only the messages produced after the latest one are consumed
+	err = consumer.Seek(latest, false)
+	assert.Nil(t, err)
+	produceKafkaMessages(t, client, topic, 1)
+	msg := receiveKafkaMessage(t, consumer)
+	assert.Equal(t, int64(3), msg.ID().(*kafkaID).messageID)
+}
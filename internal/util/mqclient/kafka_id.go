@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"fmt"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// kafkaID wraps the offset of a kafka message, topics are created with a single partition
+type kafkaID struct {
+	messageID int64
+}
+
+// Check if kafkaID implements MessageID interface
+var _ MessageID = &kafkaID{}
+
+// Serialize convert kafka message id to []byte
+func (kid *kafkaID) Serialize() []byte {
+	return SerializeKafkaID(kid.messageID)
+}
+
+func (kid *kafkaID) LedgerID() int64 {
+	// TODO
+	return 0
+}
+
+func (kid *kafkaID) EntryID() int64 {
+	// TODO
+	return 0
+}
+
+func (kid *kafkaID) BatchIdx() int32 {
+	// TODO
+	return 0
+}
+
+func (kid *kafkaID) PartitionIdx() int32 {
+	return 0
+}
+
+// offset returns the kafka offset the message id points to
+func (kid *kafkaID) offset() kafka.Offset {
+	return kafka.Offset(kid.messageID)
+}
+
+// SerializeKafkaID is used to serialize a message ID to byte array
+func SerializeKafkaID(messageID int64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(messageID))
+	return b
+}
+
+// DeserializeKafkaID is used to deserialize a message ID from byte array
+func DeserializeKafkaID(messageID []byte) (int64, error) {
+	if len(messageID) != 8 {
+		return 0, fmt.Errorf("invalid kafka message id length %d", len(messageID))
+	}
+	return int64(common.Endian.Uint64(messageID)), nil
+}
@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaID_Serialize(t *testing.T) {
+	kid := &kafkaID{messageID: 8}
+
+	bin := kid.Serialize()
+	assert.NotNil(t, bin)
+	assert.NotZero(t, len(bin))
+
+	kid.LedgerID()
+	kid.EntryID()
+	kid.BatchIdx()
+	kid.PartitionIdx()
+}
+
+func Test_DeserializeKafkaID(t *testing.T) {
+	offset, err := DeserializeKafkaID(SerializeKafkaID(5))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), offset)
+
+	_, err = DeserializeKafkaID([]byte{1, 2})
+	assert.Error(t, err)
+}
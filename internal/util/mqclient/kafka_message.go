@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// Check kafkaMessage implements Message
+var _ Message = (*kafkaMessage)(nil)
+
+// kafkaMessage wraps a message consumed from kafka
+type kafkaMessage struct {
+	msg *kafka.Message
+}
+
+// Topic returns the topic name of the message
+func (km *kafkaMessage) Topic() string {
+	return *km.msg.TopicPartition.Topic
+}
+
+// Properties returns the headers of the message
+func (km *kafkaMessage) Properties() map[string]string {
+	properties := make(map[string]string, len(km.msg.Headers))
+	for _, header := range km.msg.Headers {
+		properties[header.Key] = string(header.Value)
+	}
+	return properties
+}
+
+// Payload returns the payload of the message
+func (km *kafkaMessage) Payload() []byte {
+	return km.msg.Value
+}
+
+// ID returns the offset of the message
+func (km *kafkaMessage) ID() MessageID {
+	return &kafkaID{messageID: int64(km.msg.TopicPartition.Offset)}
+}
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+var _ Producer = (*kafkaProducer)(nil)
+
+// kafkaProducer publishes messages to partition 0 of a kafka topic
+type kafkaProducer struct {
+	p     *kafka.Producer
+	topic string
+}
+
+// Topic returns the topic of kafka producer
+func (kp *kafkaProducer) Topic() string {
+	return kp.topic
+}
+
+// Send publishes the message and waits until it is acknowledged by the broker
+func (kp *kafkaProducer) Send(ctx context.Context, message *ProducerMessage) (MessageID, error) {
+	headers := make([]kafka.Header, 0, len(message.Properties))
+	for key, value := range message.Properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+
+	deliveryChan := make(chan kafka.Event, 1)
+	err := kp.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: 0},
+		Value:          message.Payload,
+		Headers:        headers,
+	}, deliveryChan)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case e := <-deliveryChan:
+		m := e.(*kafka.Message)
+		if m.TopicPartition.Error != nil {
+			return nil, m.TopicPartition.Error
+		}
+		return &kafkaID{messageID: int64(m.TopicPartition.Offset)}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close flushes the pending messages and closes the producer
+func (kp *kafkaProducer) Close() {
+	kp.p.Flush(kafkaTimeoutMs)
+	kp.p.Close()
+}
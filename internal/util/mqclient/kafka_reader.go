@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+var _ Reader = (*kafkaReader)(nil)
+
+// kafkaReader reads partition 0 of a kafka topic from a given position, it never commits offsets
+type kafkaReader struct {
+	c     *kafka.Consumer
+	topic string
+
+	mu         sync.Mutex
+	nextOffset kafka.Offset
+}
+
+func newKafkaReader(c *kafka.Consumer, options ReaderOptions) (*kafkaReader, error) {
+	offset := options.StartMessageID.(*kafkaID).offset()
+	if offset >= 0 && !options.StartMessageIDInclusive {
+		offset++
+	}
+	kr := &kafkaReader{c: c, topic: options.Topic}
+	if err := kr.assign(offset); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// assign moves the reader to the offset, the caller must hold the lock unless the reader is being created
+func (kr *kafkaReader) assign(offset kafka.Offset) error {
+	err := kr.c.Assign([]kafka.TopicPartition{{Topic: &kr.topic, Partition: 0, Offset: offset}})
+	if err != nil {
+		return err
+	}
+	kr.nextOffset = offset
+	return nil
+}
+
+// Topic returns the topic name of a reader
+func (kr *kafkaReader) Topic() string {
+	return kr.topic
+}
+
+// Next returns the next message of reader, blocking until a message is available or the context is done
+func (kr *kafkaReader) Next(ctx context.Context) (Message, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		msg, err := kr.c.ReadMessage(kafkaPollInterval)
+		if err != nil {
+			if kafkaErr, ok := err.(kafka.Error); !ok || kafkaErr.Code() != kafka.ErrTimedOut {
+				log.Warn("kafka reader failed to read message", zap.String("topic", kr.topic), zap.Error(err))
+			}
+			continue
+		}
+		kr.mu.Lock()
+		kr.nextOffset = msg.TopicPartition.Offset + 1
+		kr.mu.Unlock()
+		return &kafkaMessage{msg: msg}, nil
+	}
+}
+
+// HasNext returns whether there is any message after the current position of the reader
+func (kr *kafkaReader) HasNext() bool {
+	low, high, err := kr.c.QueryWatermarkOffsets(kr.topic, 0, kafkaTimeoutMs)
+	if err != nil {
+		log.Warn("failed to query kafka watermark offsets", zap.String("topic", kr.topic), zap.Error(err))
+		return false
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	next := int64(kr.nextOffset)
+	switch kr.nextOffset {
+	case kafka.OffsetBeginning:
+		next = low
+	case kafka.OffsetEnd:
+		next = high
+	}
+	return high > next
+}
+
+// Seek moves the reader to the message id, the message is the next one to be read
+func (kr *kafkaReader) Seek(id MessageID) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	return kr.assign(id.(*kafkaID).offset())
+}
+
+// Close closes the reader
+func (kr *kafkaReader) Close() {
+	if err := kr.c.Close(); err != nil {
+		log.Warn("failed to close kafka reader", zap.String("topic", kr.topic), zap.Error(err))
+	}
+}
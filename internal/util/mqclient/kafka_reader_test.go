@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaReader(t *testing.T) {
+	client, closeFn := newMockKafkaClient(t)
+	defer closeFn()
+
+	topic := "TestKafkaReader"
+	ids := produceKafkaMessages(t, client, topic, 10)
+
+	_, err := client.CreateReader(ReaderOptions{StartMessageID: client.EarliestMessageID()})
+	assert.Error(t, err)
+
+	reader, err := client.CreateReader(ReaderOptions{
+		Topic:                  topic,
+		StartMessageID:         client.EarliestMessageID(),
+		SubscriptionRolePrefix: "reader",
+	})
+	require.Nil(t, err)
+	defer reader.Close()
+	assert.Equal(t, topic, reader.Topic())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for i := 0; i < 10; i++ {
+		assert.True(t, reader.HasNext())
+		msg, err := reader.Next(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Payload())
+	}
+	assert.False(t, reader.HasNext())
+
+	err = reader.Seek(ids[4])
+	assert.Nil(t, err)
+	assert.True(t, reader.HasNext())
+	msg, err := reader.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("msg-4"), msg.Payload())
+
+	// no more message, Next returns when the context is done
+	err = reader.Seek(&kafkaID{messageID: 10})
+	assert.Nil(t, err)
+	timeoutCtx, timeoutCancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer timeoutCancel()
+	_, err = reader.Next(timeoutCtx)
+	assert.Error(t, err)
+
+	t.Run("start message", func(t *testing.T) {
+		reader, err := client.CreateReader(ReaderOptions{
+			Topic:                   topic,
+			StartMessageID:          ids[2],
+			StartMessageIDInclusive: false,
+		})
+		require.Nil(t, err)
+		defer reader.Close()
+		msg, err := reader.Next(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("msg-3"), msg.Payload())
+
+		inclusiveReader, err := client.CreateReader(ReaderOptions{
+			Topic:                   topic,
+			StartMessageID:          ids[2],
+			StartMessageIDInclusive: true,
+		})
+		require.Nil(t, err)
+		defer inclusiveReader.Close()
+		msg, err = inclusiveReader.Next(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("msg-2"), msg.Payload())
+	})
+}
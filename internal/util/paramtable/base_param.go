@@ -40,6 +40,10 @@ type BaseParamTable struct {
 	UseEmbedEtcd   bool
 	EtcdConfigPath string
 	EtcdDataDir    string
+
+	// --- Meta Store ---
+	MetaStoreType string
+	MetaStorePath string
 }
 
 // Init is an override method of BaseTable's Init. It mainly calls the
@@ -56,6 +60,7 @@ func (p *BaseParamTable) LoadCfgToMemory() {
 	p.initEtcdConf()
 	p.initMetaRootPath()
 	p.initKvRootPath()
+	p.initMetaStoreConf()
 }
 
 func (p *BaseParamTable) initEtcdConf() {
@@ -116,3 +121,11 @@ func (p *BaseParamTable) initKvRootPath() {
 	}
 	p.KvRootPath = path.Join(rootPath, subPath)
 }
+
+func (p *BaseParamTable) initMetaStoreConf() {
+	p.MetaStoreType = p.LoadWithDefault("metastore.type", "etcd")
+	if p.MetaStoreType != "etcd" && (os.Getenv(metricsinfo.DeployModeEnvKey) != metricsinfo.StandaloneDeployMode) {
+		panic("meta store " + p.MetaStoreType + " can not be used under distributed mode")
+	}
+	p.MetaStorePath = p.LoadWithDefault("metastore.path", "/var/lib/milvus/meta_data")
+}
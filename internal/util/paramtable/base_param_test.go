@@ -39,4 +39,15 @@ func TestBaseParamTable(t *testing.T) {
 
 	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode))
 	Params.LoadCfgToMemory()
+
+	// test meta store
+	assert.Equal(t, "etcd", Params.MetaStoreType)
+	assert.NotEqual(t, "", Params.MetaStorePath)
+	Params.Save("metastore.type", "rocksdb")
+	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode))
+	assert.Panics(t, func() { Params.initMetaStoreConf() })
+
+	assert.Nil(t, os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.StandaloneDeployMode))
+	Params.initMetaStoreConf()
+	assert.Equal(t, "rocksdb", Params.MetaStoreType)
 }
@@ -176,6 +176,12 @@ func (gp *BaseTable) tryloadFromEnv() {
 	}
 	gp.Save("_PulsarAddress", pulsarAddress)
 
+	kafkaBrokerList := os.Getenv("KAFKA_BROKER_LIST")
+	if kafkaBrokerList == "" {
+		kafkaBrokerList = gp.LoadWithDefault("kafka.brokerList", "")
+	}
+	gp.Save("_KafkaBrokerList", kafkaBrokerList)
+
 	rocksmqPath := os.Getenv("ROCKSMQ_PATH")
 	if rocksmqPath == "" {
 		path, err := gp.Load("rocksmq.path")
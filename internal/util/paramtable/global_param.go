@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"fmt"
 	"math"
 	"net"
 	"os"
@@ -54,8 +55,10 @@ type GlobalParamTable struct {
 	BaseParams BaseParamTable
 
 	PulsarCfg  pulsarConfig
+	KafkaCfg   kafkaConfig
 	RocksmqCfg rocksmqConfig
 	MinioCfg   minioConfig
+	StorageCfg storageConfig
 
 	CommonCfg   commonConfig
 	KnowhereCfg knowhereConfig
@@ -69,6 +72,8 @@ type GlobalParamTable struct {
 	DataNodeCfg   dataNodeConfig
 	IndexCoordCfg indexCoordConfig
 	IndexNodeCfg  indexNodeConfig
+
+	QuotaCfg quotaConfig
 }
 
 // InitOnce initialize once
@@ -83,8 +88,10 @@ func (p *GlobalParamTable) Init() {
 	p.BaseParams.Init()
 
 	p.PulsarCfg.init(&p.BaseParams)
+	p.KafkaCfg.init(&p.BaseParams)
 	p.RocksmqCfg.init(&p.BaseParams)
 	p.MinioCfg.init(&p.BaseParams)
+	p.StorageCfg.init(&p.BaseParams)
 
 	p.CommonCfg.init(&p.BaseParams)
 	p.KnowhereCfg.init(&p.BaseParams)
@@ -98,6 +105,8 @@ func (p *GlobalParamTable) Init() {
 	p.DataNodeCfg.init(&p.BaseParams)
 	p.IndexCoordCfg.init(&p.BaseParams)
 	p.IndexNodeCfg.init(&p.BaseParams)
+
+	p.QuotaCfg.init(&p.BaseParams)
 }
 
 // SetLogConfig set log config with given role
@@ -144,6 +153,25 @@ func (p *pulsarConfig) initMaxMessageSize() {
 	}
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// --- kafka ---
+type kafkaConfig struct {
+	BaseParams *BaseParamTable
+
+	// Address is the comma separated broker list, kafka is not used if it is empty
+	Address string
+}
+
+func (p *kafkaConfig) init(bp *BaseParamTable) {
+	p.BaseParams = bp
+
+	p.initAddress()
+}
+
+func (p *kafkaConfig) initAddress() {
+	p.Address = p.BaseParams.LoadWithDefault("_KafkaBrokerList", "")
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- rocksmq ---
 type rocksmqConfig struct {
@@ -238,6 +266,126 @@ func (p *minioConfig) initRootPath() {
 	p.RootPath = rootPath
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// --- storage ---
+type storageConfig struct {
+	BaseParams *BaseParamTable
+
+	// Type is the storage persisting binlogs and index files, "minio", "local", "s3", "gcs" or "azure"
+	Type string
+	// Path is the root directory of the files when Type is "local"
+	Path string
+
+	// PartSize is the size in bytes of each part when uploading large files to S3, GCS and Azure
+	PartSize int64
+	// RetryAttempts is the number of attempts of each request to S3, GCS and Azure
+	RetryAttempts uint
+	// RetrySleep is the backoff before the first retry, doubled after each failed attempt
+	RetrySleep time.Duration
+
+	S3Address         string
+	S3Region          string
+	S3BucketName      string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3UseSSL          bool
+	// S3UseIAM takes the credentials from the environment, the EC2 instance or the ECS task role instead of the keys
+	S3UseIAM      bool
+	S3IAMEndpoint string
+	// S3SSEKMSKeyID encrypts the objects with SSE-KMS by the key if it's not empty
+	S3SSEKMSKeyID string
+	// S3CreateBucket creates the bucket if it doesn't exist
+	S3CreateBucket bool
+
+	GCSAddress    string
+	GCSBucketName string
+	// GCSAccessKeyID and GCSSecretAccessKey are the HMAC keys, GCS is accessed through its S3 compatible API if they're set
+	GCSAccessKeyID     string
+	GCSSecretAccessKey string
+	GCSUseSSL          bool
+	// GCSCredentialsFile is the json key file of the service account, the credentials of the GCE instance or the GKE
+	// workload identity are taken from the metadata server if it's empty and GOOGLE_APPLICATION_CREDENTIALS isn't set
+	GCSCredentialsFile string
+	// GCSProjectID is the project the bucket is created in, the project of the credentials if it's empty
+	GCSProjectID string
+	// GCSCreateBucket creates the bucket if it doesn't exist
+	GCSCreateBucket bool
+
+	AzureAddress       string
+	AzureAccountName   string
+	AzureAccountKey    string
+	AzureContainerName string
+	// AzureCreateContainer creates the container if it doesn't exist
+	AzureCreateContainer bool
+}
+
+func (p *storageConfig) init(bp *BaseParamTable) {
+	p.BaseParams = bp
+
+	p.initType()
+	p.initPath()
+
+	p.initPartSize()
+	p.initRetryAttempts()
+	p.initRetrySleep()
+
+	p.initS3()
+	p.initGCS()
+	p.initAzure()
+}
+
+func (p *storageConfig) initType() {
+	p.Type = p.BaseParams.LoadWithDefault("storage.type", "minio")
+}
+
+func (p *storageConfig) initPath() {
+	p.Path = p.BaseParams.LoadWithDefault("storage.path", "/var/lib/milvus/storage")
+}
+
+func (p *storageConfig) initPartSize() {
+	p.PartSize = p.BaseParams.ParseInt64WithDefault("storage.partSize", 64) * 1024 * 1024
+}
+
+func (p *storageConfig) initRetryAttempts() {
+	p.RetryAttempts = uint(p.BaseParams.ParseIntWithDefault("storage.retryAttempts", 5))
+}
+
+func (p *storageConfig) initRetrySleep() {
+	p.RetrySleep = time.Duration(p.BaseParams.ParseInt64WithDefault("storage.retrySleep", 200)) * time.Millisecond
+}
+
+func (p *storageConfig) initS3() {
+	p.S3Address = p.BaseParams.LoadWithDefault("storage.s3.address", "s3.amazonaws.com")
+	p.S3Region = p.BaseParams.LoadWithDefault("storage.s3.region", "")
+	p.S3BucketName = p.BaseParams.LoadWithDefault("storage.s3.bucketName", "")
+	p.S3AccessKeyID = p.BaseParams.LoadWithDefault("storage.s3.accessKeyID", "")
+	p.S3SecretAccessKey = p.BaseParams.LoadWithDefault("storage.s3.secretAccessKey", "")
+	p.S3UseSSL = p.BaseParams.ParseBool("storage.s3.useSSL", true)
+	p.S3UseIAM = p.BaseParams.ParseBool("storage.s3.useIAM", false)
+	p.S3IAMEndpoint = p.BaseParams.LoadWithDefault("storage.s3.iamEndpoint", "")
+	p.S3SSEKMSKeyID = p.BaseParams.LoadWithDefault("storage.s3.sseKmsKeyID", "")
+	p.S3CreateBucket = p.BaseParams.ParseBool("storage.s3.createBucket", false)
+}
+
+func (p *storageConfig) initGCS() {
+	p.GCSAddress = p.BaseParams.LoadWithDefault("storage.gcs.address", "storage.googleapis.com")
+	p.GCSBucketName = p.BaseParams.LoadWithDefault("storage.gcs.bucketName", "")
+	p.GCSAccessKeyID = p.BaseParams.LoadWithDefault("storage.gcs.accessKeyID", "")
+	p.GCSSecretAccessKey = p.BaseParams.LoadWithDefault("storage.gcs.secretAccessKey", "")
+	p.GCSUseSSL = p.BaseParams.ParseBool("storage.gcs.useSSL", true)
+	p.GCSCredentialsFile = p.BaseParams.LoadWithDefault("storage.gcs.credentialsFile", "")
+	p.GCSProjectID = p.BaseParams.LoadWithDefault("storage.gcs.projectID", "")
+	p.GCSCreateBucket = p.BaseParams.ParseBool("storage.gcs.createBucket", false)
+}
+
+func (p *storageConfig) initAzure() {
+	p.AzureAddress = p.BaseParams.LoadWithDefault("storage.azure.address", "")
+	p.AzureAccountName = p.BaseParams.LoadWithDefault("storage.azure.accountName", "")
+	p.AzureAccountKey = p.BaseParams.LoadWithDefault("storage.azure.accountKey", "")
+	p.AzureContainerName = p.BaseParams.LoadWithDefault("storage.azure.containerName", "")
+	p.AzureCreateContainer = p.BaseParams.ParseBool("storage.azure.createContainer", false)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- common ---
 type commonConfig struct {
@@ -246,6 +394,8 @@ type commonConfig struct {
 	DefaultPartitionName string
 	DefaultIndexName     string
 	RetentionDuration    int64
+
+	AuthorizationEnabled bool
 }
 
 func (p *commonConfig) init(bp *BaseParamTable) {
@@ -254,6 +404,7 @@ func (p *commonConfig) init(bp *BaseParamTable) {
 	p.initDefaultPartitionName()
 	p.initDefaultIndexName()
 	p.initRetentionDuration()
+	p.initAuthorizationEnabled()
 }
 
 func (p *commonConfig) initDefaultPartitionName() {
@@ -268,6 +419,10 @@ func (p *commonConfig) initRetentionDuration() {
 	p.RetentionDuration = p.BaseParams.ParseInt64WithDefault("common.retentionDuration", DefaultRetentionDuration)
 }
 
+func (p *commonConfig) initAuthorizationEnabled() {
+	p.AuthorizationEnabled = p.BaseParams.ParseBool("common.security.authorizationEnabled", false)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- knowhere ---
 type knowhereConfig struct {
@@ -559,6 +714,7 @@ type proxyConfig struct {
 	MsgStreamTimeTickBufSize int64
 	MaxNameLength            int64
 	MaxFieldNum              int64
+	MaxVectorFieldNum        int64
 	MaxShardNum              int32
 	MaxDimension             int64
 	BufFlagExpireTime        time.Duration
@@ -575,6 +731,10 @@ type proxyConfig struct {
 
 	MaxTaskNum int64
 
+	// SearchByGrpc sends search and query requests to the query nodes by grpc,
+	// the query msgstream is used instead if it's false
+	SearchByGrpc bool
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -591,12 +751,14 @@ func (p *proxyConfig) init(bp *BaseParamTable) {
 	p.initMsgStreamTimeTickBufSize()
 	p.initMaxNameLength()
 	p.initMaxFieldNum()
+	p.initMaxVectorFieldNum()
 	p.initMaxShardNum()
 	p.initMaxDimension()
 
 	p.initMaxTaskNum()
 	p.initBufFlagExpireTime()
 	p.initBufFlagCleanupInterval()
+	p.initSearchByGrpc()
 }
 
 // Refresh is called after session init
@@ -672,6 +834,10 @@ func (p *proxyConfig) initMaxFieldNum() {
 	p.MaxFieldNum = maxFieldNum
 }
 
+func (p *proxyConfig) initMaxVectorFieldNum() {
+	p.MaxVectorFieldNum = p.BaseParams.ParseInt64WithDefault("proxy.maxVectorFieldNum", 4)
+}
+
 func (p *proxyConfig) initMaxDimension() {
 	str := p.BaseParams.LoadWithDefault("proxy.maxDimension", "32768")
 	maxDimension, err := strconv.ParseInt(str, 10, 64)
@@ -695,6 +861,10 @@ func (p *proxyConfig) initBufFlagCleanupInterval() {
 	p.BufFlagCleanupInterval = time.Duration(interval) * time.Second
 }
 
+func (p *proxyConfig) initSearchByGrpc() {
+	p.SearchByGrpc = p.BaseParams.ParseBool("proxy.searchByGrpc", true)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- querycoord ---
 type queryCoordConfig struct {
@@ -1440,6 +1610,72 @@ func (p *indexNodeConfig) initIndexStorageRootPath() {
 	p.IndexStorageRootPath = path.Join(rootPath, "index_files")
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// --- quota and limits ---
+type quotaConfig struct {
+	BaseParams *BaseParamTable
+
+	QuotaAndLimitsEnabled      bool
+	QuotaCenterCollectInterval time.Duration
+
+	// rate limits, math.MaxFloat64 means no limit
+	DMLMaxRowRate               float64 // rows/s
+	DMLMaxRowRatePerCollection  float64
+	DMLMaxByteRate              float64 // bytes/s
+	DMLMaxByteRatePerCollection float64
+	DQLMaxNQRate                float64 // nq/s
+	DQLMaxNQRatePerCollection   float64
+	DDLMaxOpRate                float64 // ops/s
+	DDLMaxOpRatePerCollection   float64
+
+	// back-pressure, zero durations mean no limit
+	MaxFlushLag          time.Duration
+	MaxTSafeDelay        time.Duration
+	MemoryLowWaterLevel  float64
+	MemoryHighWaterLevel float64
+}
+
+func (p *quotaConfig) init(bp *BaseParamTable) {
+	p.BaseParams = bp
+
+	p.QuotaAndLimitsEnabled = p.BaseParams.ParseBool("quotaAndLimits.enabled", false)
+	interval := p.BaseParams.ParseFloatWithDefault("quotaAndLimits.quotaCenterCollectInterval", 3)
+	p.QuotaCenterCollectInterval = time.Duration(interval * float64(time.Second))
+
+	p.DMLMaxRowRate = p.parseRate("quotaAndLimits.dml.rowRate.max", 1)
+	p.DMLMaxRowRatePerCollection = p.parseRate("quotaAndLimits.dml.rowRate.collection.max", 1)
+	p.DMLMaxByteRate = p.parseRate("quotaAndLimits.dml.byteRate.max", 1024*1024)
+	p.DMLMaxByteRatePerCollection = p.parseRate("quotaAndLimits.dml.byteRate.collection.max", 1024*1024)
+	p.DQLMaxNQRate = p.parseRate("quotaAndLimits.dql.nqRate.max", 1)
+	p.DQLMaxNQRatePerCollection = p.parseRate("quotaAndLimits.dql.nqRate.collection.max", 1)
+	p.DDLMaxOpRate = p.parseRate("quotaAndLimits.ddl.opRate.max", 1)
+	p.DDLMaxOpRatePerCollection = p.parseRate("quotaAndLimits.ddl.opRate.collection.max", 1)
+
+	maxFlushLag := p.BaseParams.ParseFloatWithDefault("quotaAndLimits.limitWriting.maxFlushLag", 300)
+	p.MaxFlushLag = time.Duration(maxFlushLag * float64(time.Second))
+	maxTSafeDelay := p.BaseParams.ParseFloatWithDefault("quotaAndLimits.limitWriting.maxTSafeDelay", 300)
+	p.MaxTSafeDelay = time.Duration(maxTSafeDelay * float64(time.Second))
+	p.MemoryLowWaterLevel = p.BaseParams.ParseFloatWithDefault("quotaAndLimits.limitWriting.memProtection.lowWaterLevel", 0.85)
+	p.MemoryHighWaterLevel = p.BaseParams.ParseFloatWithDefault("quotaAndLimits.limitWriting.memProtection.highWaterLevel", 0.95)
+	if p.MemoryLowWaterLevel > p.MemoryHighWaterLevel {
+		panic(fmt.Sprintf("quotaAndLimits.limitWriting.memProtection: lowWaterLevel %v is higher than highWaterLevel %v",
+			p.MemoryLowWaterLevel, p.MemoryHighWaterLevel))
+	}
+}
+
+// parseRate returns the rate of key in units per second, an empty value means no limit
+func (p *quotaConfig) parseRate(key string, unit float64) float64 {
+	str := p.BaseParams.LoadWithDefault(key, "")
+	if str == "" {
+		return math.MaxFloat64
+	}
+	rate, err := strconv.ParseFloat(str, 64)
+	if err != nil || rate < 0 {
+		panic(fmt.Sprintf("invalid rate %s of %s", str, key))
+	}
+	return rate * unit
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- grpc ---
 type grpcConfig struct {
@@ -13,6 +13,7 @@ package paramtable
 
 import (
 	"log"
+	"math"
 	"os"
 	"path"
 	"testing"
@@ -41,6 +42,18 @@ func TestGlobalParamTable(t *testing.T) {
 		assert.Equal(t, Params.MaxMessageSize, SuggestPulsarMaxMessageSize)
 	})
 
+	t.Run("test kafkaConfig", func(t *testing.T) {
+		Params := GlobalParams.KafkaCfg
+
+		// kafka is disabled by default
+		assert.Equal(t, "", Params.Address)
+
+		Params.BaseParams.Save("_KafkaBrokerList", "localhost:9092")
+		Params.initAddress()
+		assert.Equal(t, "localhost:9092", Params.Address)
+		Params.BaseParams.Save("_KafkaBrokerList", "")
+	})
+
 	t.Run("test rocksmqConfig", func(t *testing.T) {
 		Params := GlobalParams.RocksmqCfg
 
@@ -67,6 +80,36 @@ func TestGlobalParamTable(t *testing.T) {
 		t.Logf("Minio rootpath = %s", Params.RootPath)
 	})
 
+	t.Run("test storageConfig", func(t *testing.T) {
+		Params := GlobalParams.StorageCfg
+
+		assert.Equal(t, "minio", Params.Type)
+		assert.NotEqual(t, "", Params.Path)
+
+		Params.BaseParams.Save("storage.type", "local")
+		Params.initType()
+		assert.Equal(t, "local", Params.Type)
+		Params.BaseParams.Save("storage.type", "minio")
+
+		assert.Equal(t, int64(64*1024*1024), Params.PartSize)
+		assert.Equal(t, uint(5), Params.RetryAttempts)
+		assert.Equal(t, 200*time.Millisecond, Params.RetrySleep)
+		assert.Equal(t, "s3.amazonaws.com", Params.S3Address)
+		assert.True(t, Params.S3UseSSL)
+		assert.False(t, Params.S3UseIAM)
+		assert.Equal(t, "storage.googleapis.com", Params.GCSAddress)
+		assert.Equal(t, "", Params.GCSCredentialsFile)
+		assert.Equal(t, "", Params.AzureAddress)
+		assert.False(t, Params.S3CreateBucket)
+		assert.False(t, Params.GCSCreateBucket)
+		assert.False(t, Params.AzureCreateContainer)
+
+		Params.BaseParams.Save("storage.s3.useIAM", "true")
+		Params.initS3()
+		assert.True(t, Params.S3UseIAM)
+		Params.BaseParams.Save("storage.s3.useIAM", "false")
+	})
+
 	t.Run("test commonConfig", func(t *testing.T) {
 		Params := GlobalParams.CommonCfg
 
@@ -77,6 +120,8 @@ func TestGlobalParamTable(t *testing.T) {
 		t.Logf("default index name = %s", Params.DefaultIndexName)
 
 		assert.Equal(t, Params.RetentionDuration, int64(DefaultRetentionDuration))
+
+		assert.False(t, Params.AuthorizationEnabled)
 	})
 
 	t.Run("test knowhereConfig", func(t *testing.T) {
@@ -133,11 +178,15 @@ func TestGlobalParamTable(t *testing.T) {
 
 		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum)
 
+		assert.Equal(t, int64(4), Params.MaxVectorFieldNum)
+
 		t.Logf("MaxShardNum: %d", Params.MaxShardNum)
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
+
+		assert.True(t, Params.SearchByGrpc)
 	})
 
 	t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -339,6 +388,27 @@ func TestGlobalParamTable(t *testing.T) {
 
 		t.Logf("IndexStorageRootPath: %v", Params.IndexStorageRootPath)
 	})
+
+	t.Run("test quotaConfig", func(t *testing.T) {
+		Params := GlobalParams.QuotaCfg
+
+		assert.False(t, Params.QuotaAndLimitsEnabled)
+		assert.Equal(t, 3*time.Second, Params.QuotaCenterCollectInterval)
+		assert.Equal(t, math.MaxFloat64, Params.DMLMaxRowRate)
+		assert.Equal(t, math.MaxFloat64, Params.DQLMaxNQRatePerCollection)
+		assert.Equal(t, 300*time.Second, Params.MaxFlushLag)
+		assert.Equal(t, 0.85, Params.MemoryLowWaterLevel)
+		assert.Equal(t, 0.95, Params.MemoryHighWaterLevel)
+
+		Params.BaseParams.Save("quotaAndLimits.dml.byteRate.max", "2")
+		defer Params.BaseParams.Remove("quotaAndLimits.dml.byteRate.max")
+		Params.init(Params.BaseParams)
+		assert.Equal(t, float64(2*1024*1024), Params.DMLMaxByteRate)
+
+		Params.BaseParams.Save("quotaAndLimits.ddl.opRate.max", "-1")
+		defer Params.BaseParams.Remove("quotaAndLimits.ddl.opRate.max")
+		assert.Panics(t, func() { Params.init(Params.BaseParams) })
+	})
 }
 
 func TestGrpcServerParams(t *testing.T) {
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is the maximum number of events per second
+type Limit float64
+
+// Inf is the infinite rate limit, all the events are allowed
+const Inf = Limit(math.MaxFloat64)
+
+// Limiter is a token bucket rate limiter, tokens are added to the bucket at the rate of limit per second,
+// up to burst tokens. An event of size n takes n tokens, an event larger than burst is allowed once
+// the bucket is full and leaves the bucket in debt. A Limiter with zero burst allows no events.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  Limit
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a full Limiter with limit and burst
+func NewLimiter(limit Limit, burst float64) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// Limit returns the current limit
+func (lim *Limiter) Limit() Limit {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return lim.limit
+}
+
+// AllowN reports whether an event of size n may happen at now, the tokens are taken if it's allowed
+func (lim *Limiter) AllowN(now time.Time, n int) bool {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	if lim.limit == Inf {
+		return true
+	}
+	lim.advance(now)
+	if lim.tokens < float64(n) && (lim.burst <= 0 || lim.tokens < lim.burst) {
+		return false
+	}
+	lim.tokens -= float64(n)
+	return true
+}
+
+// Cancel puts back the tokens taken by an allowed event of size n, which doesn't happen in the end
+func (lim *Limiter) Cancel(n int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	if lim.limit == Inf {
+		return
+	}
+	lim.tokens = math.Min(lim.tokens+float64(n), lim.burst)
+}
+
+// SetLimit sets a new limit, the tokens added with the old limit are kept
+func (lim *Limiter) SetLimit(limit Limit) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.advance(time.Now())
+	lim.limit = limit
+}
+
+// advance adds the tokens accumulated since the last update
+func (lim *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(lim.last)
+	if elapsed <= 0 {
+		return
+	}
+	lim.last = now
+	if lim.limit == Inf {
+		lim.tokens = lim.burst
+		return
+	}
+	lim.tokens = math.Min(lim.tokens+elapsed.Seconds()*float64(lim.limit), lim.burst)
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter_AllowN(t *testing.T) {
+	now := time.Now()
+	lim := NewLimiter(10, 10)
+	lim.last = now
+
+	assert.True(t, lim.AllowN(now, 6))
+	assert.True(t, lim.AllowN(now, 4))
+	assert.False(t, lim.AllowN(now, 1))
+
+	// 5 tokens are added in half a second
+	now = now.Add(500 * time.Millisecond)
+	assert.False(t, lim.AllowN(now, 6))
+	assert.True(t, lim.AllowN(now, 5))
+	assert.False(t, lim.AllowN(now, 1))
+
+	// no more than burst tokens are kept
+	now = now.Add(10 * time.Second)
+	assert.True(t, lim.AllowN(now, 10))
+	assert.False(t, lim.AllowN(now, 1))
+
+	lim.Cancel(3)
+	assert.True(t, lim.AllowN(now, 3))
+	// cancelled tokens don't exceed burst either
+	lim.Cancel(100)
+	assert.True(t, lim.AllowN(now, 10))
+	assert.False(t, lim.AllowN(now, 1))
+}
+
+func TestLimiter_Debt(t *testing.T) {
+	now := time.Now()
+	lim := NewLimiter(10, 10)
+	lim.last = now
+
+	// an event larger than burst is allowed with a full bucket
+	assert.True(t, lim.AllowN(now, 30))
+	assert.False(t, lim.AllowN(now, 1))
+	// the debt of 20 tokens is paid in 2 seconds
+	now = now.Add(2 * time.Second)
+	assert.False(t, lim.AllowN(now, 1))
+	now = now.Add(100 * time.Millisecond)
+	assert.True(t, lim.AllowN(now, 1))
+}
+
+func TestLimiter_SetLimit(t *testing.T) {
+	lim := NewLimiter(Inf, 0)
+	assert.Equal(t, Inf, lim.Limit())
+	for i := 0; i < 100; i++ {
+		assert.True(t, lim.AllowN(time.Now(), 1000))
+	}
+	lim.Cancel(1)
+
+	lim = NewLimiter(0, 0)
+	assert.False(t, lim.AllowN(time.Now(), 1))
+	assert.True(t, lim.AllowN(time.Now(), 0))
+
+	lim = NewLimiter(10, 10)
+	lim.SetLimit(0)
+	assert.Equal(t, Limit(0), lim.Limit())
+	assert.True(t, lim.AllowN(time.Now(), 10))
+	assert.False(t, lim.AllowN(time.Now().Add(time.Hour), 1))
+}
@@ -10,10 +10,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/retry"
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 )
@@ -62,10 +62,8 @@ type Session struct {
 	TriggerKill bool
 
 	liveCh  <-chan bool
-	etcdCli *clientv3.Client
-	leaseID *clientv3.LeaseID
-
-	metaRoot string
+	metaKV  kv.MetaKv
+	leaseID *kv.LeaseID
 
 	registered atomic.Value
 }
@@ -73,23 +71,15 @@ type Session struct {
 // NewSession is a helper to build Session object.
 // ServerID, ServerName, Address, Exclusive will be assigned after Init().
 // metaRoot is a path in etcd to save session information.
-// etcdEndpoints is to init etcdCli when NewSession
+// client is the etcd client the session is kept with.
 func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client) *Session {
-	session := &Session{
-		ctx:      ctx,
-		metaRoot: metaRoot,
-	}
-
-	session.UpdateRegistered(false)
-
 	connectEtcdFn := func() error {
 		log.Debug("Session try to connect to etcd")
-		ctx2, cancel2 := context.WithTimeout(session.ctx, 5*time.Second)
+		ctx2, cancel2 := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel2()
 		if _, err := client.Get(ctx2, "health"); err != nil {
 			return err
 		}
-		session.etcdCli = client
 		return nil
 	}
 	err := retry.Do(ctx, connectEtcdFn, retry.Attempts(300))
@@ -99,6 +89,17 @@ func NewSession(ctx context.Context, metaRoot string, client *clientv3.Client) *
 		return nil
 	}
 	log.Debug("Session connect to etcd success")
+	return NewSessionWithMetaKV(ctx, etcdkv.NewEtcdKV(client, metaRoot))
+}
+
+// NewSessionWithMetaKV builds a Session kept in metaKV, such as an embedded meta kv, the session information is
+// saved under the root path of metaKV.
+func NewSessionWithMetaKV(ctx context.Context, metaKV kv.MetaKv) *Session {
+	session := &Session{
+		ctx:    ctx,
+		metaKV: metaKV,
+	}
+	session.UpdateRegistered(false)
 	return session
 }
 
@@ -132,46 +133,31 @@ func (s *Session) getServerID() (int64, error) {
 }
 
 func (s *Session) checkIDExist() {
-	s.etcdCli.Txn(s.ctx).If(
-		clientv3.Compare(
-			clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey)),
-			"=",
-			0)).
-		Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, DefaultIDKey), "1")).Commit()
+	// fails if the id key exists
+	_ = s.metaKV.CompareVersionAndSwap(path.Join(DefaultServiceRoot, DefaultIDKey), 0, "1")
 }
 
 func (s *Session) getServerIDWithKey(key string) (int64, error) {
 	for {
-		getResp, err := s.etcdCli.Get(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, key))
+		value, err := s.metaKV.Load(path.Join(DefaultServiceRoot, key))
 		if err != nil {
 			log.Warn("Session get etcd key error", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
-		if getResp.Count <= 0 {
-			log.Warn("Session there is no value", zap.String("key", key))
-			continue
-		}
-		value := string(getResp.Kvs[0].Value)
 		valueInt, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
 			log.Warn("Session ParseInt error", zap.String("value", value), zap.Error(err))
 			continue
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Value(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				value)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), strconv.FormatInt(valueInt+1, 10))).Commit()
+		err = s.metaKV.CompareValueAndSwap(path.Join(DefaultServiceRoot, key), value, strconv.FormatInt(valueInt+1, 10))
+		if errors.Is(err, kv.ErrCompareFailed) {
+			log.Warn("Session Txn unsuccessful", zap.String("key", key))
+			continue
+		}
 		if err != nil {
 			log.Warn("Session Txn failed", zap.String("key", key), zap.Error(err))
 			return -1, err
 		}
-
-		if !txnResp.Succeeded {
-			log.Warn("Session Txn unsuccessful", zap.String("key", key))
-			continue
-		}
 		log.Debug("Session get serverID success", zap.String("key", key), zap.Int64("ServerId", valueInt))
 		return valueInt, nil
 	}
@@ -190,16 +176,16 @@ func (s *Session) getServerIDWithKey(key string) (int64, error) {
 // }
 // Exclusive means whether this service can exist two at the same time, if so,
 // it is false. Otherwise, set it to true.
-func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
-	var ch <-chan *clientv3.LeaseKeepAliveResponse
+func (s *Session) registerService() (<-chan *kv.LeaseKeepAliveResponse, error) {
+	var ch <-chan *kv.LeaseKeepAliveResponse
 	log.Debug("Session Register Begin", zap.String("ServerName", s.ServerName))
 	registerFn := func() error {
-		resp, err := s.etcdCli.Grant(s.ctx, DefaultTTL)
+		leaseID, err := s.metaKV.Grant(DefaultTTL)
 		if err != nil {
 			log.Error("register service", zap.Error(err))
 			return err
 		}
-		s.leaseID = &resp.ID
+		s.leaseID = &leaseID
 
 		sessionJSON, err := json.Marshal(s)
 		if err != nil {
@@ -210,25 +196,15 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 		if !s.Exclusive {
 			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
 		}
-		txnResp, err := s.etcdCli.Txn(s.ctx).If(
-			clientv3.Compare(
-				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
-				"=",
-				0)).
-			Then(clientv3.OpPut(path.Join(s.metaRoot, DefaultServiceRoot, key), string(sessionJSON), clientv3.WithLease(resp.ID))).Commit()
-
+		err = s.metaKV.CompareVersionAndSwap(path.Join(DefaultServiceRoot, key), 0, string(sessionJSON), kv.WithLease(leaseID))
 		if err != nil {
 			log.Warn("compare and swap error, maybe the key has ben registered", zap.Error(err))
 			return err
 		}
 
-		if !txnResp.Succeeded {
-			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", key)
-		}
-
 		keepAliveCtx, keepAliveCancel := context.WithCancel(context.Background())
 		s.keepAliveCancel = keepAliveCancel
-		ch, err = s.etcdCli.KeepAlive(keepAliveCtx, resp.ID)
+		ch, err = s.metaKV.KeepAlive(keepAliveCtx, leaseID)
 		if err != nil {
 			fmt.Printf("keep alive error %s\n", err)
 			return err
@@ -245,7 +221,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
-func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
+func (s *Session) processKeepAliveResponse(ch <-chan *kv.LeaseKeepAliveResponse) (failChannel <-chan bool) {
 	failCh := make(chan bool)
 	go func() {
 		for {
@@ -278,25 +254,23 @@ func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveRes
 // Revision is returned for WatchServices to prevent key events from being missed.
 func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error) {
 	res := make(map[string]*Session)
-	key := path.Join(s.metaRoot, DefaultServiceRoot, prefix)
-	resp, err := s.etcdCli.Get(s.ctx, key, clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	keys, values, revision, err := s.metaKV.LoadWithRevision(path.Join(DefaultServiceRoot, prefix))
 	if err != nil {
 		return nil, 0, err
 	}
-	for _, kv := range resp.Kvs {
+	for i, key := range keys {
 		session := &Session{}
-		err = json.Unmarshal(kv.Value, session)
+		err = json.Unmarshal([]byte(values[i]), session)
 		if err != nil {
 			return nil, 0, err
 		}
-		_, mapKey := path.Split(string(kv.Key))
+		_, mapKey := path.Split(key)
 		log.Debug("SessionUtil GetSessions ", zap.Any("prefix", prefix),
 			zap.String("key", mapKey),
 			zap.Any("address", session.Address))
 		res[mapKey] = session
 	}
-	return res, resp.Header.Revision, nil
+	return res, revision, nil
 }
 
 // SessionEvent indicates the changes of other servers.
@@ -310,7 +284,7 @@ type SessionEvent struct {
 
 type sessionWatcher struct {
 	s       *Session
-	rch     clientv3.WatchChan
+	rch     kv.WatchChan
 	eventCh chan *SessionEvent
 	prefix  string
 	rewatch Rewatch
@@ -350,7 +324,7 @@ func (s *Session) WatchServices(prefix string, revision int64, rewatch Rewatch)
 	w := &sessionWatcher{
 		s:       s,
 		eventCh: make(chan *SessionEvent, 100),
-		rch:     s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision)),
+		rch:     s.metaKV.WatchWithRevision(path.Join(DefaultServiceRoot, prefix), revision),
 		prefix:  prefix,
 		rewatch: rewatch,
 	}
@@ -358,15 +332,15 @@ func (s *Session) WatchServices(prefix string, revision int64, rewatch Rewatch)
 	return w.eventCh
 }
 
-func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) error {
-	if wresp.Err() != nil {
-		return w.handleWatchErr(wresp.Err())
+func (w *sessionWatcher) handleWatchResponse(wresp kv.WatchResponse) error {
+	if wresp.Err != nil {
+		return w.handleWatchErr(wresp.Err)
 	}
 	for _, ev := range wresp.Events {
 		session := &Session{}
 		var eventType SessionEventType
 		switch ev.Type {
-		case mvccpb.PUT:
+		case kv.EventTypePut:
 			log.Debug("watch services",
 				zap.Any("add kv", ev.Kv))
 			err := json.Unmarshal([]byte(ev.Kv.Value), session)
@@ -375,7 +349,7 @@ func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) error
 				continue
 			}
 			eventType = SessionAddEvent
-		case mvccpb.DELETE:
+		case kv.EventTypeDelete:
 			log.Debug("watch services",
 				zap.Any("delete kv", ev.PrevKv))
 			err := json.Unmarshal([]byte(ev.PrevKv.Value), session)
@@ -396,7 +370,7 @@ func (w *sessionWatcher) handleWatchResponse(wresp clientv3.WatchResponse) error
 
 func (w *sessionWatcher) handleWatchErr(err error) error {
 	// if not ErrCompacted, just close the channel
-	if err != v3rpc.ErrCompacted {
+	if err != kv.ErrCompacted {
 		//close event channel
 		log.Warn("Watch service found error", zap.Error(err))
 		close(w.eventCh)
@@ -424,7 +398,7 @@ func (w *sessionWatcher) handleWatchErr(err error) error {
 		return err
 	}
 
-	w.rch = w.s.etcdCli.Watch(w.s.ctx, path.Join(w.s.metaRoot, DefaultServiceRoot, w.prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
+	w.rch = w.s.metaKV.WatchWithRevision(path.Join(DefaultServiceRoot, w.prefix), revision)
 	return nil
 }
 
@@ -462,14 +436,19 @@ func (s *Session) Revoke(timeout time.Duration) {
 	if s == nil {
 		return
 	}
-	if s.etcdCli == nil || s.leaseID == nil {
+	if s.metaKV == nil || s.leaseID == nil {
 		return
 	}
-	// can NOT use s.ctx, it may be Done here
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	// ignores resp & error, just do best effort to revoke
-	_, _ = s.etcdCli.Revoke(ctx, *s.leaseID)
+	// ignores error, just do best effort to revoke within timeout
+	done := make(chan struct{})
+	go func() {
+		_ = s.metaKV.Revoke(*s.leaseID)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
 }
 
 // UpdateRegistered update the state of registered.
@@ -5,181 +5,194 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	embeddedkv "github.com/milvus-io/milvus/internal/kv/embedded"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-
-	"go.etcd.io/etcd/api/v3/mvccpb"
-	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 var Params paramtable.BaseTable
 
-func TestGetServerIDConcurrently(t *testing.T) {
-	ctx := context.Background()
-	Params.Init()
+// sessionBackend is a meta store the session tests run against
+type sessionBackend struct {
+	name       string
+	newSession func(ctx context.Context, metaRoot string) *Session
+	// rootKV is the meta kv at the root of the store
+	rootKV kv.MetaKv
+}
 
+// newSessionBackends returns the etcd and the embedded meta store backends
+func newSessionBackends(t *testing.T) []sessionBackend {
+	Params.Init()
 	endpoints, err := Params.Load("_EtcdEndpoints")
-	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
 	if err != nil {
 		panic(err)
 	}
-
-	etcdEndpoints := strings.Split(endpoints, ",")
-	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
+	etcdCli, err := etcd.GetRemoteEtcdClient(strings.Split(endpoints, ","))
 	require.NoError(t, err)
-	defer etcdCli.Close()
-	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
-	err = etcdKV.RemoveWithPrefix("")
-	assert.NoError(t, err)
-
-	defer etcdKV.Close()
-	defer etcdKV.RemoveWithPrefix("")
+	t.Cleanup(func() { etcdCli.Close() })
 
-	var wg sync.WaitGroup
-	var muList = sync.Mutex{}
+	store, err := embeddedkv.NewStore(memkv.NewMemoryKV())
+	require.NoError(t, err)
+	t.Cleanup(store.Close)
 
-	s := NewSession(ctx, metaRoot, etcdCli)
-	res := make([]int64, 0)
-
-	getIDFunc := func() {
-		s.checkIDExist()
-		id, err := s.getServerID()
-		assert.Nil(t, err)
-		muList.Lock()
-		res = append(res, id)
-		muList.Unlock()
-		wg.Done()
+	return []sessionBackend{
+		{
+			name: "etcd",
+			newSession: func(ctx context.Context, metaRoot string) *Session {
+				return NewSession(ctx, metaRoot, etcdCli)
+			},
+			rootKV: etcdkv.NewEtcdKV(etcdCli, ""),
+		},
+		{
+			name: "embedded",
+			newSession: func(ctx context.Context, metaRoot string) *Session {
+				return NewSessionWithMetaKV(ctx, embeddedkv.NewMetaKV(store, metaRoot))
+			},
+			rootKV: embeddedkv.NewMetaKV(store, ""),
+		},
 	}
+}
 
-	for i := 0; i < 10; i++ {
-		wg.Add(1)
-		go getIDFunc()
-	}
-	wg.Wait()
-	for i := 1; i <= 10; i++ {
-		assert.Contains(t, res, int64(i))
+func TestGetServerIDConcurrently(t *testing.T) {
+	for _, backend := range newSessionBackends(t) {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			ctx := context.Background()
+			metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+			err := backend.rootKV.RemoveWithPrefix(metaRoot)
+			assert.NoError(t, err)
+			defer backend.rootKV.RemoveWithPrefix(metaRoot)
+
+			var wg sync.WaitGroup
+			var muList = sync.Mutex{}
+
+			s := backend.newSession(ctx, metaRoot)
+			res := make([]int64, 0)
+
+			getIDFunc := func() {
+				s.checkIDExist()
+				id, err := s.getServerID()
+				assert.Nil(t, err)
+				muList.Lock()
+				res = append(res, id)
+				muList.Unlock()
+				wg.Done()
+			}
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go getIDFunc()
+			}
+			wg.Wait()
+			for i := 1; i <= 10; i++ {
+				assert.Contains(t, res, int64(i))
+			}
+		})
 	}
 }
 
 func TestInit(t *testing.T) {
-	ctx := context.Background()
-	Params.Init()
-
-	endpoints, err := Params.Load("_EtcdEndpoints")
-	if err != nil {
-		panic(err)
+	for _, backend := range newSessionBackends(t) {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			ctx := context.Background()
+			metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+			err := backend.rootKV.RemoveWithPrefix(metaRoot)
+			assert.NoError(t, err)
+			defer backend.rootKV.RemoveWithPrefix(metaRoot)
+
+			s := backend.newSession(ctx, metaRoot)
+			s.Init("inittest", "testAddr", false, false)
+			assert.NotEqual(t, int64(0), s.leaseID)
+			assert.NotEqual(t, int64(0), s.ServerID)
+			s.Register()
+			sessions, _, err := s.GetSessions("inittest")
+			assert.Nil(t, err)
+			assert.Contains(t, sessions, "inittest-"+strconv.FormatInt(s.ServerID, 10))
+		})
 	}
-	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
-
-	etcdEndpoints := strings.Split(endpoints, ",")
-	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
-	require.NoError(t, err)
-	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
-	err = etcdKV.RemoveWithPrefix("")
-	assert.NoError(t, err)
-
-	defer etcdKV.Close()
-	defer etcdKV.RemoveWithPrefix("")
-
-	s := NewSession(ctx, metaRoot, etcdCli)
-	s.Init("inittest", "testAddr", false, false)
-	assert.NotEqual(t, int64(0), s.leaseID)
-	assert.NotEqual(t, int64(0), s.ServerID)
-	s.Register()
-	sessions, _, err := s.GetSessions("inittest")
-	assert.Nil(t, err)
-	assert.Contains(t, sessions, "inittest-"+strconv.FormatInt(s.ServerID, 10))
 }
 
 func TestUpdateSessions(t *testing.T) {
-	ctx := context.Background()
-	Params.Init()
-
-	endpoints, err := Params.Load("_EtcdEndpoints")
-	if err != nil {
-		panic(err)
-	}
-
-	etcdEndpoints := strings.Split(endpoints, ",")
-	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
-	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
-	require.NoError(t, err)
-	defer etcdCli.Close()
-	etcdKV := etcdkv.NewEtcdKV(etcdCli, "")
-
-	defer etcdKV.Close()
-	defer etcdKV.RemoveWithPrefix("")
-
-	var wg sync.WaitGroup
-	var muList = sync.Mutex{}
-
-	s := NewSession(ctx, metaRoot, etcdCli)
-
-	sessions, rev, err := s.GetSessions("test")
-	assert.Nil(t, err)
-	assert.Equal(t, len(sessions), 0)
-	eventCh := s.WatchServices("test", rev, nil)
-
-	sList := []*Session{}
-
-	getIDFunc := func() {
-		etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
-		require.NoError(t, err)
-		singleS := NewSession(ctx, metaRoot, etcdCli)
-		singleS.Init("test", "testAddr", false, false)
-		singleS.Register()
-		muList.Lock()
-		sList = append(sList, singleS)
-		muList.Unlock()
-		wg.Done()
-	}
-
-	for i := 0; i < 10; i++ {
-		wg.Add(1)
-		go getIDFunc()
-	}
-	wg.Wait()
-
-	assert.Eventually(t, func() bool {
-		sessions, _, _ := s.GetSessions("test")
-		return len(sessions) == 10
-	}, 10*time.Second, 100*time.Millisecond)
-	notExistSessions, _, _ := s.GetSessions("testt")
-	assert.Equal(t, len(notExistSessions), 0)
-
-	etcdKV.RemoveWithPrefix(metaRoot)
-	assert.Eventually(t, func() bool {
-		sessions, _, _ := s.GetSessions("test")
-		return len(sessions) == 0
-	}, 10*time.Second, 100*time.Millisecond)
-
-	sessionEvents := []*SessionEvent{}
-	addEventLen := 0
-	delEventLen := 0
-	eventLength := len(eventCh)
-	for i := 0; i < eventLength; i++ {
-		sessionEvent := <-eventCh
-		if sessionEvent.EventType == SessionAddEvent {
-			addEventLen++
-		}
-		if sessionEvent.EventType == SessionDelEvent {
-			delEventLen++
-		}
-		sessionEvents = append(sessionEvents, sessionEvent)
+	for _, backend := range newSessionBackends(t) {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			ctx := context.Background()
+			metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+			defer backend.rootKV.RemoveWithPrefix(metaRoot)
+
+			var wg sync.WaitGroup
+			var muList = sync.Mutex{}
+
+			s := backend.newSession(ctx, metaRoot)
+
+			sessions, rev, err := s.GetSessions("test")
+			assert.Nil(t, err)
+			assert.Equal(t, len(sessions), 0)
+			eventCh := s.WatchServices("test", rev, nil)
+
+			sList := []*Session{}
+
+			getIDFunc := func() {
+				singleS := backend.newSession(ctx, metaRoot)
+				singleS.Init("test", "testAddr", false, false)
+				singleS.Register()
+				muList.Lock()
+				sList = append(sList, singleS)
+				muList.Unlock()
+				wg.Done()
+			}
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go getIDFunc()
+			}
+			wg.Wait()
+
+			assert.Eventually(t, func() bool {
+				sessions, _, _ := s.GetSessions("test")
+				return len(sessions) == 10
+			}, 10*time.Second, 100*time.Millisecond)
+			notExistSessions, _, _ := s.GetSessions("testt")
+			assert.Equal(t, len(notExistSessions), 0)
+
+			backend.rootKV.RemoveWithPrefix(metaRoot)
+			assert.Eventually(t, func() bool {
+				sessions, _, _ := s.GetSessions("test")
+				return len(sessions) == 0
+			}, 10*time.Second, 100*time.Millisecond)
+
+			sessionEvents := []*SessionEvent{}
+			addEventLen := 0
+			delEventLen := 0
+			eventLength := len(eventCh)
+			for i := 0; i < eventLength; i++ {
+				sessionEvent := <-eventCh
+				if sessionEvent.EventType == SessionAddEvent {
+					addEventLen++
+				}
+				if sessionEvent.EventType == SessionDelEvent {
+					delEventLen++
+				}
+				sessionEvents = append(sessionEvents, sessionEvent)
+			}
+			assert.Equal(t, len(sessionEvents), 20)
+			assert.Equal(t, addEventLen, 10)
+			assert.Equal(t, delEventLen, 10)
+		})
 	}
-	assert.Equal(t, len(sessionEvents), 20)
-	assert.Equal(t, addEventLen, 10)
-	assert.Equal(t, delEventLen, 10)
 }
 
 func TestSessionLivenessCheck(t *testing.T) {
@@ -250,17 +263,17 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("handle normal events", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
-			Events: []*clientv3.Event{
+		wresp := kv.WatchResponse{
+			Events: []*kv.Event{
 				{
-					Type: mvccpb.PUT,
-					Kv: &mvccpb.KeyValue{
+					Type: kv.EventTypePut,
+					Kv: &kv.KeyValue{
 						Value: []byte(`{"ServerID": 1, "ServerName": "test1"}`),
 					},
 				},
 				{
-					Type: mvccpb.DELETE,
-					PrevKv: &mvccpb.KeyValue{
+					Type: kv.EventTypeDelete,
+					PrevKv: &kv.KeyValue{
 						Value: []byte(`{"ServerID": 2, "ServerName": "test2"}`),
 					},
 				},
@@ -273,17 +286,17 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("handle abnormal events", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
-			Events: []*clientv3.Event{
+		wresp := kv.WatchResponse{
+			Events: []*kv.Event{
 				{
-					Type: mvccpb.PUT,
-					Kv: &mvccpb.KeyValue{
+					Type: kv.EventTypePut,
+					Kv: &kv.KeyValue{
 						Value: []byte(``),
 					},
 				},
 				{
-					Type: mvccpb.DELETE,
-					PrevKv: &mvccpb.KeyValue{
+					Type: kv.EventTypeDelete,
+					PrevKv: &kv.KeyValue{
 						Value: []byte(``),
 					},
 				},
@@ -299,20 +312,24 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("err compacted resp, nil Rewatch", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
+			Canceled:        true,
+			Err:             kv.ErrCompacted,
 		}
 		err := w.handleWatchResponse(wresp)
 		assert.Error(t, err)
-		assert.Equal(t, v3rpc.ErrCompacted, err)
+		assert.Equal(t, kv.ErrCompacted, err)
 	})
 
 	t.Run("err compacted resp, valid Rewatch", func(t *testing.T) {
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return nil
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
+			Canceled:        true,
+			Err:             kv.ErrCompacted,
 		}
 		err := w.handleWatchResponse(wresp)
 		assert.NoError(t, err)
@@ -320,21 +337,26 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 
 	t.Run("err canceled", func(t *testing.T) {
 		w := getWatcher(s, nil)
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			Canceled: true,
+			Err:      errors.New("watch canceled"),
 		}
 		err := w.handleWatchResponse(wresp)
 		assert.Error(t, err)
 	})
 
 	t.Run("err handled but list failed", func(t *testing.T) {
+		etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
+		require.NoError(t, err)
 		s := NewSession(ctx, "/by-dev/session-ut", etcdCli)
-		s.etcdCli.Close()
+		etcdCli.Close()
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return nil
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
+			Canceled:        true,
+			Err:             kv.ErrCompacted,
 		}
 
 		err = w.handleWatchResponse(wresp)
@@ -345,8 +367,10 @@ func TestWatcherHandleWatchResp(t *testing.T) {
 		w := getWatcher(s, func(sessions map[string]*Session) error {
 			return errors.New("mocked")
 		})
-		wresp := clientv3.WatchResponse{
+		wresp := kv.WatchResponse{
 			CompactRevision: 1,
+			Canceled:        true,
+			Err:             kv.ErrCompacted,
 		}
 		err := w.handleWatchResponse(wresp)
 
@@ -365,31 +389,22 @@ func TestSessionRevoke(t *testing.T) {
 		s.Revoke(time.Second)
 	})
 
-	ctx := context.Background()
-	Params.Init()
-
-	endpoints, err := Params.Load("_EtcdEndpoints")
-	if err != nil {
-		panic(err)
+	for _, backend := range newSessionBackends(t) {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			ctx := context.Background()
+			metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+			err := backend.rootKV.RemoveWithPrefix(metaRoot)
+			assert.NoError(t, err)
+			defer backend.rootKV.RemoveWithPrefix(metaRoot)
+
+			s := backend.newSession(ctx, metaRoot)
+			s.Init("revoketest", "testAddr", false, false)
+			assert.NotPanics(t, func() {
+				s.Revoke(time.Second)
+			})
+		})
 	}
-	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
-
-	etcdEndpoints := strings.Split(endpoints, ",")
-	etcdCli, err := etcd.GetRemoteEtcdClient(etcdEndpoints)
-	defer etcdCli.Close()
-	require.NoError(t, err)
-	etcdKV := etcdkv.NewEtcdKV(etcdCli, metaRoot)
-	err = etcdKV.RemoveWithPrefix("")
-	assert.NoError(t, err)
-
-	defer etcdKV.Close()
-	defer etcdKV.RemoveWithPrefix("")
-
-	s = NewSession(ctx, metaRoot, etcdCli)
-	s.Init("revoketest", "testAddr", false, false)
-	assert.NotPanics(t, func() {
-		s.Revoke(time.Second)
-	})
 }
 
 func TestSession_Registered(t *testing.T) {
@@ -399,3 +414,113 @@ func TestSession_Registered(t *testing.T) {
 	session.UpdateRegistered(true)
 	assert.True(t, session.Registered())
 }
+
+func TestSessionWithEmbeddedMetaKV(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store, err := embeddedkv.NewStore(memkv.NewMemoryKV())
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV := embeddedkv.NewMetaKV(store, "by-dev/meta")
+
+	s := NewSessionWithMetaKV(ctx, metaKV)
+	sessions, rev, err := s.GetSessions("test")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+	eventCh := s.WatchServices("test", rev+1, nil)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	serverIDs := make(map[int64]*Session)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			singleS := NewSessionWithMetaKV(ctx, metaKV)
+			singleS.Init("test", "testAddr", false, false)
+			singleS.Register()
+			mu.Lock()
+			serverIDs[singleS.ServerID] = singleS
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	// the server ids are unique
+	assert.Equal(t, 10, len(serverIDs))
+
+	sessions, _, err = s.GetSessions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, len(sessions))
+	for id := range serverIDs {
+		assert.Contains(t, sessions, "test-"+strconv.FormatInt(id, 10))
+	}
+	for i := 0; i < 10; i++ {
+		e := <-eventCh
+		assert.Equal(t, SessionAddEvent, e.EventType)
+		assert.Contains(t, serverIDs, e.Session.ServerID)
+	}
+
+	// an exclusive server can only register once
+	exclusive := NewSessionWithMetaKV(ctx, metaKV)
+	exclusive.Init("exclusive", "testAddr", true, false)
+	exclusive.Register()
+	sessions, _, err = s.GetSessions("exclusive")
+	assert.NoError(t, err)
+	assert.Contains(t, sessions, "exclusive")
+	err = metaKV.CompareVersionAndSwap(path.Join(DefaultServiceRoot, "exclusive"), 0, "{}")
+	assert.Error(t, err)
+
+	// the session is deleted once it's revoked, and the liveness check finds it
+	for _, singleS := range serverIDs {
+		lost := make(chan struct{})
+		go singleS.LivenessCheck(ctx, func() {
+			close(lost)
+		})
+		singleS.Revoke(time.Second)
+		e := <-eventCh
+		assert.Equal(t, SessionDelEvent, e.EventType)
+		assert.Equal(t, singleS.ServerID, e.Session.ServerID)
+		<-lost
+		break
+	}
+	sessions, _, err = s.GetSessions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 9, len(sessions))
+}
+
+func TestSessionWatchServicesRewatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	backend := memkv.NewMemoryKV()
+	store, err := embeddedkv.NewStore(backend)
+	require.NoError(t, err)
+	metaKV := embeddedkv.NewMetaKV(store, "by-dev/meta")
+
+	s := NewSessionWithMetaKV(ctx, metaKV)
+	s.Init("test", "testAddr", false, false)
+	s.Register()
+	_, rev, err := s.GetSessions("test")
+	require.NoError(t, err)
+	store.Close()
+
+	// the revision to watch from is compacted after the store is reopened
+	store, err = embeddedkv.NewStore(backend)
+	require.NoError(t, err)
+	defer store.Close()
+	metaKV = embeddedkv.NewMetaKV(store, "by-dev/meta")
+	s = NewSessionWithMetaKV(ctx, metaKV)
+	rewatched := make(chan map[string]*Session, 1)
+	eventCh := s.WatchServices("test", rev, func(sessions map[string]*Session) error {
+		rewatched <- sessions
+		return nil
+	})
+	sessions := <-rewatched
+	// the session of the last process is gone with its lease
+	assert.Empty(t, sessions)
+
+	s.Init("test", "testAddr", false, false)
+	s.Register()
+	e := <-eventCh
+	assert.Equal(t, SessionAddEvent, e.EventType)
+	assert.Equal(t, s.ServerID, e.Session.ServerID)
+}
@@ -17,6 +17,7 @@
 package typeutil
 
 import (
+	"fmt"
 	"unsafe"
 
 	"github.com/milvus-io/milvus/internal/common"
@@ -46,6 +47,18 @@ func Hash32Int64(v int64) (uint32, error) {
 	return Hash32Uint64(uint64(v))
 }
 
+// Hash32PartitionKey hashing a value of the partition key field, which is an int64 or a string
+func Hash32PartitionKey(v interface{}) (uint32, error) {
+	switch key := v.(type) {
+	case int64:
+		return Hash32Int64(key)
+	case string:
+		return Hash32Bytes([]byte(key))
+	default:
+		return 0, fmt.Errorf("unsupported partition key type %T", v)
+	}
+}
+
 // Hash32String hashing a string to int64
 func Hash32String(s string) (int64, error) {
 	b := []byte(s)
@@ -67,3 +67,20 @@ func TestHash32_String(t *testing.T) {
 
 	assert.Equal(t, uint32(h), h2)
 }
+
+func TestHash32_PartitionKey(t *testing.T) {
+	h, err := Hash32PartitionKey(int64(100))
+	assert.Nil(t, err)
+	h1, err := Hash32Int64(100)
+	assert.Nil(t, err)
+	assert.Equal(t, h1, h)
+
+	h, err = Hash32PartitionKey("tenant")
+	assert.Nil(t, err)
+	h2, err := Hash32String("tenant")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(h2), h)
+
+	_, err = Hash32PartitionKey(1.5)
+	assert.NotNil(t, err)
+}
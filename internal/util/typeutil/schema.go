@@ -19,6 +19,7 @@ package typeutil
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -41,6 +42,8 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 8
 		case schemapb.DataType_String:
 			res += 125 // todo find a better way to estimate string type
+		case schemapb.DataType_JSON:
+			res += 256 // json documents are usually larger than plain strings
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -189,6 +192,51 @@ func IsBoolType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsStringType returns true if input is a string type, otherwise false
+func IsStringType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_String:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsJSONType returns true if input is a json type, otherwise false
+func IsJSONType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_JSON
+}
+
+// GetPartitionKeyField returns the partition key field of the schema, nil if there is none
+func GetPartitionKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.IsPartitionKey {
+			return field
+		}
+	}
+	return nil
+}
+
+// GetClusteringKeyField returns the clustering key field of the schema, nil if there is none
+func GetClusteringKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.IsClusteringKey {
+			return field
+		}
+	}
+	return nil
+}
+
+// IsClusteringKeyType returns true if a field of the data type can be the clustering key
+func IsClusteringKeyType(dataType schemapb.DataType) bool {
+	return IsIntegerType(dataType) || IsFloatingType(dataType) || IsStringType(dataType)
+}
+
+// PartitionKeyPartitionName returns the name of the idx-th partition created for a collection with partition key
+func PartitionKeyPartitionName(defaultPartitionName string, idx int64) string {
+	return fmt.Sprintf("%s_%d", defaultPartitionName, idx)
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -255,6 +303,26 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
 				}
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{srcScalar.StringData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -298,3 +366,154 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 		}
 	}
 }
+
+// GetSizeOfIDs returns the number of int64 or string primary keys in data
+func GetSizeOfIDs(data *schemapb.IDs) int {
+	switch data.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(data.GetIntId().GetData())
+	case *schemapb.IDs_StrId:
+		return len(data.GetStrId().GetData())
+	}
+	return 0
+}
+
+// GetPK returns the primary key of specified index in data, which is either an int64 or a string
+func GetPK(data *schemapb.IDs, idx int64) interface{} {
+	switch data.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return data.GetIntId().GetData()[idx]
+	case *schemapb.IDs_StrId:
+		return data.GetStrId().GetData()[idx]
+	}
+	return nil
+}
+
+// AppendPKs appends the int64 or string primary key pk to pks
+func AppendPKs(pks *schemapb.IDs, pk interface{}) {
+	switch realPK := pk.(type) {
+	case int64:
+		if pks.GetIntId() == nil {
+			pks.IdField = &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{},
+			}
+		}
+		pks.GetIntId().Data = append(pks.GetIntId().Data, realPK)
+	case string:
+		if pks.GetStrId() == nil {
+			pks.IdField = &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{},
+			}
+		}
+		pks.GetStrId().Data = append(pks.GetStrId().Data, realPK)
+	default:
+		log.Warn("got unexpected data type of pk when append pks", zap.Any("pk", pk))
+	}
+}
+
+// ComparePK returns whether the primary key a is less than b, they are both int64 or both string
+func ComparePK(a, b interface{}) bool {
+	switch realA := a.(type) {
+	case int64:
+		return realA < b.(int64)
+	case string:
+		return realA < b.(string)
+	}
+	log.Warn("got unexpected data type of pk when compare pks", zap.Any("pk", a))
+	return false
+}
+
+// CheckDefaultValue returns an error if the default value of field is missing or doesn't match the field's data type
+func CheckDefaultValue(field *schemapb.FieldSchema) error {
+	value := field.GetDefaultValue()
+	if value == nil || value.GetData() == nil {
+		return fmt.Errorf("default value of field %s is not set", field.GetName())
+	}
+	mismatch := fmt.Errorf("type of default value %T mismatches field %s of type %s", value.GetData(), field.GetName(), field.GetDataType().String())
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		if _, ok := value.GetData().(*schemapb.ValueField_BoolData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data, ok := value.GetData().(*schemapb.ValueField_IntData)
+		if !ok {
+			return mismatch
+		}
+		if (field.GetDataType() == schemapb.DataType_Int8 && (data.IntData < math.MinInt8 || data.IntData > math.MaxInt8)) ||
+			(field.GetDataType() == schemapb.DataType_Int16 && (data.IntData < math.MinInt16 || data.IntData > math.MaxInt16)) {
+			return fmt.Errorf("default value %d overflows field %s of type %s", data.IntData, field.GetName(), field.GetDataType().String())
+		}
+	case schemapb.DataType_Int64:
+		if _, ok := value.GetData().(*schemapb.ValueField_LongData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Float:
+		if _, ok := value.GetData().(*schemapb.ValueField_FloatData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Double:
+		if _, ok := value.GetData().(*schemapb.ValueField_DoubleData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_String:
+		if _, ok := value.GetData().(*schemapb.ValueField_StringData); !ok {
+			return mismatch
+		}
+	default:
+		return fmt.Errorf("field %s of type %s can't have a default value", field.GetName(), field.GetDataType().String())
+	}
+	return nil
+}
+
+// GenDefaultFieldData returns numRows copies of the default value of field
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	if err := CheckDefaultValue(field); err != nil {
+		return nil, err
+	}
+	scalars := &schemapb.ScalarField{}
+	switch value := field.GetDefaultValue().GetData().(type) {
+	case *schemapb.ValueField_BoolData:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.BoolData
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case *schemapb.ValueField_IntData:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.IntData
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case *schemapb.ValueField_LongData:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.LongData
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case *schemapb.ValueField_FloatData:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.FloatData
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case *schemapb.ValueField_DoubleData:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.DoubleData
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case *schemapb.ValueField_StringData:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.StringData
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
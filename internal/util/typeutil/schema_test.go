@@ -186,6 +186,16 @@ func TestSchema(t *testing.T) {
 		assert.False(t, IsFloatingType(schemapb.DataType_String))
 		assert.False(t, IsFloatingType(schemapb.DataType_BinaryVector))
 		assert.False(t, IsFloatingType(schemapb.DataType_FloatVector))
+
+		assert.False(t, IsStringType(schemapb.DataType_Bool))
+		assert.False(t, IsStringType(schemapb.DataType_Int64))
+		assert.False(t, IsStringType(schemapb.DataType_Double))
+		assert.True(t, IsStringType(schemapb.DataType_String))
+		assert.False(t, IsStringType(schemapb.DataType_FloatVector))
+		assert.False(t, IsStringType(schemapb.DataType_JSON))
+
+		assert.True(t, IsJSONType(schemapb.DataType_JSON))
+		assert.False(t, IsJSONType(schemapb.DataType_String))
 	})
 }
 
@@ -412,6 +422,36 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 			},
 			FieldId: fieldID,
 		}
+	case schemapb.DataType_String:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_String,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: fieldValue.([]string),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
+	case schemapb.DataType_JSON:
+		fieldData = &schemapb.FieldData{
+			Type:      schemapb.DataType_JSON,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: fieldValue.([][]byte),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
 	case schemapb.DataType_BinaryVector:
 		fieldData = &schemapb.FieldData{
 			Type:      schemapb.DataType_BinaryVector,
@@ -459,6 +499,8 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		JSONFieldName         = "JSONField"
+		StringFieldName       = "StringField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -466,6 +508,8 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		JSONFieldID           = common.StartOfUserFieldID + 8
+		StringFieldID         = common.StartOfUserFieldID + 9
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -474,8 +518,10 @@ func TestAppendFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	JSONArray := [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":5}`)}
+	StringArray := []string{"a", "b"}
 
-	result := make([]*schemapb.FieldData, 7)
+	result := make([]*schemapb.FieldData, 9)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -484,6 +530,8 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(JSONFieldName, JSONFieldID, schemapb.DataType_JSON, JSONArray[0:1], 1))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(StringFieldName, StringFieldID, schemapb.DataType_String, StringArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -493,6 +541,8 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(JSONFieldName, JSONFieldID, schemapb.DataType_JSON, JSONArray[1:2], 1))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(StringFieldName, StringFieldID, schemapb.DataType_String, StringArray[1:2], 1))
 
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
@@ -504,4 +554,111 @@ func TestAppendFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray, result[7].GetScalars().GetBytesData().Data)
+	assert.Equal(t, StringArray, result[8].GetScalars().GetStringData().Data)
+}
+
+func TestPKs(t *testing.T) {
+	intPks := &schemapb.IDs{}
+	AppendPKs(intPks, int64(1))
+	AppendPKs(intPks, int64(2))
+	assert.Equal(t, 2, GetSizeOfIDs(intPks))
+	assert.Equal(t, int64(2), GetPK(intPks, 1))
+
+	strPks := &schemapb.IDs{}
+	AppendPKs(strPks, "a")
+	AppendPKs(strPks, "b")
+	AppendPKs(strPks, 1.0)
+	assert.Equal(t, 2, GetSizeOfIDs(strPks))
+	assert.Equal(t, "a", GetPK(strPks, 0))
+	assert.Equal(t, []string{"a", "b"}, strPks.GetStrId().GetData())
+
+	assert.Equal(t, 0, GetSizeOfIDs(&schemapb.IDs{}))
+	assert.Nil(t, GetPK(&schemapb.IDs{}, 0))
+	assert.True(t, ComparePK(int64(1), int64(2)))
+	assert.False(t, ComparePK(int64(2), int64(2)))
+	assert.True(t, ComparePK("a", "b"))
+	assert.False(t, ComparePK("b", "a"))
+	assert.False(t, ComparePK(1.0, 2.0))
+}
+
+func TestCheckDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:     "field",
+		DataType: schemapb.DataType_Int8,
+	}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 127}}
+	assert.NoError(t, CheckDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 128}}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DataType = schemapb.DataType_Int64
+	assert.NoError(t, CheckDefaultValue(field))
+
+	field.DataType = schemapb.DataType_FloatVector
+	assert.Error(t, CheckDefaultValue(field))
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:      common.StartOfUserFieldID,
+		Name:         "field",
+		DataType:     schemapb.DataType_Double,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 1.5}},
+	}
+	fieldData, err := GenDefaultFieldData(field, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, field.FieldID, fieldData.FieldId)
+	assert.Equal(t, field.Name, fieldData.FieldName)
+	assert.Equal(t, schemapb.DataType_Double, fieldData.Type)
+	assert.Equal(t, []float64{1.5, 1.5, 1.5}, fieldData.GetScalars().GetDoubleData().GetData())
+
+	field.DataType = schemapb.DataType_String
+	_, err = GenDefaultFieldData(field, 3)
+	assert.Error(t, err)
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "a"}, fieldData.GetScalars().GetStringData().GetData())
+}
+
+func TestGetPartitionKeyField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_String},
+		},
+	}
+	assert.Nil(t, GetPartitionKeyField(schema))
+
+	schema.Fields[1].IsPartitionKey = true
+	assert.Equal(t, "tenant", GetPartitionKeyField(schema).GetName())
+	assert.Equal(t, "_default_3", PartitionKeyPartitionName("_default", 3))
+}
+
+func TestGetClusteringKeyField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+		},
+	}
+	assert.Nil(t, GetClusteringKeyField(schema))
+
+	schema.Fields[1].IsClusteringKey = true
+	assert.Equal(t, "age", GetClusteringKeyField(schema).GetName())
+
+	assert.True(t, IsClusteringKeyType(schemapb.DataType_Int8))
+	assert.True(t, IsClusteringKeyType(schemapb.DataType_Double))
+	assert.True(t, IsClusteringKeyType(schemapb.DataType_String))
+	assert.False(t, IsClusteringKeyType(schemapb.DataType_Bool))
+	assert.False(t, IsClusteringKeyType(schemapb.DataType_JSON))
+	assert.False(t, IsClusteringKeyType(schemapb.DataType_FloatVector))
 }